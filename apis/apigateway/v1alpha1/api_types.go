@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// States of API Gateway resources as reported by the API Gateway API.
+const (
+	StateCreating   = "CREATING"
+	StateActive     = "ACTIVE"
+	StateFailed     = "FAILED"
+	StateDeleting   = "DELETING"
+	StateUpdating   = "UPDATING"
+	StateActivating = "ACTIVATING"
+)
+
+// APIParameters define the desired state of an API Gateway API. The ID of
+// the API is determined by the value of the `crossplane.io/external-name`
+// annotation.
+// https://cloud.google.com/api-gateway/docs/reference/rest/v1/projects.locations.apis
+type APIParameters struct {
+	// DisplayName is a human readable name for the API.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels to associate with this API.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ManagedService is the name of the Service Management service that
+	// backs this API. A service is created when omitted.
+	// +optional
+	// +immutable
+	ManagedService *string `json:"managedService,omitempty"`
+}
+
+// APIObservation is used to show the observed state of the API resource on
+// GCP.
+type APIObservation struct {
+	// Name is the resource name of the API, in the form
+	// projects/{project}/locations/global/apis/{api}.
+	Name string `json:"name,omitempty"`
+
+	// State of the API.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the API was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the API was last updated.
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+}
+
+// An APISpec defines the desired state of an API.
+type APISpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  APIParameters `json:"forProvider,omitempty"`
+}
+
+// An APIStatus represents the observed state of an API.
+type APIStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     APIObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An API is a managed resource that represents a Google API Gateway API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type API struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   APISpec   `json:"spec"`
+	Status APIStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// APIList contains a list of API.
+type APIList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []API `json:"items"`
+}
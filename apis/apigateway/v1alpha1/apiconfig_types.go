@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// APIConfigParameters define the desired state of an API Gateway API config.
+// The ID of the config is determined by the value of the
+// `crossplane.io/external-name` annotation. A config cannot be changed once it
+// has been created, except for its display name and labels; create a new
+// config and point the Gateway at it instead.
+// https://cloud.google.com/api-gateway/docs/reference/rest/v1/projects.locations.apis.configs
+type APIConfigParameters struct {
+	// API is the ID of the API this config belongs to.
+	// +optional
+	// +immutable
+	API *string `json:"api,omitempty"`
+
+	// APIRef references an API and retrieves its external name.
+	// +optional
+	// +immutable
+	APIRef *runtimev1alpha1.Reference `json:"apiRef,omitempty"`
+
+	// APISelector selects a reference to an API.
+	// +optional
+	APISelector *runtimev1alpha1.Selector `json:"apiSelector,omitempty"`
+
+	// DisplayName is a human readable name for the config.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels to associate with this config.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// GatewayServiceAccount is the email of the service account that
+	// gateways serving this config use to authenticate to backends.
+	// +optional
+	// +immutable
+	GatewayServiceAccount *string `json:"gatewayServiceAccount,omitempty"`
+
+	// OpenAPIDocuments are the OpenAPI specs that define the API.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	OpenAPIDocuments []OpenAPIDocument `json:"openapiDocuments"`
+}
+
+// An OpenAPIDocument is an OpenAPI spec. Exactly one of Contents or GCSSource
+// must be specified.
+type OpenAPIDocument struct {
+	// Path is the file name of the document, e.g. openapi.yaml.
+	Path string `json:"path"`
+
+	// Contents of the document.
+	// +optional
+	Contents *string `json:"contents,omitempty"`
+
+	// GCSSource is a Cloud Storage object from which the contents of the
+	// document are read when the config is created.
+	// +optional
+	GCSSource *GCSSource `json:"gcsSource,omitempty"`
+}
+
+// A GCSSource identifies a Cloud Storage object.
+type GCSSource struct {
+	// Bucket is the name of the bucket containing the object.
+	Bucket string `json:"bucket"`
+
+	// Object is the name of the object.
+	Object string `json:"object"`
+}
+
+// APIConfigObservation is used to show the observed state of the APIConfig
+// resource on GCP.
+type APIConfigObservation struct {
+	// Name is the resource name of the config, in the form
+	// projects/{project}/locations/global/apis/{api}/configs/{config}.
+	Name string `json:"name,omitempty"`
+
+	// ServiceConfigID is the ID of the Service Management service config
+	// generated from this config.
+	ServiceConfigID string `json:"serviceConfigId,omitempty"`
+
+	// State of the config.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the config was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the config was last updated.
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+}
+
+// An APIConfigSpec defines the desired state of an APIConfig.
+type APIConfigSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  APIConfigParameters `json:"forProvider"`
+}
+
+// An APIConfigStatus represents the observed state of an APIConfig.
+type APIConfigStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     APIConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An APIConfig is a managed resource that represents a Google API Gateway API
+// config.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="API",type="string",JSONPath=".spec.forProvider.api"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type APIConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   APIConfigSpec   `json:"spec"`
+	Status APIConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// APIConfigList contains a list of APIConfig.
+type APIConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIConfig `json:"items"`
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// GatewayParameters define the desired state of an API Gateway gateway. The
+// ID of the gateway is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/api-gateway/docs/reference/rest/v1/projects.locations.gateways
+type GatewayParameters struct {
+	// Region in which the gateway is deployed, e.g. us-central1.
+	// +immutable
+	Region string `json:"region"`
+
+	// APIConfig is the resource name of the API config the gateway serves,
+	// in the form
+	// projects/{project}/locations/global/apis/{api}/configs/{config}.
+	// Changing it rolls the gateway out to the new config.
+	// +optional
+	APIConfig *string `json:"apiConfig,omitempty"`
+
+	// APIConfigRef references an APIConfig and retrieves its resource name.
+	// +optional
+	APIConfigRef *runtimev1alpha1.Reference `json:"apiConfigRef,omitempty"`
+
+	// APIConfigSelector selects a reference to an APIConfig.
+	// +optional
+	APIConfigSelector *runtimev1alpha1.Selector `json:"apiConfigSelector,omitempty"`
+
+	// DisplayName is a human readable name for the gateway.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels to associate with this gateway.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// GatewayObservation is used to show the observed state of the Gateway
+// resource on GCP.
+type GatewayObservation struct {
+	// Name is the resource name of the gateway, in the form
+	// projects/{project}/locations/{region}/gateways/{gateway}.
+	Name string `json:"name,omitempty"`
+
+	// DefaultHostname is the hostname at which the gateway serves the API.
+	DefaultHostname string `json:"defaultHostname,omitempty"`
+
+	// State of the gateway.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the gateway was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the gateway was last updated.
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+}
+
+// A GatewaySpec defines the desired state of a Gateway.
+type GatewaySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  GatewayParameters `json:"forProvider"`
+}
+
+// A GatewayStatus represents the observed state of a Gateway.
+type GatewayStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     GatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Gateway is a managed resource that represents a Google API Gateway
+// gateway. Its default hostname is published as the endpoint connection
+// detail.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="HOSTNAME",type="string",JSONPath=".status.atProvider.defaultHostname"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewaySpec   `json:"spec"`
+	Status GatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayList contains a list of Gateway.
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Gateway `json:"items"`
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// APIConfigName extracts the resource name of an APIConfig.
+func APIConfigName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		c, ok := mg.(*APIConfig)
+		if !ok {
+			return ""
+		}
+		return c.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this APIConfig
+func (mg *APIConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.api
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.API),
+		Reference:    mg.Spec.ForProvider.APIRef,
+		Selector:     mg.Spec.ForProvider.APISelector,
+		To:           reference.To{Managed: &API{}, List: &APIList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.API = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.APIRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Gateway
+func (mg *Gateway) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.apiConfig
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.APIConfig),
+		Reference:    mg.Spec.ForProvider.APIConfigRef,
+		Selector:     mg.Spec.ForProvider.APIConfigSelector,
+		To:           reference.To{Managed: &APIConfig{}, List: &APIConfigList{}},
+		Extract:      APIConfigName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.APIConfig = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.APIConfigRef = rsp.ResolvedReference
+
+	return nil
+}
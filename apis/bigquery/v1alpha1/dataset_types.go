@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// DatasetParameters define the desired state of a BigQuery Dataset.
+// https://cloud.google.com/bigquery/docs/reference/rest/v2/datasets
+type DatasetParameters struct {
+	// Location is the geographic location where the dataset should reside.
+	// Defaults to US.
+	// +optional
+	// +immutable
+	Location *string `json:"location,omitempty"`
+
+	// FriendlyName is a descriptive name for the dataset.
+	// +optional
+	FriendlyName *string `json:"friendlyName,omitempty"`
+
+	// Description is a user-friendly description of the dataset.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DefaultTableExpirationMs is the default lifetime of all tables in the
+	// dataset, in milliseconds. The minimum value is 3600000 milliseconds
+	// (one hour). It only applies to tables that are created after it is
+	// set.
+	// +optional
+	// +kubebuilder:validation:Minimum=3600000
+	DefaultTableExpirationMs *int64 `json:"defaultTableExpirationMs,omitempty"`
+
+	// Labels to associate with this dataset.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Access controls who may access the dataset. When it is set, it
+	// replaces all access entries of the dataset, including those BigQuery
+	// grants by default when the dataset is created.
+	// +optional
+	Access []DatasetAccess `json:"access,omitempty"`
+}
+
+// A DatasetAccess grants a role on a dataset to a user, group, domain,
+// special group or IAM member, or grants a view access to the dataset.
+// Exactly one grantee must be specified.
+type DatasetAccess struct {
+	// Role that is granted. Either a basic role, i.e. OWNER, WRITER or
+	// READER, or a predefined BigQuery role such as
+	// roles/bigquery.dataViewer. Required unless View is set.
+	// +optional
+	Role *string `json:"role,omitempty"`
+
+	// UserByEmail is the email address of a user or service account to
+	// grant access to.
+	// +optional
+	UserByEmail *string `json:"userByEmail,omitempty"`
+
+	// GroupByEmail is the email address of a Google Group to grant access
+	// to.
+	// +optional
+	GroupByEmail *string `json:"groupByEmail,omitempty"`
+
+	// Domain is a domain to grant access to, e.g. example.com.
+	// +optional
+	Domain *string `json:"domain,omitempty"`
+
+	// SpecialGroup is a special group to grant access to.
+	// +optional
+	// +kubebuilder:validation:Enum=projectOwners;projectReaders;projectWriters;allAuthenticatedUsers
+	SpecialGroup *string `json:"specialGroup,omitempty"`
+
+	// IAMMember is an IAM member to grant access to, e.g.
+	// user:jane@example.com.
+	// +optional
+	IAMMember *string `json:"iamMember,omitempty"`
+
+	// View is a view from a different dataset to grant access to. Queries
+	// executed against that view have read access to the tables in this
+	// dataset.
+	// +optional
+	View *TableReference `json:"view,omitempty"`
+}
+
+// DatasetObservation is used to show the observed state of the Dataset
+// resource on GCP.
+type DatasetObservation struct {
+	// ID is the fully-qualified, unique, opaque ID of the dataset.
+	ID string `json:"id,omitempty"`
+
+	// SelfLink is a URL that can be used to access the dataset again.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Etag is a hash of the dataset.
+	Etag string `json:"etag,omitempty"`
+
+	// CreationTime is the time when this dataset was created.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// LastModifiedTime is the time when this dataset or any of its tables
+	// was last modified.
+	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
+}
+
+// A DatasetSpec defines the desired state of a Dataset.
+type DatasetSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DatasetParameters `json:"forProvider"`
+}
+
+// A DatasetStatus represents the observed state of a Dataset.
+type DatasetStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DatasetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Dataset is a managed resource that represents a Google BigQuery Dataset.
+// The external name of the dataset is its dataset ID. Dataset IDs may not
+// contain hyphens, so the external name must be set explicitly when the name
+// of the managed resource contains one.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Dataset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatasetSpec   `json:"spec"`
+	Status DatasetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatasetList contains a list of Dataset.
+type DatasetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dataset `json:"items"`
+}
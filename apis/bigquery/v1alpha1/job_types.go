@@ -0,0 +1,318 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Job states as reported by the BigQuery API.
+const (
+	JobStatePending = "PENDING"
+	JobStateRunning = "RUNNING"
+	JobStateDone    = "DONE"
+)
+
+// JobParameters define the desired state of a BigQuery Job. Exactly one of
+// Query, Load, Extract or Copy must be specified. Jobs are run once when they
+// are created; all fields are immutable.
+// https://cloud.google.com/bigquery/docs/reference/rest/v2/Job
+type JobParameters struct {
+	// Location is the geographic location in which the job should run. It
+	// must match the location of any datasets the job reads or writes.
+	// +optional
+	// +immutable
+	Location *string `json:"location,omitempty"`
+
+	// Labels to associate with this job.
+	// +optional
+	// +immutable
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// JobTimeoutMs is the time in milliseconds after which BigQuery attempts
+	// to cancel the job.
+	// +optional
+	// +immutable
+	JobTimeoutMs *int64 `json:"jobTimeoutMs,omitempty"`
+
+	// Query configures a query job.
+	// +optional
+	// +immutable
+	Query *JobConfigurationQuery `json:"query,omitempty"`
+
+	// Load configures a load job.
+	// +optional
+	// +immutable
+	Load *JobConfigurationLoad `json:"load,omitempty"`
+
+	// Extract configures an extract job.
+	// +optional
+	// +immutable
+	Extract *JobConfigurationExtract `json:"extract,omitempty"`
+
+	// Copy configures a copy job.
+	// +optional
+	// +immutable
+	Copy *JobConfigurationTableCopy `json:"copy,omitempty"`
+}
+
+// A TableReference identifies a BigQuery table.
+type TableReference struct {
+	// ProjectID is the ID of the project containing this table. Defaults to
+	// the project of the Provider.
+	// +optional
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// DatasetID is the ID of the dataset containing this table.
+	DatasetID string `json:"datasetId"`
+
+	// TableID is the ID of the table.
+	TableID string `json:"tableId"`
+}
+
+// A DatasetReference identifies a BigQuery dataset.
+type DatasetReference struct {
+	// ProjectID is the ID of the project containing this dataset. Defaults to
+	// the project of the Provider.
+	// +optional
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// DatasetID is the ID of the dataset.
+	DatasetID string `json:"datasetId"`
+}
+
+// JobConfigurationQuery configures a BigQuery query job.
+type JobConfigurationQuery struct {
+	// Query is the SQL query text to execute.
+	Query string `json:"query"`
+
+	// UseLegacySQL specifies whether to use BigQuery's legacy SQL dialect for
+	// this query. Defaults to true on the BigQuery side.
+	// +optional
+	UseLegacySQL *bool `json:"useLegacySql,omitempty"`
+
+	// DefaultDataset is the dataset to use for unqualified table names in
+	// the query.
+	// +optional
+	DefaultDataset *DatasetReference `json:"defaultDataset,omitempty"`
+
+	// DestinationTable is the table where the query results are stored.
+	// +optional
+	DestinationTable *TableReference `json:"destinationTable,omitempty"`
+
+	// CreateDisposition specifies whether the job is allowed to create new
+	// tables.
+	// +optional
+	// +kubebuilder:validation:Enum=CREATE_IF_NEEDED;CREATE_NEVER
+	CreateDisposition *string `json:"createDisposition,omitempty"`
+
+	// WriteDisposition specifies the action that occurs if the destination
+	// table already exists.
+	// +optional
+	// +kubebuilder:validation:Enum=WRITE_TRUNCATE;WRITE_APPEND;WRITE_EMPTY
+	WriteDisposition *string `json:"writeDisposition,omitempty"`
+
+	// Priority specifies a priority for the query.
+	// +optional
+	// +kubebuilder:validation:Enum=INTERACTIVE;BATCH
+	Priority *string `json:"priority,omitempty"`
+
+	// MaximumBytesBilled limits the bytes billed for this job. Queries that
+	// will have bytes billed beyond this limit will fail without incurring a
+	// charge.
+	// +optional
+	MaximumBytesBilled *int64 `json:"maximumBytesBilled,omitempty"`
+}
+
+// JobConfigurationLoad configures a BigQuery load job.
+type JobConfigurationLoad struct {
+	// SourceURIs are the fully-qualified URIs that point to the data in
+	// Google Cloud Storage, e.g. gs://bucket/path/*.csv.
+	SourceURIs []string `json:"sourceUris"`
+
+	// DestinationTable is the table to load the data into.
+	DestinationTable TableReference `json:"destinationTable"`
+
+	// SourceFormat is the format of the data files.
+	// +optional
+	// +kubebuilder:validation:Enum=CSV;NEWLINE_DELIMITED_JSON;AVRO;PARQUET;ORC;DATASTORE_BACKUP
+	SourceFormat *string `json:"sourceFormat,omitempty"`
+
+	// Autodetect indicates whether BigQuery should automatically infer the
+	// options and schema for CSV and JSON sources.
+	// +optional
+	Autodetect *bool `json:"autodetect,omitempty"`
+
+	// SkipLeadingRows is the number of rows at the top of a CSV file that
+	// BigQuery will skip when loading the data.
+	// +optional
+	SkipLeadingRows *int64 `json:"skipLeadingRows,omitempty"`
+
+	// FieldDelimiter is the separator for fields in a CSV file.
+	// +optional
+	FieldDelimiter *string `json:"fieldDelimiter,omitempty"`
+
+	// MaxBadRecords is the maximum number of bad records that BigQuery can
+	// ignore when running the job.
+	// +optional
+	MaxBadRecords *int64 `json:"maxBadRecords,omitempty"`
+
+	// CreateDisposition specifies whether the job is allowed to create new
+	// tables.
+	// +optional
+	// +kubebuilder:validation:Enum=CREATE_IF_NEEDED;CREATE_NEVER
+	CreateDisposition *string `json:"createDisposition,omitempty"`
+
+	// WriteDisposition specifies the action that occurs if the destination
+	// table already exists.
+	// +optional
+	// +kubebuilder:validation:Enum=WRITE_TRUNCATE;WRITE_APPEND;WRITE_EMPTY
+	WriteDisposition *string `json:"writeDisposition,omitempty"`
+}
+
+// JobConfigurationExtract configures a BigQuery extract job.
+type JobConfigurationExtract struct {
+	// SourceTable is the table to export.
+	SourceTable TableReference `json:"sourceTable"`
+
+	// DestinationURIs are the fully-qualified Google Cloud Storage URIs where
+	// the extracted table should be written.
+	DestinationURIs []string `json:"destinationUris"`
+
+	// DestinationFormat is the exported file format.
+	// +optional
+	// +kubebuilder:validation:Enum=CSV;NEWLINE_DELIMITED_JSON;AVRO
+	DestinationFormat *string `json:"destinationFormat,omitempty"`
+
+	// Compression is the compression type to use for exported files.
+	// +optional
+	// +kubebuilder:validation:Enum=GZIP;DEFLATE;SNAPPY;NONE
+	Compression *string `json:"compression,omitempty"`
+
+	// FieldDelimiter is the delimiter to use between fields in the exported
+	// data.
+	// +optional
+	FieldDelimiter *string `json:"fieldDelimiter,omitempty"`
+
+	// PrintHeader indicates whether to print out a header row in the results.
+	// +optional
+	PrintHeader *bool `json:"printHeader,omitempty"`
+}
+
+// JobConfigurationTableCopy configures a BigQuery copy job.
+type JobConfigurationTableCopy struct {
+	// SourceTables are the tables to copy.
+	SourceTables []TableReference `json:"sourceTables"`
+
+	// DestinationTable is the table to copy the source tables into.
+	DestinationTable TableReference `json:"destinationTable"`
+
+	// CreateDisposition specifies whether the job is allowed to create new
+	// tables.
+	// +optional
+	// +kubebuilder:validation:Enum=CREATE_IF_NEEDED;CREATE_NEVER
+	CreateDisposition *string `json:"createDisposition,omitempty"`
+
+	// WriteDisposition specifies the action that occurs if the destination
+	// table already exists.
+	// +optional
+	// +kubebuilder:validation:Enum=WRITE_TRUNCATE;WRITE_APPEND;WRITE_EMPTY
+	WriteDisposition *string `json:"writeDisposition,omitempty"`
+}
+
+// JobObservation is used to show the observed state of the Job resource on
+// GCP.
+type JobObservation struct {
+	// ID is the opaque ID of the job.
+	ID string `json:"id,omitempty"`
+
+	// SelfLink is a URL that can be used to access the job again.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// State is the running state of the job, one of PENDING, RUNNING or
+	// DONE.
+	State string `json:"state,omitempty"`
+
+	// ErrorReason is a short error code that summarizes the error the job
+	// finished with, if any.
+	ErrorReason string `json:"errorReason,omitempty"`
+
+	// ErrorMessage is a human-readable description of the error the job
+	// finished with, if any.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// CreationTime is the creation time of this job.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// StartTime is the time this job started running.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time this job finished.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// TotalBytesProcessed is the total number of bytes processed by the job.
+	TotalBytesProcessed int64 `json:"totalBytesProcessed,omitempty"`
+
+	// TotalBytesBilled is the total number of bytes billed for a query job.
+	TotalBytesBilled int64 `json:"totalBytesBilled,omitempty"`
+
+	// NumDMLAffectedRows is the number of rows affected by a DML statement.
+	NumDMLAffectedRows int64 `json:"numDmlAffectedRows,omitempty"`
+
+	// OutputRows is the number of rows imported by a load job.
+	OutputRows int64 `json:"outputRows,omitempty"`
+}
+
+// A JobSpec defines the desired state of a Job.
+type JobSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  JobParameters `json:"forProvider"`
+}
+
+// A JobStatus represents the observed state of a Job.
+type JobStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     JobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Job is a managed resource that represents a Google BigQuery Job. The job
+// is submitted when the resource is created and runs exactly once.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Job struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobSpec   `json:"spec"`
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobList contains a list of Job.
+type JobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Job `json:"items"`
+}
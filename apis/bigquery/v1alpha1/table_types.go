@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TableParameters define the desired state of a BigQuery Table.
+// https://cloud.google.com/bigquery/docs/reference/rest/v2/tables
+type TableParameters struct {
+	// Dataset is the ID of the dataset that contains the table.
+	// +optional
+	// +immutable
+	Dataset *string `json:"dataset,omitempty"`
+
+	// DatasetRef references a Dataset and retrieves its ID.
+	// +optional
+	DatasetRef *runtimev1alpha1.Reference `json:"datasetRef,omitempty"`
+
+	// DatasetSelector selects a reference to a Dataset.
+	// +optional
+	DatasetSelector *runtimev1alpha1.Selector `json:"datasetSelector,omitempty"`
+
+	// FriendlyName is a descriptive name for the table.
+	// +optional
+	FriendlyName *string `json:"friendlyName,omitempty"`
+
+	// Description is a user-friendly description of the table.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to associate with this table.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Schema describes the columns of the table. Columns may be added to
+	// the schema of an existing table, but existing columns cannot be
+	// removed and their types cannot be changed.
+	// +optional
+	Schema []TableFieldSchema `json:"schema,omitempty"`
+
+	// TimePartitioning configures the time-based partitioning of the table.
+	// +optional
+	// +immutable
+	TimePartitioning *TimePartitioning `json:"timePartitioning,omitempty"`
+
+	// ExpirationTime is the time when the table expires. Expired tables are
+	// deleted and their storage reclaimed. Defaults to the default table
+	// expiration of the dataset, if any.
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+}
+
+// A TableFieldSchema describes a column of a table.
+type TableFieldSchema struct {
+	// Name of the column. It may contain only letters, numbers and
+	// underscores, and must start with a letter or underscore.
+	Name string `json:"name"`
+
+	// Type of the column.
+	// +kubebuilder:validation:Enum=STRING;BYTES;INTEGER;INT64;FLOAT;FLOAT64;NUMERIC;BOOLEAN;BOOL;TIMESTAMP;DATE;TIME;DATETIME;GEOGRAPHY;RECORD;STRUCT
+	Type string `json:"type"`
+
+	// Mode of the column. Defaults to NULLABLE. Columns that are added to
+	// an existing table must be NULLABLE or REPEATED.
+	// +optional
+	// +kubebuilder:validation:Enum=NULLABLE;REQUIRED;REPEATED
+	Mode *string `json:"mode,omitempty"`
+
+	// Description of the column.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Fields describes the nested columns of a RECORD column.
+	// +optional
+	Fields []TableFieldSchema `json:"fields,omitempty"`
+}
+
+// TimePartitioning configures the time-based partitioning of a table.
+type TimePartitioning struct {
+	// Type is the granularity of the partitions.
+	// +kubebuilder:validation:Enum=HOUR;DAY;MONTH;YEAR
+	Type string `json:"type"`
+
+	// Field is the TIMESTAMP or DATE column the table is partitioned by. The
+	// table is partitioned by ingestion time if it is not set.
+	// +optional
+	Field *string `json:"field,omitempty"`
+
+	// ExpirationMs is how long BigQuery keeps the storage of a partition,
+	// in milliseconds.
+	// +optional
+	ExpirationMs *int64 `json:"expirationMs,omitempty"`
+
+	// RequirePartitionFilter specifies whether queries of the table must
+	// specify a filter on the partitioning column.
+	// +optional
+	RequirePartitionFilter *bool `json:"requirePartitionFilter,omitempty"`
+}
+
+// TableObservation is used to show the observed state of the Table resource
+// on GCP.
+type TableObservation struct {
+	// ID is the fully-qualified, unique, opaque ID of the table.
+	ID string `json:"id,omitempty"`
+
+	// SelfLink is a URL that can be used to access the table again.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Etag is a hash of the table.
+	Etag string `json:"etag,omitempty"`
+
+	// Type of the table, e.g. TABLE or VIEW.
+	Type string `json:"type,omitempty"`
+
+	// Location is the geographic location where the table resides. It is
+	// inherited from the dataset.
+	Location string `json:"location,omitempty"`
+
+	// CreationTime is the time when this table was created.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// LastModifiedTime is the time when this table was last modified.
+	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
+
+	// NumBytes is the size of this table in bytes, excluding any data in
+	// the streaming buffer.
+	NumBytes int64 `json:"numBytes,omitempty"`
+
+	// NumRows is the number of rows of data in this table, excluding any
+	// data in the streaming buffer.
+	NumRows int64 `json:"numRows,omitempty"`
+}
+
+// A TableSpec defines the desired state of a Table.
+type TableSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TableParameters `json:"forProvider"`
+}
+
+// A TableStatus represents the observed state of a Table.
+type TableStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Table is a managed resource that represents a Google BigQuery Table. The
+// external name of the table is its table ID. Table IDs may not contain
+// hyphens, so the external name must be set explicitly when the name of the
+// managed resource contains one.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DATASET",type="string",JSONPath=".spec.forProvider.dataset"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Table struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TableSpec   `json:"spec"`
+	Status TableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TableList contains a list of Table.
+type TableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Table `json:"items"`
+}
@@ -0,0 +1,874 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dataset) DeepCopyInto(out *Dataset) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dataset.
+func (in *Dataset) DeepCopy() *Dataset {
+	if in == nil {
+		return nil
+	}
+	out := new(Dataset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Dataset) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetAccess) DeepCopyInto(out *DatasetAccess) {
+	*out = *in
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserByEmail != nil {
+		in, out := &in.UserByEmail, &out.UserByEmail
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupByEmail != nil {
+		in, out := &in.GroupByEmail, &out.GroupByEmail
+		*out = new(string)
+		**out = **in
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.SpecialGroup != nil {
+		in, out := &in.SpecialGroup, &out.SpecialGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMMember != nil {
+		in, out := &in.IAMMember, &out.IAMMember
+		*out = new(string)
+		**out = **in
+	}
+	if in.View != nil {
+		in, out := &in.View, &out.View
+		*out = new(TableReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetAccess.
+func (in *DatasetAccess) DeepCopy() *DatasetAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetList) DeepCopyInto(out *DatasetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Dataset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetList.
+func (in *DatasetList) DeepCopy() *DatasetList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetObservation) DeepCopyInto(out *DatasetObservation) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetObservation.
+func (in *DatasetObservation) DeepCopy() *DatasetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetParameters) DeepCopyInto(out *DatasetParameters) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.FriendlyName != nil {
+		in, out := &in.FriendlyName, &out.FriendlyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultTableExpirationMs != nil {
+		in, out := &in.DefaultTableExpirationMs, &out.DefaultTableExpirationMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]DatasetAccess, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetParameters.
+func (in *DatasetParameters) DeepCopy() *DatasetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetReference) DeepCopyInto(out *DatasetReference) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetReference.
+func (in *DatasetReference) DeepCopy() *DatasetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetSpec) DeepCopyInto(out *DatasetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetSpec.
+func (in *DatasetSpec) DeepCopy() *DatasetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetStatus) DeepCopyInto(out *DatasetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetStatus.
+func (in *DatasetStatus) DeepCopy() *DatasetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Job) DeepCopyInto(out *Job) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Job.
+func (in *Job) DeepCopy() *Job {
+	if in == nil {
+		return nil
+	}
+	out := new(Job)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Job) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobConfigurationExtract) DeepCopyInto(out *JobConfigurationExtract) {
+	*out = *in
+	in.SourceTable.DeepCopyInto(&out.SourceTable)
+	if in.DestinationURIs != nil {
+		in, out := &in.DestinationURIs, &out.DestinationURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationFormat != nil {
+		in, out := &in.DestinationFormat, &out.DestinationFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(string)
+		**out = **in
+	}
+	if in.FieldDelimiter != nil {
+		in, out := &in.FieldDelimiter, &out.FieldDelimiter
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrintHeader != nil {
+		in, out := &in.PrintHeader, &out.PrintHeader
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobConfigurationExtract.
+func (in *JobConfigurationExtract) DeepCopy() *JobConfigurationExtract {
+	if in == nil {
+		return nil
+	}
+	out := new(JobConfigurationExtract)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobConfigurationLoad) DeepCopyInto(out *JobConfigurationLoad) {
+	*out = *in
+	if in.SourceURIs != nil {
+		in, out := &in.SourceURIs, &out.SourceURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DestinationTable.DeepCopyInto(&out.DestinationTable)
+	if in.SourceFormat != nil {
+		in, out := &in.SourceFormat, &out.SourceFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Autodetect != nil {
+		in, out := &in.Autodetect, &out.Autodetect
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipLeadingRows != nil {
+		in, out := &in.SkipLeadingRows, &out.SkipLeadingRows
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FieldDelimiter != nil {
+		in, out := &in.FieldDelimiter, &out.FieldDelimiter
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxBadRecords != nil {
+		in, out := &in.MaxBadRecords, &out.MaxBadRecords
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CreateDisposition != nil {
+		in, out := &in.CreateDisposition, &out.CreateDisposition
+		*out = new(string)
+		**out = **in
+	}
+	if in.WriteDisposition != nil {
+		in, out := &in.WriteDisposition, &out.WriteDisposition
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobConfigurationLoad.
+func (in *JobConfigurationLoad) DeepCopy() *JobConfigurationLoad {
+	if in == nil {
+		return nil
+	}
+	out := new(JobConfigurationLoad)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobConfigurationQuery) DeepCopyInto(out *JobConfigurationQuery) {
+	*out = *in
+	if in.UseLegacySQL != nil {
+		in, out := &in.UseLegacySQL, &out.UseLegacySQL
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultDataset != nil {
+		in, out := &in.DefaultDataset, &out.DefaultDataset
+		*out = new(DatasetReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationTable != nil {
+		in, out := &in.DestinationTable, &out.DestinationTable
+		*out = new(TableReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateDisposition != nil {
+		in, out := &in.CreateDisposition, &out.CreateDisposition
+		*out = new(string)
+		**out = **in
+	}
+	if in.WriteDisposition != nil {
+		in, out := &in.WriteDisposition, &out.WriteDisposition
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaximumBytesBilled != nil {
+		in, out := &in.MaximumBytesBilled, &out.MaximumBytesBilled
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobConfigurationQuery.
+func (in *JobConfigurationQuery) DeepCopy() *JobConfigurationQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(JobConfigurationQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobConfigurationTableCopy) DeepCopyInto(out *JobConfigurationTableCopy) {
+	*out = *in
+	if in.SourceTables != nil {
+		in, out := &in.SourceTables, &out.SourceTables
+		*out = make([]TableReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.DestinationTable.DeepCopyInto(&out.DestinationTable)
+	if in.CreateDisposition != nil {
+		in, out := &in.CreateDisposition, &out.CreateDisposition
+		*out = new(string)
+		**out = **in
+	}
+	if in.WriteDisposition != nil {
+		in, out := &in.WriteDisposition, &out.WriteDisposition
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobConfigurationTableCopy.
+func (in *JobConfigurationTableCopy) DeepCopy() *JobConfigurationTableCopy {
+	if in == nil {
+		return nil
+	}
+	out := new(JobConfigurationTableCopy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobList) DeepCopyInto(out *JobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Job, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobList.
+func (in *JobList) DeepCopy() *JobList {
+	if in == nil {
+		return nil
+	}
+	out := new(JobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobObservation) DeepCopyInto(out *JobObservation) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobObservation.
+func (in *JobObservation) DeepCopy() *JobObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(JobObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobParameters) DeepCopyInto(out *JobParameters) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.JobTimeoutMs != nil {
+		in, out := &in.JobTimeoutMs, &out.JobTimeoutMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(JobConfigurationQuery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(JobConfigurationLoad)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Extract != nil {
+		in, out := &in.Extract, &out.Extract
+		*out = new(JobConfigurationExtract)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Copy != nil {
+		in, out := &in.Copy, &out.Copy
+		*out = new(JobConfigurationTableCopy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobParameters.
+func (in *JobParameters) DeepCopy() *JobParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(JobParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobSpec) DeepCopyInto(out *JobSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobSpec.
+func (in *JobSpec) DeepCopy() *JobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobStatus) DeepCopyInto(out *JobStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobStatus.
+func (in *JobStatus) DeepCopy() *JobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Table) DeepCopyInto(out *Table) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Table.
+func (in *Table) DeepCopy() *Table {
+	if in == nil {
+		return nil
+	}
+	out := new(Table)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Table) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableFieldSchema) DeepCopyInto(out *TableFieldSchema) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]TableFieldSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableFieldSchema.
+func (in *TableFieldSchema) DeepCopy() *TableFieldSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(TableFieldSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableList) DeepCopyInto(out *TableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Table, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableList.
+func (in *TableList) DeepCopy() *TableList {
+	if in == nil {
+		return nil
+	}
+	out := new(TableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableObservation) DeepCopyInto(out *TableObservation) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableObservation.
+func (in *TableObservation) DeepCopy() *TableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableParameters) DeepCopyInto(out *TableParameters) {
+	*out = *in
+	if in.Dataset != nil {
+		in, out := &in.Dataset, &out.Dataset
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatasetRef != nil {
+		in, out := &in.DatasetRef, &out.DatasetRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.DatasetSelector != nil {
+		in, out := &in.DatasetSelector, &out.DatasetSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FriendlyName != nil {
+		in, out := &in.FriendlyName, &out.FriendlyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = make([]TableFieldSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimePartitioning != nil {
+		in, out := &in.TimePartitioning, &out.TimePartitioning
+		*out = new(TimePartitioning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableParameters.
+func (in *TableParameters) DeepCopy() *TableParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableReference) DeepCopyInto(out *TableReference) {
+	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableReference.
+func (in *TableReference) DeepCopy() *TableReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TableReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableSpec) DeepCopyInto(out *TableSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableSpec.
+func (in *TableSpec) DeepCopy() *TableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableStatus) DeepCopyInto(out *TableStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableStatus.
+func (in *TableStatus) DeepCopy() *TableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimePartitioning) DeepCopyInto(out *TimePartitioning) {
+	*out = *in
+	if in.Field != nil {
+		in, out := &in.Field, &out.Field
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpirationMs != nil {
+		in, out := &in.ExpirationMs, &out.ExpirationMs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RequirePartitionFilter != nil {
+		in, out := &in.RequirePartitionFilter, &out.RequirePartitionFilter
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimePartitioning.
+func (in *TimePartitioning) DeepCopy() *TimePartitioning {
+	if in == nil {
+		return nil
+	}
+	out := new(TimePartitioning)
+	in.DeepCopyInto(out)
+	return out
+}
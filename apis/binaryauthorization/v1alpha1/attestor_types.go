@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// AttestorParameters define the desired state of a Binary Authorization
+// attestor. The ID of the attestor is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/binary-authorization/docs/reference/rest/v1/projects.attestors
+type AttestorParameters struct {
+	// Description is a descriptive comment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// UserOwnedGrafeasNote is the Container Analysis note in which the
+	// attestations of this attestor are stored, and the keys that verify
+	// them.
+	UserOwnedGrafeasNote UserOwnedGrafeasNote `json:"userOwnedGrafeasNote"`
+}
+
+// A UserOwnedGrafeasNote is a Container Analysis note created by the user.
+type UserOwnedGrafeasNote struct {
+	// NoteReference is the resource name of the attestation authority note,
+	// in the form projects/{project}/notes/{note}.
+	// +immutable
+	NoteReference string `json:"noteReference"`
+
+	// PublicKeys that verify attestations signed by this attestor. If none
+	// are set, the attestor never finds a valid attestation.
+	// +optional
+	PublicKeys []AttestorPublicKey `json:"publicKeys,omitempty"`
+}
+
+// An AttestorPublicKey verifies attestations. Exactly one of
+// ASCIIArmoredPGPPublicKey and PKIXPublicKey must be set.
+type AttestorPublicKey struct {
+	// ID of the key. It is computed from the fingerprint of PGP keys, and
+	// must be set to a URI for PKIX keys.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Comment describing the key.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// ASCIIArmoredPGPPublicKey is an ASCII-armored, OpenPGP public key.
+	// +optional
+	ASCIIArmoredPGPPublicKey *string `json:"asciiArmoredPgpPublicKey,omitempty"`
+
+	// PKIXPublicKey is a raw PKIX SubjectPublicKeyInfo public key.
+	// +optional
+	PKIXPublicKey *PKIXPublicKey `json:"pkixPublicKey,omitempty"`
+}
+
+// A PKIXPublicKey is a PEM encoded public key and the algorithm used to
+// verify signatures with it.
+type PKIXPublicKey struct {
+	// PublicKeyPEM is the PEM encoded public key.
+	PublicKeyPEM string `json:"publicKeyPem"`
+
+	// SignatureAlgorithm used to verify signatures with this key, e.g.
+	// ECDSA_P256_SHA256 or RSA_PSS_2048_SHA256.
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+}
+
+// AttestorObservation is used to show the observed state of the Attestor
+// resource on GCP.
+type AttestorObservation struct {
+	// Name is the resource name of the attestor, in the form
+	// projects/{project}/attestors/{attestor}.
+	Name string `json:"name,omitempty"`
+
+	// DelegationServiceAccountEmail is the service account the attestor
+	// uses to read attestations from its note. It must be granted the
+	// containeranalysis.notes.occurrences.viewer role on the note.
+	DelegationServiceAccountEmail string `json:"delegationServiceAccountEmail,omitempty"`
+
+	// UpdateTime is the time the attestor was last updated.
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+}
+
+// An AttestorSpec defines the desired state of an Attestor.
+type AttestorSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AttestorParameters `json:"forProvider"`
+}
+
+// An AttestorStatus represents the observed state of an Attestor.
+type AttestorStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AttestorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Attestor is a managed resource that represents a Google Cloud Binary
+// Authorization attestor.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NOTE",type="string",JSONPath=".spec.forProvider.userOwnedGrafeasNote.noteReference"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Attestor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AttestorSpec   `json:"spec"`
+	Status AttestorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AttestorList contains a list of Attestor.
+type AttestorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Attestor `json:"items"`
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Evaluation modes of an AdmissionRule.
+const (
+	EvaluationModeAlwaysAllow        = "ALWAYS_ALLOW"
+	EvaluationModeRequireAttestation = "REQUIRE_ATTESTATION"
+	EvaluationModeAlwaysDeny         = "ALWAYS_DENY"
+)
+
+// Enforcement modes of an AdmissionRule.
+const (
+	EnforcementModeEnforcedBlockAndAuditLog = "ENFORCED_BLOCK_AND_AUDIT_LOG"
+	EnforcementModeDryrunAuditLogOnly       = "DRYRUN_AUDIT_LOG_ONLY"
+)
+
+// PolicyParameters define the desired state of the Binary Authorization
+// policy of a project. Each project has exactly one policy, so a Policy
+// configures the policy of the project of its Provider.
+// https://cloud.google.com/binary-authorization/docs/reference/rest/v1/projects/updatePolicy
+type PolicyParameters struct {
+	// Description is a descriptive comment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// GlobalPolicyEvaluationMode controls the evaluation of a
+	// Google-maintained global admission policy for common system-level
+	// images. Images not covered by the global policy are subject to this
+	// policy.
+	// +optional
+	// +kubebuilder:validation:Enum=ENABLE;DISABLE
+	GlobalPolicyEvaluationMode *string `json:"globalPolicyEvaluationMode,omitempty"`
+
+	// AdmissionWhitelistPatterns are patterns of images that are always
+	// admitted, regardless of any admission rule. They are typically used to
+	// exclude Google or third-party infrastructure images.
+	// +optional
+	AdmissionWhitelistPatterns []AdmissionWhitelistPattern `json:"admissionWhitelistPatterns,omitempty"`
+
+	// DefaultAdmissionRule is the admission rule of clusters without a
+	// cluster admission rule.
+	DefaultAdmissionRule AdmissionRule `json:"defaultAdmissionRule"`
+
+	// ClusterAdmissionRules are per-cluster admission rules, keyed by
+	// cluster in the form location.clusterId, e.g. us-central1-a.prod. A
+	// location is either a zone or a region.
+	// +optional
+	ClusterAdmissionRules map[string]AdmissionRule `json:"clusterAdmissionRules,omitempty"`
+}
+
+// An AdmissionWhitelistPattern matches images that are always admitted.
+type AdmissionWhitelistPattern struct {
+	// NamePattern is an image name pattern. It may end with * to match any
+	// image with the preceding prefix, or ** to also match subdirectories.
+	NamePattern string `json:"namePattern"`
+}
+
+// An AdmissionRule determines whether a pod may be created.
+type AdmissionRule struct {
+	// EvaluationMode specifies how the rule is evaluated.
+	// +kubebuilder:validation:Enum=ALWAYS_ALLOW;REQUIRE_ATTESTATION;ALWAYS_DENY
+	EvaluationMode string `json:"evaluationMode"`
+
+	// EnforcementMode specifies the action taken when the rule denies the
+	// creation of a pod.
+	// +kubebuilder:validation:Enum=ENFORCED_BLOCK_AND_AUDIT_LOG;DRYRUN_AUDIT_LOG_ONLY
+	EnforcementMode string `json:"enforcementMode"`
+
+	// RequireAttestationsBy are the resource names of the attestors that
+	// must attest to an image, in the form
+	// projects/{project}/attestors/{attestor}. It must be set if, and only
+	// if, EvaluationMode is REQUIRE_ATTESTATION.
+	// +optional
+	RequireAttestationsBy []string `json:"requireAttestationsBy,omitempty"`
+
+	// RequireAttestationsByRefs references Attestors and retrieves their
+	// resource names.
+	// +optional
+	RequireAttestationsByRefs []runtimev1alpha1.Reference `json:"requireAttestationsByRefs,omitempty"`
+
+	// RequireAttestationsBySelector selects references to Attestors.
+	// +optional
+	RequireAttestationsBySelector *runtimev1alpha1.Selector `json:"requireAttestationsBySelector,omitempty"`
+}
+
+// PolicyObservation is used to show the observed state of the Policy
+// resource on GCP.
+type PolicyObservation struct {
+	// Name is the resource name of the policy, in the form
+	// projects/{project}/policy.
+	Name string `json:"name,omitempty"`
+
+	// UpdateTime is the time the policy was last updated.
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+}
+
+// A PolicySpec defines the desired state of a Policy.
+type PolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PolicyParameters `json:"forProvider"`
+}
+
+// A PolicyStatus represents the observed state of a Policy.
+type PolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Policy is a managed resource that represents the Binary Authorization
+// policy of a Google Cloud project. The policy is reset to its default, which
+// admits all images, when the Policy is deleted.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DEFAULT-RULE",type="string",JSONPath=".spec.forProvider.defaultAdmissionRule.evaluationMode"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Policy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicySpec   `json:"spec"`
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyList contains a list of Policy.
+type PolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Policy `json:"items"`
+}
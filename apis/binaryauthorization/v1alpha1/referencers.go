@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AttestorName extracts the resource name of an Attestor.
+func AttestorName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		a, ok := mg.(*Attestor)
+		if !ok {
+			return ""
+		}
+		return a.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Policy
+func (mg *Policy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.defaultAdmissionRule.requireAttestationsBy
+	if err := resolveAttestors(ctx, r, &mg.Spec.ForProvider.DefaultAdmissionRule); err != nil {
+		return err
+	}
+
+	// Resolve spec.forProvider.clusterAdmissionRules[*].requireAttestationsBy
+	for cluster := range mg.Spec.ForProvider.ClusterAdmissionRules {
+		rule := mg.Spec.ForProvider.ClusterAdmissionRules[cluster]
+		if err := resolveAttestors(ctx, r, &rule); err != nil {
+			return err
+		}
+		mg.Spec.ForProvider.ClusterAdmissionRules[cluster] = rule
+	}
+
+	return nil
+}
+
+func resolveAttestors(ctx context.Context, r *reference.APIResolver, rule *AdmissionRule) error {
+	rsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: rule.RequireAttestationsBy,
+		References:    rule.RequireAttestationsByRefs,
+		Selector:      rule.RequireAttestationsBySelector,
+		To:            reference.To{Managed: &Attestor{}, List: &AttestorList{}},
+		Extract:       AttestorName(),
+	})
+	if err != nil {
+		return err
+	}
+	rule.RequireAttestationsBy = rsp.ResolvedValues
+	rule.RequireAttestationsByRefs = rsp.ResolvedReferences
+	return nil
+}
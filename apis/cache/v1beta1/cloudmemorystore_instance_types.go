@@ -108,6 +108,13 @@ type CloudMemorystoreInstanceParameters struct {
 	// +optional
 	// +immutable
 	AuthorizedNetwork *string `json:"authorizedNetwork,omitempty"`
+
+	// AuthEnabled specifies whether clients must authenticate using the
+	// Redis AUTH command. When enabled the AUTH string is published to the
+	// connection secret as the password. AUTH is left as it is when this
+	// field is not set.
+	// +optional
+	AuthEnabled *bool `json:"authEnabled,omitempty"`
 }
 
 // CloudMemorystoreInstanceObservation is used to show the observed state of the
@@ -154,6 +161,10 @@ type CloudMemorystoreInstanceObservation struct {
 	// for a given instance so should be checked before each import/export
 	// operation.
 	PersistenceIAMIdentity string `json:"persistenceIamIdentity,omitempty"`
+
+	// AuthEnabled indicates whether Redis AUTH is enabled for the instance.
+	// It is only observed when spec.forProvider.authEnabled is set.
+	AuthEnabled *bool `json:"authEnabled,omitempty"`
 }
 
 // A CloudMemorystoreInstanceSpec defines the desired state of a
@@ -165,6 +165,11 @@ func (in *CloudMemorystoreInstanceObservation) DeepCopyInto(out *CloudMemorystor
 		in, out := &in.CreateTime, &out.CreateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.AuthEnabled != nil {
+		in, out := &in.AuthEnabled, &out.AuthEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudMemorystoreInstanceObservation.
@@ -224,6 +229,11 @@ func (in *CloudMemorystoreInstanceParameters) DeepCopyInto(out *CloudMemorystore
 		*out = new(string)
 		**out = **in
 	}
+	if in.AuthEnabled != nil {
+		in, out := &in.AuthEnabled, &out.AuthEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudMemorystoreInstanceParameters.
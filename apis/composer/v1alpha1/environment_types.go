@@ -0,0 +1,303 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// States of a Cloud Composer environment.
+const (
+	StateCreating = "CREATING"
+	StateRunning  = "RUNNING"
+	StateUpdating = "UPDATING"
+	StateDeleting = "DELETING"
+	StateError    = "ERROR"
+)
+
+// Keys of the connection details published by an Environment.
+const (
+	ConnectionAirflowURIKey   = "airflowUri"
+	ConnectionDAGGCSPrefixKey = "dagGcsPrefix"
+)
+
+// EnvironmentParameters define the desired state of a Cloud Composer
+// environment. The ID of the environment is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/composer/docs/reference/rest/v1beta1/projects.locations.environments
+type EnvironmentParameters struct {
+	// Location is the region in which the environment is deployed, e.g.
+	// us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Labels to associate with this environment.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// NodeCount is the number of nodes of the Kubernetes Engine cluster that
+	// runs the environment. It must be at least 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=3
+	NodeCount *int64 `json:"nodeCount,omitempty"`
+
+	// NodeConfig configures the nodes of the Kubernetes Engine cluster that
+	// runs the environment.
+	// +optional
+	// +immutable
+	NodeConfig *NodeConfig `json:"nodeConfig,omitempty"`
+
+	// SoftwareConfig configures the software, such as the Airflow and
+	// Python versions, that runs in the environment.
+	// +optional
+	SoftwareConfig *SoftwareConfig `json:"softwareConfig,omitempty"`
+
+	// PrivateEnvironmentConfig configures the network isolation of the
+	// environment.
+	// +optional
+	// +immutable
+	PrivateEnvironmentConfig *PrivateEnvironmentConfig `json:"privateEnvironmentConfig,omitempty"`
+}
+
+// NodeConfig configures the nodes of the Kubernetes Engine cluster that runs
+// a Cloud Composer environment.
+type NodeConfig struct {
+	// Location is the Compute Engine zone in which the nodes are deployed,
+	// e.g. us-central1-a. It defaults to a zone of the environment's
+	// region.
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// MachineType is the Compute Engine machine type of the nodes, e.g.
+	// n1-standard-1.
+	// +optional
+	MachineType *string `json:"machineType,omitempty"`
+
+	// Network is the Compute Engine network the nodes are connected to, in
+	// the form projects/{project}/global/networks/{network}.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	NetworkRef *runtimev1alpha1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	NetworkSelector *runtimev1alpha1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork is the Compute Engine subnetwork the nodes are connected
+	// to, in the form
+	// projects/{project}/regions/{region}/subnetworks/{subnetwork}. It must
+	// belong to Network.
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork and retrieves its URI
+	// +optional
+	SubnetworkRef *runtimev1alpha1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork
+	// +optional
+	SubnetworkSelector *runtimev1alpha1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// DiskSizeGB is the size of the disk of each node, in GB.
+	// +optional
+	DiskSizeGB *int64 `json:"diskSizeGb,omitempty"`
+
+	// OAuthScopes available to the nodes.
+	// +optional
+	OAuthScopes []string `json:"oauthScopes,omitempty"`
+
+	// ServiceAccount is the email address of the Google Cloud service
+	// account the nodes run as. It defaults to the Compute Engine default
+	// service account.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// Tags are the network tags applied to the nodes.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// IPAllocationPolicy configures the IP address ranges of the pods and
+	// services of the cluster.
+	// +optional
+	IPAllocationPolicy *IPAllocationPolicy `json:"ipAllocationPolicy,omitempty"`
+}
+
+// IPAllocationPolicy configures the IP address ranges of the pods and
+// services of the Kubernetes Engine cluster that runs an environment.
+type IPAllocationPolicy struct {
+	// UseIPAliases creates a VPC-native cluster. It must be true for a
+	// private environment.
+	// +optional
+	UseIPAliases *bool `json:"useIpAliases,omitempty"`
+
+	// ClusterSecondaryRangeName is the name of the secondary range of the
+	// subnetwork used for pod IP addresses.
+	// +optional
+	ClusterSecondaryRangeName *string `json:"clusterSecondaryRangeName,omitempty"`
+
+	// ClusterIPv4CIDRBlock is the IP address range used for pod IP
+	// addresses.
+	// +optional
+	ClusterIPv4CIDRBlock *string `json:"clusterIpv4CidrBlock,omitempty"`
+
+	// ServicesSecondaryRangeName is the name of the secondary range of the
+	// subnetwork used for service IP addresses.
+	// +optional
+	ServicesSecondaryRangeName *string `json:"servicesSecondaryRangeName,omitempty"`
+
+	// ServicesIPv4CIDRBlock is the IP address range used for service IP
+	// addresses.
+	// +optional
+	ServicesIPv4CIDRBlock *string `json:"servicesIpv4CidrBlock,omitempty"`
+}
+
+// SoftwareConfig configures the software that runs in a Cloud Composer
+// environment.
+type SoftwareConfig struct {
+	// ImageVersion is the version of Cloud Composer and Airflow the
+	// environment runs, in the form composer-{version}-airflow-{version},
+	// e.g. composer-1.10.6-airflow-1.10.6. Changing it upgrades the
+	// environment.
+	// +optional
+	ImageVersion *string `json:"imageVersion,omitempty"`
+
+	// PythonVersion is the major version of Python used to run Airflow,
+	// either 2 or 3.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum="2";"3"
+	PythonVersion *string `json:"pythonVersion,omitempty"`
+
+	// AirflowConfigOverrides override Airflow configuration properties.
+	// Keys are in the form {section}-{property}, e.g. core-dags_are_paused_at_creation.
+	// +optional
+	AirflowConfigOverrides map[string]string `json:"airflowConfigOverrides,omitempty"`
+
+	// PyPIPackages are the custom Python packages installed in the
+	// environment, keyed by package name. Values are version specifiers
+	// such as "==1.0.0", or the empty string for the latest version.
+	// +optional
+	PyPIPackages map[string]string `json:"pypiPackages,omitempty"`
+
+	// EnvVariables are additional environment variables of the Airflow
+	// scheduler, worker and webserver processes.
+	// +optional
+	EnvVariables map[string]string `json:"envVariables,omitempty"`
+}
+
+// PrivateEnvironmentConfig configures the network isolation of a Cloud
+// Composer environment.
+type PrivateEnvironmentConfig struct {
+	// EnablePrivateEnvironment runs the environment in a private
+	// Kubernetes Engine cluster, whose nodes have no public IP addresses.
+	// +optional
+	EnablePrivateEnvironment *bool `json:"enablePrivateEnvironment,omitempty"`
+
+	// PrivateClusterConfig configures the private cluster.
+	// +optional
+	PrivateClusterConfig *PrivateClusterConfig `json:"privateClusterConfig,omitempty"`
+}
+
+// PrivateClusterConfig configures the private Kubernetes Engine cluster of a
+// private environment.
+type PrivateClusterConfig struct {
+	// EnablePrivateEndpoint makes the cluster's master reachable only from
+	// its internal IP address.
+	// +optional
+	EnablePrivateEndpoint *bool `json:"enablePrivateEndpoint,omitempty"`
+
+	// MasterIPv4CIDRBlock is the /28 IP address range of the cluster's
+	// master.
+	// +optional
+	MasterIPv4CIDRBlock *string `json:"masterIpv4CidrBlock,omitempty"`
+}
+
+// EnvironmentObservation is used to show the observed state of the
+// Environment resource on GCP.
+type EnvironmentObservation struct {
+	// Name is the resource name of the environment, in the form
+	// projects/{project}/locations/{location}/environments/{environment}.
+	Name string `json:"name,omitempty"`
+
+	// UUID is the unique identifier of the environment.
+	UUID string `json:"uuid,omitempty"`
+
+	// State of the environment.
+	State string `json:"state,omitempty"`
+
+	// AirflowURI is the URI of the Airflow web interface.
+	AirflowURI string `json:"airflowUri,omitempty"`
+
+	// DAGGCSPrefix is the Cloud Storage prefix of the environment's DAGs,
+	// e.g. gs://us-central1-example-1234-bucket/dags.
+	DAGGCSPrefix string `json:"dagGcsPrefix,omitempty"`
+
+	// GKECluster is the resource name of the Kubernetes Engine cluster that
+	// runs the environment.
+	GKECluster string `json:"gkeCluster,omitempty"`
+
+	// CreateTime is the time the environment was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the environment was last updated.
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+}
+
+// An EnvironmentSpec defines the desired state of an Environment.
+type EnvironmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  EnvironmentParameters `json:"forProvider"`
+}
+
+// An EnvironmentStatus represents the observed state of an Environment.
+type EnvironmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     EnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Environment is a managed resource that represents a Google Cloud
+// Composer environment, which runs Apache Airflow. Its Airflow URI and DAG
+// Cloud Storage prefix are published as connection details.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AIRFLOW-URI",type="string",JSONPath=".status.atProvider.airflowUri"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environment.
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
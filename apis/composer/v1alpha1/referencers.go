@@ -0,0 +1,57 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// ResolveReferences of this Environment
+func (mg *Environment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	nc := mg.Spec.ForProvider.NodeConfig
+	if nc == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.nodeConfig.network
+	if err := v1beta1.ResolveNetwork(ctx, r, &nc.Network, &nc.NetworkRef, nc.NetworkSelector); err != nil {
+		return err
+	}
+
+	// Resolve spec.forProvider.nodeConfig.subnetwork
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(nc.Subnetwork),
+		Reference:    nc.SubnetworkRef,
+		Selector:     nc.SubnetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:      v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return err
+	}
+	nc.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	nc.SubnetworkRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,400 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Environment) DeepCopyInto(out *Environment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Environment.
+func (in *Environment) DeepCopy() *Environment {
+	if in == nil {
+		return nil
+	}
+	out := new(Environment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Environment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentList) DeepCopyInto(out *EnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Environment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentList.
+func (in *EnvironmentList) DeepCopy() *EnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentObservation) DeepCopyInto(out *EnvironmentObservation) {
+	*out = *in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdateTime != nil {
+		in, out := &in.UpdateTime, &out.UpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentObservation.
+func (in *EnvironmentObservation) DeepCopy() *EnvironmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentParameters) DeepCopyInto(out *EnvironmentParameters) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeCount != nil {
+		in, out := &in.NodeCount, &out.NodeCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NodeConfig != nil {
+		in, out := &in.NodeConfig, &out.NodeConfig
+		*out = new(NodeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoftwareConfig != nil {
+		in, out := &in.SoftwareConfig, &out.SoftwareConfig
+		*out = new(SoftwareConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateEnvironmentConfig != nil {
+		in, out := &in.PrivateEnvironmentConfig, &out.PrivateEnvironmentConfig
+		*out = new(PrivateEnvironmentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentParameters.
+func (in *EnvironmentParameters) DeepCopy() *EnvironmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
+func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentStatus.
+func (in *EnvironmentStatus) DeepCopy() *EnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAllocationPolicy) DeepCopyInto(out *IPAllocationPolicy) {
+	*out = *in
+	if in.UseIPAliases != nil {
+		in, out := &in.UseIPAliases, &out.UseIPAliases
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClusterSecondaryRangeName != nil {
+		in, out := &in.ClusterSecondaryRangeName, &out.ClusterSecondaryRangeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterIPv4CIDRBlock != nil {
+		in, out := &in.ClusterIPv4CIDRBlock, &out.ClusterIPv4CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServicesSecondaryRangeName != nil {
+		in, out := &in.ServicesSecondaryRangeName, &out.ServicesSecondaryRangeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServicesIPv4CIDRBlock != nil {
+		in, out := &in.ServicesIPv4CIDRBlock, &out.ServicesIPv4CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAllocationPolicy.
+func (in *IPAllocationPolicy) DeepCopy() *IPAllocationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAllocationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConfig) DeepCopyInto(out *NodeConfig) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.MachineType != nil {
+		in, out := &in.MachineType, &out.MachineType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnetwork != nil {
+		in, out := &in.Subnetwork, &out.Subnetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetworkRef != nil {
+		in, out := &in.SubnetworkRef, &out.SubnetworkRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.SubnetworkSelector != nil {
+		in, out := &in.SubnetworkSelector, &out.SubnetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiskSizeGB != nil {
+		in, out := &in.DiskSizeGB, &out.DiskSizeGB
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OAuthScopes != nil {
+		in, out := &in.OAuthScopes, &out.OAuthScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPAllocationPolicy != nil {
+		in, out := &in.IPAllocationPolicy, &out.IPAllocationPolicy
+		*out = new(IPAllocationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConfig.
+func (in *NodeConfig) DeepCopy() *NodeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateClusterConfig) DeepCopyInto(out *PrivateClusterConfig) {
+	*out = *in
+	if in.EnablePrivateEndpoint != nil {
+		in, out := &in.EnablePrivateEndpoint, &out.EnablePrivateEndpoint
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MasterIPv4CIDRBlock != nil {
+		in, out := &in.MasterIPv4CIDRBlock, &out.MasterIPv4CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateClusterConfig.
+func (in *PrivateClusterConfig) DeepCopy() *PrivateClusterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateClusterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateEnvironmentConfig) DeepCopyInto(out *PrivateEnvironmentConfig) {
+	*out = *in
+	if in.EnablePrivateEnvironment != nil {
+		in, out := &in.EnablePrivateEnvironment, &out.EnablePrivateEnvironment
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateClusterConfig != nil {
+		in, out := &in.PrivateClusterConfig, &out.PrivateClusterConfig
+		*out = new(PrivateClusterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateEnvironmentConfig.
+func (in *PrivateEnvironmentConfig) DeepCopy() *PrivateEnvironmentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateEnvironmentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SoftwareConfig) DeepCopyInto(out *SoftwareConfig) {
+	*out = *in
+	if in.ImageVersion != nil {
+		in, out := &in.ImageVersion, &out.ImageVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.PythonVersion != nil {
+		in, out := &in.PythonVersion, &out.PythonVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.AirflowConfigOverrides != nil {
+		in, out := &in.AirflowConfigOverrides, &out.AirflowConfigOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PyPIPackages != nil {
+		in, out := &in.PyPIPackages, &out.PyPIPackages
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EnvVariables != nil {
+		in, out := &in.EnvVariables, &out.EnvVariables
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SoftwareConfig.
+func (in *SoftwareConfig) DeepCopy() *SoftwareConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SoftwareConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Autoscaler statuses as reported by the Compute Engine API.
+const (
+	AutoscalerStatusActive   = "ACTIVE"
+	AutoscalerStatusDeleting = "DELETING"
+	AutoscalerStatusError    = "ERROR"
+	AutoscalerStatusPending  = "PENDING"
+)
+
+// AutoscalerParameters define the desired state of a Google Compute Engine
+// Autoscaler. Exactly one of zone and region must be set; a zonal autoscaler
+// scales a zonal managed instance group and a regional autoscaler scales a
+// regional one. Most fields map directly to an Autoscaler:
+// https://cloud.google.com/compute/docs/reference/rest/v1/autoscalers
+type AutoscalerParameters struct {
+	// Zone: URI of the zone where the instance group resides, for zonal
+	// autoscalers.
+	// +optional
+	// +immutable
+	Zone *string `json:"zone,omitempty"`
+
+	// Region: URI of the region where the instance group resides, for
+	// regional autoscalers.
+	// +optional
+	// +immutable
+	Region *string `json:"region,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Target: URL of the managed instance group that this autoscaler will
+	// scale. It must be in the same zone or region as the autoscaler.
+	// +immutable
+	Target string `json:"target"`
+
+	// AutoscalingPolicy: The configuration parameters for the autoscaling
+	// algorithm.
+	AutoscalingPolicy AutoscalingPolicy `json:"autoscalingPolicy"`
+}
+
+// AutoscalingPolicy configures how an Autoscaler scales its target. If none
+// of the utilization signals are set, the autoscaler scales based on CPU
+// utilization.
+type AutoscalingPolicy struct {
+	// MinNumReplicas: The minimum number of replicas that the autoscaler
+	// can scale down to. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinNumReplicas *int64 `json:"minNumReplicas,omitempty"`
+
+	// MaxNumReplicas: The maximum number of instances that the autoscaler
+	// can scale up to.
+	// +kubebuilder:validation:Minimum=1
+	MaxNumReplicas int64 `json:"maxNumReplicas"`
+
+	// CoolDownPeriodSec: The number of seconds that the autoscaler should
+	// wait before it starts collecting information from a new instance.
+	// Defaults to 60.
+	// +optional
+	CoolDownPeriodSec *int64 `json:"coolDownPeriodSec,omitempty"`
+
+	// Mode: Defines the operating mode for this policy. Defaults to ON.
+	// +optional
+	// +kubebuilder:validation:Enum=ON;OFF;ONLY_UP
+	Mode *string `json:"mode,omitempty"`
+
+	// CPUUtilization: Scales based on the average CPU utilization of the
+	// managed instance group.
+	// +optional
+	CPUUtilization *AutoscalingPolicyUtilization `json:"cpuUtilization,omitempty"`
+
+	// LoadBalancingUtilization: Scales based on the serving capacity of
+	// the HTTP(S) load balancer that serves the managed instance group.
+	// +optional
+	LoadBalancingUtilization *AutoscalingPolicyUtilization `json:"loadBalancingUtilization,omitempty"`
+
+	// CustomMetricUtilizations: Scales based on Stackdriver Monitoring
+	// metrics.
+	// +optional
+	CustomMetricUtilizations []AutoscalingPolicyCustomMetricUtilization `json:"customMetricUtilizations,omitempty"`
+}
+
+// AutoscalingPolicyUtilization specifies the utilization an autoscaler should
+// maintain.
+type AutoscalingPolicyUtilization struct {
+	// UtilizationTarget: The utilization the autoscaler should maintain, as
+	// a decimal such as 0.6. CPU utilization targets must be in the range
+	// (0, 1] and default to 0.6; load balancing utilization targets default
+	// to 0.8.
+	// +kubebuilder:validation:Pattern=`^([0-9]+\.?[0-9]*|\.[0-9]+)$`
+	UtilizationTarget string `json:"utilizationTarget"`
+}
+
+// AutoscalingPolicyCustomMetricUtilization specifies a Stackdriver Monitoring
+// metric an autoscaler should scale on.
+type AutoscalingPolicyCustomMetricUtilization struct {
+	// Metric: The identifier (type) of the Stackdriver Monitoring metric.
+	Metric string `json:"metric"`
+
+	// UtilizationTarget: The target value of the metric the autoscaler
+	// should maintain, as a decimal such as 100.5. Must be positive.
+	// +kubebuilder:validation:Pattern=`^([0-9]+\.?[0-9]*|\.[0-9]+)$`
+	UtilizationTarget string `json:"utilizationTarget"`
+
+	// UtilizationTargetType: Defines how the target utilization value is
+	// expressed. Defaults to GAUGE.
+	// +optional
+	// +kubebuilder:validation:Enum=GAUGE;DELTA_PER_SECOND;DELTA_PER_MINUTE
+	UtilizationTargetType *string `json:"utilizationTargetType,omitempty"`
+}
+
+// An AutoscalerObservation represents the observed state of a Google
+// Compute Engine Autoscaler.
+type AutoscalerObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the autoscaler configuration, one of PENDING,
+	// DELETING, ACTIVE or ERROR.
+	Status string `json:"status,omitempty"`
+
+	// StatusDetails: Human-readable details about the current state of the
+	// autoscaler.
+	StatusDetails []AutoscalerStatusDetails `json:"statusDetails,omitempty"`
+
+	// RecommendedSize: Target recommended MIG size computed by the
+	// autoscaler.
+	RecommendedSize int64 `json:"recommendedSize,omitempty"`
+}
+
+// AutoscalerStatusDetails is a detail about the current state of an
+// autoscaler.
+type AutoscalerStatusDetails struct {
+	// Type: The type of error, warning, or notice, for example
+	// CAPPED_AT_MAX_NUM_REPLICAS.
+	Type string `json:"type,omitempty"`
+
+	// Message: The status message.
+	Message string `json:"message,omitempty"`
+}
+
+// An AutoscalerSpec defines the desired state of an Autoscaler.
+type AutoscalerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  AutoscalerParameters `json:"forProvider"`
+}
+
+// An AutoscalerStatus represents the observed state of an Autoscaler.
+type AutoscalerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     AutoscalerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Autoscaler is a managed resource that represents a Google Compute
+// Engine Autoscaler, which scales a managed instance group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="RECOMMENDED",type="integer",JSONPath=".status.atProvider.recommendedSize"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Autoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoscalerSpec   `json:"spec"`
+	Status AutoscalerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoscalerList contains a list of Autoscaler.
+type AutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Autoscaler `json:"items"`
+}
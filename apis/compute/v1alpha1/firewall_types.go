@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Directions of the traffic a firewall rule applies to.
+const (
+	FirewallDirectionIngress = "INGRESS"
+	FirewallDirectionEgress  = "EGRESS"
+)
+
+// FirewallParameters define the desired state of a Google Compute Engine VPC
+// firewall rule. Most fields map directly to a Firewall:
+// https://cloud.google.com/compute/docs/reference/rest/v1/firewalls
+type FirewallParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Network: URL of the network resource for this firewall rule. Defaults
+	// to the default network of the project.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *runtimev1alpha1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	// +immutable
+	NetworkSelector *runtimev1alpha1.Selector `json:"networkSelector,omitempty"`
+
+	// Priority: Priority for this rule. Lower values take precedence; a
+	// rule with priority 0 has the highest precedence. Defaults to 1000.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	Priority *int64 `json:"priority,omitempty"`
+
+	// Direction: Direction of the traffic to which this rule applies.
+	// Defaults to INGRESS. Compute Engine does not allow the direction of an
+	// existing rule to be changed.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=INGRESS;EGRESS
+	Direction *string `json:"direction,omitempty"`
+
+	// Allowed: The protocols and ports that this rule allows. Only one of
+	// Allowed and Denied may be set.
+	// +optional
+	Allowed []FirewallRule `json:"allowed,omitempty"`
+
+	// Denied: The protocols and ports that this rule denies. Only one of
+	// Allowed and Denied may be set.
+	// +optional
+	Denied []FirewallRule `json:"denied,omitempty"`
+
+	// SourceRanges: The source IP ranges, in CIDR format, to which an
+	// INGRESS rule applies. Compute Engine applies an INGRESS rule that has
+	// neither source ranges nor source tags to 0.0.0.0/0.
+	// +optional
+	SourceRanges []string `json:"sourceRanges,omitempty"`
+
+	// DestinationRanges: The destination IP ranges, in CIDR format, to
+	// which an EGRESS rule applies.
+	// +optional
+	DestinationRanges []string `json:"destinationRanges,omitempty"`
+
+	// SourceTags: The network tags of the instances whose traffic an INGRESS
+	// rule applies to.
+	// +optional
+	SourceTags []string `json:"sourceTags,omitempty"`
+
+	// TargetTags: The network tags of the instances this rule applies to.
+	// The rule applies to all instances on the network if neither target
+	// tags nor target service accounts are set.
+	// +optional
+	TargetTags []string `json:"targetTags,omitempty"`
+
+	// Disabled: Whether the rule is disabled, in which case it is not
+	// enforced. Defaults to false.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+}
+
+// A FirewallRule is a protocol, and optionally the ports, that a firewall
+// rule allows or denies.
+type FirewallRule struct {
+	// IPProtocol: The IP protocol to which this rule applies, either one of
+	// tcp, udp, icmp, esp, ah, ipip, sctp or all, or an IP protocol number.
+	IPProtocol string `json:"ipProtocol"`
+
+	// Ports: The ports to which this rule applies, either single ports such
+	// as 443 or ranges such as 8000-9000. Only applies to the tcp, udp and
+	// sctp protocols. The rule applies to all ports if it is empty.
+	// +optional
+	Ports []string `json:"ports,omitempty"`
+}
+
+// A FirewallObservation represents the observed state of a Google Compute
+// Engine VPC firewall rule.
+type FirewallObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Operation is the name of the global operation that creates or updates
+	// the firewall rule, while it is pending.
+	Operation string `json:"operation,omitempty"`
+}
+
+// A FirewallSpec defines the desired state of a Firewall.
+type FirewallSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  FirewallParameters `json:"forProvider"`
+}
+
+// A FirewallStatus represents the observed state of a Firewall.
+type FirewallStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     FirewallObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Firewall is a managed resource that represents a Google Compute Engine
+// VPC firewall rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DIRECTION",type="string",JSONPath=".spec.forProvider.direction"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Firewall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FirewallSpec   `json:"spec"`
+	Status FirewallStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FirewallList contains a list of Firewall.
+type FirewallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Firewall `json:"items"`
+}
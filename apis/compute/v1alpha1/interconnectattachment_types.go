@@ -0,0 +1,180 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Interconnect attachment states as reported by the Compute Engine API.
+const (
+	InterconnectAttachmentStateActive                 = "ACTIVE"
+	InterconnectAttachmentStateDefunct                = "DEFUNCT"
+	InterconnectAttachmentStatePartnerRequestReceived = "PARTNER_REQUEST_RECEIVED"
+	InterconnectAttachmentStatePendingCustomer        = "PENDING_CUSTOMER"
+	InterconnectAttachmentStatePendingPartner         = "PENDING_PARTNER"
+	InterconnectAttachmentStateUnprovisioned          = "UNPROVISIONED"
+)
+
+// InterconnectAttachmentParameters define the desired state of a Google
+// Compute Engine Interconnect Attachment, also known as a VLAN attachment.
+// Most fields map directly to an InterconnectAttachment:
+// https://cloud.google.com/compute/docs/reference/rest/v1/interconnectAttachments
+type InterconnectAttachmentParameters struct {
+	// Region: URI of the region where the attachment resides. It must be
+	// the region of the Cloud Router.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Type: The type of the attachment, either DEDICATED for an attachment
+	// to a Dedicated Interconnect or PARTNER for an attachment to a Partner
+	// Interconnect. Defaults to DEDICATED.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=DEDICATED;PARTNER
+	Type *string `json:"type,omitempty"`
+
+	// Router: URL of the Cloud Router to be used for dynamic routing. The
+	// router must be in the same region as the attachment.
+	// +immutable
+	Router string `json:"router"`
+
+	// Interconnect: URL of the Dedicated Interconnect the attachment
+	// connects to. Required for DEDICATED attachments.
+	// +optional
+	// +immutable
+	Interconnect *string `json:"interconnect,omitempty"`
+
+	// Bandwidth: Provisioned bandwidth capacity of the attachment, e.g.
+	// BPS_1G. It may be changed in place.
+	// +optional
+	// +kubebuilder:validation:Enum=BPS_50M;BPS_100M;BPS_200M;BPS_300M;BPS_400M;BPS_500M;BPS_1G;BPS_2G;BPS_5G;BPS_10G;BPS_20G;BPS_50G
+	Bandwidth *string `json:"bandwidth,omitempty"`
+
+	// VlanTag8021q: The IEEE 802.1Q VLAN tag of the attachment. Only
+	// specified for DEDICATED attachments; one is allocated when omitted.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=4094
+	VlanTag8021q *int64 `json:"vlanTag8021q,omitempty"`
+
+	// CandidateSubnets: Up to 16 /29 CIDR ranges in 169.254.0.0/16 from
+	// which the link-local addresses of the Cloud Router and the customer
+	// router are allocated.
+	// +optional
+	// +immutable
+	CandidateSubnets []string `json:"candidateSubnets,omitempty"`
+
+	// EdgeAvailabilityDomain: The availability domain of a PARTNER
+	// attachment, used to provision redundant attachments.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=AVAILABILITY_DOMAIN_ANY;AVAILABILITY_DOMAIN_1;AVAILABILITY_DOMAIN_2
+	EdgeAvailabilityDomain *string `json:"edgeAvailabilityDomain,omitempty"`
+
+	// AdminEnabled: Whether the attachment carries traffic. A PARTNER
+	// attachment must be enabled once the partner has provisioned it.
+	// +optional
+	AdminEnabled *bool `json:"adminEnabled,omitempty"`
+}
+
+// An InterconnectAttachmentObservation represents the observed state of a
+// Google Compute Engine Interconnect Attachment.
+type InterconnectAttachmentObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// State: The provisioning state of the attachment, e.g. ACTIVE or
+	// PENDING_PARTNER.
+	State string `json:"state,omitempty"`
+
+	// OperationalStatus: Whether the attachment is operational, either
+	// OS_ACTIVE or OS_UNPROVISIONED.
+	OperationalStatus string `json:"operationalStatus,omitempty"`
+
+	// PairingKey: The opaque key a PARTNER attachment is identified by.
+	// It must be supplied to the service provider to provision the
+	// attachment.
+	PairingKey string `json:"pairingKey,omitempty"`
+
+	// CloudRouterIPAddress: The link-local address of the Cloud Router on
+	// the attachment.
+	CloudRouterIPAddress string `json:"cloudRouterIpAddress,omitempty"`
+
+	// CustomerRouterIPAddress: The link-local address of the customer
+	// router on the attachment.
+	CustomerRouterIPAddress string `json:"customerRouterIpAddress,omitempty"`
+
+	// GoogleReferenceID: The Google reference ID of the attachment, used
+	// when raising support tickets.
+	GoogleReferenceID string `json:"googleReferenceId,omitempty"`
+}
+
+// An InterconnectAttachmentSpec defines the desired state of an
+// InterconnectAttachment.
+type InterconnectAttachmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  InterconnectAttachmentParameters `json:"forProvider"`
+}
+
+// An InterconnectAttachmentStatus represents the observed state of an
+// InterconnectAttachment.
+type InterconnectAttachmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     InterconnectAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InterconnectAttachment is a managed resource that represents a Google
+// Compute Engine Interconnect Attachment (VLAN attachment).
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type InterconnectAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InterconnectAttachmentSpec   `json:"spec"`
+	Status InterconnectAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InterconnectAttachmentList contains a list of InterconnectAttachment.
+type InterconnectAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InterconnectAttachment `json:"items"`
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Network endpoint types.
+const (
+	NetworkEndpointTypeGCEVMIPPort         = "GCE_VM_IP_PORT"
+	NetworkEndpointTypeNonGCPPrivateIPPort = "NON_GCP_PRIVATE_IP_PORT"
+	NetworkEndpointTypeInternetIPPort      = "INTERNET_IP_PORT"
+	NetworkEndpointTypeInternetFQDNPort    = "INTERNET_FQDN_PORT"
+)
+
+// NetworkEndpointGroupParameters define the desired state of a Google Compute
+// Engine Network Endpoint Group. Most fields map directly to a
+// NetworkEndpointGroup:
+// https://cloud.google.com/compute/docs/reference/rest/v1/networkEndpointGroups
+type NetworkEndpointGroupParameters struct {
+	// Zone: The zone where the network endpoint group is located. Zonal
+	// network endpoint groups contain GCE_VM_IP_PORT or
+	// NON_GCP_PRIVATE_IP_PORT endpoints. Omit the zone to create a global
+	// network endpoint group of INTERNET_IP_PORT or INTERNET_FQDN_PORT
+	// endpoints.
+	// +optional
+	// +immutable
+	Zone *string `json:"zone,omitempty"`
+
+	// NetworkEndpointType: Type of network endpoints in this network
+	// endpoint group.
+	// +immutable
+	// +kubebuilder:validation:Enum=GCE_VM_IP_PORT;NON_GCP_PRIVATE_IP_PORT;INTERNET_IP_PORT;INTERNET_FQDN_PORT
+	NetworkEndpointType string `json:"networkEndpointType"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// DefaultPort: The default port used if the port number is not
+	// specified in the network endpoint.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	DefaultPort *int64 `json:"defaultPort,omitempty"`
+
+	// Network: The URL of the network to which all network endpoints in
+	// the NEG belong. Uses the "default" project network if unspecified.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *runtimev1alpha1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	// +immutable
+	NetworkSelector *runtimev1alpha1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork: Optional URL of the subnetwork to which all network
+	// endpoints in the NEG belong.
+	// +optional
+	// +immutable
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork and retrieves its URI
+	// +optional
+	// +immutable
+	SubnetworkRef *runtimev1alpha1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork
+	// +optional
+	// +immutable
+	SubnetworkSelector *runtimev1alpha1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// NetworkEndpoints that should be attached to this network endpoint
+	// group. Endpoints are attached and detached as this list changes.
+	// +optional
+	NetworkEndpoints []NetworkEndpoint `json:"networkEndpoints,omitempty"`
+}
+
+// A NetworkEndpoint is a single endpoint of a network endpoint group.
+type NetworkEndpoint struct {
+	// Fqdn: Fully qualified domain name of network endpoint. This can only
+	// be specified when NetworkEndpointType is INTERNET_FQDN_PORT.
+	// +optional
+	Fqdn *string `json:"fqdn,omitempty"`
+
+	// Instance: The name for a specific VM instance that the IP address
+	// belongs to. This is required for network endpoints of type
+	// GCE_VM_IP_PORT.
+	// +optional
+	Instance *string `json:"instance,omitempty"`
+
+	// IPAddress: IPv4 address of the network endpoint.
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// Port: Port number of the network endpoint. Defaults to the
+	// DefaultPort of the network endpoint group.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int64 `json:"port,omitempty"`
+}
+
+// A NetworkEndpointGroupObservation represents the observed state of a Google
+// Compute Engine Network Endpoint Group.
+type NetworkEndpointGroupObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Size: Number of network endpoints in the network endpoint group.
+	Size int64 `json:"size,omitempty"`
+
+	// Operation is the name of the most recent attach or detach operation.
+	// It is cleared once the operation is done.
+	Operation string `json:"operation,omitempty"`
+}
+
+// A NetworkEndpointGroupSpec defines the desired state of a
+// NetworkEndpointGroup.
+type NetworkEndpointGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NetworkEndpointGroupParameters `json:"forProvider"`
+}
+
+// A NetworkEndpointGroupStatus represents the observed state of a
+// NetworkEndpointGroup.
+type NetworkEndpointGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     NetworkEndpointGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkEndpointGroup is a managed resource that represents a Google
+// Compute Engine Network Endpoint Group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.networkEndpointType"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".status.atProvider.size"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type NetworkEndpointGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkEndpointGroupSpec   `json:"spec"`
+	Status NetworkEndpointGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkEndpointGroupList contains a list of NetworkEndpointGroup.
+type NetworkEndpointGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkEndpointGroup `json:"items"`
+}
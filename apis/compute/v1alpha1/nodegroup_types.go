@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Known NodeGroup statuses.
+const (
+	NodeGroupStatusCreating = "CREATING"
+	NodeGroupStatusReady    = "READY"
+	NodeGroupStatusInvalid  = "INVALID"
+	NodeGroupStatusDeleting = "DELETING"
+)
+
+// Known NodeGroup autoscaling modes.
+const (
+	NodeGroupAutoscalingModeOn           = "ON"
+	NodeGroupAutoscalingModeOff          = "OFF"
+	NodeGroupAutoscalingModeOnlyScaleOut = "ONLY_SCALE_OUT"
+)
+
+// NodeGroupParameters define the desired state of a Google Compute Engine
+// sole-tenant node group. Instances are scheduled on the nodes of the group
+// using a node affinity for the compute.googleapis.com/node-group-name label,
+// or for the node affinity labels of its template. Most fields map directly
+// to a NodeGroup:
+// https://cloud.google.com/compute/docs/reference/rest/v1/nodeGroups
+type NodeGroupParameters struct {
+	// Zone: The zone where the node group resides, e.g. us-central1-a.
+	// +immutable
+	Zone string `json:"zone"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// NodeTemplate: URL of the node template used to create the nodes of
+	// this node group. Changing it only affects nodes created afterwards.
+	// +optional
+	NodeTemplate *string `json:"nodeTemplate,omitempty"`
+
+	// NodeTemplateRef references a NodeTemplate and retrieves its URI
+	// +optional
+	NodeTemplateRef *runtimev1alpha1.Reference `json:"nodeTemplateRef,omitempty"`
+
+	// NodeTemplateSelector selects a reference to a NodeTemplate
+	// +optional
+	NodeTemplateSelector *runtimev1alpha1.Selector `json:"nodeTemplateSelector,omitempty"`
+
+	// Size: The number of nodes in the node group. Nodes are added or
+	// removed to match it unless the node group is autoscaled, in which
+	// case it is only the initial number of nodes. Only nodes that no
+	// instances are scheduled on are removed.
+	// +kubebuilder:validation:Minimum=0
+	Size int64 `json:"size"`
+
+	// AutoscalingPolicy: Specifies how the node group is autoscaled.
+	// +optional
+	AutoscalingPolicy *NodeGroupAutoscalingPolicy `json:"autoscalingPolicy,omitempty"`
+
+	// MaintenancePolicy: Specifies how instances on the nodes of this
+	// group behave during host maintenance. Defaults to DEFAULT.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=DEFAULT;RESTART_IN_PLACE;MIGRATE_WITHIN_NODE_GROUP
+	MaintenancePolicy *string `json:"maintenancePolicy,omitempty"`
+}
+
+// NodeGroupAutoscalingPolicy specifies how a node group is autoscaled.
+type NodeGroupAutoscalingPolicy struct {
+	// Mode: The autoscaling mode, i.e. ON, OFF or ONLY_SCALE_OUT.
+	// +optional
+	// +kubebuilder:validation:Enum=ON;OFF;ONLY_SCALE_OUT
+	Mode *string `json:"mode,omitempty"`
+
+	// MinNodes: The minimum number of nodes that the group should have.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinNodes *int64 `json:"minNodes,omitempty"`
+
+	// MaxNodes: The maximum number of nodes that the group should have.
+	// +kubebuilder:validation:Minimum=1
+	MaxNodes int64 `json:"maxNodes"`
+}
+
+// A NodeGroupObservation represents the observed state of a Google Compute
+// Engine node group.
+type NodeGroupObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the node group, i.e. CREATING, READY, INVALID
+	// or DELETING.
+	Status string `json:"status,omitempty"`
+
+	// Size: The current number of nodes in the node group.
+	Size int64 `json:"size,omitempty"`
+}
+
+// A NodeGroupSpec defines the desired state of a NodeGroup.
+type NodeGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NodeGroupParameters `json:"forProvider"`
+}
+
+// A NodeGroupStatus represents the observed state of a NodeGroup.
+type NodeGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     NodeGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NodeGroup is a managed resource that represents a Google Compute Engine
+// sole-tenant node group, i.e. a group of physical servers dedicated to the
+// instances of a project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".status.atProvider.size"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type NodeGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeGroupSpec   `json:"spec"`
+	Status NodeGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeGroupList contains a list of NodeGroup.
+type NodeGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeGroup `json:"items"`
+}
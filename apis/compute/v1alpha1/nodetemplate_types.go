@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Known NodeTemplate statuses.
+const (
+	NodeTemplateStatusCreating = "CREATING"
+	NodeTemplateStatusReady    = "READY"
+	NodeTemplateStatusInvalid  = "INVALID"
+	NodeTemplateStatusDeleting = "DELETING"
+)
+
+// NodeTemplateParameters define the desired state of a Google Compute Engine
+// node template. Node templates cannot be changed once created. Most fields
+// map directly to a NodeTemplate:
+// https://cloud.google.com/compute/docs/reference/rest/v1/nodeTemplates
+type NodeTemplateParameters struct {
+	// Region: The region where the node template resides, e.g.
+	// us-central1.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// NodeType: The node type to use for nodes of node groups created from
+	// this template, e.g. n1-node-96-624. Either NodeType or
+	// NodeTypeFlexibility must be set.
+	// +optional
+	// +immutable
+	NodeType *string `json:"nodeType,omitempty"`
+
+	// NodeTypeFlexibility: The flexible properties of the desired node
+	// type. Node groups created from this template match a node type with
+	// these properties.
+	// +optional
+	// +immutable
+	NodeTypeFlexibility *NodeTemplateNodeTypeFlexibility `json:"nodeTypeFlexibility,omitempty"`
+
+	// NodeAffinityLabels: Labels used for node affinity, which are added
+	// to the nodes created from this template. Instances select the nodes
+	// they are scheduled on using these labels.
+	// +optional
+	// +immutable
+	NodeAffinityLabels map[string]string `json:"nodeAffinityLabels,omitempty"`
+
+	// ServerBinding: Whether nodes restart on any physical server or on a
+	// minimal set of physical servers after a maintenance event, e.g. to
+	// comply with per-server software licenses. Defaults to
+	// RESTART_NODE_ON_ANY_SERVER.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=RESTART_NODE_ON_ANY_SERVER;RESTART_NODE_ON_MINIMAL_SERVERS
+	ServerBinding *string `json:"serverBinding,omitempty"`
+
+	// CPUOvercommitType: Whether instances on nodes created from this
+	// template may overcommit the CPUs of the node. Defaults to NONE.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=ENABLED;NONE
+	CPUOvercommitType *string `json:"cpuOvercommitType,omitempty"`
+}
+
+// NodeTemplateNodeTypeFlexibility specifies the flexible properties of a
+// node type.
+type NodeTemplateNodeTypeFlexibility struct {
+	// Cpus: The number of CPUs, or "any".
+	// +optional
+	Cpus *string `json:"cpus,omitempty"`
+
+	// Memory: The amount of memory, or "any".
+	// +optional
+	Memory *string `json:"memory,omitempty"`
+
+	// LocalSSD: The amount of local SSD, or "any".
+	// +optional
+	LocalSSD *string `json:"localSsd,omitempty"`
+}
+
+// A NodeTemplateObservation represents the observed state of a Google
+// Compute Engine node template.
+type NodeTemplateObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource. Node groups use it
+	// to reference the template.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the node template, i.e. CREATING, READY,
+	// INVALID or DELETING.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage: An optional, human-readable explanation of the
+	// status.
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// A NodeTemplateSpec defines the desired state of a NodeTemplate.
+type NodeTemplateSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  NodeTemplateParameters `json:"forProvider"`
+}
+
+// A NodeTemplateStatus represents the observed state of a NodeTemplate.
+type NodeTemplateStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     NodeTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NodeTemplate is a managed resource that represents a Google Compute
+// Engine node template, which specifies the properties of the sole-tenant
+// nodes of node groups created from it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type NodeTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeTemplateSpec   `json:"spec"`
+	Status NodeTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeTemplateList contains a list of NodeTemplate.
+type NodeTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeTemplate `json:"items"`
+}
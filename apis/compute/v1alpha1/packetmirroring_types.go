@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// PacketMirroringParameters define the desired state of a Google Compute
+// Engine Packet Mirroring policy. Most fields map directly to a
+// PacketMirroring:
+// https://cloud.google.com/compute/docs/reference/rest/v1/packetMirrorings
+type PacketMirroringParameters struct {
+	// Region: URI of the region where the packetMirroring resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Network: URL of the network in which mirrored instances, subnetworks
+	// and the collector reside.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *runtimev1alpha1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	// +immutable
+	NetworkSelector *runtimev1alpha1.Selector `json:"networkSelector,omitempty"`
+
+	// CollectorILB: URL of the forwarding rule of an internal load
+	// balancer that will be used as collector for mirrored traffic. The
+	// forwarding rule must have isMirroringCollector set to true.
+	CollectorILB string `json:"collectorIlb"`
+
+	// MirroredResources: The instances, subnetworks and network tags whose
+	// traffic is mirrored.
+	MirroredResources PacketMirroringMirroredResources `json:"mirroredResources"`
+
+	// Filter: Restricts the mirrored traffic by protocol and CIDR range.
+	// +optional
+	Filter *PacketMirroringFilter `json:"filter,omitempty"`
+
+	// Priority: The priority of applying this configuration when several
+	// policies mirror the same instance. Lower values take precedence.
+	// +optional
+	Priority *int64 `json:"priority,omitempty"`
+
+	// Enable: Indicates whether or not this packet mirroring takes effect.
+	// Defaults to TRUE.
+	// +optional
+	// +kubebuilder:validation:Enum=TRUE;FALSE
+	Enable *string `json:"enable,omitempty"`
+}
+
+// PacketMirroringMirroredResources specify the resources whose traffic is
+// mirrored.
+type PacketMirroringMirroredResources struct {
+	// Subnetworks: URLs of subnetworks whose instances' traffic is
+	// mirrored.
+	// +optional
+	Subnetworks []string `json:"subnetworks,omitempty"`
+
+	// SubnetworkRefs references Subnetworks and retrieves their URIs
+	// +optional
+	SubnetworkRefs []runtimev1alpha1.Reference `json:"subnetworkRefs,omitempty"`
+
+	// SubnetworkSelector selects references to Subnetworks
+	// +optional
+	SubnetworkSelector *runtimev1alpha1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// Instances: URLs of instances whose traffic is mirrored.
+	// +optional
+	Instances []string `json:"instances,omitempty"`
+
+	// Tags: Network tags of instances whose traffic is mirrored.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+}
+
+// PacketMirroringFilter restricts the traffic that is mirrored.
+type PacketMirroringFilter struct {
+	// IPProtocols: Protocols that apply as filter on mirrored traffic,
+	// e.g. tcp, udp or icmp.
+	// +optional
+	IPProtocols []string `json:"ipProtocols,omitempty"`
+
+	// CIDRRanges: IP CIDR ranges that apply as filter on the source
+	// (ingress) or destination (egress) IP of mirrored traffic.
+	// +optional
+	CIDRRanges []string `json:"cidrRanges,omitempty"`
+}
+
+// A PacketMirroringObservation represents the observed state of a Google
+// Compute Engine Packet Mirroring policy.
+type PacketMirroringObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A PacketMirroringSpec defines the desired state of a PacketMirroring.
+type PacketMirroringSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PacketMirroringParameters `json:"forProvider"`
+}
+
+// A PacketMirroringStatus represents the observed state of a
+// PacketMirroring.
+type PacketMirroringStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PacketMirroringObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PacketMirroring is a managed resource that represents a Google Compute
+// Engine Packet Mirroring policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type PacketMirroring struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PacketMirroringSpec   `json:"spec"`
+	Status PacketMirroringStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PacketMirroringList contains a list of PacketMirroring.
+type PacketMirroringList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PacketMirroring `json:"items"`
+}
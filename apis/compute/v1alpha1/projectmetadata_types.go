@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ProjectMetadataParameters define the desired common instance metadata of
+// the Provider's project. Only the metadata keys that are specified are
+// managed; all other keys of the project are left untouched.
+// https://cloud.google.com/compute/docs/reference/rest/v1/projects/setCommonInstanceMetadata
+type ProjectMetadataParameters struct {
+	// EnableOSLogin: Sets the enable-oslogin key, which makes instances of
+	// the project use OS Login to manage SSH access.
+	// +optional
+	EnableOSLogin *bool `json:"enableOsLogin,omitempty"`
+
+	// BlockProjectSSHKeys: Sets the block-project-ssh-keys key, which makes
+	// instances of the project ignore the project-wide SSH keys.
+	// +optional
+	BlockProjectSSHKeys *bool `json:"blockProjectSshKeys,omitempty"`
+
+	// SSHKeys: Sets the ssh-keys key to the supplied project-wide SSH keys,
+	// each in the form USERNAME:KEY_VALUE. Keys that were added to the
+	// project otherwise are removed.
+	// +optional
+	SSHKeys []string `json:"sshKeys,omitempty"`
+
+	// Items: Custom metadata keys and values. They must not include the
+	// keys managed by the other fields.
+	// +optional
+	Items map[string]string `json:"items,omitempty"`
+}
+
+// ProjectMetadataObservation is used to show the observed state of the
+// ProjectMetadata resource on GCP.
+type ProjectMetadataObservation struct {
+	// Fingerprint: A hash of the common instance metadata of the project,
+	// used for optimistic locking when it is updated.
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// A ProjectMetadataSpec defines the desired state of a ProjectMetadata.
+type ProjectMetadataSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ProjectMetadataParameters `json:"forProvider"`
+}
+
+// A ProjectMetadataStatus represents the observed state of a
+// ProjectMetadata.
+type ProjectMetadataStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ProjectMetadataObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectMetadata is a managed resource that represents metadata items of
+// the common instance metadata of a Google Compute Engine project, which
+// apply to all instances of the project. Deleting it removes the items it
+// manages from the project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="OS-LOGIN",type="boolean",JSONPath=".spec.forProvider.enableOsLogin"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ProjectMetadata struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectMetadataSpec   `json:"spec"`
+	Status ProjectMetadataStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectMetadataList contains a list of ProjectMetadata.
+type ProjectMetadataList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectMetadata `json:"items"`
+}
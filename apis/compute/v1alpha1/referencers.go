@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// NodeTemplateURL extracts the partially qualified URL of a NodeTemplate.
+func NodeTemplateURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		t, ok := mg.(*NodeTemplate)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(t.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// SslPolicyURL extracts the partially qualified URL of an SslPolicy.
+func SslPolicyURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*SslPolicy)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(p.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// ResolveReferences of this NetworkEndpointGroup
+func (mg *NetworkEndpointGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.network
+	if err := v1beta1.ResolveNetwork(ctx, r, &mg.Spec.ForProvider.Network, &mg.Spec.ForProvider.NetworkRef, mg.Spec.ForProvider.NetworkSelector); err != nil {
+		return err
+	}
+
+	// Resolve spec.subnetwork
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Subnetwork),
+		Reference:    mg.Spec.ForProvider.SubnetworkRef,
+		Selector:     mg.Spec.ForProvider.SubnetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:      v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SubnetworkRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PacketMirroring
+func (mg *PacketMirroring) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.network
+	if err := v1beta1.ResolveNetwork(ctx, r, &mg.Spec.ForProvider.Network, &mg.Spec.ForProvider.NetworkRef, mg.Spec.ForProvider.NetworkSelector); err != nil {
+		return err
+	}
+
+	// Resolve spec.mirroredResources.subnetworks
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.MirroredResources.Subnetworks,
+		References:    mg.Spec.ForProvider.MirroredResources.SubnetworkRefs,
+		Selector:      mg.Spec.ForProvider.MirroredResources.SubnetworkSelector,
+		To:            reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:       v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.MirroredResources.Subnetworks = mrsp.ResolvedValues
+	mg.Spec.ForProvider.MirroredResources.SubnetworkRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this Router
+func (mg *Router) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.network
+	if err := v1beta1.ResolveNetwork(ctx, r, &mg.Spec.ForProvider.Network, &mg.Spec.ForProvider.NetworkRef, mg.Spec.ForProvider.NetworkSelector); err != nil {
+		return err
+	}
+
+	// Resolve spec.nats[].subnetworks[].subnetwork
+	for i := range mg.Spec.ForProvider.Nats {
+		for j := range mg.Spec.ForProvider.Nats[i].Subnetworks {
+			s := &mg.Spec.ForProvider.Nats[i].Subnetworks[j]
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(s.Subnetwork),
+				Reference:    s.SubnetworkRef,
+				Selector:     s.SubnetworkSelector,
+				To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+				Extract:      v1beta1.SubnetworkURL(),
+			})
+			if err != nil {
+				return err
+			}
+			s.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+			s.SubnetworkRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this NodeGroup
+func (mg *NodeGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.nodeTemplate
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NodeTemplate),
+		Reference:    mg.Spec.ForProvider.NodeTemplateRef,
+		Selector:     mg.Spec.ForProvider.NodeTemplateSelector,
+		To:           reference.To{Managed: &NodeTemplate{}, List: &NodeTemplateList{}},
+		Extract:      NodeTemplateURL(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.NodeTemplate = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NodeTemplateRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Firewall
+func (mg *Firewall) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.network
+	return v1beta1.ResolveNetwork(ctx, r, &mg.Spec.ForProvider.Network, &mg.Spec.ForProvider.NetworkRef, mg.Spec.ForProvider.NetworkSelector)
+}
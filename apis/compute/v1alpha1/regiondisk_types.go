@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Known RegionDisk statuses.
+const (
+	RegionDiskStatusCreating  = "CREATING"
+	RegionDiskStatusRestoring = "RESTORING"
+	RegionDiskStatusFailed    = "FAILED"
+	RegionDiskStatusReady     = "READY"
+	RegionDiskStatusDeleting  = "DELETING"
+)
+
+// RegionDiskParameters define the desired state of a Google Compute Engine
+// regional persistent disk. Most fields map directly to a Disk:
+// https://cloud.google.com/compute/docs/reference/rest/v1/regionDisks
+type RegionDiskParameters struct {
+	// Region in which the disk resides, e.g. us-central1. Defaults to the
+	// defaultRegion of the Provider.
+	// +optional
+	// +immutable
+	Region string `json:"region,omitempty"`
+
+	// ReplicaZones: The two zones of the region in which the disk is
+	// replicated, e.g. us-central1-a and us-central1-b.
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	// +immutable
+	ReplicaZones []string `json:"replicaZones"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// SizeGb: Size of the persistent disk, specified in GB. The size can
+	// only be increased once the disk has been created.
+	// +optional
+	SizeGb *int64 `json:"sizeGb,omitempty"`
+
+	// Type: The disk type, either as a name such as pd-ssd or as the URL
+	// of a regional disk type. Defaults to pd-standard.
+	// +optional
+	// +immutable
+	Type *string `json:"type,omitempty"`
+
+	// KMSKeyName: The resource name of the Cloud KMS key used to encrypt
+	// the disk, in the form
+	// projects/{project}/locations/{location}/keyRings/{ring}/cryptoKeys/{key}.
+	// The disk is encrypted with a Google-managed key if omitted.
+	// +optional
+	// +immutable
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+}
+
+// A RegionDiskObservation represents the observed state of a Google Compute
+// Engine regional persistent disk.
+type RegionDiskObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of disk creation, one of CREATING, RESTORING,
+	// FAILED, READY or DELETING.
+	Status string `json:"status,omitempty"`
+
+	// Users: Links to the instances that the disk is attached to.
+	Users []string `json:"users,omitempty"`
+
+	// KMSKeyVersion: The Cloud KMS key version used to encrypt the disk,
+	// if it is encrypted with a customer-managed key.
+	KMSKeyVersion string `json:"kmsKeyVersion,omitempty"`
+}
+
+// A RegionDiskSpec defines the desired state of a RegionDisk.
+type RegionDiskSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  RegionDiskParameters `json:"forProvider"`
+}
+
+// A RegionDiskStatus represents the observed state of a RegionDisk.
+type RegionDiskStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     RegionDiskObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RegionDisk is a managed resource that represents a Google Compute Engine
+// regional persistent disk, which is replicated across two zones of a region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".spec.forProvider.sizeGb"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type RegionDisk struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegionDiskSpec   `json:"spec"`
+	Status RegionDiskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RegionDiskList contains a list of RegionDisk.
+type RegionDiskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegionDisk `json:"items"`
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// RegionInstanceGroupManagerParameters define the desired state of a Google
+// Compute Engine regional managed instance group, which spreads identical
+// instances across the zones of a region. Most fields map directly to a
+// RegionInstanceGroupManager:
+// https://cloud.google.com/compute/docs/reference/rest/v1/regionInstanceGroupManagers
+type RegionInstanceGroupManagerParameters struct {
+	// Region in which the managed instance group resides, e.g.
+	// us-central1.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// BaseInstanceName: The base instance name to use for instances in this
+	// group. Instances are named by appending a hyphen and a random string
+	// of four characters to it.
+	// +immutable
+	BaseInstanceName string `json:"baseInstanceName"`
+
+	// InstanceTemplate: The URL of the instance template that is used to
+	// create instances in this group. Changing it rolls the group out to
+	// the new template according to the update policy.
+	InstanceTemplate string `json:"instanceTemplate"`
+
+	// TargetSize: The target number of running instances for this managed
+	// instance group. Defaults to zero. An autoscaler that scales this
+	// group changes its target size.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TargetSize *int64 `json:"targetSize,omitempty"`
+
+	// TargetPools: The URLs of the target pools to which instances in this
+	// group are added.
+	// +optional
+	// +immutable
+	TargetPools []string `json:"targetPools,omitempty"`
+
+	// NamedPorts: Named ports configured for the instance groups
+	// complementary to this instance group manager.
+	// +optional
+	// +immutable
+	NamedPorts []NamedPort `json:"namedPorts,omitempty"`
+
+	// AutoHealingPolicies: The autohealing policy for this managed instance
+	// group. At most one policy may be specified.
+	// +optional
+	// +kubebuilder:validation:MaxItems=1
+	AutoHealingPolicies []AutoHealingPolicy `json:"autoHealingPolicies,omitempty"`
+
+	// DistributionPolicy specifies the zones of the region in which the
+	// group's instances are created. Instances are spread across all zones
+	// of the region if omitted.
+	// +optional
+	// +immutable
+	DistributionPolicy *DistributionPolicy `json:"distributionPolicy,omitempty"`
+
+	// UpdatePolicy specifies how changes to the instance template are
+	// rolled out to the group's instances.
+	// +optional
+	UpdatePolicy *UpdatePolicy `json:"updatePolicy,omitempty"`
+}
+
+// A NamedPort maps a name to a port number.
+type NamedPort struct {
+	// Name: The name for this named port.
+	Name string `json:"name"`
+
+	// Port: The port number, which can be a value between 1 and 65535.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+}
+
+// An AutoHealingPolicy recreates instances that fail a health check.
+type AutoHealingPolicy struct {
+	// HealthCheck: The URL for the health check that signals autohealing.
+	HealthCheck string `json:"healthCheck"`
+
+	// InitialDelaySec: The number of seconds that the managed instance
+	// group waits before it applies autohealing policies to new instances
+	// or recently recreated instances. Defaults to 300.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	InitialDelaySec *int64 `json:"initialDelaySec,omitempty"`
+}
+
+// A DistributionPolicy specifies how a regional managed instance group
+// distributes its instances.
+type DistributionPolicy struct {
+	// Zones: The zones of the region in which instances are created, e.g.
+	// us-central1-a.
+	// +kubebuilder:validation:MinItems=1
+	Zones []string `json:"zones"`
+}
+
+// An UpdatePolicy specifies how a managed instance group updates its
+// instances.
+type UpdatePolicy struct {
+	// Type: The type of update process. PROACTIVE updates instances as
+	// soon as the instance template changes, while OPPORTUNISTIC only
+	// updates instances that are recreated for other reasons. Defaults to
+	// OPPORTUNISTIC.
+	// +optional
+	// +kubebuilder:validation:Enum=PROACTIVE;OPPORTUNISTIC
+	Type *string `json:"type,omitempty"`
+
+	// MinimalAction: The minimal action performed to update an instance,
+	// either REPLACE or RESTART. Defaults to REPLACE.
+	// +optional
+	// +kubebuilder:validation:Enum=REPLACE;RESTART
+	MinimalAction *string `json:"minimalAction,omitempty"`
+
+	// InstanceRedistributionType: Whether instances are proactively
+	// redistributed across zones to keep the group balanced, either
+	// PROACTIVE or NONE. Defaults to PROACTIVE.
+	// +optional
+	// +kubebuilder:validation:Enum=PROACTIVE;NONE
+	InstanceRedistributionType *string `json:"instanceRedistributionType,omitempty"`
+
+	// MaxSurge: The maximum number of instances that can be created above
+	// the target size during an update.
+	// +optional
+	MaxSurge *FixedOrPercent `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable: The maximum number of instances that can be
+	// unavailable during an update.
+	// +optional
+	MaxUnavailable *FixedOrPercent `json:"maxUnavailable,omitempty"`
+}
+
+// FixedOrPercent is a number of instances, either as a fixed number or as a
+// percentage of the target size. Exactly one of fixed and percent must be
+// set.
+type FixedOrPercent struct {
+	// Fixed: A fixed number of instances.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Fixed *int64 `json:"fixed,omitempty"`
+
+	// Percent: A percentage of the target size of the group.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent *int64 `json:"percent,omitempty"`
+}
+
+// A RegionInstanceGroupManagerObservation represents the observed state of a
+// Google Compute Engine regional managed instance group.
+type RegionInstanceGroupManagerObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// InstanceGroup: The URL of the instance group that this managed
+	// instance group creates.
+	InstanceGroup string `json:"instanceGroup,omitempty"`
+
+	// Fingerprint of the managed instance group, which changes whenever it
+	// is updated.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// IsStable: Whether the group has reached its target state; all of its
+	// instances exist and are running the current instance template.
+	IsStable bool `json:"isStable,omitempty"`
+
+	// Autoscaler: The URL of the autoscaler that scales this group, if any.
+	Autoscaler string `json:"autoscaler,omitempty"`
+}
+
+// A RegionInstanceGroupManagerSpec defines the desired state of a
+// RegionInstanceGroupManager.
+type RegionInstanceGroupManagerSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  RegionInstanceGroupManagerParameters `json:"forProvider"`
+}
+
+// A RegionInstanceGroupManagerStatus represents the observed state of a
+// RegionInstanceGroupManager.
+type RegionInstanceGroupManagerStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     RegionInstanceGroupManagerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RegionInstanceGroupManager is a managed resource that represents a Google
+// Compute Engine regional managed instance group, which runs instances of an
+// instance template across multiple zones of a region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STABLE",type="boolean",JSONPath=".status.atProvider.isStable"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".spec.forProvider.targetSize"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type RegionInstanceGroupManager struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegionInstanceGroupManagerSpec   `json:"spec"`
+	Status RegionInstanceGroupManagerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RegionInstanceGroupManagerList contains a list of
+// RegionInstanceGroupManager.
+type RegionInstanceGroupManagerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegionInstanceGroupManager `json:"items"`
+}
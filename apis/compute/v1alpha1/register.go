@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "compute.gcp.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// NetworkEndpointGroup type metadata.
+var (
+	NetworkEndpointGroupKind             = reflect.TypeOf(NetworkEndpointGroup{}).Name()
+	NetworkEndpointGroupGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkEndpointGroupKind}.String()
+	NetworkEndpointGroupKindAPIVersion   = NetworkEndpointGroupKind + "." + SchemeGroupVersion.String()
+	NetworkEndpointGroupGroupVersionKind = SchemeGroupVersion.WithKind(NetworkEndpointGroupKind)
+)
+
+// PacketMirroring type metadata.
+var (
+	PacketMirroringKind             = reflect.TypeOf(PacketMirroring{}).Name()
+	PacketMirroringGroupKind        = schema.GroupKind{Group: Group, Kind: PacketMirroringKind}.String()
+	PacketMirroringKindAPIVersion   = PacketMirroringKind + "." + SchemeGroupVersion.String()
+	PacketMirroringGroupVersionKind = SchemeGroupVersion.WithKind(PacketMirroringKind)
+)
+
+// InterconnectAttachment type metadata.
+var (
+	InterconnectAttachmentKind             = reflect.TypeOf(InterconnectAttachment{}).Name()
+	InterconnectAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: InterconnectAttachmentKind}.String()
+	InterconnectAttachmentKindAPIVersion   = InterconnectAttachmentKind + "." + SchemeGroupVersion.String()
+	InterconnectAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(InterconnectAttachmentKind)
+)
+
+// Autoscaler type metadata.
+var (
+	AutoscalerKind             = reflect.TypeOf(Autoscaler{}).Name()
+	AutoscalerGroupKind        = schema.GroupKind{Group: Group, Kind: AutoscalerKind}.String()
+	AutoscalerKindAPIVersion   = AutoscalerKind + "." + SchemeGroupVersion.String()
+	AutoscalerGroupVersionKind = SchemeGroupVersion.WithKind(AutoscalerKind)
+)
+
+// Router type metadata.
+var (
+	RouterKind             = reflect.TypeOf(Router{}).Name()
+	RouterGroupKind        = schema.GroupKind{Group: Group, Kind: RouterKind}.String()
+	RouterKindAPIVersion   = RouterKind + "." + SchemeGroupVersion.String()
+	RouterGroupVersionKind = SchemeGroupVersion.WithKind(RouterKind)
+)
+
+// RegionDisk type metadata.
+var (
+	RegionDiskKind             = reflect.TypeOf(RegionDisk{}).Name()
+	RegionDiskGroupKind        = schema.GroupKind{Group: Group, Kind: RegionDiskKind}.String()
+	RegionDiskKindAPIVersion   = RegionDiskKind + "." + SchemeGroupVersion.String()
+	RegionDiskGroupVersionKind = SchemeGroupVersion.WithKind(RegionDiskKind)
+)
+
+// TargetInstance type metadata.
+var (
+	TargetInstanceKind             = reflect.TypeOf(TargetInstance{}).Name()
+	TargetInstanceGroupKind        = schema.GroupKind{Group: Group, Kind: TargetInstanceKind}.String()
+	TargetInstanceKindAPIVersion   = TargetInstanceKind + "." + SchemeGroupVersion.String()
+	TargetInstanceGroupVersionKind = SchemeGroupVersion.WithKind(TargetInstanceKind)
+)
+
+// ResourcePolicy type metadata.
+var (
+	ResourcePolicyKind             = reflect.TypeOf(ResourcePolicy{}).Name()
+	ResourcePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: ResourcePolicyKind}.String()
+	ResourcePolicyKindAPIVersion   = ResourcePolicyKind + "." + SchemeGroupVersion.String()
+	ResourcePolicyGroupVersionKind = SchemeGroupVersion.WithKind(ResourcePolicyKind)
+)
+
+// NodeTemplate type metadata.
+var (
+	NodeTemplateKind             = reflect.TypeOf(NodeTemplate{}).Name()
+	NodeTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: NodeTemplateKind}.String()
+	NodeTemplateKindAPIVersion   = NodeTemplateKind + "." + SchemeGroupVersion.String()
+	NodeTemplateGroupVersionKind = SchemeGroupVersion.WithKind(NodeTemplateKind)
+)
+
+// NodeGroup type metadata.
+var (
+	NodeGroupKind             = reflect.TypeOf(NodeGroup{}).Name()
+	NodeGroupGroupKind        = schema.GroupKind{Group: Group, Kind: NodeGroupKind}.String()
+	NodeGroupKindAPIVersion   = NodeGroupKind + "." + SchemeGroupVersion.String()
+	NodeGroupGroupVersionKind = SchemeGroupVersion.WithKind(NodeGroupKind)
+)
+
+// RegionInstanceGroupManager type metadata.
+var (
+	RegionInstanceGroupManagerKind             = reflect.TypeOf(RegionInstanceGroupManager{}).Name()
+	RegionInstanceGroupManagerGroupKind        = schema.GroupKind{Group: Group, Kind: RegionInstanceGroupManagerKind}.String()
+	RegionInstanceGroupManagerKindAPIVersion   = RegionInstanceGroupManagerKind + "." + SchemeGroupVersion.String()
+	RegionInstanceGroupManagerGroupVersionKind = SchemeGroupVersion.WithKind(RegionInstanceGroupManagerKind)
+)
+
+// SslPolicy type metadata.
+var (
+	SslPolicyKind             = reflect.TypeOf(SslPolicy{}).Name()
+	SslPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: SslPolicyKind}.String()
+	SslPolicyKindAPIVersion   = SslPolicyKind + "." + SchemeGroupVersion.String()
+	SslPolicyGroupVersionKind = SchemeGroupVersion.WithKind(SslPolicyKind)
+)
+
+// ProjectMetadata type metadata.
+var (
+	ProjectMetadataKind             = reflect.TypeOf(ProjectMetadata{}).Name()
+	ProjectMetadataGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectMetadataKind}.String()
+	ProjectMetadataKindAPIVersion   = ProjectMetadataKind + "." + SchemeGroupVersion.String()
+	ProjectMetadataGroupVersionKind = SchemeGroupVersion.WithKind(ProjectMetadataKind)
+)
+
+// Firewall type metadata.
+var (
+	FirewallKind             = reflect.TypeOf(Firewall{}).Name()
+	FirewallGroupKind        = schema.GroupKind{Group: Group, Kind: FirewallKind}.String()
+	FirewallKindAPIVersion   = FirewallKind + "." + SchemeGroupVersion.String()
+	FirewallGroupVersionKind = SchemeGroupVersion.WithKind(FirewallKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NetworkEndpointGroup{}, &NetworkEndpointGroupList{})
+	SchemeBuilder.Register(&PacketMirroring{}, &PacketMirroringList{})
+	SchemeBuilder.Register(&InterconnectAttachment{}, &InterconnectAttachmentList{})
+	SchemeBuilder.Register(&Autoscaler{}, &AutoscalerList{})
+	SchemeBuilder.Register(&Router{}, &RouterList{})
+	SchemeBuilder.Register(&RegionDisk{}, &RegionDiskList{})
+	SchemeBuilder.Register(&TargetInstance{}, &TargetInstanceList{})
+	SchemeBuilder.Register(&ResourcePolicy{}, &ResourcePolicyList{})
+	SchemeBuilder.Register(&NodeTemplate{}, &NodeTemplateList{})
+	SchemeBuilder.Register(&NodeGroup{}, &NodeGroupList{})
+	SchemeBuilder.Register(&RegionInstanceGroupManager{}, &RegionInstanceGroupManagerList{})
+	SchemeBuilder.Register(&SslPolicy{}, &SslPolicyList{})
+	SchemeBuilder.Register(&ProjectMetadata{}, &ProjectMetadataList{})
+	SchemeBuilder.Register(&Firewall{}, &FirewallList{})
+}
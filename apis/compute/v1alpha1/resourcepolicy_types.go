@@ -0,0 +1,252 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Known resource policy statuses.
+const (
+	ResourcePolicyStatusCreating = "CREATING"
+	ResourcePolicyStatusReady    = "READY"
+	ResourcePolicyStatusDeleting = "DELETING"
+	ResourcePolicyStatusInvalid  = "INVALID"
+	ResourcePolicyStatusExpired  = "EXPIRED"
+)
+
+// ResourcePolicyParameters define the desired state of a Google Compute
+// Engine resource policy. A resource policy either starts and stops the
+// instances it is attached to on a schedule, or takes snapshots of the disks
+// it is attached to on a schedule. Resource policies cannot be changed once
+// they have been created. Most fields map directly to a ResourcePolicy:
+// https://cloud.google.com/compute/docs/reference/rest/v1/resourcePolicies
+type ResourcePolicyParameters struct {
+	// Region: URI of the region where the resource policy resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// InstanceSchedulePolicy: Starts and stops the instances this policy is
+	// attached to on a schedule.
+	// +optional
+	// +immutable
+	InstanceSchedulePolicy *ResourcePolicyInstanceSchedulePolicy `json:"instanceSchedulePolicy,omitempty"`
+
+	// SnapshotSchedulePolicy: Takes snapshots of the disks this policy is
+	// attached to on a schedule.
+	// +optional
+	// +immutable
+	SnapshotSchedulePolicy *ResourcePolicySnapshotSchedulePolicy `json:"snapshotSchedulePolicy,omitempty"`
+}
+
+// ResourcePolicyInstanceSchedulePolicy starts and stops instances on a
+// schedule.
+type ResourcePolicyInstanceSchedulePolicy struct {
+	// VMStartSchedule: Specifies when instances are started.
+	// +optional
+	VMStartSchedule *ResourcePolicyInstanceSchedule `json:"vmStartSchedule,omitempty"`
+
+	// VMStopSchedule: Specifies when instances are stopped.
+	// +optional
+	VMStopSchedule *ResourcePolicyInstanceSchedule `json:"vmStopSchedule,omitempty"`
+
+	// TimeZone: The time zone in which the schedules are interpreted, from
+	// the tz database, e.g. Europe/Zurich.
+	TimeZone string `json:"timeZone"`
+
+	// StartTime: The time from which the schedules take effect, in RFC3339
+	// format.
+	// +optional
+	StartTime *string `json:"startTime,omitempty"`
+
+	// ExpirationTime: The time at which the schedules stop taking effect,
+	// in RFC3339 format.
+	// +optional
+	ExpirationTime *string `json:"expirationTime,omitempty"`
+}
+
+// ResourcePolicyInstanceSchedule specifies when an instance schedule
+// operation takes place.
+type ResourcePolicyInstanceSchedule struct {
+	// Schedule: A cron expression, e.g. "0 8 * * 1-5".
+	Schedule string `json:"schedule"`
+}
+
+// ResourcePolicySnapshotSchedulePolicy takes snapshots of persistent disks on
+// a schedule.
+type ResourcePolicySnapshotSchedulePolicy struct {
+	// Schedule: Specifies when snapshots are taken. Exactly one of its
+	// schedules must be set.
+	Schedule ResourcePolicySnapshotSchedule `json:"schedule"`
+
+	// RetentionPolicy: Specifies how long snapshots are kept.
+	// +optional
+	RetentionPolicy *ResourcePolicySnapshotRetentionPolicy `json:"retentionPolicy,omitempty"`
+
+	// SnapshotProperties: Properties of the snapshots that are taken.
+	// +optional
+	SnapshotProperties *ResourcePolicySnapshotProperties `json:"snapshotProperties,omitempty"`
+}
+
+// ResourcePolicySnapshotSchedule specifies when snapshots are taken.
+type ResourcePolicySnapshotSchedule struct {
+	// HourlySchedule: Takes a snapshot every few hours.
+	// +optional
+	HourlySchedule *ResourcePolicyHourlyCycle `json:"hourlySchedule,omitempty"`
+
+	// DailySchedule: Takes a snapshot every few days.
+	// +optional
+	DailySchedule *ResourcePolicyDailyCycle `json:"dailySchedule,omitempty"`
+
+	// WeeklySchedule: Takes snapshots on certain days of the week.
+	// +optional
+	WeeklySchedule *ResourcePolicyWeeklyCycle `json:"weeklySchedule,omitempty"`
+}
+
+// ResourcePolicyHourlyCycle takes a snapshot every few hours.
+type ResourcePolicyHourlyCycle struct {
+	// HoursInCycle: The number of hours between snapshots.
+	HoursInCycle int64 `json:"hoursInCycle"`
+
+	// StartTime: The UTC time at which the first snapshot is taken, in
+	// HH:MM format.
+	StartTime string `json:"startTime"`
+}
+
+// ResourcePolicyDailyCycle takes a snapshot every few days.
+type ResourcePolicyDailyCycle struct {
+	// DaysInCycle: The number of days between snapshots.
+	DaysInCycle int64 `json:"daysInCycle"`
+
+	// StartTime: The UTC time at which snapshots are taken, in HH:MM
+	// format.
+	StartTime string `json:"startTime"`
+}
+
+// ResourcePolicyWeeklyCycle takes snapshots on certain days of the week.
+type ResourcePolicyWeeklyCycle struct {
+	// DayOfWeeks: The days on which snapshots are taken.
+	DayOfWeeks []ResourcePolicyWeeklyCycleDayOfWeek `json:"dayOfWeeks"`
+}
+
+// ResourcePolicyWeeklyCycleDayOfWeek specifies a day on which snapshots are
+// taken.
+type ResourcePolicyWeeklyCycleDayOfWeek struct {
+	// Day: The day of the week.
+	// +kubebuilder:validation:Enum=MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY;SUNDAY
+	Day string `json:"day"`
+
+	// StartTime: The UTC time at which the snapshot is taken, in HH:MM
+	// format.
+	StartTime string `json:"startTime"`
+}
+
+// ResourcePolicySnapshotRetentionPolicy specifies how long snapshots are
+// kept.
+type ResourcePolicySnapshotRetentionPolicy struct {
+	// MaxRetentionDays: The number of days after which a snapshot is
+	// deleted.
+	MaxRetentionDays int64 `json:"maxRetentionDays"`
+
+	// OnSourceDiskDelete: Whether snapshots are kept once their source
+	// disk is deleted. Defaults to KEEP_AUTO_SNAPSHOTS.
+	// +optional
+	// +kubebuilder:validation:Enum=KEEP_AUTO_SNAPSHOTS;APPLY_RETENTION_POLICY
+	OnSourceDiskDelete *string `json:"onSourceDiskDelete,omitempty"`
+}
+
+// ResourcePolicySnapshotProperties are the properties of the snapshots that
+// a snapshot schedule policy takes.
+type ResourcePolicySnapshotProperties struct {
+	// Labels: Labels to apply to the snapshots.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// StorageLocations: The Cloud Storage location in which snapshots are
+	// stored, e.g. us or us-central1. Defaults to the multi-region nearest
+	// the disk.
+	// +optional
+	StorageLocations []string `json:"storageLocations,omitempty"`
+
+	// GuestFlush: Whether the snapshots are application consistent.
+	// +optional
+	GuestFlush *bool `json:"guestFlush,omitempty"`
+}
+
+// A ResourcePolicyObservation represents the observed state of a Google
+// Compute Engine resource policy.
+type ResourcePolicyObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource. Instances and disks
+	// refer to the policy by this URL.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the resource policy.
+	Status string `json:"status,omitempty"`
+}
+
+// A ResourcePolicySpec defines the desired state of a ResourcePolicy.
+type ResourcePolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResourcePolicyParameters `json:"forProvider"`
+}
+
+// A ResourcePolicyStatus represents the observed state of a ResourcePolicy.
+type ResourcePolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResourcePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourcePolicy is a managed resource that represents a Google Compute
+// Engine resource policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ResourcePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourcePolicySpec   `json:"spec"`
+	Status ResourcePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourcePolicyList contains a list of ResourcePolicy.
+type ResourcePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourcePolicy `json:"items"`
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Which subnetwork IP ranges a NAT applies to.
+const (
+	RouterNatAllSubnetworksAllIPRanges        = "ALL_SUBNETWORKS_ALL_IP_RANGES"
+	RouterNatAllSubnetworksAllPrimaryIPRanges = "ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES"
+	RouterNatListOfSubnetworks                = "LIST_OF_SUBNETWORKS"
+)
+
+// RouterParameters define the desired state of a Google Compute Engine Cloud
+// Router. Most fields map directly to a Router:
+// https://cloud.google.com/compute/docs/reference/rest/v1/routers
+type RouterParameters struct {
+	// Region: URI of the region where the router resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Network: URI of the network to which this router belongs.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	// +immutable
+	NetworkRef *runtimev1alpha1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	// +immutable
+	NetworkSelector *runtimev1alpha1.Selector `json:"networkSelector,omitempty"`
+
+	// Bgp: BGP information specific to this router.
+	// +optional
+	Bgp *RouterBgp `json:"bgp,omitempty"`
+
+	// Nats: A list of NAT services created in this router.
+	// +optional
+	Nats []RouterNat `json:"nats,omitempty"`
+}
+
+// RouterBgp is the BGP information of a router.
+type RouterBgp struct {
+	// Asn: Local BGP Autonomous System Number (ASN). Must be an RFC6996
+	// private ASN, either 16-bit or 32-bit.
+	// +optional
+	Asn *int64 `json:"asn,omitempty"`
+}
+
+// A RouterNat is a NAT service created in a router.
+type RouterNat struct {
+	// Name: Unique name of this NAT service within the router.
+	Name string `json:"name"`
+
+	// NatIPAllocateOption: Specify the NatIpAllocateOption, which can take
+	// one of the following values: MANUAL_ONLY, in which case the user must
+	// specify NatIPs, or AUTO_ONLY, in which case NAT IPs are allocated by
+	// Google Cloud Platform.
+	// +kubebuilder:validation:Enum=AUTO_ONLY;MANUAL_ONLY
+	NatIPAllocateOption string `json:"natIpAllocateOption"`
+
+	// NatIPs: A list of URLs of the IP resources used for this NAT service.
+	// They must be set when NatIPAllocateOption is MANUAL_ONLY.
+	// +optional
+	NatIPs []string `json:"natIps,omitempty"`
+
+	// SourceSubnetworkIPRangesToNat: Specify the subnetwork IP ranges this
+	// NAT applies to. ALL_SUBNETWORKS_ALL_IP_RANGES and
+	// ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES apply to every subnetwork in the
+	// region, while LIST_OF_SUBNETWORKS applies only to the ranges of the
+	// subnetworks listed in Subnetworks.
+	// +kubebuilder:validation:Enum=ALL_SUBNETWORKS_ALL_IP_RANGES;ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES;LIST_OF_SUBNETWORKS
+	SourceSubnetworkIPRangesToNat string `json:"sourceSubnetworkIpRangesToNat"`
+
+	// Subnetworks: A list of subnetworks, and which of their IP ranges,
+	// this NAT applies to. Only used when SourceSubnetworkIPRangesToNat is
+	// LIST_OF_SUBNETWORKS.
+	// +optional
+	Subnetworks []RouterNatSubnetwork `json:"subnetworks,omitempty"`
+
+	// LogConfig: Configure logging on this NAT.
+	// +optional
+	LogConfig *RouterNatLogConfig `json:"logConfig,omitempty"`
+
+	// MinPortsPerVM: Minimum number of ports allocated to a VM from this
+	// NAT.
+	// +optional
+	MinPortsPerVM *int64 `json:"minPortsPerVm,omitempty"`
+
+	// IcmpIdleTimeoutSec: Timeout (in seconds) for ICMP connections.
+	// Defaults to 30s if not set.
+	// +optional
+	IcmpIdleTimeoutSec *int64 `json:"icmpIdleTimeoutSec,omitempty"`
+
+	// TCPEstablishedIdleTimeoutSec: Timeout (in seconds) for TCP
+	// established connections. Defaults to 1200s if not set.
+	// +optional
+	TCPEstablishedIdleTimeoutSec *int64 `json:"tcpEstablishedIdleTimeoutSec,omitempty"`
+
+	// TCPTransitoryIdleTimeoutSec: Timeout (in seconds) for TCP transitory
+	// connections. Defaults to 30s if not set.
+	// +optional
+	TCPTransitoryIdleTimeoutSec *int64 `json:"tcpTransitoryIdleTimeoutSec,omitempty"`
+
+	// UDPIdleTimeoutSec: Timeout (in seconds) for UDP connections. Defaults
+	// to 30s if not set.
+	// +optional
+	UDPIdleTimeoutSec *int64 `json:"udpIdleTimeoutSec,omitempty"`
+}
+
+// A RouterNatSubnetwork specifies a subnetwork, and which of its IP ranges,
+// a NAT applies to.
+type RouterNatSubnetwork struct {
+	// Subnetwork: URL of the subnetwork.
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork and retrieves its URI
+	// +optional
+	SubnetworkRef *runtimev1alpha1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork
+	// +optional
+	SubnetworkSelector *runtimev1alpha1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// SourceIPRangesToNat: Specify the options for NAT ranges in the
+	// subnetwork. Valid options are ALL_IP_RANGES, PRIMARY_IP_RANGE and
+	// LIST_OF_SECONDARY_IP_RANGES. ALL_IP_RANGES may not be combined with
+	// any other option.
+	SourceIPRangesToNat []string `json:"sourceIpRangesToNat"`
+
+	// SecondaryIPRangeNames: A list of the secondary ranges of the
+	// subnetwork that are allowed to use NAT. This can be populated only if
+	// LIST_OF_SECONDARY_IP_RANGES is one of the values in
+	// SourceIPRangesToNat.
+	// +optional
+	SecondaryIPRangeNames []string `json:"secondaryIpRangeNames,omitempty"`
+}
+
+// RouterNatLogConfig configures logging on a NAT.
+type RouterNatLogConfig struct {
+	// Enable: Indicates whether or not to export logs.
+	Enable bool `json:"enable"`
+
+	// Filter: Specifies the desired filtering of logs on this NAT. ALL
+	// exports all logs, ERRORS_ONLY exports only connection errors and
+	// TRANSLATIONS_ONLY exports only successful connections. Defaults to
+	// ALL.
+	// +optional
+	// +kubebuilder:validation:Enum=ALL;ERRORS_ONLY;TRANSLATIONS_ONLY
+	Filter *string `json:"filter,omitempty"`
+}
+
+// A RouterObservation represents the observed state of a Google Compute
+// Engine Cloud Router.
+type RouterObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A RouterSpec defines the desired state of a Router.
+type RouterSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  RouterParameters `json:"forProvider"`
+}
+
+// A RouterStatus represents the observed state of a Router.
+type RouterStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     RouterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Router is a managed resource that represents a Google Compute Engine
+// Cloud Router, including its Cloud NAT services.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Router struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RouterSpec   `json:"spec"`
+	Status RouterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RouterList contains a list of Router.
+type RouterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Router `json:"items"`
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// SSL policy profiles.
+const (
+	SslPolicyProfileCompatible = "COMPATIBLE"
+	SslPolicyProfileModern     = "MODERN"
+	SslPolicyProfileRestricted = "RESTRICTED"
+	SslPolicyProfileCustom     = "CUSTOM"
+)
+
+// SslPolicyParameters define the desired state of a Google Compute Engine SSL
+// policy. Most fields map directly to an SslPolicy:
+// https://cloud.google.com/compute/docs/reference/rest/v1/sslPolicies
+type SslPolicyParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Profile: The set of SSL features that may be negotiated with
+	// clients. CUSTOM enables exactly the features in CustomFeatures.
+	// Defaults to COMPATIBLE.
+	// +optional
+	// +kubebuilder:validation:Enum=COMPATIBLE;MODERN;RESTRICTED;CUSTOM
+	Profile *string `json:"profile,omitempty"`
+
+	// MinTLSVersion: The minimum version of TLS that may be negotiated
+	// with clients. Defaults to TLS_1_0.
+	// +optional
+	// +kubebuilder:validation:Enum=TLS_1_0;TLS_1_1;TLS_1_2
+	MinTLSVersion *string `json:"minTlsVersion,omitempty"`
+
+	// CustomFeatures: The SSL features that are enabled when Profile is
+	// CUSTOM, e.g. TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256. Must be empty
+	// for other profiles.
+	// +optional
+	CustomFeatures []string `json:"customFeatures,omitempty"`
+}
+
+// SslPolicyObservation is used to show the observed state of the SslPolicy
+// resource on GCP.
+type SslPolicyObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource. Target HTTPS and SSL
+	// proxies reference the policy by this URL.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Fingerprint: A hash of the contents of the policy, used for
+	// optimistic locking when it is updated.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// EnabledFeatures: The SSL features that are enabled by the profile
+	// and minimum TLS version of the policy.
+	EnabledFeatures []string `json:"enabledFeatures,omitempty"`
+
+	// Warnings: Messages about the policy, for example about custom
+	// features that are not supported.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// A SslPolicySpec defines the desired state of a SslPolicy.
+type SslPolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  SslPolicyParameters `json:"forProvider"`
+}
+
+// A SslPolicyStatus represents the observed state of a SslPolicy.
+type SslPolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     SslPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SslPolicy is a managed resource that represents a Google Compute Engine
+// SSL policy, which controls the TLS versions and features load balancers
+// negotiate with clients.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROFILE",type="string",JSONPath=".spec.forProvider.profile"
+// +kubebuilder:printcolumn:name="MIN-TLS",type="string",JSONPath=".spec.forProvider.minTlsVersion"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type SslPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SslPolicySpec   `json:"spec"`
+	Status SslPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SslPolicyList contains a list of SslPolicy.
+type SslPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SslPolicy `json:"items"`
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TargetInstanceParameters define the desired state of a Google Compute
+// Engine target instance. Target instances cannot be changed once created.
+// Most fields map directly to a TargetInstance:
+// https://cloud.google.com/compute/docs/reference/rest/v1/targetInstances
+type TargetInstanceParameters struct {
+	// Zone: The zone where the target instance resides, e.g.
+	// us-central1-a.
+	// +immutable
+	Zone string `json:"zone"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Instance: URL of the instance that handles traffic for this target
+	// instance, e.g. zones/us-central1-a/instances/appliance. The instance
+	// must be in the same zone as the target instance.
+	// +immutable
+	Instance string `json:"instance"`
+
+	// NatPolicy: NAT option controlling how IPs are NAT'ed to the
+	// instance. Only NO_NAT is currently supported. Defaults to NO_NAT.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=NO_NAT
+	NatPolicy *string `json:"natPolicy,omitempty"`
+}
+
+// A TargetInstanceObservation represents the observed state of a Google
+// Compute Engine target instance.
+type TargetInstanceObservation struct {
+	// CreationTimestamp is the time the resource was created.
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+
+	// Id: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource. Forwarding rules
+	// use it as their target.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A TargetInstanceSpec defines the desired state of a TargetInstance.
+type TargetInstanceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TargetInstanceParameters `json:"forProvider"`
+}
+
+// A TargetInstanceStatus represents the observed state of a TargetInstance.
+type TargetInstanceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TargetInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TargetInstance is a managed resource that represents a Google Compute
+// Engine target instance, which forwards the traffic of a forwarding rule to
+// a single instance. It is used for protocol forwarding, including of
+// protocols such as GRE and ESP.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type TargetInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetInstanceSpec   `json:"spec"`
+	Status TargetInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetInstanceList contains a list of TargetInstance.
+type TargetInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetInstance `json:"items"`
+}
@@ -0,0 +1,3008 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoHealingPolicy) DeepCopyInto(out *AutoHealingPolicy) {
+	*out = *in
+	if in.InitialDelaySec != nil {
+		in, out := &in.InitialDelaySec, &out.InitialDelaySec
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoHealingPolicy.
+func (in *AutoHealingPolicy) DeepCopy() *AutoHealingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoHealingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Autoscaler) DeepCopyInto(out *Autoscaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscaler.
+func (in *Autoscaler) DeepCopy() *Autoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(Autoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Autoscaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerList) DeepCopyInto(out *AutoscalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Autoscaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerList.
+func (in *AutoscalerList) DeepCopy() *AutoscalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoscalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerObservation) DeepCopyInto(out *AutoscalerObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.StatusDetails != nil {
+		in, out := &in.StatusDetails, &out.StatusDetails
+		*out = make([]AutoscalerStatusDetails, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerObservation.
+func (in *AutoscalerObservation) DeepCopy() *AutoscalerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerParameters) DeepCopyInto(out *AutoscalerParameters) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	in.AutoscalingPolicy.DeepCopyInto(&out.AutoscalingPolicy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerParameters.
+func (in *AutoscalerParameters) DeepCopy() *AutoscalerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerSpec) DeepCopyInto(out *AutoscalerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerSpec.
+func (in *AutoscalerSpec) DeepCopy() *AutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerStatus) DeepCopyInto(out *AutoscalerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerStatus.
+func (in *AutoscalerStatus) DeepCopy() *AutoscalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalerStatusDetails) DeepCopyInto(out *AutoscalerStatusDetails) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalerStatusDetails.
+func (in *AutoscalerStatusDetails) DeepCopy() *AutoscalerStatusDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalerStatusDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicy) DeepCopyInto(out *AutoscalingPolicy) {
+	*out = *in
+	if in.MinNumReplicas != nil {
+		in, out := &in.MinNumReplicas, &out.MinNumReplicas
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CoolDownPeriodSec != nil {
+		in, out := &in.CoolDownPeriodSec, &out.CoolDownPeriodSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUUtilization != nil {
+		in, out := &in.CPUUtilization, &out.CPUUtilization
+		*out = new(AutoscalingPolicyUtilization)
+		**out = **in
+	}
+	if in.LoadBalancingUtilization != nil {
+		in, out := &in.LoadBalancingUtilization, &out.LoadBalancingUtilization
+		*out = new(AutoscalingPolicyUtilization)
+		**out = **in
+	}
+	if in.CustomMetricUtilizations != nil {
+		in, out := &in.CustomMetricUtilizations, &out.CustomMetricUtilizations
+		*out = make([]AutoscalingPolicyCustomMetricUtilization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicy.
+func (in *AutoscalingPolicy) DeepCopy() *AutoscalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyCustomMetricUtilization) DeepCopyInto(out *AutoscalingPolicyCustomMetricUtilization) {
+	*out = *in
+	if in.UtilizationTargetType != nil {
+		in, out := &in.UtilizationTargetType, &out.UtilizationTargetType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyCustomMetricUtilization.
+func (in *AutoscalingPolicyCustomMetricUtilization) DeepCopy() *AutoscalingPolicyCustomMetricUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyCustomMetricUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingPolicyUtilization) DeepCopyInto(out *AutoscalingPolicyUtilization) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingPolicyUtilization.
+func (in *AutoscalingPolicyUtilization) DeepCopy() *AutoscalingPolicyUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingPolicyUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DistributionPolicy) DeepCopyInto(out *DistributionPolicy) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionPolicy.
+func (in *DistributionPolicy) DeepCopy() *DistributionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DistributionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Firewall) DeepCopyInto(out *Firewall) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Firewall.
+func (in *Firewall) DeepCopy() *Firewall {
+	if in == nil {
+		return nil
+	}
+	out := new(Firewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Firewall) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallList) DeepCopyInto(out *FirewallList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Firewall, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallList.
+func (in *FirewallList) DeepCopy() *FirewallList {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FirewallList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallObservation) DeepCopyInto(out *FirewallObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallObservation.
+func (in *FirewallObservation) DeepCopy() *FirewallObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallParameters) DeepCopyInto(out *FirewallParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	if in.Allowed != nil {
+		in, out := &in.Allowed, &out.Allowed
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Denied != nil {
+		in, out := &in.Denied, &out.Denied
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceRanges != nil {
+		in, out := &in.SourceRanges, &out.SourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationRanges != nil {
+		in, out := &in.DestinationRanges, &out.DestinationRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceTags != nil {
+		in, out := &in.SourceTags, &out.SourceTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetTags != nil {
+		in, out := &in.TargetTags, &out.TargetTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallParameters.
+func (in *FirewallParameters) DeepCopy() *FirewallParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
+func (in *FirewallRule) DeepCopy() *FirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallSpec) DeepCopyInto(out *FirewallSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallSpec.
+func (in *FirewallSpec) DeepCopy() *FirewallSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallStatus) DeepCopyInto(out *FirewallStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallStatus.
+func (in *FirewallStatus) DeepCopy() *FirewallStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedOrPercent) DeepCopyInto(out *FixedOrPercent) {
+	*out = *in
+	if in.Fixed != nil {
+		in, out := &in.Fixed, &out.Fixed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedOrPercent.
+func (in *FixedOrPercent) DeepCopy() *FixedOrPercent {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedOrPercent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachment) DeepCopyInto(out *InterconnectAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachment.
+func (in *InterconnectAttachment) DeepCopy() *InterconnectAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InterconnectAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentList) DeepCopyInto(out *InterconnectAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InterconnectAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentList.
+func (in *InterconnectAttachmentList) DeepCopy() *InterconnectAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InterconnectAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentObservation) DeepCopyInto(out *InterconnectAttachmentObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentObservation.
+func (in *InterconnectAttachmentObservation) DeepCopy() *InterconnectAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentParameters) DeepCopyInto(out *InterconnectAttachmentParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interconnect != nil {
+		in, out := &in.Interconnect, &out.Interconnect
+		*out = new(string)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(string)
+		**out = **in
+	}
+	if in.VlanTag8021q != nil {
+		in, out := &in.VlanTag8021q, &out.VlanTag8021q
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CandidateSubnets != nil {
+		in, out := &in.CandidateSubnets, &out.CandidateSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EdgeAvailabilityDomain != nil {
+		in, out := &in.EdgeAvailabilityDomain, &out.EdgeAvailabilityDomain
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdminEnabled != nil {
+		in, out := &in.AdminEnabled, &out.AdminEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentParameters.
+func (in *InterconnectAttachmentParameters) DeepCopy() *InterconnectAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentSpec) DeepCopyInto(out *InterconnectAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentSpec.
+func (in *InterconnectAttachmentSpec) DeepCopy() *InterconnectAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterconnectAttachmentStatus) DeepCopyInto(out *InterconnectAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterconnectAttachmentStatus.
+func (in *InterconnectAttachmentStatus) DeepCopy() *InterconnectAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InterconnectAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamedPort) DeepCopyInto(out *NamedPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamedPort.
+func (in *NamedPort) DeepCopy() *NamedPort {
+	if in == nil {
+		return nil
+	}
+	out := new(NamedPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpoint) DeepCopyInto(out *NetworkEndpoint) {
+	*out = *in
+	if in.Fqdn != nil {
+		in, out := &in.Fqdn, &out.Fqdn
+		*out = new(string)
+		**out = **in
+	}
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpoint.
+func (in *NetworkEndpoint) DeepCopy() *NetworkEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroup) DeepCopyInto(out *NetworkEndpointGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroup.
+func (in *NetworkEndpointGroup) DeepCopy() *NetworkEndpointGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkEndpointGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupList) DeepCopyInto(out *NetworkEndpointGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkEndpointGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupList.
+func (in *NetworkEndpointGroupList) DeepCopy() *NetworkEndpointGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkEndpointGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupObservation) DeepCopyInto(out *NetworkEndpointGroupObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupObservation.
+func (in *NetworkEndpointGroupObservation) DeepCopy() *NetworkEndpointGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupParameters) DeepCopyInto(out *NetworkEndpointGroupParameters) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultPort != nil {
+		in, out := &in.DefaultPort, &out.DefaultPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnetwork != nil {
+		in, out := &in.Subnetwork, &out.Subnetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetworkRef != nil {
+		in, out := &in.SubnetworkRef, &out.SubnetworkRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.SubnetworkSelector != nil {
+		in, out := &in.SubnetworkSelector, &out.SubnetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkEndpoints != nil {
+		in, out := &in.NetworkEndpoints, &out.NetworkEndpoints
+		*out = make([]NetworkEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupParameters.
+func (in *NetworkEndpointGroupParameters) DeepCopy() *NetworkEndpointGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupSpec) DeepCopyInto(out *NetworkEndpointGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupSpec.
+func (in *NetworkEndpointGroupSpec) DeepCopy() *NetworkEndpointGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEndpointGroupStatus) DeepCopyInto(out *NetworkEndpointGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEndpointGroupStatus.
+func (in *NetworkEndpointGroupStatus) DeepCopy() *NetworkEndpointGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEndpointGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroup.
+func (in *NodeGroup) DeepCopy() *NodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupAutoscalingPolicy) DeepCopyInto(out *NodeGroupAutoscalingPolicy) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinNodes != nil {
+		in, out := &in.MinNodes, &out.MinNodes
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupAutoscalingPolicy.
+func (in *NodeGroupAutoscalingPolicy) DeepCopy() *NodeGroupAutoscalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupAutoscalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupList) DeepCopyInto(out *NodeGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupList.
+func (in *NodeGroupList) DeepCopy() *NodeGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupObservation) DeepCopyInto(out *NodeGroupObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupObservation.
+func (in *NodeGroupObservation) DeepCopy() *NodeGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupParameters) DeepCopyInto(out *NodeGroupParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeTemplate != nil {
+		in, out := &in.NodeTemplate, &out.NodeTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeTemplateRef != nil {
+		in, out := &in.NodeTemplateRef, &out.NodeTemplateRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.NodeTemplateSelector != nil {
+		in, out := &in.NodeTemplateSelector, &out.NodeTemplateSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoscalingPolicy != nil {
+		in, out := &in.AutoscalingPolicy, &out.AutoscalingPolicy
+		*out = new(NodeGroupAutoscalingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenancePolicy != nil {
+		in, out := &in.MaintenancePolicy, &out.MaintenancePolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupParameters.
+func (in *NodeGroupParameters) DeepCopy() *NodeGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupSpec) DeepCopyInto(out *NodeGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupSpec.
+func (in *NodeGroupSpec) DeepCopy() *NodeGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupStatus) DeepCopyInto(out *NodeGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupStatus.
+func (in *NodeGroupStatus) DeepCopy() *NodeGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTemplate) DeepCopyInto(out *NodeTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplate.
+func (in *NodeTemplate) DeepCopy() *NodeTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTemplateList) DeepCopyInto(out *NodeTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateList.
+func (in *NodeTemplateList) DeepCopy() *NodeTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTemplateNodeTypeFlexibility) DeepCopyInto(out *NodeTemplateNodeTypeFlexibility) {
+	*out = *in
+	if in.Cpus != nil {
+		in, out := &in.Cpus, &out.Cpus
+		*out = new(string)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(string)
+		**out = **in
+	}
+	if in.LocalSSD != nil {
+		in, out := &in.LocalSSD, &out.LocalSSD
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateNodeTypeFlexibility.
+func (in *NodeTemplateNodeTypeFlexibility) DeepCopy() *NodeTemplateNodeTypeFlexibility {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTemplateNodeTypeFlexibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTemplateObservation) DeepCopyInto(out *NodeTemplateObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateObservation.
+func (in *NodeTemplateObservation) DeepCopy() *NodeTemplateObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTemplateObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTemplateParameters) DeepCopyInto(out *NodeTemplateParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeType != nil {
+		in, out := &in.NodeType, &out.NodeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.NodeTypeFlexibility != nil {
+		in, out := &in.NodeTypeFlexibility, &out.NodeTypeFlexibility
+		*out = new(NodeTemplateNodeTypeFlexibility)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeAffinityLabels != nil {
+		in, out := &in.NodeAffinityLabels, &out.NodeAffinityLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServerBinding != nil {
+		in, out := &in.ServerBinding, &out.ServerBinding
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUOvercommitType != nil {
+		in, out := &in.CPUOvercommitType, &out.CPUOvercommitType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateParameters.
+func (in *NodeTemplateParameters) DeepCopy() *NodeTemplateParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTemplateParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTemplateSpec) DeepCopyInto(out *NodeTemplateSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateSpec.
+func (in *NodeTemplateSpec) DeepCopy() *NodeTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTemplateStatus) DeepCopyInto(out *NodeTemplateStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTemplateStatus.
+func (in *NodeTemplateStatus) DeepCopy() *NodeTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroring) DeepCopyInto(out *PacketMirroring) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroring.
+func (in *PacketMirroring) DeepCopy() *PacketMirroring {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketMirroring) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringFilter) DeepCopyInto(out *PacketMirroringFilter) {
+	*out = *in
+	if in.IPProtocols != nil {
+		in, out := &in.IPProtocols, &out.IPProtocols
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRRanges != nil {
+		in, out := &in.CIDRRanges, &out.CIDRRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringFilter.
+func (in *PacketMirroringFilter) DeepCopy() *PacketMirroringFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringList) DeepCopyInto(out *PacketMirroringList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PacketMirroring, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringList.
+func (in *PacketMirroringList) DeepCopy() *PacketMirroringList {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketMirroringList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringMirroredResources) DeepCopyInto(out *PacketMirroringMirroredResources) {
+	*out = *in
+	if in.Subnetworks != nil {
+		in, out := &in.Subnetworks, &out.Subnetworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetworkRefs != nil {
+		in, out := &in.SubnetworkRefs, &out.SubnetworkRefs
+		*out = make([]corev1alpha1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetworkSelector != nil {
+		in, out := &in.SubnetworkSelector, &out.SubnetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringMirroredResources.
+func (in *PacketMirroringMirroredResources) DeepCopy() *PacketMirroringMirroredResources {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringMirroredResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringObservation) DeepCopyInto(out *PacketMirroringObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringObservation.
+func (in *PacketMirroringObservation) DeepCopy() *PacketMirroringObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringParameters) DeepCopyInto(out *PacketMirroringParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.MirroredResources.DeepCopyInto(&out.MirroredResources)
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(PacketMirroringFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringParameters.
+func (in *PacketMirroringParameters) DeepCopy() *PacketMirroringParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringSpec) DeepCopyInto(out *PacketMirroringSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringSpec.
+func (in *PacketMirroringSpec) DeepCopy() *PacketMirroringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringStatus) DeepCopyInto(out *PacketMirroringStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringStatus.
+func (in *PacketMirroringStatus) DeepCopy() *PacketMirroringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMetadata) DeepCopyInto(out *ProjectMetadata) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMetadata.
+func (in *ProjectMetadata) DeepCopy() *ProjectMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectMetadata) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMetadataList) DeepCopyInto(out *ProjectMetadataList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectMetadata, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMetadataList.
+func (in *ProjectMetadataList) DeepCopy() *ProjectMetadataList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMetadataList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectMetadataList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMetadataObservation) DeepCopyInto(out *ProjectMetadataObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMetadataObservation.
+func (in *ProjectMetadataObservation) DeepCopy() *ProjectMetadataObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMetadataObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMetadataParameters) DeepCopyInto(out *ProjectMetadataParameters) {
+	*out = *in
+	if in.EnableOSLogin != nil {
+		in, out := &in.EnableOSLogin, &out.EnableOSLogin
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockProjectSSHKeys != nil {
+		in, out := &in.BlockProjectSSHKeys, &out.BlockProjectSSHKeys
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMetadataParameters.
+func (in *ProjectMetadataParameters) DeepCopy() *ProjectMetadataParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMetadataParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMetadataSpec) DeepCopyInto(out *ProjectMetadataSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMetadataSpec.
+func (in *ProjectMetadataSpec) DeepCopy() *ProjectMetadataSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMetadataSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMetadataStatus) DeepCopyInto(out *ProjectMetadataStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMetadataStatus.
+func (in *ProjectMetadataStatus) DeepCopy() *ProjectMetadataStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMetadataStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionDisk) DeepCopyInto(out *RegionDisk) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionDisk.
+func (in *RegionDisk) DeepCopy() *RegionDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegionDisk) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionDiskList) DeepCopyInto(out *RegionDiskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RegionDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionDiskList.
+func (in *RegionDiskList) DeepCopy() *RegionDiskList {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionDiskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegionDiskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionDiskObservation) DeepCopyInto(out *RegionDiskObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionDiskObservation.
+func (in *RegionDiskObservation) DeepCopy() *RegionDiskObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionDiskObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionDiskParameters) DeepCopyInto(out *RegionDiskParameters) {
+	*out = *in
+	if in.ReplicaZones != nil {
+		in, out := &in.ReplicaZones, &out.ReplicaZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.SizeGb != nil {
+		in, out := &in.SizeGb, &out.SizeGb
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyName != nil {
+		in, out := &in.KMSKeyName, &out.KMSKeyName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionDiskParameters.
+func (in *RegionDiskParameters) DeepCopy() *RegionDiskParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionDiskParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionDiskSpec) DeepCopyInto(out *RegionDiskSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionDiskSpec.
+func (in *RegionDiskSpec) DeepCopy() *RegionDiskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionDiskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionDiskStatus) DeepCopyInto(out *RegionDiskStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionDiskStatus.
+func (in *RegionDiskStatus) DeepCopy() *RegionDiskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionDiskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionInstanceGroupManager) DeepCopyInto(out *RegionInstanceGroupManager) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionInstanceGroupManager.
+func (in *RegionInstanceGroupManager) DeepCopy() *RegionInstanceGroupManager {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionInstanceGroupManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegionInstanceGroupManager) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionInstanceGroupManagerList) DeepCopyInto(out *RegionInstanceGroupManagerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RegionInstanceGroupManager, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionInstanceGroupManagerList.
+func (in *RegionInstanceGroupManagerList) DeepCopy() *RegionInstanceGroupManagerList {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionInstanceGroupManagerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegionInstanceGroupManagerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionInstanceGroupManagerObservation) DeepCopyInto(out *RegionInstanceGroupManagerObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionInstanceGroupManagerObservation.
+func (in *RegionInstanceGroupManagerObservation) DeepCopy() *RegionInstanceGroupManagerObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionInstanceGroupManagerObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionInstanceGroupManagerParameters) DeepCopyInto(out *RegionInstanceGroupManagerParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetSize != nil {
+		in, out := &in.TargetSize, &out.TargetSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetPools != nil {
+		in, out := &in.TargetPools, &out.TargetPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamedPorts != nil {
+		in, out := &in.NamedPorts, &out.NamedPorts
+		*out = make([]NamedPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoHealingPolicies != nil {
+		in, out := &in.AutoHealingPolicies, &out.AutoHealingPolicies
+		*out = make([]AutoHealingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DistributionPolicy != nil {
+		in, out := &in.DistributionPolicy, &out.DistributionPolicy
+		*out = new(DistributionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdatePolicy != nil {
+		in, out := &in.UpdatePolicy, &out.UpdatePolicy
+		*out = new(UpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionInstanceGroupManagerParameters.
+func (in *RegionInstanceGroupManagerParameters) DeepCopy() *RegionInstanceGroupManagerParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionInstanceGroupManagerParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionInstanceGroupManagerSpec) DeepCopyInto(out *RegionInstanceGroupManagerSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionInstanceGroupManagerSpec.
+func (in *RegionInstanceGroupManagerSpec) DeepCopy() *RegionInstanceGroupManagerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionInstanceGroupManagerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionInstanceGroupManagerStatus) DeepCopyInto(out *RegionInstanceGroupManagerStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionInstanceGroupManagerStatus.
+func (in *RegionInstanceGroupManagerStatus) DeepCopy() *RegionInstanceGroupManagerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionInstanceGroupManagerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicy) DeepCopyInto(out *ResourcePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicy.
+func (in *ResourcePolicy) DeepCopy() *ResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyDailyCycle) DeepCopyInto(out *ResourcePolicyDailyCycle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyDailyCycle.
+func (in *ResourcePolicyDailyCycle) DeepCopy() *ResourcePolicyDailyCycle {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyDailyCycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyHourlyCycle) DeepCopyInto(out *ResourcePolicyHourlyCycle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyHourlyCycle.
+func (in *ResourcePolicyHourlyCycle) DeepCopy() *ResourcePolicyHourlyCycle {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyHourlyCycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyInstanceSchedule) DeepCopyInto(out *ResourcePolicyInstanceSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyInstanceSchedule.
+func (in *ResourcePolicyInstanceSchedule) DeepCopy() *ResourcePolicyInstanceSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyInstanceSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyInstanceSchedulePolicy) DeepCopyInto(out *ResourcePolicyInstanceSchedulePolicy) {
+	*out = *in
+	if in.VMStartSchedule != nil {
+		in, out := &in.VMStartSchedule, &out.VMStartSchedule
+		*out = new(ResourcePolicyInstanceSchedule)
+		**out = **in
+	}
+	if in.VMStopSchedule != nil {
+		in, out := &in.VMStopSchedule, &out.VMStopSchedule
+		*out = new(ResourcePolicyInstanceSchedule)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyInstanceSchedulePolicy.
+func (in *ResourcePolicyInstanceSchedulePolicy) DeepCopy() *ResourcePolicyInstanceSchedulePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyInstanceSchedulePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyList) DeepCopyInto(out *ResourcePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourcePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyList.
+func (in *ResourcePolicyList) DeepCopy() *ResourcePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyObservation) DeepCopyInto(out *ResourcePolicyObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyObservation.
+func (in *ResourcePolicyObservation) DeepCopy() *ResourcePolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyParameters) DeepCopyInto(out *ResourcePolicyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceSchedulePolicy != nil {
+		in, out := &in.InstanceSchedulePolicy, &out.InstanceSchedulePolicy
+		*out = new(ResourcePolicyInstanceSchedulePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotSchedulePolicy != nil {
+		in, out := &in.SnapshotSchedulePolicy, &out.SnapshotSchedulePolicy
+		*out = new(ResourcePolicySnapshotSchedulePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyParameters.
+func (in *ResourcePolicyParameters) DeepCopy() *ResourcePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicySnapshotProperties) DeepCopyInto(out *ResourcePolicySnapshotProperties) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StorageLocations != nil {
+		in, out := &in.StorageLocations, &out.StorageLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GuestFlush != nil {
+		in, out := &in.GuestFlush, &out.GuestFlush
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicySnapshotProperties.
+func (in *ResourcePolicySnapshotProperties) DeepCopy() *ResourcePolicySnapshotProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicySnapshotProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicySnapshotRetentionPolicy) DeepCopyInto(out *ResourcePolicySnapshotRetentionPolicy) {
+	*out = *in
+	if in.OnSourceDiskDelete != nil {
+		in, out := &in.OnSourceDiskDelete, &out.OnSourceDiskDelete
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicySnapshotRetentionPolicy.
+func (in *ResourcePolicySnapshotRetentionPolicy) DeepCopy() *ResourcePolicySnapshotRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicySnapshotRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicySnapshotSchedule) DeepCopyInto(out *ResourcePolicySnapshotSchedule) {
+	*out = *in
+	if in.HourlySchedule != nil {
+		in, out := &in.HourlySchedule, &out.HourlySchedule
+		*out = new(ResourcePolicyHourlyCycle)
+		**out = **in
+	}
+	if in.DailySchedule != nil {
+		in, out := &in.DailySchedule, &out.DailySchedule
+		*out = new(ResourcePolicyDailyCycle)
+		**out = **in
+	}
+	if in.WeeklySchedule != nil {
+		in, out := &in.WeeklySchedule, &out.WeeklySchedule
+		*out = new(ResourcePolicyWeeklyCycle)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicySnapshotSchedule.
+func (in *ResourcePolicySnapshotSchedule) DeepCopy() *ResourcePolicySnapshotSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicySnapshotSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicySnapshotSchedulePolicy) DeepCopyInto(out *ResourcePolicySnapshotSchedulePolicy) {
+	*out = *in
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(ResourcePolicySnapshotRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotProperties != nil {
+		in, out := &in.SnapshotProperties, &out.SnapshotProperties
+		*out = new(ResourcePolicySnapshotProperties)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicySnapshotSchedulePolicy.
+func (in *ResourcePolicySnapshotSchedulePolicy) DeepCopy() *ResourcePolicySnapshotSchedulePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicySnapshotSchedulePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicySpec) DeepCopyInto(out *ResourcePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicySpec.
+func (in *ResourcePolicySpec) DeepCopy() *ResourcePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyStatus) DeepCopyInto(out *ResourcePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyStatus.
+func (in *ResourcePolicyStatus) DeepCopy() *ResourcePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyWeeklyCycle) DeepCopyInto(out *ResourcePolicyWeeklyCycle) {
+	*out = *in
+	if in.DayOfWeeks != nil {
+		in, out := &in.DayOfWeeks, &out.DayOfWeeks
+		*out = make([]ResourcePolicyWeeklyCycleDayOfWeek, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyWeeklyCycle.
+func (in *ResourcePolicyWeeklyCycle) DeepCopy() *ResourcePolicyWeeklyCycle {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyWeeklyCycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicyWeeklyCycleDayOfWeek) DeepCopyInto(out *ResourcePolicyWeeklyCycleDayOfWeek) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicyWeeklyCycleDayOfWeek.
+func (in *ResourcePolicyWeeklyCycleDayOfWeek) DeepCopy() *ResourcePolicyWeeklyCycleDayOfWeek {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicyWeeklyCycleDayOfWeek)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Router) DeepCopyInto(out *Router) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Router.
+func (in *Router) DeepCopy() *Router {
+	if in == nil {
+		return nil
+	}
+	out := new(Router)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Router) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterBgp) DeepCopyInto(out *RouterBgp) {
+	*out = *in
+	if in.Asn != nil {
+		in, out := &in.Asn, &out.Asn
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterBgp.
+func (in *RouterBgp) DeepCopy() *RouterBgp {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterBgp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterList) DeepCopyInto(out *RouterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Router, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterList.
+func (in *RouterList) DeepCopy() *RouterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNat) DeepCopyInto(out *RouterNat) {
+	*out = *in
+	if in.NatIPs != nil {
+		in, out := &in.NatIPs, &out.NatIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnetworks != nil {
+		in, out := &in.Subnetworks, &out.Subnetworks
+		*out = make([]RouterNatSubnetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LogConfig != nil {
+		in, out := &in.LogConfig, &out.LogConfig
+		*out = new(RouterNatLogConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinPortsPerVM != nil {
+		in, out := &in.MinPortsPerVM, &out.MinPortsPerVM
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IcmpIdleTimeoutSec != nil {
+		in, out := &in.IcmpIdleTimeoutSec, &out.IcmpIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TCPEstablishedIdleTimeoutSec != nil {
+		in, out := &in.TCPEstablishedIdleTimeoutSec, &out.TCPEstablishedIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TCPTransitoryIdleTimeoutSec != nil {
+		in, out := &in.TCPTransitoryIdleTimeoutSec, &out.TCPTransitoryIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UDPIdleTimeoutSec != nil {
+		in, out := &in.UDPIdleTimeoutSec, &out.UDPIdleTimeoutSec
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNat.
+func (in *RouterNat) DeepCopy() *RouterNat {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNatLogConfig) DeepCopyInto(out *RouterNatLogConfig) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatLogConfig.
+func (in *RouterNatLogConfig) DeepCopy() *RouterNatLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNatLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterNatSubnetwork) DeepCopyInto(out *RouterNatSubnetwork) {
+	*out = *in
+	if in.Subnetwork != nil {
+		in, out := &in.Subnetwork, &out.Subnetwork
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetworkRef != nil {
+		in, out := &in.SubnetworkRef, &out.SubnetworkRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.SubnetworkSelector != nil {
+		in, out := &in.SubnetworkSelector, &out.SubnetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceIPRangesToNat != nil {
+		in, out := &in.SourceIPRangesToNat, &out.SourceIPRangesToNat
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecondaryIPRangeNames != nil {
+		in, out := &in.SecondaryIPRangeNames, &out.SecondaryIPRangeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterNatSubnetwork.
+func (in *RouterNatSubnetwork) DeepCopy() *RouterNatSubnetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterNatSubnetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterObservation) DeepCopyInto(out *RouterObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterObservation.
+func (in *RouterObservation) DeepCopy() *RouterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterParameters) DeepCopyInto(out *RouterParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bgp != nil {
+		in, out := &in.Bgp, &out.Bgp
+		*out = new(RouterBgp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Nats != nil {
+		in, out := &in.Nats, &out.Nats
+		*out = make([]RouterNat, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterParameters.
+func (in *RouterParameters) DeepCopy() *RouterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterSpec) DeepCopyInto(out *RouterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterSpec.
+func (in *RouterSpec) DeepCopy() *RouterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterStatus) DeepCopyInto(out *RouterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterStatus.
+func (in *RouterStatus) DeepCopy() *RouterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SslPolicy) DeepCopyInto(out *SslPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SslPolicy.
+func (in *SslPolicy) DeepCopy() *SslPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SslPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SslPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SslPolicyList) DeepCopyInto(out *SslPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SslPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SslPolicyList.
+func (in *SslPolicyList) DeepCopy() *SslPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(SslPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SslPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SslPolicyObservation) DeepCopyInto(out *SslPolicyObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EnabledFeatures != nil {
+		in, out := &in.EnabledFeatures, &out.EnabledFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SslPolicyObservation.
+func (in *SslPolicyObservation) DeepCopy() *SslPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SslPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SslPolicyParameters) DeepCopyInto(out *SslPolicyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinTLSVersion != nil {
+		in, out := &in.MinTLSVersion, &out.MinTLSVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomFeatures != nil {
+		in, out := &in.CustomFeatures, &out.CustomFeatures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SslPolicyParameters.
+func (in *SslPolicyParameters) DeepCopy() *SslPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SslPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SslPolicySpec) DeepCopyInto(out *SslPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SslPolicySpec.
+func (in *SslPolicySpec) DeepCopy() *SslPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SslPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SslPolicyStatus) DeepCopyInto(out *SslPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SslPolicyStatus.
+func (in *SslPolicyStatus) DeepCopy() *SslPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SslPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetInstance) DeepCopyInto(out *TargetInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetInstance.
+func (in *TargetInstance) DeepCopy() *TargetInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetInstanceList) DeepCopyInto(out *TargetInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TargetInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetInstanceList.
+func (in *TargetInstanceList) DeepCopy() *TargetInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetInstanceObservation) DeepCopyInto(out *TargetInstanceObservation) {
+	*out = *in
+	if in.CreationTimestamp != nil {
+		in, out := &in.CreationTimestamp, &out.CreationTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetInstanceObservation.
+func (in *TargetInstanceObservation) DeepCopy() *TargetInstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetInstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetInstanceParameters) DeepCopyInto(out *TargetInstanceParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.NatPolicy != nil {
+		in, out := &in.NatPolicy, &out.NatPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetInstanceParameters.
+func (in *TargetInstanceParameters) DeepCopy() *TargetInstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetInstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetInstanceSpec) DeepCopyInto(out *TargetInstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetInstanceSpec.
+func (in *TargetInstanceSpec) DeepCopy() *TargetInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetInstanceStatus) DeepCopyInto(out *TargetInstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetInstanceStatus.
+func (in *TargetInstanceStatus) DeepCopy() *TargetInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdatePolicy) DeepCopyInto(out *UpdatePolicy) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinimalAction != nil {
+		in, out := &in.MinimalAction, &out.MinimalAction
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRedistributionType != nil {
+		in, out := &in.InstanceRedistributionType, &out.InstanceRedistributionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(FixedOrPercent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(FixedOrPercent)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdatePolicy.
+func (in *UpdatePolicy) DeepCopy() *UpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -65,7 +65,7 @@ type GKEClusterParameters struct {
 	// state that the field is output only.
 
 	// Zone specifies the name of the Google Compute Engine zone in which this
-	// cluster resides.
+	// cluster resides. Defaults to the defaultZone of the Provider.
 	// +optional
 	Zone string `json:"zone,omitempty"`
 
@@ -77,6 +77,11 @@ type NetworkObservation struct {
 	// Subnetworks: Server-defined fully-qualified URLs for
 	// all subnetworks in this VPC network.
 	Subnetworks []string `json:"subnetworks,omitempty"`
+
+	// Operation is the name of the global operation that creates the
+	// network, while it is pending. The network is not available until the
+	// operation is done.
+	Operation string `json:"operation,omitempty"`
 }
 
 // A NetworkPeering represents the observed state of a Google Compute Engine
@@ -22,6 +22,9 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	corev1 "k8s.io/api/core/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
@@ -37,6 +40,38 @@ func NetworkURL() reference.ExtractValueFn {
 	}
 }
 
+// ReadyNetworkURL extracts the partially qualified URL of a Network that is
+// ready. Nothing is extracted from a Network that is not, so that resolving
+// a reference to it fails and is retried until the Network is ready.
+func ReadyNetworkURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if mg.GetCondition(runtimev1alpha1.TypeReady).Status != corev1.ConditionTrue {
+			return ""
+		}
+		return NetworkURL()(mg)
+	}
+}
+
+// ResolveNetwork resolves the supplied reference or selector to the partially
+// qualified URL of a ready Network, and sets the supplied network and
+// reference accordingly. It is used by the ResolveReferences methods of all
+// managed resources that reference a Network.
+func ResolveNetwork(ctx context.Context, r *reference.APIResolver, network **string, ref **runtimev1alpha1.Reference, sel *runtimev1alpha1.Selector) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(*network),
+		Reference:    *ref,
+		Selector:     sel,
+		To:           reference.To{Managed: &Network{}, List: &NetworkList{}},
+		Extract:      ReadyNetworkURL(),
+	})
+	if err != nil {
+		return err
+	}
+	*network = reference.ToPtrValue(rsp.ResolvedValue)
+	*ref = rsp.ResolvedReference
+	return nil
+}
+
 // SubnetworkURL extracts the partially qualified URL of a Subnetwork.
 func SubnetworkURL() reference.ExtractValueFn {
 	return func(mg resource.Managed) string {
@@ -53,18 +88,9 @@ func (mg *GlobalAddress) ResolveReferences(ctx context.Context, c client.Reader)
 	r := reference.NewAPIResolver(c, mg)
 
 	// Resolve spec.network
-	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
-		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
-		Reference:    mg.Spec.ForProvider.NetworkRef,
-		Selector:     mg.Spec.ForProvider.NetworkSelector,
-		To:           reference.To{Managed: &Network{}, List: &NetworkList{}},
-		Extract:      NetworkURL(),
-	})
-	if err != nil {
+	if err := ResolveNetwork(ctx, r, &mg.Spec.ForProvider.Network, &mg.Spec.ForProvider.NetworkRef, mg.Spec.ForProvider.NetworkSelector); err != nil {
 		return err
 	}
-	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
-	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
 
 	return nil
 }
@@ -74,18 +100,9 @@ func (mg *Subnetwork) ResolveReferences(ctx context.Context, c client.Reader) er
 	r := reference.NewAPIResolver(c, mg)
 
 	// Resolve spec.network
-	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
-		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
-		Reference:    mg.Spec.ForProvider.NetworkRef,
-		Selector:     mg.Spec.ForProvider.NetworkSelector,
-		To:           reference.To{Managed: &Network{}, List: &NetworkList{}},
-		Extract:      NetworkURL(),
-	})
-	if err != nil {
+	if err := ResolveNetwork(ctx, r, &mg.Spec.ForProvider.Network, &mg.Spec.ForProvider.NetworkRef, mg.Spec.ForProvider.NetworkSelector); err != nil {
 		return err
 	}
-	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
-	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
 
 	return nil
 }
@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestResolveNetwork(t *testing.T) {
+	selfLink := ComputeURIPrefix + "projects/my-project/global/networks/my-network"
+	network := func(c ...runtimev1alpha1.Condition) *Network {
+		n := &Network{ObjectMeta: metav1.ObjectMeta{Name: "my-network"}}
+		n.Status.AtProvider.SelfLink = selfLink
+		n.SetConditions(c...)
+		return n
+	}
+
+	type want struct {
+		network *string
+		ref     *runtimev1alpha1.Reference
+		err     bool
+	}
+
+	cases := map[string]struct {
+		network *Network
+		want    want
+	}{
+		"Ready": {
+			network: network(runtimev1alpha1.Available()),
+			want: want{
+				network: reference.ToPtrValue("projects/my-project/global/networks/my-network"),
+				ref:     &runtimev1alpha1.Reference{Name: "my-network"},
+			},
+		},
+		"NotReady": {
+			network: network(runtimev1alpha1.Creating()),
+			want: want{
+				ref: &runtimev1alpha1.Reference{Name: "my-network"},
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				tc.network.DeepCopyInto(obj.(*Network))
+				return nil
+			}}
+			from := &GlobalAddress{}
+			var n *string
+			ref := &runtimev1alpha1.Reference{Name: "my-network"}
+			err := ResolveNetwork(context.Background(), reference.NewAPIResolver(c, from), &n, &ref, nil)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("ResolveNetwork(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.network, n); diff != "" {
+				t.Errorf("ResolveNetwork(...): -want network, +got network:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ref, ref); diff != "" {
+				t.Errorf("ResolveNetwork(...): -want reference, +got reference:\n%s", diff)
+			}
+		})
+	}
+}
@@ -29,9 +29,9 @@ type SubnetworkParameters struct {
 	// IPCIDRRange: The range of internal addresses that are owned by this
 	// subnetwork. Provide this property when you create the subnetwork. For
 	// example, 10.0.0.0/8 or 192.168.0.0/16. Ranges must be unique and
-	// non-overlapping within a network. Only IPv4 is supported. This field
-	// can be set only at resource creation time.
-	// +immutable
+	// non-overlapping within a network. Only IPv4 is supported. The range
+	// can be expanded after creation, to a range that contains it, but it
+	// cannot be shrunk or moved.
 	IPCidrRange string `json:"ipCidrRange"`
 
 	// Network: The URL of the network to which this subnetwork belongs,
@@ -81,8 +81,9 @@ type SubnetworkParameters struct {
 	// SecondaryIPRanges: An array of configurations for secondary IP ranges
 	// for VM instances contained in this subnetwork. The primary IP of such
 	// VM must belong to the primary ipCidrRange of the subnetwork. The
-	// alias IPs may belong to either primary or secondary ranges. This
-	// field can be updated with a patch request.
+	// alias IPs may belong to either primary or secondary ranges. Secondary
+	// ranges can be added after creation, but existing ranges cannot be
+	// changed or removed.
 	// +optional
 	SecondaryIPRanges []*SubnetworkSecondaryRange `json:"secondaryIpRanges,omitempty"`
 }
@@ -114,6 +115,10 @@ type SubnetworkObservation struct {
 
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
+
+	// Operation is the name of the region operation that creates the
+	// subnetwork or expands its IP CIDR range, while it is pending.
+	Operation string `json:"operation,omitempty"`
 }
 
 // A SubnetworkSecondaryRange defines the state of a Google Compute Engine
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package connection defines the keys of the connection details that GCP
+// managed resources write to their connection secrets. Resources that publish
+// the same kind of detail use the same key, so that compositions and tooling
+// such as kubeconfig generators can consume the secrets of any resource.
+//
+// Values are written as is; Kubernetes base64 encodes Secret data, so values
+// are never base64 encoded by the provider. Certificates are PEM encoded.
+package connection
+
+import (
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Keys that are shared with other Crossplane providers.
+const (
+	// KeyEndpoint is the address at which the resource is reached; a host
+	// name, an IP address or a URL.
+	KeyEndpoint = runtimev1alpha1.ResourceCredentialsSecretEndpointKey
+
+	// KeyPort is the port at which the resource is reached, in decimal.
+	KeyPort = runtimev1alpha1.ResourceCredentialsSecretPortKey
+
+	// KeyUsername is the user to authenticate as.
+	KeyUsername = runtimev1alpha1.ResourceCredentialsSecretUserKey
+
+	// KeyPassword is the password of the user to authenticate as.
+	KeyPassword = runtimev1alpha1.ResourceCredentialsSecretPasswordKey
+
+	// KeyToken is a credential to authenticate with, for example a service
+	// account key.
+	KeyToken = runtimev1alpha1.ResourceCredentialsSecretTokenKey
+
+	// KeyClientCertificate is the PEM encoded certificate to authenticate
+	// with.
+	KeyClientCertificate = runtimev1alpha1.ResourceCredentialsSecretClientCertKey
+
+	// KeyClientKey is the PEM encoded private key of KeyClientCertificate.
+	KeyClientKey = runtimev1alpha1.ResourceCredentialsSecretClientKeyKey
+
+	// KeyKubeconfig is a kubeconfig file that connects to a Kubernetes
+	// cluster.
+	KeyKubeconfig = runtimev1alpha1.ResourceCredentialsSecretKubeconfigKey
+
+	// KeyClusterCA is the PEM encoded CA certificate of a Kubernetes cluster.
+	//
+	// Deprecated: Use KeyClusterCACertificate. KeyClusterCA is still written
+	// because Crossplane reads it to connect to clusters.
+	KeyClusterCA = runtimev1alpha1.ResourceCredentialsSecretCAKey
+)
+
+// Keys that are specific to GCP resources.
+const (
+	// KeyClusterCACertificate is the PEM encoded CA certificate of a
+	// Kubernetes cluster's API server.
+	KeyClusterCACertificate = "clusterCACertificate"
+
+	// KeyServerCACertificate is the PEM encoded CA certificate of a server,
+	// for example a database instance.
+	KeyServerCACertificate = "serverCACertificate"
+
+	// KeyEmail is the email address of a service account.
+	KeyEmail = "email"
+
+	// KeyUniqueID is the unique, numeric ID of a service account.
+	KeyUniqueID = "uniqueId"
+
+	// KeyCredentials is the JSON key file of a service account, as accepted
+	// by the credentials secret of a Provider.
+	KeyCredentials = "credentials.json"
+
+	// KeyPKCS12 is the PKCS #12 file of a service account key.
+	KeyPKCS12 = "key.p12"
+
+	// KeyPKCS12Passphrase is the passphrase of KeyPKCS12.
+	KeyPKCS12Passphrase = "pkcs12Passphrase"
+
+	// KeySelfLink is the fully qualified URL of a Compute Engine resource,
+	// for example a network, as referenced by other Compute Engine
+	// resources.
+	KeySelfLink = "selfLink"
+
+	// KeyCryptoKeyName is the resource name of a Cloud KMS crypto key, as
+	// accepted by the kmsKeyName fields of other GCP APIs.
+	KeyCryptoKeyName = "cryptoKeyName"
+
+	// KeyBucketName is the name of a storage bucket.
+	KeyBucketName = "bucket-name"
+
+	// KeyGSURI is the gs:// URI of a storage bucket, as used by gsutil and
+	// the Cloud Storage client libraries.
+	KeyGSURI = "gs-uri"
+
+	// KeyHTTPSEndpoint is the URL at which a resource is reached over
+	// HTTPS, for example a storage bucket via the Cloud Storage XML API.
+	KeyHTTPSEndpoint = "https-endpoint"
+
+	// KeyNameServers is the comma separated list of the name servers of a
+	// Cloud DNS managed zone.
+	KeyNameServers = "nameServers"
+)
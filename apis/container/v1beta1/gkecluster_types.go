@@ -457,6 +457,12 @@ type GKEClusterObservation struct {
 	// resides.
 	// This field is deprecated, use location instead.
 	Zone string `json:"zone,omitempty"`
+
+	// Operation is the name of the operation that creates or updates the
+	// cluster, while it is pending. The cluster is not reported available
+	// until the operation that creates it is done, and is not updated again
+	// until the pending operation is done.
+	Operation string `json:"operation,omitempty"`
 }
 
 // AddonsConfig is configuration for the addons that can be automatically
@@ -44,21 +44,12 @@ func (mg *GKECluster) ResolveReferences(ctx context.Context, c client.Reader) er
 	r := reference.NewAPIResolver(c, mg)
 
 	// Resolve spec.forProvider.network
-	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
-		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
-		Reference:    mg.Spec.ForProvider.NetworkRef,
-		Selector:     mg.Spec.ForProvider.NetworkSelector,
-		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
-		Extract:      v1beta1.NetworkURL(),
-	})
-	if err != nil {
+	if err := v1beta1.ResolveNetwork(ctx, r, &mg.Spec.ForProvider.Network, &mg.Spec.ForProvider.NetworkRef, mg.Spec.ForProvider.NetworkSelector); err != nil {
 		return err
 	}
-	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
-	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
 
 	// Resolve spec.forProvider.subnetwork
-	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Subnetwork),
 		Reference:    mg.Spec.ForProvider.SubnetworkRef,
 		Selector:     mg.Spec.ForProvider.SubnetworkSelector,
@@ -47,9 +47,11 @@ const (
 const (
 	MysqlDBVersionPrefix = "MYSQL"
 	MysqlDefaultUser     = "root"
+	MysqlDefaultPort     = "3306"
 
 	PostgresqlDBVersionPrefix = "POSTGRES"
 	PostgresqlDefaultUser     = "postgres"
+	PostgresqlDefaultPort     = "5432"
 
 	PrivateIPType = "PRIVATE"
 	PublicIPType  = "PRIMARY"
@@ -357,7 +359,8 @@ type IPConfiguration struct {
 	PrivateNetwork *string `json:"privateNetwork,omitempty"`
 
 	// PrivateNetworkRef sets the PrivateNetwork field by resolving the resource
-	// link of the referenced Crossplane Network managed resource.
+	// link of the referenced Crossplane Network managed resource once it is
+	// ready.
 	// +optional
 	PrivateNetworkRef *runtimev1alpha1.Reference `json:"privateNetworkRef,omitempty"`
 
@@ -35,19 +35,7 @@ func (mg *CloudSQLInstance) ResolveReferences(ctx context.Context, c client.Read
 
 	r := reference.NewAPIResolver(c, mg)
 
-	// Resolve spec.network
-	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
-		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Settings.IPConfiguration.PrivateNetwork),
-		Reference:    mg.Spec.ForProvider.Settings.IPConfiguration.PrivateNetworkRef,
-		Selector:     mg.Spec.ForProvider.Settings.IPConfiguration.PrivateNetworkSelector,
-		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
-		Extract:      v1beta1.NetworkURL(),
-	})
-	if err != nil {
-		return err
-	}
-	mg.Spec.ForProvider.Settings.IPConfiguration.PrivateNetwork = reference.ToPtrValue(rsp.ResolvedValue)
-	mg.Spec.ForProvider.Settings.IPConfiguration.PrivateNetworkRef = rsp.ResolvedReference
-
-	return nil
+	// Resolve spec.forProvider.settings.ipConfiguration.privateNetwork
+	ipc := mg.Spec.ForProvider.Settings.IPConfiguration
+	return v1beta1.ResolveNetwork(ctx, r, &ipc.PrivateNetwork, &ipc.PrivateNetworkRef, ipc.PrivateNetworkSelector)
 }
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Job states as reported by the Dataflow API.
+const (
+	JobStateUnknown    = "JOB_STATE_UNKNOWN"
+	JobStateStopped    = "JOB_STATE_STOPPED"
+	JobStateRunning    = "JOB_STATE_RUNNING"
+	JobStateDone       = "JOB_STATE_DONE"
+	JobStateFailed     = "JOB_STATE_FAILED"
+	JobStateCancelled  = "JOB_STATE_CANCELLED"
+	JobStateUpdated    = "JOB_STATE_UPDATED"
+	JobStateDraining   = "JOB_STATE_DRAINING"
+	JobStateDrained    = "JOB_STATE_DRAINED"
+	JobStatePending    = "JOB_STATE_PENDING"
+	JobStateCancelling = "JOB_STATE_CANCELLING"
+	JobStateQueued     = "JOB_STATE_QUEUED"
+)
+
+// Job types as reported by the Dataflow API.
+const (
+	JobTypeBatch     = "JOB_TYPE_BATCH"
+	JobTypeStreaming = "JOB_TYPE_STREAMING"
+)
+
+// JobParameters define the desired state of a Dataflow Job that is launched
+// from a flex template. The name of the job is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/dataflow/docs/reference/rest/v1b3/projects.locations.flexTemplates/launch
+type JobParameters struct {
+	// Location is the regional endpoint to which the job is launched, e.g.
+	// us-central1. Defaults to the defaultRegion of the Provider.
+	// +optional
+	// +immutable
+	Location string `json:"location,omitempty"`
+
+	// ContainerSpecGCSPath is the Cloud Storage path to the flex template
+	// spec file, e.g. gs://bucket/templates/pipeline.json.
+	ContainerSpecGCSPath string `json:"containerSpecGcsPath"`
+
+	// Parameters are the template parameters the job is launched with.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// Environment configures the workers that run the job.
+	// +optional
+	Environment *FlexTemplateEnvironment `json:"environment,omitempty"`
+
+	// DrainOnDelete causes a streaming job to be drained rather than
+	// cancelled when this resource is deleted, so that in-flight data is
+	// processed before the job stops. Batch jobs are always cancelled.
+	// +optional
+	DrainOnDelete *bool `json:"drainOnDelete,omitempty"`
+}
+
+// FlexTemplateEnvironment configures the workers of a Dataflow Job. These
+// settings are passed to the template as the equivalent Dataflow pipeline
+// options; a value in Parameters with the same name takes precedence.
+type FlexTemplateEnvironment struct {
+	// MachineType is the Compute Engine machine type to use for workers,
+	// e.g. n1-standard-2. Passed as the workerMachineType pipeline option.
+	// +optional
+	MachineType *string `json:"machineType,omitempty"`
+
+	// MaxWorkers is the maximum number of workers the job may scale to.
+	// Passed as the maxNumWorkers pipeline option.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxWorkers *int64 `json:"maxWorkers,omitempty"`
+
+	// Network is the Compute Engine network for the workers. Passed as the
+	// network pipeline option.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// Subnetwork is the Compute Engine subnetwork for the workers, e.g.
+	// regions/us-central1/subnetworks/default. Passed as the subnetwork
+	// pipeline option.
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// ServiceAccountEmail is the email of the service account the workers
+	// run as. Passed as the serviceAccount pipeline option.
+	// +optional
+	ServiceAccountEmail *string `json:"serviceAccountEmail,omitempty"`
+}
+
+// JobObservation is used to show the observed state of the Job resource on
+// GCP.
+type JobObservation struct {
+	// JobID is the unique ID of the running job. It changes when a streaming
+	// job is replaced by an update.
+	JobID string `json:"jobId,omitempty"`
+
+	// Type of the job, either JOB_TYPE_BATCH or JOB_TYPE_STREAMING.
+	Type string `json:"type,omitempty"`
+
+	// State is the current state of the job, e.g. JOB_STATE_RUNNING.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the job was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// CurrentStateTime is the time the job entered its current state.
+	CurrentStateTime *metav1.Time `json:"currentStateTime,omitempty"`
+
+	// LaunchedSpecHash is a hash of the template path, parameters and
+	// environment the current job was launched with. It is used to detect
+	// changes that require a streaming job to be updated.
+	LaunchedSpecHash string `json:"launchedSpecHash,omitempty"`
+}
+
+// A JobSpec defines the desired state of a Job.
+type JobSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  JobParameters `json:"forProvider"`
+}
+
+// A JobStatus represents the observed state of a Job.
+type JobStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     JobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Job is a managed resource that represents a Google Dataflow Job launched
+// from a flex template. Changes to a streaming job cause it to be updated in
+// place; batch jobs run once and are not relaunched.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="JOB-ID",type="string",JSONPath=".status.atProvider.jobId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Job struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobSpec   `json:"spec"`
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobList contains a list of Job.
+type JobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Job `json:"items"`
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Visibilities of a managed zone.
+const (
+	VisibilityPublic  = "public"
+	VisibilityPrivate = "private"
+)
+
+// ManagedZoneParameters define the desired state of a Cloud DNS managed zone.
+// The name of the managed zone is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/dns/docs/reference/v1/managedZones
+type ManagedZoneParameters struct {
+	// DNSName is the DNS name of the zone, e.g. example.com. A trailing dot
+	// may be omitted.
+	// +immutable
+	DNSName string `json:"dnsName"`
+
+	// Description of the zone, for the user's convenience.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Visibility of the zone. Public zones are served on the internet, while
+	// private zones are only served to the networks in
+	// PrivateVisibilityConfig. Defaults to public.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=public;private
+	Visibility *string `json:"visibility,omitempty"`
+
+	// PrivateVisibilityConfig lists the networks a private zone is served
+	// to.
+	// +optional
+	PrivateVisibilityConfig *ManagedZonePrivateVisibilityConfig `json:"privateVisibilityConfig,omitempty"`
+
+	// Labels to apply to the zone.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// ManagedZonePrivateVisibilityConfig lists the networks a private zone is
+// served to.
+type ManagedZonePrivateVisibilityConfig struct {
+	// Networks the zone is served to.
+	Networks []ManagedZoneNetwork `json:"networks"`
+}
+
+// A ManagedZoneNetwork is a network a private zone is served to.
+type ManagedZoneNetwork struct {
+	// NetworkURL is the URL of the network, e.g.
+	// projects/my-project/global/networks/my-network.
+	// +optional
+	NetworkURL *string `json:"networkUrl,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URI
+	// +optional
+	NetworkRef *runtimev1alpha1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	NetworkSelector *runtimev1alpha1.Selector `json:"networkSelector,omitempty"`
+}
+
+// ManagedZoneObservation is used to show the observed state of the
+// ManagedZone resource on GCP.
+type ManagedZoneObservation struct {
+	// ID is the unique, numeric ID of the zone.
+	ID uint64 `json:"id,omitempty"`
+
+	// CreationTime is the time the zone was created, in RFC3339 format.
+	CreationTime string `json:"creationTime,omitempty"`
+
+	// NameServers are the name servers that serve the zone. Delegate the
+	// DNS name of a public zone to them to make it resolvable.
+	NameServers []string `json:"nameServers,omitempty"`
+}
+
+// A ManagedZoneSpec defines the desired state of a ManagedZone.
+type ManagedZoneSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ManagedZoneParameters `json:"forProvider"`
+}
+
+// A ManagedZoneStatus represents the observed state of a ManagedZone.
+type ManagedZoneStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ManagedZoneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ManagedZone is a managed resource that represents a Google Cloud DNS
+// managed zone. Its name servers are published as a connection detail.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DNS-NAME",type="string",JSONPath=".spec.forProvider.dnsName"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ManagedZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedZoneSpec   `json:"spec"`
+	Status ManagedZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedZoneList contains a list of ManagedZone.
+type ManagedZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedZone `json:"items"`
+}
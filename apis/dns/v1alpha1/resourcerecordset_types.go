@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ResourceRecordSetParameters define the desired state of a Cloud DNS
+// resource record set. A record set is identified by its managed zone, name
+// and type.
+// https://cloud.google.com/dns/docs/reference/v1/resourceRecordSets
+type ResourceRecordSetParameters struct {
+	// ManagedZone is the name of the managed zone of the record set.
+	// +optional
+	// +immutable
+	ManagedZone *string `json:"managedZone,omitempty"`
+
+	// ManagedZoneRef references a ManagedZone and retrieves its name.
+	// +optional
+	ManagedZoneRef *runtimev1alpha1.Reference `json:"managedZoneRef,omitempty"`
+
+	// ManagedZoneSelector selects a reference to a ManagedZone.
+	// +optional
+	ManagedZoneSelector *runtimev1alpha1.Selector `json:"managedZoneSelector,omitempty"`
+
+	// Name is the DNS name of the record set, e.g. www.example.com. A
+	// trailing dot may be omitted.
+	// +immutable
+	Name string `json:"name"`
+
+	// Type is the record type, e.g. A, CNAME or TXT.
+	// +immutable
+	Type string `json:"type"`
+
+	// TTL is the number of seconds the record set may be cached for.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+
+	// RRDatas are the data of the records, as defined in RFC 1035 section 5
+	// and RFC 1034 section 3.6.1.
+	RRDatas []string `json:"rrdatas"`
+}
+
+// ResourceRecordSetObservation is used to show the observed state of the
+// ResourceRecordSet resource on GCP.
+type ResourceRecordSetObservation struct {
+	// ChangeID is the ID of the change that creates, replaces or deletes the
+	// record set, while it is pending.
+	ChangeID string `json:"changeId,omitempty"`
+}
+
+// A ResourceRecordSetSpec defines the desired state of a ResourceRecordSet.
+type ResourceRecordSetSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ResourceRecordSetParameters `json:"forProvider"`
+}
+
+// A ResourceRecordSetStatus represents the observed state of a
+// ResourceRecordSet.
+type ResourceRecordSetStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ResourceRecordSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourceRecordSet is a managed resource that represents a Google Cloud
+// DNS resource record set. Cloud DNS cannot update a record set in place, so
+// a changed record set is replaced by a single change that deletes the
+// observed record set and adds the desired one.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ResourceRecordSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceRecordSetSpec   `json:"spec"`
+	Status ResourceRecordSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceRecordSetList contains a list of ResourceRecordSet.
+type ResourceRecordSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceRecordSet `json:"items"`
+}
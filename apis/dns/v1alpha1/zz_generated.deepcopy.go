@@ -0,0 +1,376 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZone) DeepCopyInto(out *ManagedZone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZone.
+func (in *ManagedZone) DeepCopy() *ManagedZone {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedZone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneList) DeepCopyInto(out *ManagedZoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneList.
+func (in *ManagedZoneList) DeepCopy() *ManagedZoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedZoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneNetwork) DeepCopyInto(out *ManagedZoneNetwork) {
+	*out = *in
+	if in.NetworkURL != nil {
+		in, out := &in.NetworkURL, &out.NetworkURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneNetwork.
+func (in *ManagedZoneNetwork) DeepCopy() *ManagedZoneNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneObservation) DeepCopyInto(out *ManagedZoneObservation) {
+	*out = *in
+	if in.NameServers != nil {
+		in, out := &in.NameServers, &out.NameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneObservation.
+func (in *ManagedZoneObservation) DeepCopy() *ManagedZoneObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneParameters) DeepCopyInto(out *ManagedZoneParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateVisibilityConfig != nil {
+		in, out := &in.PrivateVisibilityConfig, &out.PrivateVisibilityConfig
+		*out = new(ManagedZonePrivateVisibilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneParameters.
+func (in *ManagedZoneParameters) DeepCopy() *ManagedZoneParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZonePrivateVisibilityConfig) DeepCopyInto(out *ManagedZonePrivateVisibilityConfig) {
+	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]ManagedZoneNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZonePrivateVisibilityConfig.
+func (in *ManagedZonePrivateVisibilityConfig) DeepCopy() *ManagedZonePrivateVisibilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZonePrivateVisibilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneSpec) DeepCopyInto(out *ManagedZoneSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneSpec.
+func (in *ManagedZoneSpec) DeepCopy() *ManagedZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneStatus) DeepCopyInto(out *ManagedZoneStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneStatus.
+func (in *ManagedZoneStatus) DeepCopy() *ManagedZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSet) DeepCopyInto(out *ResourceRecordSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSet.
+func (in *ResourceRecordSet) DeepCopy() *ResourceRecordSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceRecordSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetList) DeepCopyInto(out *ResourceRecordSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceRecordSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetList.
+func (in *ResourceRecordSetList) DeepCopy() *ResourceRecordSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceRecordSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetObservation) DeepCopyInto(out *ResourceRecordSetObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetObservation.
+func (in *ResourceRecordSetObservation) DeepCopy() *ResourceRecordSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParameters) {
+	*out = *in
+	if in.ManagedZone != nil {
+		in, out := &in.ManagedZone, &out.ManagedZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ManagedZoneRef != nil {
+		in, out := &in.ManagedZoneRef, &out.ManagedZoneRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ManagedZoneSelector != nil {
+		in, out := &in.ManagedZoneSelector, &out.ManagedZoneSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RRDatas != nil {
+		in, out := &in.RRDatas, &out.RRDatas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetParameters.
+func (in *ResourceRecordSetParameters) DeepCopy() *ResourceRecordSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetSpec) DeepCopyInto(out *ResourceRecordSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetSpec.
+func (in *ResourceRecordSetSpec) DeepCopy() *ResourceRecordSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetStatus) DeepCopyInto(out *ResourceRecordSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetStatus.
+func (in *ResourceRecordSetStatus) DeepCopy() *ResourceRecordSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
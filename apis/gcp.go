@@ -20,33 +20,65 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	apigatewayv1alpha1 "github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	bigqueryv1alpha1 "github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	binaryauthorizationv1alpha1 "github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
 	cachev1beta1 "github.com/crossplane/provider-gcp/apis/cache/v1beta1"
+	composerv1alpha1 "github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
+	computev1alpha1 "github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
 	computev1alpha3 "github.com/crossplane/provider-gcp/apis/compute/v1alpha3"
 	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 	containerv1alpha1 "github.com/crossplane/provider-gcp/apis/container/v1alpha1"
 	containerv1beta1 "github.com/crossplane/provider-gcp/apis/container/v1beta1"
 	databasev1beta1 "github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	dataflowv1alpha1 "github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	dnsv1alpha1 "github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
 	iam "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	kmsv1alpha1 "github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	orgpolicyv1alpha1 "github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+	osconfigv1alpha1 "github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
 	pubsub "github.com/crossplane/provider-gcp/apis/pubsub/v1alpha1"
+	pubsublitev1alpha1 "github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+	servicemanagementv1alpha1 "github.com/crossplane/provider-gcp/apis/servicemanagement/v1alpha1"
 	servicenetworkingv1beta1 "github.com/crossplane/provider-gcp/apis/servicenetworking/v1beta1"
+	serviceusagev1alpha1 "github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+	storagev1alpha1 "github.com/crossplane/provider-gcp/apis/storage/v1alpha1"
 	storagev1alpha3 "github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
+	storagetransferv1alpha1 "github.com/crossplane/provider-gcp/apis/storagetransfer/v1alpha1"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	gcpv1beta1 "github.com/crossplane/provider-gcp/apis/v1beta1"
 )
 
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
 		gcpv1alpha3.SchemeBuilder.AddToScheme,
+		gcpv1beta1.SchemeBuilder.AddToScheme,
+		apigatewayv1alpha1.SchemeBuilder.AddToScheme,
+		bigqueryv1alpha1.SchemeBuilder.AddToScheme,
+		binaryauthorizationv1alpha1.SchemeBuilder.AddToScheme,
 		cachev1beta1.SchemeBuilder.AddToScheme,
+		composerv1alpha1.SchemeBuilder.AddToScheme,
+		computev1alpha1.SchemeBuilder.AddToScheme,
 		computev1alpha3.SchemeBuilder.AddToScheme,
 		computev1beta1.SchemeBuilder.AddToScheme,
 		containerv1beta1.SchemeBuilder.AddToScheme,
 		containerv1alpha1.SchemeBuilder.AddToScheme,
 		databasev1beta1.SchemeBuilder.AddToScheme,
+		dataflowv1alpha1.SchemeBuilder.AddToScheme,
+		dnsv1alpha1.SchemeBuilder.AddToScheme,
 		iam.SchemeBuilder.AddToScheme,
+		kmsv1alpha1.SchemeBuilder.AddToScheme,
+		orgpolicyv1alpha1.SchemeBuilder.AddToScheme,
+		osconfigv1alpha1.SchemeBuilder.AddToScheme,
 		pubsub.SchemeBuilder.AddToScheme,
+		pubsublitev1alpha1.SchemeBuilder.AddToScheme,
+		servicemanagementv1alpha1.SchemeBuilder.AddToScheme,
 		servicenetworkingv1beta1.SchemeBuilder.AddToScheme,
+		serviceusagev1alpha1.SchemeBuilder.AddToScheme,
+		storagev1alpha1.SchemeBuilder.AddToScheme,
 		storagev1alpha3.SchemeBuilder.AddToScheme,
+		storagetransferv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Changes a dry run DenyPolicy previews.
+const (
+	DenyPolicyPreviewCreate = "Create"
+	DenyPolicyPreviewUpdate = "Update"
+	DenyPolicyPreviewNone   = "None"
+)
+
+// DenyPolicyParameters define the desired state of an IAM deny policy. The ID
+// of the policy is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/iam/docs/reference/rest/v2/policies
+type DenyPolicyParameters struct {
+	// Parent is the resource the policy is attached to, in the form
+	// projects/{project_id}, folders/{folder_id} or
+	// organizations/{organization_id}.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^(projects|folders|organizations)/[^/]+$`
+	Parent string `json:"parent"`
+
+	// DisplayName is a human readable name for the policy.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Rules of the policy. A deny policy may have at most 500 rules.
+	// +kubebuilder:validation:MinItems=1
+	Rules []DenyRule `json:"rules"`
+
+	// DryRun previews the policy without enforcing it. While true the
+	// policy is never created, updated or deleted; the change that would be
+	// made is reported as status.atProvider.preview instead. Deleting a
+	// DenyPolicy in dry run leaves any existing policy in place.
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// A DenyRule denies a set of principals a set of permissions.
+type DenyRule struct {
+	// Description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DeniedPrincipals are the identities that are denied the permissions,
+	// for example
+	// principalSet://goog/public:all or
+	// principal://iam.googleapis.com/projects/-/serviceAccounts/{email}.
+	DeniedPrincipals []string `json:"deniedPrincipals"`
+
+	// ExceptionPrincipals are excluded from DeniedPrincipals.
+	// +optional
+	ExceptionPrincipals []string `json:"exceptionPrincipals,omitempty"`
+
+	// DeniedPermissions are the permissions that are denied, in the form
+	// {service_fqdn}/{resource}.{verb}, for example
+	// iam.googleapis.com/roles.create.
+	DeniedPermissions []string `json:"deniedPermissions"`
+
+	// ExceptionPermissions are excluded from DeniedPermissions.
+	// +optional
+	ExceptionPermissions []string `json:"exceptionPermissions,omitempty"`
+
+	// DenialCondition limits when the permissions are denied. The
+	// permissions are denied if the condition evaluates to true or cannot
+	// be evaluated.
+	// +optional
+	DenialCondition *DenialCondition `json:"denialCondition,omitempty"`
+}
+
+// A DenialCondition is a Common Expression Language expression.
+type DenialCondition struct {
+	// Expression in Common Expression Language syntax.
+	Expression string `json:"expression"`
+
+	// Title for the expression.
+	// +optional
+	Title *string `json:"title,omitempty"`
+
+	// Description of the expression.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// DenyPolicyObservation is used to show the observed state of the deny
+// policy on GCP.
+type DenyPolicyObservation struct {
+	// Name is the resource name of the policy, in the form
+	// policies/{attachment_point}/denypolicies/{policy_id}.
+	Name string `json:"name,omitempty"`
+
+	// UID is the globally unique ID of the policy.
+	UID string `json:"uid,omitempty"`
+
+	// Etag of the policy.
+	Etag string `json:"etag,omitempty"`
+
+	// CreateTime is the time the policy was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the policy was last updated.
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+
+	// Preview of the change that would be made to the policy. It is only
+	// reported in dry run.
+	Preview *DenyPolicyPreview `json:"preview,omitempty"`
+}
+
+// A DenyPolicyPreview describes the change that would be made to a deny
+// policy if dry run were disabled.
+type DenyPolicyPreview struct {
+	// Action that would be taken: Create, Update or None.
+	Action string `json:"action"`
+
+	// RulesAdded is the number of desired rules the policy does not have.
+	RulesAdded int `json:"rulesAdded"`
+
+	// RulesRemoved is the number of rules of the policy that are not
+	// desired.
+	RulesRemoved int `json:"rulesRemoved"`
+}
+
+// A DenyPolicySpec defines the desired state of a DenyPolicy.
+type DenyPolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  DenyPolicyParameters `json:"forProvider"`
+}
+
+// A DenyPolicyStatus represents the observed state of a DenyPolicy.
+type DenyPolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     DenyPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DenyPolicy is a managed resource that represents a GCP IAM deny policy,
+// which denies principals permissions regardless of the roles they are
+// granted.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DRY-RUN",type="boolean",JSONPath=".spec.forProvider.dryRun"
+// +kubebuilder:printcolumn:name="PREVIEW",type="string",JSONPath=".status.atProvider.preview.action"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type DenyPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DenyPolicySpec   `json:"spec"`
+	Status DenyPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DenyPolicyList contains a list of DenyPolicy.
+type DenyPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DenyPolicy `json:"items"`
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Audit log types.
+const (
+	AuditLogTypeAdminRead = "ADMIN_READ"
+	AuditLogTypeDataWrite = "DATA_WRITE"
+	AuditLogTypeDataRead  = "DATA_READ"
+)
+
+// AuditConfigAllServices is the service that configures audit logging for
+// all services of a project.
+const AuditConfigAllServices = "allServices"
+
+// ProjectAuditConfigParameters define the desired audit logging of a service
+// of a project. Audit logging is configured by the auditConfigs of the
+// project's IAM policy; the bindings of the policy are left untouched.
+// https://cloud.google.com/resource-manager/reference/rest/v1/Policy#auditconfig
+type ProjectAuditConfigParameters struct {
+	// Project is the ID of the project whose audit logging is configured.
+	// +immutable
+	Project string `json:"project"`
+
+	// Service for which audit logging is configured, for example
+	// storage.googleapis.com, or allServices to configure all services.
+	// +immutable
+	Service string `json:"service"`
+
+	// AuditLogConfigs configure the types of permission that are logged, and
+	// the identities that are exempted from logging them.
+	// +kubebuilder:validation:MinItems=1
+	AuditLogConfigs []AuditLogConfig `json:"auditLogConfigs"`
+}
+
+// An AuditLogConfig enables logging of a type of permission.
+type AuditLogConfig struct {
+	// LogType is the type of permission that is logged.
+	// +kubebuilder:validation:Enum=ADMIN_READ;DATA_WRITE;DATA_READ
+	LogType string `json:"logType"`
+
+	// ExemptedMembers are the identities that do not cause logging of this
+	// type of permission, in the same form as the members of IAM policy
+	// bindings, for example user:jane@example.com.
+	// +optional
+	ExemptedMembers []string `json:"exemptedMembers,omitempty"`
+}
+
+// ProjectAuditConfigObservation is used to show the observed state of the
+// ProjectAuditConfig resource on GCP.
+type ProjectAuditConfigObservation struct {
+	// Etag of the IAM policy the audit config was last observed in.
+	Etag string `json:"etag,omitempty"`
+}
+
+// A ProjectAuditConfigSpec defines the desired state of a ProjectAuditConfig.
+type ProjectAuditConfigSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ProjectAuditConfigParameters `json:"forProvider"`
+}
+
+// A ProjectAuditConfigStatus represents the observed state of a
+// ProjectAuditConfig.
+type ProjectAuditConfigStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ProjectAuditConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectAuditConfig is a managed resource that represents the audit logging
+// configuration of a service of a Google Cloud project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PROJECT",type="string",JSONPath=".spec.forProvider.project"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.service"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ProjectAuditConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectAuditConfigSpec   `json:"spec"`
+	Status ProjectAuditConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectAuditConfigList contains a list of ProjectAuditConfig.
+type ProjectAuditConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectAuditConfig `json:"items"`
+}
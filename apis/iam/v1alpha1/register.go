@@ -45,6 +45,42 @@ var (
 	ServiceAccountGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountKind)
 )
 
+// ServiceAccountKeyHardening type metadata.
+var (
+	ServiceAccountKeyHardeningKind             = reflect.TypeOf(ServiceAccountKeyHardening{}).Name()
+	ServiceAccountKeyHardeningGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceAccountKeyHardeningKind}.String()
+	ServiceAccountKeyHardeningKindAPIVersion   = ServiceAccountKeyHardeningKind + "." + SchemeGroupVersion.String()
+	ServiceAccountKeyHardeningGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountKeyHardeningKind)
+)
+
+// ServiceAccountKey type metadata.
+var (
+	ServiceAccountKeyKind             = reflect.TypeOf(ServiceAccountKey{}).Name()
+	ServiceAccountKeyGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceAccountKeyKind}.String()
+	ServiceAccountKeyKindAPIVersion   = ServiceAccountKeyKind + "." + SchemeGroupVersion.String()
+	ServiceAccountKeyGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountKeyKind)
+)
+
+// DenyPolicy type metadata.
+var (
+	DenyPolicyKind             = reflect.TypeOf(DenyPolicy{}).Name()
+	DenyPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: DenyPolicyKind}.String()
+	DenyPolicyKindAPIVersion   = DenyPolicyKind + "." + SchemeGroupVersion.String()
+	DenyPolicyGroupVersionKind = SchemeGroupVersion.WithKind(DenyPolicyKind)
+)
+
+// ProjectAuditConfig type metadata.
+var (
+	ProjectAuditConfigKind             = reflect.TypeOf(ProjectAuditConfig{}).Name()
+	ProjectAuditConfigGroupKind        = schema.GroupKind{Group: Group, Kind: ProjectAuditConfigKind}.String()
+	ProjectAuditConfigKindAPIVersion   = ProjectAuditConfigKind + "." + SchemeGroupVersion.String()
+	ProjectAuditConfigGroupVersionKind = SchemeGroupVersion.WithKind(ProjectAuditConfigKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ServiceAccount{}, &ServiceAccountList{})
+	SchemeBuilder.Register(&ServiceAccountKeyHardening{}, &ServiceAccountKeyHardeningList{})
+	SchemeBuilder.Register(&ServiceAccountKey{}, &ServiceAccountKeyList{})
+	SchemeBuilder.Register(&DenyPolicy{}, &DenyPolicyList{})
+	SchemeBuilder.Register(&ProjectAuditConfig{}, &ProjectAuditConfigList{})
 }
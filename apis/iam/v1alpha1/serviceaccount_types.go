@@ -27,17 +27,80 @@ import (
 // The name of the service account (ie the `accountId` parameter of the Create
 // call) is determined by the value of the `crossplane.io/external-name`
 // annotation. Unless overridden by the user, this annotation is automatically
-// populated with the value of the `metadata.name` attribute.
+// populated with the value of the `metadata.name` attribute if it is a valid
+// account ID, i.e. 6 to 30 lowercase letters, digits and hyphens starting
+// with a letter, or otherwise with a valid account ID derived from it.
 type ServiceAccountParameters struct {
+	// ProjectID is the ID or number of the project the service account
+	// belongs to. It defaults to the project of the Provider, and allows one
+	// Provider to manage service accounts in several projects that its
+	// credentials have access to. It cannot be changed once the service
+	// account is created.
+	// +immutable
+	// +optional
+	ProjectID *string `json:"projectId,omitempty"`
+
 	// DisplayName is an optional user-specified name for the service account.
-	// Must be less than or equal to 100 characters.
+	// Must be less than or equal to 100 characters once expanded. It may be a
+	// Go template that refers to the metadata of this ServiceAccount; see
+	// Description for the available variables.
 	// +optional
 	DisplayName *string `json:"displayName,omitempty"`
 
 	// Description is an optional user-specified opaque description of the
-	// service account. Must be less than or equal to 256 characters.
+	// service account. Must be less than or equal to 231 characters; the
+	// remainder of the 256 characters allowed by GCP is reserved for the
+	// ownership marker that is appended to the description of every service
+	// account managed by Crossplane.
+	//
+	// The description may be a Go template, e.g. "Managed by Crossplane for
+	// {{ .ObjectMeta.Labels.team }}", that is expanded before the service
+	// account is created or updated. Only the metadata of this ServiceAccount
+	// is available to templates:
+	//   {{ .ObjectMeta.Name }}: the name of this ServiceAccount.
+	//   {{ .ObjectMeta.Namespace }}: its namespace, which is empty because
+	//     ServiceAccounts are cluster scoped.
+	//   {{ .ObjectMeta.Labels.<key> }}: the value of one of its labels.
+	//   {{ .ObjectMeta.Annotations.<key> }}: the value of one of its
+	//     annotations.
+	// Referring to a label or annotation that is not set is an error, as is
+	// an expanded value that contains control characters or is too long.
 	// +optional
 	Description *string `json:"description,omitempty"`
+
+	// Disabled controls whether the service account is disabled. A disabled
+	// service account cannot authenticate, but keeps its keys and role
+	// bindings so that it can be enabled again. The service account is left
+	// as is when omitted.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Policy is the IAM policy of the service account itself, which grants
+	// principals roles such as roles/iam.serviceAccountUser on the service
+	// account. When set its bindings replace those of the service account's
+	// policy; when omitted the policy is not managed.
+	// +optional
+	Policy *ServiceAccountPolicy `json:"policy,omitempty"`
+}
+
+// A ServiceAccountPolicy is the IAM policy of a service account.
+type ServiceAccountPolicy struct {
+	// Bindings grant roles on the service account to principals. Bindings
+	// without members are ignored.
+	// +optional
+	Bindings []ServiceAccountBinding `json:"bindings,omitempty"`
+}
+
+// A ServiceAccountBinding grants a role on a service account to principals.
+type ServiceAccountBinding struct {
+	// Role that is granted, for example roles/iam.workloadIdentityUser.
+	Role string `json:"role"`
+
+	// Members the role is granted to, for example
+	// serviceAccount:my-project.svc.id.goog[my-namespace/my-ksa] or
+	// group:admins@example.com.
+	// +optional
+	Members []string `json:"members,omitempty"`
 }
 
 // ServiceAccountObservation is used to show the observed state of the
@@ -69,6 +132,29 @@ type ServiceAccountObservation struct {
 	// Disabled is a bool indicating if the service account is disabled.
 	// The field is currently in alpha phase.
 	Disabled bool `json:"disabled,omitempty"`
+
+	// Etag of the service account as last observed. The IAM API does not
+	// currently use it to guard updates, so it is informational only.
+	Etag string `json:"etag,omitempty"`
+
+	// LastReconcileError is the most recent error encountered while
+	// reconciling the service account. It is cleared once the service
+	// account is observed to be up to date.
+	LastReconcileError *ReconcileError `json:"lastReconcileError,omitempty"`
+}
+
+// A ReconcileError is an error encountered while reconciling a resource.
+type ReconcileError struct {
+	// Message describes the error.
+	Message string `json:"message"`
+
+	// Time at which the error occurred.
+	Time metav1.Time `json:"time"`
+
+	// Transient is true if the error is expected to resolve itself when
+	// retried, for example because the GCP API was unavailable.
+	// +optional
+	Transient bool `json:"transient,omitempty"`
 }
 
 // ServiceAccountSpec defines the desired state of a
@@ -76,8 +162,69 @@ type ServiceAccountObservation struct {
 type ServiceAccountSpec struct {
 	runtimev1alpha1.ResourceSpec `json:",inline"`
 	ForProvider                  ServiceAccountParameters `json:"forProvider"`
+
+	// ProviderConfigReference specifies the ProviderConfig used to connect
+	// to GCP. It takes precedence over the ProviderReference, which this
+	// API version still requires but ignores when a ProviderConfig is
+	// referenced.
+	// +optional
+	ProviderConfigReference *runtimev1alpha1.Reference `json:"providerConfigRef,omitempty"`
+
+	// AdoptUnmarked controls whether an existing service account whose
+	// description lacks the Crossplane ownership marker may be adopted and
+	// managed by this resource. This includes a service account that already
+	// exists with the requested account ID when this resource creates it.
+	// Defaults to true, in which case such a service account is adopted and
+	// its description is marked as managed by Crossplane. Set it to false to
+	// refuse to manage service accounts that were created by other tooling.
+	// +optional
+	AdoptUnmarked *bool `json:"adoptUnmarked,omitempty"`
+
+	// ReconcileWindow restricts when changes are made to the service account.
+	// Outside the window the service account is still observed, but updates
+	// and deletions are deferred until the window next opens. Changes may be
+	// made at any time if no window is specified.
+	// +optional
+	ReconcileWindow *ReconcileWindow `json:"reconcileWindow,omitempty"`
+
+	// RemoveProjectBindings controls whether the service account is removed
+	// from the bindings of its project's IAM policy before it is deleted, so
+	// that the policy is not left granting roles to a deleted service
+	// account. Bindings that grant a role only to the service account are
+	// removed entirely. Defaults to false.
+	// +optional
+	RemoveProjectBindings *bool `json:"removeProjectBindings,omitempty"`
 }
 
+// A ReconcileWindow is a recurring period of time during which changes may be
+// made to an external resource.
+type ReconcileWindow struct {
+	// Days of the week on which the window opens. The window opens every day
+	// if no days are specified.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of day at which the window opens, in 24 hour HH:MM
+	// format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of day at which the window closes, in 24 hour HH:MM
+	// format. A window whose end is not after its start closes on the day
+	// after it opens.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+
+	// TimeZone in which start and end are interpreted, as an IANA time zone
+	// name such as Europe/Zurich. Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// A Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+type Weekday string
+
 // ServiceAccountStatus represents the observed state of a
 // ServiceAccount.
 type ServiceAccountStatus struct {
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Key algorithms of a ServiceAccountKey.
+const (
+	KeyAlgorithmRSA1024 = "KEY_ALG_RSA_1024"
+	KeyAlgorithmRSA2048 = "KEY_ALG_RSA_2048"
+)
+
+// Private key types of a ServiceAccountKey.
+const (
+	// PrivateKeyTypeGoogleCredentialsFile is a JSON key file, as accepted by
+	// Google's client libraries and the credentials secret of a Provider.
+	PrivateKeyTypeGoogleCredentialsFile = "TYPE_GOOGLE_CREDENTIALS_FILE"
+
+	// PrivateKeyTypePKCS12File is a PKCS #12 file, protected by the
+	// passphrase "notasecret".
+	PrivateKeyTypePKCS12File = "TYPE_PKCS12_FILE"
+)
+
+// ServiceAccountKeyParameters define the desired state of a
+// ServiceAccountKey. A key cannot be changed once it is created, but it may be
+// replaced by a new key per its rotation policy.
+type ServiceAccountKeyParameters struct {
+	// ServiceAccount is the email or unique ID of the service account the key
+	// is created for.
+	// +optional
+	// +immutable
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount and retrieves its email.
+	// +optional
+	ServiceAccountRef *runtimev1alpha1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount.
+	// +optional
+	ServiceAccountSelector *runtimev1alpha1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// KeyAlgorithm is the algorithm of the key. Defaults to KEY_ALG_RSA_2048.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=KEY_ALG_RSA_1024;KEY_ALG_RSA_2048
+	KeyAlgorithm *string `json:"keyAlgorithm,omitempty"`
+
+	// PrivateKeyType is the format of the private key written to the
+	// connection secret. A TYPE_GOOGLE_CREDENTIALS_FILE key is written to the
+	// credentials.json key. A TYPE_PKCS12_FILE key is written to the key.p12
+	// key, and its passphrase to the pkcs12Passphrase key. Defaults to
+	// TYPE_GOOGLE_CREDENTIALS_FILE.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=TYPE_GOOGLE_CREDENTIALS_FILE;TYPE_PKCS12_FILE
+	PrivateKeyType *string `json:"privateKeyType,omitempty"`
+
+	// RotationPolicy replaces the key with a new key once it reaches a
+	// maximum age. The key is never replaced if no policy is specified.
+	// +optional
+	RotationPolicy *ServiceAccountKeyRotationPolicy `json:"rotationPolicy,omitempty"`
+}
+
+// A ServiceAccountKeyRotationPolicy specifies when a key is replaced by a new
+// key. The new key is written to the connection secret, and the replaced key
+// is deleted once its grace period has passed.
+type ServiceAccountKeyRotationPolicy struct {
+	// MaxAge is how long a key is used before it is replaced, as a duration
+	// such as 720h. The age of a key is measured from its validAfterTime.
+	MaxAge metav1.Duration `json:"maxAge"`
+
+	// GracePeriod is how long a replaced key remains valid after the new key
+	// was written to the connection secret, so that consumers of the secret
+	// can switch to the new key. Defaults to 1h.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// ServiceAccountKeyObservation is used to show the observed state of the
+// ServiceAccountKey.
+type ServiceAccountKeyObservation struct {
+	// Name is the resource name of the key, in the form
+	// projects/{project}/serviceAccounts/{email}/keys/{key}.
+	Name string `json:"name,omitempty"`
+
+	// KeyOrigin is where the key material was sourced from.
+	KeyOrigin string `json:"keyOrigin,omitempty"`
+
+	// KeyType is whether the key is user-managed or system-managed.
+	KeyType string `json:"keyType,omitempty"`
+
+	// ValidAfterTime is when the key becomes valid, in RFC 3339 format.
+	ValidAfterTime string `json:"validAfterTime,omitempty"`
+
+	// ValidBeforeTime is when the key stops being valid, in RFC 3339 format.
+	ValidBeforeTime string `json:"validBeforeTime,omitempty"`
+
+	// RetiredKeys are the keys that were replaced by a rotation and are
+	// not yet deleted.
+	RetiredKeys []RetiredServiceAccountKey `json:"retiredKeys,omitempty"`
+}
+
+// A RetiredServiceAccountKey is a key that was replaced by a rotation.
+type RetiredServiceAccountKey struct {
+	// Name is the resource name of the key, in the form
+	// projects/{project}/serviceAccounts/{email}/keys/{key}.
+	Name string `json:"name"`
+
+	// DeleteTime is when the key's grace period ends and it is deleted.
+	DeleteTime metav1.Time `json:"deleteTime"`
+}
+
+// A ServiceAccountKeySpec defines the desired state of a ServiceAccountKey.
+type ServiceAccountKeySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ServiceAccountKeyParameters `json:"forProvider"`
+}
+
+// A ServiceAccountKeyStatus represents the observed state of a
+// ServiceAccountKey.
+type ServiceAccountKeyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ServiceAccountKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceAccountKey is a managed resource that represents a user-managed key
+// of a GCP service account. The key's private key is written to the
+// connection secret when the key is created; GCP never returns it again, so
+// the connection secret is its only copy.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE-ACCOUNT",type="string",JSONPath=".spec.forProvider.serviceAccount"
+// +kubebuilder:resource:scope=Cluster
+type ServiceAccountKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceAccountKeySpec   `json:"spec"`
+	Status ServiceAccountKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountKeyList contains a list of ServiceAccountKey types
+type ServiceAccountKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccountKey `json:"items"`
+}
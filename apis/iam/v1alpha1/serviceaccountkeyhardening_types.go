@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ServiceAccountKeyHardeningParameters define which service account's keys
+// are hardened.
+type ServiceAccountKeyHardeningParameters struct {
+	// ServiceAccount is the email of the service account whose keys are
+	// hardened, for example the default compute service account
+	// 123456789012-compute@developer.gserviceaccount.com.
+	// +optional
+	// +immutable
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount and retrieves its email.
+	// +optional
+	ServiceAccountRef *runtimev1alpha1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount.
+	// +optional
+	ServiceAccountSelector *runtimev1alpha1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// DeleteUserManagedKeys causes all user-managed keys of the service
+	// account to be deleted. Keys that are managed by Google are never
+	// deleted. When false the user-managed keys are only observed.
+	// +optional
+	DeleteUserManagedKeys *bool `json:"deleteUserManagedKeys,omitempty"`
+}
+
+// ServiceAccountKeyHardeningObservation is used to show the observed keys of
+// the service account.
+type ServiceAccountKeyHardeningObservation struct {
+	// UserManagedKeys is the number of user-managed keys the service
+	// account has.
+	UserManagedKeys int `json:"userManagedKeys"`
+}
+
+// A ServiceAccountKeyHardeningSpec defines the desired state of a
+// ServiceAccountKeyHardening.
+type ServiceAccountKeyHardeningSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ServiceAccountKeyHardeningParameters `json:"forProvider"`
+}
+
+// A ServiceAccountKeyHardeningStatus represents the observed state of a
+// ServiceAccountKeyHardening.
+type ServiceAccountKeyHardeningStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ServiceAccountKeyHardeningObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceAccountKeyHardening reports the user-managed keys of a GCP service
+// account and, optionally, deletes them so that only Google-managed keys
+// remain. It does not correspond to a GCP resource; deleting it leaves the
+// service account and its keys untouched.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE-ACCOUNT",type="string",JSONPath=".spec.forProvider.serviceAccount"
+// +kubebuilder:printcolumn:name="USER-KEYS",type="integer",JSONPath=".status.atProvider.userManagedKeys"
+// +kubebuilder:resource:scope=Cluster
+type ServiceAccountKeyHardening struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceAccountKeyHardeningSpec   `json:"spec"`
+	Status ServiceAccountKeyHardeningStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountKeyHardeningList contains a list of ServiceAccountKeyHardening
+// types
+type ServiceAccountKeyHardeningList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccountKeyHardening `json:"items"`
+}
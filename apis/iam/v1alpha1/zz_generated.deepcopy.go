@@ -21,9 +21,456 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogConfig) DeepCopyInto(out *AuditLogConfig) {
+	*out = *in
+	if in.ExemptedMembers != nil {
+		in, out := &in.ExemptedMembers, &out.ExemptedMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogConfig.
+func (in *AuditLogConfig) DeepCopy() *AuditLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenialCondition) DeepCopyInto(out *DenialCondition) {
+	*out = *in
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenialCondition.
+func (in *DenialCondition) DeepCopy() *DenialCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DenialCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyPolicy) DeepCopyInto(out *DenyPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyPolicy.
+func (in *DenyPolicy) DeepCopy() *DenyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DenyPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyPolicyList) DeepCopyInto(out *DenyPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DenyPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyPolicyList.
+func (in *DenyPolicyList) DeepCopy() *DenyPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DenyPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyPolicyObservation) DeepCopyInto(out *DenyPolicyObservation) {
+	*out = *in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdateTime != nil {
+		in, out := &in.UpdateTime, &out.UpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(DenyPolicyPreview)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyPolicyObservation.
+func (in *DenyPolicyObservation) DeepCopy() *DenyPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyPolicyParameters) DeepCopyInto(out *DenyPolicyParameters) {
+	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]DenyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyPolicyParameters.
+func (in *DenyPolicyParameters) DeepCopy() *DenyPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyPolicyPreview) DeepCopyInto(out *DenyPolicyPreview) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyPolicyPreview.
+func (in *DenyPolicyPreview) DeepCopy() *DenyPolicyPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyPolicyPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyPolicySpec) DeepCopyInto(out *DenyPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyPolicySpec.
+func (in *DenyPolicySpec) DeepCopy() *DenyPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyPolicyStatus) DeepCopyInto(out *DenyPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyPolicyStatus.
+func (in *DenyPolicyStatus) DeepCopy() *DenyPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyRule) DeepCopyInto(out *DenyRule) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeniedPrincipals != nil {
+		in, out := &in.DeniedPrincipals, &out.DeniedPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExceptionPrincipals != nil {
+		in, out := &in.ExceptionPrincipals, &out.ExceptionPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedPermissions != nil {
+		in, out := &in.DeniedPermissions, &out.DeniedPermissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExceptionPermissions != nil {
+		in, out := &in.ExceptionPermissions, &out.ExceptionPermissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DenialCondition != nil {
+		in, out := &in.DenialCondition, &out.DenialCondition
+		*out = new(DenialCondition)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyRule.
+func (in *DenyRule) DeepCopy() *DenyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAuditConfig) DeepCopyInto(out *ProjectAuditConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAuditConfig.
+func (in *ProjectAuditConfig) DeepCopy() *ProjectAuditConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAuditConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAuditConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAuditConfigList) DeepCopyInto(out *ProjectAuditConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectAuditConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAuditConfigList.
+func (in *ProjectAuditConfigList) DeepCopy() *ProjectAuditConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAuditConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectAuditConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAuditConfigObservation) DeepCopyInto(out *ProjectAuditConfigObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAuditConfigObservation.
+func (in *ProjectAuditConfigObservation) DeepCopy() *ProjectAuditConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAuditConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAuditConfigParameters) DeepCopyInto(out *ProjectAuditConfigParameters) {
+	*out = *in
+	if in.AuditLogConfigs != nil {
+		in, out := &in.AuditLogConfigs, &out.AuditLogConfigs
+		*out = make([]AuditLogConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAuditConfigParameters.
+func (in *ProjectAuditConfigParameters) DeepCopy() *ProjectAuditConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAuditConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAuditConfigSpec) DeepCopyInto(out *ProjectAuditConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAuditConfigSpec.
+func (in *ProjectAuditConfigSpec) DeepCopy() *ProjectAuditConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAuditConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAuditConfigStatus) DeepCopyInto(out *ProjectAuditConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAuditConfigStatus.
+func (in *ProjectAuditConfigStatus) DeepCopy() *ProjectAuditConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAuditConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileError) DeepCopyInto(out *ReconcileError) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileError.
+func (in *ReconcileError) DeepCopy() *ReconcileError {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileWindow) DeepCopyInto(out *ReconcileWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileWindow.
+func (in *ReconcileWindow) DeepCopy() *ReconcileWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetiredServiceAccountKey) DeepCopyInto(out *RetiredServiceAccountKey) {
+	*out = *in
+	in.DeleteTime.DeepCopyInto(&out.DeleteTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetiredServiceAccountKey.
+func (in *RetiredServiceAccountKey) DeepCopy() *RetiredServiceAccountKey {
+	if in == nil {
+		return nil
+	}
+	out := new(RetiredServiceAccountKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
 	*out = *in
@@ -33,24 +480,368 @@ func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccount.
-func (in *ServiceAccount) DeepCopy() *ServiceAccount {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccount.
+func (in *ServiceAccount) DeepCopy() *ServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountBinding) DeepCopyInto(out *ServiceAccountBinding) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountBinding.
+func (in *ServiceAccountBinding) DeepCopy() *ServiceAccountBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKey) DeepCopyInto(out *ServiceAccountKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKey.
+func (in *ServiceAccountKey) DeepCopy() *ServiceAccountKey {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceAccount)
+	out := new(ServiceAccountKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ServiceAccount) DeepCopyObject() runtime.Object {
+func (in *ServiceAccountKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyHardening) DeepCopyInto(out *ServiceAccountKeyHardening) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyHardening.
+func (in *ServiceAccountKeyHardening) DeepCopy() *ServiceAccountKeyHardening {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyHardening)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountKeyHardening) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyHardeningList) DeepCopyInto(out *ServiceAccountKeyHardeningList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceAccountKeyHardening, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyHardeningList.
+func (in *ServiceAccountKeyHardeningList) DeepCopy() *ServiceAccountKeyHardeningList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyHardeningList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountKeyHardeningList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyHardeningObservation) DeepCopyInto(out *ServiceAccountKeyHardeningObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyHardeningObservation.
+func (in *ServiceAccountKeyHardeningObservation) DeepCopy() *ServiceAccountKeyHardeningObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyHardeningObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyHardeningParameters) DeepCopyInto(out *ServiceAccountKeyHardeningParameters) {
+	*out = *in
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceAccountSelector != nil {
+		in, out := &in.ServiceAccountSelector, &out.ServiceAccountSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeleteUserManagedKeys != nil {
+		in, out := &in.DeleteUserManagedKeys, &out.DeleteUserManagedKeys
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyHardeningParameters.
+func (in *ServiceAccountKeyHardeningParameters) DeepCopy() *ServiceAccountKeyHardeningParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyHardeningParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyHardeningSpec) DeepCopyInto(out *ServiceAccountKeyHardeningSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyHardeningSpec.
+func (in *ServiceAccountKeyHardeningSpec) DeepCopy() *ServiceAccountKeyHardeningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyHardeningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyHardeningStatus) DeepCopyInto(out *ServiceAccountKeyHardeningStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyHardeningStatus.
+func (in *ServiceAccountKeyHardeningStatus) DeepCopy() *ServiceAccountKeyHardeningStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyHardeningStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyList) DeepCopyInto(out *ServiceAccountKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceAccountKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyList.
+func (in *ServiceAccountKeyList) DeepCopy() *ServiceAccountKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountKeyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyObservation) DeepCopyInto(out *ServiceAccountKeyObservation) {
+	*out = *in
+	if in.RetiredKeys != nil {
+		in, out := &in.RetiredKeys, &out.RetiredKeys
+		*out = make([]RetiredServiceAccountKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyObservation.
+func (in *ServiceAccountKeyObservation) DeepCopy() *ServiceAccountKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyParameters) DeepCopyInto(out *ServiceAccountKeyParameters) {
+	*out = *in
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceAccountSelector != nil {
+		in, out := &in.ServiceAccountSelector, &out.ServiceAccountSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyAlgorithm != nil {
+		in, out := &in.KeyAlgorithm, &out.KeyAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateKeyType != nil {
+		in, out := &in.PrivateKeyType, &out.PrivateKeyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationPolicy != nil {
+		in, out := &in.RotationPolicy, &out.RotationPolicy
+		*out = new(ServiceAccountKeyRotationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyParameters.
+func (in *ServiceAccountKeyParameters) DeepCopy() *ServiceAccountKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyRotationPolicy) DeepCopyInto(out *ServiceAccountKeyRotationPolicy) {
+	*out = *in
+	out.MaxAge = in.MaxAge
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyRotationPolicy.
+func (in *ServiceAccountKeyRotationPolicy) DeepCopy() *ServiceAccountKeyRotationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyRotationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeySpec) DeepCopyInto(out *ServiceAccountKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeySpec.
+func (in *ServiceAccountKeySpec) DeepCopy() *ServiceAccountKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKeyStatus) DeepCopyInto(out *ServiceAccountKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKeyStatus.
+func (in *ServiceAccountKeyStatus) DeepCopy() *ServiceAccountKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountList) DeepCopyInto(out *ServiceAccountList) {
 	*out = *in
@@ -86,6 +877,11 @@ func (in *ServiceAccountList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountObservation) DeepCopyInto(out *ServiceAccountObservation) {
 	*out = *in
+	if in.LastReconcileError != nil {
+		in, out := &in.LastReconcileError, &out.LastReconcileError
+		*out = new(ReconcileError)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountObservation.
@@ -101,6 +897,11 @@ func (in *ServiceAccountObservation) DeepCopy() *ServiceAccountObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountParameters) DeepCopyInto(out *ServiceAccountParameters) {
 	*out = *in
+	if in.ProjectID != nil {
+		in, out := &in.ProjectID, &out.ProjectID
+		*out = new(string)
+		**out = **in
+	}
 	if in.DisplayName != nil {
 		in, out := &in.DisplayName, &out.DisplayName
 		*out = new(string)
@@ -111,6 +912,16 @@ func (in *ServiceAccountParameters) DeepCopyInto(out *ServiceAccountParameters)
 		*out = new(string)
 		**out = **in
 	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(ServiceAccountPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountParameters.
@@ -123,11 +934,53 @@ func (in *ServiceAccountParameters) DeepCopy() *ServiceAccountParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountPolicy) DeepCopyInto(out *ServiceAccountPolicy) {
+	*out = *in
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]ServiceAccountBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountPolicy.
+func (in *ServiceAccountPolicy) DeepCopy() *ServiceAccountPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountSpec) DeepCopyInto(out *ServiceAccountSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ProviderConfigReference != nil {
+		in, out := &in.ProviderConfigReference, &out.ProviderConfigReference
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.AdoptUnmarked != nil {
+		in, out := &in.AdoptUnmarked, &out.AdoptUnmarked
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReconcileWindow != nil {
+		in, out := &in.ReconcileWindow, &out.ReconcileWindow
+		*out = new(ReconcileWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoveProjectBindings != nil {
+		in, out := &in.RemoveProjectBindings, &out.RemoveProjectBindings
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountSpec.
@@ -144,7 +997,7 @@ func (in *ServiceAccountSpec) DeepCopy() *ServiceAccountSpec {
 func (in *ServiceAccountStatus) DeepCopyInto(out *ServiceAccountStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountStatus.
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// CryptoKeyParameters define the desired state of a Cloud KMS crypto key. The
+// ID of the crypto key is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/kms/docs/reference/rest/v1/projects.locations.keyRings.cryptoKeys
+type CryptoKeyParameters struct {
+	// KeyRing is the resource name of the key ring this crypto key belongs
+	// to, in the form projects/{project}/locations/{location}/keyRings/{keyRing}.
+	// +optional
+	// +immutable
+	KeyRing *string `json:"keyRing,omitempty"`
+
+	// KeyRingRef references a KeyRing and retrieves its resource name.
+	// +optional
+	// +immutable
+	KeyRingRef *runtimev1alpha1.Reference `json:"keyRingRef,omitempty"`
+
+	// KeyRingSelector selects a reference to a KeyRing.
+	// +optional
+	KeyRingSelector *runtimev1alpha1.Selector `json:"keyRingSelector,omitempty"`
+
+	// Purpose of the crypto key. Defaults to ENCRYPT_DECRYPT.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=ENCRYPT_DECRYPT;ASYMMETRIC_SIGN;ASYMMETRIC_DECRYPT
+	Purpose *string `json:"purpose,omitempty"`
+
+	// RotationPeriod is the period after which a new primary version is
+	// generated, in seconds with an `s` suffix, e.g. 7776000s. Only
+	// ENCRYPT_DECRYPT keys are rotated automatically. NextRotationTime must
+	// be set too when RotationPeriod is set.
+	// +optional
+	RotationPeriod *string `json:"rotationPeriod,omitempty"`
+
+	// NextRotationTime is the RFC 3339 time at which a new primary version is
+	// generated next. It advances by RotationPeriod each time the key is
+	// rotated, so a NextRotationTime that has passed is not enforced.
+	// +optional
+	NextRotationTime *string `json:"nextRotationTime,omitempty"`
+
+	// Labels with user-defined metadata.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// VersionTemplate specifies the properties of new versions of the crypto
+	// key.
+	// +optional
+	// +immutable
+	VersionTemplate *CryptoKeyVersionTemplate `json:"versionTemplate,omitempty"`
+
+	// DestroyPrimaryVersionOnDeletion schedules the primary version of the
+	// crypto key for destruction when the CryptoKey is deleted. GCP does not
+	// allow crypto keys to be deleted, so by default deleting a CryptoKey
+	// leaves the crypto key and its versions in place.
+	// +optional
+	DestroyPrimaryVersionOnDeletion *bool `json:"destroyPrimaryVersionOnDeletion,omitempty"`
+}
+
+// A CryptoKeyVersionTemplate specifies the properties of new versions of a
+// crypto key.
+type CryptoKeyVersionTemplate struct {
+	// Algorithm of the crypto key versions, e.g. GOOGLE_SYMMETRIC_ENCRYPTION
+	// or RSA_SIGN_PSS_2048_SHA256. It must be compatible with the purpose of
+	// the crypto key.
+	Algorithm string `json:"algorithm"`
+
+	// ProtectionLevel of the crypto key versions. Defaults to SOFTWARE.
+	// +optional
+	// +kubebuilder:validation:Enum=SOFTWARE;HSM;EXTERNAL
+	ProtectionLevel *string `json:"protectionLevel,omitempty"`
+}
+
+// CryptoKeyObservation is used to show the observed state of the CryptoKey
+// resource on GCP.
+type CryptoKeyObservation struct {
+	// Name is the resource name of the crypto key, in the form
+	// projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{cryptoKey}.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the crypto key was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// PrimaryVersion is the resource name of the version of the crypto key
+	// that is used to encrypt data.
+	PrimaryVersion string `json:"primaryVersion,omitempty"`
+
+	// PrimaryVersionState is the state of PrimaryVersion, e.g. ENABLED or
+	// DESTROY_SCHEDULED.
+	PrimaryVersionState string `json:"primaryVersionState,omitempty"`
+}
+
+// A CryptoKeySpec defines the desired state of a CryptoKey.
+type CryptoKeySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  CryptoKeyParameters `json:"forProvider"`
+}
+
+// A CryptoKeyStatus represents the observed state of a CryptoKey.
+type CryptoKeyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     CryptoKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CryptoKey is a managed resource that represents a Google Cloud KMS crypto
+// key. Its resource name is published to its connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PURPOSE",type="string",JSONPath=".spec.forProvider.purpose"
+// +kubebuilder:printcolumn:name="ROTATION",type="string",JSONPath=".spec.forProvider.rotationPeriod"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CryptoKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CryptoKeySpec   `json:"spec"`
+	Status CryptoKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CryptoKeyList contains a list of CryptoKey.
+type CryptoKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CryptoKey `json:"items"`
+}
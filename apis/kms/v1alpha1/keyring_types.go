@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// KeyRingParameters define the desired state of a Cloud KMS key ring. The ID
+// of the key ring is determined by the value of the
+// `crossplane.io/external-name` annotation. Key rings cannot be changed or
+// deleted once they have been created.
+// https://cloud.google.com/kms/docs/reference/rest/v1/projects.locations.keyRings
+type KeyRingParameters struct {
+	// Location of the key ring, e.g. global or us-central1.
+	// +immutable
+	Location string `json:"location"`
+}
+
+// KeyRingObservation is used to show the observed state of the KeyRing
+// resource on GCP.
+type KeyRingObservation struct {
+	// Name is the resource name of the key ring, in the form
+	// projects/{project}/locations/{location}/keyRings/{keyRing}.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the key ring was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+}
+
+// A KeyRingSpec defines the desired state of a KeyRing.
+type KeyRingSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  KeyRingParameters `json:"forProvider"`
+}
+
+// A KeyRingStatus represents the observed state of a KeyRing.
+type KeyRingStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     KeyRingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyRing is a managed resource that represents a Google Cloud KMS key
+// ring. GCP does not allow key rings to be deleted, so deleting a KeyRing
+// leaves the key ring in place.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type KeyRing struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyRingSpec   `json:"spec"`
+	Status KeyRingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyRingList contains a list of KeyRing.
+type KeyRingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyRing `json:"items"`
+}
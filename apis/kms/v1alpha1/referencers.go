@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// KeyRingName extracts the resource name of a KeyRing.
+func KeyRingName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		kr, ok := mg.(*KeyRing)
+		if !ok {
+			return ""
+		}
+		return kr.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this CryptoKey
+func (mg *CryptoKey) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.keyRing
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KeyRing),
+		Reference:    mg.Spec.ForProvider.KeyRingRef,
+		Selector:     mg.Spec.ForProvider.KeyRingSelector,
+		To:           reference.To{Managed: &KeyRing{}, List: &KeyRingList{}},
+		Extract:      KeyRingName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.KeyRing = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.KeyRingRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,347 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CryptoKey) DeepCopyInto(out *CryptoKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKey.
+func (in *CryptoKey) DeepCopy() *CryptoKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CryptoKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CryptoKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CryptoKeyList) DeepCopyInto(out *CryptoKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CryptoKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKeyList.
+func (in *CryptoKeyList) DeepCopy() *CryptoKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CryptoKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CryptoKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CryptoKeyObservation) DeepCopyInto(out *CryptoKeyObservation) {
+	*out = *in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKeyObservation.
+func (in *CryptoKeyObservation) DeepCopy() *CryptoKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CryptoKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CryptoKeyParameters) DeepCopyInto(out *CryptoKeyParameters) {
+	*out = *in
+	if in.KeyRing != nil {
+		in, out := &in.KeyRing, &out.KeyRing
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyRingRef != nil {
+		in, out := &in.KeyRingRef, &out.KeyRingRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.KeyRingSelector != nil {
+		in, out := &in.KeyRingSelector, &out.KeyRingSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Purpose != nil {
+		in, out := &in.Purpose, &out.Purpose
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationPeriod != nil {
+		in, out := &in.RotationPeriod, &out.RotationPeriod
+		*out = new(string)
+		**out = **in
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VersionTemplate != nil {
+		in, out := &in.VersionTemplate, &out.VersionTemplate
+		*out = new(CryptoKeyVersionTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestroyPrimaryVersionOnDeletion != nil {
+		in, out := &in.DestroyPrimaryVersionOnDeletion, &out.DestroyPrimaryVersionOnDeletion
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKeyParameters.
+func (in *CryptoKeyParameters) DeepCopy() *CryptoKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CryptoKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CryptoKeySpec) DeepCopyInto(out *CryptoKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKeySpec.
+func (in *CryptoKeySpec) DeepCopy() *CryptoKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CryptoKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CryptoKeyStatus) DeepCopyInto(out *CryptoKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKeyStatus.
+func (in *CryptoKeyStatus) DeepCopy() *CryptoKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CryptoKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CryptoKeyVersionTemplate) DeepCopyInto(out *CryptoKeyVersionTemplate) {
+	*out = *in
+	if in.ProtectionLevel != nil {
+		in, out := &in.ProtectionLevel, &out.ProtectionLevel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CryptoKeyVersionTemplate.
+func (in *CryptoKeyVersionTemplate) DeepCopy() *CryptoKeyVersionTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(CryptoKeyVersionTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRing) DeepCopyInto(out *KeyRing) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRing.
+func (in *KeyRing) DeepCopy() *KeyRing {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyRing) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRingList) DeepCopyInto(out *KeyRingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyRing, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRingList.
+func (in *KeyRingList) DeepCopy() *KeyRingList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyRingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRingObservation) DeepCopyInto(out *KeyRingObservation) {
+	*out = *in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRingObservation.
+func (in *KeyRingObservation) DeepCopy() *KeyRingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRingParameters) DeepCopyInto(out *KeyRingParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRingParameters.
+func (in *KeyRingParameters) DeepCopy() *KeyRingParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRingParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRingSpec) DeepCopyInto(out *KeyRingSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRingSpec.
+func (in *KeyRingSpec) DeepCopy() *KeyRingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyRingStatus) DeepCopyInto(out *KeyRingStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyRingStatus.
+func (in *KeyRingStatus) DeepCopy() *KeyRingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyRingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Values for ListPolicy AllValues.
+const (
+	AllValuesAllow = "ALLOW"
+	AllValuesDeny  = "DENY"
+)
+
+// PolicyParameters define the desired state of an Organization Policy.
+// Exactly one of BooleanPolicy, ListPolicy or RestoreDefault should be set.
+// https://cloud.google.com/resource-manager/reference/rest/v1/Policy
+type PolicyParameters struct {
+	// Parent is the resource the policy is attached to, in the form
+	// projects/{project_id}, folders/{folder_id} or
+	// organizations/{organization_id}.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^(projects|folders|organizations)/[^/]+$`
+	Parent string `json:"parent"`
+
+	// Constraint is the name of the constraint the policy configures, for
+	// example constraints/iam.disableServiceAccountCreation.
+	// +immutable
+	Constraint string `json:"constraint"`
+
+	// BooleanPolicy configures a boolean constraint.
+	// +optional
+	BooleanPolicy *BooleanPolicy `json:"booleanPolicy,omitempty"`
+
+	// ListPolicy configures a list constraint.
+	// +optional
+	ListPolicy *ListPolicy `json:"listPolicy,omitempty"`
+
+	// RestoreDefault restores the default behavior of the constraint,
+	// ignoring any policies set on ancestors of the parent. This differs
+	// from deleting the Policy, which causes the parent to inherit the
+	// policies of its ancestors.
+	// +optional
+	RestoreDefault *bool `json:"restoreDefault,omitempty"`
+}
+
+// BooleanPolicy configures a constraint that is either enforced or not.
+type BooleanPolicy struct {
+	// Enforced specifies whether the constraint is enforced.
+	Enforced bool `json:"enforced"`
+}
+
+// ListPolicy configures a constraint that allows or denies a list of values.
+type ListPolicy struct {
+	// AllowedValues that are allowed by this policy.
+	// +optional
+	AllowedValues []string `json:"allowedValues,omitempty"`
+
+	// DeniedValues that are denied by this policy.
+	// +optional
+	DeniedValues []string `json:"deniedValues,omitempty"`
+
+	// AllValues allows or denies all values. It may not be set together
+	// with AllowedValues or DeniedValues.
+	// +optional
+	// +kubebuilder:validation:Enum=ALLOW;DENY
+	AllValues *string `json:"allValues,omitempty"`
+
+	// SuggestedValue is the value that user interfaces should suggest when
+	// configuring the constrained resource.
+	// +optional
+	SuggestedValue *string `json:"suggestedValue,omitempty"`
+
+	// InheritFromParent specifies whether this policy is merged with the
+	// policy of the parent's ancestors. When false the policy replaces any
+	// inherited policy.
+	// +optional
+	InheritFromParent *bool `json:"inheritFromParent,omitempty"`
+}
+
+// PolicyObservation is used to show the observed state of the Policy
+// resource on GCP.
+type PolicyObservation struct {
+	// Etag is used for optimistic concurrency control when updating the
+	// policy.
+	Etag string `json:"etag,omitempty"`
+
+	// UpdateTime is the time the policy was last updated.
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+
+	// Version of the policy format.
+	Version int64 `json:"version,omitempty"`
+}
+
+// A PolicySpec defines the desired state of a Policy.
+type PolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  PolicyParameters `json:"forProvider"`
+}
+
+// A PolicyStatus represents the observed state of a Policy.
+type PolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     PolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Policy is a managed resource that represents a Google Cloud Organization
+// Policy set on a project, folder or organization.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PARENT",type="string",JSONPath=".spec.forProvider.parent"
+// +kubebuilder:printcolumn:name="CONSTRAINT",type="string",JSONPath=".spec.forProvider.constraint"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Policy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicySpec   `json:"spec"`
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyList contains a list of Policy.
+type PolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Policy `json:"items"`
+}
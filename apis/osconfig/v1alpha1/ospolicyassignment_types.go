@@ -0,0 +1,367 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Rollout states of an OS policy assignment revision as reported by the OS
+// Config API.
+const (
+	RolloutStateInProgress = "IN_PROGRESS"
+	RolloutStateCancelling = "CANCELLING"
+	RolloutStateCancelled  = "CANCELLED"
+	RolloutStateSucceeded  = "SUCCEEDED"
+)
+
+// OSPolicyAssignmentParameters define the desired state of an OS Config OS
+// policy assignment. The ID of the assignment is determined by the value of
+// the `crossplane.io/external-name` annotation.
+// https://cloud.google.com/compute/docs/osconfig/rest/v1/projects.locations.osPolicyAssignments
+type OSPolicyAssignmentParameters struct {
+	// Zone whose instances the assignment applies to, e.g. us-central1-a.
+	// +immutable
+	Zone string `json:"zone"`
+
+	// Description of the assignment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OSPolicies to apply to the targeted instances.
+	// +kubebuilder:validation:MinItems=1
+	OSPolicies []OSPolicy `json:"osPolicies"`
+
+	// InstanceFilter selects the instances the OS policies apply to.
+	InstanceFilter InstanceFilter `json:"instanceFilter"`
+
+	// Rollout controls how a new revision of the assignment, created by
+	// every change to it, is rolled out to the targeted instances.
+	Rollout Rollout `json:"rollout"`
+}
+
+// An OSPolicy is a set of resources that are configured on the instances it
+// applies to.
+type OSPolicy struct {
+	// ID of the OS policy, unique within the assignment.
+	ID string `json:"id"`
+
+	// Description of the OS policy.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Mode in which the OS policy is applied. VALIDATION only reports
+	// whether instances are compliant, ENFORCEMENT also makes them so.
+	// +kubebuilder:validation:Enum=VALIDATION;ENFORCEMENT
+	Mode string `json:"mode"`
+
+	// ResourceGroups of the OS policy. The first group whose inventory
+	// filters match an instance is applied to it.
+	// +kubebuilder:validation:MinItems=1
+	ResourceGroups []OSPolicyResourceGroup `json:"resourceGroups"`
+
+	// AllowNoResourceGroupMatch reports instances that match none of the
+	// resource groups as compliant rather than as non-compliant.
+	// +optional
+	AllowNoResourceGroupMatch *bool `json:"allowNoResourceGroupMatch,omitempty"`
+}
+
+// An OSPolicyResourceGroup is a set of resources that applies to instances
+// running one of a set of operating systems.
+type OSPolicyResourceGroup struct {
+	// InventoryFilters select the operating systems the group applies to.
+	// A group without filters applies to all instances.
+	// +optional
+	InventoryFilters []InventoryFilter `json:"inventoryFilters,omitempty"`
+
+	// Resources to configure on the instances, in order.
+	// +kubebuilder:validation:MinItems=1
+	Resources []OSPolicyResource `json:"resources"`
+}
+
+// An InventoryFilter matches instances by their operating system, as
+// reported by the OS inventory.
+type InventoryFilter struct {
+	// OSShortName is the short name of the operating system, e.g. debian.
+	OSShortName string `json:"osShortName"`
+
+	// OSVersion of the operating system. Wildcards are supported, e.g. 10.*.
+	// All versions match if it is omitted.
+	// +optional
+	OSVersion *string `json:"osVersion,omitempty"`
+}
+
+// An OSPolicyResource is a package or a package repository. Exactly one of
+// Package and Repository must be specified.
+type OSPolicyResource struct {
+	// ID of the resource, unique within the OS policy.
+	ID string `json:"id"`
+
+	// Package to install or remove.
+	// +optional
+	Package *PackageResource `json:"pkg,omitempty"`
+
+	// Repository to configure for a package manager.
+	// +optional
+	Repository *RepositoryResource `json:"repository,omitempty"`
+}
+
+// A PackageResource is a package managed by one of the package managers of
+// the instance. Exactly one package manager must be specified.
+type PackageResource struct {
+	// DesiredState of the package.
+	// +kubebuilder:validation:Enum=INSTALLED;REMOVED
+	DesiredState string `json:"desiredState"`
+
+	// Apt package.
+	// +optional
+	Apt *PackageName `json:"apt,omitempty"`
+
+	// Yum package.
+	// +optional
+	Yum *PackageName `json:"yum,omitempty"`
+
+	// Zypper package.
+	// +optional
+	Zypper *PackageName `json:"zypper,omitempty"`
+
+	// GooGet package.
+	// +optional
+	GooGet *PackageName `json:"googet,omitempty"`
+}
+
+// A PackageName identifies a package in the repositories of a package
+// manager.
+type PackageName struct {
+	// Name of the package.
+	Name string `json:"name"`
+}
+
+// A RepositoryResource is a package repository. Exactly one kind of
+// repository must be specified.
+type RepositoryResource struct {
+	// Apt repository.
+	// +optional
+	Apt *AptRepository `json:"apt,omitempty"`
+
+	// Yum repository.
+	// +optional
+	Yum *YumRepository `json:"yum,omitempty"`
+
+	// Zypper repository.
+	// +optional
+	Zypper *ZypperRepository `json:"zypper,omitempty"`
+
+	// Goo repository.
+	// +optional
+	Goo *GooRepository `json:"goo,omitempty"`
+}
+
+// An AptRepository is a repository for the apt package manager.
+type AptRepository struct {
+	// ArchiveType of the repository.
+	// +kubebuilder:validation:Enum=DEB;DEB_SRC
+	ArchiveType string `json:"archiveType"`
+
+	// URI of the repository.
+	URI string `json:"uri"`
+
+	// Distribution of the repository, e.g. buster.
+	Distribution string `json:"distribution"`
+
+	// Components of the distribution, e.g. main.
+	// +kubebuilder:validation:MinItems=1
+	Components []string `json:"components"`
+
+	// GPGKey is the URI of the key the repository is signed with.
+	// +optional
+	GPGKey *string `json:"gpgKey,omitempty"`
+}
+
+// A YumRepository is a repository for the yum package manager.
+type YumRepository struct {
+	// ID of the repository.
+	ID string `json:"id"`
+
+	// DisplayName of the repository.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// BaseURL of the repository.
+	BaseURL string `json:"baseUrl"`
+
+	// GPGKeys are the URIs of the keys the repository is signed with.
+	// +optional
+	GPGKeys []string `json:"gpgKeys,omitempty"`
+}
+
+// A ZypperRepository is a repository for the zypper package manager.
+type ZypperRepository struct {
+	// ID of the repository.
+	ID string `json:"id"`
+
+	// DisplayName of the repository.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// BaseURL of the repository.
+	BaseURL string `json:"baseUrl"`
+
+	// GPGKeys are the URIs of the keys the repository is signed with.
+	// +optional
+	GPGKeys []string `json:"gpgKeys,omitempty"`
+}
+
+// A GooRepository is a repository for the GooGet package manager.
+type GooRepository struct {
+	// Name of the repository.
+	Name string `json:"name"`
+
+	// URL of the repository.
+	URL string `json:"url"`
+}
+
+// An InstanceFilter selects instances by their labels and operating
+// systems. Instances must match all of the supplied criteria.
+type InstanceFilter struct {
+	// All targets all instances of the zone. The other criteria must be
+	// omitted if it is true.
+	// +optional
+	All *bool `json:"all,omitempty"`
+
+	// InclusionLabels select instances that have all of the labels of any
+	// of the label sets.
+	// +optional
+	InclusionLabels []LabelSet `json:"inclusionLabels,omitempty"`
+
+	// ExclusionLabels exclude instances that have all of the labels of any
+	// of the label sets.
+	// +optional
+	ExclusionLabels []LabelSet `json:"exclusionLabels,omitempty"`
+
+	// Inventories select instances running any of the operating systems.
+	// +optional
+	Inventories []InventoryFilter `json:"inventories,omitempty"`
+}
+
+// A LabelSet is a set of instance labels.
+type LabelSet struct {
+	// Labels that an instance must have.
+	Labels map[string]string `json:"labels"`
+}
+
+// A Rollout controls the pace at which a revision of an assignment is rolled
+// out across the zone.
+type Rollout struct {
+	// DisruptionBudget limits the number of instances that may be rolled
+	// out to at the same time.
+	DisruptionBudget FixedOrPercent `json:"disruptionBudget"`
+
+	// MinWaitDuration is how long to wait after the OS policies were applied
+	// to an instance before it is considered rolled out, e.g. 60s.
+	MinWaitDuration string `json:"minWaitDuration"`
+}
+
+// A FixedOrPercent is either a fixed number of instances or a percentage of
+// the targeted instances. Exactly one must be specified.
+type FixedOrPercent struct {
+	// Fixed number of instances.
+	// +optional
+	Fixed *int32 `json:"fixed,omitempty"`
+
+	// Percent of the targeted instances.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *int32 `json:"percent,omitempty"`
+}
+
+// OSPolicyAssignmentObservation is used to show the observed state of the
+// OSPolicyAssignment resource on GCP.
+type OSPolicyAssignmentObservation struct {
+	// Name is the resource name of the assignment, in the form
+	// projects/{project}/locations/{zone}/osPolicyAssignments/{assignment}.
+	Name string `json:"name,omitempty"`
+
+	// RevisionID of the current revision of the assignment.
+	RevisionID string `json:"revisionId,omitempty"`
+
+	// RevisionCreateTime is the time the current revision was created.
+	RevisionCreateTime *metav1.Time `json:"revisionCreateTime,omitempty"`
+
+	// RolloutState of the current revision.
+	RolloutState string `json:"rolloutState,omitempty"`
+
+	// Baseline is true if the current revision is the last one that was
+	// rolled out successfully.
+	Baseline bool `json:"baseline,omitempty"`
+
+	// Reconciling is true while a revision is being rolled out.
+	Reconciling bool `json:"reconciling,omitempty"`
+
+	// Etag of the current revision.
+	Etag string `json:"etag,omitempty"`
+
+	// UID of the assignment.
+	UID string `json:"uid,omitempty"`
+}
+
+// An OSPolicyAssignmentSpec defines the desired state of an
+// OSPolicyAssignment.
+type OSPolicyAssignmentSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  OSPolicyAssignmentParameters `json:"forProvider"`
+}
+
+// An OSPolicyAssignmentStatus represents the observed state of an
+// OSPolicyAssignment.
+type OSPolicyAssignmentStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     OSPolicyAssignmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OSPolicyAssignment is a managed resource that represents a Google OS
+// Config OS policy assignment, which configures packages and package
+// repositories on the Compute Engine instances of a zone. The assignment is
+// not Ready while a revision is rolling out.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ZONE",type="string",JSONPath=".spec.forProvider.zone"
+// +kubebuilder:printcolumn:name="ROLLOUT",type="string",JSONPath=".status.atProvider.rolloutState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type OSPolicyAssignment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OSPolicyAssignmentSpec   `json:"spec"`
+	Status OSPolicyAssignmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OSPolicyAssignmentList contains a list of OSPolicyAssignment.
+type OSPolicyAssignmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OSPolicyAssignment `json:"items"`
+}
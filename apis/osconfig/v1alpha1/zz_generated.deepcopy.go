@@ -0,0 +1,551 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AptRepository) DeepCopyInto(out *AptRepository) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GPGKey != nil {
+		in, out := &in.GPGKey, &out.GPGKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AptRepository.
+func (in *AptRepository) DeepCopy() *AptRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(AptRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedOrPercent) DeepCopyInto(out *FixedOrPercent) {
+	*out = *in
+	if in.Fixed != nil {
+		in, out := &in.Fixed, &out.Fixed
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedOrPercent.
+func (in *FixedOrPercent) DeepCopy() *FixedOrPercent {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedOrPercent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GooRepository) DeepCopyInto(out *GooRepository) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GooRepository.
+func (in *GooRepository) DeepCopy() *GooRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(GooRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceFilter) DeepCopyInto(out *InstanceFilter) {
+	*out = *in
+	if in.All != nil {
+		in, out := &in.All, &out.All
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InclusionLabels != nil {
+		in, out := &in.InclusionLabels, &out.InclusionLabels
+		*out = make([]LabelSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExclusionLabels != nil {
+		in, out := &in.ExclusionLabels, &out.ExclusionLabels
+		*out = make([]LabelSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Inventories != nil {
+		in, out := &in.Inventories, &out.Inventories
+		*out = make([]InventoryFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceFilter.
+func (in *InstanceFilter) DeepCopy() *InstanceFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryFilter) DeepCopyInto(out *InventoryFilter) {
+	*out = *in
+	if in.OSVersion != nil {
+		in, out := &in.OSVersion, &out.OSVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryFilter.
+func (in *InventoryFilter) DeepCopy() *InventoryFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelSet) DeepCopyInto(out *LabelSet) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelSet.
+func (in *LabelSet) DeepCopy() *LabelSet {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPolicy) DeepCopyInto(out *OSPolicy) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]OSPolicyResourceGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowNoResourceGroupMatch != nil {
+		in, out := &in.AllowNoResourceGroupMatch, &out.AllowNoResourceGroupMatch
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPolicy.
+func (in *OSPolicy) DeepCopy() *OSPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPolicyAssignment) DeepCopyInto(out *OSPolicyAssignment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPolicyAssignment.
+func (in *OSPolicyAssignment) DeepCopy() *OSPolicyAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPolicyAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OSPolicyAssignment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPolicyAssignmentList) DeepCopyInto(out *OSPolicyAssignmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OSPolicyAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPolicyAssignmentList.
+func (in *OSPolicyAssignmentList) DeepCopy() *OSPolicyAssignmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPolicyAssignmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OSPolicyAssignmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPolicyAssignmentObservation) DeepCopyInto(out *OSPolicyAssignmentObservation) {
+	*out = *in
+	if in.RevisionCreateTime != nil {
+		in, out := &in.RevisionCreateTime, &out.RevisionCreateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPolicyAssignmentObservation.
+func (in *OSPolicyAssignmentObservation) DeepCopy() *OSPolicyAssignmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPolicyAssignmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPolicyAssignmentParameters) DeepCopyInto(out *OSPolicyAssignmentParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.OSPolicies != nil {
+		in, out := &in.OSPolicies, &out.OSPolicies
+		*out = make([]OSPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.InstanceFilter.DeepCopyInto(&out.InstanceFilter)
+	in.Rollout.DeepCopyInto(&out.Rollout)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPolicyAssignmentParameters.
+func (in *OSPolicyAssignmentParameters) DeepCopy() *OSPolicyAssignmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPolicyAssignmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPolicyAssignmentSpec) DeepCopyInto(out *OSPolicyAssignmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPolicyAssignmentSpec.
+func (in *OSPolicyAssignmentSpec) DeepCopy() *OSPolicyAssignmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPolicyAssignmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPolicyAssignmentStatus) DeepCopyInto(out *OSPolicyAssignmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPolicyAssignmentStatus.
+func (in *OSPolicyAssignmentStatus) DeepCopy() *OSPolicyAssignmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPolicyAssignmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPolicyResource) DeepCopyInto(out *OSPolicyResource) {
+	*out = *in
+	if in.Package != nil {
+		in, out := &in.Package, &out.Package
+		*out = new(PackageResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(RepositoryResource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPolicyResource.
+func (in *OSPolicyResource) DeepCopy() *OSPolicyResource {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPolicyResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSPolicyResourceGroup) DeepCopyInto(out *OSPolicyResourceGroup) {
+	*out = *in
+	if in.InventoryFilters != nil {
+		in, out := &in.InventoryFilters, &out.InventoryFilters
+		*out = make([]InventoryFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]OSPolicyResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSPolicyResourceGroup.
+func (in *OSPolicyResourceGroup) DeepCopy() *OSPolicyResourceGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(OSPolicyResourceGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageName) DeepCopyInto(out *PackageName) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageName.
+func (in *PackageName) DeepCopy() *PackageName {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PackageResource) DeepCopyInto(out *PackageResource) {
+	*out = *in
+	if in.Apt != nil {
+		in, out := &in.Apt, &out.Apt
+		*out = new(PackageName)
+		**out = **in
+	}
+	if in.Yum != nil {
+		in, out := &in.Yum, &out.Yum
+		*out = new(PackageName)
+		**out = **in
+	}
+	if in.Zypper != nil {
+		in, out := &in.Zypper, &out.Zypper
+		*out = new(PackageName)
+		**out = **in
+	}
+	if in.GooGet != nil {
+		in, out := &in.GooGet, &out.GooGet
+		*out = new(PackageName)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PackageResource.
+func (in *PackageResource) DeepCopy() *PackageResource {
+	if in == nil {
+		return nil
+	}
+	out := new(PackageResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryResource) DeepCopyInto(out *RepositoryResource) {
+	*out = *in
+	if in.Apt != nil {
+		in, out := &in.Apt, &out.Apt
+		*out = new(AptRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Yum != nil {
+		in, out := &in.Yum, &out.Yum
+		*out = new(YumRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zypper != nil {
+		in, out := &in.Zypper, &out.Zypper
+		*out = new(ZypperRepository)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Goo != nil {
+		in, out := &in.Goo, &out.Goo
+		*out = new(GooRepository)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryResource.
+func (in *RepositoryResource) DeepCopy() *RepositoryResource {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollout) DeepCopyInto(out *Rollout) {
+	*out = *in
+	in.DisruptionBudget.DeepCopyInto(&out.DisruptionBudget)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollout.
+func (in *Rollout) DeepCopy() *Rollout {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YumRepository) DeepCopyInto(out *YumRepository) {
+	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GPGKeys != nil {
+		in, out := &in.GPGKeys, &out.GPGKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YumRepository.
+func (in *YumRepository) DeepCopy() *YumRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(YumRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZypperRepository) DeepCopyInto(out *ZypperRepository) {
+	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+	if in.GPGKeys != nil {
+		in, out := &in.GPGKeys, &out.GPGKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZypperRepository.
+func (in *ZypperRepository) DeepCopy() *ZypperRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(ZypperRepository)
+	in.DeepCopyInto(out)
+	return out
+}
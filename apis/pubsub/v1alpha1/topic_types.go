@@ -25,9 +25,16 @@ import (
 // Keys used in connection secret.
 const (
 	ConnectionSecretKeyTopic       = "topic"
+	ConnectionSecretKeyTopicName   = "topicName"
 	ConnectionSecretKeyProjectName = "projectName"
 )
 
+// Encodings of messages validated against a schema.
+const (
+	EncodingJSON   = "JSON"
+	EncodingBinary = "BINARY"
+)
+
 // TopicParameters defines parameters for a desired PubSub Topic.
 type TopicParameters struct {
 	// Labels are used as additional metadata on Topic.
@@ -48,6 +55,34 @@ type TopicParameters struct {
 	// +optional
 	// +immutable
 	KmsKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// MessageRetentionDuration is how long messages published to the topic
+	// are retained, from 10 minutes to 31 days, for example "86400s".
+	// Subscriptions may seek back to any retained message. If not present,
+	// messages are retained only until they are acknowledged by every
+	// subscription.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]{1,9})?s$`
+	MessageRetentionDuration *string `json:"messageRetentionDuration,omitempty"`
+
+	// SchemaSettings are the schema that messages published to the topic are
+	// validated against. If not present, messages are not validated.
+	// +optional
+	SchemaSettings *SchemaSettings `json:"schemaSettings,omitempty"`
+}
+
+// SchemaSettings contains configuration for validating messages against a
+// schema.
+type SchemaSettings struct {
+	// Schema is the resource name of the schema that messages are validated
+	// against, in the format `projects/*/schemas/*`.
+	// +kubebuilder:validation:Pattern=`^projects/[^/]+/schemas/[^/]+$`
+	Schema string `json:"schema"`
+
+	// Encoding of messages validated against the schema.
+	// +optional
+	// +kubebuilder:validation:Enum=JSON;BINARY
+	Encoding *string `json:"encoding,omitempty"`
 }
 
 // MessageStoragePolicy contains configuration for message storage policy.
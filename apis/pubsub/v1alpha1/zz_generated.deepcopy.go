@@ -44,6 +44,26 @@ func (in *MessageStoragePolicy) DeepCopy() *MessageStoragePolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaSettings) DeepCopyInto(out *SchemaSettings) {
+	*out = *in
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaSettings.
+func (in *SchemaSettings) DeepCopy() *SchemaSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Topic) DeepCopyInto(out *Topic) {
 	*out = *in
@@ -123,6 +143,16 @@ func (in *TopicParameters) DeepCopyInto(out *TopicParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MessageRetentionDuration != nil {
+		in, out := &in.MessageRetentionDuration, &out.MessageRetentionDuration
+		*out = new(string)
+		**out = **in
+	}
+	if in.SchemaSettings != nil {
+		in, out := &in.SchemaSettings, &out.SchemaSettings
+		*out = new(SchemaSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicParameters.
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LiteReservationParameters define the desired state of a Pub/Sub Lite
+// reservation. The ID of the reservation is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/pubsub/lite/docs/reference/rest/v1/admin.projects.locations.reservations
+type LiteReservationParameters struct {
+	// Region in which the reservation is created, e.g. us-central1.
+	// Reservations are regional and may only be used by topics in the same
+	// region or its zones.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[a-z]+-[a-z]+[0-9]+$`
+	Region string `json:"region"`
+
+	// ThroughputCapacity is the reserved throughput capacity. Every unit of
+	// capacity is equivalent to 1 MiB/s of published messages or 2 MiB/s of
+	// subscribed messages.
+	// +kubebuilder:validation:Minimum=1
+	ThroughputCapacity int64 `json:"throughputCapacity"`
+}
+
+// LiteReservationObservation is used to show the observed state of the
+// LiteReservation resource on GCP.
+type LiteReservationObservation struct {
+	// Name is the resource name of the reservation, in the form
+	// projects/{project}/locations/{region}/reservations/{reservation}.
+	Name string `json:"name,omitempty"`
+}
+
+// A LiteReservationSpec defines the desired state of a LiteReservation.
+type LiteReservationSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LiteReservationParameters `json:"forProvider"`
+}
+
+// A LiteReservationStatus represents the observed state of a
+// LiteReservation.
+type LiteReservationStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LiteReservationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LiteReservation is a managed resource that represents a Google Pub/Sub
+// Lite reservation, which provides throughput capacity to the topics that
+// use it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.forProvider.region"
+// +kubebuilder:printcolumn:name="CAPACITY",type="integer",JSONPath=".spec.forProvider.throughputCapacity"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type LiteReservation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LiteReservationSpec   `json:"spec"`
+	Status LiteReservationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LiteReservationList contains a list of LiteReservation.
+type LiteReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LiteReservation `json:"items"`
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Delivery requirements of a LiteSubscription.
+const (
+	DeliverImmediately = "DELIVER_IMMEDIATELY"
+	DeliverAfterStored = "DELIVER_AFTER_STORED"
+)
+
+// LiteSubscriptionParameters define the desired state of a Pub/Sub Lite
+// subscription. The ID of the subscription is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/pubsub/lite/docs/reference/rest/v1/admin.projects.locations.subscriptions
+type LiteSubscriptionParameters struct {
+	// Location of the subscription, which must be the location of its topic.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[a-z]+-[a-z]+[0-9]+(-[a-z])?$`
+	Location string `json:"location"`
+
+	// Topic is the resource name of the topic the subscription is attached
+	// to, in the form projects/{project}/locations/{location}/topics/{topic}.
+	// +optional
+	// +immutable
+	Topic *string `json:"topic,omitempty"`
+
+	// TopicRef references a LiteTopic and retrieves its resource name.
+	// +optional
+	// +immutable
+	TopicRef *runtimev1alpha1.Reference `json:"topicRef,omitempty"`
+
+	// TopicSelector selects a reference to a LiteTopic.
+	// +optional
+	// +immutable
+	TopicSelector *runtimev1alpha1.Selector `json:"topicSelector,omitempty"`
+
+	// DeliveryRequirement controls when messages are delivered to
+	// subscribers: immediately after they are published, or only once they
+	// are durably stored.
+	// +optional
+	// +kubebuilder:validation:Enum=DELIVER_IMMEDIATELY;DELIVER_AFTER_STORED
+	DeliveryRequirement *string `json:"deliveryRequirement,omitempty"`
+}
+
+// LiteSubscriptionObservation is used to show the observed state of the
+// LiteSubscription resource on GCP.
+type LiteSubscriptionObservation struct {
+	// Name is the resource name of the subscription, in the form
+	// projects/{project}/locations/{location}/subscriptions/{subscription}.
+	Name string `json:"name,omitempty"`
+}
+
+// A LiteSubscriptionSpec defines the desired state of a LiteSubscription.
+type LiteSubscriptionSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LiteSubscriptionParameters `json:"forProvider"`
+}
+
+// A LiteSubscriptionStatus represents the observed state of a
+// LiteSubscription.
+type LiteSubscriptionStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LiteSubscriptionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LiteSubscription is a managed resource that represents a Google Pub/Sub
+// Lite subscription.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type LiteSubscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LiteSubscriptionSpec   `json:"spec"`
+	Status LiteSubscriptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LiteSubscriptionList contains a list of LiteSubscription.
+type LiteSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LiteSubscription `json:"items"`
+}
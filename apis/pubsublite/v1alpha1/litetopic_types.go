@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LiteTopicParameters define the desired state of a Pub/Sub Lite topic. The
+// ID of the topic is determined by the value of the
+// `crossplane.io/external-name` annotation.
+// https://cloud.google.com/pubsub/lite/docs/reference/rest/v1/admin.projects.locations.topics
+type LiteTopicParameters struct {
+	// Location of the topic. Unlike standard Pub/Sub topics, Lite topics are
+	// not global; a zone (e.g. us-central1-a) creates a zonal topic and a
+	// region (e.g. us-central1) creates a regional topic.
+	// +immutable
+	// +kubebuilder:validation:Pattern=`^[a-z]+-[a-z]+[0-9]+(-[a-z])?$`
+	Location string `json:"location"`
+
+	// PartitionConfig configures the partitions of the topic.
+	PartitionConfig LitePartitionConfig `json:"partitionConfig"`
+
+	// RetentionConfig configures how long messages are retained.
+	// +optional
+	RetentionConfig *LiteRetentionConfig `json:"retentionConfig,omitempty"`
+
+	// ReservationConfig configures the reservation that provides the
+	// throughput capacity of the topic.
+	// +optional
+	ReservationConfig *LiteReservationConfig `json:"reservationConfig,omitempty"`
+}
+
+// LitePartitionConfig configures the partitions of a LiteTopic.
+type LitePartitionConfig struct {
+	// Count is the number of partitions in the topic. The number of
+	// partitions may be increased but never decreased.
+	// +kubebuilder:validation:Minimum=1
+	Count int64 `json:"count"`
+
+	// Capacity is the throughput capacity of each partition.
+	// +optional
+	Capacity *LitePartitionCapacity `json:"capacity,omitempty"`
+}
+
+// LitePartitionCapacity is the throughput capacity of a partition.
+type LitePartitionCapacity struct {
+	// PublishMiBPerSec is the publish throughput capacity per partition in
+	// MiB/s. Must be between 4 and 16.
+	// +kubebuilder:validation:Minimum=4
+	// +kubebuilder:validation:Maximum=16
+	PublishMiBPerSec int64 `json:"publishMibPerSec"`
+
+	// SubscribeMiBPerSec is the subscribe throughput capacity per partition
+	// in MiB/s. Must be between 4 and 32.
+	// +kubebuilder:validation:Minimum=4
+	// +kubebuilder:validation:Maximum=32
+	SubscribeMiBPerSec int64 `json:"subscribeMibPerSec"`
+}
+
+// LiteRetentionConfig configures how long the messages of a LiteTopic are
+// retained.
+type LiteRetentionConfig struct {
+	// PerPartitionBytes is the provisioned storage, in bytes, per partition.
+	// Once exceeded the oldest messages are deleted.
+	// +optional
+	PerPartitionBytes *int64 `json:"perPartitionBytes,omitempty"`
+
+	// Period is how long a published message is retained, as a duration in
+	// seconds such as "86400s". Messages are retained until storage is
+	// exhausted if it is omitted.
+	// +optional
+	Period *string `json:"period,omitempty"`
+}
+
+// LiteReservationConfig configures the reservation of a LiteTopic.
+type LiteReservationConfig struct {
+	// ThroughputReservation is the resource name of the reservation that
+	// provides the throughput capacity of the topic, in the form
+	// projects/{project}/locations/{region}/reservations/{reservation}.
+	// +optional
+	ThroughputReservation *string `json:"throughputReservation,omitempty"`
+
+	// ThroughputReservationRef references a LiteReservation and retrieves
+	// its resource name.
+	// +optional
+	ThroughputReservationRef *runtimev1alpha1.Reference `json:"throughputReservationRef,omitempty"`
+
+	// ThroughputReservationSelector selects a reference to a
+	// LiteReservation.
+	// +optional
+	ThroughputReservationSelector *runtimev1alpha1.Selector `json:"throughputReservationSelector,omitempty"`
+}
+
+// LiteTopicObservation is used to show the observed state of the LiteTopic
+// resource on GCP.
+type LiteTopicObservation struct {
+	// Name is the resource name of the topic, in the form
+	// projects/{project}/locations/{location}/topics/{topic}.
+	Name string `json:"name,omitempty"`
+}
+
+// A LiteTopicSpec defines the desired state of a LiteTopic.
+type LiteTopicSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  LiteTopicParameters `json:"forProvider"`
+}
+
+// A LiteTopicStatus represents the observed state of a LiteTopic.
+type LiteTopicStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     LiteTopicObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LiteTopic is a managed resource that represents a Google Pub/Sub Lite
+// topic.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="PARTITIONS",type="integer",JSONPath=".spec.forProvider.partitionConfig.count"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type LiteTopic struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LiteTopicSpec   `json:"spec"`
+	Status LiteTopicStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LiteTopicList contains a list of LiteTopic.
+type LiteTopicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LiteTopic `json:"items"`
+}
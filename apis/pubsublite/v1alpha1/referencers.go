@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// LiteTopicName extracts the resource name of a LiteTopic.
+func LiteTopicName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		t, ok := mg.(*LiteTopic)
+		if !ok {
+			return ""
+		}
+		return t.Status.AtProvider.Name
+	}
+}
+
+// LiteReservationName extracts the resource name of a LiteReservation.
+func LiteReservationName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*LiteReservation)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this LiteTopic
+func (mg *LiteTopic) ResolveReferences(ctx context.Context, c client.Reader) error {
+	rc := mg.Spec.ForProvider.ReservationConfig
+	if rc == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.reservationConfig.throughputReservation
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(rc.ThroughputReservation),
+		Reference:    rc.ThroughputReservationRef,
+		Selector:     rc.ThroughputReservationSelector,
+		To:           reference.To{Managed: &LiteReservation{}, List: &LiteReservationList{}},
+		Extract:      LiteReservationName(),
+	})
+	if err != nil {
+		return err
+	}
+	rc.ThroughputReservation = reference.ToPtrValue(rsp.ResolvedValue)
+	rc.ThroughputReservationRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this LiteSubscription
+func (mg *LiteSubscription) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.topic
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Topic),
+		Reference:    mg.Spec.ForProvider.TopicRef,
+		Selector:     mg.Spec.ForProvider.TopicSelector,
+		To:           reference.To{Managed: &LiteTopic{}, List: &LiteTopicList{}},
+		Extract:      LiteTopicName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.Topic = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TopicRef = rsp.ResolvedReference
+
+	return nil
+}
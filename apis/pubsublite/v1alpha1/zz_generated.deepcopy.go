@@ -0,0 +1,516 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LitePartitionCapacity) DeepCopyInto(out *LitePartitionCapacity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LitePartitionCapacity.
+func (in *LitePartitionCapacity) DeepCopy() *LitePartitionCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(LitePartitionCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LitePartitionConfig) DeepCopyInto(out *LitePartitionConfig) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(LitePartitionCapacity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LitePartitionConfig.
+func (in *LitePartitionConfig) DeepCopy() *LitePartitionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LitePartitionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteReservation) DeepCopyInto(out *LiteReservation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteReservation.
+func (in *LiteReservation) DeepCopy() *LiteReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LiteReservation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteReservationConfig) DeepCopyInto(out *LiteReservationConfig) {
+	*out = *in
+	if in.ThroughputReservation != nil {
+		in, out := &in.ThroughputReservation, &out.ThroughputReservation
+		*out = new(string)
+		**out = **in
+	}
+	if in.ThroughputReservationRef != nil {
+		in, out := &in.ThroughputReservationRef, &out.ThroughputReservationRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.ThroughputReservationSelector != nil {
+		in, out := &in.ThroughputReservationSelector, &out.ThroughputReservationSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteReservationConfig.
+func (in *LiteReservationConfig) DeepCopy() *LiteReservationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteReservationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteReservationList) DeepCopyInto(out *LiteReservationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LiteReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteReservationList.
+func (in *LiteReservationList) DeepCopy() *LiteReservationList {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteReservationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LiteReservationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteReservationObservation) DeepCopyInto(out *LiteReservationObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteReservationObservation.
+func (in *LiteReservationObservation) DeepCopy() *LiteReservationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteReservationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteReservationParameters) DeepCopyInto(out *LiteReservationParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteReservationParameters.
+func (in *LiteReservationParameters) DeepCopy() *LiteReservationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteReservationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteReservationSpec) DeepCopyInto(out *LiteReservationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteReservationSpec.
+func (in *LiteReservationSpec) DeepCopy() *LiteReservationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteReservationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteReservationStatus) DeepCopyInto(out *LiteReservationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteReservationStatus.
+func (in *LiteReservationStatus) DeepCopy() *LiteReservationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteReservationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteRetentionConfig) DeepCopyInto(out *LiteRetentionConfig) {
+	*out = *in
+	if in.PerPartitionBytes != nil {
+		in, out := &in.PerPartitionBytes, &out.PerPartitionBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteRetentionConfig.
+func (in *LiteRetentionConfig) DeepCopy() *LiteRetentionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteRetentionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteSubscription) DeepCopyInto(out *LiteSubscription) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteSubscription.
+func (in *LiteSubscription) DeepCopy() *LiteSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LiteSubscription) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteSubscriptionList) DeepCopyInto(out *LiteSubscriptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LiteSubscription, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteSubscriptionList.
+func (in *LiteSubscriptionList) DeepCopy() *LiteSubscriptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteSubscriptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LiteSubscriptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteSubscriptionObservation) DeepCopyInto(out *LiteSubscriptionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteSubscriptionObservation.
+func (in *LiteSubscriptionObservation) DeepCopy() *LiteSubscriptionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteSubscriptionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteSubscriptionParameters) DeepCopyInto(out *LiteSubscriptionParameters) {
+	*out = *in
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = new(string)
+		**out = **in
+	}
+	if in.TopicRef != nil {
+		in, out := &in.TopicRef, &out.TopicRef
+		*out = new(corev1alpha1.Reference)
+		**out = **in
+	}
+	if in.TopicSelector != nil {
+		in, out := &in.TopicSelector, &out.TopicSelector
+		*out = new(corev1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeliveryRequirement != nil {
+		in, out := &in.DeliveryRequirement, &out.DeliveryRequirement
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteSubscriptionParameters.
+func (in *LiteSubscriptionParameters) DeepCopy() *LiteSubscriptionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteSubscriptionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteSubscriptionSpec) DeepCopyInto(out *LiteSubscriptionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteSubscriptionSpec.
+func (in *LiteSubscriptionSpec) DeepCopy() *LiteSubscriptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteSubscriptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteSubscriptionStatus) DeepCopyInto(out *LiteSubscriptionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteSubscriptionStatus.
+func (in *LiteSubscriptionStatus) DeepCopy() *LiteSubscriptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteSubscriptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteTopic) DeepCopyInto(out *LiteTopic) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteTopic.
+func (in *LiteTopic) DeepCopy() *LiteTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteTopic)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LiteTopic) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteTopicList) DeepCopyInto(out *LiteTopicList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LiteTopic, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteTopicList.
+func (in *LiteTopicList) DeepCopy() *LiteTopicList {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteTopicList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LiteTopicList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteTopicObservation) DeepCopyInto(out *LiteTopicObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteTopicObservation.
+func (in *LiteTopicObservation) DeepCopy() *LiteTopicObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteTopicObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteTopicParameters) DeepCopyInto(out *LiteTopicParameters) {
+	*out = *in
+	in.PartitionConfig.DeepCopyInto(&out.PartitionConfig)
+	if in.RetentionConfig != nil {
+		in, out := &in.RetentionConfig, &out.RetentionConfig
+		*out = new(LiteRetentionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReservationConfig != nil {
+		in, out := &in.ReservationConfig, &out.ReservationConfig
+		*out = new(LiteReservationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteTopicParameters.
+func (in *LiteTopicParameters) DeepCopy() *LiteTopicParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteTopicParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteTopicSpec) DeepCopyInto(out *LiteTopicSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteTopicSpec.
+func (in *LiteTopicSpec) DeepCopy() *LiteTopicSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteTopicSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiteTopicStatus) DeepCopyInto(out *LiteTopicStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiteTopicStatus.
+func (in *LiteTopicStatus) DeepCopy() *LiteTopicStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LiteTopicStatus)
+	in.DeepCopyInto(out)
+	return out
+}
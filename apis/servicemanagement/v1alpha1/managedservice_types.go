@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Rollout statuses as reported by the Service Management API.
+const (
+	RolloutStatusInProgress       = "IN_PROGRESS"
+	RolloutStatusSuccess          = "SUCCESS"
+	RolloutStatusCancelled        = "CANCELLED"
+	RolloutStatusFailed           = "FAILED"
+	RolloutStatusPending          = "PENDING"
+	RolloutStatusFailedRolledBack = "FAILED_ROLLED_BACK"
+)
+
+// ManagedServiceParameters define the desired state of a Service Management
+// service, such as one that describes a Cloud Endpoints API. The name of the
+// service, e.g. my-api.endpoints.my-project.cloud.goog, is determined by the
+// value of the `crossplane.io/external-name` annotation.
+// https://cloud.google.com/service-infrastructure/docs/service-management/reference/rest/v1/services
+type ManagedServiceParameters struct {
+	// ProducerProjectID is the project that owns the service. Defaults to
+	// the project of the Provider.
+	// +optional
+	// +immutable
+	ProducerProjectID *string `json:"producerProjectId,omitempty"`
+
+	// ConfigFiles are the source files of the service config, for example
+	// an OpenAPI document or a gRPC service config together with its
+	// compiled proto descriptor. Changing them submits a new config version
+	// and rolls it out.
+	// +kubebuilder:validation:MinItems=1
+	ConfigFiles []ConfigFile `json:"configFiles"`
+}
+
+// A ConfigFile is a source file of a service config.
+type ConfigFile struct {
+	// FilePath is the name of the file, e.g. openapi.yaml.
+	FilePath string `json:"filePath"`
+
+	// FileContents are the contents of the file. FILE_DESCRIPTOR_SET_PROTO
+	// files must be base64 encoded; all other files are plain text.
+	FileContents string `json:"fileContents"`
+
+	// FileType is the type of the file.
+	// +kubebuilder:validation:Enum=SERVICE_CONFIG_YAML;OPEN_API_JSON;OPEN_API_YAML;FILE_DESCRIPTOR_SET_PROTO;PROTO_FILE
+	FileType string `json:"fileType"`
+}
+
+// ManagedServiceObservation is used to show the observed state of the
+// ManagedService resource on GCP.
+type ManagedServiceObservation struct {
+	// ServiceName is the name of the service.
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// ConfigID is the ID of the service config that is currently rolled
+	// out.
+	ConfigID string `json:"configId,omitempty"`
+
+	// RolloutID is the ID of the most recent rollout of the service.
+	RolloutID string `json:"rolloutId,omitempty"`
+
+	// RolloutStatus is the status of the most recent rollout of the
+	// service, e.g. SUCCESS.
+	RolloutStatus string `json:"rolloutStatus,omitempty"`
+
+	// SubmittedConfigID is the ID of the config version that was most
+	// recently submitted for this resource.
+	SubmittedConfigID string `json:"submittedConfigId,omitempty"`
+
+	// SubmitOperation is the name of the operation that is submitting a
+	// new config version, if any.
+	SubmitOperation string `json:"submitOperation,omitempty"`
+
+	// ConfigFilesHash is a hash of the config files that were most
+	// recently submitted. It is used to detect changes that require a new
+	// config version.
+	ConfigFilesHash string `json:"configFilesHash,omitempty"`
+}
+
+// A ManagedServiceSpec defines the desired state of a ManagedService.
+type ManagedServiceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ManagedServiceParameters `json:"forProvider"`
+}
+
+// A ManagedServiceStatus represents the observed state of a ManagedService.
+type ManagedServiceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ManagedServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ManagedService is a managed resource that represents a Google Service
+// Management service, the configuration behind a Cloud Endpoints API. Every
+// change to its config files is submitted as a new config version and rolled
+// out to the service.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CONFIG-ID",type="string",JSONPath=".status.atProvider.configId"
+// +kubebuilder:printcolumn:name="ROLLOUT",type="string",JSONPath=".status.atProvider.rolloutStatus"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ManagedService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedServiceSpec   `json:"spec"`
+	Status ManagedServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedServiceList contains a list of ManagedService.
+type ManagedServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedService `json:"items"`
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Known service states.
+const (
+	StateEnabled  = "ENABLED"
+	StateDisabled = "DISABLED"
+)
+
+// ServiceParameters define the desired state of a GCP service API on a
+// project. The name of the service, e.g. compute.googleapis.com, is
+// determined by the value of the `crossplane.io/external-name` annotation.
+// https://cloud.google.com/service-usage/docs/reference/rest/v1/services
+type ServiceParameters struct {
+	// Project is the ID or number of the project on which the service is
+	// enabled. Defaults to the project of the Provider.
+	// +optional
+	// +immutable
+	Project *string `json:"project,omitempty"`
+
+	// DisableDependentServices disables the services that depend on this
+	// service when it is disabled. If it is false, disabling a service that
+	// other enabled services depend on fails.
+	// +optional
+	DisableDependentServices *bool `json:"disableDependentServices,omitempty"`
+}
+
+// A ServiceObservation represents the observed state of a GCP service API
+// on a project.
+type ServiceObservation struct {
+	// Name is the resource name of the service, in the form
+	// projects/{project-number}/services/{service}.
+	Name string `json:"name,omitempty"`
+
+	// Title is the title of the service, e.g. Compute Engine API.
+	Title string `json:"title,omitempty"`
+
+	// State of the service, i.e. ENABLED or DISABLED.
+	State string `json:"state,omitempty"`
+}
+
+// A ServiceSpec defines the desired state of a Service.
+type ServiceSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  ServiceParameters `json:"forProvider,omitempty"`
+}
+
+// A ServiceStatus represents the observed state of a Service.
+type ServiceStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     ServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Service is a managed resource that represents a GCP service API enabled
+// on a project. Creating a Service enables the API and deleting it disables
+// the API. A Service becomes ready once the API is enabled, so that other
+// resources of the project can wait for it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Service.
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
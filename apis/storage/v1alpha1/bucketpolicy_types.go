@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// BucketPolicyParameters define the desired state of the IAM policy of a
+// bucket. Only the declared bindings are managed; bindings that other
+// BucketPolicies, Buckets or users add to the bucket's policy are left intact.
+type BucketPolicyParameters struct {
+	// Bucket is the name of the bucket whose IAM policy is managed.
+	// +optional
+	// +immutable
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket and retrieves its name.
+	// +optional
+	// +immutable
+	BucketRef *runtimev1alpha1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket.
+	// +optional
+	BucketSelector *runtimev1alpha1.Selector `json:"bucketSelector,omitempty"`
+
+	// Bindings grant roles on the bucket to principals.
+	// +kubebuilder:validation:MinItems=1
+	Bindings []BucketPolicyBinding `json:"bindings"`
+}
+
+// A BucketPolicyBinding grants a role on a bucket to principals.
+type BucketPolicyBinding struct {
+	// Role that is granted, for example roles/storage.objectViewer.
+	Role string `json:"role"`
+
+	// Members the role is granted to, for example
+	// serviceAccount:my-sa@my-project.iam.gserviceaccount.com or
+	// group:readers@example.com.
+	// +kubebuilder:validation:MinItems=1
+	Members []string `json:"members"`
+}
+
+// BucketPolicyObservation is the observed state of the IAM policy of a
+// bucket.
+type BucketPolicyObservation struct {
+	// Bindings are the bindings this BucketPolicy last granted. Members that
+	// are removed from the spec are revoked only if they are recorded here.
+	Bindings []BucketPolicyBinding `json:"bindings,omitempty"`
+}
+
+// A BucketPolicySpec defines the desired state of a BucketPolicy.
+type BucketPolicySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  BucketPolicyParameters `json:"forProvider"`
+}
+
+// A BucketPolicyStatus represents the observed state of a BucketPolicy.
+type BucketPolicyStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     BucketPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BucketPolicy is a managed resource that represents the bindings of the
+// IAM policy of a Google Cloud Storage bucket.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type BucketPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketPolicySpec   `json:"spec"`
+	Status BucketPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketPolicyList contains a list of BucketPolicy.
+type BucketPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketPolicy `json:"items"`
+}
@@ -195,6 +195,13 @@ type LifecycleCondition struct {
 	NumNewerVersions int64 `json:"numNewerVersions,omitempty"`
 }
 
+// MatchesNoncurrentVersions returns true if the condition only matches
+// noncurrent (archived) versions of objects, which exist only in buckets with
+// versioning enabled.
+func (lc LifecycleCondition) MatchesNoncurrentVersions() bool {
+	return lc.Liveness == storage.Archived || lc.NumNewerVersions > 0
+}
+
 // NewLifecycleCondition creates a new instance of LifecycleCondition from the storage counterpart
 func NewLifecycleCondition(lc storage.LifecycleCondition) LifecycleCondition {
 	return LifecycleCondition{
@@ -301,6 +308,13 @@ type RetentionPolicy struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=3155673600
 	RetentionPeriodSeconds int `json:"retentionPeriodSeconds,omitempty"`
+
+	// Locked locks the retention policy, after which its retention period
+	// can be extended but never shortened or removed, and the bucket cannot
+	// be deleted until all of its objects are past their retention period.
+	// Locking a retention policy cannot be undone.
+	// +optional
+	Locked bool `json:"locked,omitempty"`
 }
 
 // NewRetentionPolicy creates a new instance of RetentionPolicy from the storage counterpart
@@ -310,10 +324,12 @@ func NewRetentionPolicy(rp *storage.RetentionPolicy) *RetentionPolicy {
 	}
 	return &RetentionPolicy{
 		RetentionPeriodSeconds: int(rp.RetentionPeriod.Seconds()),
+		Locked:                 rp.IsLocked,
 	}
 }
 
-// CopyToRetentionPolicy create a copy in storage format
+// CopyToRetentionPolicy create a copy in storage format. Whether the policy
+// is locked is not copied; policies are locked separately.
 func CopyToRetentionPolicy(rp *RetentionPolicy) *storage.RetentionPolicy {
 	var d time.Duration
 
@@ -361,9 +377,11 @@ type BucketEncryption struct {
 	DefaultKMSKeyName string `json:"defaultKmsKeyName,omitempty"`
 }
 
-// NewBucketEncryption creates a new instance of BucketEncryption from the storage counterpart
+// NewBucketEncryption creates a new instance of BucketEncryption from the
+// storage counterpart. An encryption configuration without a key is
+// equivalent to none.
 func NewBucketEncryption(e *storage.BucketEncryption) *BucketEncryption {
-	if e == nil {
+	if e == nil || e.DefaultKMSKeyName == "" {
 		return nil
 	}
 	return &BucketEncryption{
@@ -565,16 +583,21 @@ type BucketUpdatableAttrs struct {
 
 	// DefaultEventBasedHold is the default value for event-based hold on
 	// newly created objects in this bucket. It defaults to false.
+	// Deprecated: Use Defaults.EventBasedHold, which takes precedence.
 	DefaultEventBasedHold bool `json:"defaultEventBasedHold,omitempty"`
 
 	// The encryption configuration used by default for newly inserted objects.
+	// Deprecated: Use Defaults.KMSKeyName, which takes precedence.
 	Encryption *BucketEncryption `json:"encryption,omitempty"`
 
 	// Labels are the bucket's labels.
 	Labels map[string]string `json:"labels,omitempty"`
 
 	// Lifecycle is the lifecycle configuration for objects in the bucket.
-	Lifecycle Lifecycle `json:"lifecycle,omitempty"`
+	// The bucket's lifecycle rules are left as they are if it is omitted,
+	// and all of them are removed if it has no rules.
+	// +optional
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
 
 	// The logging configuration.
 	Logging *BucketLogging `json:"logging,omitempty"`
@@ -627,7 +650,7 @@ func NewBucketUpdatableAttrs(ba *storage.BucketAttrs) *BucketUpdatableAttrs {
 		DefaultEventBasedHold:      ba.DefaultEventBasedHold,
 		Encryption:                 NewBucketEncryption(ba.Encryption),
 		Labels:                     ba.Labels,
-		Lifecycle:                  *NewLifecycle(ba.Lifecycle),
+		Lifecycle:                  NewLifecycle(ba.Lifecycle),
 		Logging:                    NewBucketLogging(ba.Logging),
 		PredefinedACL:              ba.PredefinedACL,
 		PredefinedDefaultObjectACL: ba.PredefinedDefaultObjectACL,
@@ -644,13 +667,18 @@ func CopyToBucketAttrs(ba *BucketUpdatableAttrs) *storage.BucketAttrs {
 		return nil
 	}
 
+	var lifecycle storage.Lifecycle
+	if ba.Lifecycle != nil {
+		lifecycle = CopyToLifecycle(*ba.Lifecycle)
+	}
+
 	return &storage.BucketAttrs{
 		BucketPolicyOnly:           CopyToBucketPolicyOnly(ba.BucketPolicyOnly),
 		CORS:                       CopyToCORSList(ba.CORS),
 		DefaultEventBasedHold:      ba.DefaultEventBasedHold,
 		Encryption:                 CopyToBucketEncryption(ba.Encryption),
 		Labels:                     ba.Labels,
-		Lifecycle:                  CopyToLifecycle(ba.Lifecycle),
+		Lifecycle:                  lifecycle,
 		Logging:                    CopyToBucketLogging(ba.Logging),
 		PredefinedACL:              ba.PredefinedACL,
 		PredefinedDefaultObjectACL: ba.PredefinedDefaultObjectACL,
@@ -661,17 +689,29 @@ func CopyToBucketAttrs(ba *BucketUpdatableAttrs) *storage.BucketAttrs {
 	}
 }
 
-// CopyToBucketUpdateAttrs create a copy in storage format
-func CopyToBucketUpdateAttrs(ba BucketUpdatableAttrs, labels map[string]string) storage.BucketAttrsToUpdate {
+// CopyToBucketUpdateAttrs create a copy in storage format. Labels are not
+// copied; they must be set and deleted individually against the bucket's
+// current labels. A nil encryption configuration removes the bucket's
+// existing one, whereas a nil lifecycle configuration leaves the bucket's
+// lifecycle rules unchanged.
+func CopyToBucketUpdateAttrs(ba BucketUpdatableAttrs) storage.BucketAttrsToUpdate {
 	bucketPolicyOnly := CopyToBucketPolicyOnly(ba.BucketPolicyOnly)
-	lifecycle := CopyToLifecycle(ba.Lifecycle)
+	var lifecycle *storage.Lifecycle
+	if ba.Lifecycle != nil {
+		l := CopyToLifecycle(*ba.Lifecycle)
+		lifecycle = &l
+	}
+	encryption := CopyToBucketEncryption(ba.Encryption)
+	if encryption == nil {
+		encryption = &storage.BucketEncryption{}
+	}
 
 	update := storage.BucketAttrsToUpdate{
 		BucketPolicyOnly:           &bucketPolicyOnly,
 		CORS:                       CopyToCORSList(ba.CORS),
 		DefaultEventBasedHold:      ba.DefaultEventBasedHold,
-		Encryption:                 CopyToBucketEncryption(ba.Encryption),
-		Lifecycle:                  &lifecycle,
+		Encryption:                 encryption,
+		Lifecycle:                  lifecycle,
 		Logging:                    CopyToBucketLogging(ba.Logging),
 		PredefinedACL:              ba.PredefinedACL,
 		PredefinedDefaultObjectACL: ba.PredefinedDefaultObjectACL,
@@ -681,15 +721,6 @@ func CopyToBucketUpdateAttrs(ba BucketUpdatableAttrs, labels map[string]string)
 		Website:                    CopyToBucketWebsite(ba.Website),
 	}
 
-	for k, v := range ba.Labels {
-		update.SetLabel(k, v)
-		delete(labels, k)
-	}
-
-	for k := range labels {
-		update.DeleteLabel(k)
-	}
-
 	return update
 }
 
@@ -705,7 +736,10 @@ type BucketSpecAttrs struct {
 	// apply to new objects when no object ACL is provided.
 	DefaultObjectACL []ACLRule `json:"defaultObjectAcl,omitempty"`
 
-	// Location is the location of the bucket. It defaults to "US".
+	// Location is the location of the bucket. It defaults to "US". Changing
+	// the location of an existing bucket relocates it where GCS supports
+	// relocating between the old and new location. Otherwise it cannot be
+	// changed; see StorageClass.
 	Location string `json:"location,omitempty"`
 
 	// StorageClass is the default storage class of the bucket. This defines
@@ -715,6 +749,11 @@ type BucketSpecAttrs struct {
 	// "DURABLE_REDUCED_AVAILABILITY". Defaults to "STANDARD", which
 	// is equivalent to "MULTI_REGIONAL" or "REGIONAL" depending on
 	// the bucket's location settings.
+	// Deprecated: Use Defaults.StorageClass, which takes precedence. Neither the location nor the storage class of an existing bucket can
+	// be changed in place. Changing either is an error unless the bucket is
+	// annotated with crossplane.io/recreate: "true", in which case the
+	// bucket is deleted and created again. Deleting a bucket deletes all of
+	// its data, so only empty buckets are recreated.
 	// +kubebuilder:validation:Enum=MULTI_REGIONAL;REGIONAL;NEARLINE;COLDLINE;STANDARD;DURABLE_REDUCED_AVAILABILITY
 	StorageClass string `json:"storageClass,omitempty"`
 }
@@ -755,6 +794,11 @@ type BucketOutputAttrs struct {
 	// Created is the creation time of the bucket.
 	Created metav1.Time `json:"created,omitempty"`
 
+	// Metageneration is the generation of the bucket's metadata. It changes
+	// every time the bucket is updated, and updates are only applied if it
+	// still matches the generation that was last observed.
+	Metageneration int64 `json:"metageneration,omitempty"`
+
 	// Retention policy enforces a minimum retention time for all objects
 	// contained in the bucket. A RetentionPolicy of nil implies the bucket
 	// has no minimum data retention.
@@ -775,18 +819,97 @@ func NewBucketOutputAttrs(attrs *storage.BucketAttrs) BucketOutputAttrs {
 		Created: metav1.Time{
 			Time: attrs.Created,
 		},
+		Metageneration:  attrs.MetaGeneration,
 		RetentionPolicy: NewRetentionPolicyStatus(attrs.RetentionPolicy),
 	}
 }
 
+// BucketDefaults are the defaults that GCS applies to objects that are
+// written to a bucket without specifying them.
+type BucketDefaults struct {
+	// EventBasedHold is the default value for event-based hold on newly
+	// created objects in this bucket.
+	// +optional
+	EventBasedHold *bool `json:"eventBasedHold,omitempty"`
+
+	// StorageClass is the default storage class of newly created objects in
+	// this bucket. Like the bucket's location it cannot be changed in place.
+	// +optional
+	// +kubebuilder:validation:Enum=MULTI_REGIONAL;REGIONAL;NEARLINE;COLDLINE;STANDARD;DURABLE_REDUCED_AVAILABILITY
+	StorageClass *string `json:"storageClass,omitempty"`
+
+	// KMSKeyName is the Cloud KMS key, in the form
+	// projects/P/locations/L/keyRings/R/cryptoKeys/K, used to encrypt newly
+	// created objects in this bucket that do not specify an encryption
+	// method. The key's location must be the same as the bucket's. An empty
+	// key name removes the bucket's default key.
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+}
+
+// ApplyTo overrides the supplied attributes with any defaults that are set.
+// Nil defaults apply nothing.
+func (d *BucketDefaults) ApplyTo(ba *BucketSpecAttrs) {
+	if d == nil {
+		return
+	}
+	if d.EventBasedHold != nil {
+		ba.DefaultEventBasedHold = *d.EventBasedHold
+	}
+	if d.StorageClass != nil {
+		ba.StorageClass = *d.StorageClass
+	}
+	if d.KMSKeyName != nil {
+		ba.Encryption = nil
+		if *d.KMSKeyName != "" {
+			ba.Encryption = &BucketEncryption{DefaultKMSKeyName: *d.KMSKeyName}
+		}
+	}
+}
+
 // BucketParameters define the desired state of a Google Cloud Storage Bucket.
 // Most fields map directly to a bucket resource:
 // https://cloud.google.com/storage/docs/json_api/v1/buckets#resource
 type BucketParameters struct {
 	BucketSpecAttrs `json:",inline"`
+
+	// Defaults for objects written to this bucket. Defaults that are set
+	// take precedence over the deprecated defaultEventBasedHold, encryption
+	// and storageClass fields, which reflect the bucket's observed defaults.
+	// +optional
+	Defaults *BucketDefaults `json:"defaults,omitempty"`
+
 	// ServiceAccountSecretRef contains GCP ServiceAccount secret that will be used
 	// for bucket connection secret credentials
 	ServiceAccountSecretRef *runtimev1alpha1.SecretReference `json:"serviceAccountSecretRef,omitempty"`
+
+	// ServiceAccount is the email of a service account that is granted
+	// ServiceAccountRole on the bucket. The role is added to the bucket's
+	// IAM policy if it is missing; other bindings are left untouched, and
+	// the role is not revoked if the service account is changed.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount and retrieves its email.
+	// +optional
+	ServiceAccountRef *runtimev1alpha1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount.
+	// +optional
+	ServiceAccountSelector *runtimev1alpha1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// ServiceAccountRole is the role ServiceAccount is granted on the bucket.
+	// Defaults to roles/storage.objectAdmin.
+	// +optional
+	ServiceAccountRole *string `json:"serviceAccountRole,omitempty"`
+}
+
+// GetSpecAttrs returns the desired attributes of the bucket, with any
+// Defaults applied.
+func (p *BucketParameters) GetSpecAttrs() BucketSpecAttrs {
+	ba := p.BucketSpecAttrs
+	p.Defaults.ApplyTo(&ba)
+	return ba
 }
 
 // A BucketSpec defines the desired state of a Bucket.
@@ -800,6 +923,29 @@ type BucketStatus struct {
 	runtimev1alpha1.ResourceStatus `json:",inline"`
 
 	BucketOutputAttrs `json:"attributes,omitempty"`
+
+	// Relocation is the most recent relocation of the bucket to a new
+	// location, while it is in progress or if it failed.
+	// +optional
+	Relocation *BucketRelocation `json:"relocation,omitempty"`
+}
+
+// A BucketRelocation is a long running operation that moves a bucket to a new
+// location.
+type BucketRelocation struct {
+	// Operation is the name of the relocation operation.
+	Operation string `json:"operation"`
+
+	// DestinationLocation is the location the bucket is relocated to.
+	DestinationLocation string `json:"destinationLocation"`
+
+	// ProgressPercent is the estimated progress of the relocation.
+	// +optional
+	ProgressPercent int32 `json:"progressPercent,omitempty"`
+
+	// Failure is why the relocation failed, if it did.
+	// +optional
+	Failure string `json:"failure,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -467,6 +467,7 @@ func TestNewBucketEncryption(t *testing.T) {
 		want *BucketEncryption
 	}{
 		{"Nil", nil, nil},
+		{"NoKey", &storage.BucketEncryption{}, nil},
 		{"Val", testStorageBucketEncryption, testBucketEncryption},
 	}
 	for _, tt := range tests {
@@ -690,7 +691,7 @@ var (
 		DefaultEventBasedHold:      true,
 		Encryption:                 testBucketEncryption,
 		Labels:                     map[string]string{"application": "crossplane"},
-		Lifecycle:                  testLifecycle,
+		Lifecycle:                  &testLifecycle,
 		Logging:                    testBucketLogging,
 		PredefinedACL:              "test-predefined-acl",
 		PredefinedDefaultObjectACL: "test-predefined-default-object-acl",
@@ -774,9 +775,17 @@ func TestCopyToBucketAttrs(t *testing.T) {
 }
 
 func TestCopyToBucketUpdateAttrs(t *testing.T) {
+	noEncryption := *testBucketUpdateAttrs
+	noEncryption.Encryption = nil
+	removeEncryption := testStorageBucketAttrsToUpdate
+	removeEncryption.Encryption = &storage.BucketEncryption{}
+	noLifecycle := *testBucketUpdateAttrs
+	noLifecycle.Lifecycle = nil
+	keepLifecycle := testStorageBucketAttrsToUpdate
+	keepLifecycle.Lifecycle = nil
+
 	type args struct {
-		ba     BucketUpdatableAttrs
-		labels map[string]string
+		ba BucketUpdatableAttrs
 	}
 	tests := []struct {
 		name string
@@ -785,15 +794,23 @@ func TestCopyToBucketUpdateAttrs(t *testing.T) {
 	}{
 		{
 			name: "Test",
-			args: args{*testBucketUpdateAttrs, map[string]string{"application": "crossplane", "foo": "bar"}},
+			args: args{*testBucketUpdateAttrs},
 			want: testStorageBucketAttrsToUpdate,
 		},
+		{
+			name: "NoEncryption",
+			args: args{noEncryption},
+			want: removeEncryption,
+		},
+		{
+			name: "NoLifecycle",
+			args: args{noLifecycle},
+			want: keepLifecycle,
+		},
 	}
 	for _, tt := range tests {
-		tt.want.SetLabel("application", "crossplane")
-		tt.want.DeleteLabel("foo")
 		t.Run(tt.name, func(t *testing.T) {
-			got := CopyToBucketUpdateAttrs(tt.args.ba, tt.args.labels)
+			got := CopyToBucketUpdateAttrs(tt.args.ba)
 			if diff := cmp.Diff(got, tt.want, cmp.AllowUnexported(storage.BucketAttrsToUpdate{})); diff != "" {
 				t.Errorf("CopyToBucketUpdateAttrs()\n%+v, want \n%+v\n%s", got, tt.want, diff)
 			}
@@ -874,11 +891,13 @@ func TestCopyBucketSpecAttrs(t *testing.T) {
 var (
 	testBucketOutputAttrs = BucketOutputAttrs{
 		Created:         metav1.NewTime(now),
+		Metageneration:  4,
 		RetentionPolicy: testRetentionPolicyStatus,
 	}
 
 	testStorageBucketAttrs3 = &storage.BucketAttrs{
 		Created:         now,
+		MetaGeneration:  4,
 		Name:            "test-name",
 		RetentionPolicy: testStorageRetentionPolicy,
 	}
@@ -902,3 +921,93 @@ func TestNewBucketOutputAttrs(t *testing.T) {
 		})
 	}
 }
+
+func TestBucketDefaultsApplyTo(t *testing.T) {
+	yes, no := true, false
+	nearline, empty, key := "NEARLINE", "", "test-kms"
+	legacy := BucketSpecAttrs{
+		BucketUpdatableAttrs: BucketUpdatableAttrs{
+			DefaultEventBasedHold: true,
+			Encryption:            &BucketEncryption{DefaultKMSKeyName: "legacy-kms"},
+		},
+		StorageClass: "STANDARD",
+	}
+
+	tests := []struct {
+		name string
+		d    *BucketDefaults
+		ba   BucketSpecAttrs
+		want BucketSpecAttrs
+	}{
+		{
+			name: "Nil",
+			ba:   legacy,
+			want: legacy,
+		},
+		{
+			name: "Unset",
+			d:    &BucketDefaults{},
+			ba:   legacy,
+			want: legacy,
+		},
+		{
+			name: "EventBasedHold",
+			d:    &BucketDefaults{EventBasedHold: &no},
+			ba:   legacy,
+			want: BucketSpecAttrs{
+				BucketUpdatableAttrs: BucketUpdatableAttrs{Encryption: legacy.Encryption},
+				StorageClass:         "STANDARD",
+			},
+		},
+		{
+			name: "StorageClass",
+			d:    &BucketDefaults{StorageClass: &nearline},
+			ba:   legacy,
+			want: BucketSpecAttrs{
+				BucketUpdatableAttrs: legacy.BucketUpdatableAttrs,
+				StorageClass:         "NEARLINE",
+			},
+		},
+		{
+			name: "KMSKeyName",
+			d:    &BucketDefaults{KMSKeyName: &key},
+			ba:   legacy,
+			want: BucketSpecAttrs{
+				BucketUpdatableAttrs: BucketUpdatableAttrs{
+					DefaultEventBasedHold: true,
+					Encryption:            testBucketEncryption,
+				},
+				StorageClass: "STANDARD",
+			},
+		},
+		{
+			name: "EmptyKMSKeyName",
+			d:    &BucketDefaults{KMSKeyName: &empty},
+			ba:   legacy,
+			want: BucketSpecAttrs{
+				BucketUpdatableAttrs: BucketUpdatableAttrs{DefaultEventBasedHold: true},
+				StorageClass:         "STANDARD",
+			},
+		},
+		{
+			name: "All",
+			d:    &BucketDefaults{EventBasedHold: &yes, StorageClass: &nearline, KMSKeyName: &key},
+			want: BucketSpecAttrs{
+				BucketUpdatableAttrs: BucketUpdatableAttrs{
+					DefaultEventBasedHold: true,
+					Encryption:            testBucketEncryption,
+				},
+				StorageClass: "NEARLINE",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.ba
+			tt.d.ApplyTo(&got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("BucketDefaults.ApplyTo(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -147,6 +147,36 @@ func (in *BucketClassSpecTemplate) DeepCopy() *BucketClassSpecTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketDefaults) DeepCopyInto(out *BucketDefaults) {
+	*out = *in
+	if in.EventBasedHold != nil {
+		in, out := &in.EventBasedHold, &out.EventBasedHold
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyName != nil {
+		in, out := &in.KMSKeyName, &out.KMSKeyName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketDefaults.
+func (in *BucketDefaults) DeepCopy() *BucketDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketEncryption) DeepCopyInto(out *BucketEncryption) {
 	*out = *in
@@ -239,11 +269,36 @@ func (in *BucketOutputAttrs) DeepCopy() *BucketOutputAttrs {
 func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 	*out = *in
 	in.BucketSpecAttrs.DeepCopyInto(&out.BucketSpecAttrs)
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(BucketDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServiceAccountSecretRef != nil {
 		in, out := &in.ServiceAccountSecretRef, &out.ServiceAccountSecretRef
 		*out = new(v1alpha1.SecretReference)
 		**out = **in
 	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(v1alpha1.Reference)
+		**out = **in
+	}
+	if in.ServiceAccountSelector != nil {
+		in, out := &in.ServiceAccountSelector, &out.ServiceAccountSelector
+		*out = new(v1alpha1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountRole != nil {
+		in, out := &in.ServiceAccountRole, &out.ServiceAccountRole
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -272,6 +327,21 @@ func (in *BucketPolicyOnly) DeepCopy() *BucketPolicyOnly {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketRelocation) DeepCopyInto(out *BucketRelocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketRelocation.
+func (in *BucketRelocation) DeepCopy() *BucketRelocation {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketRelocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
 	*out = *in
@@ -324,6 +394,11 @@ func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.BucketOutputAttrs.DeepCopyInto(&out.BucketOutputAttrs)
+	if in.Relocation != nil {
+		in, out := &in.Relocation, &out.Relocation
+		*out = new(BucketRelocation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
@@ -363,7 +438,11 @@ func (in *BucketUpdatableAttrs) DeepCopyInto(out *BucketUpdatableAttrs) {
 			(*out)[key] = val
 		}
 	}
-	in.Lifecycle.DeepCopyInto(&out.Lifecycle)
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Logging != nil {
 		in, out := &in.Logging, &out.Logging
 		*out = new(BucketLogging)
@@ -0,0 +1,290 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// Transfer job statuses.
+const (
+	TransferJobStatusEnabled  = "ENABLED"
+	TransferJobStatusDisabled = "DISABLED"
+	TransferJobStatusDeleted  = "DELETED"
+)
+
+// TransferJobParameters define the desired state of a Storage Transfer
+// Service transfer job. The job is named transferJobs/ followed by the value
+// of the `crossplane.io/external-name` annotation.
+// https://cloud.google.com/storage-transfer/docs/reference/rest/v1/transferJobs
+type TransferJobParameters struct {
+	// Description of the transfer job.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// TransferSpec specifies what is transferred.
+	TransferSpec TransferSpec `json:"transferSpec"`
+
+	// Schedule specifies when the transfer job runs. Transfer job schedules
+	// cannot be changed once the job has been created.
+	// +immutable
+	Schedule Schedule `json:"schedule"`
+
+	// Status of the transfer job. A DISABLED job does not start new
+	// transfers. Defaults to ENABLED.
+	// +optional
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	Status *string `json:"status,omitempty"`
+}
+
+// A TransferSpec specifies the source and sink of a transfer job, which
+// objects are transferred and how.
+type TransferSpec struct {
+	// GCSDataSource is a Cloud Storage bucket to transfer objects from.
+	// Exactly one source must be set.
+	// +optional
+	GCSDataSource *GCSData `json:"gcsDataSource,omitempty"`
+
+	// AWSS3DataSource is an Amazon S3 bucket to transfer objects from.
+	// Exactly one source must be set.
+	// +optional
+	AWSS3DataSource *AWSS3Data `json:"awsS3DataSource,omitempty"`
+
+	// HTTPDataSource is a list of URLs to transfer objects from. Exactly
+	// one source must be set.
+	// +optional
+	HTTPDataSource *HTTPData `json:"httpDataSource,omitempty"`
+
+	// GCSDataSink is the Cloud Storage bucket to transfer objects to.
+	GCSDataSink GCSData `json:"gcsDataSink"`
+
+	// ObjectConditions select the source objects that are transferred.
+	// +optional
+	ObjectConditions *ObjectConditions `json:"objectConditions,omitempty"`
+
+	// TransferOptions specify how objects are transferred.
+	// +optional
+	TransferOptions *TransferOptions `json:"transferOptions,omitempty"`
+}
+
+// GCSData is a Cloud Storage bucket.
+type GCSData struct {
+	// BucketName is the name of the bucket.
+	// +optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a Bucket and retrieves its name.
+	// +optional
+	BucketNameRef *runtimev1alpha1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket.
+	// +optional
+	BucketNameSelector *runtimev1alpha1.Selector `json:"bucketNameSelector,omitempty"`
+}
+
+// AWSS3Data is an Amazon S3 bucket.
+type AWSS3Data struct {
+	// BucketName is the name of the bucket.
+	BucketName string `json:"bucketName"`
+
+	// AccessKeyIDSecretRef references the secret key that contains the ID
+	// of an AWS access key that can read the bucket.
+	AccessKeyIDSecretRef runtimev1alpha1.SecretKeySelector `json:"accessKeyIdSecretRef"`
+
+	// SecretAccessKeySecretRef references the secret key that contains the
+	// secret of the AWS access key.
+	SecretAccessKeySecretRef runtimev1alpha1.SecretKeySelector `json:"secretAccessKeySecretRef"`
+}
+
+// HTTPData is a list of objects that are transferred over HTTP.
+type HTTPData struct {
+	// ListURL is the URL of a TSV file that lists the objects to transfer.
+	ListURL string `json:"listUrl"`
+}
+
+// ObjectConditions select the source objects that are transferred.
+type ObjectConditions struct {
+	// IncludePrefixes of objects that are transferred.
+	// +optional
+	IncludePrefixes []string `json:"includePrefixes,omitempty"`
+
+	// ExcludePrefixes of objects that are not transferred.
+	// +optional
+	ExcludePrefixes []string `json:"excludePrefixes,omitempty"`
+
+	// MinTimeElapsedSinceLastModification selects objects that were last
+	// modified at least this long before the transfer, e.g. 86400s.
+	// +optional
+	MinTimeElapsedSinceLastModification *string `json:"minTimeElapsedSinceLastModification,omitempty"`
+
+	// MaxTimeElapsedSinceLastModification selects objects that were last
+	// modified at most this long before the transfer, e.g. 86400s.
+	// +optional
+	MaxTimeElapsedSinceLastModification *string `json:"maxTimeElapsedSinceLastModification,omitempty"`
+
+	// LastModifiedSince selects objects that were last modified at or
+	// after this time, in RFC3339 format.
+	// +optional
+	LastModifiedSince *string `json:"lastModifiedSince,omitempty"`
+
+	// LastModifiedBefore selects objects that were last modified before
+	// this time, in RFC3339 format.
+	// +optional
+	LastModifiedBefore *string `json:"lastModifiedBefore,omitempty"`
+}
+
+// TransferOptions specify how objects are transferred.
+type TransferOptions struct {
+	// OverwriteObjectsAlreadyExistingInSink overwrites objects in the sink
+	// even if they are identical to those in the source.
+	// +optional
+	OverwriteObjectsAlreadyExistingInSink *bool `json:"overwriteObjectsAlreadyExistingInSink,omitempty"`
+
+	// DeleteObjectsUniqueInSink deletes objects from the sink that are not
+	// in the source.
+	// +optional
+	DeleteObjectsUniqueInSink *bool `json:"deleteObjectsUniqueInSink,omitempty"`
+
+	// DeleteObjectsFromSourceAfterTransfer deletes objects from the source
+	// once they have been transferred.
+	// +optional
+	DeleteObjectsFromSourceAfterTransfer *bool `json:"deleteObjectsFromSourceAfterTransfer,omitempty"`
+}
+
+// A Schedule specifies when a transfer job runs. A job whose start and end
+// dates are the same runs once.
+type Schedule struct {
+	// ScheduleStartDate is the first date on which the job runs.
+	ScheduleStartDate Date `json:"scheduleStartDate"`
+
+	// ScheduleEndDate is the last date on which the job runs. The job
+	// runs daily until it is deleted if no end date is set.
+	// +optional
+	ScheduleEndDate *Date `json:"scheduleEndDate,omitempty"`
+
+	// StartTimeOfDay is the UTC time at which the job runs. The job runs
+	// as soon as possible on the start date if no time is set.
+	// +optional
+	StartTimeOfDay *TimeOfDay `json:"startTimeOfDay,omitempty"`
+}
+
+// A Date in the Gregorian calendar.
+type Date struct {
+	Year  int64 `json:"year"`
+	Month int64 `json:"month"`
+	Day   int64 `json:"day"`
+}
+
+// A TimeOfDay in 24 hour format.
+type TimeOfDay struct {
+	Hours int64 `json:"hours"`
+
+	// +optional
+	Minutes int64 `json:"minutes,omitempty"`
+
+	// +optional
+	Seconds int64 `json:"seconds,omitempty"`
+}
+
+// TransferJobObservation is used to show the observed state of the
+// TransferJob resource on GCP.
+type TransferJobObservation struct {
+	// Name of the transfer job, in the form transferJobs/{job}.
+	Name string `json:"name,omitempty"`
+
+	// Status of the transfer job.
+	Status string `json:"status,omitempty"`
+
+	// CreationTime is the time the transfer job was created.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// LastModificationTime is the time the transfer job was last modified.
+	LastModificationTime *metav1.Time `json:"lastModificationTime,omitempty"`
+
+	// LatestOperation is the most recently started run of the transfer
+	// job, if any.
+	LatestOperation *TransferOperationObservation `json:"latestOperation,omitempty"`
+}
+
+// TransferOperationObservation is the observed state of a run of a transfer
+// job.
+type TransferOperationObservation struct {
+	// Name of the transfer operation.
+	Name string `json:"name,omitempty"`
+
+	// Status of the transfer operation, e.g. IN_PROGRESS or SUCCESS.
+	Status string `json:"status,omitempty"`
+
+	// StartTime is the time the transfer operation started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time the transfer operation ended.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// ObjectsCopiedToSink is the number of objects that were transferred.
+	ObjectsCopiedToSink int64 `json:"objectsCopiedToSink,omitempty"`
+
+	// BytesCopiedToSink is the number of bytes that were transferred.
+	BytesCopiedToSink int64 `json:"bytesCopiedToSink,omitempty"`
+
+	// ObjectsFromSourceFailed is the number of objects that could not be
+	// transferred.
+	ObjectsFromSourceFailed int64 `json:"objectsFromSourceFailed,omitempty"`
+}
+
+// A TransferJobSpec defines the desired state of a TransferJob.
+type TransferJobSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ForProvider                  TransferJobParameters `json:"forProvider"`
+}
+
+// A TransferJobStatus represents the observed state of a TransferJob.
+type TransferJobStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	AtProvider                     TransferJobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransferJob is a managed resource that represents a Google Storage
+// Transfer Service transfer job. Deleting a TransferJob sets the status of
+// the transfer job to DELETED; Storage Transfer Service garbage collects it
+// 30 days later.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="LAST-RUN",type="string",JSONPath=".status.atProvider.latestOperation.status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type TransferJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransferJobSpec   `json:"spec"`
+	Status TransferJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransferJobList contains a list of TransferJob.
+type TransferJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransferJob `json:"items"`
+}
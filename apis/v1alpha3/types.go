@@ -22,12 +22,107 @@ import (
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 )
 
+// A CredentialsSource is a source from which a Provider's credentials are
+// obtained.
+type CredentialsSource string
+
+// Credentials sources.
+const (
+	// CredentialsSourceSecret obtains credentials from the JSON key file
+	// stored in the Secret referenced by the Provider.
+	CredentialsSourceSecret CredentialsSource = "Secret"
+
+	// CredentialsSourceInjectedIdentity obtains credentials from the
+	// environment the provider runs in, using application default
+	// credentials, for example the GCP service account a GKE Workload
+	// Identity binds to the provider's Kubernetes service account.
+	CredentialsSourceInjectedIdentity CredentialsSource = "InjectedIdentity"
+)
+
 // A ProviderSpec defines the desired state of a Provider.
 type ProviderSpec struct {
 	runtimev1alpha1.ProviderSpec `json:",inline"`
 
+	// CredentialsSource is the source from which credentials are obtained.
+	// The credentialsSecretRef is ignored when it is InjectedIdentity.
+	// Defaults to Secret.
+	// +optional
+	// +kubebuilder:validation:Enum=Secret;InjectedIdentity
+	CredentialsSource CredentialsSource `json:"credentialsSource,omitempty"`
+
+	// ImpersonateServiceAccount is the email of a service account that is
+	// impersonated using the Provider's credentials, for example
+	// sa@my-project.iam.gserviceaccount.com. The identity of the credentials
+	// must be granted roles/iam.serviceAccountTokenCreator on it.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9-]*@[a-z0-9][a-z0-9.-]*\.gserviceaccount\.com$`
+	ImpersonateServiceAccount string `json:"impersonateServiceAccount,omitempty"`
+
 	// ProjectID is the project name (not numerical ID) of this GCP Provider.
 	ProjectID string `json:"projectID"`
+
+	// DefaultRegion is the region used by managed resources that do not
+	// specify a region or location, for example us-central1. The region set on
+	// a managed resource always takes precedence.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z]+-[a-z]+[0-9]+$`
+	DefaultRegion string `json:"defaultRegion,omitempty"`
+
+	// DefaultZone is the zone used by managed resources that do not specify
+	// a zone, for example us-central1-a. The zone set on a managed resource
+	// always takes precedence.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z]+-[a-z]+[0-9]+-[a-z]$`
+	DefaultZone string `json:"defaultZone,omitempty"`
+
+	// HTTPClient configures the HTTP client used to call GCP APIs. The
+	// defaults of the Google API client libraries are used when omitted.
+	// +optional
+	HTTPClient *HTTPClientConfig `json:"httpClient,omitempty"`
+
+	// Endpoints override the base URLs of GCP APIs, keyed by the name of the
+	// API, for example iam or storage. They can be used to call an emulator,
+	// or a Private Service Connect endpoint such as
+	// https://storage-myendpoint.p.googleapis.com/storage/v1/. A base URL
+	// includes any path the API is served under. Only the iam,
+	// cloudresourcemanager and storage APIs may be overridden; APIs without
+	// an override are called at their default base URL.
+	// +optional
+	Endpoints map[string]string `json:"endpoints,omitempty"`
+}
+
+// HTTPClientConfig configures the timeout, retry policy, logging and user
+// agent of the HTTP client used to call GCP APIs.
+type HTTPClientConfig struct {
+	// Timeout bounds each API call, including any retries. Calls are also
+	// bounded by the deadline of the reconcile that makes them.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Retries is the number of times an idempotent GET request is retried
+	// after a transport error or a 429 or 5xx response.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Retries *int32 `json:"retries,omitempty"`
+
+	// RetryInterval is the time to wait before the first retry. The wait is
+	// doubled for every subsequent retry. Defaults to 1s.
+	// +optional
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty"`
+
+	// LogRequests logs the method, path, response code and latency of every
+	// GCP API call at info level, for example to audit what the provider
+	// does in a GCP organization. The values of query parameters that may
+	// contain credentials are redacted. Defaults to false.
+	// +optional
+	LogRequests bool `json:"logRequests,omitempty"`
+
+	// UserAgentSuffix is appended to the user agent the provider sends with
+	// every GCP API call, which identifies the provider and its version. It
+	// can be used to attribute API traffic to a particular team or
+	// environment, for example in quota reports and support cases.
+	// +optional
+	UserAgentSuffix string `json:"userAgentSuffix,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// LabelKeyProviderConfigName is the label of a ProviderConfigUsage whose
+// value is the name of the ProviderConfig that is used.
+const LabelKeyProviderConfigName = "gcp.crossplane.io/provider-config"
+
+// A CredentialsSource is a source from which a ProviderConfig's credentials
+// are obtained.
+type CredentialsSource string
+
+// Credentials sources.
+const (
+	// CredentialsSourceSecret obtains credentials from the JSON key file
+	// stored in the referenced Secret.
+	CredentialsSourceSecret CredentialsSource = "Secret"
+
+	// CredentialsSourceInjectedIdentity obtains credentials from the
+	// environment the provider runs in, using application default
+	// credentials.
+	CredentialsSourceInjectedIdentity CredentialsSource = "InjectedIdentity"
+)
+
+// ProviderCredentials are the credentials a ProviderConfig authenticates
+// to GCP with.
+type ProviderCredentials struct {
+	// Source of the credentials.
+	// +kubebuilder:validation:Enum=Secret;InjectedIdentity
+	Source CredentialsSource `json:"source"`
+
+	// SecretRef selects the key of the Secret that holds a JSON key file.
+	// It is required when the source is Secret.
+	// +optional
+	SecretRef *runtimev1alpha1.SecretKeySelector `json:"secretRef,omitempty"`
+}
+
+// A ProviderConfigSpec defines the desired state of a ProviderConfig.
+type ProviderConfigSpec struct {
+	// Credentials required to authenticate to GCP.
+	Credentials ProviderCredentials `json:"credentials"`
+
+	// ProjectID is the ID or number of the project managed resources that
+	// use this ProviderConfig belong to.
+	ProjectID string `json:"projectID"`
+
+	// ImpersonateServiceAccount is the email of a service account that is
+	// impersonated using the credentials, for example
+	// sa@my-project.iam.gserviceaccount.com.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9-]*@[a-z0-9][a-z0-9.-]*\.gserviceaccount\.com$`
+	ImpersonateServiceAccount string `json:"impersonateServiceAccount,omitempty"`
+
+	// Endpoints override the base URLs of GCP APIs, keyed by the name of the
+	// API, like those of a Provider.
+	// +optional
+	Endpoints map[string]string `json:"endpoints,omitempty"`
+}
+
+// A ProviderConfigStatus reflects the observed state of a ProviderConfig.
+type ProviderConfigStatus struct {
+	// Users of this ProviderConfig, i.e. the number of managed resources
+	// that use it.
+	// +optional
+	Users int64 `json:"users,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProviderConfig configures how managed resources connect to GCP. It is
+// the successor of the Provider; managed resources that reference a
+// ProviderConfig use it in favour of their Provider. A ProviderConfig cannot
+// be deleted while managed resources use it.
+// +kubebuilder:printcolumn:name="PROJECT-ID",type="string",JSONPath=".spec.projectID"
+// +kubebuilder:printcolumn:name="USERS",type="integer",JSONPath=".status.users"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,gcp}
+type ProviderConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProviderConfigSpec   `json:"spec"`
+	Status ProviderConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderConfigList contains a list of ProviderConfig
+type ProviderConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderConfig `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProviderConfigUsage records that a managed resource uses a
+// ProviderConfig. It is owned by the managed resource, and so deleted with
+// it.
+// +kubebuilder:printcolumn:name="CONFIG-NAME",type="string",JSONPath=".providerConfigRef.name"
+// +kubebuilder:printcolumn:name="RESOURCE-KIND",type="string",JSONPath=".resourceRef.kind"
+// +kubebuilder:printcolumn:name="RESOURCE-NAME",type="string",JSONPath=".resourceRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,gcp}
+type ProviderConfigUsage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// ProviderConfigReference to the ProviderConfig that is used.
+	ProviderConfigReference runtimev1alpha1.Reference `json:"providerConfigRef"`
+
+	// ResourceReference to the managed resource that uses it.
+	ResourceReference runtimev1alpha1.TypedReference `json:"resourceRef"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProviderConfigUsageList contains a list of ProviderConfigUsage
+type ProviderConfigUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProviderConfigUsage `json:"items"`
+}
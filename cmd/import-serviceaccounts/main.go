@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command import-serviceaccounts prints a ServiceAccount managed resource
+// for each existing service account of a GCP project. Applying the printed
+// resources brings the service accounts under Crossplane management without
+// recreating them.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	iamv1 "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	"gopkg.in/alecthomas/kingpin.v2"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/controller/iam"
+)
+
+func main() {
+	var (
+		app         = kingpin.New(filepath.Base(os.Args[0]), "Print ServiceAccount resources that adopt the existing service accounts of a GCP project.").DefaultEnvars()
+		project     = app.Flag("project", "ID or number of the GCP project whose service accounts are imported.").Required().String()
+		provider    = app.Flag("provider", "Name of the Provider the imported resources reference.").Required().String()
+		credentials = app.Flag("credentials", "Path to a service account key file. Application default credentials are used if unset.").ExistingFile()
+	)
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	ctx := context.Background()
+	opts := []option.ClientOption{gcp.UserAgentOption(nil)}
+	if *credentials != "" {
+		creds, err := ioutil.ReadFile(*credentials)
+		kingpin.FatalIfError(err, "Cannot read credentials")
+		opts = append(opts, option.WithCredentialsJSON(creds))
+	}
+
+	s, err := iamv1.NewService(ctx, opts...)
+	kingpin.FatalIfError(err, "Cannot create IAM API client")
+
+	rrn := iam.NewRelativeResourceNamer(*project)
+	if iam.IsProjectNumber(*project) {
+		id, err := iam.ResolveProjectID(ctx, *project, opts...)
+		kingpin.FatalIfError(err, "Cannot resolve project ID")
+		rrn = iam.NewProjectNumberResourceNamer(*project, id)
+	}
+
+	sas, err := iam.ImportServiceAccounts(ctx, iamv1.NewProjectsService(s).ServiceAccounts, rrn, &corev1.ObjectReference{Name: *provider})
+	kingpin.FatalIfError(err, "Cannot import service accounts")
+
+	for i := range sas {
+		y, err := yaml.Marshal(&sas[i])
+		kingpin.FatalIfError(err, "Cannot marshal ServiceAccount")
+		fmt.Printf("---\n%s", y)
+	}
+}
@@ -17,9 +17,12 @@ limitations under the License.
 package main
 
 import (
+	"net/http"
 	"os"
 	"path/filepath"
 
+	"go.opencensus.io/trace"
+	"go.opencensus.io/zpages"
 	"gopkg.in/alecthomas/kingpin.v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -28,7 +31,9 @@ import (
 	crossplaneapis "github.com/crossplane/crossplane/apis"
 
 	"github.com/crossplane/provider-gcp/apis"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/controller"
+	"github.com/crossplane/provider-gcp/pkg/controller/iam"
 )
 
 func main() {
@@ -36,6 +41,9 @@ func main() {
 		app        = kingpin.New(filepath.Base(os.Args[0]), "GCP support for Crossplane.").DefaultEnvars()
 		debug      = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncPeriod = app.Flag("sync", "Controller manager sync period such as 300ms, 1.5h, or 2h45m").Short('s').Default("1h").Duration()
+		tracing    = app.Flag("tracing", "Trace reconciles and the GCP API calls they make. Recent traces are served at /debug/tracez.").Bool()
+		traceAddr  = app.Flag("tracing-address", "Address at which recent traces are served when tracing is enabled.").Default(":8090").String()
+		saGrace    = app.Flag("serviceaccount-create-grace", "Period after a ServiceAccount is created during which it is not created again if GCP cannot yet find it.").Default(iam.DefaultCreateGracePeriod.String()).Duration()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -50,6 +58,19 @@ func main() {
 
 	log.Debug("Starting", "sync-period", syncPeriod.String())
 
+	if *tracing {
+		gcp.EnableTracing()
+		trace.ApplyConfig(trace.Config{DefaultSampler: trace.AlwaysSample()})
+		mux := http.NewServeMux()
+		zpages.Handle(mux, "/debug")
+		go func() {
+			kingpin.FatalIfError(http.ListenAndServe(*traceAddr, mux), "Cannot serve traces")
+		}()
+	}
+
+	iam.SetCreateGracePeriod(*saGrace)
+	gcp.SetRequestLogger(log.WithValues("component", "gcp-api"))
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
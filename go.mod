@@ -16,6 +16,7 @@ require (
 	github.com/mitchellh/copystructure v1.0.0
 	github.com/onsi/gomega v1.8.1
 	github.com/pkg/errors v0.8.1
+	go.opencensus.io v0.22.3
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
 	google.golang.org/api v0.21.0
 	google.golang.org/genproto v0.0.0-20200331122359-1ee6d9798940
@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// APIUpdateMask lists the fields of an API that may be updated.
+var APIUpdateMask = []string{"displayName", "labels"}
+
+// GenerateAPI takes APIParameters and returns an API.
+func GenerateAPI(in v1alpha1.APIParameters) *API {
+	return &API{
+		DisplayName:    gcp.StringValue(in.DisplayName),
+		Labels:         in.Labels,
+		ManagedService: gcp.StringValue(in.ManagedService),
+	}
+}
+
+// GenerateAPIObservation takes an API and returns an APIObservation.
+func GenerateAPIObservation(in API) v1alpha1.APIObservation {
+	return v1alpha1.APIObservation{
+		Name:       in.Name,
+		State:      in.State,
+		CreateTime: gcp.TimeFromRFC3339(in.CreateTime),
+		UpdateTime: gcp.TimeFromRFC3339(in.UpdateTime),
+	}
+}
+
+// LateInitializeAPI fills the empty fields of the supplied APIParameters with
+// those of the supplied API.
+func LateInitializeAPI(spec *v1alpha1.APIParameters, in API) {
+	spec.DisplayName = gcp.LateInitializeString(spec.DisplayName, in.DisplayName)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	spec.ManagedService = gcp.LateInitializeString(spec.ManagedService, in.ManagedService)
+}
+
+// IsAPIUpToDate returns true if the updatable fields of the supplied API match
+// the supplied APIParameters.
+func IsAPIUpToDate(in v1alpha1.APIParameters, observed API) bool {
+	return gcp.StringValue(in.DisplayName) == observed.DisplayName &&
+		gcp.LabelsUpToDate(in.Labels, observed.Labels)
+}
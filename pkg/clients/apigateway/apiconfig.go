@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Error strings.
+const (
+	errDocumentSource = "OpenAPI document %q must specify exactly one of contents or gcsSource"
+	errReadDocument   = "cannot read OpenAPI document %q from gs://%s/%s"
+)
+
+// APIConfigUpdateMask lists the fields of an API config that may be updated.
+var APIConfigUpdateMask = []string{"displayName", "labels"}
+
+// An ObjectReader reads the contents of a Cloud Storage object.
+type ObjectReader func(ctx context.Context, bucket, object string) ([]byte, error)
+
+// GenerateAPIConfig takes APIConfigParameters and returns an APIConfig. The
+// contents of OpenAPI documents sourced from Cloud Storage are read using the
+// supplied ObjectReader.
+func GenerateAPIConfig(ctx context.Context, in v1alpha1.APIConfigParameters, read ObjectReader) (*APIConfig, error) {
+	c := &APIConfig{
+		DisplayName:           gcp.StringValue(in.DisplayName),
+		Labels:                in.Labels,
+		GatewayServiceAccount: gcp.StringValue(in.GatewayServiceAccount),
+		OpenAPIDocuments:      make([]*OpenAPIDocument, len(in.OpenAPIDocuments)),
+	}
+	for i, d := range in.OpenAPIDocuments {
+		f := &File{Path: d.Path}
+		switch {
+		case d.Contents != nil && d.GCSSource == nil:
+			f.Contents = []byte(*d.Contents)
+		case d.Contents == nil && d.GCSSource != nil:
+			b, err := read(ctx, d.GCSSource.Bucket, d.GCSSource.Object)
+			if err != nil {
+				return nil, errors.Wrapf(err, errReadDocument, d.Path, d.GCSSource.Bucket, d.GCSSource.Object)
+			}
+			f.Contents = b
+		default:
+			return nil, errors.Errorf(errDocumentSource, d.Path)
+		}
+		c.OpenAPIDocuments[i] = &OpenAPIDocument{Document: f}
+	}
+	return c, nil
+}
+
+// GenerateAPIConfigObservation takes an APIConfig and returns an
+// APIConfigObservation.
+func GenerateAPIConfigObservation(in APIConfig) v1alpha1.APIConfigObservation {
+	return v1alpha1.APIConfigObservation{
+		Name:            in.Name,
+		ServiceConfigID: in.ServiceConfigID,
+		State:           in.State,
+		CreateTime:      gcp.TimeFromRFC3339(in.CreateTime),
+		UpdateTime:      gcp.TimeFromRFC3339(in.UpdateTime),
+	}
+}
+
+// LateInitializeAPIConfig fills the empty fields of the supplied
+// APIConfigParameters with those of the supplied APIConfig.
+func LateInitializeAPIConfig(spec *v1alpha1.APIConfigParameters, in APIConfig) {
+	spec.DisplayName = gcp.LateInitializeString(spec.DisplayName, in.DisplayName)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	spec.GatewayServiceAccount = gcp.LateInitializeString(spec.GatewayServiceAccount, in.GatewayServiceAccount)
+}
+
+// IsAPIConfigUpToDate returns true if the updatable fields of the supplied
+// APIConfig match the supplied APIConfigParameters. All other fields of an API
+// config are immutable.
+func IsAPIConfigUpToDate(in v1alpha1.APIConfigParameters, observed APIConfig) bool {
+	return gcp.StringValue(in.DisplayName) == observed.DisplayName &&
+		gcp.LabelsUpToDate(in.Labels, observed.Labels)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestGenerateAPIConfig(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	read := func(b []byte, err error) ObjectReader {
+		return func(_ context.Context, bucket, object string) ([]byte, error) {
+			if diff := cmp.Diff("bucket/specs/openapi.yaml", bucket+"/"+object); diff != "" {
+				t.Errorf("read: -want, +got:\n%s", diff)
+			}
+			return b, err
+		}
+	}
+
+	type args struct {
+		in   v1alpha1.APIConfigParameters
+		read ObjectReader
+	}
+	type want struct {
+		out *APIConfig
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"InlineContents": {
+			args: args{
+				in: v1alpha1.APIConfigParameters{
+					DisplayName:           gcp.StringPtr("v1"),
+					GatewayServiceAccount: gcp.StringPtr("gw@project.iam.gserviceaccount.com"),
+					OpenAPIDocuments:      []v1alpha1.OpenAPIDocument{{Path: "openapi.yaml", Contents: gcp.StringPtr("swagger: '2.0'")}},
+				},
+			},
+			want: want{
+				out: &APIConfig{
+					DisplayName:           "v1",
+					GatewayServiceAccount: "gw@project.iam.gserviceaccount.com",
+					OpenAPIDocuments:      []*OpenAPIDocument{{Document: &File{Path: "openapi.yaml", Contents: []byte("swagger: '2.0'")}}},
+				},
+			},
+		},
+		"GCSSource": {
+			args: args{
+				in: v1alpha1.APIConfigParameters{
+					OpenAPIDocuments: []v1alpha1.OpenAPIDocument{{
+						Path:      "openapi.yaml",
+						GCSSource: &v1alpha1.GCSSource{Bucket: "bucket", Object: "specs/openapi.yaml"},
+					}},
+				},
+				read: read([]byte("swagger: '2.0'"), nil),
+			},
+			want: want{
+				out: &APIConfig{
+					OpenAPIDocuments: []*OpenAPIDocument{{Document: &File{Path: "openapi.yaml", Contents: []byte("swagger: '2.0'")}}},
+				},
+			},
+		},
+		"ReadFailed": {
+			args: args{
+				in: v1alpha1.APIConfigParameters{
+					OpenAPIDocuments: []v1alpha1.OpenAPIDocument{{
+						Path:      "openapi.yaml",
+						GCSSource: &v1alpha1.GCSSource{Bucket: "bucket", Object: "specs/openapi.yaml"},
+					}},
+				},
+				read: read(nil, errBoom),
+			},
+			want: want{
+				err: errors.Wrapf(errBoom, errReadDocument, "openapi.yaml", "bucket", "specs/openapi.yaml"),
+			},
+		},
+		"NoSource": {
+			args: args{
+				in: v1alpha1.APIConfigParameters{
+					OpenAPIDocuments: []v1alpha1.OpenAPIDocument{{Path: "openapi.yaml"}},
+				},
+			},
+			want: want{
+				err: errors.Errorf(errDocumentSource, "openapi.yaml"),
+			},
+		},
+		"BothSources": {
+			args: args{
+				in: v1alpha1.APIConfigParameters{
+					OpenAPIDocuments: []v1alpha1.OpenAPIDocument{{
+						Path:      "openapi.yaml",
+						Contents:  gcp.StringPtr("swagger: '2.0'"),
+						GCSSource: &v1alpha1.GCSSource{Bucket: "bucket", Object: "specs/openapi.yaml"},
+					}},
+				},
+			},
+			want: want{
+				err: errors.Errorf(errDocumentSource, "openapi.yaml"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateAPIConfig(context.Background(), tc.args.in, tc.args.read)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GenerateAPIConfig(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("GenerateAPIConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
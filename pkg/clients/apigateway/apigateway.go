@@ -0,0 +1,214 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apigateway contains a client for the GCP API Gateway API and
+// utilities to convert between its resources and managed resources.
+//
+// The Google API client library used by this provider predates API Gateway, so
+// this package implements the small subset of the v1 REST API that the API
+// Gateway controllers need on top of the same authenticated transport.
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Client defaults.
+const (
+	DefaultEndpoint    = "https://apigateway.googleapis.com/"
+	CloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// locationGlobal is the location of all APIs and API configs.
+const locationGlobal = "global"
+
+// An API is an API Gateway API.
+type API struct {
+	Name           string            `json:"name,omitempty"`
+	DisplayName    string            `json:"displayName,omitempty"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	ManagedService string            `json:"managedService,omitempty"`
+	State          string            `json:"state,omitempty"`
+	CreateTime     string            `json:"createTime,omitempty"`
+	UpdateTime     string            `json:"updateTime,omitempty"`
+}
+
+// An APIConfig is an API Gateway API config.
+type APIConfig struct {
+	Name                  string             `json:"name,omitempty"`
+	DisplayName           string             `json:"displayName,omitempty"`
+	Labels                map[string]string  `json:"labels,omitempty"`
+	GatewayServiceAccount string             `json:"gatewayServiceAccount,omitempty"`
+	OpenAPIDocuments      []*OpenAPIDocument `json:"openapiDocuments,omitempty"`
+	ServiceConfigID       string             `json:"serviceConfigId,omitempty"`
+	State                 string             `json:"state,omitempty"`
+	CreateTime            string             `json:"createTime,omitempty"`
+	UpdateTime            string             `json:"updateTime,omitempty"`
+}
+
+// An OpenAPIDocument is an OpenAPI spec of an APIConfig.
+type OpenAPIDocument struct {
+	Document *File `json:"document,omitempty"`
+}
+
+// A File is the path and contents of a file. Contents are base64 encoded on
+// the wire.
+type File struct {
+	Path     string `json:"path,omitempty"`
+	Contents []byte `json:"contents,omitempty"`
+}
+
+// A Gateway is an API Gateway gateway.
+type Gateway struct {
+	Name            string            `json:"name,omitempty"`
+	DisplayName     string            `json:"displayName,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	APIConfig       string            `json:"apiConfig,omitempty"`
+	DefaultHostname string            `json:"defaultHostname,omitempty"`
+	State           string            `json:"state,omitempty"`
+	CreateTime      string            `json:"createTime,omitempty"`
+	UpdateTime      string            `json:"updateTime,omitempty"`
+}
+
+// An Operation is a long running operation started by a mutating call.
+type Operation struct {
+	Name string `json:"name,omitempty"`
+	Done bool   `json:"done,omitempty"`
+}
+
+// APIName returns the resource name of the supplied API.
+func APIName(project, api string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/apis/%s", project, locationGlobal, api)
+}
+
+// APIConfigName returns the resource name of the supplied API config.
+func APIConfigName(project, api, config string) string {
+	return APIName(project, api) + "/configs/" + config
+}
+
+// GatewayName returns the resource name of the supplied gateway.
+func GatewayName(project, region, gateway string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/gateways/%s", project, region, gateway)
+}
+
+// A Service calls the API Gateway API.
+type Service struct {
+	json *gcp.JSONClient
+}
+
+// NewService returns a Service configured per the supplied options.
+func NewService(ctx context.Context, opts ...option.ClientOption) (*Service, error) {
+	c, err := gcp.NewJSONClient(ctx, DefaultEndpoint, append([]option.ClientOption{option.WithScopes(CloudPlatformScope)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{json: c}, nil
+}
+
+// GetAPI returns the named API.
+func (s *Service) GetAPI(ctx context.Context, name string) (*API, error) {
+	a := &API{}
+	return a, s.do(ctx, http.MethodGet, name, nil, nil, a)
+}
+
+// CreateAPI creates the supplied API in the supplied project.
+func (s *Service) CreateAPI(ctx context.Context, project, id string, a *API) (*Operation, error) {
+	parent := fmt.Sprintf("projects/%s/locations/%s", project, locationGlobal)
+	return s.create(ctx, parent+"/apis", url.Values{"apiId": {id}}, a)
+}
+
+// PatchAPI updates the supplied fields of the named API.
+func (s *Service) PatchAPI(ctx context.Context, name string, a *API, mask ...string) (*Operation, error) {
+	return s.patch(ctx, name, a, mask)
+}
+
+// DeleteAPI deletes the named API.
+func (s *Service) DeleteAPI(ctx context.Context, name string) (*Operation, error) {
+	return s.delete(ctx, name)
+}
+
+// GetAPIConfig returns the named API config.
+func (s *Service) GetAPIConfig(ctx context.Context, name string) (*APIConfig, error) {
+	c := &APIConfig{}
+	return c, s.do(ctx, http.MethodGet, name, url.Values{"view": {"BASIC"}}, nil, c)
+}
+
+// CreateAPIConfig creates the supplied config of the supplied API.
+func (s *Service) CreateAPIConfig(ctx context.Context, project, api, id string, c *APIConfig) (*Operation, error) {
+	return s.create(ctx, APIName(project, api)+"/configs", url.Values{"apiConfigId": {id}}, c)
+}
+
+// PatchAPIConfig updates the supplied fields of the named API config.
+func (s *Service) PatchAPIConfig(ctx context.Context, name string, c *APIConfig, mask ...string) (*Operation, error) {
+	return s.patch(ctx, name, c, mask)
+}
+
+// DeleteAPIConfig deletes the named API config.
+func (s *Service) DeleteAPIConfig(ctx context.Context, name string) (*Operation, error) {
+	return s.delete(ctx, name)
+}
+
+// GetGateway returns the named gateway.
+func (s *Service) GetGateway(ctx context.Context, name string) (*Gateway, error) {
+	g := &Gateway{}
+	return g, s.do(ctx, http.MethodGet, name, nil, nil, g)
+}
+
+// CreateGateway creates the supplied gateway in the supplied project and
+// region.
+func (s *Service) CreateGateway(ctx context.Context, project, region, id string, g *Gateway) (*Operation, error) {
+	parent := fmt.Sprintf("projects/%s/locations/%s", project, region)
+	return s.create(ctx, parent+"/gateways", url.Values{"gatewayId": {id}}, g)
+}
+
+// PatchGateway updates the supplied fields of the named gateway.
+func (s *Service) PatchGateway(ctx context.Context, name string, g *Gateway, mask ...string) (*Operation, error) {
+	return s.patch(ctx, name, g, mask)
+}
+
+// DeleteGateway deletes the named gateway.
+func (s *Service) DeleteGateway(ctx context.Context, name string) (*Operation, error) {
+	return s.delete(ctx, name)
+}
+
+func (s *Service) create(ctx context.Context, collection string, q url.Values, in interface{}) (*Operation, error) {
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodPost, collection, q, in, op)
+}
+
+func (s *Service) patch(ctx context.Context, name string, in interface{}, mask []string) (*Operation, error) {
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodPatch, name, url.Values{"updateMask": {strings.Join(mask, ",")}}, in, op)
+}
+
+func (s *Service) delete(ctx context.Context, name string) (*Operation, error) {
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodDelete, name, nil, nil, op)
+}
+
+// do sends a request for the supplied resource path and decodes the response
+// into out.
+func (s *Service) do(ctx context.Context, method, path string, q url.Values, in, out interface{}) error {
+	return s.json.Do(ctx, method, "v1/"+path, q, in, out)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"strings"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GatewayUpdateMask lists the fields of a gateway that may be updated.
+var GatewayUpdateMask = []string{"apiConfig", "displayName", "labels"}
+
+// GenerateGateway takes GatewayParameters and returns a Gateway.
+func GenerateGateway(in v1alpha1.GatewayParameters) *Gateway {
+	return &Gateway{
+		APIConfig:   gcp.StringValue(in.APIConfig),
+		DisplayName: gcp.StringValue(in.DisplayName),
+		Labels:      in.Labels,
+	}
+}
+
+// GenerateGatewayObservation takes a Gateway and returns a
+// GatewayObservation.
+func GenerateGatewayObservation(in Gateway) v1alpha1.GatewayObservation {
+	return v1alpha1.GatewayObservation{
+		Name:            in.Name,
+		DefaultHostname: in.DefaultHostname,
+		State:           in.State,
+		CreateTime:      gcp.TimeFromRFC3339(in.CreateTime),
+		UpdateTime:      gcp.TimeFromRFC3339(in.UpdateTime),
+	}
+}
+
+// LateInitializeGateway fills the empty fields of the supplied
+// GatewayParameters with those of the supplied Gateway.
+func LateInitializeGateway(spec *v1alpha1.GatewayParameters, in Gateway) {
+	spec.APIConfig = gcp.LateInitializeString(spec.APIConfig, in.APIConfig)
+	spec.DisplayName = gcp.LateInitializeString(spec.DisplayName, in.DisplayName)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+}
+
+// IsGatewayUpToDate returns true if the updatable fields of the supplied
+// Gateway match the supplied GatewayParameters.
+func IsGatewayUpToDate(in v1alpha1.GatewayParameters, observed Gateway) bool {
+	return sameAPIConfig(gcp.StringValue(in.APIConfig), observed.APIConfig) &&
+		gcp.StringValue(in.DisplayName) == observed.DisplayName &&
+		gcp.LabelsUpToDate(in.Labels, observed.Labels)
+}
+
+// sameAPIConfig returns true if the supplied API config resource names refer
+// to the same config. The API may identify the project of a config by its
+// number rather than its ID, so the project segment is not compared.
+func sameAPIConfig(a, b string) bool {
+	return withoutProject(a) == withoutProject(b)
+}
+
+func withoutProject(name string) string {
+	parts := strings.SplitN(name, "/", 3)
+	if len(parts) != 3 || parts[0] != "projects" {
+		return name
+	}
+	return parts[2]
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const testConfig = "projects/my-project/locations/global/apis/api/configs/v1"
+
+func TestIsGatewayUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.GatewayParameters
+		observed Gateway
+		want     bool
+	}{
+		"UpToDate": {
+			in:       v1alpha1.GatewayParameters{APIConfig: gcp.StringPtr(testConfig), Labels: map[string]string{"a": "b"}},
+			observed: Gateway{APIConfig: testConfig, Labels: map[string]string{"a": "b"}},
+			want:     true,
+		},
+		"ProjectNumber": {
+			in:       v1alpha1.GatewayParameters{APIConfig: gcp.StringPtr(testConfig)},
+			observed: Gateway{APIConfig: "projects/123456/locations/global/apis/api/configs/v1"},
+			want:     true,
+		},
+		"APIConfigChanged": {
+			in:       v1alpha1.GatewayParameters{APIConfig: gcp.StringPtr("projects/my-project/locations/global/apis/api/configs/v2")},
+			observed: Gateway{APIConfig: testConfig},
+			want:     false,
+		},
+		"DisplayNameChanged": {
+			in:       v1alpha1.GatewayParameters{APIConfig: gcp.StringPtr(testConfig), DisplayName: gcp.StringPtr("new")},
+			observed: Gateway{APIConfig: testConfig, DisplayName: "old"},
+			want:     false,
+		},
+		"LabelsChanged": {
+			in:       v1alpha1.GatewayParameters{APIConfig: gcp.StringPtr(testConfig), Labels: map[string]string{"a": "c"}},
+			observed: Gateway{APIConfig: testConfig, Labels: map[string]string{"a": "b"}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsGatewayUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsGatewayUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package auditconfig contains utilities to manage the audit configs of a
+// project's IAM policy.
+package auditconfig
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+// PolicyVersion is the IAM policy version that is requested and set. Policies
+// with conditional bindings must be read and written with version 3, or their
+// bindings are lost.
+const PolicyVersion = 3
+
+// UpdateMask limits setting an IAM policy to its audit configs, so that the
+// bindings of the policy are never changed.
+const UpdateMask = "auditConfigs"
+
+// GenerateAuditConfig takes a ProjectAuditConfigParameters and returns the
+// *crm.AuditConfig that should be part of the project's IAM policy.
+func GenerateAuditConfig(in v1alpha1.ProjectAuditConfigParameters) *crm.AuditConfig {
+	ac := &crm.AuditConfig{Service: in.Service}
+	for _, c := range in.AuditLogConfigs {
+		ac.AuditLogConfigs = append(ac.AuditLogConfigs, &crm.AuditLogConfig{
+			LogType:         c.LogType,
+			ExemptedMembers: c.ExemptedMembers,
+		})
+	}
+	return ac
+}
+
+// GenerateObservation takes the IAM policy of a project and returns a
+// ProjectAuditConfigObservation.
+func GenerateObservation(in crm.Policy) v1alpha1.ProjectAuditConfigObservation {
+	return v1alpha1.ProjectAuditConfigObservation{Etag: in.Etag}
+}
+
+// Find returns the audit config of the supplied service, or nil if the
+// supplied policy does not configure audit logging for it.
+func Find(p *crm.Policy, service string) *crm.AuditConfig {
+	for _, ac := range p.AuditConfigs {
+		if ac.Service == service {
+			return ac
+		}
+	}
+	return nil
+}
+
+// Set replaces the audit config of the supplied config's service in the
+// supplied policy, or adds it if the policy has none.
+func Set(p *crm.Policy, ac *crm.AuditConfig) {
+	for i := range p.AuditConfigs {
+		if p.AuditConfigs[i].Service == ac.Service {
+			p.AuditConfigs[i] = ac
+			return
+		}
+	}
+	p.AuditConfigs = append(p.AuditConfigs, ac)
+}
+
+// Remove removes the audit config of the supplied service from the supplied
+// policy. It returns false if the policy has no audit config for the service.
+func Remove(p *crm.Policy, service string) bool {
+	for i := range p.AuditConfigs {
+		if p.AuditConfigs[i].Service == service {
+			p.AuditConfigs = append(p.AuditConfigs[:i], p.AuditConfigs[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// IsUpToDate returns true if the supplied audit config enables the log types
+// and exempts the members of the supplied ProjectAuditConfigParameters. The
+// order of log types and exempted members is not significant.
+func IsUpToDate(in v1alpha1.ProjectAuditConfigParameters, observed crm.AuditConfig) bool {
+	return cmp.Equal(GenerateAuditConfig(in).AuditLogConfigs, observed.AuditLogConfigs,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b *crm.AuditLogConfig) bool { return a.LogType < b.LogType }),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.IgnoreFields(crm.AuditLogConfig{}, "ForceSendFields", "NullFields"))
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditconfig
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+func params() v1alpha1.ProjectAuditConfigParameters {
+	return v1alpha1.ProjectAuditConfigParameters{
+		Project: "some-project",
+		Service: "storage.googleapis.com",
+		AuditLogConfigs: []v1alpha1.AuditLogConfig{
+			{LogType: v1alpha1.AuditLogTypeDataRead, ExemptedMembers: []string{"user:a@example.com", "user:b@example.com"}},
+			{LogType: v1alpha1.AuditLogTypeDataWrite},
+		},
+	}
+}
+
+func TestSet(t *testing.T) {
+	storage := &crm.AuditConfig{Service: "storage.googleapis.com"}
+	all := &crm.AuditConfig{Service: v1alpha1.AuditConfigAllServices}
+	updated := &crm.AuditConfig{Service: "storage.googleapis.com", AuditLogConfigs: []*crm.AuditLogConfig{{LogType: v1alpha1.AuditLogTypeAdminRead}}}
+
+	cases := map[string]struct {
+		policy *crm.Policy
+		ac     *crm.AuditConfig
+		want   *crm.Policy
+	}{
+		"Added": {
+			policy: &crm.Policy{AuditConfigs: []*crm.AuditConfig{all}},
+			ac:     storage,
+			want:   &crm.Policy{AuditConfigs: []*crm.AuditConfig{all, storage}},
+		},
+		"Replaced": {
+			policy: &crm.Policy{AuditConfigs: []*crm.AuditConfig{storage, all}},
+			ac:     updated,
+			want:   &crm.Policy{AuditConfigs: []*crm.AuditConfig{updated, all}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			Set(tc.policy, tc.ac)
+			if diff := cmp.Diff(tc.want, tc.policy); diff != "" {
+				t.Errorf("Set(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	storage := &crm.AuditConfig{Service: "storage.googleapis.com"}
+	all := &crm.AuditConfig{Service: v1alpha1.AuditConfigAllServices}
+
+	type want struct {
+		policy  *crm.Policy
+		removed bool
+	}
+
+	cases := map[string]struct {
+		policy *crm.Policy
+		want   want
+	}{
+		"Removed": {
+			policy: &crm.Policy{AuditConfigs: []*crm.AuditConfig{all, storage}},
+			want:   want{policy: &crm.Policy{AuditConfigs: []*crm.AuditConfig{all}}, removed: true},
+		},
+		"NotConfigured": {
+			policy: &crm.Policy{AuditConfigs: []*crm.AuditConfig{all}},
+			want:   want{policy: &crm.Policy{AuditConfigs: []*crm.AuditConfig{all}}, removed: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			removed := Remove(tc.policy, "storage.googleapis.com")
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("Remove(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("Remove(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		observed crm.AuditConfig
+		want     bool
+	}{
+		"UpToDate": {
+			observed: *GenerateAuditConfig(params()),
+			want:     true,
+		},
+		"DifferentOrder": {
+			observed: crm.AuditConfig{AuditLogConfigs: []*crm.AuditLogConfig{
+				{LogType: v1alpha1.AuditLogTypeDataWrite},
+				{LogType: v1alpha1.AuditLogTypeDataRead, ExemptedMembers: []string{"user:b@example.com", "user:a@example.com"}},
+			}},
+			want: true,
+		},
+		"LogTypeMissing": {
+			observed: crm.AuditConfig{AuditLogConfigs: []*crm.AuditLogConfig{
+				{LogType: v1alpha1.AuditLogTypeDataRead, ExemptedMembers: []string{"user:a@example.com", "user:b@example.com"}},
+			}},
+			want: false,
+		},
+		"ExemptedMemberAdded": {
+			observed: crm.AuditConfig{AuditLogConfigs: []*crm.AuditLogConfig{
+				{LogType: v1alpha1.AuditLogTypeDataRead, ExemptedMembers: []string{"user:a@example.com", "user:b@example.com", "user:c@example.com"}},
+				{LogType: v1alpha1.AuditLogTypeDataWrite},
+			}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(params(), tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"path"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Error strings.
+const (
+	errNoLocation         = "exactly one of zone and region must be set"
+	errInvalidUtilization = "cannot parse utilization target %q"
+)
+
+// Location returns the zone or region of an autoscaler with the supplied
+// AutoscalerParameters, and whether the autoscaler is regional. Zonal and
+// regional autoscalers are managed via different Compute Engine endpoints.
+func Location(in v1alpha1.AutoscalerParameters) (string, bool, error) {
+	switch {
+	case in.Zone != nil && in.Region == nil:
+		return path.Base(*in.Zone), false, nil
+	case in.Region != nil && in.Zone == nil:
+		return path.Base(*in.Region), true, nil
+	default:
+		return "", false, errors.New(errNoLocation)
+	}
+}
+
+// GenerateAutoscaler creates a *compute.Autoscaler from the supplied
+// AutoscalerParameters.
+func GenerateAutoscaler(name string, in v1alpha1.AutoscalerParameters) (*compute.Autoscaler, error) {
+	p, err := GenerateAutoscalingPolicy(in.AutoscalingPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &compute.Autoscaler{
+		Name:              name,
+		Description:       gcp.StringValue(in.Description),
+		Target:            in.Target,
+		AutoscalingPolicy: p,
+	}, nil
+}
+
+// GenerateAutoscalingPolicy creates a *compute.AutoscalingPolicy from the
+// supplied AutoscalingPolicy.
+func GenerateAutoscalingPolicy(in v1alpha1.AutoscalingPolicy) (*compute.AutoscalingPolicy, error) {
+	p := &compute.AutoscalingPolicy{
+		MinNumReplicas:    gcp.Int64Value(in.MinNumReplicas),
+		MaxNumReplicas:    in.MaxNumReplicas,
+		CoolDownPeriodSec: gcp.Int64Value(in.CoolDownPeriodSec),
+		Mode:              gcp.StringValue(in.Mode),
+	}
+	if in.MinNumReplicas != nil {
+		// A minimum of zero replicas must be sent explicitly, or the API
+		// will default it to one.
+		p.ForceSendFields = []string{"MinNumReplicas"}
+	}
+	if in.CPUUtilization != nil {
+		t, err := parseUtilization(in.CPUUtilization.UtilizationTarget)
+		if err != nil {
+			return nil, err
+		}
+		p.CpuUtilization = &compute.AutoscalingPolicyCpuUtilization{UtilizationTarget: t}
+	}
+	if in.LoadBalancingUtilization != nil {
+		t, err := parseUtilization(in.LoadBalancingUtilization.UtilizationTarget)
+		if err != nil {
+			return nil, err
+		}
+		p.LoadBalancingUtilization = &compute.AutoscalingPolicyLoadBalancingUtilization{UtilizationTarget: t}
+	}
+	for _, m := range in.CustomMetricUtilizations {
+		t, err := parseUtilization(m.UtilizationTarget)
+		if err != nil {
+			return nil, err
+		}
+		p.CustomMetricUtilizations = append(p.CustomMetricUtilizations, &compute.AutoscalingPolicyCustomMetricUtilization{
+			Metric:                m.Metric,
+			UtilizationTarget:     t,
+			UtilizationTargetType: gcp.StringValue(m.UtilizationTargetType),
+		})
+	}
+	return p, nil
+}
+
+// GenerateAutoscalerObservation creates an AutoscalerObservation from the
+// supplied compute.Autoscaler.
+func GenerateAutoscalerObservation(in compute.Autoscaler) v1alpha1.AutoscalerObservation {
+	o := v1alpha1.AutoscalerObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+		RecommendedSize:   in.RecommendedSize,
+	}
+	for _, d := range in.StatusDetails {
+		if d == nil {
+			continue
+		}
+		o.StatusDetails = append(o.StatusDetails, v1alpha1.AutoscalerStatusDetails{Type: d.Type, Message: d.Message})
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.Autoscaler object.
+func LateInitializeSpec(spec *v1alpha1.AutoscalerParameters, in compute.Autoscaler) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	if in.AutoscalingPolicy == nil {
+		return
+	}
+	p := &spec.AutoscalingPolicy
+	if p.MinNumReplicas == nil {
+		p.MinNumReplicas = gcp.Int64Ptr(in.AutoscalingPolicy.MinNumReplicas)
+	}
+	p.CoolDownPeriodSec = gcp.LateInitializeInt64(p.CoolDownPeriodSec, in.AutoscalingPolicy.CoolDownPeriodSec)
+	p.Mode = gcp.LateInitializeString(p.Mode, in.AutoscalingPolicy.Mode)
+
+	// The API scales on CPU utilization if no other signal is configured.
+	if p.CPUUtilization == nil && in.AutoscalingPolicy.CpuUtilization != nil {
+		p.CPUUtilization = &v1alpha1.AutoscalingPolicyUtilization{
+			UtilizationTarget: formatUtilization(in.AutoscalingPolicy.CpuUtilization.UtilizationTarget),
+		}
+	}
+	for i := range p.CustomMetricUtilizations {
+		if i >= len(in.AutoscalingPolicy.CustomMetricUtilizations) {
+			break
+		}
+		m := in.AutoscalingPolicy.CustomMetricUtilizations[i]
+		if m == nil || m.Metric != p.CustomMetricUtilizations[i].Metric {
+			continue
+		}
+		p.CustomMetricUtilizations[i].UtilizationTargetType = gcp.LateInitializeString(p.CustomMetricUtilizations[i].UtilizationTargetType, m.UtilizationTargetType)
+	}
+}
+
+// IsUpToDate checks whether the observed compute.Autoscaler matches the
+// supplied AutoscalerParameters. Only the description and the autoscaling
+// policy may be updated in place.
+func IsUpToDate(in v1alpha1.AutoscalerParameters, observed *compute.Autoscaler) (bool, error) {
+	desired, err := GenerateAutoscalingPolicy(in.AutoscalingPolicy)
+	if err != nil {
+		return false, err
+	}
+	if gcp.StringValue(in.Description) != observed.Description {
+		return false, nil
+	}
+	return cmp.Equal(desired, observed.AutoscalingPolicy,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(compute.AutoscalingPolicy{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.AutoscalingPolicyCpuUtilization{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.AutoscalingPolicyLoadBalancingUtilization{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.AutoscalingPolicyCustomMetricUtilization{}, "ForceSendFields", "NullFields"),
+	), nil
+}
+
+func parseUtilization(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	return f, errors.Wrapf(err, errInvalidUtilization, s)
+}
+
+func formatUtilization(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
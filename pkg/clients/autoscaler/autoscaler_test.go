@@ -0,0 +1,282 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "some-autoscaler"
+	testZone   = "us-central1-a"
+	testTarget = "zones/us-central1-a/instanceGroupManagers/web"
+	testMetric = "custom.googleapis.com/queue_depth"
+)
+
+func params(m ...func(*v1alpha1.AutoscalerParameters)) *v1alpha1.AutoscalerParameters {
+	p := &v1alpha1.AutoscalerParameters{
+		Zone:        gcp.StringPtr(testZone),
+		Description: gcp.StringPtr("desc"),
+		Target:      testTarget,
+		AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+			MinNumReplicas:           gcp.Int64Ptr(0),
+			MaxNumReplicas:           10,
+			CoolDownPeriodSec:        gcp.Int64Ptr(90),
+			Mode:                     gcp.StringPtr("ONLY_UP"),
+			CPUUtilization:           &v1alpha1.AutoscalingPolicyUtilization{UtilizationTarget: "0.75"},
+			LoadBalancingUtilization: &v1alpha1.AutoscalingPolicyUtilization{UtilizationTarget: "0.8"},
+			CustomMetricUtilizations: []v1alpha1.AutoscalingPolicyCustomMetricUtilization{{
+				Metric:                testMetric,
+				UtilizationTarget:     "100.5",
+				UtilizationTargetType: gcp.StringPtr("GAUGE"),
+			}},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func autoscaler(m ...func(*compute.Autoscaler)) *compute.Autoscaler {
+	a := &compute.Autoscaler{
+		Name:        testName,
+		Description: "desc",
+		Target:      testTarget,
+		AutoscalingPolicy: &compute.AutoscalingPolicy{
+			MinNumReplicas:           0,
+			MaxNumReplicas:           10,
+			CoolDownPeriodSec:        90,
+			Mode:                     "ONLY_UP",
+			CpuUtilization:           &compute.AutoscalingPolicyCpuUtilization{UtilizationTarget: 0.75},
+			LoadBalancingUtilization: &compute.AutoscalingPolicyLoadBalancingUtilization{UtilizationTarget: 0.8},
+			CustomMetricUtilizations: []*compute.AutoscalingPolicyCustomMetricUtilization{{
+				Metric:                testMetric,
+				UtilizationTarget:     100.5,
+				UtilizationTargetType: "GAUGE",
+			}},
+			ForceSendFields: []string{"MinNumReplicas"},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestLocation(t *testing.T) {
+	type want struct {
+		location string
+		regional bool
+		err      error
+	}
+	cases := map[string]struct {
+		in   *v1alpha1.AutoscalerParameters
+		want want
+	}{
+		"Zonal": {
+			in:   params(),
+			want: want{location: testZone},
+		},
+		"RegionalURI": {
+			in: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.Zone = nil
+				p.Region = gcp.StringPtr("https://www.googleapis.com/compute/v1/projects/example/regions/us-central1")
+			}),
+			want: want{location: "us-central1", regional: true},
+		},
+		"Neither": {
+			in:   params(func(p *v1alpha1.AutoscalerParameters) { p.Zone = nil }),
+			want: want{err: errors.New(errNoLocation)},
+		},
+		"Both": {
+			in:   params(func(p *v1alpha1.AutoscalerParameters) { p.Region = gcp.StringPtr("us-central1") }),
+			want: want{err: errors.New(errNoLocation)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			location, regional, err := Location(*tc.in)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Location(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.location, location); diff != "" {
+				t.Errorf("Location(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.regional, regional); diff != "" {
+				t.Errorf("Location(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAutoscaler(t *testing.T) {
+	type want struct {
+		out *compute.Autoscaler
+		err error
+	}
+	cases := map[string]struct {
+		in   *v1alpha1.AutoscalerParameters
+		want want
+	}{
+		"Full": {
+			in:   params(),
+			want: want{out: autoscaler()},
+		},
+		"DefaultMinimum": {
+			in: params(func(p *v1alpha1.AutoscalerParameters) { p.AutoscalingPolicy.MinNumReplicas = nil }),
+			want: want{out: autoscaler(func(a *compute.Autoscaler) {
+				a.AutoscalingPolicy.ForceSendFields = nil
+			})},
+		},
+		"InvalidUtilization": {
+			in: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.CPUUtilization.UtilizationTarget = "most"
+			}),
+			want: want{err: errors.Wrapf(errors.New(`strconv.ParseFloat: parsing "most": invalid syntax`), errInvalidUtilization, "most")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateAutoscaler(testName, *tc.in)
+			if tc.want.err != nil {
+				if err == nil || tc.want.err.Error() != err.Error() {
+					t.Errorf("GenerateAutoscaler(...): want error %v, got %v", tc.want.err, err)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("GenerateAutoscaler(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.AutoscalerParameters
+		in   *compute.Autoscaler
+		want *v1alpha1.AutoscalerParameters
+	}{
+		"AllFilledAlready": {
+			spec: params(),
+			in: autoscaler(func(a *compute.Autoscaler) {
+				a.AutoscalingPolicy.Mode = "ON"
+			}),
+			want: params(),
+		},
+		"APIDefaults": {
+			spec: &v1alpha1.AutoscalerParameters{
+				Zone:   gcp.StringPtr(testZone),
+				Target: testTarget,
+				AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+					MaxNumReplicas: 10,
+					CustomMetricUtilizations: []v1alpha1.AutoscalingPolicyCustomMetricUtilization{{
+						Metric:            testMetric,
+						UtilizationTarget: "100.5",
+					}},
+				},
+			},
+			in: autoscaler(func(a *compute.Autoscaler) {
+				a.Description = ""
+				a.AutoscalingPolicy.MinNumReplicas = 1
+				a.AutoscalingPolicy.CpuUtilization.UtilizationTarget = 0.6
+			}),
+			want: &v1alpha1.AutoscalerParameters{
+				Zone:   gcp.StringPtr(testZone),
+				Target: testTarget,
+				AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+					MinNumReplicas:    gcp.Int64Ptr(1),
+					MaxNumReplicas:    10,
+					CoolDownPeriodSec: gcp.Int64Ptr(90),
+					Mode:              gcp.StringPtr("ONLY_UP"),
+					CPUUtilization:    &v1alpha1.AutoscalingPolicyUtilization{UtilizationTarget: "0.6"},
+					CustomMetricUtilizations: []v1alpha1.AutoscalingPolicyCustomMetricUtilization{{
+						Metric:                testMetric,
+						UtilizationTarget:     "100.5",
+						UtilizationTargetType: gcp.StringPtr("GAUGE"),
+					}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, *tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.AutoscalerParameters
+		obs  *compute.Autoscaler
+		want bool
+	}{
+		"UpToDate": {
+			in: params(),
+			obs: autoscaler(func(a *compute.Autoscaler) {
+				a.Status = "ACTIVE"
+				a.RecommendedSize = 4
+				a.AutoscalingPolicy.ForceSendFields = nil
+			}),
+			want: true,
+		},
+		"UtilizationTargetChanged": {
+			in: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.CPUUtilization.UtilizationTarget = "0.5"
+			}),
+			obs:  autoscaler(),
+			want: false,
+		},
+		"CustomMetricRemoved": {
+			in: params(func(p *v1alpha1.AutoscalerParameters) {
+				p.AutoscalingPolicy.CustomMetricUtilizations = nil
+			}),
+			obs:  autoscaler(),
+			want: false,
+		},
+		"DescriptionChanged": {
+			in:   params(func(p *v1alpha1.AutoscalerParameters) { p.Description = gcp.StringPtr("new") }),
+			obs:  autoscaler(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(*tc.in, tc.obs)
+			if err != nil {
+				t.Errorf("IsUpToDate(...): unexpected error %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// basicRoles are the basic roles that BigQuery reports in the access entries
+// of a dataset in place of the equivalent predefined roles.
+var basicRoles = map[string]string{
+	"roles/bigquery.dataOwner":  "OWNER",
+	"roles/bigquery.dataEditor": "WRITER",
+	"roles/bigquery.dataViewer": "READER",
+}
+
+// GenerateDataset sets the fields of the supplied dataset that are declared
+// by the supplied DatasetParameters. Fields of parameters that are not set
+// are left as they are, so that an observed dataset can be updated in place.
+func GenerateDataset(projectID, name string, in v1alpha1.DatasetParameters, ds *bigquery.Dataset) {
+	ds.DatasetReference = &bigquery.DatasetReference{ProjectId: projectID, DatasetId: name}
+	if in.Location != nil {
+		ds.Location = *in.Location
+	}
+	if in.FriendlyName != nil {
+		ds.FriendlyName = *in.FriendlyName
+	}
+	if in.Description != nil {
+		ds.Description = *in.Description
+	}
+	if in.DefaultTableExpirationMs != nil {
+		ds.DefaultTableExpirationMs = *in.DefaultTableExpirationMs
+	}
+	if in.Labels != nil {
+		ds.Labels = in.Labels
+	}
+	if in.Access != nil {
+		ds.Access = generateDatasetAccess(projectID, in.Access)
+	}
+}
+
+func generateDatasetAccess(projectID string, in []v1alpha1.DatasetAccess) []*bigquery.DatasetAccess {
+	out := make([]*bigquery.DatasetAccess, len(in))
+	for i, a := range in {
+		out[i] = &bigquery.DatasetAccess{
+			Role:         gcp.StringValue(a.Role),
+			UserByEmail:  gcp.StringValue(a.UserByEmail),
+			GroupByEmail: gcp.StringValue(a.GroupByEmail),
+			Domain:       gcp.StringValue(a.Domain),
+			SpecialGroup: gcp.StringValue(a.SpecialGroup),
+			IamMember:    gcp.StringValue(a.IAMMember),
+			View:         generateTableReference(projectID, a.View),
+		}
+	}
+	return out
+}
+
+// LateInitializeDataset fills the unset location of the supplied
+// DatasetParameters with the observed location.
+func LateInitializeDataset(in *v1alpha1.DatasetParameters, observed bigquery.Dataset) {
+	in.Location = gcp.LateInitializeString(in.Location, observed.Location)
+}
+
+// GenerateDatasetObservation takes a bigquery.Dataset and returns a
+// DatasetObservation.
+func GenerateDatasetObservation(in bigquery.Dataset) v1alpha1.DatasetObservation {
+	return v1alpha1.DatasetObservation{
+		ID:               in.Id,
+		SelfLink:         in.SelfLink,
+		Etag:             in.Etag,
+		CreationTime:     millisToTime(in.CreationTime),
+		LastModifiedTime: millisToTime(in.LastModifiedTime),
+	}
+}
+
+// IsDatasetUpToDate returns true if the observed dataset matches the
+// supplied DatasetParameters. Parameters that are not set are not compared.
+// Access entries are compared regardless of their order.
+func IsDatasetUpToDate(projectID string, in v1alpha1.DatasetParameters, observed bigquery.Dataset) bool {
+	switch {
+	case in.FriendlyName != nil && *in.FriendlyName != observed.FriendlyName:
+		return false
+	case in.Description != nil && *in.Description != observed.Description:
+		return false
+	case in.DefaultTableExpirationMs != nil && *in.DefaultTableExpirationMs != observed.DefaultTableExpirationMs:
+		return false
+	case in.Labels != nil && !cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty()):
+		return false
+	case in.Access != nil && !cmp.Equal(accessKeys(generateDatasetAccess(projectID, in.Access)), accessKeys(observed.Access)):
+		return false
+	}
+	return true
+}
+
+// accessKeys returns a set of keys that identify the supplied access entries.
+// Predefined roles are keyed by the basic role BigQuery reports them as.
+func accessKeys(in []*bigquery.DatasetAccess) map[string]bool {
+	keys := make(map[string]bool, len(in))
+	for _, a := range in {
+		if a == nil {
+			continue
+		}
+		role := a.Role
+		if r, ok := basicRoles[role]; ok {
+			role = r
+		}
+		view := ""
+		if v := a.View; v != nil {
+			view = strings.Join([]string{v.ProjectId, v.DatasetId, v.TableId}, ".")
+		}
+		keys[fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s", role, a.UserByEmail, a.GroupByEmail, a.Domain, a.SpecialGroup, a.IamMember, view)] = true
+	}
+	return keys
+}
+
+// ChangedImmutableDatasetFields returns the JSON names of the supplied
+// parameters that differ from the observed dataset but cannot be changed once
+// it is created. Unset parameters are not considered changed.
+func ChangedImmutableDatasetFields(in v1alpha1.DatasetParameters, observed bigquery.Dataset) []string {
+	var changed []string
+	if in.Location != nil && !strings.EqualFold(*in.Location, observed.Location) {
+		changed = append(changed, "location")
+	}
+	return changed
+}
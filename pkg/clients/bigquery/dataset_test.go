@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const datasetName = "bardataset"
+
+func TestGenerateDataset(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.DatasetParameters
+		observed *bigquery.Dataset
+		want     *bigquery.Dataset
+	}{
+		"Create": {
+			in: v1alpha1.DatasetParameters{
+				Location:                 gcp.StringPtr("EU"),
+				Description:              gcp.StringPtr("desc"),
+				DefaultTableExpirationMs: gcp.Int64Ptr(3600000),
+				Labels:                   map[string]string{"foo": "bar"},
+				Access: []v1alpha1.DatasetAccess{
+					{Role: gcp.StringPtr("READER"), GroupByEmail: gcp.StringPtr("readers@example.com")},
+					{View: &v1alpha1.TableReference{DatasetID: "other", TableID: "view"}},
+				},
+			},
+			observed: &bigquery.Dataset{},
+			want: &bigquery.Dataset{
+				DatasetReference:         &bigquery.DatasetReference{ProjectId: projectID, DatasetId: datasetName},
+				Location:                 "EU",
+				Description:              "desc",
+				DefaultTableExpirationMs: 3600000,
+				Labels:                   map[string]string{"foo": "bar"},
+				Access: []*bigquery.DatasetAccess{
+					{Role: "READER", GroupByEmail: "readers@example.com"},
+					{View: &bigquery.TableReference{ProjectId: projectID, DatasetId: "other", TableId: "view"}},
+				},
+			},
+		},
+		"UnsetParametersKeepObservedFields": {
+			in: v1alpha1.DatasetParameters{FriendlyName: gcp.StringPtr("new")},
+			observed: &bigquery.Dataset{
+				Etag:         "etag",
+				FriendlyName: "old",
+				Labels:       map[string]string{"foo": "bar"},
+				Access:       []*bigquery.DatasetAccess{{Role: "OWNER", SpecialGroup: "projectOwners"}},
+			},
+			want: &bigquery.Dataset{
+				DatasetReference: &bigquery.DatasetReference{ProjectId: projectID, DatasetId: datasetName},
+				Etag:             "etag",
+				FriendlyName:     "new",
+				Labels:           map[string]string{"foo": "bar"},
+				Access:           []*bigquery.DatasetAccess{{Role: "OWNER", SpecialGroup: "projectOwners"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			GenerateDataset(projectID, datasetName, tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.observed); diff != "" {
+				t.Errorf("GenerateDataset(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDatasetUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.DatasetParameters
+		observed bigquery.Dataset
+		want     bool
+	}{
+		"UnsetParameters": {
+			in:       v1alpha1.DatasetParameters{},
+			observed: bigquery.Dataset{Description: "desc", Labels: map[string]string{"foo": "bar"}},
+			want:     true,
+		},
+		"AccessInAnyOrderWithBasicRoles": {
+			in: v1alpha1.DatasetParameters{
+				Access: []v1alpha1.DatasetAccess{
+					{Role: gcp.StringPtr("roles/bigquery.dataViewer"), Domain: gcp.StringPtr("example.com")},
+					{Role: gcp.StringPtr("OWNER"), SpecialGroup: gcp.StringPtr("projectOwners")},
+				},
+			},
+			observed: bigquery.Dataset{Access: []*bigquery.DatasetAccess{
+				{Role: "OWNER", SpecialGroup: "projectOwners"},
+				{Role: "READER", Domain: "example.com"},
+			}},
+			want: true,
+		},
+		"AccessEntryRemoved": {
+			in: v1alpha1.DatasetParameters{
+				Access: []v1alpha1.DatasetAccess{
+					{Role: gcp.StringPtr("OWNER"), SpecialGroup: gcp.StringPtr("projectOwners")},
+				},
+			},
+			observed: bigquery.Dataset{Access: []*bigquery.DatasetAccess{
+				{Role: "OWNER", SpecialGroup: "projectOwners"},
+				{Role: "READER", Domain: "example.com"},
+			}},
+			want: false,
+		},
+		"DefaultTableExpirationChanged": {
+			in:       v1alpha1.DatasetParameters{DefaultTableExpirationMs: gcp.Int64Ptr(7200000)},
+			observed: bigquery.Dataset{DefaultTableExpirationMs: 3600000},
+			want:     false,
+		},
+		"LabelsChanged": {
+			in:       v1alpha1.DatasetParameters{Labels: map[string]string{"foo": "baz"}},
+			observed: bigquery.Dataset{Labels: map[string]string{"foo": "bar"}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDatasetUpToDate(projectID, tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDatasetUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangedImmutableDatasetFields(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.DatasetParameters
+		observed bigquery.Dataset
+		want     []string
+	}{
+		"Unset": {
+			in:       v1alpha1.DatasetParameters{},
+			observed: bigquery.Dataset{Location: "US"},
+		},
+		"SameLocationDifferentCase": {
+			in:       v1alpha1.DatasetParameters{Location: gcp.StringPtr("us")},
+			observed: bigquery.Dataset{Location: "US"},
+		},
+		"LocationChanged": {
+			in:       v1alpha1.DatasetParameters{Location: gcp.StringPtr("EU")},
+			observed: bigquery.Dataset{Location: "US"},
+			want:     []string{"location"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ChangedImmutableDatasetFields(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ChangedImmutableDatasetFields(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
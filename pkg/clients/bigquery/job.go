@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"time"
+
+	bigquery "google.golang.org/api/bigquery/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateJob takes a JobParameters and returns the *bigquery.Job that should
+// be submitted to run it. Table and dataset references that do not specify a
+// project default to the supplied projectID.
+func GenerateJob(projectID, name string, in v1alpha1.JobParameters) *bigquery.Job {
+	j := &bigquery.Job{
+		JobReference: &bigquery.JobReference{
+			ProjectId: projectID,
+			JobId:     name,
+			Location:  gcp.StringValue(in.Location),
+		},
+		Configuration: &bigquery.JobConfiguration{
+			Labels:       in.Labels,
+			JobTimeoutMs: gcp.Int64Value(in.JobTimeoutMs),
+		},
+	}
+	if q := in.Query; q != nil {
+		j.Configuration.Query = &bigquery.JobConfigurationQuery{
+			Query:              q.Query,
+			UseLegacySql:       q.UseLegacySQL,
+			DefaultDataset:     generateDatasetReference(projectID, q.DefaultDataset),
+			DestinationTable:   generateTableReference(projectID, q.DestinationTable),
+			CreateDisposition:  gcp.StringValue(q.CreateDisposition),
+			WriteDisposition:   gcp.StringValue(q.WriteDisposition),
+			Priority:           gcp.StringValue(q.Priority),
+			MaximumBytesBilled: gcp.Int64Value(q.MaximumBytesBilled),
+		}
+	}
+	if l := in.Load; l != nil {
+		j.Configuration.Load = &bigquery.JobConfigurationLoad{
+			SourceUris:        l.SourceURIs,
+			DestinationTable:  generateTableReference(projectID, &l.DestinationTable),
+			SourceFormat:      gcp.StringValue(l.SourceFormat),
+			Autodetect:        gcp.BoolValue(l.Autodetect),
+			SkipLeadingRows:   gcp.Int64Value(l.SkipLeadingRows),
+			FieldDelimiter:    gcp.StringValue(l.FieldDelimiter),
+			MaxBadRecords:     gcp.Int64Value(l.MaxBadRecords),
+			CreateDisposition: gcp.StringValue(l.CreateDisposition),
+			WriteDisposition:  gcp.StringValue(l.WriteDisposition),
+		}
+	}
+	if e := in.Extract; e != nil {
+		j.Configuration.Extract = &bigquery.JobConfigurationExtract{
+			SourceTable:       generateTableReference(projectID, &e.SourceTable),
+			DestinationUris:   e.DestinationURIs,
+			DestinationFormat: gcp.StringValue(e.DestinationFormat),
+			Compression:       gcp.StringValue(e.Compression),
+			FieldDelimiter:    gcp.StringValue(e.FieldDelimiter),
+			PrintHeader:       e.PrintHeader,
+		}
+	}
+	if c := in.Copy; c != nil {
+		j.Configuration.Copy = &bigquery.JobConfigurationTableCopy{
+			DestinationTable:  generateTableReference(projectID, &c.DestinationTable),
+			CreateDisposition: gcp.StringValue(c.CreateDisposition),
+			WriteDisposition:  gcp.StringValue(c.WriteDisposition),
+		}
+		for i := range c.SourceTables {
+			j.Configuration.Copy.SourceTables = append(j.Configuration.Copy.SourceTables, generateTableReference(projectID, &c.SourceTables[i]))
+		}
+	}
+	return j
+}
+
+func generateTableReference(projectID string, in *v1alpha1.TableReference) *bigquery.TableReference {
+	if in == nil {
+		return nil
+	}
+	t := &bigquery.TableReference{
+		ProjectId: projectID,
+		DatasetId: in.DatasetID,
+		TableId:   in.TableID,
+	}
+	if in.ProjectID != nil {
+		t.ProjectId = *in.ProjectID
+	}
+	return t
+}
+
+func generateDatasetReference(projectID string, in *v1alpha1.DatasetReference) *bigquery.DatasetReference {
+	if in == nil {
+		return nil
+	}
+	d := &bigquery.DatasetReference{
+		ProjectId: projectID,
+		DatasetId: in.DatasetID,
+	}
+	if in.ProjectID != nil {
+		d.ProjectId = *in.ProjectID
+	}
+	return d
+}
+
+// GenerateJobObservation takes a bigquery.Job and returns a JobObservation.
+func GenerateJobObservation(in bigquery.Job) v1alpha1.JobObservation {
+	o := v1alpha1.JobObservation{
+		ID:       in.Id,
+		SelfLink: in.SelfLink,
+	}
+	if in.Status != nil {
+		o.State = in.Status.State
+		if in.Status.ErrorResult != nil {
+			o.ErrorReason = in.Status.ErrorResult.Reason
+			o.ErrorMessage = in.Status.ErrorResult.Message
+		}
+	}
+	if s := in.Statistics; s != nil {
+		o.CreationTime = millisToTime(s.CreationTime)
+		o.StartTime = millisToTime(s.StartTime)
+		o.EndTime = millisToTime(s.EndTime)
+		o.TotalBytesProcessed = s.TotalBytesProcessed
+		if s.Query != nil {
+			o.TotalBytesBilled = s.Query.TotalBytesBilled
+			o.NumDMLAffectedRows = s.Query.NumDmlAffectedRows
+		}
+		if s.Load != nil {
+			o.OutputRows = s.Load.OutputRows
+		}
+	}
+	return o
+}
+
+// millisToTime converts the milliseconds since epoch the BigQuery API uses to
+// report job timestamps into a *metav1.Time.
+func millisToTime(ms int64) *metav1.Time {
+	if ms == 0 {
+		return nil
+	}
+	t := metav1.NewTime(time.Unix(0, ms*int64(time.Millisecond)).UTC())
+	return &t
+}
+
+// IsDone returns true if the supplied job has finished, whether successfully
+// or not.
+func IsDone(in bigquery.Job) bool {
+	return in.Status != nil && in.Status.State == v1alpha1.JobStateDone
+}
+
+// IsFailed returns true if the supplied job has finished with an error.
+func IsFailed(in bigquery.Job) bool {
+	return IsDone(in) && in.Status.ErrorResult != nil
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "fooproject"
+	jobName   = "barjob"
+)
+
+func TestGenerateJob(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.JobParameters
+		want *bigquery.Job
+	}{
+		"Query": {
+			in: v1alpha1.JobParameters{
+				Location: gcp.StringPtr("EU"),
+				Labels:   map[string]string{"foo": "bar"},
+				Query: &v1alpha1.JobConfigurationQuery{
+					Query:            "SELECT 1",
+					UseLegacySQL:     gcp.BoolPtr(false),
+					DestinationTable: &v1alpha1.TableReference{DatasetID: "ds", TableID: "tbl"},
+					WriteDisposition: gcp.StringPtr("WRITE_TRUNCATE"),
+				},
+			},
+			want: &bigquery.Job{
+				JobReference: &bigquery.JobReference{ProjectId: projectID, JobId: jobName, Location: "EU"},
+				Configuration: &bigquery.JobConfiguration{
+					Labels: map[string]string{"foo": "bar"},
+					Query: &bigquery.JobConfigurationQuery{
+						Query:            "SELECT 1",
+						UseLegacySql:     gcp.BoolPtr(false),
+						DestinationTable: &bigquery.TableReference{ProjectId: projectID, DatasetId: "ds", TableId: "tbl"},
+						WriteDisposition: "WRITE_TRUNCATE",
+					},
+				},
+			},
+		},
+		"Load": {
+			in: v1alpha1.JobParameters{
+				Load: &v1alpha1.JobConfigurationLoad{
+					SourceURIs:       []string{"gs://bucket/*.csv"},
+					DestinationTable: v1alpha1.TableReference{ProjectID: gcp.StringPtr("other"), DatasetID: "ds", TableID: "tbl"},
+					SourceFormat:     gcp.StringPtr("CSV"),
+					SkipLeadingRows:  gcp.Int64Ptr(1),
+				},
+			},
+			want: &bigquery.Job{
+				JobReference: &bigquery.JobReference{ProjectId: projectID, JobId: jobName},
+				Configuration: &bigquery.JobConfiguration{
+					Load: &bigquery.JobConfigurationLoad{
+						SourceUris:       []string{"gs://bucket/*.csv"},
+						DestinationTable: &bigquery.TableReference{ProjectId: "other", DatasetId: "ds", TableId: "tbl"},
+						SourceFormat:     "CSV",
+						SkipLeadingRows:  1,
+					},
+				},
+			},
+		},
+		"Extract": {
+			in: v1alpha1.JobParameters{
+				Extract: &v1alpha1.JobConfigurationExtract{
+					SourceTable:       v1alpha1.TableReference{DatasetID: "ds", TableID: "tbl"},
+					DestinationURIs:   []string{"gs://bucket/out-*.json"},
+					DestinationFormat: gcp.StringPtr("NEWLINE_DELIMITED_JSON"),
+				},
+			},
+			want: &bigquery.Job{
+				JobReference: &bigquery.JobReference{ProjectId: projectID, JobId: jobName},
+				Configuration: &bigquery.JobConfiguration{
+					Extract: &bigquery.JobConfigurationExtract{
+						SourceTable:       &bigquery.TableReference{ProjectId: projectID, DatasetId: "ds", TableId: "tbl"},
+						DestinationUris:   []string{"gs://bucket/out-*.json"},
+						DestinationFormat: "NEWLINE_DELIMITED_JSON",
+					},
+				},
+			},
+		},
+		"Copy": {
+			in: v1alpha1.JobParameters{
+				Copy: &v1alpha1.JobConfigurationTableCopy{
+					SourceTables: []v1alpha1.TableReference{
+						{DatasetID: "ds", TableID: "a"},
+						{DatasetID: "ds", TableID: "b"},
+					},
+					DestinationTable: v1alpha1.TableReference{DatasetID: "ds", TableID: "c"},
+				},
+			},
+			want: &bigquery.Job{
+				JobReference: &bigquery.JobReference{ProjectId: projectID, JobId: jobName},
+				Configuration: &bigquery.JobConfiguration{
+					Copy: &bigquery.JobConfigurationTableCopy{
+						SourceTables: []*bigquery.TableReference{
+							{ProjectId: projectID, DatasetId: "ds", TableId: "a"},
+							{ProjectId: projectID, DatasetId: "ds", TableId: "b"},
+						},
+						DestinationTable: &bigquery.TableReference{ProjectId: projectID, DatasetId: "ds", TableId: "c"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateJob(projectID, jobName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateJob(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateJobObservation(t *testing.T) {
+	created := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	ended := created.Add(time.Minute)
+
+	cases := map[string]struct {
+		in   bigquery.Job
+		want v1alpha1.JobObservation
+	}{
+		"Running": {
+			in: bigquery.Job{
+				Id:         "fooproject:US.barjob",
+				SelfLink:   "https://bigquery.googleapis.com/bigquery/v2/projects/fooproject/jobs/barjob",
+				Status:     &bigquery.JobStatus{State: v1alpha1.JobStateRunning},
+				Statistics: &bigquery.JobStatistics{CreationTime: created.UnixNano() / int64(time.Millisecond)},
+			},
+			want: v1alpha1.JobObservation{
+				ID:           "fooproject:US.barjob",
+				SelfLink:     "https://bigquery.googleapis.com/bigquery/v2/projects/fooproject/jobs/barjob",
+				State:        v1alpha1.JobStateRunning,
+				CreationTime: &metav1.Time{Time: created},
+			},
+		},
+		"DoneWithStatistics": {
+			in: bigquery.Job{
+				Status: &bigquery.JobStatus{State: v1alpha1.JobStateDone},
+				Statistics: &bigquery.JobStatistics{
+					CreationTime:        created.UnixNano() / int64(time.Millisecond),
+					EndTime:             ended.UnixNano() / int64(time.Millisecond),
+					TotalBytesProcessed: 42,
+					Query:               &bigquery.JobStatistics2{TotalBytesBilled: 1024, NumDmlAffectedRows: 7},
+					Load:                &bigquery.JobStatistics3{OutputRows: 3},
+				},
+			},
+			want: v1alpha1.JobObservation{
+				State:               v1alpha1.JobStateDone,
+				CreationTime:        &metav1.Time{Time: created},
+				EndTime:             &metav1.Time{Time: ended},
+				TotalBytesProcessed: 42,
+				TotalBytesBilled:    1024,
+				NumDMLAffectedRows:  7,
+				OutputRows:          3,
+			},
+		},
+		"Failed": {
+			in: bigquery.Job{
+				Status: &bigquery.JobStatus{
+					State:       v1alpha1.JobStateDone,
+					ErrorResult: &bigquery.ErrorProto{Reason: "invalidQuery", Message: "Syntax error"},
+				},
+			},
+			want: v1alpha1.JobObservation{
+				State:        v1alpha1.JobStateDone,
+				ErrorReason:  "invalidQuery",
+				ErrorMessage: "Syntax error",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateJobObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateJobObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	bigquery "google.golang.org/api/bigquery/v2"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Column modes.
+const (
+	modeNullable = "NULLABLE"
+	modeRequired = "REQUIRED"
+)
+
+// standardSQLTypes are the standard SQL names of column types that BigQuery
+// reports by their legacy SQL names.
+var standardSQLTypes = map[string]string{
+	"INT64":   "INTEGER",
+	"FLOAT64": "FLOAT",
+	"BOOL":    "BOOLEAN",
+	"STRUCT":  "RECORD",
+}
+
+// GenerateTable sets the fields of the supplied table that are declared by
+// the supplied TableParameters. Fields of parameters that are not set are left
+// as they are, so that an observed table can be updated in place.
+func GenerateTable(projectID, name string, in v1alpha1.TableParameters, t *bigquery.Table) {
+	t.TableReference = &bigquery.TableReference{ProjectId: projectID, DatasetId: gcp.StringValue(in.Dataset), TableId: name}
+	if in.FriendlyName != nil {
+		t.FriendlyName = *in.FriendlyName
+	}
+	if in.Description != nil {
+		t.Description = *in.Description
+	}
+	if in.Labels != nil {
+		t.Labels = in.Labels
+	}
+	if in.Schema != nil {
+		t.Schema = &bigquery.TableSchema{Fields: generateFields(in.Schema)}
+	}
+	if tp := in.TimePartitioning; tp != nil {
+		t.TimePartitioning = &bigquery.TimePartitioning{
+			Type:                   tp.Type,
+			Field:                  gcp.StringValue(tp.Field),
+			ExpirationMs:           gcp.Int64Value(tp.ExpirationMs),
+			RequirePartitionFilter: gcp.BoolValue(tp.RequirePartitionFilter),
+		}
+	}
+	if in.ExpirationTime != nil {
+		t.ExpirationTime = timeToMillis(in.ExpirationTime.Time)
+	}
+}
+
+func generateFields(in []v1alpha1.TableFieldSchema) []*bigquery.TableFieldSchema {
+	if in == nil {
+		return nil
+	}
+	out := make([]*bigquery.TableFieldSchema, len(in))
+	for i, f := range in {
+		out[i] = &bigquery.TableFieldSchema{
+			Name:        f.Name,
+			Type:        f.Type,
+			Mode:        gcp.StringValue(f.Mode),
+			Description: gcp.StringValue(f.Description),
+			Fields:      generateFields(f.Fields),
+		}
+	}
+	return out
+}
+
+// GenerateTableObservation takes a bigquery.Table and returns a
+// TableObservation.
+func GenerateTableObservation(in bigquery.Table) v1alpha1.TableObservation {
+	return v1alpha1.TableObservation{
+		ID:               in.Id,
+		SelfLink:         in.SelfLink,
+		Etag:             in.Etag,
+		Type:             in.Type,
+		Location:         in.Location,
+		CreationTime:     millisToTime(in.CreationTime),
+		LastModifiedTime: millisToTime(int64(in.LastModifiedTime)),
+		NumBytes:         in.NumBytes,
+		NumRows:          int64(in.NumRows),
+	}
+}
+
+// IsTableUpToDate returns true if the observed table matches the supplied
+// TableParameters. Parameters that are not set are not compared. Columns are
+// compared by name, regardless of their order.
+func IsTableUpToDate(in v1alpha1.TableParameters, observed bigquery.Table) bool {
+	switch {
+	case in.FriendlyName != nil && *in.FriendlyName != observed.FriendlyName:
+		return false
+	case in.Description != nil && *in.Description != observed.Description:
+		return false
+	case in.Labels != nil && !cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty()):
+		return false
+	case in.ExpirationTime != nil && timeToMillis(in.ExpirationTime.Time) != observed.ExpirationTime:
+		return false
+	case in.Schema != nil && !fieldsEqual(generateFields(in.Schema), observedFields(observed)):
+		return false
+	}
+	return true
+}
+
+func observedFields(t bigquery.Table) []*bigquery.TableFieldSchema {
+	if t.Schema == nil {
+		return nil
+	}
+	return t.Schema.Fields
+}
+
+func fieldsEqual(desired, observed []*bigquery.TableFieldSchema) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	byName := fieldsByName(observed)
+	for _, d := range desired {
+		o, ok := byName[d.Name]
+		if !ok || columnType(d) != columnType(o) || columnMode(d) != columnMode(o) || d.Description != o.Description {
+			return false
+		}
+		if !fieldsEqual(d.Fields, o.Fields) {
+			return false
+		}
+	}
+	return true
+}
+
+// ChangedImmutableTableFields returns the JSON names of the supplied
+// parameters that differ from the observed table but cannot be changed once
+// it is created, and the names of the observed columns that the supplied
+// schema removes or changes the type of. Columns may only be added, or have
+// their mode relaxed from REQUIRED to NULLABLE. Unset parameters are not
+// considered changed.
+func ChangedImmutableTableFields(in v1alpha1.TableParameters, observed bigquery.Table) []string {
+	var changed []string
+	if tp := in.TimePartitioning; tp != nil {
+		o := observed.TimePartitioning
+		if o == nil {
+			o = &bigquery.TimePartitioning{}
+		}
+		if tp.Type != o.Type ||
+			gcp.StringValue(tp.Field) != o.Field ||
+			(tp.ExpirationMs != nil && *tp.ExpirationMs != o.ExpirationMs) ||
+			(tp.RequirePartitionFilter != nil && *tp.RequirePartitionFilter != o.RequirePartitionFilter) {
+			changed = append(changed, "timePartitioning")
+		}
+	}
+	if in.Schema != nil {
+		changed = append(changed, changedColumns("", generateFields(in.Schema), observedFields(observed))...)
+	}
+	return changed
+}
+
+func changedColumns(prefix string, desired, observed []*bigquery.TableFieldSchema) []string {
+	var changed []string
+	byName := fieldsByName(desired)
+	for _, o := range observed {
+		name := prefix + o.Name
+		d, ok := byName[o.Name]
+		if !ok || columnType(d) != columnType(o) || !modeChangeAllowed(columnMode(o), columnMode(d)) {
+			changed = append(changed, "column "+name)
+			continue
+		}
+		changed = append(changed, changedColumns(name+".", d.Fields, o.Fields)...)
+	}
+	return changed
+}
+
+func modeChangeAllowed(from, to string) bool {
+	return from == to || (from == modeRequired && to == modeNullable)
+}
+
+func fieldsByName(in []*bigquery.TableFieldSchema) map[string]*bigquery.TableFieldSchema {
+	m := make(map[string]*bigquery.TableFieldSchema, len(in))
+	for _, f := range in {
+		if f != nil {
+			m[f.Name] = f
+		}
+	}
+	return m
+}
+
+func columnType(f *bigquery.TableFieldSchema) string {
+	if t, ok := standardSQLTypes[f.Type]; ok {
+		return t
+	}
+	return f.Type
+}
+
+func columnMode(f *bigquery.TableFieldSchema) string {
+	if f.Mode == "" {
+		return modeNullable
+	}
+	return f.Mode
+}
+
+// timeToMillis converts the supplied time into the milliseconds since epoch
+// the BigQuery API uses for timestamps.
+func timeToMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	bigquery "google.golang.org/api/bigquery/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const tableName = "bartable"
+
+func TestGenerateTable(t *testing.T) {
+	expires := metav1.NewTime(time.Unix(1600000000, 0))
+	cases := map[string]struct {
+		in   v1alpha1.TableParameters
+		want *bigquery.Table
+	}{
+		"Full": {
+			in: v1alpha1.TableParameters{
+				Dataset:     gcp.StringPtr(datasetName),
+				Description: gcp.StringPtr("desc"),
+				Labels:      map[string]string{"foo": "bar"},
+				Schema: []v1alpha1.TableFieldSchema{
+					{Name: "id", Type: "INT64", Mode: gcp.StringPtr("REQUIRED")},
+					{Name: "address", Type: "RECORD", Fields: []v1alpha1.TableFieldSchema{{Name: "city", Type: "STRING"}}},
+				},
+				TimePartitioning: &v1alpha1.TimePartitioning{Type: "DAY", Field: gcp.StringPtr("ts")},
+				ExpirationTime:   &expires,
+			},
+			want: &bigquery.Table{
+				TableReference: &bigquery.TableReference{ProjectId: projectID, DatasetId: datasetName, TableId: tableName},
+				Description:    "desc",
+				Labels:         map[string]string{"foo": "bar"},
+				Schema: &bigquery.TableSchema{Fields: []*bigquery.TableFieldSchema{
+					{Name: "id", Type: "INT64", Mode: "REQUIRED"},
+					{Name: "address", Type: "RECORD", Fields: []*bigquery.TableFieldSchema{{Name: "city", Type: "STRING"}}},
+				}},
+				TimePartitioning: &bigquery.TimePartitioning{Type: "DAY", Field: "ts"},
+				ExpirationTime:   1600000000000,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &bigquery.Table{}
+			GenerateTable(projectID, tableName, tc.in, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateTable(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTableUpToDate(t *testing.T) {
+	observed := bigquery.Table{
+		Description: "desc",
+		Schema: &bigquery.TableSchema{Fields: []*bigquery.TableFieldSchema{
+			{Name: "id", Type: "INTEGER", Mode: "REQUIRED"},
+			{Name: "name", Type: "STRING", Mode: "NULLABLE"},
+		}},
+		ExpirationTime: 1600000000000,
+	}
+	cases := map[string]struct {
+		in   v1alpha1.TableParameters
+		want bool
+	}{
+		"UnsetParameters": {
+			in:   v1alpha1.TableParameters{},
+			want: true,
+		},
+		"SchemaInAnyOrderWithStandardSQLTypes": {
+			in: v1alpha1.TableParameters{Schema: []v1alpha1.TableFieldSchema{
+				{Name: "name", Type: "STRING"},
+				{Name: "id", Type: "INT64", Mode: gcp.StringPtr("REQUIRED")},
+			}},
+			want: true,
+		},
+		"ColumnAdded": {
+			in: v1alpha1.TableParameters{Schema: []v1alpha1.TableFieldSchema{
+				{Name: "id", Type: "INTEGER", Mode: gcp.StringPtr("REQUIRED")},
+				{Name: "name", Type: "STRING"},
+				{Name: "email", Type: "STRING"},
+			}},
+			want: false,
+		},
+		"ExpirationTimeChanged": {
+			in: v1alpha1.TableParameters{ExpirationTime: func() *metav1.Time {
+				t := metav1.NewTime(time.Unix(1700000000, 0))
+				return &t
+			}()},
+			want: false,
+		},
+		"DescriptionChanged": {
+			in:   v1alpha1.TableParameters{Description: gcp.StringPtr("new")},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTableUpToDate(tc.in, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsTableUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangedImmutableTableFields(t *testing.T) {
+	observed := bigquery.Table{
+		Schema: &bigquery.TableSchema{Fields: []*bigquery.TableFieldSchema{
+			{Name: "id", Type: "INTEGER", Mode: "REQUIRED"},
+			{Name: "address", Type: "RECORD", Fields: []*bigquery.TableFieldSchema{
+				{Name: "city", Type: "STRING"},
+			}},
+		}},
+		TimePartitioning: &bigquery.TimePartitioning{Type: "DAY"},
+	}
+	cases := map[string]struct {
+		in   v1alpha1.TableParameters
+		want []string
+	}{
+		"Unset": {
+			in: v1alpha1.TableParameters{},
+		},
+		"ColumnsAddedAndModeRelaxed": {
+			in: v1alpha1.TableParameters{
+				Schema: []v1alpha1.TableFieldSchema{
+					{Name: "id", Type: "INT64", Mode: gcp.StringPtr("NULLABLE")},
+					{Name: "address", Type: "STRUCT", Fields: []v1alpha1.TableFieldSchema{
+						{Name: "city", Type: "STRING"},
+						{Name: "zip", Type: "STRING"},
+					}},
+					{Name: "email", Type: "STRING"},
+				},
+				TimePartitioning: &v1alpha1.TimePartitioning{Type: "DAY"},
+			},
+		},
+		"ColumnRemovedAndTypeChanged": {
+			in: v1alpha1.TableParameters{
+				Schema: []v1alpha1.TableFieldSchema{
+					{Name: "id", Type: "STRING", Mode: gcp.StringPtr("REQUIRED")},
+					{Name: "address", Type: "RECORD"},
+				},
+			},
+			want: []string{"column id", "column address.city"},
+		},
+		"ModeTightened": {
+			in: v1alpha1.TableParameters{
+				Schema: []v1alpha1.TableFieldSchema{
+					{Name: "id", Type: "INTEGER", Mode: gcp.StringPtr("REQUIRED")},
+					{Name: "address", Type: "RECORD", Mode: gcp.StringPtr("REQUIRED"), Fields: []v1alpha1.TableFieldSchema{
+						{Name: "city", Type: "STRING"},
+					}},
+				},
+			},
+			want: []string{"column address"},
+		},
+		"PartitioningChanged": {
+			in: v1alpha1.TableParameters{
+				TimePartitioning: &v1alpha1.TimePartitioning{Type: "DAY", Field: gcp.StringPtr("ts")},
+			},
+			want: []string{"timePartitioning"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ChangedImmutableTableFields(tc.in, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ChangedImmutableTableFields(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// AttestorParent returns the resource name of the parent of the attestors of
+// the supplied project.
+func AttestorParent(project string) string {
+	return fmt.Sprintf("projects/%s", project)
+}
+
+// AttestorName returns the resource name of the supplied attestor.
+func AttestorName(project, attestor string) string {
+	return AttestorParent(project) + "/attestors/" + attestor
+}
+
+// GenerateAttestor takes AttestorParameters and returns an Attestor with the
+// supplied resource name.
+func GenerateAttestor(name string, in v1alpha1.AttestorParameters) *binaryauthorization.Attestor {
+	a := &binaryauthorization.Attestor{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+		UserOwnedGrafeasNote: &binaryauthorization.UserOwnedGrafeasNote{
+			NoteReference: in.UserOwnedGrafeasNote.NoteReference,
+		},
+	}
+	for _, k := range in.UserOwnedGrafeasNote.PublicKeys {
+		pk := &binaryauthorization.AttestorPublicKey{
+			Id:                       gcp.StringValue(k.ID),
+			Comment:                  gcp.StringValue(k.Comment),
+			AsciiArmoredPgpPublicKey: gcp.StringValue(k.ASCIIArmoredPGPPublicKey),
+		}
+		if k.PKIXPublicKey != nil {
+			pk.PkixPublicKey = &binaryauthorization.PkixPublicKey{
+				PublicKeyPem:       k.PKIXPublicKey.PublicKeyPEM,
+				SignatureAlgorithm: k.PKIXPublicKey.SignatureAlgorithm,
+			}
+		}
+		a.UserOwnedGrafeasNote.PublicKeys = append(a.UserOwnedGrafeasNote.PublicKeys, pk)
+	}
+	return a
+}
+
+// GenerateAttestorObservation takes an Attestor and returns an
+// AttestorObservation.
+func GenerateAttestorObservation(in binaryauthorization.Attestor) v1alpha1.AttestorObservation {
+	o := v1alpha1.AttestorObservation{
+		Name:       in.Name,
+		UpdateTime: gcp.TimeFromRFC3339(in.UpdateTime),
+	}
+	if in.UserOwnedGrafeasNote != nil {
+		o.DelegationServiceAccountEmail = in.UserOwnedGrafeasNote.DelegationServiceAccountEmail
+	}
+	return o
+}
+
+// LateInitializeAttestor fills the empty fields of the supplied
+// AttestorParameters with those of the supplied Attestor. The IDs the API
+// computes for PGP keys are filled in for the keys at the same position.
+func LateInitializeAttestor(spec *v1alpha1.AttestorParameters, in binaryauthorization.Attestor) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	if in.UserOwnedGrafeasNote == nil || len(in.UserOwnedGrafeasNote.PublicKeys) != len(spec.UserOwnedGrafeasNote.PublicKeys) {
+		return
+	}
+	for i, k := range in.UserOwnedGrafeasNote.PublicKeys {
+		spec.UserOwnedGrafeasNote.PublicKeys[i].ID = gcp.LateInitializeString(spec.UserOwnedGrafeasNote.PublicKeys[i].ID, k.Id)
+	}
+}
+
+// IsAttestorUpToDate returns true if the description and public keys of the
+// supplied Attestor match the supplied AttestorParameters.
+func IsAttestorUpToDate(in v1alpha1.AttestorParameters, observed binaryauthorization.Attestor) bool {
+	desired := GenerateAttestor(observed.Name, in)
+	if desired.Description != observed.Description {
+		return false
+	}
+	var keys []*binaryauthorization.AttestorPublicKey
+	if observed.UserOwnedGrafeasNote != nil {
+		keys = observed.UserOwnedGrafeasNote.PublicKeys
+	}
+	return cmp.Equal(desired.UserOwnedGrafeasNote.PublicKeys, keys,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(binaryauthorization.AttestorPublicKey{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(binaryauthorization.PkixPublicKey{}, "ForceSendFields", "NullFields"))
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testNote        = "projects/my-project/notes/build"
+	testPGPKey      = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+	testFingerprint = "0123456789ABCDEF"
+)
+
+func TestLateInitializeAttestor(t *testing.T) {
+	spec := v1alpha1.AttestorParameters{
+		UserOwnedGrafeasNote: v1alpha1.UserOwnedGrafeasNote{
+			NoteReference: testNote,
+			PublicKeys:    []v1alpha1.AttestorPublicKey{{ASCIIArmoredPGPPublicKey: gcp.StringPtr(testPGPKey)}},
+		},
+	}
+	observed := binaryauthorization.Attestor{
+		Description: "builds",
+		UserOwnedGrafeasNote: &binaryauthorization.UserOwnedGrafeasNote{
+			NoteReference: testNote,
+			PublicKeys:    []*binaryauthorization.AttestorPublicKey{{Id: testFingerprint, AsciiArmoredPgpPublicKey: testPGPKey}},
+		},
+	}
+	want := v1alpha1.AttestorParameters{
+		Description: gcp.StringPtr("builds"),
+		UserOwnedGrafeasNote: v1alpha1.UserOwnedGrafeasNote{
+			NoteReference: testNote,
+			PublicKeys: []v1alpha1.AttestorPublicKey{{
+				ID:                       gcp.StringPtr(testFingerprint),
+				ASCIIArmoredPGPPublicKey: gcp.StringPtr(testPGPKey),
+			}},
+		},
+	}
+
+	LateInitializeAttestor(&spec, observed)
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeAttestor(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsAttestorUpToDate(t *testing.T) {
+	pkix := &v1alpha1.PKIXPublicKey{PublicKeyPEM: "-----BEGIN PUBLIC KEY-----", SignatureAlgorithm: "ECDSA_P256_SHA256"}
+
+	cases := map[string]struct {
+		in       v1alpha1.AttestorParameters
+		observed binaryauthorization.Attestor
+		want     bool
+	}{
+		"UpToDate": {
+			in: v1alpha1.AttestorParameters{
+				Description: gcp.StringPtr("builds"),
+				UserOwnedGrafeasNote: v1alpha1.UserOwnedGrafeasNote{
+					NoteReference: testNote,
+					PublicKeys:    []v1alpha1.AttestorPublicKey{{ID: gcp.StringPtr("key"), PKIXPublicKey: pkix}},
+				},
+			},
+			observed: binaryauthorization.Attestor{
+				Description: "builds",
+				UserOwnedGrafeasNote: &binaryauthorization.UserOwnedGrafeasNote{
+					NoteReference:                 testNote,
+					DelegationServiceAccountEmail: "service-123@gcp-sa-binaryauthorization.iam.gserviceaccount.com",
+					PublicKeys: []*binaryauthorization.AttestorPublicKey{{
+						Id:            "key",
+						PkixPublicKey: &binaryauthorization.PkixPublicKey{PublicKeyPem: pkix.PublicKeyPEM, SignatureAlgorithm: pkix.SignatureAlgorithm},
+					}},
+				},
+			},
+			want: true,
+		},
+		"DescriptionChanged": {
+			in: v1alpha1.AttestorParameters{
+				Description:          gcp.StringPtr("new"),
+				UserOwnedGrafeasNote: v1alpha1.UserOwnedGrafeasNote{NoteReference: testNote},
+			},
+			observed: binaryauthorization.Attestor{
+				Description:          "old",
+				UserOwnedGrafeasNote: &binaryauthorization.UserOwnedGrafeasNote{NoteReference: testNote},
+			},
+			want: false,
+		},
+		"KeyAdded": {
+			in: v1alpha1.AttestorParameters{
+				UserOwnedGrafeasNote: v1alpha1.UserOwnedGrafeasNote{
+					NoteReference: testNote,
+					PublicKeys:    []v1alpha1.AttestorPublicKey{{ASCIIArmoredPGPPublicKey: gcp.StringPtr(testPGPKey)}},
+				},
+			},
+			observed: binaryauthorization.Attestor{
+				UserOwnedGrafeasNote: &binaryauthorization.UserOwnedGrafeasNote{NoteReference: testNote},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAttestorUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsAttestorUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package binaryauthorization contains utilities to convert between GCP
+// Binary Authorization resources and managed resources.
+package binaryauthorization
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// PolicyName returns the resource name of the policy of the supplied project.
+func PolicyName(project string) string {
+	return fmt.Sprintf("projects/%s/policy", project)
+}
+
+// GeneratePolicy takes PolicyParameters and returns the policy that should be
+// set on the project.
+func GeneratePolicy(in v1alpha1.PolicyParameters) *binaryauthorization.Policy {
+	p := &binaryauthorization.Policy{
+		Description:                gcp.StringValue(in.Description),
+		GlobalPolicyEvaluationMode: gcp.StringValue(in.GlobalPolicyEvaluationMode),
+		DefaultAdmissionRule:       generateAdmissionRule(in.DefaultAdmissionRule),
+	}
+	for _, wp := range in.AdmissionWhitelistPatterns {
+		p.AdmissionWhitelistPatterns = append(p.AdmissionWhitelistPatterns, &binaryauthorization.AdmissionWhitelistPattern{NamePattern: wp.NamePattern})
+	}
+	if len(in.ClusterAdmissionRules) > 0 {
+		p.ClusterAdmissionRules = make(map[string]binaryauthorization.AdmissionRule, len(in.ClusterAdmissionRules))
+		for cluster, r := range in.ClusterAdmissionRules {
+			p.ClusterAdmissionRules[cluster] = *generateAdmissionRule(r)
+		}
+	}
+	return p
+}
+
+func generateAdmissionRule(in v1alpha1.AdmissionRule) *binaryauthorization.AdmissionRule {
+	return &binaryauthorization.AdmissionRule{
+		EvaluationMode:        in.EvaluationMode,
+		EnforcementMode:       in.EnforcementMode,
+		RequireAttestationsBy: in.RequireAttestationsBy,
+	}
+}
+
+// DefaultPolicy returns the policy a project has before it is configured,
+// which admits all images.
+func DefaultPolicy() *binaryauthorization.Policy {
+	return &binaryauthorization.Policy{
+		DefaultAdmissionRule: &binaryauthorization.AdmissionRule{
+			EvaluationMode:  v1alpha1.EvaluationModeAlwaysAllow,
+			EnforcementMode: v1alpha1.EnforcementModeEnforcedBlockAndAuditLog,
+		},
+	}
+}
+
+// GeneratePolicyObservation takes a Policy and returns a PolicyObservation.
+func GeneratePolicyObservation(in binaryauthorization.Policy) v1alpha1.PolicyObservation {
+	return v1alpha1.PolicyObservation{
+		Name:       in.Name,
+		UpdateTime: gcp.TimeFromRFC3339(in.UpdateTime),
+	}
+}
+
+// IsPolicyUpToDate returns true if the supplied Policy matches the supplied
+// PolicyParameters. The description and global policy evaluation mode are
+// only compared if they are set.
+func IsPolicyUpToDate(in v1alpha1.PolicyParameters, observed binaryauthorization.Policy) bool {
+	if in.Description != nil && *in.Description != observed.Description {
+		return false
+	}
+	if in.GlobalPolicyEvaluationMode != nil && *in.GlobalPolicyEvaluationMode != observed.GlobalPolicyEvaluationMode {
+		return false
+	}
+	return sameAdmissionRules(GeneratePolicy(in), &observed)
+}
+
+// IsDefaultPolicy returns true if the admission rules of the supplied Policy
+// are those of the DefaultPolicy.
+func IsDefaultPolicy(observed binaryauthorization.Policy) bool {
+	return sameAdmissionRules(DefaultPolicy(), &observed)
+}
+
+// sameAdmissionRules returns true if the supplied policies admit the same
+// images.
+func sameAdmissionRules(a, b *binaryauthorization.Policy) bool {
+	return cmp.Equal(a, b,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(binaryauthorization.Policy{}, "Description", "GlobalPolicyEvaluationMode", "Name", "UpdateTime", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(binaryauthorization.AdmissionRule{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(binaryauthorization.AdmissionWhitelistPattern{}, "ForceSendFields", "NullFields"),
+		cmpopts.SortSlices(func(a, b *binaryauthorization.AdmissionWhitelistPattern) bool { return a.NamePattern < b.NamePattern }),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const testAttestor = "projects/my-project/attestors/build"
+
+func testPolicyParameters() v1alpha1.PolicyParameters {
+	return v1alpha1.PolicyParameters{
+		AdmissionWhitelistPatterns: []v1alpha1.AdmissionWhitelistPattern{
+			{NamePattern: "gcr.io/google_containers/*"},
+			{NamePattern: "gcr.io/my-project/tools/**"},
+		},
+		DefaultAdmissionRule: v1alpha1.AdmissionRule{
+			EvaluationMode:        v1alpha1.EvaluationModeRequireAttestation,
+			EnforcementMode:       v1alpha1.EnforcementModeEnforcedBlockAndAuditLog,
+			RequireAttestationsBy: []string{testAttestor},
+		},
+		ClusterAdmissionRules: map[string]v1alpha1.AdmissionRule{
+			"us-central1-a.dev": {
+				EvaluationMode:  v1alpha1.EvaluationModeAlwaysAllow,
+				EnforcementMode: v1alpha1.EnforcementModeDryrunAuditLogOnly,
+			},
+		},
+	}
+}
+
+func testPolicy() *binaryauthorization.Policy {
+	return &binaryauthorization.Policy{
+		Name: "projects/my-project/policy",
+		AdmissionWhitelistPatterns: []*binaryauthorization.AdmissionWhitelistPattern{
+			{NamePattern: "gcr.io/my-project/tools/**"},
+			{NamePattern: "gcr.io/google_containers/*"},
+		},
+		DefaultAdmissionRule: &binaryauthorization.AdmissionRule{
+			EvaluationMode:        v1alpha1.EvaluationModeRequireAttestation,
+			EnforcementMode:       v1alpha1.EnforcementModeEnforcedBlockAndAuditLog,
+			RequireAttestationsBy: []string{testAttestor},
+		},
+		ClusterAdmissionRules: map[string]binaryauthorization.AdmissionRule{
+			"us-central1-a.dev": {
+				EvaluationMode:  v1alpha1.EvaluationModeAlwaysAllow,
+				EnforcementMode: v1alpha1.EnforcementModeDryrunAuditLogOnly,
+			},
+		},
+		GlobalPolicyEvaluationMode: "ENABLE",
+	}
+}
+
+func TestGeneratePolicy(t *testing.T) {
+	in := testPolicyParameters()
+	in.Description = gcp.StringPtr("secure")
+	want := &binaryauthorization.Policy{
+		Description: "secure",
+		AdmissionWhitelistPatterns: []*binaryauthorization.AdmissionWhitelistPattern{
+			{NamePattern: "gcr.io/google_containers/*"},
+			{NamePattern: "gcr.io/my-project/tools/**"},
+		},
+		DefaultAdmissionRule: &binaryauthorization.AdmissionRule{
+			EvaluationMode:        v1alpha1.EvaluationModeRequireAttestation,
+			EnforcementMode:       v1alpha1.EnforcementModeEnforcedBlockAndAuditLog,
+			RequireAttestationsBy: []string{testAttestor},
+		},
+		ClusterAdmissionRules: map[string]binaryauthorization.AdmissionRule{
+			"us-central1-a.dev": {
+				EvaluationMode:  v1alpha1.EvaluationModeAlwaysAllow,
+				EnforcementMode: v1alpha1.EnforcementModeDryrunAuditLogOnly,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, GeneratePolicy(in)); diff != "" {
+		t.Errorf("GeneratePolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       func(p *v1alpha1.PolicyParameters)
+		observed func(p *binaryauthorization.Policy)
+		want     bool
+	}{
+		"UpToDate": {
+			want: true,
+		},
+		"GlobalPolicyEvaluationModeChanged": {
+			in:   func(p *v1alpha1.PolicyParameters) { p.GlobalPolicyEvaluationMode = gcp.StringPtr("DISABLE") },
+			want: false,
+		},
+		"DescriptionChanged": {
+			in:   func(p *v1alpha1.PolicyParameters) { p.Description = gcp.StringPtr("new") },
+			want: false,
+		},
+		"DefaultRuleChanged": {
+			in: func(p *v1alpha1.PolicyParameters) {
+				p.DefaultAdmissionRule.EnforcementMode = v1alpha1.EnforcementModeDryrunAuditLogOnly
+			},
+			want: false,
+		},
+		"AttestorAdded": {
+			in: func(p *v1alpha1.PolicyParameters) {
+				p.DefaultAdmissionRule.RequireAttestationsBy = append(p.DefaultAdmissionRule.RequireAttestationsBy, "projects/my-project/attestors/qa")
+			},
+			want: false,
+		},
+		"ClusterRuleRemoved": {
+			in:   func(p *v1alpha1.PolicyParameters) { p.ClusterAdmissionRules = nil },
+			want: false,
+		},
+		"WhitelistPatternRemoved": {
+			observed: func(p *binaryauthorization.Policy) {
+				p.AdmissionWhitelistPatterns = append(p.AdmissionWhitelistPatterns, &binaryauthorization.AdmissionWhitelistPattern{NamePattern: "docker.io/*"})
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := testPolicyParameters()
+			if tc.in != nil {
+				tc.in(&in)
+			}
+			observed := testPolicy()
+			if tc.observed != nil {
+				tc.observed(observed)
+			}
+			got := IsPolicyUpToDate(in, *observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDefaultPolicy(t *testing.T) {
+	cases := map[string]struct {
+		observed binaryauthorization.Policy
+		want     bool
+	}{
+		"Default": {
+			observed: binaryauthorization.Policy{
+				Name:                       "projects/my-project/policy",
+				GlobalPolicyEvaluationMode: "ENABLE",
+				DefaultAdmissionRule: &binaryauthorization.AdmissionRule{
+					EvaluationMode:  v1alpha1.EvaluationModeAlwaysAllow,
+					EnforcementMode: v1alpha1.EnforcementModeEnforcedBlockAndAuditLog,
+				},
+			},
+			want: true,
+		},
+		"Configured": {
+			observed: *testPolicy(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDefaultPolicy(tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDefaultPolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudmemorystore
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// AUTH client defaults.
+const (
+	DefaultAuthEndpoint = "https://redis.googleapis.com/"
+	CloudPlatformScope  = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// An AuthClient manages Redis AUTH of Cloud Memorystore instances. The Cloud
+// Memorystore client library used by this provider predates AUTH, so it is
+// managed using the v1 REST API instead.
+type AuthClient interface {
+	GetAuthEnabled(ctx context.Context, name string) (bool, error)
+	SetAuthEnabled(ctx context.Context, name string, enabled bool) error
+	GetAuthString(ctx context.Context, name string) (string, error)
+}
+
+// NewAuthClient returns a new AuthClient configured per the supplied options.
+func NewAuthClient(ctx context.Context, opts ...option.ClientOption) (AuthClient, error) {
+	c, err := gcp.NewJSONClient(ctx, DefaultAuthEndpoint, append([]option.ClientOption{option.WithScopes(CloudPlatformScope)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &authClient{json: c}, nil
+}
+
+type authInstance struct {
+	AuthEnabled bool `json:"authEnabled"`
+}
+
+type authString struct {
+	AuthString string `json:"authString,omitempty"`
+}
+
+type authClient struct {
+	json *gcp.JSONClient
+}
+
+// GetAuthEnabled returns true if AUTH is enabled for the named instance.
+func (c *authClient) GetAuthEnabled(ctx context.Context, name string) (bool, error) {
+	i := &authInstance{}
+	err := c.do(ctx, http.MethodGet, name, nil, nil, i)
+	return i.AuthEnabled, err
+}
+
+// SetAuthEnabled enables or disables AUTH for the named instance. AUTH is
+// changed by a long running operation that is not waited for.
+func (c *authClient) SetAuthEnabled(ctx context.Context, name string, enabled bool) error {
+	q := url.Values{"updateMask": {"authEnabled"}}
+	return c.do(ctx, http.MethodPatch, name, q, &authInstance{AuthEnabled: enabled}, &struct{}{})
+}
+
+// GetAuthString returns the AUTH string of the named instance.
+func (c *authClient) GetAuthString(ctx context.Context, name string) (string, error) {
+	s := &authString{}
+	err := c.do(ctx, http.MethodGet, name+"/authString", nil, nil, s)
+	return s.AuthString, err
+}
+
+// do sends a request for the supplied resource path and decodes the response
+// into out.
+func (c *authClient) do(ctx context.Context, method, path string, q url.Values, in, out interface{}) error {
+	return c.json.Do(ctx, method, "v1/"+path, q, in, out)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudmemorystore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+)
+
+func TestAuthClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case http.MethodGet + " /v1/" + qualifiedName:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": qualifiedName, "authEnabled": true})
+		case http.MethodGet + " /v1/" + qualifiedName + "/authString":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"authString": "sosecret"})
+		case http.MethodPatch + " /v1/" + qualifiedName:
+			if diff := cmp.Diff("authEnabled", r.URL.Query().Get("updateMask")); diff != "" {
+				t.Errorf("SetAuthEnabled(...): -want mask, +got mask:\n%s", diff)
+			}
+			body := map[string]interface{}{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if diff := cmp.Diff(map[string]interface{}{"authEnabled": false}, body); diff != "" {
+				t.Errorf("SetAuthEnabled(...): -want body, +got body:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "operation"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c, err := NewAuthClient(ctx, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("NewAuthClient(...): %s", err)
+	}
+
+	enabled, err := c.GetAuthEnabled(ctx, qualifiedName)
+	if err != nil || !enabled {
+		t.Errorf("GetAuthEnabled(...): want true, got %t, %v", enabled, err)
+	}
+	auth, err := c.GetAuthString(ctx, qualifiedName)
+	if diff := cmp.Diff("sosecret", auth); diff != "" || err != nil {
+		t.Errorf("GetAuthString(...): -want, +got:\n%s\nerror: %v", diff, err)
+	}
+	if err := c.SetAuthEnabled(ctx, qualifiedName, false); err != nil {
+		t.Errorf("SetAuthEnabled(...): %s", err)
+	}
+}
@@ -45,6 +45,7 @@ const errCheckUpToDate = "unable to determine if external resource is up to date
 var (
 	StateCreating = redisv1pb.Instance_CREATING.String()
 	StateReady    = redisv1pb.Instance_READY.String()
+	StateUpdating = redisv1pb.Instance_UPDATING.String()
 	StateDeleting = redisv1pb.Instance_DELETING.String()
 )
 
@@ -57,10 +58,10 @@ type Client interface {
 	GetInstance(ctx context.Context, req *redisv1pb.GetInstanceRequest, opts ...gax.CallOption) (*redisv1pb.Instance, error)
 }
 
-// NewClient returns a new CloudMemorystore Client. Credentials must be passed
-// as JSON encoded data.
-func NewClient(ctx context.Context, credentials []byte) (Client, error) {
-	return redisv1.NewCloudRedisClient(ctx, option.WithCredentialsJSON(credentials))
+// NewClient returns a new CloudMemorystore Client configured per the supplied
+// options, which must include any credentials.
+func NewClient(ctx context.Context, opts ...option.ClientOption) (Client, error) {
+	return redisv1.NewCloudRedisClient(ctx, opts...)
 }
 
 // An InstanceID represents a CloudMemorystore instance in the GCP API.
@@ -208,6 +209,15 @@ func IsUpToDate(id InstanceID, in *v1beta1.CloudMemorystoreInstanceParameters, o
 	return true, nil
 }
 
+// IsAuthUpToDate returns true if AUTH is enabled or disabled as the supplied
+// parameters require. AUTH is always up to date if they do not specify it.
+func IsAuthUpToDate(in v1beta1.CloudMemorystoreInstanceParameters, observed *bool) bool {
+	if in.AuthEnabled == nil {
+		return true
+	}
+	return observed != nil && *in.AuthEnabled == *observed
+}
+
 // NewDeleteInstanceRequest creates a request to delete an instance suitable for
 // use with the GCP API.
 func NewDeleteInstanceRequest(id InstanceID) *redisv1pb.DeleteInstanceRequest {
@@ -362,6 +362,49 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsAuthUpToDate(t *testing.T) {
+	enabled, disabled := true, false
+	cases := []struct {
+		name     string
+		in       v1beta1.CloudMemorystoreInstanceParameters
+		observed *bool
+		want     bool
+	}{
+		{
+			name:     "NotSpecified",
+			in:       v1beta1.CloudMemorystoreInstanceParameters{},
+			observed: &enabled,
+			want:     true,
+		},
+		{
+			name:     "Matches",
+			in:       v1beta1.CloudMemorystoreInstanceParameters{AuthEnabled: &enabled},
+			observed: &enabled,
+			want:     true,
+		},
+		{
+			name:     "Differs",
+			in:       v1beta1.CloudMemorystoreInstanceParameters{AuthEnabled: &disabled},
+			observed: &enabled,
+			want:     false,
+		},
+		{
+			name: "NotObserved",
+			in:   v1beta1.CloudMemorystoreInstanceParameters{AuthEnabled: &disabled},
+			want: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsAuthUpToDate(tc.in, tc.observed)
+			if got != tc.want {
+				t.Errorf("IsAuthUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
 func TestNewDeleteInstanceRequest(t *testing.T) {
 	cases := []struct {
 		name    string
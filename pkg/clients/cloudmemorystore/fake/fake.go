@@ -27,6 +27,7 @@ import (
 )
 
 var _ cloudmemorystore.Client = &MockClient{}
+var _ cloudmemorystore.AuthClient = &MockAuthClient{}
 
 // MockClient is a fake implementation of cloudmemorystore.Client.
 type MockClient struct {
@@ -55,3 +56,25 @@ func (c *MockClient) DeleteInstance(ctx context.Context, req *redisv1pb.DeleteIn
 func (c *MockClient) GetInstance(ctx context.Context, req *redisv1pb.GetInstanceRequest, opts ...gax.CallOption) (*redisv1pb.Instance, error) {
 	return c.MockGetInstance(ctx, req, opts...)
 }
+
+// MockAuthClient is a fake implementation of cloudmemorystore.AuthClient.
+type MockAuthClient struct {
+	MockGetAuthEnabled func(ctx context.Context, name string) (bool, error)
+	MockSetAuthEnabled func(ctx context.Context, name string, enabled bool) error
+	MockGetAuthString  func(ctx context.Context, name string) (string, error)
+}
+
+// GetAuthEnabled calls the MockAuthClient's MockGetAuthEnabled function.
+func (c *MockAuthClient) GetAuthEnabled(ctx context.Context, name string) (bool, error) {
+	return c.MockGetAuthEnabled(ctx, name)
+}
+
+// SetAuthEnabled calls the MockAuthClient's MockSetAuthEnabled function.
+func (c *MockAuthClient) SetAuthEnabled(ctx context.Context, name string, enabled bool) error {
+	return c.MockSetAuthEnabled(ctx, name, enabled)
+}
+
+// GetAuthString calls the MockAuthClient's MockGetAuthString function.
+func (c *MockAuthClient) GetAuthString(ctx context.Context, name string) (string, error) {
+	return c.MockGetAuthString(ctx, name)
+}
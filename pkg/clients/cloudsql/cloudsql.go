@@ -26,6 +26,7 @@ import (
 	"github.com/mitchellh/copystructure"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 )
@@ -312,6 +313,20 @@ func IsUpToDate(name string, in *v1beta1.CloudSQLInstanceParameters, observed *s
 	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(sqladmin.DatabaseInstance{}, "Settings.IpConfiguration.ForceSendFields")), nil
 }
 
+// ChangedImmutableFields returns the JSON names of the supplied parameters
+// that differ from the observed instance but cannot be changed once it is
+// created. Unset parameters are not considered changed.
+func ChangedImmutableFields(in v1beta1.CloudSQLInstanceParameters, observed sqladmin.DatabaseInstance) []string {
+	var changed []string
+	if in.Region != "" && in.Region != observed.Region {
+		changed = append(changed, "region")
+	}
+	if in.DatabaseVersion != nil && *in.DatabaseVersion != observed.DatabaseVersion {
+		changed = append(changed, "databaseVersion")
+	}
+	return changed
+}
+
 // DatabaseUserName returns default database user name base on database version
 func DatabaseUserName(p v1beta1.CloudSQLInstanceParameters) string {
 	if strings.HasPrefix(gcp.StringValue(p.DatabaseVersion), v1beta1.PostgresqlDBVersionPrefix) {
@@ -320,6 +335,15 @@ func DatabaseUserName(p v1beta1.CloudSQLInstanceParameters) string {
 	return v1beta1.MysqlDefaultUser
 }
 
+// DatabasePort returns the port at which the database engine of the supplied
+// instance listens.
+func DatabasePort(p v1beta1.CloudSQLInstanceParameters) string {
+	if strings.HasPrefix(gcp.StringValue(p.DatabaseVersion), v1beta1.PostgresqlDBVersionPrefix) {
+		return v1beta1.PostgresqlDefaultPort
+	}
+	return v1beta1.MysqlDefaultPort
+}
+
 // GetServerCACertificate takes sqladmin.DatabaseInstance and returns the server CA certificate
 // in a form that can be embedded directly into a connection secret.
 func GetServerCACertificate(in sqladmin.DatabaseInstance) map[string][]byte {
@@ -327,6 +351,7 @@ func GetServerCACertificate(in sqladmin.DatabaseInstance) map[string][]byte {
 		return nil
 	}
 	return map[string][]byte{
+		connection.KeyServerCACertificate:                            []byte(in.ServerCaCert.Cert),
 		v1beta1.CloudSQLSecretServerCACertificateCertKey:             []byte(in.ServerCaCert.Cert),
 		v1beta1.CloudSQLSecretServerCACertificateCertSerialNumberKey: []byte(in.ServerCaCert.CertSerialNumber),
 		v1beta1.CloudSQLSecretServerCACertificateCommonNameKey:       []byte(in.ServerCaCert.CommonName),
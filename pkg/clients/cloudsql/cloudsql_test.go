@@ -22,6 +22,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 )
@@ -367,6 +368,17 @@ func TestDatabaseUserName(t *testing.T) {
 	}
 }
 
+func TestDatabasePort(t *testing.T) {
+	p := v1beta1.CloudSQLInstanceParameters{DatabaseVersion: gcp.StringPtr("POSTGRES_9_6")}
+	if diff := cmp.Diff(v1beta1.PostgresqlDefaultPort, DatabasePort(p)); diff != "" {
+		t.Errorf("DatabasePort(...): -want, +got:\n%s", diff)
+	}
+	p.DatabaseVersion = gcp.StringPtr("MYSQL_5_7")
+	if diff := cmp.Diff(v1beta1.MysqlDefaultPort, DatabasePort(p)); diff != "" {
+		t.Errorf("DatabasePort(...): -want, +got:\n%s", diff)
+	}
+}
+
 func TestGetServerCACertificate(t *testing.T) {
 	cert := &sqladmin.SslCert{
 		Cert:             "my-cert",
@@ -400,6 +412,7 @@ func TestGetServerCACertificate(t *testing.T) {
 				db.ServerCaCert = cert
 			})},
 			want: want{r: map[string][]byte{
+				connection.KeyServerCACertificate:                            []byte(cert.Cert),
 				v1beta1.CloudSQLSecretServerCACertificateCertKey:             []byte(cert.Cert),
 				v1beta1.CloudSQLSecretServerCACertificateCertSerialNumberKey: []byte(cert.CertSerialNumber),
 				v1beta1.CloudSQLSecretServerCACertificateCommonNameKey:       []byte(cert.CommonName),
@@ -471,3 +484,32 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestChangedImmutableFields(t *testing.T) {
+	cases := map[string]struct {
+		params v1beta1.CloudSQLInstanceParameters
+		db     sqladmin.DatabaseInstance
+		want   []string
+	}{
+		"Unchanged": {
+			params: v1beta1.CloudSQLInstanceParameters{Region: "us-east1", DatabaseVersion: gcp.StringPtr("POSTGRES_11")},
+			db:     sqladmin.DatabaseInstance{Region: "us-east1", DatabaseVersion: "POSTGRES_11"},
+		},
+		"Unset": {
+			db: sqladmin.DatabaseInstance{Region: "us-east1", DatabaseVersion: "POSTGRES_11"},
+		},
+		"Changed": {
+			params: v1beta1.CloudSQLInstanceParameters{Region: "us-west1", DatabaseVersion: gcp.StringPtr("POSTGRES_12")},
+			db:     sqladmin.DatabaseInstance{Region: "us-east1", DatabaseVersion: "POSTGRES_11"},
+			want:   []string{"region", "databaseVersion"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ChangedImmutableFields(tc.params, tc.db)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ChangedImmutableFields(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -45,6 +45,10 @@ const (
 
 	// ClusterNameFormat is the format for the fully qualified name of a cluster.
 	ClusterNameFormat = "projects/%s/locations/%s/clusters/%s"
+
+	// OperationNameFormat is the format for the fully qualified name of an
+	// operation.
+	OperationNameFormat = "projects/%s/locations/%s/operations/%s"
 )
 
 const (
@@ -1145,6 +1149,12 @@ func GetFullyQualifiedName(project string, p v1beta1.GKEClusterParameters, name
 	return fmt.Sprintf(ClusterNameFormat, project, p.Location, name)
 }
 
+// GetFullyQualifiedOperation builds the fully qualified name of an operation
+// in the location of the cluster.
+func GetFullyQualifiedOperation(project string, p v1beta1.GKEClusterParameters, op string) string {
+	return fmt.Sprintf(OperationNameFormat, project, p.Location, op)
+}
+
 // GetFullyQualifiedBNP build the fully qualified name of the bootstrap node
 // pool.
 func GetFullyQualifiedBNP(clusterName string) string {
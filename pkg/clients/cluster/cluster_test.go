@@ -1600,6 +1600,28 @@ func TestGetFullyQualifiedName(t *testing.T) {
 	}
 }
 
+func TestGetFullyQualifiedOperation(t *testing.T) {
+	tests := map[string]struct {
+		params v1beta1.GKEClusterParameters
+		op     string
+		want   string
+	}{
+		"Successful": {
+			params: *params(),
+			op:     "operation-1234",
+			want:   fmt.Sprintf(OperationNameFormat, project, location, "operation-1234"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := GetFullyQualifiedOperation(project, tc.params, tc.op)
+			if diff := cmp.Diff(tc.want, s); diff != "" {
+				t.Errorf("GetFullyQualifiedOperation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGetFullyQualifiedBNP(t *testing.T) {
 	clusterName := fmt.Sprintf(ClusterNameFormat, project, location, name)
 	tests := map[string]struct {
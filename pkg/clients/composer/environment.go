@@ -0,0 +1,228 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composer
+
+import (
+	"fmt"
+	"sort"
+
+	composer "google.golang.org/api/composer/v1beta1"
+
+	"github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Update mask paths of the updatable fields of an environment. The API
+// accepts only one kind of update per request, so each is patched
+// separately.
+const (
+	maskLabels                 = "labels"
+	maskNodeCount              = "config.nodeCount"
+	maskImageVersion           = "config.softwareConfig.imageVersion"
+	maskAirflowConfigOverrides = "config.softwareConfig.airflowConfigOverrides"
+	maskPyPIPackages           = "config.softwareConfig.pypiPackages"
+	maskEnvVariables           = "config.softwareConfig.envVariables"
+)
+
+// LocationName returns the resource name of the supplied location.
+func LocationName(project, location string) string {
+	return fmt.Sprintf("projects/%s/locations/%s", project, location)
+}
+
+// EnvironmentName returns the resource name of the supplied environment.
+func EnvironmentName(project, location, id string) string {
+	return LocationName(project, location) + "/environments/" + id
+}
+
+// GenerateEnvironment takes EnvironmentParameters and returns an Environment
+// with the supplied resource name.
+func GenerateEnvironment(name string, in v1alpha1.EnvironmentParameters) *composer.Environment {
+	cfg := &composer.EnvironmentConfig{NodeCount: gcp.Int64Value(in.NodeCount)}
+
+	if nc := in.NodeConfig; nc != nil {
+		cfg.NodeConfig = &composer.NodeConfig{
+			Location:       gcp.StringValue(nc.Location),
+			MachineType:    gcp.StringValue(nc.MachineType),
+			Network:        gcp.StringValue(nc.Network),
+			Subnetwork:     gcp.StringValue(nc.Subnetwork),
+			DiskSizeGb:     gcp.Int64Value(nc.DiskSizeGB),
+			OauthScopes:    nc.OAuthScopes,
+			ServiceAccount: gcp.StringValue(nc.ServiceAccount),
+			Tags:           nc.Tags,
+		}
+		if p := nc.IPAllocationPolicy; p != nil {
+			cfg.NodeConfig.IpAllocationPolicy = &composer.IPAllocationPolicy{
+				UseIpAliases:               gcp.BoolValue(p.UseIPAliases),
+				ClusterSecondaryRangeName:  gcp.StringValue(p.ClusterSecondaryRangeName),
+				ClusterIpv4CidrBlock:       gcp.StringValue(p.ClusterIPv4CIDRBlock),
+				ServicesSecondaryRangeName: gcp.StringValue(p.ServicesSecondaryRangeName),
+				ServicesIpv4CidrBlock:      gcp.StringValue(p.ServicesIPv4CIDRBlock),
+			}
+		}
+	}
+
+	if sc := in.SoftwareConfig; sc != nil {
+		cfg.SoftwareConfig = &composer.SoftwareConfig{
+			ImageVersion:           gcp.StringValue(sc.ImageVersion),
+			PythonVersion:          gcp.StringValue(sc.PythonVersion),
+			AirflowConfigOverrides: sc.AirflowConfigOverrides,
+			PypiPackages:           sc.PyPIPackages,
+			EnvVariables:           sc.EnvVariables,
+		}
+	}
+
+	if pc := in.PrivateEnvironmentConfig; pc != nil {
+		cfg.PrivateEnvironmentConfig = &composer.PrivateEnvironmentConfig{
+			EnablePrivateEnvironment: gcp.BoolValue(pc.EnablePrivateEnvironment),
+		}
+		if cc := pc.PrivateClusterConfig; cc != nil {
+			cfg.PrivateEnvironmentConfig.PrivateClusterConfig = &composer.PrivateClusterConfig{
+				EnablePrivateEndpoint: gcp.BoolValue(cc.EnablePrivateEndpoint),
+				MasterIpv4CidrBlock:   gcp.StringValue(cc.MasterIPv4CIDRBlock),
+			}
+		}
+	}
+
+	return &composer.Environment{Name: name, Labels: in.Labels, Config: cfg}
+}
+
+// GenerateObservation takes an Environment and returns an
+// EnvironmentObservation.
+func GenerateObservation(in composer.Environment) v1alpha1.EnvironmentObservation {
+	o := v1alpha1.EnvironmentObservation{
+		Name:       in.Name,
+		UUID:       in.Uuid,
+		State:      in.State,
+		CreateTime: gcp.TimeFromRFC3339(in.CreateTime),
+		UpdateTime: gcp.TimeFromRFC3339(in.UpdateTime),
+	}
+	if in.Config != nil {
+		o.AirflowURI = in.Config.AirflowUri
+		o.DAGGCSPrefix = in.Config.DagGcsPrefix
+		o.GKECluster = in.Config.GkeCluster
+	}
+	return o
+}
+
+// LateInitializeSpec fills the empty fields of the supplied
+// EnvironmentParameters with those of the supplied Environment.
+func LateInitializeSpec(spec *v1alpha1.EnvironmentParameters, in composer.Environment) {
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	cfg := in.Config
+	if cfg == nil {
+		return
+	}
+	spec.NodeCount = gcp.LateInitializeInt64(spec.NodeCount, cfg.NodeCount)
+
+	if nc := cfg.NodeConfig; nc != nil {
+		if spec.NodeConfig == nil {
+			spec.NodeConfig = &v1alpha1.NodeConfig{}
+		}
+		s := spec.NodeConfig
+		s.Location = gcp.LateInitializeString(s.Location, nc.Location)
+		s.MachineType = gcp.LateInitializeString(s.MachineType, nc.MachineType)
+		s.Network = gcp.LateInitializeString(s.Network, nc.Network)
+		s.Subnetwork = gcp.LateInitializeString(s.Subnetwork, nc.Subnetwork)
+		s.DiskSizeGB = gcp.LateInitializeInt64(s.DiskSizeGB, nc.DiskSizeGb)
+		s.OAuthScopes = gcp.LateInitializeStringSlice(s.OAuthScopes, nc.OauthScopes)
+		s.ServiceAccount = gcp.LateInitializeString(s.ServiceAccount, nc.ServiceAccount)
+		s.Tags = gcp.LateInitializeStringSlice(s.Tags, nc.Tags)
+	}
+
+	if sc := cfg.SoftwareConfig; sc != nil {
+		if spec.SoftwareConfig == nil {
+			spec.SoftwareConfig = &v1alpha1.SoftwareConfig{}
+		}
+		s := spec.SoftwareConfig
+		s.ImageVersion = gcp.LateInitializeString(s.ImageVersion, sc.ImageVersion)
+		s.PythonVersion = gcp.LateInitializeString(s.PythonVersion, sc.PythonVersion)
+		s.AirflowConfigOverrides = gcp.LateInitializeStringMap(s.AirflowConfigOverrides, sc.AirflowConfigOverrides)
+		s.PyPIPackages = gcp.LateInitializeStringMap(s.PyPIPackages, sc.PypiPackages)
+		s.EnvVariables = gcp.LateInitializeStringMap(s.EnvVariables, sc.EnvVariables)
+	}
+}
+
+// UpdateMask returns the update mask of the next patch needed to make the
+// supplied Environment match the supplied EnvironmentParameters, or nil if it
+// is up to date. The API accepts only one kind of update per request, so
+// the mask covers only the first kind of update that is needed. Airflow
+// configuration overrides and PyPI packages are patched individually, so
+// that unchanged packages are not reinstalled.
+func UpdateMask(in v1alpha1.EnvironmentParameters, observed composer.Environment) []string {
+	if !gcp.LabelsUpToDate(in.Labels, observed.Labels) {
+		return []string{maskLabels}
+	}
+
+	cfg := observed.Config
+	if cfg == nil {
+		cfg = &composer.EnvironmentConfig{}
+	}
+	if in.NodeCount != nil && *in.NodeCount != cfg.NodeCount {
+		return []string{maskNodeCount}
+	}
+
+	sc := in.SoftwareConfig
+	if sc == nil {
+		return nil
+	}
+	osc := cfg.SoftwareConfig
+	if osc == nil {
+		osc = &composer.SoftwareConfig{}
+	}
+	if sc.ImageVersion != nil && *sc.ImageVersion != osc.ImageVersion {
+		return []string{maskImageVersion}
+	}
+	if m := keyMasks(maskAirflowConfigOverrides, sc.AirflowConfigOverrides, osc.AirflowConfigOverrides); len(m) > 0 {
+		return m
+	}
+	if m := keyMasks(maskPyPIPackages, sc.PyPIPackages, osc.PypiPackages); len(m) > 0 {
+		return m
+	}
+	// Environment variables can only be replaced as a whole.
+	if len(keyMasks(maskEnvVariables, sc.EnvVariables, osc.EnvVariables)) > 0 {
+		return []string{maskEnvVariables}
+	}
+	return nil
+}
+
+// IsUpToDate returns true if the updatable fields of the supplied Environment
+// match the supplied EnvironmentParameters.
+func IsUpToDate(in v1alpha1.EnvironmentParameters, observed composer.Environment) bool {
+	return len(UpdateMask(in, observed)) == 0
+}
+
+// keyMasks returns an update mask path, prefixed by the supplied path, for
+// each key that is added, changed or removed in the desired map. A nil
+// desired map is not managed and never differs.
+func keyMasks(prefix string, desired, observed map[string]string) []string {
+	if desired == nil {
+		return nil
+	}
+	var mask []string
+	for k, v := range desired {
+		if ov, ok := observed[k]; !ok || ov != v {
+			mask = append(mask, prefix+"."+k)
+		}
+	}
+	for k := range observed {
+		if _, ok := desired[k]; !ok {
+			mask = append(mask, prefix+"."+k)
+		}
+	}
+	sort.Strings(mask)
+	return mask
+}
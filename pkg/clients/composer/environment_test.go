@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	composer "google.golang.org/api/composer/v1beta1"
+
+	"github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const imageVersion = "composer-1.10.6-airflow-1.10.6"
+
+func observedEnvironment() composer.Environment {
+	return composer.Environment{
+		Labels: map[string]string{"team": "data"},
+		Config: &composer.EnvironmentConfig{
+			NodeCount: 3,
+			SoftwareConfig: &composer.SoftwareConfig{
+				ImageVersion:           imageVersion,
+				AirflowConfigOverrides: map[string]string{"core-dags_are_paused_at_creation": "True"},
+				PypiPackages:           map[string]string{"requests": ">=2.23.0", "numpy": ""},
+				EnvVariables:           map[string]string{"ENVIRONMENT": "prod"},
+			},
+		},
+	}
+}
+
+func desiredEnvironment() v1alpha1.EnvironmentParameters {
+	return v1alpha1.EnvironmentParameters{
+		Labels:    map[string]string{"team": "data"},
+		NodeCount: gcp.Int64Ptr(3),
+		SoftwareConfig: &v1alpha1.SoftwareConfig{
+			ImageVersion:           gcp.StringPtr(imageVersion),
+			AirflowConfigOverrides: map[string]string{"core-dags_are_paused_at_creation": "True"},
+			PyPIPackages:           map[string]string{"requests": ">=2.23.0", "numpy": ""},
+			EnvVariables:           map[string]string{"ENVIRONMENT": "prod"},
+		},
+	}
+}
+
+func TestUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		in   func(p *v1alpha1.EnvironmentParameters)
+		want []string
+	}{
+		"UpToDate": {
+			in: func(p *v1alpha1.EnvironmentParameters) {},
+		},
+		"Unmanaged": {
+			in:   func(p *v1alpha1.EnvironmentParameters) { *p = v1alpha1.EnvironmentParameters{Labels: p.Labels} },
+			want: nil,
+		},
+		"LabelsFirst": {
+			in: func(p *v1alpha1.EnvironmentParameters) {
+				p.Labels = map[string]string{"team": "ml"}
+				p.NodeCount = gcp.Int64Ptr(5)
+			},
+			want: []string{maskLabels},
+		},
+		"NodeCount": {
+			in:   func(p *v1alpha1.EnvironmentParameters) { p.NodeCount = gcp.Int64Ptr(5) },
+			want: []string{maskNodeCount},
+		},
+		"ImageVersion": {
+			in: func(p *v1alpha1.EnvironmentParameters) {
+				p.SoftwareConfig.ImageVersion = gcp.StringPtr("composer-1.11.0-airflow-1.10.9")
+			},
+			want: []string{maskImageVersion},
+		},
+		"AirflowConfigOverrideAdded": {
+			in: func(p *v1alpha1.EnvironmentParameters) {
+				p.SoftwareConfig.AirflowConfigOverrides["webserver-dag_orientation"] = "TB"
+			},
+			want: []string{maskAirflowConfigOverrides + ".webserver-dag_orientation"},
+		},
+		"PyPIPackagesChangedAndRemoved": {
+			in: func(p *v1alpha1.EnvironmentParameters) {
+				p.SoftwareConfig.PyPIPackages = map[string]string{"requests": "==2.24.0"}
+			},
+			want: []string{maskPyPIPackages + ".numpy", maskPyPIPackages + ".requests"},
+		},
+		"EnvVariablesReplaced": {
+			in:   func(p *v1alpha1.EnvironmentParameters) { p.SoftwareConfig.EnvVariables["DEBUG"] = "1" },
+			want: []string{maskEnvVariables},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := desiredEnvironment()
+			tc.in(&in)
+			got := UpdateMask(in, observedEnvironment())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("UpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	observed := observedEnvironment()
+	observed.Config.NodeConfig = &composer.NodeConfig{
+		Location:       "projects/p/zones/us-central1-a",
+		MachineType:    "projects/p/zones/us-central1-a/machineTypes/n1-standard-1",
+		DiskSizeGb:     100,
+		ServiceAccount: "default",
+	}
+	observed.Config.SoftwareConfig.PythonVersion = "3"
+
+	got := v1alpha1.EnvironmentParameters{
+		NodeConfig:     &v1alpha1.NodeConfig{MachineType: gcp.StringPtr("n1-standard-1")},
+		SoftwareConfig: &v1alpha1.SoftwareConfig{},
+	}
+	LateInitializeSpec(&got, observed)
+
+	want := desiredEnvironment()
+	want.NodeConfig = &v1alpha1.NodeConfig{
+		Location:       gcp.StringPtr("projects/p/zones/us-central1-a"),
+		MachineType:    gcp.StringPtr("n1-standard-1"),
+		DiskSizeGB:     gcp.Int64Ptr(100),
+		ServiceAccount: gcp.StringPtr("default"),
+	}
+	want.SoftwareConfig.PythonVersion = gcp.StringPtr("3")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
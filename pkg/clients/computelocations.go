@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+)
+
+// Error strings.
+const (
+	errUnknownRegion   = "%q is not a region of project %s; expected one of %s"
+	errUnknownZone     = "%q is not a zone of project %s; expected one of %s"
+	errZoneNotInRegion = "zone %q belongs to region %q, not to region %q"
+)
+
+// DefaultLocationCacheTTL is how long the regions and zones of a project are
+// cached by ComputeLocations.
+const DefaultLocationCacheTTL = 1 * time.Hour
+
+// ComputeLocations validates regions and zones for all controllers, so that
+// the regions and zones of a project are listed at most once per
+// DefaultLocationCacheTTL.
+var ComputeLocations = NewLocationValidator(DefaultLocationCacheTTL)
+
+// A LocationValidator validates regions and zones against those the Compute
+// API lists for a project. Lists are cached per project, so that validating
+// does not call the Compute API every reconcile. A nil LocationValidator
+// considers every region and zone valid.
+type LocationValidator struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu       sync.Mutex
+	projects map[string]*computeLocations
+}
+
+// computeLocations are the regions and zones of a project. Zones are mapped
+// to the region they belong to.
+type computeLocations struct {
+	regions map[string]bool
+	zones   map[string]string
+	expires time.Time
+}
+
+// NewLocationValidator returns a LocationValidator that caches the regions
+// and zones of a project for the supplied duration.
+func NewLocationValidator(ttl time.Duration) *LocationValidator {
+	return &LocationValidator{ttl: ttl, now: time.Now, projects: map[string]*computeLocations{}}
+}
+
+// ValidateRegion returns an error if the supplied region is not a region of
+// the supplied project. Regions may be names or URLs. Validation is skipped
+// if the regions of the project cannot be listed, for example because the
+// Provider's service account may not list them; the API call that uses the
+// region reports any problem instead.
+func (v *LocationValidator) ValidateRegion(ctx context.Context, s *compute.Service, project, region string) error {
+	l := v.locations(ctx, s, project)
+	if l == nil {
+		return nil
+	}
+	if r := path.Base(region); !l.regions[r] {
+		return errors.Errorf(errUnknownRegion, r, project, strings.Join(keys(l.regions), ", "))
+	}
+	return nil
+}
+
+// ValidateZone returns an error if the supplied zone is not a zone of the
+// supplied project, or if a region is supplied and the zone does not belong
+// to it. Zones and regions may be names or URLs. Like ValidateRegion,
+// validation is skipped if the zones of the project cannot be listed.
+func (v *LocationValidator) ValidateZone(ctx context.Context, s *compute.Service, project, zone, region string) error {
+	l := v.locations(ctx, s, project)
+	if l == nil {
+		return nil
+	}
+	z := path.Base(zone)
+	in, ok := l.zones[z]
+	if !ok {
+		zones := map[string]bool{}
+		for zone := range l.zones {
+			zones[zone] = true
+		}
+		return errors.Errorf(errUnknownZone, z, project, strings.Join(keys(zones), ", "))
+	}
+	if r := path.Base(region); region != "" && in != r {
+		return errors.Errorf(errZoneNotInRegion, z, in, r)
+	}
+	return nil
+}
+
+// locations returns the regions and zones of the supplied project, listing
+// them only if they are not cached. It returns nil if they cannot be listed.
+func (v *LocationValidator) locations(ctx context.Context, s *compute.Service, project string) *computeLocations {
+	if v == nil {
+		return nil
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if l, ok := v.projects[project]; ok && v.now().Before(l.expires) {
+		return l
+	}
+
+	l := &computeLocations{regions: map[string]bool{}, zones: map[string]string{}, expires: v.now().Add(v.ttl)}
+	err := s.Regions.List(project).Pages(ctx, func(rl *compute.RegionList) error {
+		for _, r := range rl.Items {
+			l.regions[r.Name] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil
+	}
+	err = s.Zones.List(project).Pages(ctx, func(zl *compute.ZoneList) error {
+		for _, z := range zl.Items {
+			l.zones[z.Name] = path.Base(z.Region)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil
+	}
+	v.projects[project] = l
+	return l
+}
+
+func keys(m map[string]bool) []string {
+	k := make([]string, 0, len(m))
+	for s := range m {
+		k = append(k, s)
+	}
+	sort.Strings(k)
+	return k
+}
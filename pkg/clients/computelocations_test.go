@@ -0,0 +1,171 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+// computeLocationsServer serves the regions and zones of project "p". It
+// counts the region lists it serves, and fails them if fail is set.
+func computeLocationsServer(lists *int, fail bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/p/regions"):
+			*lists++
+			if fail {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("{}"))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(&compute.RegionList{Items: []*compute.Region{{Name: "us-central1"}, {Name: "europe-west1"}}})
+		case strings.HasSuffix(r.URL.Path, "/p/zones"):
+			_ = json.NewEncoder(w).Encode(&compute.ZoneList{Items: []*compute.Zone{
+				{Name: "us-central1-a", Region: "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1"},
+				{Name: "europe-west1-b", Region: "https://www.googleapis.com/compute/v1/projects/p/regions/europe-west1"},
+			}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestLocationValidator(t *testing.T) {
+	type args struct {
+		zone   string
+		region string
+	}
+	cases := map[string]struct {
+		reason string
+		fail   bool
+		nilV   bool
+		args   args
+		want   error
+	}{
+		"ValidRegion": {
+			reason: "A listed region should be valid.",
+			args:   args{region: "us-central1"},
+		},
+		"ValidRegionURL": {
+			reason: "A region may be supplied as a URL.",
+			args:   args{region: "https://www.googleapis.com/compute/v1/projects/p/regions/europe-west1"},
+		},
+		"UnknownRegion": {
+			reason: "A region that is not listed should be invalid.",
+			args:   args{region: "us-central"},
+			want:   errors.Errorf(errUnknownRegion, "us-central", "p", "europe-west1, us-central1"),
+		},
+		"ValidZone": {
+			reason: "A listed zone should be valid.",
+			args:   args{zone: "us-central1-a"},
+		},
+		"ValidZoneInRegion": {
+			reason: "A listed zone of the supplied region should be valid.",
+			args:   args{zone: "us-central1-a", region: "us-central1"},
+		},
+		"UnknownZone": {
+			reason: "A zone that is not listed should be invalid.",
+			args:   args{zone: "us-central1-z"},
+			want:   errors.Errorf(errUnknownZone, "us-central1-z", "p", "europe-west1-b, us-central1-a"),
+		},
+		"ZoneNotInRegion": {
+			reason: "A zone of another region should be invalid.",
+			args:   args{zone: "europe-west1-b", region: "us-central1"},
+			want:   errors.Errorf(errZoneNotInRegion, "europe-west1-b", "europe-west1", "us-central1"),
+		},
+		"ListFailed": {
+			reason: "Validation should be skipped if locations cannot be listed.",
+			fail:   true,
+			args:   args{region: "us-central"},
+		},
+		"NilValidator": {
+			reason: "A nil validator should consider every location valid.",
+			nilV:   true,
+			args:   args{region: "us-central"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			lists := 0
+			server := computeLocationsServer(&lists, tc.fail)
+			defer server.Close()
+			s, err := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			v := NewLocationValidator(DefaultLocationCacheTTL)
+			if tc.nilV {
+				v = nil
+			}
+
+			if tc.args.zone != "" {
+				err = v.ValidateZone(context.Background(), s, "p", tc.args.zone, tc.args.region)
+			} else {
+				err = v.ValidateRegion(context.Background(), s, "p", tc.args.region)
+			}
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLocationValidatorCache(t *testing.T) {
+	lists := 0
+	server := computeLocationsServer(&lists, false)
+	defer server.Close()
+	s, err := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	v := NewLocationValidator(time.Hour)
+	v.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if err := v.ValidateRegion(context.Background(), s, "p", "us-central1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if lists != 1 {
+		t.Errorf("ValidateRegion(...): want 1 list before the cache expires, got %d", lists)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if err := v.ValidateZone(context.Background(), s, "p", "us-central1-a", "us-central1"); err != nil {
+		t.Fatal(err)
+	}
+	if lists != 2 {
+		t.Errorf("ValidateZone(...): want 2 lists after the cache expires, got %d", lists)
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultCredentialsKey is the key of the Secret referenced by a Provider at
+// which its JSON credentials are read when the reference does not specify a
+// key.
+const DefaultCredentialsKey = "credentials.json"
+
+const errMissingCredentialsData = "Provider credentials Secret %s/%s has no data at key %q"
+
+// CredentialsData returns the credentials stored at the supplied key of the
+// supplied Secret, or at DefaultCredentialsKey if no key is supplied. It
+// returns an error if there are none, rather than let the empty credentials
+// fail to parse further down.
+func CredentialsData(s *corev1.Secret, key string) ([]byte, error) {
+	if key == "" {
+		key = DefaultCredentialsKey
+	}
+	data := s.Data[key]
+	if len(data) == 0 {
+		return nil, errors.Errorf(errMissingCredentialsData, s.GetNamespace(), s.GetName(), key)
+	}
+	return data, nil
+}
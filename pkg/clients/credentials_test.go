@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestCredentialsData(t *testing.T) {
+	creds := []byte(`{"type":"service_account"}`)
+	secret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "gcp-creds"}, Data: data}
+	}
+
+	type want struct {
+		data []byte
+		err  error
+	}
+
+	cases := map[string]struct {
+		s    *corev1.Secret
+		key  string
+		want want
+	}{
+		"Key": {
+			s:    secret(map[string][]byte{"key.json": creds}),
+			key:  "key.json",
+			want: want{data: creds},
+		},
+		"DefaultKey": {
+			s:    secret(map[string][]byte{DefaultCredentialsKey: creds}),
+			want: want{data: creds},
+		},
+		"MissingKey": {
+			s:    secret(map[string][]byte{DefaultCredentialsKey: creds}),
+			key:  "key.json",
+			want: want{err: errors.Errorf(errMissingCredentialsData, "crossplane-system", "gcp-creds", "key.json")},
+		},
+		"EmptyValue": {
+			s:    secret(map[string][]byte{DefaultCredentialsKey: {}}),
+			want: want{err: errors.Errorf(errMissingCredentialsData, "crossplane-system", "gcp-creds", DefaultCredentialsKey)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, err := CredentialsData(tc.s, tc.key)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("CredentialsData(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.data, data); diff != "" {
+				t.Errorf("CredentialsData(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	dataflow "google.golang.org/api/dataflow/v1b3"
+
+	"github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Pipeline options that FlexTemplateEnvironment settings are passed as.
+const (
+	optionMachineType    = "workerMachineType"
+	optionMaxWorkers     = "maxNumWorkers"
+	optionNetwork        = "network"
+	optionSubnetwork     = "subnetwork"
+	optionServiceAccount = "serviceAccount"
+
+	// optionUpdate causes a launched streaming job to replace the running job
+	// with the same name.
+	optionUpdate = "update"
+)
+
+// GenerateLaunchRequest produces a request that launches a flex template job
+// with the supplied name and JobParameters. If update is true the launched job
+// replaces the running streaming job of the same name.
+func GenerateLaunchRequest(name string, in v1alpha1.JobParameters, update bool) *dataflow.LaunchFlexTemplateRequest {
+	params := map[string]string{}
+	if e := in.Environment; e != nil {
+		setIfNotNil(params, optionMachineType, e.MachineType)
+		if e.MaxWorkers != nil {
+			params[optionMaxWorkers] = strconv.FormatInt(*e.MaxWorkers, 10)
+		}
+		setIfNotNil(params, optionNetwork, e.Network)
+		setIfNotNil(params, optionSubnetwork, e.Subnetwork)
+		setIfNotNil(params, optionServiceAccount, e.ServiceAccountEmail)
+	}
+	for k, v := range in.Parameters {
+		params[k] = v
+	}
+	if update {
+		params[optionUpdate] = "true"
+	}
+	return &dataflow.LaunchFlexTemplateRequest{
+		LaunchParameter: &dataflow.LaunchFlexTemplateParameter{
+			JobName:              name,
+			ContainerSpecGcsPath: in.ContainerSpecGCSPath,
+			Parameters:           params,
+		},
+	}
+}
+
+func setIfNotNil(m map[string]string, k string, v *string) {
+	if v != nil {
+		m[k] = *v
+	}
+}
+
+// GenerateJobObservation produces a JobObservation from the supplied
+// dataflow.Job. The LaunchedSpecHash is not known to the Dataflow API and is
+// left empty.
+func GenerateJobObservation(in dataflow.Job) v1alpha1.JobObservation {
+	return v1alpha1.JobObservation{
+		JobID:            in.Id,
+		Type:             in.Type,
+		State:            in.CurrentState,
+		CreateTime:       gcp.TimeFromRFC3339(in.CreateTime),
+		CurrentStateTime: gcp.TimeFromRFC3339(in.CurrentStateTime),
+	}
+}
+
+// LaunchedSpecHash returns a hash of the fields of the supplied JobParameters
+// that determine how a job is launched.
+func LaunchedSpecHash(in v1alpha1.JobParameters) string {
+	// Marshalling a struct of strings and maps cannot fail, and encoding/json
+	// sorts map keys so the result is stable.
+	b, _ := json.Marshal(struct {
+		Path        string                            `json:"path"`
+		Parameters  map[string]string                 `json:"parameters,omitempty"`
+		Environment *v1alpha1.FlexTemplateEnvironment `json:"environment,omitempty"`
+	}{
+		Path:        in.ContainerSpecGCSPath,
+		Parameters:  in.Parameters,
+		Environment: in.Environment,
+	})
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
+
+// IsTerminal returns true if a job in the supplied state will not run again.
+func IsTerminal(state string) bool {
+	switch state {
+	case v1alpha1.JobStateDone,
+		v1alpha1.JobStateFailed,
+		v1alpha1.JobStateCancelled,
+		v1alpha1.JobStateDrained,
+		v1alpha1.JobStateUpdated:
+		return true
+	}
+	return false
+}
+
+// CancelRequestedState returns the state that must be requested to stop the
+// supplied job: drained if the job is a streaming job that should be drained,
+// and cancelled otherwise.
+func CancelRequestedState(in v1alpha1.JobParameters, o v1alpha1.JobObservation) string {
+	if o.Type == v1alpha1.JobTypeStreaming && gcp.BoolValue(in.DrainOnDelete) {
+		return v1alpha1.JobStateDrained
+	}
+	return v1alpha1.JobStateCancelled
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dataflow "google.golang.org/api/dataflow/v1b3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	jobName      = "wordcount"
+	templatePath = "gs://bucket/templates/wordcount.json"
+)
+
+func TestGenerateLaunchRequest(t *testing.T) {
+	type args struct {
+		in     v1alpha1.JobParameters
+		update bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want *dataflow.LaunchFlexTemplateRequest
+	}{
+		"Minimal": {
+			args: args{
+				in: v1alpha1.JobParameters{Location: "us-central1", ContainerSpecGCSPath: templatePath},
+			},
+			want: &dataflow.LaunchFlexTemplateRequest{
+				LaunchParameter: &dataflow.LaunchFlexTemplateParameter{
+					JobName:              jobName,
+					ContainerSpecGcsPath: templatePath,
+					Parameters:           map[string]string{},
+				},
+			},
+		},
+		"EnvironmentAndParameters": {
+			args: args{
+				in: v1alpha1.JobParameters{
+					Location:             "us-central1",
+					ContainerSpecGCSPath: templatePath,
+					Parameters: map[string]string{
+						"input":   "gs://bucket/in",
+						"network": "overridden",
+					},
+					Environment: &v1alpha1.FlexTemplateEnvironment{
+						MachineType:         gcp.StringPtr("n1-standard-2"),
+						MaxWorkers:          gcp.Int64Ptr(5),
+						Network:             gcp.StringPtr("default"),
+						Subnetwork:          gcp.StringPtr("regions/us-central1/subnetworks/default"),
+						ServiceAccountEmail: gcp.StringPtr("sa@project.iam.gserviceaccount.com"),
+					},
+				},
+			},
+			want: &dataflow.LaunchFlexTemplateRequest{
+				LaunchParameter: &dataflow.LaunchFlexTemplateParameter{
+					JobName:              jobName,
+					ContainerSpecGcsPath: templatePath,
+					Parameters: map[string]string{
+						"input":             "gs://bucket/in",
+						"network":           "overridden",
+						"workerMachineType": "n1-standard-2",
+						"maxNumWorkers":     "5",
+						"subnetwork":        "regions/us-central1/subnetworks/default",
+						"serviceAccount":    "sa@project.iam.gserviceaccount.com",
+					},
+				},
+			},
+		},
+		"Update": {
+			args: args{
+				in:     v1alpha1.JobParameters{Location: "us-central1", ContainerSpecGCSPath: templatePath},
+				update: true,
+			},
+			want: &dataflow.LaunchFlexTemplateRequest{
+				LaunchParameter: &dataflow.LaunchFlexTemplateParameter{
+					JobName:              jobName,
+					ContainerSpecGcsPath: templatePath,
+					Parameters:           map[string]string{"update": "true"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateLaunchRequest(jobName, tc.args.in, tc.args.update)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateLaunchRequest(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateJobObservation(t *testing.T) {
+	created := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	running := created.Add(time.Minute)
+
+	cases := map[string]struct {
+		in   dataflow.Job
+		want v1alpha1.JobObservation
+	}{
+		"Running": {
+			in: dataflow.Job{
+				Id:               "2020-05-01_05_00_00-1234",
+				Type:             v1alpha1.JobTypeStreaming,
+				CurrentState:     v1alpha1.JobStateRunning,
+				CreateTime:       "2020-05-01T12:00:00Z",
+				CurrentStateTime: "2020-05-01T12:01:00Z",
+			},
+			want: v1alpha1.JobObservation{
+				JobID:            "2020-05-01_05_00_00-1234",
+				Type:             v1alpha1.JobTypeStreaming,
+				State:            v1alpha1.JobStateRunning,
+				CreateTime:       &metav1.Time{Time: created},
+				CurrentStateTime: &metav1.Time{Time: running},
+			},
+		},
+		"NoTimes": {
+			in: dataflow.Job{Id: "2020-05-01_05_00_00-1234", CurrentState: v1alpha1.JobStateQueued},
+			want: v1alpha1.JobObservation{
+				JobID: "2020-05-01_05_00_00-1234",
+				State: v1alpha1.JobStateQueued,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateJobObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateJobObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLaunchedSpecHash(t *testing.T) {
+	base := v1alpha1.JobParameters{
+		Location:             "us-central1",
+		ContainerSpecGCSPath: templatePath,
+		Parameters:           map[string]string{"a": "1", "b": "2"},
+	}
+
+	cases := map[string]struct {
+		in   v1alpha1.JobParameters
+		same bool
+	}{
+		"Identical": {
+			in:   *base.DeepCopy(),
+			same: true,
+		},
+		"IgnoresDrainOnDelete": {
+			in: func() v1alpha1.JobParameters {
+				p := base.DeepCopy()
+				p.DrainOnDelete = gcp.BoolPtr(true)
+				return *p
+			}(),
+			same: true,
+		},
+		"ParameterChanged": {
+			in: func() v1alpha1.JobParameters {
+				p := base.DeepCopy()
+				p.Parameters["b"] = "3"
+				return *p
+			}(),
+			same: false,
+		},
+		"EnvironmentChanged": {
+			in: func() v1alpha1.JobParameters {
+				p := base.DeepCopy()
+				p.Environment = &v1alpha1.FlexTemplateEnvironment{MaxWorkers: gcp.Int64Ptr(3)}
+				return *p
+			}(),
+			same: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LaunchedSpecHash(tc.in) == LaunchedSpecHash(base)
+			if diff := cmp.Diff(tc.same, got); diff != "" {
+				t.Errorf("LaunchedSpecHash(...): -want same, +got same:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCancelRequestedState(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.JobParameters
+		o    v1alpha1.JobObservation
+		want string
+	}{
+		"StreamingDrain": {
+			in:   v1alpha1.JobParameters{DrainOnDelete: gcp.BoolPtr(true)},
+			o:    v1alpha1.JobObservation{Type: v1alpha1.JobTypeStreaming},
+			want: v1alpha1.JobStateDrained,
+		},
+		"StreamingCancel": {
+			o:    v1alpha1.JobObservation{Type: v1alpha1.JobTypeStreaming},
+			want: v1alpha1.JobStateCancelled,
+		},
+		"BatchAlwaysCancelled": {
+			in:   v1alpha1.JobParameters{DrainOnDelete: gcp.BoolPtr(true)},
+			o:    v1alpha1.JobObservation{Type: v1alpha1.JobTypeBatch},
+			want: v1alpha1.JobStateCancelled,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CancelRequestedState(tc.in, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CancelRequestedState(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package denypolicy contains a client for the deny policies of the GCP IAM v2
+// API and utilities to convert between them and DenyPolicy managed resources.
+//
+// The Google API client library used by this provider predates IAM v2, so
+// this package implements the small subset of the v2 REST API that the
+// DenyPolicy controller needs on top of the same authenticated transport.
+package denypolicy
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Client defaults.
+const (
+	DefaultEndpoint    = "https://iam.googleapis.com/"
+	CloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// attachmentPointPrefix prefixes the resource manager resource names deny
+// policies are attached to.
+const attachmentPointPrefix = "cloudresourcemanager.googleapis.com/"
+
+// A Policy is an IAM deny policy.
+type Policy struct {
+	Name        string        `json:"name,omitempty"`
+	UID         string        `json:"uid,omitempty"`
+	DisplayName string        `json:"displayName,omitempty"`
+	Etag        string        `json:"etag,omitempty"`
+	Rules       []*PolicyRule `json:"rules,omitempty"`
+	CreateTime  string        `json:"createTime,omitempty"`
+	UpdateTime  string        `json:"updateTime,omitempty"`
+}
+
+// A PolicyRule is a rule of a Policy.
+type PolicyRule struct {
+	Description string    `json:"description,omitempty"`
+	DenyRule    *DenyRule `json:"denyRule,omitempty"`
+}
+
+// A DenyRule denies principals permissions.
+type DenyRule struct {
+	DeniedPrincipals     []string `json:"deniedPrincipals,omitempty"`
+	ExceptionPrincipals  []string `json:"exceptionPrincipals,omitempty"`
+	DeniedPermissions    []string `json:"deniedPermissions,omitempty"`
+	ExceptionPermissions []string `json:"exceptionPermissions,omitempty"`
+	DenialCondition      *Expr    `json:"denialCondition,omitempty"`
+}
+
+// An Expr is a Common Expression Language expression.
+type Expr struct {
+	Expression  string `json:"expression,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// An Operation is a long running operation started by a mutating call.
+type Operation struct {
+	Name string `json:"name,omitempty"`
+	Done bool   `json:"done,omitempty"`
+}
+
+// AttachmentPoint returns the URL encoded attachment point of deny policies
+// attached to the supplied parent, e.g. projects/example.
+func AttachmentPoint(parent string) string {
+	return url.PathEscape(attachmentPointPrefix + parent)
+}
+
+// PolicyName returns the resource name of the supplied deny policy of the
+// supplied parent.
+func PolicyName(parent, id string) string {
+	return "policies/" + AttachmentPoint(parent) + "/denypolicies/" + id
+}
+
+// A Service calls the deny policy methods of the IAM v2 API.
+type Service struct {
+	json *gcp.JSONClient
+}
+
+// NewService returns a Service configured per the supplied options.
+func NewService(ctx context.Context, opts ...option.ClientOption) (*Service, error) {
+	c, err := gcp.NewJSONClient(ctx, DefaultEndpoint, append([]option.ClientOption{option.WithScopes(CloudPlatformScope)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{json: c}, nil
+}
+
+// GetPolicy returns the named deny policy.
+func (s *Service) GetPolicy(ctx context.Context, name string) (*Policy, error) {
+	p := &Policy{}
+	return p, s.do(ctx, http.MethodGet, name, nil, nil, p)
+}
+
+// CreatePolicy creates the supplied deny policy, attached to the supplied
+// parent.
+func (s *Service) CreatePolicy(ctx context.Context, parent, id string, p *Policy) (*Operation, error) {
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodPost, "policies/"+AttachmentPoint(parent)+"/denypolicies", url.Values{"policyId": {id}}, p, op)
+}
+
+// UpdatePolicy replaces the supplied deny policy. The policy's etag must
+// match that of the current policy.
+func (s *Service) UpdatePolicy(ctx context.Context, p *Policy) (*Operation, error) {
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodPut, p.Name, nil, p, op)
+}
+
+// DeletePolicy deletes the named deny policy.
+func (s *Service) DeletePolicy(ctx context.Context, name string) (*Operation, error) {
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodDelete, name, nil, nil, op)
+}
+
+// do sends a request for the supplied resource path and decodes the response
+// into out.
+func (s *Service) do(ctx context.Context, method, path string, q url.Values, in, out interface{}) error {
+	return s.json.Do(ctx, method, "v2/"+path, q, in, out)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package denypolicy
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GeneratePolicy takes DenyPolicyParameters and returns a Policy.
+func GeneratePolicy(in v1alpha1.DenyPolicyParameters) *Policy {
+	p := &Policy{DisplayName: gcp.StringValue(in.DisplayName)}
+	for _, r := range in.Rules {
+		pr := &PolicyRule{
+			Description: gcp.StringValue(r.Description),
+			DenyRule: &DenyRule{
+				DeniedPrincipals:     r.DeniedPrincipals,
+				ExceptionPrincipals:  r.ExceptionPrincipals,
+				DeniedPermissions:    r.DeniedPermissions,
+				ExceptionPermissions: r.ExceptionPermissions,
+			},
+		}
+		if c := r.DenialCondition; c != nil {
+			pr.DenyRule.DenialCondition = &Expr{
+				Expression:  c.Expression,
+				Title:       gcp.StringValue(c.Title),
+				Description: gcp.StringValue(c.Description),
+			}
+		}
+		p.Rules = append(p.Rules, pr)
+	}
+	return p
+}
+
+// GenerateDenyPolicyObservation takes a Policy and returns a
+// DenyPolicyObservation.
+func GenerateDenyPolicyObservation(in Policy) v1alpha1.DenyPolicyObservation {
+	return v1alpha1.DenyPolicyObservation{
+		Name:       in.Name,
+		UID:        in.UID,
+		Etag:       in.Etag,
+		CreateTime: gcp.TimeFromRFC3339(in.CreateTime),
+		UpdateTime: gcp.TimeFromRFC3339(in.UpdateTime),
+	}
+}
+
+// LateInitializeDenyPolicy fills the empty fields of the supplied
+// DenyPolicyParameters with those of the supplied Policy.
+func LateInitializeDenyPolicy(spec *v1alpha1.DenyPolicyParameters, in Policy) {
+	spec.DisplayName = gcp.LateInitializeString(spec.DisplayName, in.DisplayName)
+}
+
+// DiffRules returns the number of the supplied desired rules that the
+// observed rules lack, and the number of observed rules that are not
+// desired. Rules are compared as a set; neither the order of the rules nor
+// the order of the principals and permissions within them is significant.
+func DiffRules(desired []v1alpha1.DenyRule, observed []*PolicyRule) (added, removed int) {
+	counts := map[string]int{}
+	for _, r := range GeneratePolicy(v1alpha1.DenyPolicyParameters{Rules: desired}).Rules {
+		counts[ruleKey(r)]++
+	}
+	for _, r := range observed {
+		counts[ruleKey(r)]--
+	}
+	for _, c := range counts {
+		if c > 0 {
+			added += c
+		}
+		if c < 0 {
+			removed -= c
+		}
+	}
+	return added, removed
+}
+
+// IsDenyPolicyUpToDate returns true if the supplied Policy matches the
+// supplied DenyPolicyParameters.
+func IsDenyPolicyUpToDate(in v1alpha1.DenyPolicyParameters, observed Policy) bool {
+	added, removed := DiffRules(in.Rules, observed.Rules)
+	return gcp.StringValue(in.DisplayName) == observed.DisplayName && added == 0 && removed == 0
+}
+
+// GeneratePreview returns a preview of the change that would be made to the
+// supplied Policy, which is nil if the policy does not exist, to make it
+// match the supplied DenyPolicyParameters.
+func GeneratePreview(in v1alpha1.DenyPolicyParameters, observed *Policy) *v1alpha1.DenyPolicyPreview {
+	if observed == nil {
+		return &v1alpha1.DenyPolicyPreview{Action: v1alpha1.DenyPolicyPreviewCreate, RulesAdded: len(in.Rules)}
+	}
+	added, removed := DiffRules(in.Rules, observed.Rules)
+	p := &v1alpha1.DenyPolicyPreview{Action: v1alpha1.DenyPolicyPreviewNone, RulesAdded: added, RulesRemoved: removed}
+	if !IsDenyPolicyUpToDate(in, *observed) {
+		p.Action = v1alpha1.DenyPolicyPreviewUpdate
+	}
+	return p
+}
+
+// ruleKey returns a key that is equal for equivalent rules.
+func ruleKey(r *PolicyRule) string {
+	n := PolicyRule{Description: r.Description}
+	if dr := r.DenyRule; dr != nil {
+		n.DenyRule = &DenyRule{
+			DeniedPrincipals:     sorted(dr.DeniedPrincipals),
+			ExceptionPrincipals:  sorted(dr.ExceptionPrincipals),
+			DeniedPermissions:    sorted(dr.DeniedPermissions),
+			ExceptionPermissions: sorted(dr.ExceptionPermissions),
+			DenialCondition:      dr.DenialCondition,
+		}
+	}
+	// Marshalling a PolicyRule cannot fail.
+	b, _ := json.Marshal(n)
+	return string(b)
+}
+
+func sorted(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	c := make([]string, len(s))
+	copy(c, s)
+	sort.Strings(c)
+	return c
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package denypolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+var (
+	denyAll = v1alpha1.DenyRule{
+		DeniedPrincipals:  []string{"principalSet://goog/public:all"},
+		DeniedPermissions: []string{"iam.googleapis.com/roles.create", "iam.googleapis.com/roles.delete"},
+	}
+	denyAllReordered = &PolicyRule{DenyRule: &DenyRule{
+		DeniedPrincipals:  []string{"principalSet://goog/public:all"},
+		DeniedPermissions: []string{"iam.googleapis.com/roles.delete", "iam.googleapis.com/roles.create"},
+	}}
+	denyKeys = &PolicyRule{Description: "keys", DenyRule: &DenyRule{
+		DeniedPrincipals:  []string{"principalSet://goog/public:all"},
+		DeniedPermissions: []string{"iam.googleapis.com/serviceAccountKeys.create"},
+	}}
+)
+
+func TestPolicyName(t *testing.T) {
+	want := "policies/cloudresourcemanager.googleapis.com%2Fprojects%2Fmy-project/denypolicies/my-policy"
+	if diff := cmp.Diff(want, PolicyName("projects/my-project", "my-policy")); diff != "" {
+		t.Errorf("PolicyName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsDenyPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.DenyPolicyParameters
+		observed Policy
+		want     bool
+	}{
+		"UpToDate": {
+			in:       v1alpha1.DenyPolicyParameters{Rules: []v1alpha1.DenyRule{denyAll}},
+			observed: Policy{Rules: []*PolicyRule{denyAllReordered}},
+			want:     true,
+		},
+		"DisplayNameChanged": {
+			in:       v1alpha1.DenyPolicyParameters{DisplayName: gcp.StringPtr("new"), Rules: []v1alpha1.DenyRule{denyAll}},
+			observed: Policy{DisplayName: "old", Rules: []*PolicyRule{denyAllReordered}},
+			want:     false,
+		},
+		"RuleRemoved": {
+			in:       v1alpha1.DenyPolicyParameters{Rules: []v1alpha1.DenyRule{denyAll}},
+			observed: Policy{Rules: []*PolicyRule{denyKeys, denyAllReordered}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDenyPolicyUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDenyPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePreview(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.DenyPolicyParameters
+		observed *Policy
+		want     *v1alpha1.DenyPolicyPreview
+	}{
+		"Create": {
+			in:   v1alpha1.DenyPolicyParameters{Rules: []v1alpha1.DenyRule{denyAll, denyAll}},
+			want: &v1alpha1.DenyPolicyPreview{Action: v1alpha1.DenyPolicyPreviewCreate, RulesAdded: 2},
+		},
+		"Update": {
+			in:       v1alpha1.DenyPolicyParameters{Rules: []v1alpha1.DenyRule{denyAll, denyAll}},
+			observed: &Policy{Rules: []*PolicyRule{denyKeys, denyAllReordered}},
+			want:     &v1alpha1.DenyPolicyPreview{Action: v1alpha1.DenyPolicyPreviewUpdate, RulesAdded: 1, RulesRemoved: 1},
+		},
+		"DisplayNameOnly": {
+			in:       v1alpha1.DenyPolicyParameters{DisplayName: gcp.StringPtr("new"), Rules: []v1alpha1.DenyRule{denyAll}},
+			observed: &Policy{Rules: []*PolicyRule{denyAllReordered}},
+			want:     &v1alpha1.DenyPolicyPreview{Action: v1alpha1.DenyPolicyPreviewUpdate},
+		},
+		"None": {
+			in:       v1alpha1.DenyPolicyParameters{Rules: []v1alpha1.DenyRule{denyAll}},
+			observed: &Policy{Rules: []*PolicyRule{denyAllReordered}},
+			want:     &v1alpha1.DenyPolicyPreview{Action: v1alpha1.DenyPolicyPreviewNone},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePreview(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GeneratePreview(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
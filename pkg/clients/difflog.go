@@ -0,0 +1,32 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// LogFieldDiff logs at debug level that the supplied field of an external
+// resource differs from its desired value, along with both values, so that
+// it can be told why a resource is updated every reconcile. The values of
+// fields whose names suggest that they hold credentials are never logged.
+func LogFieldDiff(log logging.Logger, field string, observed, desired interface{}) {
+	if isSensitiveParam(field) {
+		observed, desired = redacted, redacted
+	}
+	log.Debug("External resource field differs from desired value", "field", field, "observed", observed, "desired", desired)
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLogFieldDiff(t *testing.T) {
+	cases := map[string]struct {
+		field    string
+		observed interface{}
+		desired  interface{}
+		want     map[string]interface{}
+	}{
+		"Logged": {
+			field:    "displayName",
+			observed: "old",
+			desired:  "new",
+			want:     map[string]interface{}{"field": "displayName", "observed": "old", "desired": "new"},
+		},
+		"Redacted": {
+			field:    "privateKeyData",
+			observed: "old",
+			desired:  "new",
+			want:     map[string]interface{}{"field": "privateKeyData", "observed": redacted, "desired": redacted},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &recordingLogger{}
+			LogFieldDiff(l, tc.field, tc.observed, tc.desired)
+			if len(l.entries) != 1 {
+				t.Fatalf("LogFieldDiff(...): want 1 entry, got %d", len(l.entries))
+			}
+			if diff := cmp.Diff(tc.want, l.entries[0].kv); diff != "" {
+				t.Errorf("LogFieldDiff(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dns contains utilities to convert between Cloud DNS resources and
+// managed resources.
+package dns
+
+import (
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dnsv1 "google.golang.org/api/dns/v1"
+
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// FQDN returns the supplied DNS name with a trailing dot, as Cloud DNS
+// expects and reports DNS names.
+func FQDN(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// networkURL returns the fully qualified URL of the supplied network, which
+// may be a partially qualified URL as resolved from a Network reference.
+func networkURL(u string) string {
+	if strings.HasPrefix(u, "https://") {
+		return u
+	}
+	return computev1beta1.ComputeURIPrefix + u
+}
+
+// GenerateManagedZone returns a managed zone with the supplied name that is
+// configured per the supplied parameters.
+func GenerateManagedZone(name string, in v1alpha1.ManagedZoneParameters) *dnsv1.ManagedZone {
+	mz := &dnsv1.ManagedZone{
+		Name:        name,
+		DnsName:     FQDN(in.DNSName),
+		Description: gcp.StringValue(in.Description),
+		Visibility:  gcp.StringValue(in.Visibility),
+		Labels:      in.Labels,
+	}
+	if in.PrivateVisibilityConfig != nil {
+		mz.PrivateVisibilityConfig = &dnsv1.ManagedZonePrivateVisibilityConfig{}
+		for _, n := range in.PrivateVisibilityConfig.Networks {
+			mz.PrivateVisibilityConfig.Networks = append(mz.PrivateVisibilityConfig.Networks,
+				&dnsv1.ManagedZonePrivateVisibilityConfigNetwork{NetworkUrl: networkURL(gcp.StringValue(n.NetworkURL))})
+		}
+	}
+	return mz
+}
+
+// GenerateManagedZonePatch returns a managed zone that patches the mutable
+// fields of a managed zone per the supplied parameters. The description is
+// always sent, so that it can be cleared.
+func GenerateManagedZonePatch(in v1alpha1.ManagedZoneParameters) *dnsv1.ManagedZone {
+	g := GenerateManagedZone("", in)
+	return &dnsv1.ManagedZone{
+		Description:             g.Description,
+		Labels:                  g.Labels,
+		PrivateVisibilityConfig: g.PrivateVisibilityConfig,
+		ForceSendFields:         []string{"Description"},
+	}
+}
+
+// GenerateManagedZoneObservation takes a ManagedZone and returns a
+// ManagedZoneObservation.
+func GenerateManagedZoneObservation(in dnsv1.ManagedZone) v1alpha1.ManagedZoneObservation {
+	return v1alpha1.ManagedZoneObservation{
+		ID:           in.Id,
+		CreationTime: in.CreationTime,
+		NameServers:  in.NameServers,
+	}
+}
+
+// LateInitializeManagedZone fills the empty fields of the supplied parameters
+// with the values of the observed managed zone.
+func LateInitializeManagedZone(in *v1alpha1.ManagedZoneParameters, observed dnsv1.ManagedZone) {
+	in.Description = gcp.LateInitializeString(in.Description, observed.Description)
+	in.Visibility = gcp.LateInitializeString(in.Visibility, observed.Visibility)
+	if in.Labels == nil && len(observed.Labels) != 0 {
+		in.Labels = observed.Labels
+	}
+}
+
+// IsManagedZoneUpToDate returns true if the mutable fields of the observed
+// managed zone are as the supplied parameters declare. The networks of a
+// private zone are compared regardless of order.
+func IsManagedZoneUpToDate(in v1alpha1.ManagedZoneParameters, observed dnsv1.ManagedZone) bool {
+	desired := GenerateManagedZone("", in)
+	if desired.Description != observed.Description {
+		return false
+	}
+	if !cmp.Equal(desired.Labels, observed.Labels, cmpopts.EquateEmpty()) {
+		return false
+	}
+	return cmp.Equal(networkURLs(desired.PrivateVisibilityConfig), networkURLs(observed.PrivateVisibilityConfig),
+		cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+func networkURLs(c *dnsv1.ManagedZonePrivateVisibilityConfig) []string {
+	if c == nil {
+		return nil
+	}
+	urls := make([]string, 0, len(c.Networks))
+	for _, n := range c.Networks {
+		if n != nil {
+			urls = append(urls, networkURL(n.NetworkUrl))
+		}
+	}
+	return urls
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dnsv1 "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testZone       = "example-zone"
+	testDNSName    = "example.com"
+	testNetwork    = "projects/my-project/global/networks/default"
+	testNetworkURL = "https://www.googleapis.com/compute/v1/" + testNetwork
+)
+
+func zoneParams(m ...func(*v1alpha1.ManagedZoneParameters)) v1alpha1.ManagedZoneParameters {
+	p := v1alpha1.ManagedZoneParameters{
+		DNSName:     testDNSName,
+		Description: gcp.StringPtr("an example zone"),
+		Visibility:  gcp.StringPtr(v1alpha1.VisibilityPrivate),
+		PrivateVisibilityConfig: &v1alpha1.ManagedZonePrivateVisibilityConfig{
+			Networks: []v1alpha1.ManagedZoneNetwork{{NetworkURL: gcp.StringPtr(testNetwork)}},
+		},
+		Labels: map[string]string{"team": "dns"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func zone(m ...func(*dnsv1.ManagedZone)) *dnsv1.ManagedZone {
+	z := &dnsv1.ManagedZone{
+		Name:        testZone,
+		DnsName:     testDNSName + ".",
+		Description: "an example zone",
+		Visibility:  v1alpha1.VisibilityPrivate,
+		PrivateVisibilityConfig: &dnsv1.ManagedZonePrivateVisibilityConfig{
+			Networks: []*dnsv1.ManagedZonePrivateVisibilityConfigNetwork{{NetworkUrl: testNetworkURL}},
+		},
+		Labels: map[string]string{"team": "dns"},
+	}
+	for _, f := range m {
+		f(z)
+	}
+	return z
+}
+
+func TestFQDN(t *testing.T) {
+	cases := map[string]string{
+		"example.com":  "example.com.",
+		"example.com.": "example.com.",
+	}
+	for in, want := range cases {
+		t.Run(in, func(t *testing.T) {
+			if diff := cmp.Diff(want, FQDN(in)); diff != "" {
+				t.Errorf("FQDN(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateManagedZone(t *testing.T) {
+	if diff := cmp.Diff(zone(), GenerateManagedZone(testZone, zoneParams())); diff != "" {
+		t.Errorf("GenerateManagedZone(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateManagedZonePatch(t *testing.T) {
+	want := &dnsv1.ManagedZone{
+		Labels: map[string]string{"team": "dns"},
+		PrivateVisibilityConfig: &dnsv1.ManagedZonePrivateVisibilityConfig{
+			Networks: []*dnsv1.ManagedZonePrivateVisibilityConfigNetwork{{NetworkUrl: testNetworkURL}},
+		},
+		ForceSendFields: []string{"Description"},
+	}
+	in := zoneParams(func(p *v1alpha1.ManagedZoneParameters) { p.Description = nil })
+	if diff := cmp.Diff(want, GenerateManagedZonePatch(in)); diff != "" {
+		t.Errorf("GenerateManagedZonePatch(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeManagedZone(t *testing.T) {
+	in := v1alpha1.ManagedZoneParameters{DNSName: testDNSName}
+	LateInitializeManagedZone(&in, *zone(func(z *dnsv1.ManagedZone) { z.Visibility = v1alpha1.VisibilityPublic }))
+	want := v1alpha1.ManagedZoneParameters{
+		DNSName:     testDNSName,
+		Description: gcp.StringPtr("an example zone"),
+		Visibility:  gcp.StringPtr(v1alpha1.VisibilityPublic),
+		Labels:      map[string]string{"team": "dns"},
+	}
+	if diff := cmp.Diff(want, in); diff != "" {
+		t.Errorf("LateInitializeManagedZone(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsManagedZoneUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.ManagedZoneParameters
+		observed *dnsv1.ManagedZone
+		want     bool
+	}{
+		"UpToDate": {
+			in:       zoneParams(),
+			observed: zone(func(z *dnsv1.ManagedZone) { z.NameServers = []string{"ns-cloud-a1.googledomains.com."} }),
+			want:     true,
+		},
+		"DescriptionDiffers": {
+			in:       zoneParams(),
+			observed: zone(func(z *dnsv1.ManagedZone) { z.Description = "other" }),
+			want:     false,
+		},
+		"LabelsDiffer": {
+			in:       zoneParams(),
+			observed: zone(func(z *dnsv1.ManagedZone) { z.Labels = nil }),
+			want:     false,
+		},
+		"NetworksInOtherOrder": {
+			in: zoneParams(func(p *v1alpha1.ManagedZoneParameters) {
+				p.PrivateVisibilityConfig.Networks = append(p.PrivateVisibilityConfig.Networks,
+					v1alpha1.ManagedZoneNetwork{NetworkURL: gcp.StringPtr("projects/my-project/global/networks/other")})
+			}),
+			observed: zone(func(z *dnsv1.ManagedZone) {
+				z.PrivateVisibilityConfig.Networks = []*dnsv1.ManagedZonePrivateVisibilityConfigNetwork{
+					{NetworkUrl: "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/other"},
+					{NetworkUrl: testNetworkURL},
+				}
+			}),
+			want: true,
+		},
+		"NetworkRemoved": {
+			in: zoneParams(func(p *v1alpha1.ManagedZoneParameters) {
+				p.PrivateVisibilityConfig.Networks = nil
+			}),
+			observed: zone(),
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsManagedZoneUpToDate(tc.in, *tc.observed)); diff != "" {
+				t.Errorf("IsManagedZoneUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
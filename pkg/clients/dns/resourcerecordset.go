@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dnsv1 "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+)
+
+// ChangeStatusDone is the status of a change once it has been applied.
+const ChangeStatusDone = "done"
+
+// GenerateResourceRecordSet returns the resource record set declared by the
+// supplied parameters.
+func GenerateResourceRecordSet(in v1alpha1.ResourceRecordSetParameters) *dnsv1.ResourceRecordSet {
+	rrs := &dnsv1.ResourceRecordSet{
+		Name:    FQDN(in.Name),
+		Type:    in.Type,
+		Rrdatas: in.RRDatas,
+	}
+	if in.TTL != nil {
+		rrs.Ttl = *in.TTL
+	}
+	return rrs
+}
+
+// LateInitializeResourceRecordSet fills the empty fields of the supplied
+// parameters with the values of the observed resource record set.
+func LateInitializeResourceRecordSet(in *v1alpha1.ResourceRecordSetParameters, observed dnsv1.ResourceRecordSet) {
+	if in.TTL == nil {
+		ttl := observed.Ttl
+		in.TTL = &ttl
+	}
+}
+
+// IsResourceRecordSetUpToDate returns true if the observed resource record
+// set has the TTL and data the supplied parameters declare. Data are compared
+// regardless of order.
+func IsResourceRecordSetUpToDate(in v1alpha1.ResourceRecordSetParameters, observed dnsv1.ResourceRecordSet) bool {
+	if in.TTL != nil && *in.TTL != observed.Ttl {
+		return false
+	}
+	return cmp.Equal(in.RRDatas, observed.Rrdatas, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// GenerateReplaceChange returns a change that atomically replaces the
+// observed resource record set with the one declared by the supplied
+// parameters. Cloud DNS cannot update a record set in place.
+func GenerateReplaceChange(in v1alpha1.ResourceRecordSetParameters, observed *dnsv1.ResourceRecordSet) *dnsv1.Change {
+	return &dnsv1.Change{
+		Deletions: []*dnsv1.ResourceRecordSet{observed},
+		Additions: []*dnsv1.ResourceRecordSet{GenerateResourceRecordSet(in)},
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dnsv1 "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+)
+
+func recordSetParams(m ...func(*v1alpha1.ResourceRecordSetParameters)) v1alpha1.ResourceRecordSetParameters {
+	ttl := int64(300)
+	p := v1alpha1.ResourceRecordSetParameters{
+		Name:    "www." + testDNSName,
+		Type:    "A",
+		TTL:     &ttl,
+		RRDatas: []string{"10.0.0.1", "10.0.0.2"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func recordSet(m ...func(*dnsv1.ResourceRecordSet)) *dnsv1.ResourceRecordSet {
+	rrs := &dnsv1.ResourceRecordSet{
+		Name:    "www." + testDNSName + ".",
+		Type:    "A",
+		Ttl:     300,
+		Rrdatas: []string{"10.0.0.1", "10.0.0.2"},
+	}
+	for _, f := range m {
+		f(rrs)
+	}
+	return rrs
+}
+
+func TestGenerateResourceRecordSet(t *testing.T) {
+	if diff := cmp.Diff(recordSet(), GenerateResourceRecordSet(recordSetParams())); diff != "" {
+		t.Errorf("GenerateResourceRecordSet(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeResourceRecordSet(t *testing.T) {
+	in := recordSetParams(func(p *v1alpha1.ResourceRecordSetParameters) { p.TTL = nil })
+	LateInitializeResourceRecordSet(&in, *recordSet(func(r *dnsv1.ResourceRecordSet) { r.Ttl = 60 }))
+	ttl := int64(60)
+	if diff := cmp.Diff(&ttl, in.TTL); diff != "" {
+		t.Errorf("LateInitializeResourceRecordSet(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsResourceRecordSetUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.ResourceRecordSetParameters
+		observed *dnsv1.ResourceRecordSet
+		want     bool
+	}{
+		"UpToDate": {
+			in:       recordSetParams(),
+			observed: recordSet(),
+			want:     true,
+		},
+		"DataInOtherOrder": {
+			in:       recordSetParams(),
+			observed: recordSet(func(r *dnsv1.ResourceRecordSet) { r.Rrdatas = []string{"10.0.0.2", "10.0.0.1"} }),
+			want:     true,
+		},
+		"DataDiffers": {
+			in:       recordSetParams(),
+			observed: recordSet(func(r *dnsv1.ResourceRecordSet) { r.Rrdatas = []string{"10.0.0.1"} }),
+			want:     false,
+		},
+		"TTLDiffers": {
+			in:       recordSetParams(),
+			observed: recordSet(func(r *dnsv1.ResourceRecordSet) { r.Ttl = 60 }),
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsResourceRecordSetUpToDate(tc.in, *tc.observed)); diff != "" {
+				t.Errorf("IsResourceRecordSetUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateReplaceChange(t *testing.T) {
+	observed := recordSet(func(r *dnsv1.ResourceRecordSet) { r.Rrdatas = []string{"10.0.0.1"} })
+	want := &dnsv1.Change{
+		Deletions: []*dnsv1.ResourceRecordSet{observed},
+		Additions: []*dnsv1.ResourceRecordSet{recordSet()},
+	}
+	if diff := cmp.Diff(want, GenerateReplaceChange(recordSetParams(), observed)); diff != "" {
+		t.Errorf("GenerateReplaceChange(...): -want, +got:\n%s", diff)
+	}
+}
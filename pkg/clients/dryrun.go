@@ -0,0 +1,174 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errPlanUpdate = "cannot plan update of external resource"
+
+// AnnotationKeyDryRun is the key of an annotation that puts a managed
+// resource in dry run mode when its value is "true". Its external resource is
+// still observed, but controllers that honour the annotation only record the
+// changes they would make to it, as an event and as the DryRun condition,
+// rather than making them. A managed resource that is deleted in dry run mode
+// is not finalized until the annotation is removed, because its external
+// resource is never deleted.
+const AnnotationKeyDryRun = "gcp.crossplane.io/dry-run"
+
+// TypeDryRun indicates whether a change to the external resource was planned
+// but not made, because the managed resource is in dry run mode.
+const TypeDryRun runtimev1alpha1.ConditionType = "DryRun"
+
+// Reasons a change to an external resource is or is not planned.
+const (
+	ReasonWouldCreate      runtimev1alpha1.ConditionReason = "WouldCreate"
+	ReasonWouldUpdate      runtimev1alpha1.ConditionReason = "WouldUpdate"
+	ReasonWouldDelete      runtimev1alpha1.ConditionReason = "WouldDelete"
+	ReasonNoChangesPlanned runtimev1alpha1.ConditionReason = "NoChangesPlanned"
+)
+
+// IsDryRun returns true if the supplied object is in dry run mode.
+func IsDryRun(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyDryRun] == "true"
+}
+
+// PlannedChange returns a condition that indicates the supplied change to the
+// external resource was planned but not made.
+func PlannedChange(r runtimev1alpha1.ConditionReason, message string) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            message,
+	}
+}
+
+// NoPlannedChanges returns a condition that indicates no change to the
+// external resource is planned, either because it is up to date or because
+// the managed resource is no longer in dry run mode.
+func NoPlannedChanges() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoChangesPlanned,
+	}
+}
+
+// An UpdatePlanner describes the changes an ExternalClient would make to an
+// external resource to update it, for example the fields it would patch.
+type UpdatePlanner interface {
+	PlanUpdate(ctx context.Context, mg resource.Managed) (string, error)
+}
+
+// An UpdatePlannerFn is a function that satisfies the UpdatePlanner
+// interface.
+type UpdatePlannerFn func(ctx context.Context, mg resource.Managed) (string, error)
+
+// PlanUpdate calls the UpdatePlannerFn.
+func (fn UpdatePlannerFn) PlanUpdate(ctx context.Context, mg resource.Managed) (string, error) {
+	return fn(ctx, mg)
+}
+
+// WithDryRun returns an ExternalClient that honours the dry run annotation of
+// the managed resources it manages using the supplied ExternalClient. Changes
+// are recorded using the supplied Recorder. Updates are described by the
+// supplied UpdatePlanner, if any.
+func WithDryRun(c managed.ExternalClient, r event.Recorder, p UpdatePlanner) managed.ExternalClient {
+	if r == nil {
+		r = event.NewNopRecorder()
+	}
+	return &dryRunExternal{ExternalClient: c, record: r, planner: p}
+}
+
+type dryRunExternal struct {
+	managed.ExternalClient
+	record  event.Recorder
+	planner UpdatePlanner
+}
+
+// Observe observes the external resource whether or not the managed resource
+// is in dry run mode. A planned change is cleared once it is no longer
+// needed.
+func (e *dryRunExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if mg.GetCondition(TypeDryRun).Status != corev1.ConditionTrue {
+		return o, err
+	}
+	if !IsDryRun(mg) || (err == nil && o.ResourceExists && o.ResourceUpToDate && !meta.WasDeleted(mg)) {
+		mg.SetConditions(NoPlannedChanges())
+	}
+	return o, err
+}
+
+func (e *dryRunExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if !IsDryRun(mg) {
+		return e.ExternalClient.Create(ctx, mg)
+	}
+	e.plan(mg, ReasonWouldCreate, fmt.Sprintf("would create external resource %s", externalNameOrUnnamed(mg)))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *dryRunExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if !IsDryRun(mg) {
+		return e.ExternalClient.Update(ctx, mg)
+	}
+	msg := fmt.Sprintf("would update external resource %s", externalNameOrUnnamed(mg))
+	if e.planner != nil {
+		p, err := e.planner.PlanUpdate(ctx, mg)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPlanUpdate)
+		}
+		msg = p
+	}
+	e.plan(mg, ReasonWouldUpdate, msg)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *dryRunExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	if !IsDryRun(mg) {
+		return e.ExternalClient.Delete(ctx, mg)
+	}
+	e.plan(mg, ReasonWouldDelete, fmt.Sprintf("would delete external resource %s", externalNameOrUnnamed(mg)))
+	return nil
+}
+
+func (e *dryRunExternal) plan(mg resource.Managed, r runtimev1alpha1.ConditionReason, message string) {
+	mg.SetConditions(PlannedChange(r, message))
+	e.record.Event(mg, event.Normal(event.Reason(r), message))
+}
+
+func externalNameOrUnnamed(mg resource.Managed) string {
+	if n := meta.GetExternalName(mg); n != "" {
+		return fmt.Sprintf("%q", n)
+	}
+	return "(unnamed)"
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestDryRunExternal(t *testing.T) {
+	errBoom := errors.New("boom")
+	dryRun := func() *fake.Managed {
+		mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyDryRun: "true"}}}
+		meta.SetExternalName(mg, "cool")
+		return mg
+	}
+	none := runtimev1alpha1.Condition{Type: TypeDryRun, Status: corev1.ConditionUnknown}
+	planned := func() *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetConditions(PlannedChange(ReasonWouldUpdate, "would update"))
+		return mg
+	}
+
+	cases := map[string]struct {
+		reason  string
+		planner UpdatePlanner
+		mg      *fake.Managed
+		call    func(e managed.ExternalClient, mg resource.Managed) error
+		want    runtimev1alpha1.Condition
+		wantErr error
+		calls   int
+	}{
+		"CreateDryRun": {
+			reason: "Creating a resource in dry run mode should only record the planned creation",
+			mg:     dryRun(),
+			call: func(e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: PlannedChange(ReasonWouldCreate, `would create external resource "cool"`),
+		},
+		"UpdateDryRunPlanned": {
+			reason:  "Updating a resource in dry run mode should record the update its UpdatePlanner describes",
+			planner: UpdatePlannerFn(func(_ context.Context, _ resource.Managed) (string, error) { return "would patch", nil }),
+			mg:      dryRun(),
+			call: func(e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: PlannedChange(ReasonWouldUpdate, "would patch"),
+		},
+		"UpdateDryRunPlanFailed": {
+			reason:  "Errors planning an update should be returned",
+			planner: UpdatePlannerFn(func(_ context.Context, _ resource.Managed) (string, error) { return "", errBoom }),
+			mg:      dryRun(),
+			call: func(e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want:    none,
+			wantErr: errors.Wrap(errBoom, errPlanUpdate),
+		},
+		"DeleteDryRun": {
+			reason: "Deleting a resource in dry run mode should only record the planned deletion",
+			mg:     dryRun(),
+			call: func(e managed.ExternalClient, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: PlannedChange(ReasonWouldDelete, `would delete external resource "cool"`),
+		},
+		"UpdateNotDryRun": {
+			reason: "Updating a resource that is not in dry run mode should update it",
+			mg:     &fake.Managed{},
+			call: func(e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want:  none,
+			calls: 1,
+		},
+		"ObserveNotDryRunClears": {
+			reason: "A planned change should be cleared once the resource is no longer in dry run mode",
+			mg:     planned(),
+			call: func(e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Observe(context.Background(), mg)
+				return err
+			},
+			want:  NoPlannedChanges(),
+			calls: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			c := &managed.ExternalClientFns{
+				ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+					calls++
+					return managed.ExternalObservation{ResourceExists: true}, nil
+				},
+				CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+					calls++
+					return managed.ExternalCreation{}, nil
+				},
+				UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+					calls++
+					return managed.ExternalUpdate{}, nil
+				},
+				DeleteFn: func(_ context.Context, _ resource.Managed) error {
+					calls++
+					return nil
+				},
+			}
+			err := tc.call(WithDryRun(c, nil, tc.planner), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.mg.GetCondition(TypeDryRun), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\n-want condition, +got condition:\n%s", tc.reason, diff)
+			}
+			if calls != tc.calls {
+				t.Errorf("\n%s\nwant %d calls to the wrapped ExternalClient, got %d", tc.reason, tc.calls, calls)
+			}
+		})
+	}
+}
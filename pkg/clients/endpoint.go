@@ -0,0 +1,38 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import "google.golang.org/api/option"
+
+// Names of the GCP APIs whose endpoints a Provider may override.
+const (
+	APIIAM             = "iam"
+	APIResourceManager = "cloudresourcemanager"
+	APIStorage         = "storage"
+)
+
+// WithEndpoint returns the supplied client options, plus an option that
+// overrides the endpoint of the supplied API if the supplied endpoints, keyed
+// by API name, have one for it. The supplied options are not modified, so
+// they may be shared by clients of several APIs.
+func WithEndpoint(opts []option.ClientOption, endpoints map[string]string, api string) []option.ClientOption {
+	e, ok := endpoints[api]
+	if !ok || e == "" {
+		return opts
+	}
+	return append(append(make([]option.ClientOption, 0, len(opts)+1), opts...), option.WithEndpoint(e))
+}
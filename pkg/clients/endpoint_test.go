@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"google.golang.org/api/option"
+)
+
+func TestWithEndpoint(t *testing.T) {
+	opts := make([]option.ClientOption, 1, 2)
+	opts[0] = option.WithTelemetryDisabled()
+
+	cases := map[string]struct {
+		endpoints map[string]string
+		want      int
+	}{
+		"NoEndpoints": {
+			want: 1,
+		},
+		"OtherAPI": {
+			endpoints: map[string]string{APIStorage: "http://localhost:9023/"},
+			want:      1,
+		},
+		"EmptyEndpoint": {
+			endpoints: map[string]string{APIIAM: ""},
+			want:      1,
+		},
+		"Override": {
+			endpoints: map[string]string{APIIAM: "http://localhost:9023/"},
+			want:      2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := WithEndpoint(opts, tc.endpoints, APIIAM)
+			if len(got) != tc.want {
+				t.Errorf("WithEndpoint(...): want %d options, got %d", tc.want, len(got))
+			}
+			if opts[:cap(opts)][1] != nil {
+				t.Errorf("WithEndpoint(...): modified the supplied options")
+			}
+		})
+	}
+}
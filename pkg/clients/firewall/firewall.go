@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateFirewall creates a *compute.Firewall from the supplied
+// FirewallParameters.
+func GenerateFirewall(name string, in v1alpha1.FirewallParameters) *compute.Firewall {
+	f := &compute.Firewall{
+		Name:              name,
+		Description:       gcp.StringValue(in.Description),
+		Network:           gcp.StringValue(in.Network),
+		Priority:          gcp.Int64Value(in.Priority),
+		Direction:         gcp.StringValue(in.Direction),
+		SourceRanges:      in.SourceRanges,
+		DestinationRanges: in.DestinationRanges,
+		SourceTags:        in.SourceTags,
+		TargetTags:        in.TargetTags,
+		Disabled:          gcp.BoolValue(in.Disabled),
+	}
+	for _, r := range in.Allowed {
+		f.Allowed = append(f.Allowed, &compute.FirewallAllowed{IPProtocol: r.IPProtocol, Ports: r.Ports})
+	}
+	for _, r := range in.Denied {
+		f.Denied = append(f.Denied, &compute.FirewallDenied{IPProtocol: r.IPProtocol, Ports: r.Ports})
+	}
+	return f
+}
+
+// GenerateFirewallPatch creates the *compute.Firewall that updates the
+// mutable fields of a firewall rule to those of the supplied
+// FirewallParameters. The network and direction cannot be changed, so they
+// are not included. Empty rules, ranges and tags and zero values are always
+// sent, so that they are cleared rather than left unchanged.
+func GenerateFirewallPatch(in v1alpha1.FirewallParameters) *compute.Firewall {
+	f := GenerateFirewall("", in)
+	f.Network = ""
+	f.Direction = ""
+	f.ForceSendFields = []string{"Allowed", "Denied", "Description", "DestinationRanges", "Disabled", "Priority", "SourceRanges", "SourceTags", "TargetTags"}
+	return f
+}
+
+// GenerateFirewallObservation creates a FirewallObservation from the
+// supplied compute.Firewall.
+func GenerateFirewallObservation(in compute.Firewall) v1alpha1.FirewallObservation {
+	return v1alpha1.FirewallObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// compute.Firewall. Compute Engine defaults the ranges of a rule that has
+// none, so they are late initialized too.
+func LateInitializeSpec(spec *v1alpha1.FirewallParameters, in compute.Firewall) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Network = gcp.LateInitializeString(spec.Network, in.Network)
+	spec.Priority = gcp.LateInitializeInt64(spec.Priority, in.Priority)
+	spec.Direction = gcp.LateInitializeString(spec.Direction, in.Direction)
+	spec.Disabled = gcp.LateInitializeBool(spec.Disabled, in.Disabled)
+	spec.SourceRanges = gcp.LateInitializeStringSlice(spec.SourceRanges, in.SourceRanges)
+	spec.DestinationRanges = gcp.LateInitializeStringSlice(spec.DestinationRanges, in.DestinationRanges)
+}
+
+// IsUpToDate checks whether the observed compute.Firewall matches the
+// supplied FirewallParameters. Compute Engine may return allowed and denied
+// rules, their ports, and ranges and tags in a different order than they were
+// supplied in, and lower cases protocol names, so none of these are compared
+// by order or case.
+func IsUpToDate(name string, in v1alpha1.FirewallParameters, observed *compute.Firewall) bool {
+	desired := GenerateFirewall(name, in)
+	if !cmp.Equal(allowedRules(desired.Allowed), allowedRules(observed.Allowed), cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !cmp.Equal(deniedRules(desired.Denied), deniedRules(observed.Denied), cmpopts.EquateEmpty()) {
+		return false
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		gcp.EquateComputeURLs(),
+		cmpopts.SortSlices(func(i, j string) bool { return i < j }),
+		cmpopts.IgnoreFields(compute.Firewall{}, "Allowed", "Denied", "CreationTimestamp", "Id", "Kind", "LogConfig", "SelfLink",
+			"SourceServiceAccounts", "TargetServiceAccounts", "ServerResponse", "ForceSendFields", "NullFields"),
+	)
+}
+
+// ChangedImmutableFields returns the JSON names of the supplied parameters
+// that differ from the observed firewall rule but cannot be changed once it
+// is created. Unset parameters are not considered changed.
+func ChangedImmutableFields(in v1alpha1.FirewallParameters, observed compute.Firewall) []string {
+	var changed []string
+	if in.Network != nil && !cmp.Equal(*in.Network, observed.Network, gcp.EquateComputeURLs()) {
+		changed = append(changed, "network")
+	}
+	if in.Direction != nil && *in.Direction != observed.Direction {
+		changed = append(changed, "direction")
+	}
+	return changed
+}
+
+func allowedRules(rs []*compute.FirewallAllowed) []string {
+	keys := make([]string, 0, len(rs))
+	for _, r := range rs {
+		if r != nil {
+			keys = append(keys, ruleKey(r.IPProtocol, r.Ports))
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func deniedRules(rs []*compute.FirewallDenied) []string {
+	keys := make([]string, 0, len(rs))
+	for _, r := range rs {
+		if r != nil {
+			keys = append(keys, ruleKey(r.IPProtocol, r.Ports))
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ruleKey returns a key that identifies a rule regardless of the case of its
+// protocol and the order of its ports.
+func ruleKey(protocol string, ports []string) string {
+	p := append([]string{}, ports...)
+	sort.Strings(p)
+	return strings.ToLower(protocol) + ":" + strings.Join(p, ",")
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firewall
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testName    = "some-firewall"
+	testNetwork = "projects/some-project/global/networks/default"
+)
+
+func params(m ...func(*v1alpha1.FirewallParameters)) *v1alpha1.FirewallParameters {
+	p := &v1alpha1.FirewallParameters{
+		Description: gcp.StringPtr("desc"),
+		Network:     gcp.StringPtr(testNetwork),
+		Priority:    gcp.Int64Ptr(900),
+		Direction:   gcp.StringPtr(v1alpha1.FirewallDirectionIngress),
+		Allowed: []v1alpha1.FirewallRule{
+			{IPProtocol: "tcp", Ports: []string{"443", "8000-9000"}},
+			{IPProtocol: "icmp"},
+		},
+		SourceRanges: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		TargetTags:   []string{"web", "api"},
+		Disabled:     gcp.BoolPtr(false),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func firewall(m ...func(*compute.Firewall)) *compute.Firewall {
+	f := &compute.Firewall{
+		Name:        testName,
+		Description: "desc",
+		Network:     testNetwork,
+		Priority:    900,
+		Direction:   v1alpha1.FirewallDirectionIngress,
+		Allowed: []*compute.FirewallAllowed{
+			{IPProtocol: "tcp", Ports: []string{"443", "8000-9000"}},
+			{IPProtocol: "icmp"},
+		},
+		SourceRanges: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		TargetTags:   []string{"web", "api"},
+	}
+	for _, fn := range m {
+		fn(f)
+	}
+	return f
+}
+
+func TestGenerateFirewall(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.FirewallParameters
+		want *compute.Firewall
+	}{
+		"Full": {
+			in:   *params(),
+			want: firewall(),
+		},
+		"Denied": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.Denied, p.Allowed = p.Allowed, nil
+			}),
+			want: firewall(func(f *compute.Firewall) {
+				f.Denied = []*compute.FirewallDenied{
+					{IPProtocol: "tcp", Ports: []string{"443", "8000-9000"}},
+					{IPProtocol: "icmp"},
+				}
+				f.Allowed = nil
+			}),
+		},
+		"Minimal": {
+			in:   v1alpha1.FirewallParameters{},
+			want: &compute.Firewall{Name: testName},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateFirewall(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateFirewall(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateFirewallPatch(t *testing.T) {
+	got := GenerateFirewallPatch(*params(func(p *v1alpha1.FirewallParameters) { p.SourceRanges = nil }))
+	want := firewall(func(f *compute.Firewall) {
+		f.Name = ""
+		f.Network = ""
+		f.Direction = ""
+		f.SourceRanges = nil
+		f.ForceSendFields = []string{"Allowed", "Denied", "Description", "DestinationRanges", "Disabled", "Priority", "SourceRanges", "SourceTags", "TargetTags"}
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateFirewallPatch(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.FirewallParameters
+		observed compute.Firewall
+		want     *v1alpha1.FirewallParameters
+	}{
+		"FillsDefaults": {
+			spec: &v1alpha1.FirewallParameters{
+				Allowed: []v1alpha1.FirewallRule{{IPProtocol: "tcp"}},
+			},
+			observed: *firewall(func(f *compute.Firewall) {
+				f.Description = ""
+				f.Priority = 1000
+				f.SourceRanges = []string{"0.0.0.0/0"}
+			}),
+			want: &v1alpha1.FirewallParameters{
+				Network:      gcp.StringPtr(testNetwork),
+				Priority:     gcp.Int64Ptr(1000),
+				Direction:    gcp.StringPtr(v1alpha1.FirewallDirectionIngress),
+				Allowed:      []v1alpha1.FirewallRule{{IPProtocol: "tcp"}},
+				SourceRanges: []string{"0.0.0.0/0"},
+			},
+		},
+		"KeepsSpec": {
+			spec: params(),
+			observed: *firewall(func(f *compute.Firewall) {
+				f.Priority = 1000
+				f.SourceRanges = []string{"0.0.0.0/0"}
+			}),
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.FirewallParameters
+		observed *compute.Firewall
+		want     bool
+	}{
+		"UpToDate": {
+			in:       *params(),
+			observed: firewall(),
+			want:     true,
+		},
+		"Reordered": {
+			in: *params(),
+			observed: firewall(func(f *compute.Firewall) {
+				f.Allowed = []*compute.FirewallAllowed{
+					{IPProtocol: "ICMP"},
+					{IPProtocol: "tcp", Ports: []string{"8000-9000", "443"}},
+				}
+				f.SourceRanges = []string{"192.168.0.0/16", "10.0.0.0/8"}
+				f.TargetTags = []string{"api", "web"}
+			}),
+			want: true,
+		},
+		"FullyQualifiedNetwork": {
+			in: *params(),
+			observed: firewall(func(f *compute.Firewall) {
+				f.Network = "https://www.googleapis.com/compute/v1/" + testNetwork
+			}),
+			want: true,
+		},
+		"PortAllowed": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.Allowed[0].Ports = append(p.Allowed[0].Ports, "80")
+			}),
+			observed: firewall(),
+			want:     false,
+		},
+		"RuleRemoved": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.Allowed = p.Allowed[:1]
+			}),
+			observed: firewall(),
+			want:     false,
+		},
+		"AllowedToDenied": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.Denied, p.Allowed = p.Allowed, nil
+			}),
+			observed: firewall(),
+			want:     false,
+		},
+		"PriorityChanged": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.Priority = gcp.Int64Ptr(100)
+			}),
+			observed: firewall(),
+			want:     false,
+		},
+		"DirectionChanged": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.Direction = gcp.StringPtr(v1alpha1.FirewallDirectionEgress)
+			}),
+			observed: firewall(),
+			want:     false,
+		},
+		"TargetTagRemoved": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.TargetTags = p.TargetTags[:1]
+			}),
+			observed: firewall(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(testName, tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangedImmutableFields(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.FirewallParameters
+		observed compute.Firewall
+		want     []string
+	}{
+		"Unchanged": {
+			in:       *params(),
+			observed: *firewall(),
+		},
+		"Unset": {
+			in:       v1alpha1.FirewallParameters{},
+			observed: *firewall(),
+		},
+		"FullyQualifiedNetwork": {
+			in: *params(),
+			observed: *firewall(func(f *compute.Firewall) {
+				f.Network = "https://www.googleapis.com/compute/v1/" + testNetwork
+			}),
+		},
+		"Changed": {
+			in: *params(func(p *v1alpha1.FirewallParameters) {
+				p.Network = gcp.StringPtr("projects/some-project/global/networks/other")
+				p.Direction = gcp.StringPtr(v1alpha1.FirewallDirectionEgress)
+			}),
+			observed: *firewall(),
+			want:     []string{"network", "direction"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ChangedImmutableFields(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ChangedImmutableFields(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
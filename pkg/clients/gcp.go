@@ -17,14 +17,17 @@ limitations under the License.
 package gcp
 
 import (
+	"net"
 	"net/http"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 )
@@ -40,13 +43,33 @@ func IsErrorNotFoundGRPC(err error) bool {
 	return ok && grpcErr.GRPCStatus().Code() == codes.NotFound
 }
 
-// IsErrorNotFound gets a value indicating whether the given error represents a "not found" response from the Google API
+// IsErrorNotFound gets a value indicating whether the given error represents a
+// "not found" response from the Google API. It works for clients that return
+// a *googleapi.Error as well as for those that use gRPC, and for errors that
+// wrap such a response.
 func IsErrorNotFound(err error) bool {
-	if err == nil {
-		return false
+	for ; err != nil; err = unwrap(err) {
+		if googleapiErr, ok := err.(*googleapi.Error); ok && googleapiErr.Code == http.StatusNotFound {
+			return true
+		}
+		if grpcErr, ok := err.(interface{ GRPCStatus() *status.Status }); ok && grpcErr.GRPCStatus().Code() == codes.NotFound {
+			return true
+		}
 	}
-	googleapiErr, ok := err.(*googleapi.Error)
-	return ok && googleapiErr.Code == http.StatusNotFound
+	return false
+}
+
+// unwrap returns the error the supplied error wraps, or nil if it does not
+// wrap one. The version of github.com/pkg/errors we use exposes wrapped errors
+// through Cause rather than Unwrap, so errors.As cannot see through them.
+func unwrap(err error) error {
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		return e.Unwrap()
+	case interface{ Cause() error }:
+		return e.Cause()
+	}
+	return nil
 }
 
 // IsErrorAlreadyExists gets a value indicating whether the given error represents a "conflict" response from the Google API
@@ -58,6 +81,15 @@ func IsErrorAlreadyExists(err error) bool {
 	return ok && googleapiErr.Code == http.StatusConflict
 }
 
+// IsErrorPreconditionFailed gets a value indicating whether the given error represents a "precondition failed" response from the Google API
+func IsErrorPreconditionFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	googleapiErr, ok := err.(*googleapi.Error)
+	return ok && googleapiErr.Code == http.StatusPreconditionFailed
+}
+
 // IsErrorBadRequest gets a value indicating whether the given error represents a "bad request" response from the Google API
 func IsErrorBadRequest(err error) bool {
 	if err == nil {
@@ -67,6 +99,42 @@ func IsErrorBadRequest(err error) bool {
 	return ok && googleapiErr.Code == http.StatusBadRequest
 }
 
+// IsErrorTransient gets a value indicating whether the given error is likely
+// to resolve itself when the request is retried, for example because the
+// Google API was unavailable or timed out.
+func IsErrorTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return true
+	}
+	googleapiErr, ok := err.(*googleapi.Error)
+	if !ok {
+		return false
+	}
+	switch googleapiErr.Code {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// TimeFromRFC3339 converts the supplied RFC3339 timestamp, as returned by most
+// GCP APIs, to a *metav1.Time. It returns nil if the timestamp is empty or
+// cannot be parsed.
+func TimeFromRFC3339(s string) *metav1.Time {
+	if s == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+	mt := metav1.NewTime(t)
+	return &mt
+}
+
 // StringValue converts the supplied string pointer to a string, returning the
 // empty string if the pointer is nil.
 func StringValue(v *string) string {
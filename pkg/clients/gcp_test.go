@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsErrorTransient(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":                {err: nil, want: false},
+		"Other":              {err: errors.New("boom"), want: false},
+		"ServiceUnavailable": {err: &googleapi.Error{Code: http.StatusServiceUnavailable}, want: true},
+		"InternalError":      {err: &googleapi.Error{Code: http.StatusInternalServerError}, want: true},
+		"BadRequest":         {err: &googleapi.Error{Code: http.StatusBadRequest}, want: false},
+		"Forbidden":          {err: &googleapi.Error{Code: http.StatusForbidden}, want: false},
+		"Timeout":            {err: &url.Error{Op: "Get", URL: "https://iam.googleapis.com", Err: timeoutError{}}, want: true},
+		"Canceled":           {err: &url.Error{Op: "Get", URL: "https://iam.googleapis.com", Err: context.Canceled}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsErrorTransient(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsErrorTransient(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsErrorNotFound(t *testing.T) {
+	notFound := &googleapi.Error{Code: http.StatusNotFound}
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":            {err: nil, want: false},
+		"Other":          {err: errors.New("boom"), want: false},
+		"GoogleAPI":      {err: notFound, want: true},
+		"GoogleAPIOther": {err: &googleapi.Error{Code: http.StatusForbidden}, want: false},
+		"GRPC":           {err: status.Error(codes.NotFound, "boom"), want: true},
+		"GRPCOther":      {err: status.Error(codes.PermissionDenied, "boom"), want: false},
+		"Wrapped":        {err: pkgerrors.Wrap(notFound, "cannot get"), want: true},
+		"DoublyWrapped":  {err: pkgerrors.Wrap(pkgerrors.Wrap(status.Error(codes.NotFound, "boom"), "cannot get"), "cannot observe"), want: true},
+		"StdlibWrapped":  {err: fmt.Errorf("cannot get: %w", notFound), want: true},
+		"WrappedOther":   {err: pkgerrors.Wrap(errors.New("boom"), "cannot get"), want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsErrorNotFound(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsErrorNotFound(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsErrorPreconditionFailed(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":                {err: nil, want: false},
+		"Other":              {err: errors.New("boom"), want: false},
+		"PreconditionFailed": {err: &googleapi.Error{Code: http.StatusPreconditionFailed}, want: true},
+		"Conflict":           {err: &googleapi.Error{Code: http.StatusConflict}, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsErrorPreconditionFailed(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsErrorPreconditionFailed(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeFromRFC3339(t *testing.T) {
+	cases := map[string]struct {
+		in   string
+		want *metav1.Time
+	}{
+		"Empty": {
+			in: "",
+		},
+		"Invalid": {
+			in: "yesterday",
+		},
+		"UTC": {
+			in:   "2020-05-01T12:00:00Z",
+			want: &metav1.Time{Time: time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)},
+		},
+		"OffsetWithFraction": {
+			in:   "2020-05-01T05:00:00.500-07:00",
+			want: &metav1.Time{Time: time.Date(2020, 5, 1, 12, 0, 0, 500000000, time.UTC)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TimeFromRFC3339(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("TimeFromRFC3339(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
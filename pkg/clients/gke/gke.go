@@ -54,11 +54,14 @@ type ClusterClient struct {
 }
 
 // NewClusterClient return new instance of the Client based on credentials
-func NewClusterClient(ctx context.Context, creds *google.Credentials) (*ClusterClient, error) {
+// that identifies itself using the supplied user agent.
+func NewClusterClient(ctx context.Context, creds *google.Credentials, userAgent string) (*ClusterClient, error) {
 	client, err := container.NewService(ctx, option.WithHTTPClient(oauth2.NewClient(context.Background(), creds.TokenSource)))
 	if err != nil {
 		return nil, err
 	}
+	// User agent options are ignored when an HTTP client is supplied.
+	client.UserAgent = userAgent
 
 	return &ClusterClient{
 		creds:  creds,
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+const defaultRetryInterval = 1 * time.Second
+
+// ClientOptions returns the options used to build a client for a GCP REST
+// API that authenticates using the supplied JSON credentials and identifies
+// itself using UserAgent. When an HTTPClientConfig is supplied the client
+// uses an *http.Client that honours its timeout and retry policy. Such a
+// client is also used when tracing is enabled, so that its requests can be
+// traced.
+func ClientOptions(ctx context.Context, creds []byte, cfg *v1alpha3.HTTPClientConfig, scopes ...string) ([]option.ClientOption, error) {
+	return clientOptions(ctx, []option.ClientOption{option.WithCredentialsJSON(creds)}, cfg, scopes...)
+}
+
+// InjectedIdentityClientOptions returns the options used to build a client
+// for a GCP REST API that authenticates using the application default
+// credentials of the environment the provider runs in, for example those of
+// a GKE Workload Identity. Otherwise it behaves like ClientOptions.
+func InjectedIdentityClientOptions(ctx context.Context, cfg *v1alpha3.HTTPClientConfig, scopes ...string) ([]option.ClientOption, error) {
+	return clientOptions(ctx, nil, cfg, scopes...)
+}
+
+func clientOptions(ctx context.Context, auth []option.ClientOption, cfg *v1alpha3.HTTPClientConfig, scopes ...string) ([]option.ClientOption, error) {
+	// The client library instruments requests itself unless told otherwise;
+	// requests are only traced by NewHTTPClient, and only when enabled.
+	opts := append(auth, option.WithTelemetryDisabled(), UserAgentOption(cfg))
+	if len(scopes) > 0 {
+		opts = append(opts, option.WithScopes(scopes...))
+	}
+	if cfg == nil && !tracing {
+		return opts, nil
+	}
+	if cfg == nil {
+		cfg = &v1alpha3.HTTPClientConfig{}
+	}
+	hc, err := NewHTTPClient(ctx, cfg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return []option.ClientOption{option.WithHTTPClient(hc)}, nil
+}
+
+// NewHTTPClient returns an *http.Client configured per the supplied
+// HTTPClientConfig. Requests are authenticated per the supplied options, and
+// traced if tracing is enabled.
+func NewHTTPClient(ctx context.Context, cfg *v1alpha3.HTTPClientConfig, opts ...option.ClientOption) (*http.Client, error) {
+	var base http.RoundTripper = http.DefaultTransport
+	if cfg.LogRequests {
+		// Log below any retries so that every attempt is logged.
+		base = &loggingTransport{base: base, log: requestLogger}
+	}
+	if cfg.Retries != nil && *cfg.Retries > 0 {
+		rt := &retryTransport{base: base, retries: int(*cfg.Retries), interval: defaultRetryInterval}
+		if cfg.RetryInterval != nil {
+			rt.interval = cfg.RetryInterval.Duration
+		}
+		base = rt
+	}
+	if tracing {
+		base = newTracingTransport(base)
+	}
+	// Requests are traced above, if at all, rather than by the client library.
+	opts = append(opts, option.WithTelemetryDisabled())
+	t, err := htransport.NewTransport(ctx, base, opts...)
+	if err != nil {
+		return nil, err
+	}
+	hc := &http.Client{Transport: t}
+	if cfg.Timeout != nil {
+		hc.Timeout = cfg.Timeout.Duration
+	}
+	return hc, nil
+}
+
+// retryTransport retries idempotent GET requests that fail with a transport
+// error or a retryable status code, doubling the wait between attempts.
+// Retries stop as soon as the request's context is done.
+type retryTransport struct {
+	base     http.RoundTripper
+	retries  int
+	interval time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.base.RoundTrip(req)
+	}
+
+	wait := t.interval
+	for i := 0; ; i++ {
+		rsp, err := t.base.RoundTrip(req)
+		if i == t.retries || !shouldRetry(rsp, err) {
+			return rsp, err
+		}
+		if rsp != nil {
+			_ = rsp.Body.Close()
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+func shouldRetry(rsp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch rsp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
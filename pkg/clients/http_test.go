@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func TestNewHTTPClient(t *testing.T) {
+	type want struct {
+		requests int
+		status   int
+		err      bool
+	}
+
+	cases := map[string]struct {
+		reason   string
+		cfg      *v1alpha3.HTTPClientConfig
+		method   string
+		failures int
+		delay    time.Duration
+		want     want
+	}{
+		"RetriedGet": {
+			reason:   "A GET request should be retried until it succeeds",
+			cfg:      &v1alpha3.HTTPClientConfig{Retries: int32Ptr(3), RetryInterval: &metav1.Duration{}},
+			method:   http.MethodGet,
+			failures: 2,
+			want:     want{requests: 3, status: http.StatusOK},
+		},
+		"RetriesExhausted": {
+			reason:   "The last response should be returned once retries are exhausted",
+			cfg:      &v1alpha3.HTTPClientConfig{Retries: int32Ptr(1), RetryInterval: &metav1.Duration{}},
+			method:   http.MethodGet,
+			failures: 5,
+			want:     want{requests: 2, status: http.StatusServiceUnavailable},
+		},
+		"PostNotRetried": {
+			reason:   "Requests that are not idempotent should never be retried",
+			cfg:      &v1alpha3.HTTPClientConfig{Retries: int32Ptr(3), RetryInterval: &metav1.Duration{}},
+			method:   http.MethodPost,
+			failures: 1,
+			want:     want{requests: 1, status: http.StatusServiceUnavailable},
+		},
+		"Timeout": {
+			reason: "Requests should fail once the configured timeout elapses",
+			cfg:    &v1alpha3.HTTPClientConfig{Timeout: &metav1.Duration{Duration: 10 * time.Millisecond}},
+			method: http.MethodGet,
+			delay:  time.Second,
+			want:   want{requests: 1, err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if tc.delay > 0 {
+					select {
+					case <-r.Context().Done():
+					case <-time.After(tc.delay):
+					}
+				}
+				if requests <= tc.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			hc, err := NewHTTPClient(context.Background(), tc.cfg, option.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("NewHTTPClient(...): %s", err)
+			}
+			req, _ := http.NewRequest(tc.method, server.URL, nil)
+			rsp, err := hc.Do(req)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want error, +got error:\n%s\n%s", tc.reason, diff, err)
+			}
+			if rsp != nil {
+				_ = rsp.Body.Close()
+				if diff := cmp.Diff(tc.want.status, rsp.StatusCode); diff != "" {
+					t.Errorf("\n%s\nDo(...): -want status, +got status:\n%s", tc.reason, diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.requests, requests); diff != "" {
+				t.Errorf("\n%s\nDo(...): -want requests, +got requests:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRetryTransportContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	rt := &retryTransport{base: http.DefaultTransport, retries: 10, interval: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err := rt.RoundTrip(req.WithContext(ctx))
+	if diff := cmp.Diff(context.DeadlineExceeded, errors.Cause(err)); diff != "" {
+		t.Errorf("RoundTrip(...): -want error, +got error:\n%s", diff)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Account ID length limits of the IAM API.
+const (
+	AccountIDMinLength = 6
+	AccountIDMaxLength = 30
+)
+
+// Error strings.
+const (
+	errAccountIDLength = "account ID %q must be between %d and %d characters long, but is %d"
+	errAccountIDFormat = "account ID %q must start with a lowercase letter, contain only lowercase letters, digits and hyphens, and not end with a hyphen"
+)
+
+// accountIDSuffixLength is the number of hex digits of the hash of a name
+// that AccountID appends to keep derived account IDs unique.
+const accountIDSuffixLength = 6
+
+var accountIDFormat = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+// ValidateAccountID returns an error that explains why the IAM API would
+// reject the supplied account ID, if it would.
+func ValidateAccountID(id string) error {
+	if l := len(id); l < AccountIDMinLength || l > AccountIDMaxLength {
+		return errors.Errorf(errAccountIDLength, id, AccountIDMinLength, AccountIDMaxLength, l)
+	}
+	if !accountIDFormat.MatchString(id) {
+		return errors.Errorf(errAccountIDFormat, id)
+	}
+	return nil
+}
+
+// AccountID derives a valid account ID from the supplied name, typically the
+// name of a Kubernetes object. A name that is a valid account ID is returned
+// unchanged. Otherwise it is lowercased, characters other than letters,
+// digits and hyphens are replaced by hyphens, names that do not start with a
+// letter are prefixed with "sa-", and names that are too short or too long
+// are padded or truncated. Names that are changed get a suffix derived from
+// the original name, so that different names do not derive the same ID.
+func AccountID(name string) string {
+	if ValidateAccountID(name) == nil {
+		return name
+	}
+	id := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+		return '-'
+	}, name)
+	if id == "" || id[0] < 'a' || id[0] > 'z' {
+		id = "sa-" + id
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	suffix := hex.EncodeToString(sum[:])[:accountIDSuffixLength]
+	if max := AccountIDMaxLength - len(suffix) - 1; len(id) > max {
+		id = id[:max]
+	}
+	return strings.TrimRight(id, "-") + "-" + suffix
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestValidateAccountID(t *testing.T) {
+	cases := map[string]struct {
+		id   string
+		want error
+	}{
+		"Valid":           {id: "my-service-account", want: nil},
+		"TooShort":        {id: "my-sa", want: errors.Errorf(errAccountIDLength, "my-sa", 6, 30, 5)},
+		"TooLong":         {id: "my-very-long-service-account-name", want: errors.Errorf(errAccountIDLength, "my-very-long-service-account-name", 6, 30, 33)},
+		"Uppercase":       {id: "my-Service-Account", want: errors.Errorf(errAccountIDFormat, "my-Service-Account")},
+		"StartsWithDigit": {id: "1-service-account", want: errors.Errorf(errAccountIDFormat, "1-service-account")},
+		"EndsWithHyphen":  {id: "service-account-", want: errors.Errorf(errAccountIDFormat, "service-account-")},
+		"Dots":            {id: "my.service.account", want: errors.Errorf(errAccountIDFormat, "my.service.account")},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateAccountID(tc.id)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateAccountID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAccountID(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want string
+	}{
+		"Valid":           {name: "my-service-account", want: "my-service-account"},
+		"TooShort":        {name: "my-sa", want: "my-sa-44bd3e"},
+		"TooLong":         {name: "my-very-long-service-account-name", want: "my-very-long-service-ac-f9cfc9"},
+		"Uppercase":       {name: "My-Service-Account", want: "my-service-account-613ff9"},
+		"StartsWithDigit": {name: "1-service-account", want: "sa-1-service-account-6e33ce"},
+		"Dots":            {name: "my.service.account", want: "my-service-account-b922f5"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AccountID(tc.name)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("AccountID(...): -want, +got:\n%s", diff)
+			}
+			if err := ValidateAccountID(got); err != nil {
+				t.Errorf("AccountID(...): derived an invalid account ID: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake contains fake implementations of the IAM clients.
+package fake
+
+import (
+	"context"
+
+	iamv1 "google.golang.org/api/iam/v1"
+
+	"github.com/crossplane/provider-gcp/pkg/clients/iam"
+)
+
+var _ iam.ServiceAccountClient = &MockServiceAccountClient{}
+
+// MockServiceAccountClient is a fake implementation of
+// iam.ServiceAccountClient.
+type MockServiceAccountClient struct {
+	MockGet    func(ctx context.Context, name string) (*iamv1.ServiceAccount, error)
+	MockCreate func(ctx context.Context, project string, req *iamv1.CreateServiceAccountRequest) (*iamv1.ServiceAccount, error)
+	MockPatch  func(ctx context.Context, name string, req *iamv1.PatchServiceAccountRequest) (*iamv1.ServiceAccount, error)
+	MockDelete func(ctx context.Context, name string) error
+
+	MockDisable func(ctx context.Context, name string) error
+	MockEnable  func(ctx context.Context, name string) error
+
+	MockGetIamPolicy func(ctx context.Context, name string) (*iamv1.Policy, error)
+	MockSetIamPolicy func(ctx context.Context, name string, p *iamv1.Policy) (*iamv1.Policy, error)
+}
+
+// Get calls the MockServiceAccountClient's MockGet function.
+func (c *MockServiceAccountClient) Get(ctx context.Context, name string) (*iamv1.ServiceAccount, error) {
+	return c.MockGet(ctx, name)
+}
+
+// Create calls the MockServiceAccountClient's MockCreate function.
+func (c *MockServiceAccountClient) Create(ctx context.Context, project string, req *iamv1.CreateServiceAccountRequest) (*iamv1.ServiceAccount, error) {
+	return c.MockCreate(ctx, project, req)
+}
+
+// Patch calls the MockServiceAccountClient's MockPatch function.
+func (c *MockServiceAccountClient) Patch(ctx context.Context, name string, req *iamv1.PatchServiceAccountRequest) (*iamv1.ServiceAccount, error) {
+	return c.MockPatch(ctx, name, req)
+}
+
+// Delete calls the MockServiceAccountClient's MockDelete function.
+func (c *MockServiceAccountClient) Delete(ctx context.Context, name string) error {
+	return c.MockDelete(ctx, name)
+}
+
+// Disable calls the MockServiceAccountClient's MockDisable function.
+func (c *MockServiceAccountClient) Disable(ctx context.Context, name string) error {
+	return c.MockDisable(ctx, name)
+}
+
+// Enable calls the MockServiceAccountClient's MockEnable function.
+func (c *MockServiceAccountClient) Enable(ctx context.Context, name string) error {
+	return c.MockEnable(ctx, name)
+}
+
+// GetIamPolicy calls the MockServiceAccountClient's MockGetIamPolicy
+// function.
+func (c *MockServiceAccountClient) GetIamPolicy(ctx context.Context, name string) (*iamv1.Policy, error) {
+	return c.MockGetIamPolicy(ctx, name)
+}
+
+// SetIamPolicy calls the MockServiceAccountClient's MockSetIamPolicy
+// function.
+func (c *MockServiceAccountClient) SetIamPolicy(ctx context.Context, name string, p *iamv1.Policy) (*iamv1.Policy, error) {
+	return c.MockSetIamPolicy(ctx, name, p)
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iam contains a client for the service accounts of the GCP IAM API.
+package iam
+
+import (
+	"context"
+
+	iamv1 "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+)
+
+// policyVersion is the IAM policy version that is requested and written, so
+// that policies with conditional bindings are never silently truncated.
+const policyVersion = 3
+
+// A ServiceAccountGetter gets service accounts.
+type ServiceAccountGetter interface {
+	Get(ctx context.Context, name string) (*iamv1.ServiceAccount, error)
+}
+
+// A ServiceAccountCreator creates service accounts.
+type ServiceAccountCreator interface {
+	Create(ctx context.Context, project string, req *iamv1.CreateServiceAccountRequest) (*iamv1.ServiceAccount, error)
+}
+
+// A ServiceAccountPatcher patches service accounts.
+type ServiceAccountPatcher interface {
+	Patch(ctx context.Context, name string, req *iamv1.PatchServiceAccountRequest) (*iamv1.ServiceAccount, error)
+}
+
+// A ServiceAccountDeleter deletes service accounts.
+type ServiceAccountDeleter interface {
+	Delete(ctx context.Context, name string) error
+}
+
+// A ServiceAccountDisabler disables and enables service accounts.
+type ServiceAccountDisabler interface {
+	Disable(ctx context.Context, name string) error
+	Enable(ctx context.Context, name string) error
+}
+
+// A ServiceAccountPolicyGetter gets the IAM policies of service accounts.
+type ServiceAccountPolicyGetter interface {
+	GetIamPolicy(ctx context.Context, name string) (*iamv1.Policy, error)
+}
+
+// A ServiceAccountPolicySetter sets the IAM policies of service accounts.
+type ServiceAccountPolicySetter interface {
+	SetIamPolicy(ctx context.Context, name string, p *iamv1.Policy) (*iamv1.Policy, error)
+}
+
+// A ServiceAccountClient handles CRUD operations for service accounts.
+// Service accounts are identified by their relative resource name, e.g.
+// projects/my-project/serviceAccounts/sa@my-project.iam.gserviceaccount.com.
+type ServiceAccountClient interface {
+	ServiceAccountGetter
+	ServiceAccountCreator
+	ServiceAccountPatcher
+	ServiceAccountDeleter
+	ServiceAccountDisabler
+	ServiceAccountPolicyGetter
+	ServiceAccountPolicySetter
+}
+
+// NewServiceAccountClient returns a ServiceAccountClient that calls the IAM
+// API per the supplied options.
+func NewServiceAccountClient(ctx context.Context, opts ...option.ClientOption) (ServiceAccountClient, error) {
+	s, err := iamv1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceAccounts{Service: iamv1.NewProjectsService(s).ServiceAccounts}, nil
+}
+
+// ServiceAccounts is a ServiceAccountClient backed by the IAM API.
+type ServiceAccounts struct {
+	Service *iamv1.ProjectsServiceAccountsService
+}
+
+// Get the named service account.
+func (c *ServiceAccounts) Get(ctx context.Context, name string) (*iamv1.ServiceAccount, error) {
+	return c.Service.Get(name).Context(ctx).Do()
+}
+
+// Create a service account in the named project.
+func (c *ServiceAccounts) Create(ctx context.Context, project string, req *iamv1.CreateServiceAccountRequest) (*iamv1.ServiceAccount, error) {
+	return c.Service.Create(project, req).Context(ctx).Do()
+}
+
+// Patch the named service account.
+func (c *ServiceAccounts) Patch(ctx context.Context, name string, req *iamv1.PatchServiceAccountRequest) (*iamv1.ServiceAccount, error) {
+	return c.Service.Patch(name, req).Context(ctx).Do()
+}
+
+// Delete the named service account.
+func (c *ServiceAccounts) Delete(ctx context.Context, name string) error {
+	_, err := c.Service.Delete(name).Context(ctx).Do()
+	return err
+}
+
+// Disable the named service account.
+func (c *ServiceAccounts) Disable(ctx context.Context, name string) error {
+	_, err := c.Service.Disable(name, &iamv1.DisableServiceAccountRequest{}).Context(ctx).Do()
+	return err
+}
+
+// Enable the named service account.
+func (c *ServiceAccounts) Enable(ctx context.Context, name string) error {
+	_, err := c.Service.Enable(name, &iamv1.EnableServiceAccountRequest{}).Context(ctx).Do()
+	return err
+}
+
+// GetIamPolicy gets the IAM policy of the named service account.
+func (c *ServiceAccounts) GetIamPolicy(ctx context.Context, name string) (*iamv1.Policy, error) {
+	return c.Service.GetIamPolicy(name).OptionsRequestedPolicyVersion(policyVersion).Context(ctx).Do()
+}
+
+// SetIamPolicy sets the IAM policy of the named service account. The write
+// fails if the policy's etag does not match that of the current policy.
+func (c *ServiceAccounts) SetIamPolicy(ctx context.Context, name string, p *iamv1.Policy) (*iamv1.Policy, error) {
+	p.Version = policyVersion
+	return c.Service.SetIamPolicy(name, &iamv1.SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+}
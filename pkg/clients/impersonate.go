@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/iamcredentials/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+// CloudPlatformScope is the OAuth 2.0 scope that grants access to all GCP
+// APIs the impersonated service account may call.
+const CloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// impersonatedTokenLifetime is how long an impersonated access token is
+// valid. One hour is the longest lifetime the IAM Credentials API allows
+// without an organization policy exception.
+const impersonatedTokenLifetime = "3600s"
+
+// serviceAccountEmail matches the email of a user-managed or Google-managed
+// service account, for example sa@my-project.iam.gserviceaccount.com or
+// 123456789012-compute@developer.gserviceaccount.com.
+var serviceAccountEmail = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*@[a-z0-9][a-z0-9.-]*\.gserviceaccount\.com$`)
+
+// IsServiceAccountEmail returns true if the supplied string is the fully
+// qualified email of a service account.
+func IsServiceAccountEmail(s string) bool {
+	return serviceAccountEmail.MatchString(s)
+}
+
+// An impersonatedTokenSource mints access tokens for a service account via
+// the IAM Credentials API.
+type impersonatedTokenSource struct {
+	credentials *iamcredentials.Service
+	name        string
+	scopes      []string
+}
+
+// Token mints a new access token.
+func (ts *impersonatedTokenSource) Token() (*oauth2.Token, error) {
+	rsp, err := ts.credentials.Projects.ServiceAccounts.GenerateAccessToken(ts.name, &iamcredentials.GenerateAccessTokenRequest{
+		Lifetime: impersonatedTokenLifetime,
+		Scope:    ts.scopes,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	expiry, err := time.Parse(time.RFC3339, rsp.ExpireTime)
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{AccessToken: rsp.AccessToken, TokenType: "Bearer", Expiry: expiry}, nil
+}
+
+// ImpersonatedTokenSource returns a TokenSource of access tokens for the
+// service account with the supplied email, minted by the identity the
+// supplied options authenticate as. That identity must be granted
+// roles/iam.serviceAccountTokenCreator on the service account. Tokens are
+// reused until they expire. The cloud-platform scope is requested if no
+// scopes are supplied.
+func ImpersonatedTokenSource(ctx context.Context, email string, scopes []string, opts ...option.ClientOption) (oauth2.TokenSource, error) {
+	s, err := iamcredentials.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(scopes) == 0 {
+		scopes = []string{CloudPlatformScope}
+	}
+	ts := &impersonatedTokenSource{
+		credentials: s,
+		name:        fmt.Sprintf("projects/-/serviceAccounts/%s", email),
+		scopes:      scopes,
+	}
+	return oauth2.ReuseTokenSource(nil, ts), nil
+}
+
+// ImpersonatedClientOptions returns the options used to build a client for a
+// GCP REST API that authenticates using tokens from the supplied
+// TokenSource. Otherwise it behaves like ClientOptions.
+func ImpersonatedClientOptions(ctx context.Context, ts oauth2.TokenSource, cfg *v1alpha3.HTTPClientConfig) ([]option.ClientOption, error) {
+	return clientOptions(ctx, []option.ClientOption{option.WithTokenSource(ts)}, cfg)
+}
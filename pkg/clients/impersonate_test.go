@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/iamcredentials/v1"
+	"google.golang.org/api/option"
+)
+
+func TestIsServiceAccountEmail(t *testing.T) {
+	cases := map[string]bool{
+		"sa@my-project.iam.gserviceaccount.com":              true,
+		"123456789012-compute@developer.gserviceaccount.com": true,
+		"my-project@appspot.gserviceaccount.com":             true,
+		"sa":                                                 false,
+		"sa@my-project":                                      false,
+		"user@example.com":                                   false,
+		"projects/-/serviceAccounts/sa@my-project.iam.gserviceaccount.com": false,
+	}
+
+	for email, want := range cases {
+		t.Run(email, func(t *testing.T) {
+			if got := IsServiceAccountEmail(email); got != want {
+				t.Errorf("IsServiceAccountEmail(%q): want %t, got %t", email, want, got)
+			}
+		})
+	}
+}
+
+func TestImpersonatedTokenSource(t *testing.T) {
+	email := "sa@my-project.iam.gserviceaccount.com"
+	expiry := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type want struct {
+		path   string
+		scopes []string
+		token  string
+		err    bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		want   want
+	}{
+		"Minted": {
+			reason: "A token should be minted for the impersonated service account",
+			status: http.StatusOK,
+			want: want{
+				path:   "/v1/projects/-/serviceAccounts/" + email + ":generateAccessToken",
+				scopes: []string{CloudPlatformScope},
+				token:  "token",
+			},
+		},
+		"PermissionDenied": {
+			reason: "An error should be returned if the token cannot be minted",
+			status: http.StatusForbidden,
+			want: want{
+				path:   "/v1/projects/-/serviceAccounts/" + email + ":generateAccessToken",
+				scopes: []string{CloudPlatformScope},
+				err:    true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var path string
+			var scopes []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				req := &iamcredentials.GenerateAccessTokenRequest{}
+				_ = json.NewDecoder(r.Body).Decode(req)
+				scopes = req.Scope
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&iamcredentials.GenerateAccessTokenResponse{
+					AccessToken: "token",
+					ExpireTime:  expiry.Format(time.RFC3339),
+				})
+			}))
+			defer srv.Close()
+
+			ts, err := ImpersonatedTokenSource(context.Background(), email, nil, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("ImpersonatedTokenSource(...): %s", err)
+			}
+			tok, err := ts.Token()
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nts.Token(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.path, path); diff != "" {
+				t.Errorf("\n%s\nts.Token(...): -want path, +got path:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.scopes, scopes); diff != "" {
+				t.Errorf("\n%s\nts.Token(...): -want scopes, +got scopes:\n%s", tc.reason, diff)
+			}
+			if err == nil && tok.AccessToken != tc.want.token {
+				t.Errorf("\n%s\nts.Token(...): want token %q, got %q", tc.reason, tc.want.token, tok.AccessToken)
+			}
+		})
+	}
+}
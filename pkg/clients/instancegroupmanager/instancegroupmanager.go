@@ -0,0 +1,215 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroupmanager
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateRegionInstanceGroupManager creates a *compute.InstanceGroupManager
+// from the supplied RegionInstanceGroupManagerParameters. Zone names are
+// expanded into URLs within the supplied project.
+func GenerateRegionInstanceGroupManager(project, name string, in v1alpha1.RegionInstanceGroupManagerParameters) *compute.InstanceGroupManager {
+	m := &compute.InstanceGroupManager{
+		Name:                name,
+		Description:         gcp.StringValue(in.Description),
+		BaseInstanceName:    in.BaseInstanceName,
+		InstanceTemplate:    in.InstanceTemplate,
+		TargetSize:          gcp.Int64Value(in.TargetSize),
+		TargetPools:         in.TargetPools,
+		AutoHealingPolicies: GenerateAutoHealingPolicies(in.AutoHealingPolicies),
+		UpdatePolicy:        GenerateUpdatePolicy(in.UpdatePolicy),
+	}
+	if in.TargetSize != nil {
+		// A target size of zero must be sent explicitly to scale the group
+		// in to no instances.
+		m.ForceSendFields = []string{"TargetSize"}
+	}
+	for _, p := range in.NamedPorts {
+		m.NamedPorts = append(m.NamedPorts, &compute.NamedPort{Name: p.Name, Port: p.Port})
+	}
+	if in.DistributionPolicy != nil {
+		m.DistributionPolicy = &compute.DistributionPolicy{}
+		for _, z := range in.DistributionPolicy.Zones {
+			m.DistributionPolicy.Zones = append(m.DistributionPolicy.Zones, &compute.DistributionPolicyZoneConfiguration{
+				Zone: qualify(z, fmt.Sprintf("projects/%s/zones/", project)),
+			})
+		}
+	}
+	return m
+}
+
+// GenerateAutoHealingPolicies creates compute autohealing policies from the
+// supplied AutoHealingPolicies.
+func GenerateAutoHealingPolicies(in []v1alpha1.AutoHealingPolicy) []*compute.InstanceGroupManagerAutoHealingPolicy {
+	var out []*compute.InstanceGroupManagerAutoHealingPolicy
+	for _, p := range in {
+		out = append(out, &compute.InstanceGroupManagerAutoHealingPolicy{
+			HealthCheck:     p.HealthCheck,
+			InitialDelaySec: gcp.Int64Value(p.InitialDelaySec),
+		})
+	}
+	return out
+}
+
+// GenerateUpdatePolicy creates a *compute.InstanceGroupManagerUpdatePolicy from
+// the supplied UpdatePolicy.
+func GenerateUpdatePolicy(in *v1alpha1.UpdatePolicy) *compute.InstanceGroupManagerUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	return &compute.InstanceGroupManagerUpdatePolicy{
+		Type:                       gcp.StringValue(in.Type),
+		MinimalAction:              gcp.StringValue(in.MinimalAction),
+		InstanceRedistributionType: gcp.StringValue(in.InstanceRedistributionType),
+		MaxSurge:                   generateFixedOrPercent(in.MaxSurge),
+		MaxUnavailable:             generateFixedOrPercent(in.MaxUnavailable),
+	}
+}
+
+func generateFixedOrPercent(in *v1alpha1.FixedOrPercent) *compute.FixedOrPercent {
+	if in == nil {
+		return nil
+	}
+	out := &compute.FixedOrPercent{Fixed: gcp.Int64Value(in.Fixed), Percent: gcp.Int64Value(in.Percent)}
+	// Zero instances must be sent explicitly, or the API will apply its
+	// default.
+	if in.Fixed != nil {
+		out.ForceSendFields = []string{"Fixed"}
+	}
+	if in.Percent != nil {
+		out.ForceSendFields = []string{"Percent"}
+	}
+	return out
+}
+
+// qualify prefixes the supplied name, unless it is already a URL.
+func qualify(name, prefix string) string {
+	if strings.Contains(name, "/") {
+		return name
+	}
+	return prefix + name
+}
+
+// GenerateRegionInstanceGroupManagerObservation creates a
+// RegionInstanceGroupManagerObservation from the supplied
+// compute.InstanceGroupManager.
+func GenerateRegionInstanceGroupManagerObservation(in compute.InstanceGroupManager) v1alpha1.RegionInstanceGroupManagerObservation {
+	o := v1alpha1.RegionInstanceGroupManagerObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		InstanceGroup:     in.InstanceGroup,
+		Fingerprint:       in.Fingerprint,
+	}
+	if in.Status != nil {
+		o.IsStable = in.Status.IsStable
+		o.Autoscaler = in.Status.Autoscaler
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// compute.InstanceGroupManager.
+func LateInitializeSpec(spec *v1alpha1.RegionInstanceGroupManagerParameters, in compute.InstanceGroupManager) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.TargetSize = gcp.LateInitializeInt64(spec.TargetSize, in.TargetSize)
+
+	for i := range spec.AutoHealingPolicies {
+		if i >= len(in.AutoHealingPolicies) || in.AutoHealingPolicies[i] == nil {
+			break
+		}
+		p := &spec.AutoHealingPolicies[i]
+		p.InitialDelaySec = gcp.LateInitializeInt64(p.InitialDelaySec, in.AutoHealingPolicies[i].InitialDelaySec)
+	}
+
+	if spec.DistributionPolicy == nil && in.DistributionPolicy != nil && len(in.DistributionPolicy.Zones) > 0 {
+		spec.DistributionPolicy = &v1alpha1.DistributionPolicy{}
+		for _, z := range in.DistributionPolicy.Zones {
+			spec.DistributionPolicy.Zones = append(spec.DistributionPolicy.Zones, path.Base(z.Zone))
+		}
+	}
+
+	if in.UpdatePolicy == nil {
+		return
+	}
+	if spec.UpdatePolicy == nil {
+		spec.UpdatePolicy = &v1alpha1.UpdatePolicy{}
+	}
+	u := spec.UpdatePolicy
+	u.Type = gcp.LateInitializeString(u.Type, in.UpdatePolicy.Type)
+	u.MinimalAction = gcp.LateInitializeString(u.MinimalAction, in.UpdatePolicy.MinimalAction)
+	u.InstanceRedistributionType = gcp.LateInitializeString(u.InstanceRedistributionType, in.UpdatePolicy.InstanceRedistributionType)
+	if u.MaxSurge == nil {
+		u.MaxSurge = lateInitializeFixedOrPercent(in.UpdatePolicy.MaxSurge)
+	}
+	if u.MaxUnavailable == nil {
+		u.MaxUnavailable = lateInitializeFixedOrPercent(in.UpdatePolicy.MaxUnavailable)
+	}
+}
+
+// lateInitializeFixedOrPercent returns the supplied number of instances as it
+// was specified; as a percentage if it has one, and as a fixed number
+// otherwise. The API reports the number a percentage amounts to as a
+// calculated number, which is not part of the spec.
+func lateInitializeFixedOrPercent(in *compute.FixedOrPercent) *v1alpha1.FixedOrPercent {
+	if in == nil {
+		return nil
+	}
+	if in.Percent != 0 {
+		return &v1alpha1.FixedOrPercent{Percent: gcp.Int64Ptr(in.Percent)}
+	}
+	return &v1alpha1.FixedOrPercent{Fixed: gcp.Int64Ptr(in.Fixed)}
+}
+
+// IsUpToDate checks whether the observed compute.InstanceGroupManager matches
+// the supplied RegionInstanceGroupManagerParameters. Only the instance
+// template, target size, autohealing policies and update policy are compared;
+// other fields cannot be updated in place. The target size of a group that is
+// scaled by an autoscaler is not compared, because the autoscaler changes it.
+func IsUpToDate(in v1alpha1.RegionInstanceGroupManagerParameters, observed *compute.InstanceGroupManager) bool {
+	if !cmp.Equal(in.InstanceTemplate, observed.InstanceTemplate, gcp.EquateComputeURLs()) {
+		return false
+	}
+	autoscaled := observed.Status != nil && observed.Status.Autoscaler != ""
+	if in.TargetSize != nil && !autoscaled && *in.TargetSize != observed.TargetSize {
+		return false
+	}
+	if !cmp.Equal(GenerateAutoHealingPolicies(in.AutoHealingPolicies), observed.AutoHealingPolicies,
+		cmpopts.EquateEmpty(),
+		gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.InstanceGroupManagerAutoHealingPolicy{}, "ForceSendFields", "NullFields"),
+	) {
+		return false
+	}
+	if in.UpdatePolicy == nil {
+		return true
+	}
+	return cmp.Equal(GenerateUpdatePolicy(in.UpdatePolicy), observed.UpdatePolicy,
+		cmpopts.IgnoreFields(compute.InstanceGroupManagerUpdatePolicy{}, "ReplacementMethod", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.FixedOrPercent{}, "Calculated", "ForceSendFields", "NullFields"),
+	)
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancegroupmanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject     = "some-project"
+	testName        = "some-group"
+	testTemplate    = "projects/some-project/global/instanceTemplates/some-template"
+	testHealthCheck = "projects/some-project/global/healthChecks/some-check"
+)
+
+func params(m ...func(*v1alpha1.RegionInstanceGroupManagerParameters)) *v1alpha1.RegionInstanceGroupManagerParameters {
+	p := &v1alpha1.RegionInstanceGroupManagerParameters{
+		Region:           "us-central1",
+		Description:      gcp.StringPtr("desc"),
+		BaseInstanceName: "some",
+		InstanceTemplate: testTemplate,
+		TargetSize:       gcp.Int64Ptr(3),
+		NamedPorts:       []v1alpha1.NamedPort{{Name: "http", Port: 80}},
+		AutoHealingPolicies: []v1alpha1.AutoHealingPolicy{{
+			HealthCheck:     testHealthCheck,
+			InitialDelaySec: gcp.Int64Ptr(300),
+		}},
+		DistributionPolicy: &v1alpha1.DistributionPolicy{Zones: []string{"us-central1-a", "us-central1-b"}},
+		UpdatePolicy: &v1alpha1.UpdatePolicy{
+			Type:                       gcp.StringPtr("PROACTIVE"),
+			MinimalAction:              gcp.StringPtr("REPLACE"),
+			InstanceRedistributionType: gcp.StringPtr("PROACTIVE"),
+			MaxSurge:                   &v1alpha1.FixedOrPercent{Fixed: gcp.Int64Ptr(3)},
+			MaxUnavailable:             &v1alpha1.FixedOrPercent{Percent: gcp.Int64Ptr(20)},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func manager(m ...func(*compute.InstanceGroupManager)) *compute.InstanceGroupManager {
+	g := &compute.InstanceGroupManager{
+		Name:             testName,
+		Description:      "desc",
+		BaseInstanceName: "some",
+		InstanceTemplate: testTemplate,
+		TargetSize:       3,
+		NamedPorts:       []*compute.NamedPort{{Name: "http", Port: 80}},
+		AutoHealingPolicies: []*compute.InstanceGroupManagerAutoHealingPolicy{{
+			HealthCheck:     testHealthCheck,
+			InitialDelaySec: 300,
+		}},
+		DistributionPolicy: &compute.DistributionPolicy{Zones: []*compute.DistributionPolicyZoneConfiguration{
+			{Zone: "projects/some-project/zones/us-central1-a"},
+			{Zone: "projects/some-project/zones/us-central1-b"},
+		}},
+		UpdatePolicy: &compute.InstanceGroupManagerUpdatePolicy{
+			Type:                       "PROACTIVE",
+			MinimalAction:              "REPLACE",
+			InstanceRedistributionType: "PROACTIVE",
+			MaxSurge:                   &compute.FixedOrPercent{Fixed: 3, ForceSendFields: []string{"Fixed"}},
+			MaxUnavailable:             &compute.FixedOrPercent{Percent: 20, ForceSendFields: []string{"Percent"}},
+		},
+		ForceSendFields: []string{"TargetSize"},
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+// observed returns the supplied manager as the API reports it; with URLs and
+// calculated numbers of instances.
+func observed(g *compute.InstanceGroupManager) *compute.InstanceGroupManager {
+	g.ForceSendFields = nil
+	g.InstanceTemplate = "https://www.googleapis.com/compute/v1/" + testTemplate
+	g.DistributionPolicy.Zones[0].Zone = "https://www.googleapis.com/compute/v1/projects/some-project/zones/us-central1-a"
+	g.DistributionPolicy.Zones[1].Zone = "https://www.googleapis.com/compute/v1/projects/some-project/zones/us-central1-b"
+	g.UpdatePolicy.MaxSurge = &compute.FixedOrPercent{Fixed: 3, Calculated: 3}
+	g.UpdatePolicy.MaxUnavailable = &compute.FixedOrPercent{Percent: 20, Calculated: 1}
+	g.UpdatePolicy.ReplacementMethod = "SUBSTITUTE"
+	g.Status = &compute.InstanceGroupManagerStatus{IsStable: true}
+	return g
+}
+
+func TestGenerateRegionInstanceGroupManager(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.RegionInstanceGroupManagerParameters
+		want *compute.InstanceGroupManager
+	}{
+		"Full": {
+			in:   *params(),
+			want: manager(),
+		},
+		"Minimal": {
+			in: v1alpha1.RegionInstanceGroupManagerParameters{
+				Region:           "us-central1",
+				BaseInstanceName: "some",
+				InstanceTemplate: testTemplate,
+			},
+			want: &compute.InstanceGroupManager{
+				Name:             testName,
+				BaseInstanceName: "some",
+				InstanceTemplate: testTemplate,
+			},
+		},
+		"ZeroTargetSize": {
+			in: *params(func(p *v1alpha1.RegionInstanceGroupManagerParameters) {
+				p.TargetSize = gcp.Int64Ptr(0)
+			}),
+			want: manager(func(g *compute.InstanceGroupManager) {
+				g.TargetSize = 0
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRegionInstanceGroupManager(testProject, testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateRegionInstanceGroupManager(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.RegionInstanceGroupManagerParameters
+		observed compute.InstanceGroupManager
+		want     *v1alpha1.RegionInstanceGroupManagerParameters
+	}{
+		"FillsDefaults": {
+			spec: params(func(p *v1alpha1.RegionInstanceGroupManagerParameters) {
+				p.Description = nil
+				p.TargetSize = nil
+				p.AutoHealingPolicies[0].InitialDelaySec = nil
+				p.DistributionPolicy = nil
+				p.UpdatePolicy = nil
+			}),
+			observed: *observed(manager()),
+			want:     params(),
+		},
+		"KeepsSpec": {
+			spec: params(),
+			observed: *observed(manager(func(g *compute.InstanceGroupManager) {
+				g.TargetSize = 5
+				g.UpdatePolicy.MinimalAction = "RESTART"
+			})),
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.RegionInstanceGroupManagerParameters
+		observed *compute.InstanceGroupManager
+		want     bool
+	}{
+		"UpToDate": {
+			in:       *params(),
+			observed: observed(manager()),
+			want:     true,
+		},
+		"TemplateChanged": {
+			in: *params(func(p *v1alpha1.RegionInstanceGroupManagerParameters) {
+				p.InstanceTemplate = "projects/some-project/global/instanceTemplates/other"
+			}),
+			observed: observed(manager()),
+			want:     false,
+		},
+		"Resized": {
+			in: *params(func(p *v1alpha1.RegionInstanceGroupManagerParameters) {
+				p.TargetSize = gcp.Int64Ptr(5)
+			}),
+			observed: observed(manager()),
+			want:     false,
+		},
+		"Autoscaled": {
+			in: *params(func(p *v1alpha1.RegionInstanceGroupManagerParameters) {
+				p.TargetSize = gcp.Int64Ptr(5)
+			}),
+			observed: func() *compute.InstanceGroupManager {
+				g := observed(manager())
+				g.Status.Autoscaler = "some-autoscaler"
+				return g
+			}(),
+			want: true,
+		},
+		"AutoHealingRemoved": {
+			in: *params(func(p *v1alpha1.RegionInstanceGroupManagerParameters) {
+				p.AutoHealingPolicies = nil
+			}),
+			observed: observed(manager()),
+			want:     false,
+		},
+		"UpdatePolicyChanged": {
+			in: *params(func(p *v1alpha1.RegionInstanceGroupManagerParameters) {
+				p.UpdatePolicy.MaxUnavailable = &v1alpha1.FixedOrPercent{Fixed: gcp.Int64Ptr(0)}
+			}),
+			observed: observed(manager()),
+			want:     false,
+		},
+		"NoUpdatePolicy": {
+			in: *params(func(p *v1alpha1.RegionInstanceGroupManagerParameters) {
+				p.UpdatePolicy = nil
+			}),
+			observed: observed(manager()),
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interconnectattachment
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateInterconnectAttachment creates a *compute.InterconnectAttachment
+// from the supplied InterconnectAttachmentParameters.
+func GenerateInterconnectAttachment(name string, in v1alpha1.InterconnectAttachmentParameters) *compute.InterconnectAttachment {
+	ia := &compute.InterconnectAttachment{
+		Name:                   name,
+		Description:            gcp.StringValue(in.Description),
+		Region:                 in.Region,
+		Type:                   gcp.StringValue(in.Type),
+		Router:                 in.Router,
+		Interconnect:           gcp.StringValue(in.Interconnect),
+		Bandwidth:              gcp.StringValue(in.Bandwidth),
+		VlanTag8021q:           gcp.Int64Value(in.VlanTag8021q),
+		CandidateSubnets:       in.CandidateSubnets,
+		EdgeAvailabilityDomain: gcp.StringValue(in.EdgeAvailabilityDomain),
+		AdminEnabled:           gcp.BoolValue(in.AdminEnabled),
+	}
+	if in.AdminEnabled != nil {
+		ia.ForceSendFields = []string{"AdminEnabled"}
+	}
+	return ia
+}
+
+// GenerateInterconnectAttachmentUpdate creates a *compute.InterconnectAttachment
+// that patches the fields of an attachment that may be updated in place.
+func GenerateInterconnectAttachmentUpdate(in v1alpha1.InterconnectAttachmentParameters) *compute.InterconnectAttachment {
+	ia := &compute.InterconnectAttachment{
+		Description:  gcp.StringValue(in.Description),
+		Bandwidth:    gcp.StringValue(in.Bandwidth),
+		AdminEnabled: gcp.BoolValue(in.AdminEnabled),
+	}
+	if in.AdminEnabled != nil {
+		ia.ForceSendFields = []string{"AdminEnabled"}
+	}
+	return ia
+}
+
+// GenerateInterconnectAttachmentObservation creates an
+// InterconnectAttachmentObservation from the supplied
+// compute.InterconnectAttachment.
+func GenerateInterconnectAttachmentObservation(in compute.InterconnectAttachment) v1alpha1.InterconnectAttachmentObservation {
+	return v1alpha1.InterconnectAttachmentObservation{
+		CreationTimestamp:       gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                      in.Id,
+		SelfLink:                in.SelfLink,
+		State:                   in.State,
+		OperationalStatus:       in.OperationalStatus,
+		PairingKey:              in.PairingKey,
+		CloudRouterIPAddress:    in.CloudRouterIpAddress,
+		CustomerRouterIPAddress: in.CustomerRouterIpAddress,
+		GoogleReferenceID:       in.GoogleReferenceId,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// compute.InterconnectAttachment.
+func LateInitializeSpec(spec *v1alpha1.InterconnectAttachmentParameters, in compute.InterconnectAttachment) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Type = gcp.LateInitializeString(spec.Type, in.Type)
+	spec.Interconnect = gcp.LateInitializeString(spec.Interconnect, in.Interconnect)
+	spec.Bandwidth = gcp.LateInitializeString(spec.Bandwidth, in.Bandwidth)
+	spec.VlanTag8021q = gcp.LateInitializeInt64(spec.VlanTag8021q, in.VlanTag8021q)
+	spec.CandidateSubnets = gcp.LateInitializeStringSlice(spec.CandidateSubnets, in.CandidateSubnets)
+	spec.EdgeAvailabilityDomain = gcp.LateInitializeString(spec.EdgeAvailabilityDomain, in.EdgeAvailabilityDomain)
+	if spec.AdminEnabled == nil {
+		spec.AdminEnabled = gcp.BoolPtr(in.AdminEnabled)
+	}
+}
+
+// IsUpToDate checks whether the observed compute.InterconnectAttachment
+// matches the supplied InterconnectAttachmentParameters. Only the fields that
+// may be updated in place are considered.
+func IsUpToDate(in v1alpha1.InterconnectAttachmentParameters, observed *compute.InterconnectAttachment) bool {
+	desired := GenerateInterconnectAttachmentUpdate(in)
+	current := &compute.InterconnectAttachment{
+		Description:  observed.Description,
+		Bandwidth:    observed.Bandwidth,
+		AdminEnabled: observed.AdminEnabled,
+	}
+	return cmp.Equal(desired, current, cmpopts.IgnoreFields(compute.InterconnectAttachment{}, "ForceSendFields"))
+}
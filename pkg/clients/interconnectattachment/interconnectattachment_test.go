@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package interconnectattachment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "some-attachment"
+	testRegion = "us-central1"
+)
+
+func params(m ...func(*v1alpha1.InterconnectAttachmentParameters)) *v1alpha1.InterconnectAttachmentParameters {
+	p := &v1alpha1.InterconnectAttachmentParameters{
+		Region:                 testRegion,
+		Description:            gcp.StringPtr("desc"),
+		Type:                   gcp.StringPtr("PARTNER"),
+		Router:                 "regions/us-central1/routers/router",
+		Bandwidth:              gcp.StringPtr("BPS_1G"),
+		EdgeAvailabilityDomain: gcp.StringPtr("AVAILABILITY_DOMAIN_1"),
+		AdminEnabled:           gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func attachment(m ...func(*compute.InterconnectAttachment)) *compute.InterconnectAttachment {
+	ia := &compute.InterconnectAttachment{
+		Name:                   testName,
+		Region:                 testRegion,
+		Description:            "desc",
+		Type:                   "PARTNER",
+		Router:                 "regions/us-central1/routers/router",
+		Bandwidth:              "BPS_1G",
+		EdgeAvailabilityDomain: "AVAILABILITY_DOMAIN_1",
+		AdminEnabled:           true,
+	}
+	for _, f := range m {
+		f(ia)
+	}
+	return ia
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.InterconnectAttachmentParameters
+		in   compute.InterconnectAttachment
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.InterconnectAttachmentParameters
+	}{
+		"AllFilledAlready": {
+			args: args{
+				spec: params(),
+				in: *attachment(func(ia *compute.InterconnectAttachment) {
+					ia.Bandwidth = "BPS_10G"
+				}),
+			},
+			want: params(),
+		},
+		"PartiallyFilled": {
+			args: args{
+				spec: params(func(p *v1alpha1.InterconnectAttachmentParameters) {
+					p.Bandwidth = nil
+					p.AdminEnabled = nil
+				}),
+				in: *attachment(func(ia *compute.InterconnectAttachment) {
+					ia.VlanTag8021q = 100
+				}),
+			},
+			want: params(func(p *v1alpha1.InterconnectAttachmentParameters) {
+				p.VlanTag8021q = gcp.Int64Ptr(100)
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.InterconnectAttachmentParameters
+		obs  *compute.InterconnectAttachment
+		want bool
+	}{
+		"UpToDate": {
+			in:   params(),
+			obs:  attachment(),
+			want: true,
+		},
+		"IgnoresImmutableFields": {
+			in: params(),
+			obs: attachment(func(ia *compute.InterconnectAttachment) {
+				ia.VlanTag8021q = 100
+				ia.PairingKey = "key"
+			}),
+			want: true,
+		},
+		"BandwidthChanged": {
+			in:   params(func(p *v1alpha1.InterconnectAttachmentParameters) { p.Bandwidth = gcp.StringPtr("BPS_2G") }),
+			obs:  attachment(),
+			want: false,
+		},
+		"AdminDisabled": {
+			in:   params(func(p *v1alpha1.InterconnectAttachmentParameters) { p.AdminEnabled = gcp.BoolPtr(false) }),
+			obs:  attachment(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(*tc.in, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
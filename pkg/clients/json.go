@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+)
+
+// A JSONClient calls a GCP JSON REST API directly, on top of the same
+// authenticated transport as the generated Google API clients. It is used
+// for the APIs and methods the client libraries used by this provider do not
+// support.
+type JSONClient struct {
+	client   *http.Client
+	basePath string
+}
+
+// NewJSONClient returns a JSONClient that calls the API served at the
+// supplied base path, unless the supplied options configure another
+// endpoint.
+func NewJSONClient(ctx context.Context, basePath string, opts ...option.ClientOption) (*JSONClient, error) {
+	opts = append([]option.ClientOption{option.WithEndpoint(basePath)}, opts...)
+	c, endpoint, err := htransport.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONClient{client: c, basePath: endpoint}, nil
+}
+
+// BasePath returns the base path the JSONClient resolves request paths
+// against.
+func (c *JSONClient) BasePath() string {
+	return c.basePath
+}
+
+// WithBasePath returns a copy of the JSONClient that resolves request paths
+// against the supplied base path instead, for example that of a regional
+// endpoint.
+func (c *JSONClient) WithBasePath(basePath string) *JSONClient {
+	return &JSONClient{client: c.client, basePath: basePath}
+}
+
+// Do sends a request with the supplied method, query and JSON encoded body
+// for the supplied path, relative to the client's base path, and decodes the
+// response into out. Errors are returned as *googleapi.Error, like those of
+// the generated Google API clients.
+func (c *JSONClient) Do(ctx context.Context, method, path string, q url.Values, in, out interface{}) error {
+	var body bytes.Buffer
+	if in != nil {
+		if err := json.NewEncoder(&body).Encode(in); err != nil {
+			return err
+		}
+	}
+	u := googleapi.ResolveRelative(c.basePath, path)
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+	req, err := http.NewRequest(method, u, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	rsp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer googleapi.CloseBody(rsp)
+	if err := googleapi.CheckResponse(rsp); err != nil {
+		return err
+	}
+	return json.NewDecoder(rsp.Body).Decode(out)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+type jsonThing struct {
+	Name string `json:"name,omitempty"`
+}
+
+func TestJSONClientDo(t *testing.T) {
+	type request struct {
+		method string
+		path   string
+		query  string
+		body   *jsonThing
+	}
+	type want struct {
+		req    request
+		out    *jsonThing
+		status int
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		method string
+		path   string
+		q      url.Values
+		in     interface{}
+		want   want
+	}{
+		"Get": {
+			reason: "A request without a body should be sent and its response decoded.",
+			status: http.StatusOK,
+			method: http.MethodGet,
+			path:   "v1/things/cool",
+			want: want{
+				req: request{method: http.MethodGet, path: "/v1/things/cool"},
+				out: &jsonThing{Name: "things/cool"},
+			},
+		},
+		"Post": {
+			reason: "A request's query and JSON encoded body should be sent.",
+			status: http.StatusOK,
+			method: http.MethodPost,
+			path:   "v1/things",
+			q:      url.Values{"thingId": {"cool"}},
+			in:     &jsonThing{Name: "cool"},
+			want: want{
+				req: request{method: http.MethodPost, path: "/v1/things", query: "thingId=cool", body: &jsonThing{Name: "cool"}},
+				out: &jsonThing{Name: "things/cool"},
+			},
+		},
+		"Error": {
+			reason: "An error response should be returned as a *googleapi.Error.",
+			status: http.StatusNotFound,
+			method: http.MethodGet,
+			path:   "v1/things/cool",
+			want: want{
+				req:    request{method: http.MethodGet, path: "/v1/things/cool"},
+				out:    &jsonThing{},
+				status: http.StatusNotFound,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = request{method: r.Method, path: r.URL.Path, query: r.URL.RawQuery}
+				if r.ContentLength > 0 {
+					got.body = &jsonThing{}
+					if err := json.NewDecoder(r.Body).Decode(got.body); err != nil {
+						t.Errorf("cannot decode request body: %s", err)
+					}
+				}
+				_ = r.Body.Close()
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(`{"name": "things/cool"}`))
+			}))
+			defer server.Close()
+
+			c, err := NewJSONClient(context.Background(), server.URL+"/", option.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("NewJSONClient(...): %s", err)
+			}
+
+			out := &jsonThing{}
+			err = c.Do(context.Background(), tc.method, tc.path, tc.q, tc.in, out)
+			status := 0
+			if gerr, ok := err.(*googleapi.Error); ok {
+				status = gerr.Code
+			} else if err != nil {
+				t.Fatalf("\n%s\nc.Do(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("\n%s\nc.Do(...): -want status, +got status:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.req, got, cmp.AllowUnexported(request{})); diff != "" {
+				t.Errorf("\n%s\nc.Do(...): -want request, +got request:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, out); diff != "" {
+				t.Errorf("\n%s\nc.Do(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestJSONClientWithBasePath(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Path
+		_ = r.Body.Close()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c, err := NewJSONClient(context.Background(), "https://example.org/", option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("NewJSONClient(...): %s", err)
+	}
+	if diff := cmp.Diff("https://example.org/", c.BasePath()); diff != "" {
+		t.Errorf("c.BasePath(): -want, +got:\n%s", diff)
+	}
+
+	if err := c.WithBasePath(server.URL+"/regional/").Do(context.Background(), http.MethodGet, "v1/things/cool", nil, nil, &jsonThing{}); err != nil {
+		t.Fatalf("c.WithBasePath(...).Do(...): %s", err)
+	}
+	if diff := cmp.Diff("/regional/v1/things/cool", got); diff != "" {
+		t.Errorf("c.WithBasePath(...).Do(...): -want path, +got path:\n%s", diff)
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+
+	"github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Fields of a crypto key that are updated in place.
+const (
+	fieldRotationPeriod   = "rotationPeriod"
+	fieldNextRotationTime = "nextRotationTime"
+	fieldLabels           = "labels"
+)
+
+// CryptoKeyName returns the resource name of the supplied crypto key of the
+// supplied key ring.
+func CryptoKeyName(keyRing, cryptoKey string) string {
+	return keyRing + "/cryptoKeys/" + cryptoKey
+}
+
+// GenerateCryptoKey takes CryptoKeyParameters and returns a CryptoKey.
+func GenerateCryptoKey(in v1alpha1.CryptoKeyParameters) *kmsv1.CryptoKey {
+	ck := &kmsv1.CryptoKey{
+		Purpose:          gcp.StringValue(in.Purpose),
+		RotationPeriod:   gcp.StringValue(in.RotationPeriod),
+		NextRotationTime: gcp.StringValue(in.NextRotationTime),
+		Labels:           in.Labels,
+	}
+	if in.VersionTemplate != nil {
+		ck.VersionTemplate = &kmsv1.CryptoKeyVersionTemplate{
+			Algorithm:       in.VersionTemplate.Algorithm,
+			ProtectionLevel: gcp.StringValue(in.VersionTemplate.ProtectionLevel),
+		}
+	}
+	return ck
+}
+
+// GenerateCryptoKeyObservation takes a CryptoKey and returns a
+// CryptoKeyObservation.
+func GenerateCryptoKeyObservation(in kmsv1.CryptoKey) v1alpha1.CryptoKeyObservation {
+	o := v1alpha1.CryptoKeyObservation{
+		Name:       in.Name,
+		CreateTime: gcp.TimeFromRFC3339(in.CreateTime),
+	}
+	if in.Primary != nil {
+		o.PrimaryVersion = in.Primary.Name
+		o.PrimaryVersionState = in.Primary.State
+	}
+	return o
+}
+
+// LateInitializeCryptoKey fills the empty fields of the supplied
+// CryptoKeyParameters with those of the supplied CryptoKey.
+func LateInitializeCryptoKey(spec *v1alpha1.CryptoKeyParameters, in kmsv1.CryptoKey) {
+	spec.Purpose = gcp.LateInitializeString(spec.Purpose, in.Purpose)
+	spec.RotationPeriod = gcp.LateInitializeString(spec.RotationPeriod, in.RotationPeriod)
+	spec.NextRotationTime = gcp.LateInitializeString(spec.NextRotationTime, in.NextRotationTime)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	if in.VersionTemplate == nil {
+		return
+	}
+	if spec.VersionTemplate == nil {
+		spec.VersionTemplate = &v1alpha1.CryptoKeyVersionTemplate{Algorithm: in.VersionTemplate.Algorithm}
+	}
+	spec.VersionTemplate.ProtectionLevel = gcp.LateInitializeString(spec.VersionTemplate.ProtectionLevel, in.VersionTemplate.ProtectionLevel)
+}
+
+// IsCryptoKeyUpToDate returns true if the rotation period, next rotation time
+// and labels of the supplied CryptoKey match the supplied
+// CryptoKeyParameters. A next rotation time that is not after the supplied
+// time is not compared, because the key has since been rotated and its next
+// rotation time has advanced.
+func IsCryptoKeyUpToDate(in v1alpha1.CryptoKeyParameters, observed kmsv1.CryptoKey, now time.Time) bool {
+	return UpdateMask(in, observed, now) == ""
+}
+
+// UpdateMask returns the comma separated fields of the supplied CryptoKey
+// that differ from the supplied CryptoKeyParameters, or an empty string if it
+// is up to date.
+func UpdateMask(in v1alpha1.CryptoKeyParameters, observed kmsv1.CryptoKey, now time.Time) string {
+	var fields []string
+	if in.RotationPeriod != nil && !equalDurations(*in.RotationPeriod, observed.RotationPeriod) {
+		fields = append(fields, fieldRotationPeriod)
+	}
+	if in.NextRotationTime != nil && !equalTimes(*in.NextRotationTime, observed.NextRotationTime, now) {
+		fields = append(fields, fieldNextRotationTime)
+	}
+	if !cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty()) {
+		fields = append(fields, fieldLabels)
+	}
+	return strings.Join(fields, ",")
+}
+
+// equalDurations returns true if the supplied durations are equal. KMS
+// formats durations as seconds with an s suffix, but accepts fractions of a
+// second.
+func equalDurations(desired, observed string) bool {
+	d, err := time.ParseDuration(desired)
+	if err != nil {
+		return desired == observed
+	}
+	o, err := time.ParseDuration(observed)
+	return err == nil && d == o
+}
+
+// equalTimes returns true if the supplied desired time has passed, or if it is
+// the same time as the supplied observed time.
+func equalTimes(desired, observed string, now time.Time) bool {
+	d, err := time.Parse(time.RFC3339, desired)
+	if err != nil {
+		return desired == observed
+	}
+	if !d.After(now) {
+		return true
+	}
+	o, err := time.Parse(time.RFC3339, observed)
+	return err == nil && d.Equal(o)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+
+	"github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testCryptoKey = testKeyRing + "/cryptoKeys/envelope"
+	testPeriod    = "7776000s"
+	testNext      = "2020-07-01T00:00:00Z"
+)
+
+var testNow = time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func TestGenerateCryptoKey(t *testing.T) {
+	in := v1alpha1.CryptoKeyParameters{
+		KeyRing:          gcp.StringPtr(testKeyRing),
+		Purpose:          gcp.StringPtr("ENCRYPT_DECRYPT"),
+		RotationPeriod:   gcp.StringPtr(testPeriod),
+		NextRotationTime: gcp.StringPtr(testNext),
+		Labels:           map[string]string{"team": "payments"},
+		VersionTemplate:  &v1alpha1.CryptoKeyVersionTemplate{Algorithm: "GOOGLE_SYMMETRIC_ENCRYPTION", ProtectionLevel: gcp.StringPtr("HSM")},
+	}
+	want := &kmsv1.CryptoKey{
+		Purpose:          "ENCRYPT_DECRYPT",
+		RotationPeriod:   testPeriod,
+		NextRotationTime: testNext,
+		Labels:           map[string]string{"team": "payments"},
+		VersionTemplate:  &kmsv1.CryptoKeyVersionTemplate{Algorithm: "GOOGLE_SYMMETRIC_ENCRYPTION", ProtectionLevel: "HSM"},
+	}
+	if diff := cmp.Diff(want, GenerateCryptoKey(in)); diff != "" {
+		t.Errorf("GenerateCryptoKey(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateCryptoKeyObservation(t *testing.T) {
+	in := kmsv1.CryptoKey{
+		Name:       testCryptoKey,
+		CreateTime: "2020-06-01T10:00:00Z",
+		Primary:    &kmsv1.CryptoKeyVersion{Name: testCryptoKey + "/cryptoKeyVersions/1", State: "ENABLED"},
+	}
+	want := v1alpha1.CryptoKeyObservation{
+		Name:                testCryptoKey,
+		CreateTime:          gcp.TimeFromRFC3339("2020-06-01T10:00:00Z"),
+		PrimaryVersion:      testCryptoKey + "/cryptoKeyVersions/1",
+		PrimaryVersionState: "ENABLED",
+	}
+	if diff := cmp.Diff(want, GenerateCryptoKeyObservation(in)); diff != "" {
+		t.Errorf("GenerateCryptoKeyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeCryptoKey(t *testing.T) {
+	spec := v1alpha1.CryptoKeyParameters{KeyRing: gcp.StringPtr(testKeyRing)}
+	observed := kmsv1.CryptoKey{
+		Purpose:          "ENCRYPT_DECRYPT",
+		RotationPeriod:   testPeriod,
+		NextRotationTime: testNext,
+		VersionTemplate:  &kmsv1.CryptoKeyVersionTemplate{Algorithm: "GOOGLE_SYMMETRIC_ENCRYPTION", ProtectionLevel: "SOFTWARE"},
+	}
+	want := v1alpha1.CryptoKeyParameters{
+		KeyRing:          gcp.StringPtr(testKeyRing),
+		Purpose:          gcp.StringPtr("ENCRYPT_DECRYPT"),
+		RotationPeriod:   gcp.StringPtr(testPeriod),
+		NextRotationTime: gcp.StringPtr(testNext),
+		VersionTemplate:  &v1alpha1.CryptoKeyVersionTemplate{Algorithm: "GOOGLE_SYMMETRIC_ENCRYPTION", ProtectionLevel: gcp.StringPtr("SOFTWARE")},
+	}
+
+	LateInitializeCryptoKey(&spec, observed)
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeCryptoKey(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdateMask(t *testing.T) {
+	observed := kmsv1.CryptoKey{
+		RotationPeriod:   testPeriod,
+		NextRotationTime: testNext,
+		Labels:           map[string]string{"team": "payments"},
+	}
+
+	cases := map[string]struct {
+		in   v1alpha1.CryptoKeyParameters
+		want string
+	}{
+		"UpToDate": {
+			in: v1alpha1.CryptoKeyParameters{
+				RotationPeriod:   gcp.StringPtr("2160h"),
+				NextRotationTime: gcp.StringPtr("2020-07-01T00:00:00.000Z"),
+				Labels:           map[string]string{"team": "payments"},
+			},
+			want: "",
+		},
+		"RotationChanged": {
+			in: v1alpha1.CryptoKeyParameters{
+				RotationPeriod:   gcp.StringPtr("86400s"),
+				NextRotationTime: gcp.StringPtr("2020-06-02T00:00:00Z"),
+				Labels:           map[string]string{"team": "payments"},
+			},
+			want: "rotationPeriod,nextRotationTime",
+		},
+		"NextRotationTimePassed": {
+			in: v1alpha1.CryptoKeyParameters{
+				RotationPeriod:   gcp.StringPtr(testPeriod),
+				NextRotationTime: gcp.StringPtr("2020-04-01T00:00:00Z"),
+				Labels:           map[string]string{"team": "payments"},
+			},
+			want: "",
+		},
+		"LabelsChanged": {
+			in:   v1alpha1.CryptoKeyParameters{Labels: map[string]string{"team": "billing"}},
+			want: "labels",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := UpdateMask(tc.in, observed, testNow)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("UpdateMask(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want == "", IsCryptoKeyUpToDate(tc.in, observed, testNow)); diff != "" {
+				t.Errorf("IsCryptoKeyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
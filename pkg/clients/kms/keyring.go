@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kms contains utilities to convert between Cloud KMS resources and
+// managed resources.
+package kms
+
+import (
+	"fmt"
+
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+
+	"github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// KeyRingParent returns the resource name of the parent of the key rings of
+// the supplied project and location.
+func KeyRingParent(project, location string) string {
+	return fmt.Sprintf("projects/%s/locations/%s", project, location)
+}
+
+// KeyRingName returns the resource name of the supplied key ring.
+func KeyRingName(project, location, keyRing string) string {
+	return KeyRingParent(project, location) + "/keyRings/" + keyRing
+}
+
+// GenerateKeyRingObservation takes a KeyRing and returns a
+// KeyRingObservation.
+func GenerateKeyRingObservation(in kmsv1.KeyRing) v1alpha1.KeyRingObservation {
+	return v1alpha1.KeyRingObservation{
+		Name:       in.Name,
+		CreateTime: gcp.TimeFromRFC3339(in.CreateTime),
+	}
+}
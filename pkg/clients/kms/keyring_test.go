@@ -0,0 +1,47 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+
+	"github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject  = "my-project"
+	testLocation = "global"
+	testKeyRing  = "projects/" + testProject + "/locations/" + testLocation + "/keyRings/secrets"
+)
+
+func TestKeyRingName(t *testing.T) {
+	if diff := cmp.Diff(testKeyRing, KeyRingName(testProject, testLocation, "secrets")); diff != "" {
+		t.Errorf("KeyRingName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateKeyRingObservation(t *testing.T) {
+	in := kmsv1.KeyRing{Name: testKeyRing, CreateTime: "2020-06-01T10:00:00Z"}
+	want := v1alpha1.KeyRingObservation{Name: testKeyRing, CreateTime: gcp.TimeFromRFC3339("2020-06-01T10:00:00Z")}
+	if diff := cmp.Diff(want, GenerateKeyRingObservation(in)); diff != "" {
+		t.Errorf("GenerateKeyRingObservation(...): -want, +got:\n%s", diff)
+	}
+}
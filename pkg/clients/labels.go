@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+// A LabelUpdater records changes to the labels of a GCP resource. The
+// storage.BucketAttrsToUpdate type satisfies this interface.
+type LabelUpdater interface {
+	SetLabel(name, value string)
+	DeleteLabel(name string)
+}
+
+// LabelsUpToDate returns true if the observed labels of a GCP resource match
+// the desired labels. Nil and empty label maps are considered equal.
+func LabelsUpToDate(desired, observed map[string]string) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	for k, v := range desired {
+		if ov, ok := observed[k]; !ok || ov != v {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateLabels records the changes required to make the observed labels
+// match the desired labels on the supplied LabelUpdater. Labels that are
+// missing or have a different value are set, and observed labels that are
+// not desired are deleted. Neither map is modified.
+func UpdateLabels(u LabelUpdater, desired, observed map[string]string) {
+	for k, v := range desired {
+		if ov, ok := observed[k]; !ok || ov != v {
+			u.SetLabel(k, v)
+		}
+	}
+	for k := range observed {
+		if _, ok := desired[k]; !ok {
+			u.DeleteLabel(k)
+		}
+	}
+}
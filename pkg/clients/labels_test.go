@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type labelUpdater struct {
+	set    map[string]string
+	delete []string
+}
+
+func (u *labelUpdater) SetLabel(name, value string) {
+	if u.set == nil {
+		u.set = map[string]string{}
+	}
+	u.set[name] = value
+}
+
+func (u *labelUpdater) DeleteLabel(name string) {
+	u.delete = append(u.delete, name)
+}
+
+func TestLabelsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  map[string]string
+		observed map[string]string
+		want     bool
+	}{
+		"BothNil": {
+			want: true,
+		},
+		"NilAndEmpty": {
+			desired:  nil,
+			observed: map[string]string{},
+			want:     true,
+		},
+		"Equal": {
+			desired:  map[string]string{"team": "a", "env": "prod"},
+			observed: map[string]string{"env": "prod", "team": "a"},
+			want:     true,
+		},
+		"ValueChanged": {
+			desired:  map[string]string{"team": "a"},
+			observed: map[string]string{"team": "b"},
+			want:     false,
+		},
+		"LabelAdded": {
+			desired:  map[string]string{"team": "a", "env": "prod"},
+			observed: map[string]string{"team": "a"},
+			want:     false,
+		},
+		"LabelRemoved": {
+			desired:  map[string]string{"team": "a"},
+			observed: map[string]string{"team": "a", "env": "prod"},
+			want:     false,
+		},
+		"KeyReplaced": {
+			desired:  map[string]string{"team": ""},
+			observed: map[string]string{"env": ""},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LabelsUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("LabelsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateLabels(t *testing.T) {
+	cases := map[string]struct {
+		desired  map[string]string
+		observed map[string]string
+		want     labelUpdater
+	}{
+		"UpToDate": {
+			desired:  map[string]string{"team": "a"},
+			observed: map[string]string{"team": "a"},
+			want:     labelUpdater{},
+		},
+		"SetMissingAndChanged": {
+			desired:  map[string]string{"team": "a", "env": "prod", "tier": "web"},
+			observed: map[string]string{"team": "b", "tier": "web"},
+			want:     labelUpdater{set: map[string]string{"team": "a", "env": "prod"}},
+		},
+		"DeleteUndesired": {
+			desired:  map[string]string{"team": "a"},
+			observed: map[string]string{"team": "a", "env": "prod", "tier": "web"},
+			want:     labelUpdater{delete: []string{"env", "tier"}},
+		},
+		"DeleteAll": {
+			observed: map[string]string{"team": "a"},
+			want:     labelUpdater{delete: []string{"team"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := map[string]string{}
+			for k, v := range tc.observed {
+				observed[k] = v
+			}
+			got := labelUpdater{}
+			UpdateLabels(&got, tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(labelUpdater{}), cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("UpdateLabels(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(observed, tc.observed, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("UpdateLabels(...): observed labels were modified: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
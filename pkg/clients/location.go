@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+// Error strings.
+const (
+	errNoRegion      = "neither the managed resource nor its Provider's defaultRegion specify a region"
+	errNoZone        = "neither the managed resource nor its Provider's defaultZone specify a zone"
+	errInvalidRegion = "%q is not a region; expected a region such as us-central1"
+	errInvalidZone   = "%q is not a zone; expected a zone such as us-central1-a"
+)
+
+var (
+	regionRegexp = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+$`)
+	zoneRegexp   = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+-[a-z]$`)
+)
+
+// Region returns the supplied region if it is set, or the default region of
+// the supplied Provider otherwise. It returns an error if neither is set, or if
+// the result is not a region, for example because a zone was supplied.
+func Region(region string, p *v1alpha3.Provider) (string, error) {
+	if region == "" {
+		region = p.Spec.DefaultRegion
+	}
+	if region == "" {
+		return "", errors.New(errNoRegion)
+	}
+	if !regionRegexp.MatchString(region) {
+		return "", errors.Errorf(errInvalidRegion, region)
+	}
+	return region, nil
+}
+
+// Zone returns the supplied zone if it is set, or the default zone of the
+// supplied Provider otherwise. It returns an error if neither is set, or if the
+// result is not a zone, for example because a region was supplied.
+func Zone(zone string, p *v1alpha3.Provider) (string, error) {
+	if zone == "" {
+		zone = p.Spec.DefaultZone
+	}
+	if zone == "" {
+		return "", errors.New(errNoZone)
+	}
+	if !zoneRegexp.MatchString(zone) {
+		return "", errors.Errorf(errInvalidZone, zone)
+	}
+	return zone, nil
+}
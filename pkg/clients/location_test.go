@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+func TestRegion(t *testing.T) {
+	type args struct {
+		region string
+		p      *v1alpha3.Provider
+	}
+	type want struct {
+		region string
+		err    error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ResourceWins": {
+			args: args{
+				region: "europe-west1",
+				p:      &v1alpha3.Provider{Spec: v1alpha3.ProviderSpec{DefaultRegion: "us-central1"}},
+			},
+			want: want{region: "europe-west1"},
+		},
+		"ProviderDefault": {
+			args: args{
+				p: &v1alpha3.Provider{Spec: v1alpha3.ProviderSpec{DefaultRegion: "us-central1"}},
+			},
+			want: want{region: "us-central1"},
+		},
+		"NeitherSet": {
+			args: args{
+				p: &v1alpha3.Provider{},
+			},
+			want: want{err: errors.New(errNoRegion)},
+		},
+		"ZoneSupplied": {
+			args: args{
+				region: "us-central1-a",
+				p:      &v1alpha3.Provider{},
+			},
+			want: want{err: errors.Errorf(errInvalidRegion, "us-central1-a")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Region(tc.args.region, tc.args.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Region(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.region, got); diff != "" {
+				t.Errorf("Region(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestZone(t *testing.T) {
+	type args struct {
+		zone string
+		p    *v1alpha3.Provider
+	}
+	type want struct {
+		zone string
+		err  error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ResourceWins": {
+			args: args{
+				zone: "europe-west1-b",
+				p:    &v1alpha3.Provider{Spec: v1alpha3.ProviderSpec{DefaultZone: "us-central1-a"}},
+			},
+			want: want{zone: "europe-west1-b"},
+		},
+		"ProviderDefault": {
+			args: args{
+				p: &v1alpha3.Provider{Spec: v1alpha3.ProviderSpec{DefaultZone: "us-central1-a"}},
+			},
+			want: want{zone: "us-central1-a"},
+		},
+		"NeitherSet": {
+			args: args{
+				p: &v1alpha3.Provider{},
+			},
+			want: want{err: errors.New(errNoZone)},
+		},
+		"RegionSupplied": {
+			args: args{
+				zone: "us-central1",
+				p:    &v1alpha3.Provider{},
+			},
+			want: want{err: errors.Errorf(errInvalidZone, "us-central1")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Zone(tc.args.zone, tc.args.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Zone(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.zone, got); diff != "" {
+				t.Errorf("Zone(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
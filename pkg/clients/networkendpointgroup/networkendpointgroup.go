@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkendpointgroup
+
+import (
+	"net"
+	"path"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Error strings.
+const (
+	errInvalidIPAddress = "%q is not an IPv4 address"
+	errInvalidFqdn      = "%q is not a fully qualified domain name"
+	errInvalidPort      = "%d is not a valid port; expected a port between 1 and 65535"
+)
+
+// fqdnRegexp matches fully qualified domain names, with or without a trailing
+// dot.
+var fqdnRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}\.?$`)
+
+// GenerateNetworkEndpointGroup creates a *compute.NetworkEndpointGroup from
+// the supplied NetworkEndpointGroupParameters. Network endpoints are not part
+// of the returned object; they are attached separately.
+func GenerateNetworkEndpointGroup(name string, in v1alpha1.NetworkEndpointGroupParameters) *compute.NetworkEndpointGroup {
+	return &compute.NetworkEndpointGroup{
+		Name:                name,
+		Description:         gcp.StringValue(in.Description),
+		DefaultPort:         gcp.Int64Value(in.DefaultPort),
+		Network:             gcp.StringValue(in.Network),
+		NetworkEndpointType: in.NetworkEndpointType,
+		Subnetwork:          gcp.StringValue(in.Subnetwork),
+	}
+}
+
+// GenerateNetworkEndpointGroupObservation creates a
+// NetworkEndpointGroupObservation from the supplied
+// compute.NetworkEndpointGroup.
+func GenerateNetworkEndpointGroupObservation(in compute.NetworkEndpointGroup) v1alpha1.NetworkEndpointGroupObservation {
+	return v1alpha1.NetworkEndpointGroupObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Size:              in.Size,
+	}
+}
+
+// GenerateNetworkEndpoint creates a *compute.NetworkEndpoint from the supplied
+// NetworkEndpoint.
+func GenerateNetworkEndpoint(in v1alpha1.NetworkEndpoint) *compute.NetworkEndpoint {
+	return &compute.NetworkEndpoint{
+		Fqdn:      gcp.StringValue(in.Fqdn),
+		Instance:  gcp.StringValue(in.Instance),
+		IpAddress: gcp.StringValue(in.IPAddress),
+		Port:      gcp.Int64Value(in.Port),
+	}
+}
+
+// DiffNetworkEndpoints returns the endpoints that must be attached to and
+// detached from a network endpoint group in order for its observed endpoints
+// to match the desired ones. Fields that are omitted from a desired endpoint
+// match any observed value, except for the port which defaults to the
+// supplied default port.
+func DiffNetworkEndpoints(defaultPort int64, desired []v1alpha1.NetworkEndpoint, observed []*compute.NetworkEndpoint) (attach, detach []*compute.NetworkEndpoint) {
+	matched := make([]bool, len(observed))
+	for _, d := range desired {
+		found := false
+		for i, o := range observed {
+			if !matched[i] && endpointMatches(defaultPort, d, o) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			attach = append(attach, GenerateNetworkEndpoint(d))
+		}
+	}
+	for i, o := range observed {
+		if !matched[i] {
+			detach = append(detach, o)
+		}
+	}
+	return attach, detach
+}
+
+func endpointMatches(defaultPort int64, d v1alpha1.NetworkEndpoint, o *compute.NetworkEndpoint) bool {
+	if o == nil {
+		return false
+	}
+	if d.Fqdn != nil && *d.Fqdn != o.Fqdn {
+		return false
+	}
+	if d.IPAddress != nil && *d.IPAddress != o.IpAddress {
+		return false
+	}
+	// Instances may be reported as a name or as a URL.
+	if d.Instance != nil && path.Base(*d.Instance) != path.Base(o.Instance) {
+		return false
+	}
+	port := defaultPort
+	if d.Port != nil {
+		port = *d.Port
+	}
+	return port == 0 || o.Port == 0 || port == o.Port
+}
+
+// ValidateNetworkEndpoints returns an error if any of the supplied network
+// endpoints has a malformed IP address, FQDN, or port. Network endpoints are
+// attached after the network endpoint group is created, so GCP would otherwise
+// only reject them once the group exists.
+func ValidateNetworkEndpoints(in []v1alpha1.NetworkEndpoint) error {
+	for _, e := range in {
+		if e.IPAddress != nil {
+			if ip := net.ParseIP(*e.IPAddress); ip == nil || ip.To4() == nil {
+				return errors.Errorf(errInvalidIPAddress, *e.IPAddress)
+			}
+		}
+		if e.Fqdn != nil && !fqdnRegexp.MatchString(*e.Fqdn) {
+			return errors.Errorf(errInvalidFqdn, *e.Fqdn)
+		}
+		if e.Port != nil && (*e.Port < 1 || *e.Port > 65535) {
+			return errors.Errorf(errInvalidPort, *e.Port)
+		}
+	}
+	return nil
+}
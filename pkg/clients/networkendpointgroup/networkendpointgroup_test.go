@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkendpointgroup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const testName = "some-neg"
+
+func TestGenerateNetworkEndpointGroup(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.NetworkEndpointGroupParameters
+		want *compute.NetworkEndpointGroup
+	}{
+		"Zonal": {
+			in: v1alpha1.NetworkEndpointGroupParameters{
+				Zone:                gcp.StringPtr("us-central1-a"),
+				NetworkEndpointType: v1alpha1.NetworkEndpointTypeGCEVMIPPort,
+				Description:         gcp.StringPtr("desc"),
+				DefaultPort:         gcp.Int64Ptr(8080),
+				Network:             gcp.StringPtr("global/networks/default"),
+				Subnetwork:          gcp.StringPtr("regions/us-central1/subnetworks/default"),
+			},
+			want: &compute.NetworkEndpointGroup{
+				Name:                testName,
+				NetworkEndpointType: v1alpha1.NetworkEndpointTypeGCEVMIPPort,
+				Description:         "desc",
+				DefaultPort:         8080,
+				Network:             "global/networks/default",
+				Subnetwork:          "regions/us-central1/subnetworks/default",
+			},
+		},
+		"Global": {
+			in: v1alpha1.NetworkEndpointGroupParameters{
+				NetworkEndpointType: v1alpha1.NetworkEndpointTypeInternetFQDNPort,
+			},
+			want: &compute.NetworkEndpointGroup{
+				Name:                testName,
+				NetworkEndpointType: v1alpha1.NetworkEndpointTypeInternetFQDNPort,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateNetworkEndpointGroup(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateNetworkEndpointGroup(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffNetworkEndpoints(t *testing.T) {
+	type args struct {
+		defaultPort int64
+		desired     []v1alpha1.NetworkEndpoint
+		observed    []*compute.NetworkEndpoint
+	}
+	type want struct {
+		attach []*compute.NetworkEndpoint
+		detach []*compute.NetworkEndpoint
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				defaultPort: 80,
+				desired: []v1alpha1.NetworkEndpoint{
+					{Instance: gcp.StringPtr("vm-1")},
+					{Instance: gcp.StringPtr("vm-2"), IPAddress: gcp.StringPtr("10.0.0.2"), Port: gcp.Int64Ptr(8080)},
+				},
+				observed: []*compute.NetworkEndpoint{
+					{Instance: "https://www.googleapis.com/compute/v1/projects/p/zones/z/instances/vm-2", IpAddress: "10.0.0.2", Port: 8080},
+					{Instance: "vm-1", IpAddress: "10.0.0.1", Port: 80},
+				},
+			},
+			want: want{},
+		},
+		"AttachAndDetach": {
+			args: args{
+				defaultPort: 443,
+				desired: []v1alpha1.NetworkEndpoint{
+					{Fqdn: gcp.StringPtr("a.example.com")},
+					{Fqdn: gcp.StringPtr("b.example.com")},
+				},
+				observed: []*compute.NetworkEndpoint{
+					{Fqdn: "a.example.com", Port: 443},
+					{Fqdn: "c.example.com", Port: 443},
+				},
+			},
+			want: want{
+				attach: []*compute.NetworkEndpoint{{Fqdn: "b.example.com"}},
+				detach: []*compute.NetworkEndpoint{{Fqdn: "c.example.com", Port: 443}},
+			},
+		},
+		"PortChanged": {
+			args: args{
+				desired:  []v1alpha1.NetworkEndpoint{{IPAddress: gcp.StringPtr("1.2.3.4"), Port: gcp.Int64Ptr(81)}},
+				observed: []*compute.NetworkEndpoint{{IpAddress: "1.2.3.4", Port: 80}},
+			},
+			want: want{
+				attach: []*compute.NetworkEndpoint{{IpAddress: "1.2.3.4", Port: 81}},
+				detach: []*compute.NetworkEndpoint{{IpAddress: "1.2.3.4", Port: 80}},
+			},
+		},
+		"DuplicateEndpointsMatchOnce": {
+			args: args{
+				desired: []v1alpha1.NetworkEndpoint{
+					{IPAddress: gcp.StringPtr("1.2.3.4")},
+					{IPAddress: gcp.StringPtr("1.2.3.4")},
+				},
+				observed: []*compute.NetworkEndpoint{{IpAddress: "1.2.3.4", Port: 80}},
+			},
+			want: want{
+				attach: []*compute.NetworkEndpoint{{IpAddress: "1.2.3.4"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffNetworkEndpoints(tc.args.defaultPort, tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.attach, attach); diff != "" {
+				t.Errorf("DiffNetworkEndpoints(...): -want attach, +got attach:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detach, detach); diff != "" {
+				t.Errorf("DiffNetworkEndpoints(...): -want detach, +got detach:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateNetworkEndpoints(t *testing.T) {
+	cases := map[string]struct {
+		in   []v1alpha1.NetworkEndpoint
+		want error
+	}{
+		"Valid": {
+			in: []v1alpha1.NetworkEndpoint{
+				{IPAddress: gcp.StringPtr("203.0.113.1"), Port: gcp.Int64Ptr(443)},
+				{Fqdn: gcp.StringPtr("backend.example.com."), Port: gcp.Int64Ptr(1)},
+				{Instance: gcp.StringPtr("vm")},
+			},
+		},
+		"IPv6Address": {
+			in:   []v1alpha1.NetworkEndpoint{{IPAddress: gcp.StringPtr("2001:db8::1")}},
+			want: errors.Errorf(errInvalidIPAddress, "2001:db8::1"),
+		},
+		"MalformedIPAddress": {
+			in:   []v1alpha1.NetworkEndpoint{{IPAddress: gcp.StringPtr("203.0.113")}},
+			want: errors.Errorf(errInvalidIPAddress, "203.0.113"),
+		},
+		"MalformedFqdn": {
+			in:   []v1alpha1.NetworkEndpoint{{Fqdn: gcp.StringPtr("-backend.example.com")}},
+			want: errors.Errorf(errInvalidFqdn, "-backend.example.com"),
+		},
+		"UnqualifiedFqdn": {
+			in:   []v1alpha1.NetworkEndpoint{{Fqdn: gcp.StringPtr("backend")}},
+			want: errors.Errorf(errInvalidFqdn, "backend"),
+		},
+		"PortOutOfRange": {
+			in:   []v1alpha1.NetworkEndpoint{{Fqdn: gcp.StringPtr("example.com"), Port: gcp.Int64Ptr(0)}},
+			want: errors.Errorf(errInvalidPort, 0),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateNetworkEndpoints(tc.in)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateNetworkEndpoints(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
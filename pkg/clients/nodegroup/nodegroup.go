@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodegroup
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/compute/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateNodeGroup creates a *compute.NodeGroup from the supplied
+// NodeGroupParameters. The size of a node group is set when it is inserted,
+// rather than as part of it.
+func GenerateNodeGroup(name string, in v1alpha1.NodeGroupParameters) *compute.NodeGroup {
+	return &compute.NodeGroup{
+		Name:              name,
+		Description:       gcp.StringValue(in.Description),
+		NodeTemplate:      gcp.StringValue(in.NodeTemplate),
+		MaintenancePolicy: gcp.StringValue(in.MaintenancePolicy),
+		AutoscalingPolicy: GenerateAutoscalingPolicy(in.AutoscalingPolicy),
+	}
+}
+
+// GenerateAutoscalingPolicy creates a *compute.NodeGroupAutoscalingPolicy
+// from the supplied NodeGroupAutoscalingPolicy.
+func GenerateAutoscalingPolicy(in *v1alpha1.NodeGroupAutoscalingPolicy) *compute.NodeGroupAutoscalingPolicy {
+	if in == nil {
+		return nil
+	}
+	p := &compute.NodeGroupAutoscalingPolicy{
+		Mode:     gcp.StringValue(in.Mode),
+		MinNodes: gcp.Int64Value(in.MinNodes),
+		MaxNodes: in.MaxNodes,
+	}
+	if in.MinNodes != nil {
+		p.ForceSendFields = []string{"MinNodes"}
+	}
+	return p
+}
+
+// GenerateNodeGroupObservation creates a NodeGroupObservation from the
+// supplied compute.NodeGroup.
+func GenerateNodeGroupObservation(in compute.NodeGroup) v1alpha1.NodeGroupObservation {
+	return v1alpha1.NodeGroupObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+		Size:              in.Size,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// compute.NodeGroup.
+func LateInitializeSpec(spec *v1alpha1.NodeGroupParameters, in compute.NodeGroup) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.NodeTemplate = gcp.LateInitializeString(spec.NodeTemplate, in.NodeTemplate)
+	spec.MaintenancePolicy = gcp.LateInitializeString(spec.MaintenancePolicy, in.MaintenancePolicy)
+	if spec.AutoscalingPolicy != nil && in.AutoscalingPolicy != nil {
+		spec.AutoscalingPolicy.Mode = gcp.LateInitializeString(spec.AutoscalingPolicy.Mode, in.AutoscalingPolicy.Mode)
+		spec.AutoscalingPolicy.MinNodes = gcp.LateInitializeInt64(spec.AutoscalingPolicy.MinNodes, in.AutoscalingPolicy.MinNodes)
+	}
+}
+
+// IsAutoscaled returns true if the size of a node group with the supplied
+// NodeGroupParameters is managed by its autoscaler.
+func IsAutoscaled(in v1alpha1.NodeGroupParameters) bool {
+	return in.AutoscalingPolicy != nil && gcp.StringValue(in.AutoscalingPolicy.Mode) != v1alpha1.NodeGroupAutoscalingModeOff
+}
+
+// IsNodeTemplateUpToDate returns true if the supplied compute.NodeGroup uses
+// the node template of the supplied NodeGroupParameters.
+func IsNodeTemplateUpToDate(in v1alpha1.NodeGroupParameters, observed compute.NodeGroup) bool {
+	return cmp.Equal(gcp.StringValue(in.NodeTemplate), observed.NodeTemplate, gcp.EquateComputeURLs())
+}
+
+// IsAutoscalingPolicyUpToDate returns true if the autoscaling policy of the
+// supplied compute.NodeGroup matches the supplied NodeGroupParameters.
+func IsAutoscalingPolicyUpToDate(in v1alpha1.NodeGroupParameters, observed compute.NodeGroup) bool {
+	return cmp.Equal(GenerateAutoscalingPolicy(in.AutoscalingPolicy), observed.AutoscalingPolicy,
+		cmpopts.IgnoreFields(compute.NodeGroupAutoscalingPolicy{}, "ForceSendFields", "NullFields"))
+}
+
+// IsSizeUpToDate returns true if the supplied compute.NodeGroup has as many
+// nodes as the supplied NodeGroupParameters ask for. The size of an
+// autoscaled node group is always up to date.
+func IsSizeUpToDate(in v1alpha1.NodeGroupParameters, observed compute.NodeGroup) bool {
+	return IsAutoscaled(in) || in.Size == observed.Size
+}
+
+// IsUpToDate returns true if the supplied compute.NodeGroup matches the
+// supplied NodeGroupParameters.
+func IsUpToDate(in v1alpha1.NodeGroupParameters, observed compute.NodeGroup) bool {
+	return IsNodeTemplateUpToDate(in, observed) &&
+		IsAutoscalingPolicyUpToDate(in, observed) &&
+		IsSizeUpToDate(in, observed)
+}
+
+// RemovableNodes returns the names of up to count of the supplied nodes that
+// no instances are scheduled on.
+func RemovableNodes(nodes []*compute.NodeGroupNode, count int64) []string {
+	names := []string{}
+	for _, n := range nodes {
+		if int64(len(names)) == count {
+			break
+		}
+		if len(n.Instances) == 0 {
+			names = append(names, n.Name)
+		}
+	}
+	return names
+}
+
+// Condition returns the condition that corresponds to the supplied status of
+// a node group.
+func Condition(status string) runtimev1alpha1.Condition {
+	switch status {
+	case v1alpha1.NodeGroupStatusReady:
+		return runtimev1alpha1.Available()
+	case v1alpha1.NodeGroupStatusCreating:
+		return runtimev1alpha1.Creating()
+	case v1alpha1.NodeGroupStatusDeleting:
+		return runtimev1alpha1.Deleting()
+	default:
+		return runtimev1alpha1.Unavailable()
+	}
+}
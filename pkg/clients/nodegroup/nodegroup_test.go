@@ -0,0 +1,269 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodegroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testName     = "licensed"
+	testTemplate = "projects/p/regions/us-central1/nodeTemplates/licensed"
+)
+
+func params(m ...func(*v1alpha1.NodeGroupParameters)) *v1alpha1.NodeGroupParameters {
+	p := &v1alpha1.NodeGroupParameters{
+		Zone:              "us-central1-a",
+		Description:       gcp.StringPtr("desc"),
+		NodeTemplate:      gcp.StringPtr(testTemplate),
+		Size:              2,
+		MaintenancePolicy: gcp.StringPtr("RESTART_IN_PLACE"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func withAutoscaling(p *v1alpha1.NodeGroupParameters) {
+	p.AutoscalingPolicy = &v1alpha1.NodeGroupAutoscalingPolicy{
+		Mode:     gcp.StringPtr(v1alpha1.NodeGroupAutoscalingModeOn),
+		MinNodes: gcp.Int64Ptr(0),
+		MaxNodes: 5,
+	}
+}
+
+func nodeGroup(m ...func(*compute.NodeGroup)) *compute.NodeGroup {
+	ng := &compute.NodeGroup{
+		Name:              testName,
+		Description:       "desc",
+		NodeTemplate:      "https://www.googleapis.com/compute/v1/" + testTemplate,
+		MaintenancePolicy: "RESTART_IN_PLACE",
+		Size:              2,
+	}
+	for _, f := range m {
+		f(ng)
+	}
+	return ng
+}
+
+func TestGenerateNodeGroup(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.NodeGroupParameters
+		want *compute.NodeGroup
+	}{
+		"Full": {
+			in: *params(withAutoscaling),
+			want: &compute.NodeGroup{
+				Name:              testName,
+				Description:       "desc",
+				NodeTemplate:      testTemplate,
+				MaintenancePolicy: "RESTART_IN_PLACE",
+				AutoscalingPolicy: &compute.NodeGroupAutoscalingPolicy{
+					Mode:            v1alpha1.NodeGroupAutoscalingModeOn,
+					MaxNodes:        5,
+					ForceSendFields: []string{"MinNodes"},
+				},
+			},
+		},
+		"Minimal": {
+			in: v1alpha1.NodeGroupParameters{Zone: "us-central1-a", NodeTemplate: gcp.StringPtr(testTemplate), Size: 1},
+			want: &compute.NodeGroup{
+				Name:         testName,
+				NodeTemplate: testTemplate,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateNodeGroup(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateNodeGroup(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNodeGroupObservation(t *testing.T) {
+	in := compute.NodeGroup{
+		CreationTimestamp: "2020-06-01T12:00:00Z",
+		Id:                42,
+		SelfLink:          "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/nodeGroups/licensed",
+		Status:            v1alpha1.NodeGroupStatusReady,
+		Size:              3,
+	}
+	ts := metav1.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	want := v1alpha1.NodeGroupObservation{
+		CreationTimestamp: &ts,
+		ID:                42,
+		SelfLink:          in.SelfLink,
+		Status:            v1alpha1.NodeGroupStatusReady,
+		Size:              3,
+	}
+	if diff := cmp.Diff(want, GenerateNodeGroupObservation(in)); diff != "" {
+		t.Errorf("GenerateNodeGroupObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.NodeGroupParameters
+		observed compute.NodeGroup
+		want     *v1alpha1.NodeGroupParameters
+	}{
+		"FillsDefaults": {
+			spec: params(func(p *v1alpha1.NodeGroupParameters) {
+				p.Description = nil
+				p.MaintenancePolicy = nil
+				p.AutoscalingPolicy = &v1alpha1.NodeGroupAutoscalingPolicy{MaxNodes: 5}
+			}),
+			observed: *nodeGroup(func(ng *compute.NodeGroup) {
+				ng.AutoscalingPolicy = &compute.NodeGroupAutoscalingPolicy{Mode: v1alpha1.NodeGroupAutoscalingModeOn, MaxNodes: 5}
+			}),
+			want: params(func(p *v1alpha1.NodeGroupParameters) {
+				p.AutoscalingPolicy = &v1alpha1.NodeGroupAutoscalingPolicy{
+					Mode:     gcp.StringPtr(v1alpha1.NodeGroupAutoscalingModeOn),
+					MaxNodes: 5,
+				}
+			}),
+		},
+		"KeepsSpec": {
+			spec: params(),
+			observed: compute.NodeGroup{
+				Description:       "other",
+				NodeTemplate:      "other",
+				MaintenancePolicy: "DEFAULT",
+			},
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.NodeGroupParameters
+		observed compute.NodeGroup
+		want     bool
+	}{
+		"UpToDate": {
+			in:       *params(),
+			observed: *nodeGroup(),
+			want:     true,
+		},
+		"SizeDiffers": {
+			in:       *params(),
+			observed: *nodeGroup(func(ng *compute.NodeGroup) { ng.Size = 3 }),
+			want:     false,
+		},
+		"AutoscaledSizeDiffers": {
+			in: *params(withAutoscaling),
+			observed: *nodeGroup(func(ng *compute.NodeGroup) {
+				ng.Size = 3
+				ng.AutoscalingPolicy = &compute.NodeGroupAutoscalingPolicy{Mode: v1alpha1.NodeGroupAutoscalingModeOn, MaxNodes: 5}
+			}),
+			want: true,
+		},
+		"AutoscalingPolicyDiffers": {
+			in: *params(withAutoscaling),
+			observed: *nodeGroup(func(ng *compute.NodeGroup) {
+				ng.AutoscalingPolicy = &compute.NodeGroupAutoscalingPolicy{Mode: v1alpha1.NodeGroupAutoscalingModeOn, MaxNodes: 3}
+			}),
+			want: false,
+		},
+		"NodeTemplateDiffers": {
+			in:       *params(),
+			observed: *nodeGroup(func(ng *compute.NodeGroup) { ng.NodeTemplate = "projects/p/regions/us-central1/nodeTemplates/other" }),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsUpToDate(tc.in, tc.observed); got != tc.want {
+				t.Errorf("IsUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRemovableNodes(t *testing.T) {
+	nodes := []*compute.NodeGroupNode{
+		{Name: "busy", Instances: []string{"zones/us-central1-a/instances/vm"}},
+		{Name: "empty-1"},
+		{Name: "empty-2"},
+	}
+
+	cases := map[string]struct {
+		count int64
+		want  []string
+	}{
+		"SkipsBusyNodes": {
+			count: 1,
+			want:  []string{"empty-1"},
+		},
+		"NotEnoughEmptyNodes": {
+			count: 3,
+			want:  []string{"empty-1", "empty-2"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, RemovableNodes(nodes, tc.count)); diff != "" {
+				t.Errorf("RemovableNodes(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	cases := map[string]runtimev1alpha1.Condition{
+		v1alpha1.NodeGroupStatusReady:    runtimev1alpha1.Available(),
+		v1alpha1.NodeGroupStatusCreating: runtimev1alpha1.Creating(),
+		v1alpha1.NodeGroupStatusDeleting: runtimev1alpha1.Deleting(),
+		v1alpha1.NodeGroupStatusInvalid:  runtimev1alpha1.Unavailable(),
+	}
+
+	for status, want := range cases {
+		t.Run(status, func(t *testing.T) {
+			got := Condition(status)
+			if !want.Equal(got) {
+				t.Errorf("Condition(%s): want %v, got %v", status, want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nodetemplate contains a client for Compute Engine node templates
+// and utilities to convert between them and NodeTemplate managed resources.
+//
+// The Google API client library used by this provider predates CPU
+// overcommit, so this package implements the few v1 nodeTemplates REST calls
+// the NodeTemplate controller needs on top of the same authenticated
+// transport.
+package nodetemplate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// DefaultEndpoint of the Compute Engine API.
+const DefaultEndpoint = "https://compute.googleapis.com/compute/v1/"
+
+// A NodeTemplate is a Compute Engine node template.
+type NodeTemplate struct {
+	Name                string               `json:"name,omitempty"`
+	Description         string               `json:"description,omitempty"`
+	Region              string               `json:"region,omitempty"`
+	NodeType            string               `json:"nodeType,omitempty"`
+	NodeTypeFlexibility *NodeTypeFlexibility `json:"nodeTypeFlexibility,omitempty"`
+	NodeAffinityLabels  map[string]string    `json:"nodeAffinityLabels,omitempty"`
+	ServerBinding       *ServerBinding       `json:"serverBinding,omitempty"`
+	CPUOvercommitType   string               `json:"cpuOvercommitType,omitempty"`
+	CreationTimestamp   string               `json:"creationTimestamp,omitempty"`
+	ID                  uint64               `json:"id,omitempty,string"`
+	SelfLink            string               `json:"selfLink,omitempty"`
+	Status              string               `json:"status,omitempty"`
+	StatusMessage       string               `json:"statusMessage,omitempty"`
+}
+
+// NodeTypeFlexibility are the flexible properties of a node type.
+type NodeTypeFlexibility struct {
+	Cpus     string `json:"cpus,omitempty"`
+	Memory   string `json:"memory,omitempty"`
+	LocalSSD string `json:"localSsd,omitempty"`
+}
+
+// A ServerBinding specifies the physical servers a node restarts on.
+type ServerBinding struct {
+	Type string `json:"type,omitempty"`
+}
+
+// A Service calls the nodeTemplates methods of the Compute Engine API.
+type Service struct {
+	json *gcp.JSONClient
+}
+
+// NewService returns a Service configured per the supplied options.
+func NewService(ctx context.Context, opts ...option.ClientOption) (*Service, error) {
+	c, err := gcp.NewJSONClient(ctx, DefaultEndpoint, append([]option.ClientOption{option.WithScopes(compute.ComputeScope)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{json: c}, nil
+}
+
+// Get returns the named node template.
+func (s *Service) Get(ctx context.Context, project, region, name string) (*NodeTemplate, error) {
+	nt := &NodeTemplate{}
+	return nt, s.do(ctx, http.MethodGet, collection(project, region)+"/"+name, nil, nt)
+}
+
+// Insert creates the supplied node template.
+func (s *Service) Insert(ctx context.Context, project, region string, nt *NodeTemplate) (*compute.Operation, error) {
+	op := &compute.Operation{}
+	return op, s.do(ctx, http.MethodPost, collection(project, region), nt, op)
+}
+
+// Delete deletes the named node template.
+func (s *Service) Delete(ctx context.Context, project, region, name string) (*compute.Operation, error) {
+	op := &compute.Operation{}
+	return op, s.do(ctx, http.MethodDelete, collection(project, region)+"/"+name, nil, op)
+}
+
+func collection(project, region string) string {
+	return fmt.Sprintf("projects/%s/regions/%s/nodeTemplates", project, region)
+}
+
+// do sends a request for the supplied resource path and decodes the response
+// into out.
+func (s *Service) do(ctx context.Context, method, path string, in, out interface{}) error {
+	return s.json.Do(ctx, method, path, nil, in, out)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetemplate
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateNodeTemplate creates a *NodeTemplate from the supplied
+// NodeTemplateParameters.
+func GenerateNodeTemplate(name string, in v1alpha1.NodeTemplateParameters) *NodeTemplate {
+	nt := &NodeTemplate{
+		Name:               name,
+		Description:        gcp.StringValue(in.Description),
+		NodeType:           gcp.StringValue(in.NodeType),
+		NodeAffinityLabels: in.NodeAffinityLabels,
+		CPUOvercommitType:  gcp.StringValue(in.CPUOvercommitType),
+	}
+	if f := in.NodeTypeFlexibility; f != nil {
+		nt.NodeTypeFlexibility = &NodeTypeFlexibility{
+			Cpus:     gcp.StringValue(f.Cpus),
+			Memory:   gcp.StringValue(f.Memory),
+			LocalSSD: gcp.StringValue(f.LocalSSD),
+		}
+	}
+	if in.ServerBinding != nil {
+		nt.ServerBinding = &ServerBinding{Type: *in.ServerBinding}
+	}
+	return nt
+}
+
+// GenerateNodeTemplateObservation creates a NodeTemplateObservation from the
+// supplied NodeTemplate.
+func GenerateNodeTemplateObservation(in NodeTemplate) v1alpha1.NodeTemplateObservation {
+	return v1alpha1.NodeTemplateObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.ID,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+		StatusMessage:     in.StatusMessage,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// NodeTemplate.
+func LateInitializeSpec(spec *v1alpha1.NodeTemplateParameters, in NodeTemplate) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.CPUOvercommitType = gcp.LateInitializeString(spec.CPUOvercommitType, in.CPUOvercommitType)
+	if in.ServerBinding != nil {
+		spec.ServerBinding = gcp.LateInitializeString(spec.ServerBinding, in.ServerBinding.Type)
+	}
+	// Compute Engine picks a node type matching the flexible properties of
+	// the template, which must not become part of the spec.
+	if spec.NodeTypeFlexibility == nil {
+		spec.NodeType = gcp.LateInitializeString(spec.NodeType, in.NodeType)
+	}
+}
+
+// IsUpToDate returns true if the supplied NodeTemplate matches the supplied
+// NodeTemplateParameters. Unset and empty descriptions and labels are
+// considered equal.
+func IsUpToDate(in v1alpha1.NodeTemplateParameters, observed NodeTemplate) bool {
+	ignore := []string{"Region", "CreationTimestamp", "ID", "SelfLink", "Status", "StatusMessage"}
+	if in.NodeTypeFlexibility != nil {
+		ignore = append(ignore, "NodeType")
+	}
+	return cmp.Equal(GenerateNodeTemplate(observed.Name, in), &observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(NodeTemplate{}, ignore...))
+}
+
+// Condition returns the condition that corresponds to the supplied status of
+// a node template.
+func Condition(status string) runtimev1alpha1.Condition {
+	switch status {
+	case v1alpha1.NodeTemplateStatusReady:
+		return runtimev1alpha1.Available()
+	case v1alpha1.NodeTemplateStatusCreating:
+		return runtimev1alpha1.Creating()
+	case v1alpha1.NodeTemplateStatusDeleting:
+		return runtimev1alpha1.Deleting()
+	default:
+		return runtimev1alpha1.Unavailable()
+	}
+}
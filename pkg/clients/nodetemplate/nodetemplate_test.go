@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodetemplate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testName     = "licensed"
+	testNodeType = "n1-node-96-624"
+)
+
+func params(m ...func(*v1alpha1.NodeTemplateParameters)) *v1alpha1.NodeTemplateParameters {
+	p := &v1alpha1.NodeTemplateParameters{
+		Region:             "us-central1",
+		Description:        gcp.StringPtr("desc"),
+		NodeType:           gcp.StringPtr(testNodeType),
+		NodeAffinityLabels: map[string]string{"workload": "licensed"},
+		ServerBinding:      gcp.StringPtr("RESTART_NODE_ON_MINIMAL_SERVERS"),
+		CPUOvercommitType:  gcp.StringPtr("ENABLED"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func withFlexibility(p *v1alpha1.NodeTemplateParameters) {
+	p.NodeType = nil
+	p.NodeTypeFlexibility = &v1alpha1.NodeTemplateNodeTypeFlexibility{Cpus: gcp.StringPtr("96"), Memory: gcp.StringPtr("any")}
+}
+
+func TestGenerateNodeTemplate(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.NodeTemplateParameters
+		want *NodeTemplate
+	}{
+		"Full": {
+			in: *params(),
+			want: &NodeTemplate{
+				Name:               testName,
+				Description:        "desc",
+				NodeType:           testNodeType,
+				NodeAffinityLabels: map[string]string{"workload": "licensed"},
+				ServerBinding:      &ServerBinding{Type: "RESTART_NODE_ON_MINIMAL_SERVERS"},
+				CPUOvercommitType:  "ENABLED",
+			},
+		},
+		"Flexibility": {
+			in: v1alpha1.NodeTemplateParameters{
+				Region:              "us-central1",
+				NodeTypeFlexibility: &v1alpha1.NodeTemplateNodeTypeFlexibility{Cpus: gcp.StringPtr("96"), Memory: gcp.StringPtr("any")},
+			},
+			want: &NodeTemplate{
+				Name:                testName,
+				NodeTypeFlexibility: &NodeTypeFlexibility{Cpus: "96", Memory: "any"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateNodeTemplate(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateNodeTemplate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNodeTemplateObservation(t *testing.T) {
+	in := NodeTemplate{
+		CreationTimestamp: "2020-06-01T12:00:00Z",
+		ID:                42,
+		SelfLink:          "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1/nodeTemplates/licensed",
+		Status:            v1alpha1.NodeTemplateStatusInvalid,
+		StatusMessage:     "no such node type",
+	}
+	ts := metav1.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	want := v1alpha1.NodeTemplateObservation{
+		CreationTimestamp: &ts,
+		ID:                42,
+		SelfLink:          in.SelfLink,
+		Status:            v1alpha1.NodeTemplateStatusInvalid,
+		StatusMessage:     "no such node type",
+	}
+	if diff := cmp.Diff(want, GenerateNodeTemplateObservation(in)); diff != "" {
+		t.Errorf("GenerateNodeTemplateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.NodeTemplateParameters
+		observed NodeTemplate
+		want     *v1alpha1.NodeTemplateParameters
+	}{
+		"FillsDefaults": {
+			spec: params(func(p *v1alpha1.NodeTemplateParameters) {
+				p.Description = nil
+				p.ServerBinding = nil
+				p.CPUOvercommitType = nil
+			}),
+			observed: *GenerateNodeTemplate(testName, *params()),
+			want:     params(),
+		},
+		"KeepsFlexibleNodeType": {
+			spec: params(withFlexibility),
+			observed: NodeTemplate{
+				Description:       "desc",
+				NodeType:          testNodeType,
+				ServerBinding:     &ServerBinding{Type: "RESTART_NODE_ON_MINIMAL_SERVERS"},
+				CPUOvercommitType: "ENABLED",
+			},
+			want: params(withFlexibility),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.NodeTemplateParameters
+		observed NodeTemplate
+		want     bool
+	}{
+		"UpToDate": {
+			in: *params(),
+			observed: func() NodeTemplate {
+				nt := GenerateNodeTemplate(testName, *params())
+				nt.Region = "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1"
+				nt.Status = v1alpha1.NodeTemplateStatusReady
+				return *nt
+			}(),
+			want: true,
+		},
+		"FlexibleNodeTypeMatched": {
+			in: *params(withFlexibility),
+			observed: func() NodeTemplate {
+				nt := GenerateNodeTemplate(testName, *params(withFlexibility))
+				nt.NodeType = testNodeType
+				return *nt
+			}(),
+			want: true,
+		},
+		"CPUOvercommitChanged": {
+			in:       *params(),
+			observed: *GenerateNodeTemplate(testName, *params(func(p *v1alpha1.NodeTemplateParameters) { p.CPUOvercommitType = gcp.StringPtr("NONE") })),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsUpToDate(tc.in, tc.observed); got != tc.want {
+				t.Errorf("IsUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	cases := map[string]runtimev1alpha1.Condition{
+		v1alpha1.NodeTemplateStatusReady:    runtimev1alpha1.Available(),
+		v1alpha1.NodeTemplateStatusCreating: runtimev1alpha1.Creating(),
+		v1alpha1.NodeTemplateStatusDeleting: runtimev1alpha1.Deleting(),
+		v1alpha1.NodeTemplateStatusInvalid:  runtimev1alpha1.Unavailable(),
+	}
+
+	for status, want := range cases {
+		t.Run(status, func(t *testing.T) {
+			got := Condition(status)
+			if !want.Equal(got) {
+				t.Errorf("Condition(%s): want %v, got %v", status, want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	container "google.golang.org/api/container/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+)
+
+// Reasons of the events recorded for GCP operations.
+const (
+	ReasonOperationPending event.Reason = "PendingOperation"
+	ReasonOperationDone    event.Reason = "CompletedOperation"
+	ReasonOperationFailed  event.Reason = "FailedOperation"
+)
+
+// Annotations of the events recorded for GCP operations.
+const (
+	AnnotationOperationName   = "operation"
+	AnnotationOperationStatus = "operation-status"
+)
+
+// Statuses of GCP operations, as annotated on their events.
+const (
+	OperationStatusPending = "Pending"
+	OperationStatusDone    = "Done"
+	OperationStatusFailed  = "Failed"
+)
+
+// Statuses of completed operations.
+const (
+	computeOperationDone   = "DONE"
+	containerOperationDone = "DONE"
+)
+
+// An Operation is a GCP long running operation, independent of the API that
+// started it.
+type Operation struct {
+	// Name of the operation, as shown by the Cloud Console and gcloud.
+	Name string
+
+	// Done is true once the operation has completed, successfully or not.
+	Done bool
+
+	// Error describes why a completed operation failed, if it did.
+	Error string
+
+	// Started is when the operation was started. It is zero if unknown.
+	Started time.Time
+}
+
+// ComputeOperation returns the Operation of the supplied Compute API
+// operation.
+func ComputeOperation(op *compute.Operation) Operation {
+	o := Operation{Name: op.Name, Done: op.Status == computeOperationDone}
+	if t, err := time.Parse(time.RFC3339, op.InsertTime); err == nil {
+		o.Started = t
+	}
+	if op.Error == nil {
+		return o
+	}
+	msgs := make([]string, 0, len(op.Error.Errors))
+	for _, e := range op.Error.Errors {
+		if e != nil && e.Message != "" {
+			msgs = append(msgs, e.Message)
+		}
+	}
+	o.Error = strings.Join(msgs, "; ")
+	return o
+}
+
+// ContainerOperation returns the Operation of the supplied Kubernetes Engine
+// API operation. The API describes why an operation failed in its status
+// message.
+func ContainerOperation(op *container.Operation) Operation {
+	o := Operation{Name: op.Name, Done: op.Status == containerOperationDone}
+	if t, err := time.Parse(time.RFC3339, op.StartTime); err == nil {
+		o.Started = t
+	}
+	if o.Done {
+		o.Error = op.StatusMessage
+	}
+	return o
+}
+
+// OperationEvent returns the event that describes the supplied operation. It
+// is a normal event while the operation is pending or once it has succeeded,
+// and a warning event once it has failed. The operation name is included in
+// the message so that it can be looked up in the Cloud Console.
+func OperationEvent(op Operation) event.Event {
+	switch {
+	case !op.Done:
+		return event.Normal(ReasonOperationPending,
+			fmt.Sprintf("GCP operation %s is pending; polling until it is done", op.Name),
+			AnnotationOperationName, op.Name, AnnotationOperationStatus, OperationStatusPending)
+	case op.Error != "":
+		return event.Warning(ReasonOperationFailed,
+			errors.Errorf("GCP operation %s failed: %s", op.Name, op.Error),
+			AnnotationOperationName, op.Name, AnnotationOperationStatus, OperationStatusFailed)
+	default:
+		return event.Normal(ReasonOperationDone,
+			fmt.Sprintf("GCP operation %s is done", op.Name),
+			AnnotationOperationName, op.Name, AnnotationOperationStatus, OperationStatusDone)
+	}
+}
+
+// RecordOperation records the event that describes the supplied operation on
+// the supplied object. A nil Recorder records nothing.
+func RecordOperation(r event.Recorder, obj runtime.Object, op Operation) {
+	if r == nil {
+		return
+	}
+	r.Event(obj, OperationEvent(op))
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	container "google.golang.org/api/container/v1beta1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+)
+
+func TestComputeOperation(t *testing.T) {
+	cases := map[string]struct {
+		op   *compute.Operation
+		want Operation
+	}{
+		"Running": {
+			op:   &compute.Operation{Name: "op", Status: "RUNNING", InsertTime: "2020-06-01T10:00:00.000-07:00"},
+			want: Operation{Name: "op", Started: time.Date(2020, 6, 1, 17, 0, 0, 0, time.UTC)},
+		},
+		"Done": {
+			op:   &compute.Operation{Name: "op", Status: "DONE"},
+			want: Operation{Name: "op", Done: true},
+		},
+		"Failed": {
+			op: &compute.Operation{Name: "op", Status: "DONE", Error: &compute.OperationError{Errors: []*compute.OperationErrorErrors{
+				{Message: "quota exceeded"},
+				nil,
+				{Message: "try again later"},
+			}}},
+			want: Operation{Name: "op", Done: true, Error: "quota exceeded; try again later"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ComputeOperation(tc.op), cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+				t.Errorf("ComputeOperation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestContainerOperation(t *testing.T) {
+	cases := map[string]struct {
+		op   *container.Operation
+		want Operation
+	}{
+		"Running": {
+			op:   &container.Operation{Name: "op", Status: "RUNNING", StartTime: "2020-06-01T17:00:00.000Z", StatusMessage: "creating"},
+			want: Operation{Name: "op", Started: time.Date(2020, 6, 1, 17, 0, 0, 0, time.UTC)},
+		},
+		"Done": {
+			op:   &container.Operation{Name: "op", Status: "DONE"},
+			want: Operation{Name: "op", Done: true},
+		},
+		"Failed": {
+			op:   &container.Operation{Name: "op", Status: "DONE", StatusMessage: "quota exceeded"},
+			want: Operation{Name: "op", Done: true, Error: "quota exceeded"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ContainerOperation(tc.op), cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })); diff != "" {
+				t.Errorf("ContainerOperation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOperationEvent(t *testing.T) {
+	cases := map[string]struct {
+		op   Operation
+		want event.Event
+	}{
+		"Pending": {
+			op: Operation{Name: "op"},
+			want: event.Normal(ReasonOperationPending, "GCP operation op is pending; polling until it is done",
+				AnnotationOperationName, "op", AnnotationOperationStatus, OperationStatusPending),
+		},
+		"Done": {
+			op: Operation{Name: "op", Done: true},
+			want: event.Normal(ReasonOperationDone, "GCP operation op is done",
+				AnnotationOperationName, "op", AnnotationOperationStatus, OperationStatusDone),
+		},
+		"Failed": {
+			op: Operation{Name: "op", Done: true, Error: "boom"},
+			want: event.Warning(ReasonOperationFailed, errors.New("GCP operation op failed: boom"),
+				AnnotationOperationName, "op", AnnotationOperationStatus, OperationStatusFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, OperationEvent(tc.op)); diff != "" {
+				t.Errorf("OperationEvent(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// AnnotationKeyOperationPollInterval is the key of an annotation that sets
+// how often a managed resource is reconciled while one of its long running
+// operations is pending, for example "10s". It defaults to
+// DefaultOperationPollInterval.
+const AnnotationKeyOperationPollInterval = "crossplane.io/operation-poll-interval"
+
+// AnnotationKeyOperationTimeout is the key of an annotation that sets how long
+// a controller waits for a pending long running operation of a managed
+// resource to complete, for example "1h". Once an operation has been pending
+// for longer the controller stops waiting for it and reports an error. It
+// defaults to DefaultOperationTimeout.
+const AnnotationKeyOperationTimeout = "crossplane.io/operation-timeout"
+
+// Operation polling defaults.
+const (
+	DefaultOperationPollInterval = 30 * time.Second
+	DefaultOperationTimeout      = 30 * time.Minute
+)
+
+const errParseAnnotation = "cannot parse annotation %s: expected a positive duration such as 30s or 5m"
+
+// OperationPollInterval returns the interval at which the pending operations
+// of the supplied object should be polled.
+func OperationPollInterval(o metav1.Object) (time.Duration, error) {
+	return annotationDuration(o, AnnotationKeyOperationPollInterval, DefaultOperationPollInterval)
+}
+
+// OperationTimeout returns how long to wait for a pending operation of the
+// supplied object to complete.
+func OperationTimeout(o metav1.Object) (time.Duration, error) {
+	return annotationDuration(o, AnnotationKeyOperationTimeout, DefaultOperationTimeout)
+}
+
+func annotationDuration(o metav1.Object, key string, def time.Duration) (time.Duration, error) {
+	v, ok := o.GetAnnotations()[key]
+	if !ok {
+		return def, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, errors.Errorf(errParseAnnotation, key)
+	}
+	return d, nil
+}
+
+// OperationTimedOut returns true if the supplied operation is still pending
+// more than the supplied timeout after it was started. Operations whose start
+// time is unknown never time out.
+func OperationTimedOut(op Operation, timeout time.Duration, now time.Time) bool {
+	return !op.Done && !op.Started.IsZero() && now.Sub(op.Started) > timeout
+}
+
+// An OperationPoller requeues managed resources whose long running operations
+// are pending after their operation poll interval, rather than after the
+// managed reconciler's usual poll interval. Controllers never block waiting
+// for an operation; they tell the OperationPoller that it is pending and
+// observe it again once the resource is requeued. A nil OperationPoller
+// requeues nothing.
+type OperationPoller struct {
+	mu      sync.Mutex
+	pending map[string]time.Duration
+}
+
+// NewOperationPoller returns an OperationPoller.
+func NewOperationPoller() *OperationPoller {
+	return &OperationPoller{pending: map[string]time.Duration{}}
+}
+
+// Pending records that an operation of the supplied managed resource is
+// pending, and that the resource should be requeued after the supplied
+// interval.
+func (p *OperationPoller) Pending(o metav1.Object, interval time.Duration) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending[o.GetName()] = interval
+}
+
+// Reconciler returns a Reconciler that requeues a managed resource after its
+// operation poll interval if the supplied Reconciler found one of its
+// operations pending.
+func (p *OperationPoller) Reconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(req reconcile.Request) (reconcile.Result, error) {
+		res, err := r.Reconcile(req)
+		if d, ok := p.interval(req.Name); ok && err == nil {
+			res = reconcile.Result{RequeueAfter: d}
+		}
+		return res, err
+	})
+}
+
+// interval returns the poll interval of the named managed resource if one of
+// its operations was found pending since interval was last called.
+func (p *OperationPoller) interval(name string) (time.Duration, bool) {
+	if p == nil {
+		return 0, false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	d, ok := p.pending[name]
+	delete(p.pending, name)
+	return d, ok
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestOperationTimeout(t *testing.T) {
+	type want struct {
+		d   time.Duration
+		err error
+	}
+	cases := map[string]struct {
+		annotations map[string]string
+		want        want
+	}{
+		"Default": {
+			want: want{d: DefaultOperationTimeout},
+		},
+		"Annotated": {
+			annotations: map[string]string{AnnotationKeyOperationTimeout: "1h30m"},
+			want:        want{d: 90 * time.Minute},
+		},
+		"Invalid": {
+			annotations: map[string]string{AnnotationKeyOperationTimeout: "soon"},
+			want:        want{err: errors.Errorf(errParseAnnotation, AnnotationKeyOperationTimeout)},
+		},
+		"NotPositive": {
+			annotations: map[string]string{AnnotationKeyOperationTimeout: "-1m"},
+			want:        want{err: errors.Errorf(errParseAnnotation, AnnotationKeyOperationTimeout)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, err := OperationTimeout(&metav1.ObjectMeta{Annotations: tc.annotations})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("OperationTimeout(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.d, d); diff != "" {
+				t.Errorf("OperationTimeout(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOperationTimedOut(t *testing.T) {
+	started := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		op   Operation
+		now  time.Time
+		want bool
+	}{
+		"WithinTimeout": {
+			op:  Operation{Name: "op", Started: started},
+			now: started.Add(time.Minute),
+		},
+		"NeverCompletes": {
+			op:   Operation{Name: "op", Started: started},
+			now:  started.Add(time.Hour),
+			want: true,
+		},
+		"Done": {
+			op:  Operation{Name: "op", Done: true, Started: started},
+			now: started.Add(time.Hour),
+		},
+		"UnknownStart": {
+			op:  Operation{Name: "op"},
+			now: started.Add(time.Hour),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := OperationTimedOut(tc.op, 30*time.Minute, tc.now); got != tc.want {
+				t.Errorf("OperationTimedOut(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestOperationPollerReconciler(t *testing.T) {
+	p := NewOperationPoller()
+	r := p.Reconciler(reconcile.Func(func(req reconcile.Request) (reconcile.Result, error) {
+		if req.Name == "pending" {
+			p.Pending(&metav1.ObjectMeta{Name: req.Name}, 5*time.Second)
+		}
+		return reconcile.Result{RequeueAfter: time.Minute}, nil
+	}))
+
+	cases := map[string]time.Duration{
+		"pending": 5 * time.Second,
+		"idle":    time.Minute,
+	}
+	for name, want := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := reconcile.Request{}
+			req.Name = name
+			res, _ := r.Reconcile(req)
+			if diff := cmp.Diff(want, res.RequeueAfter); diff != "" {
+				t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GeneratePolicy takes a PolicyParameters and returns the *crm.OrgPolicy that
+// should be set on the parent resource.
+func GeneratePolicy(in v1alpha1.PolicyParameters) *crm.OrgPolicy {
+	p := &crm.OrgPolicy{Constraint: in.Constraint}
+	if in.BooleanPolicy != nil {
+		p.BooleanPolicy = &crm.BooleanPolicy{Enforced: in.BooleanPolicy.Enforced}
+	}
+	if in.ListPolicy != nil {
+		p.ListPolicy = &crm.ListPolicy{
+			AllowedValues:     in.ListPolicy.AllowedValues,
+			DeniedValues:      in.ListPolicy.DeniedValues,
+			AllValues:         gcp.StringValue(in.ListPolicy.AllValues),
+			SuggestedValue:    gcp.StringValue(in.ListPolicy.SuggestedValue),
+			InheritFromParent: gcp.BoolValue(in.ListPolicy.InheritFromParent),
+		}
+	}
+	if gcp.BoolValue(in.RestoreDefault) {
+		p.RestoreDefault = &crm.RestoreDefault{}
+	}
+	return p
+}
+
+// GeneratePolicyObservation takes a crm.OrgPolicy and returns a
+// PolicyObservation.
+func GeneratePolicyObservation(in crm.OrgPolicy) v1alpha1.PolicyObservation {
+	return v1alpha1.PolicyObservation{
+		Etag:       in.Etag,
+		UpdateTime: gcp.TimeFromRFC3339(in.UpdateTime),
+		Version:    in.Version,
+	}
+}
+
+// IsSet returns true if the supplied policy configures its constraint. The
+// Resource Manager API returns an empty policy, rather than an error, for
+// constraints that are not set on a resource; such a resource inherits the
+// policy of its parent.
+func IsSet(in crm.OrgPolicy) bool {
+	return in.BooleanPolicy != nil || in.ListPolicy != nil || in.RestoreDefault != nil
+}
+
+// IsUpToDate returns true if the rules and inheritance of the supplied
+// crm.OrgPolicy match those of the supplied PolicyParameters.
+func IsUpToDate(in v1alpha1.PolicyParameters, observed crm.OrgPolicy) bool {
+	desired := GeneratePolicy(in)
+	if !cmp.Equal(desired.BooleanPolicy, observed.BooleanPolicy) {
+		return false
+	}
+	if !cmp.Equal(desired.ListPolicy, observed.ListPolicy,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.IgnoreFields(crm.ListPolicy{}, "ForceSendFields", "NullFields")) {
+		return false
+	}
+	return (desired.RestoreDefault == nil) == (observed.RestoreDefault == nil)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const constraint = "constraints/iam.disableServiceAccountCreation"
+
+func TestGeneratePolicy(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.PolicyParameters
+		want *crm.OrgPolicy
+	}{
+		"BooleanPolicy": {
+			in: v1alpha1.PolicyParameters{
+				Parent:        "projects/foo",
+				Constraint:    constraint,
+				BooleanPolicy: &v1alpha1.BooleanPolicy{Enforced: true},
+			},
+			want: &crm.OrgPolicy{
+				Constraint:    constraint,
+				BooleanPolicy: &crm.BooleanPolicy{Enforced: true},
+			},
+		},
+		"ListPolicy": {
+			in: v1alpha1.PolicyParameters{
+				Parent:     "folders/123",
+				Constraint: constraint,
+				ListPolicy: &v1alpha1.ListPolicy{
+					AllowedValues:     []string{"a", "b"},
+					InheritFromParent: gcp.BoolPtr(true),
+				},
+			},
+			want: &crm.OrgPolicy{
+				Constraint: constraint,
+				ListPolicy: &crm.ListPolicy{
+					AllowedValues:     []string{"a", "b"},
+					InheritFromParent: true,
+				},
+			},
+		},
+		"RestoreDefault": {
+			in: v1alpha1.PolicyParameters{
+				Parent:         "organizations/456",
+				Constraint:     constraint,
+				RestoreDefault: gcp.BoolPtr(true),
+			},
+			want: &crm.OrgPolicy{
+				Constraint:     constraint,
+				RestoreDefault: &crm.RestoreDefault{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePolicy(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GeneratePolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSet(t *testing.T) {
+	cases := map[string]struct {
+		in   crm.OrgPolicy
+		want bool
+	}{
+		"Empty": {
+			in:   crm.OrgPolicy{Constraint: constraint, Etag: "BwWKmjvelug="},
+			want: false,
+		},
+		"BooleanPolicy": {
+			in:   crm.OrgPolicy{Constraint: constraint, BooleanPolicy: &crm.BooleanPolicy{}},
+			want: true,
+		},
+		"RestoreDefault": {
+			in:   crm.OrgPolicy{Constraint: constraint, RestoreDefault: &crm.RestoreDefault{}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSet(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsSet(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.PolicyParameters
+		observed crm.OrgPolicy
+		want     bool
+	}{
+		"BooleanUpToDate": {
+			in:       v1alpha1.PolicyParameters{BooleanPolicy: &v1alpha1.BooleanPolicy{Enforced: true}},
+			observed: crm.OrgPolicy{BooleanPolicy: &crm.BooleanPolicy{Enforced: true}},
+			want:     true,
+		},
+		"BooleanNeedsUpdate": {
+			in:       v1alpha1.PolicyParameters{BooleanPolicy: &v1alpha1.BooleanPolicy{Enforced: true}},
+			observed: crm.OrgPolicy{BooleanPolicy: &crm.BooleanPolicy{}},
+			want:     false,
+		},
+		"ListValuesInDifferentOrder": {
+			in: v1alpha1.PolicyParameters{ListPolicy: &v1alpha1.ListPolicy{
+				DeniedValues: []string{"b", "a"},
+			}},
+			observed: crm.OrgPolicy{ListPolicy: &crm.ListPolicy{DeniedValues: []string{"a", "b"}}},
+			want:     true,
+		},
+		"InheritanceNeedsUpdate": {
+			in: v1alpha1.PolicyParameters{ListPolicy: &v1alpha1.ListPolicy{
+				DeniedValues:      []string{"a"},
+				InheritFromParent: gcp.BoolPtr(true),
+			}},
+			observed: crm.OrgPolicy{ListPolicy: &crm.ListPolicy{DeniedValues: []string{"a"}}},
+			want:     false,
+		},
+		"RestoreDefaultNeedsUpdate": {
+			in:       v1alpha1.PolicyParameters{RestoreDefault: gcp.BoolPtr(true)},
+			observed: crm.OrgPolicy{BooleanPolicy: &crm.BooleanPolicy{}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package osconfig contains a client for the GCP OS Config API and utilities
+// to convert between its resources and managed resources.
+//
+// The Google API client library used by this provider predates OS policy
+// assignments, so this package implements the small subset of the v1 REST
+// API that the OSPolicyAssignment controller needs on top of the same
+// authenticated transport.
+package osconfig
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Client defaults.
+const (
+	DefaultEndpoint    = "https://osconfig.googleapis.com/"
+	CloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// An OSPolicyAssignment is an OS Config OS policy assignment.
+type OSPolicyAssignment struct {
+	Name               string          `json:"name,omitempty"`
+	Description        string          `json:"description,omitempty"`
+	OSPolicies         []*OSPolicy     `json:"osPolicies,omitempty"`
+	InstanceFilter     *InstanceFilter `json:"instanceFilter,omitempty"`
+	Rollout            *Rollout        `json:"rollout,omitempty"`
+	RevisionID         string          `json:"revisionId,omitempty"`
+	RevisionCreateTime string          `json:"revisionCreateTime,omitempty"`
+	Etag               string          `json:"etag,omitempty"`
+	RolloutState       string          `json:"rolloutState,omitempty"`
+	Baseline           bool            `json:"baseline,omitempty"`
+	Deleted            bool            `json:"deleted,omitempty"`
+	Reconciling        bool            `json:"reconciling,omitempty"`
+	UID                string          `json:"uid,omitempty"`
+}
+
+// An OSPolicy is a set of resources configured on instances.
+type OSPolicy struct {
+	ID                        string           `json:"id,omitempty"`
+	Description               string           `json:"description,omitempty"`
+	Mode                      string           `json:"mode,omitempty"`
+	ResourceGroups            []*ResourceGroup `json:"resourceGroups,omitempty"`
+	AllowNoResourceGroupMatch bool             `json:"allowNoResourceGroupMatch,omitempty"`
+}
+
+// A ResourceGroup is a set of resources applied to instances running one of
+// a set of operating systems.
+type ResourceGroup struct {
+	InventoryFilters []*InventoryFilter `json:"inventoryFilters,omitempty"`
+	Resources        []*Resource        `json:"resources,omitempty"`
+}
+
+// An InventoryFilter matches instances by their operating system.
+type InventoryFilter struct {
+	OSShortName string `json:"osShortName,omitempty"`
+	OSVersion   string `json:"osVersion,omitempty"`
+}
+
+// A Resource is a resource of an OS policy.
+type Resource struct {
+	ID         string              `json:"id,omitempty"`
+	Pkg        *PackageResource    `json:"pkg,omitempty"`
+	Repository *RepositoryResource `json:"repository,omitempty"`
+}
+
+// A PackageResource is a package managed by a package manager.
+type PackageResource struct {
+	DesiredState string       `json:"desiredState,omitempty"`
+	Apt          *PackageName `json:"apt,omitempty"`
+	Yum          *PackageName `json:"yum,omitempty"`
+	Zypper       *PackageName `json:"zypper,omitempty"`
+	GooGet       *PackageName `json:"googet,omitempty"`
+}
+
+// A PackageName is the name of a package.
+type PackageName struct {
+	Name string `json:"name,omitempty"`
+}
+
+// A RepositoryResource is a package repository.
+type RepositoryResource struct {
+	Apt    *AptRepository `json:"apt,omitempty"`
+	Yum    *YumRepository `json:"yum,omitempty"`
+	Zypper *YumRepository `json:"zypper,omitempty"`
+	Goo    *GooRepository `json:"goo,omitempty"`
+}
+
+// An AptRepository is a repository of the apt package manager.
+type AptRepository struct {
+	ArchiveType  string   `json:"archiveType,omitempty"`
+	URI          string   `json:"uri,omitempty"`
+	Distribution string   `json:"distribution,omitempty"`
+	Components   []string `json:"components,omitempty"`
+	GPGKey       string   `json:"gpgKey,omitempty"`
+}
+
+// A YumRepository is a repository of the yum or zypper package manager, whose
+// repositories share the same fields.
+type YumRepository struct {
+	ID          string   `json:"id,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	BaseURL     string   `json:"baseUrl,omitempty"`
+	GPGKeys     []string `json:"gpgKeys,omitempty"`
+}
+
+// A GooRepository is a repository of the GooGet package manager.
+type GooRepository struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// An InstanceFilter selects instances by their labels and operating systems.
+type InstanceFilter struct {
+	All             bool               `json:"all,omitempty"`
+	InclusionLabels []*LabelSet        `json:"inclusionLabels,omitempty"`
+	ExclusionLabels []*LabelSet        `json:"exclusionLabels,omitempty"`
+	Inventories     []*InventoryFilter `json:"inventories,omitempty"`
+}
+
+// A LabelSet is a set of instance labels.
+type LabelSet struct {
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// A Rollout controls how a revision of an assignment is rolled out.
+type Rollout struct {
+	DisruptionBudget *FixedOrPercent `json:"disruptionBudget,omitempty"`
+	MinWaitDuration  string          `json:"minWaitDuration,omitempty"`
+}
+
+// A FixedOrPercent is a fixed number or a percentage of instances.
+type FixedOrPercent struct {
+	Fixed   int64 `json:"fixed,omitempty"`
+	Percent int64 `json:"percent,omitempty"`
+}
+
+// An Operation is a long running operation started by a mutating call.
+type Operation struct {
+	Name string `json:"name,omitempty"`
+	Done bool   `json:"done,omitempty"`
+}
+
+// OSPolicyAssignmentName returns the resource name of the supplied OS policy
+// assignment.
+func OSPolicyAssignmentName(project, zone, assignment string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/osPolicyAssignments/%s", project, zone, assignment)
+}
+
+// A Service calls the OS Config API.
+type Service struct {
+	json *gcp.JSONClient
+}
+
+// NewService returns a Service configured per the supplied options.
+func NewService(ctx context.Context, opts ...option.ClientOption) (*Service, error) {
+	c, err := gcp.NewJSONClient(ctx, DefaultEndpoint, append([]option.ClientOption{option.WithScopes(CloudPlatformScope)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{json: c}, nil
+}
+
+// GetOSPolicyAssignment returns the named OS policy assignment.
+func (s *Service) GetOSPolicyAssignment(ctx context.Context, name string) (*OSPolicyAssignment, error) {
+	a := &OSPolicyAssignment{}
+	return a, s.do(ctx, http.MethodGet, name, nil, nil, a)
+}
+
+// CreateOSPolicyAssignment creates the supplied OS policy assignment in the
+// supplied project and zone. The returned operation completes once the first
+// revision of the assignment was rolled out.
+func (s *Service) CreateOSPolicyAssignment(ctx context.Context, project, zone, id string, a *OSPolicyAssignment) (*Operation, error) {
+	parent := fmt.Sprintf("projects/%s/locations/%s", project, zone)
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodPost, parent+"/osPolicyAssignments", url.Values{"osPolicyAssignmentId": {id}}, a, op)
+}
+
+// PatchOSPolicyAssignment updates the supplied fields of the named OS policy
+// assignment, creating a new revision that is rolled out per its rollout.
+func (s *Service) PatchOSPolicyAssignment(ctx context.Context, name string, a *OSPolicyAssignment, mask ...string) (*Operation, error) {
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodPatch, name, url.Values{"updateMask": {strings.Join(mask, ",")}}, a, op)
+}
+
+// DeleteOSPolicyAssignment deletes the named OS policy assignment.
+func (s *Service) DeleteOSPolicyAssignment(ctx context.Context, name string) (*Operation, error) {
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodDelete, name, nil, nil, op)
+}
+
+// do sends a request for the supplied resource path and decodes the response
+// into out.
+func (s *Service) do(ctx context.Context, method, path string, q url.Values, in, out interface{}) error {
+	return s.json.Do(ctx, method, "v1/"+path, q, in, out)
+}
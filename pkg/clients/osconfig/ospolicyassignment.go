@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// OSPolicyAssignmentUpdateMask lists the fields of an OS policy assignment
+// that may be updated.
+var OSPolicyAssignmentUpdateMask = []string{"description", "osPolicies", "instanceFilter", "rollout"}
+
+// GenerateOSPolicyAssignment takes OSPolicyAssignmentParameters and returns
+// an OSPolicyAssignment.
+func GenerateOSPolicyAssignment(in v1alpha1.OSPolicyAssignmentParameters) *OSPolicyAssignment {
+	a := &OSPolicyAssignment{
+		Description:    gcp.StringValue(in.Description),
+		InstanceFilter: generateInstanceFilter(in.InstanceFilter),
+		Rollout: &Rollout{
+			DisruptionBudget: &FixedOrPercent{
+				Fixed:   fromInt32Ptr(in.Rollout.DisruptionBudget.Fixed),
+				Percent: fromInt32Ptr(in.Rollout.DisruptionBudget.Percent),
+			},
+			MinWaitDuration: in.Rollout.MinWaitDuration,
+		},
+	}
+	for _, p := range in.OSPolicies {
+		op := &OSPolicy{
+			ID:                        p.ID,
+			Description:               gcp.StringValue(p.Description),
+			Mode:                      p.Mode,
+			AllowNoResourceGroupMatch: gcp.BoolValue(p.AllowNoResourceGroupMatch),
+		}
+		for _, g := range p.ResourceGroups {
+			rg := &ResourceGroup{InventoryFilters: generateInventoryFilters(g.InventoryFilters)}
+			for _, r := range g.Resources {
+				rg.Resources = append(rg.Resources, &Resource{
+					ID:         r.ID,
+					Pkg:        generatePackage(r.Package),
+					Repository: generateRepository(r.Repository),
+				})
+			}
+			op.ResourceGroups = append(op.ResourceGroups, rg)
+		}
+		a.OSPolicies = append(a.OSPolicies, op)
+	}
+	return a
+}
+
+func generateInstanceFilter(in v1alpha1.InstanceFilter) *InstanceFilter {
+	f := &InstanceFilter{
+		All:         gcp.BoolValue(in.All),
+		Inventories: generateInventoryFilters(in.Inventories),
+	}
+	for _, l := range in.InclusionLabels {
+		f.InclusionLabels = append(f.InclusionLabels, &LabelSet{Labels: l.Labels})
+	}
+	for _, l := range in.ExclusionLabels {
+		f.ExclusionLabels = append(f.ExclusionLabels, &LabelSet{Labels: l.Labels})
+	}
+	return f
+}
+
+func generateInventoryFilters(in []v1alpha1.InventoryFilter) []*InventoryFilter {
+	var out []*InventoryFilter
+	for _, f := range in {
+		out = append(out, &InventoryFilter{OSShortName: f.OSShortName, OSVersion: gcp.StringValue(f.OSVersion)})
+	}
+	return out
+}
+
+func generatePackage(in *v1alpha1.PackageResource) *PackageResource {
+	if in == nil {
+		return nil
+	}
+	return &PackageResource{
+		DesiredState: in.DesiredState,
+		Apt:          generatePackageName(in.Apt),
+		Yum:          generatePackageName(in.Yum),
+		Zypper:       generatePackageName(in.Zypper),
+		GooGet:       generatePackageName(in.GooGet),
+	}
+}
+
+func generatePackageName(in *v1alpha1.PackageName) *PackageName {
+	if in == nil {
+		return nil
+	}
+	return &PackageName{Name: in.Name}
+}
+
+func generateRepository(in *v1alpha1.RepositoryResource) *RepositoryResource {
+	if in == nil {
+		return nil
+	}
+	r := &RepositoryResource{}
+	if a := in.Apt; a != nil {
+		r.Apt = &AptRepository{
+			ArchiveType:  a.ArchiveType,
+			URI:          a.URI,
+			Distribution: a.Distribution,
+			Components:   a.Components,
+			GPGKey:       gcp.StringValue(a.GPGKey),
+		}
+	}
+	if y := in.Yum; y != nil {
+		r.Yum = &YumRepository{ID: y.ID, DisplayName: gcp.StringValue(y.DisplayName), BaseURL: y.BaseURL, GPGKeys: y.GPGKeys}
+	}
+	if z := in.Zypper; z != nil {
+		r.Zypper = &YumRepository{ID: z.ID, DisplayName: gcp.StringValue(z.DisplayName), BaseURL: z.BaseURL, GPGKeys: z.GPGKeys}
+	}
+	if g := in.Goo; g != nil {
+		r.Goo = &GooRepository{Name: g.Name, URL: g.URL}
+	}
+	return r
+}
+
+func fromInt32Ptr(v *int32) int64 {
+	if v == nil {
+		return 0
+	}
+	return int64(*v)
+}
+
+// GenerateOSPolicyAssignmentObservation takes an OSPolicyAssignment and
+// returns an OSPolicyAssignmentObservation.
+func GenerateOSPolicyAssignmentObservation(in OSPolicyAssignment) v1alpha1.OSPolicyAssignmentObservation {
+	return v1alpha1.OSPolicyAssignmentObservation{
+		Name:               in.Name,
+		RevisionID:         in.RevisionID,
+		RevisionCreateTime: gcp.TimeFromRFC3339(in.RevisionCreateTime),
+		RolloutState:       in.RolloutState,
+		Baseline:           in.Baseline,
+		Reconciling:        in.Reconciling,
+		Etag:               in.Etag,
+		UID:                in.UID,
+	}
+}
+
+// LateInitializeOSPolicyAssignment fills the empty fields of the supplied
+// OSPolicyAssignmentParameters with those of the supplied
+// OSPolicyAssignment.
+func LateInitializeOSPolicyAssignment(spec *v1alpha1.OSPolicyAssignmentParameters, in OSPolicyAssignment) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+}
+
+// IsOSPolicyAssignmentUpToDate returns true if the OS policies, instance
+// filter and rollout of the supplied OSPolicyAssignment match the supplied
+// OSPolicyAssignmentParameters.
+func IsOSPolicyAssignmentUpToDate(in v1alpha1.OSPolicyAssignmentParameters, observed OSPolicyAssignment) bool {
+	desired := GenerateOSPolicyAssignment(in)
+	if desired.Description != observed.Description {
+		return false
+	}
+	if !cmp.Equal(desired.OSPolicies, observed.OSPolicies, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if !cmp.Equal(desired.InstanceFilter, observed.InstanceFilter, cmpopts.EquateEmpty()) {
+		return false
+	}
+	return isRolloutUpToDate(desired.Rollout, observed.Rollout)
+}
+
+// isRolloutUpToDate compares rollouts. The API returns the minimum wait
+// duration in seconds, e.g. 600s, which may be specified as 10m.
+func isRolloutUpToDate(desired, observed *Rollout) bool {
+	if observed == nil {
+		return false
+	}
+	if !cmp.Equal(desired.DisruptionBudget, observed.DisruptionBudget, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if desired.MinWaitDuration == observed.MinWaitDuration {
+		return true
+	}
+	d, err := time.ParseDuration(desired.MinWaitDuration)
+	if err != nil {
+		return false
+	}
+	o, err := time.ParseDuration(observed.MinWaitDuration)
+	return err == nil && d == o
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func params(m ...func(*v1alpha1.OSPolicyAssignmentParameters)) v1alpha1.OSPolicyAssignmentParameters {
+	p := v1alpha1.OSPolicyAssignmentParameters{
+		Zone: "us-central1-a",
+		OSPolicies: []v1alpha1.OSPolicy{{
+			ID:   "nginx",
+			Mode: "ENFORCEMENT",
+			ResourceGroups: []v1alpha1.OSPolicyResourceGroup{{
+				InventoryFilters: []v1alpha1.InventoryFilter{{OSShortName: "debian", OSVersion: gcp.StringPtr("10.*")}},
+				Resources: []v1alpha1.OSPolicyResource{
+					{ID: "repo", Repository: &v1alpha1.RepositoryResource{Apt: &v1alpha1.AptRepository{
+						ArchiveType:  "DEB",
+						URI:          "https://nginx.org/packages/debian",
+						Distribution: "buster",
+						Components:   []string{"nginx"},
+					}}},
+					{ID: "pkg", Package: &v1alpha1.PackageResource{DesiredState: "INSTALLED", Apt: &v1alpha1.PackageName{Name: "nginx"}}},
+				},
+			}},
+		}},
+		InstanceFilter: v1alpha1.InstanceFilter{
+			InclusionLabels: []v1alpha1.LabelSet{{Labels: map[string]string{"role": "web"}}},
+		},
+		Rollout: v1alpha1.Rollout{
+			DisruptionBudget: v1alpha1.FixedOrPercent{Percent: int32Ptr(10)},
+			MinWaitDuration:  "10m",
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func assignment(m ...func(*OSPolicyAssignment)) *OSPolicyAssignment {
+	a := &OSPolicyAssignment{
+		OSPolicies: []*OSPolicy{{
+			ID:   "nginx",
+			Mode: "ENFORCEMENT",
+			ResourceGroups: []*ResourceGroup{{
+				InventoryFilters: []*InventoryFilter{{OSShortName: "debian", OSVersion: "10.*"}},
+				Resources: []*Resource{
+					{ID: "repo", Repository: &RepositoryResource{Apt: &AptRepository{
+						ArchiveType:  "DEB",
+						URI:          "https://nginx.org/packages/debian",
+						Distribution: "buster",
+						Components:   []string{"nginx"},
+					}}},
+					{ID: "pkg", Pkg: &PackageResource{DesiredState: "INSTALLED", Apt: &PackageName{Name: "nginx"}}},
+				},
+			}},
+		}},
+		InstanceFilter: &InstanceFilter{
+			InclusionLabels: []*LabelSet{{Labels: map[string]string{"role": "web"}}},
+		},
+		Rollout: &Rollout{
+			DisruptionBudget: &FixedOrPercent{Percent: 10},
+			MinWaitDuration:  "10m",
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestGenerateOSPolicyAssignment(t *testing.T) {
+	want := assignment()
+	got := GenerateOSPolicyAssignment(params())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateOSPolicyAssignment(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsOSPolicyAssignmentUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.OSPolicyAssignmentParameters
+		observed *OSPolicyAssignment
+		want     bool
+	}{
+		"UpToDate": {
+			in: params(),
+			observed: assignment(func(a *OSPolicyAssignment) {
+				a.RevisionID = "abc"
+				a.RolloutState = v1alpha1.RolloutStateSucceeded
+				a.Rollout.MinWaitDuration = "600s"
+			}),
+			want: true,
+		},
+		"PackageChanged": {
+			in: params(),
+			observed: assignment(func(a *OSPolicyAssignment) {
+				a.OSPolicies[0].ResourceGroups[0].Resources[1].Pkg.DesiredState = "REMOVED"
+			}),
+			want: false,
+		},
+		"ModeChanged": {
+			in:       params(),
+			observed: assignment(func(a *OSPolicyAssignment) { a.OSPolicies[0].Mode = "VALIDATION" }),
+			want:     false,
+		},
+		"InstanceFilterChanged": {
+			in: params(func(p *v1alpha1.OSPolicyAssignmentParameters) {
+				p.InstanceFilter = v1alpha1.InstanceFilter{All: gcp.BoolPtr(true)}
+			}),
+			observed: assignment(),
+			want:     false,
+		},
+		"DisruptionBudgetChanged": {
+			in:       params(),
+			observed: assignment(func(a *OSPolicyAssignment) { a.Rollout.DisruptionBudget = &FixedOrPercent{Fixed: 1} }),
+			want:     false,
+		},
+		"MinWaitDurationChanged": {
+			in:       params(),
+			observed: assignment(func(a *OSPolicyAssignment) { a.Rollout.MinWaitDuration = "60s" }),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsOSPolicyAssignmentUpToDate(tc.in, *tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsOSPolicyAssignmentUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
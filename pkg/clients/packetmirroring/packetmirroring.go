@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packetmirroring
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GeneratePacketMirroring creates a *compute.PacketMirroring from the supplied
+// PacketMirroringParameters.
+func GeneratePacketMirroring(name string, in v1alpha1.PacketMirroringParameters) *compute.PacketMirroring {
+	pm := &compute.PacketMirroring{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+		Region:      in.Region,
+		CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{
+			Url: in.CollectorILB,
+		},
+		MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+			Tags: in.MirroredResources.Tags,
+		},
+		Priority: gcp.Int64Value(in.Priority),
+		Enable:   gcp.StringValue(in.Enable),
+	}
+	if in.Network != nil {
+		pm.Network = &compute.PacketMirroringNetworkInfo{Url: *in.Network}
+	}
+	for _, s := range in.MirroredResources.Subnetworks {
+		pm.MirroredResources.Subnetworks = append(pm.MirroredResources.Subnetworks, &compute.PacketMirroringMirroredResourceInfoSubnetInfo{Url: s})
+	}
+	for _, i := range in.MirroredResources.Instances {
+		pm.MirroredResources.Instances = append(pm.MirroredResources.Instances, &compute.PacketMirroringMirroredResourceInfoInstanceInfo{Url: i})
+	}
+	if in.Filter != nil {
+		pm.Filter = &compute.PacketMirroringFilter{
+			IPProtocols: in.Filter.IPProtocols,
+			CidrRanges:  in.Filter.CIDRRanges,
+		}
+	}
+	return pm
+}
+
+// GeneratePacketMirroringObservation creates a PacketMirroringObservation from
+// the supplied compute.PacketMirroring.
+func GeneratePacketMirroringObservation(in compute.PacketMirroring) v1alpha1.PacketMirroringObservation {
+	return v1alpha1.PacketMirroringObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// compute.PacketMirroring.
+func LateInitializeSpec(spec *v1alpha1.PacketMirroringParameters, in compute.PacketMirroring) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Priority = gcp.LateInitializeInt64(spec.Priority, in.Priority)
+	spec.Enable = gcp.LateInitializeString(spec.Enable, in.Enable)
+	if spec.Network == nil && in.Network != nil {
+		spec.Network = gcp.LateInitializeString(spec.Network, in.Network.Url)
+	}
+}
+
+// IsUpToDate checks whether the observed compute.PacketMirroring matches the
+// supplied PacketMirroringParameters.
+func IsUpToDate(name string, in v1alpha1.PacketMirroringParameters, observed *compute.PacketMirroring) bool {
+	desired := GeneratePacketMirroring(name, in)
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		gcp.EquateComputeURLs(),
+		cmpopts.SortSlices(func(i, j string) bool { return i < j }),
+		cmpopts.SortSlices(func(i, j *compute.PacketMirroringMirroredResourceInfoSubnetInfo) bool { return i.Url < j.Url }),
+		cmpopts.SortSlices(func(i, j *compute.PacketMirroringMirroredResourceInfoInstanceInfo) bool { return i.Url < j.Url }),
+		cmpopts.IgnoreFields(compute.PacketMirroring{}, "CreationTimestamp", "Id", "Kind", "SelfLink", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.PacketMirroringForwardingRuleInfo{}, "CanonicalUrl"),
+		cmpopts.IgnoreFields(compute.PacketMirroringNetworkInfo{}, "CanonicalUrl"),
+		cmpopts.IgnoreFields(compute.PacketMirroringMirroredResourceInfoSubnetInfo{}, "CanonicalUrl"),
+		cmpopts.IgnoreFields(compute.PacketMirroringMirroredResourceInfoInstanceInfo{}, "CanonicalUrl"),
+	)
+}
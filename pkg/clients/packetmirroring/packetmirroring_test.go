@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package packetmirroring
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "some-mirroring"
+	testRegion = "us-central1"
+)
+
+func params(m ...func(*v1alpha1.PacketMirroringParameters)) *v1alpha1.PacketMirroringParameters {
+	p := &v1alpha1.PacketMirroringParameters{
+		Region:       testRegion,
+		Description:  gcp.StringPtr("desc"),
+		Network:      gcp.StringPtr("global/networks/default"),
+		CollectorILB: "regions/us-central1/forwardingRules/collector",
+		MirroredResources: v1alpha1.PacketMirroringMirroredResources{
+			Subnetworks: []string{"regions/us-central1/subnetworks/a", "regions/us-central1/subnetworks/b"},
+			Instances:   []string{"zones/us-central1-a/instances/vm"},
+			Tags:        []string{"x", "y"},
+		},
+		Filter: &v1alpha1.PacketMirroringFilter{
+			IPProtocols: []string{"tcp"},
+			CIDRRanges:  []string{"10.0.0.0/8"},
+		},
+		Priority: gcp.Int64Ptr(1000),
+		Enable:   gcp.StringPtr("TRUE"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func packetMirroring(m ...func(*compute.PacketMirroring)) *compute.PacketMirroring {
+	pm := &compute.PacketMirroring{
+		Name:         testName,
+		Region:       testRegion,
+		Description:  "desc",
+		Network:      &compute.PacketMirroringNetworkInfo{Url: "global/networks/default"},
+		CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{Url: "regions/us-central1/forwardingRules/collector"},
+		MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+			Subnetworks: []*compute.PacketMirroringMirroredResourceInfoSubnetInfo{
+				{Url: "regions/us-central1/subnetworks/a"},
+				{Url: "regions/us-central1/subnetworks/b"},
+			},
+			Instances: []*compute.PacketMirroringMirroredResourceInfoInstanceInfo{
+				{Url: "zones/us-central1-a/instances/vm"},
+			},
+			Tags: []string{"x", "y"},
+		},
+		Filter: &compute.PacketMirroringFilter{
+			IPProtocols: []string{"tcp"},
+			CidrRanges:  []string{"10.0.0.0/8"},
+		},
+		Priority: 1000,
+		Enable:   "TRUE",
+	}
+	for _, f := range m {
+		f(pm)
+	}
+	return pm
+}
+
+func TestGeneratePacketMirroring(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.PacketMirroringParameters
+		want *compute.PacketMirroring
+	}{
+		"Full": {
+			in:   *params(),
+			want: packetMirroring(),
+		},
+		"Minimal": {
+			in: v1alpha1.PacketMirroringParameters{
+				Region:       testRegion,
+				CollectorILB: "collector",
+			},
+			want: &compute.PacketMirroring{
+				Name:              testName,
+				Region:            testRegion,
+				CollectorIlb:      &compute.PacketMirroringForwardingRuleInfo{Url: "collector"},
+				MirroredResources: &compute.PacketMirroringMirroredResourceInfo{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePacketMirroring(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GeneratePacketMirroring(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.PacketMirroringParameters
+		observed compute.PacketMirroring
+		want     *v1alpha1.PacketMirroringParameters
+	}{
+		"FillsDefaults": {
+			spec: params(func(p *v1alpha1.PacketMirroringParameters) {
+				p.Priority = nil
+				p.Enable = nil
+				p.Network = nil
+			}),
+			observed: *packetMirroring(),
+			want:     params(),
+		},
+		"KeepsSpec": {
+			spec: params(),
+			observed: *packetMirroring(func(pm *compute.PacketMirroring) {
+				pm.Priority = 5
+			}),
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.PacketMirroringParameters
+		observed *compute.PacketMirroring
+		want     bool
+	}{
+		"UpToDate": {
+			in: *params(),
+			observed: packetMirroring(func(pm *compute.PacketMirroring) {
+				pm.Id = 42
+				pm.SelfLink = "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1/packetMirrorings/some-mirroring"
+				pm.Region = "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1"
+				pm.Network.CanonicalUrl = "https://www.googleapis.com/compute/v1/projects/p/global/networks/default"
+				pm.MirroredResources.Tags = []string{"y", "x"}
+			}),
+			want: true,
+		},
+		"PriorityChanged": {
+			in: *params(),
+			observed: packetMirroring(func(pm *compute.PacketMirroring) {
+				pm.Priority = 1
+			}),
+			want: false,
+		},
+		"SubnetworkRemoved": {
+			in: *params(),
+			observed: packetMirroring(func(pm *compute.PacketMirroring) {
+				pm.MirroredResources.Subnetworks = pm.MirroredResources.Subnetworks[:1]
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(testName, tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
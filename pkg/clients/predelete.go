@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errPreDelete = "cannot prepare external resource for deletion"
+
+// A PreDeleteHook prepares an external resource to be deleted, for example by
+// removing references to it from other resources so that none dangle once it
+// is gone.
+type PreDeleteHook interface {
+	PreDelete(ctx context.Context, mg resource.Managed) error
+}
+
+// A PreDeleteHookFn is a function that satisfies the PreDeleteHook interface.
+type PreDeleteHookFn func(ctx context.Context, mg resource.Managed) error
+
+// PreDelete calls the PreDeleteHookFn.
+func (fn PreDeleteHookFn) PreDelete(ctx context.Context, mg resource.Managed) error {
+	return fn(ctx, mg)
+}
+
+// WithPreDeleteHooks returns an ExternalClient that runs the supplied hooks
+// before it deletes an external resource using the supplied ExternalClient.
+//
+// Hooks run in the order they are supplied, each only once the previous hook
+// has succeeded, and the external resource is deleted only once every hook
+// has succeeded. If a hook fails its error is returned, and neither the
+// remaining hooks nor the deletion run; the managed reconciler retries them
+// all on its next reconcile. Hooks run each time deletion is attempted, until
+// the external resource is observed not to exist, so they must be idempotent.
+// Hooks never run for managed resources whose reclaim policy is Retain,
+// because their external resources are never deleted.
+func WithPreDeleteHooks(c managed.ExternalClient, h ...PreDeleteHook) managed.ExternalClient {
+	if len(h) == 0 {
+		return c
+	}
+	return &preDeleteExternal{ExternalClient: c, hooks: h}
+}
+
+type preDeleteExternal struct {
+	managed.ExternalClient
+	hooks []PreDeleteHook
+}
+
+func (e *preDeleteExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	for _, h := range e.hooks {
+		if err := h.PreDelete(ctx, mg); err != nil {
+			return errors.Wrap(err, errPreDelete)
+		}
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
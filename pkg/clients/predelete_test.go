@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestWithPreDeleteHooks(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	// hook returns a hook that records its name in calls, then returns err.
+	hook := func(calls *[]string, name string, err error) PreDeleteHook {
+		return PreDeleteHookFn(func(_ context.Context, _ resource.Managed) error {
+			*calls = append(*calls, name)
+			return err
+		})
+	}
+
+	cases := map[string]struct {
+		hooks     func(calls *[]string) []PreDeleteHook
+		deleteErr error
+		want      want
+	}{
+		"NoHooks": {
+			hooks: func(_ *[]string) []PreDeleteHook { return nil },
+			want:  want{calls: []string{"delete"}},
+		},
+		"HooksRunInOrderBeforeDelete": {
+			hooks: func(calls *[]string) []PreDeleteHook {
+				return []PreDeleteHook{hook(calls, "first", nil), hook(calls, "second", nil)}
+			},
+			want: want{calls: []string{"first", "second", "delete"}},
+		},
+		"HookFailed": {
+			hooks: func(calls *[]string) []PreDeleteHook {
+				return []PreDeleteHook{hook(calls, "first", errBoom), hook(calls, "second", nil)}
+			},
+			want: want{
+				calls: []string{"first"},
+				err:   errors.Wrap(errBoom, errPreDelete),
+			},
+		},
+		"DeleteFailed": {
+			hooks: func(calls *[]string) []PreDeleteHook {
+				return []PreDeleteHook{hook(calls, "first", nil)}
+			},
+			deleteErr: errBoom,
+			want: want{
+				calls: []string{"first", "delete"},
+				err:   errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			c := &managed.ExternalClientFns{
+				DeleteFn: func(_ context.Context, _ resource.Managed) error {
+					calls = append(calls, "delete")
+					return tc.deleteErr
+				},
+			}
+			err := WithPreDeleteHooks(c, tc.hooks(&calls)...).Delete(context.Background(), &fake.Managed{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Delete(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
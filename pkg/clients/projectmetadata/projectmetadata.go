@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectmetadata
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+)
+
+// Well known metadata keys.
+const (
+	KeyEnableOSLogin       = "enable-oslogin"
+	KeyBlockProjectSSHKeys = "block-project-ssh-keys"
+	KeySSHKeys             = "ssh-keys"
+)
+
+// GenerateItems returns the metadata keys and values declared by the supplied
+// ProjectMetadataParameters. Boolean keys are TRUE or FALSE, and SSH keys
+// are separated by newlines.
+func GenerateItems(in v1alpha1.ProjectMetadataParameters) map[string]string {
+	items := make(map[string]string, len(in.Items)+3)
+	for k, v := range in.Items {
+		items[k] = v
+	}
+	if in.EnableOSLogin != nil {
+		items[KeyEnableOSLogin] = boolValue(*in.EnableOSLogin)
+	}
+	if in.BlockProjectSSHKeys != nil {
+		items[KeyBlockProjectSSHKeys] = boolValue(*in.BlockProjectSSHKeys)
+	}
+	if in.SSHKeys != nil {
+		items[KeySSHKeys] = strings.Join(in.SSHKeys, "\n")
+	}
+	return items
+}
+
+func boolValue(b bool) string {
+	if b {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+// GenerateMetadata returns the common instance metadata that results from
+// setting the keys declared by the supplied ProjectMetadataParameters in the
+// observed metadata. Other keys are kept as they are. The fingerprint of the
+// observed metadata is kept, so that setting the metadata fails rather than
+// overwrite a concurrent change.
+func GenerateMetadata(in v1alpha1.ProjectMetadataParameters, observed compute.Metadata) *compute.Metadata {
+	desired := GenerateItems(in)
+	md := &compute.Metadata{Fingerprint: observed.Fingerprint}
+	for _, i := range observed.Items {
+		if v, ok := desired[i.Key]; ok {
+			md.Items = append(md.Items, &compute.MetadataItems{Key: i.Key, Value: &v})
+			delete(desired, i.Key)
+			continue
+		}
+		md.Items = append(md.Items, i)
+	}
+	for _, k := range sortedKeys(desired) {
+		v := desired[k]
+		md.Items = append(md.Items, &compute.MetadataItems{Key: k, Value: &v})
+	}
+	return md
+}
+
+// RemoveItems returns the common instance metadata that results from removing
+// the keys declared by the supplied ProjectMetadataParameters from the
+// observed metadata, and whether any key was removed.
+func RemoveItems(in v1alpha1.ProjectMetadataParameters, observed compute.Metadata) (*compute.Metadata, bool) {
+	declared := GenerateItems(in)
+	md := &compute.Metadata{Fingerprint: observed.Fingerprint}
+	removed := false
+	for _, i := range observed.Items {
+		if _, ok := declared[i.Key]; ok {
+			removed = true
+			continue
+		}
+		md.Items = append(md.Items, i)
+	}
+	return md, removed
+}
+
+// GenerateObservation creates a ProjectMetadataObservation from the supplied
+// compute.Metadata.
+func GenerateObservation(in compute.Metadata) v1alpha1.ProjectMetadataObservation {
+	return v1alpha1.ProjectMetadataObservation{Fingerprint: in.Fingerprint}
+}
+
+// Exists returns true if any of the keys declared by the supplied
+// ProjectMetadataParameters is set in the observed metadata.
+func Exists(in v1alpha1.ProjectMetadataParameters, observed compute.Metadata) bool {
+	_, removed := RemoveItems(in, observed)
+	return removed
+}
+
+// IsUpToDate returns true if all keys declared by the supplied
+// ProjectMetadataParameters are set to the declared values in the observed
+// metadata. Keys that are not declared are not compared. Boolean keys are
+// compared regardless of case, because Compute Engine accepts either.
+func IsUpToDate(in v1alpha1.ProjectMetadataParameters, observed compute.Metadata) bool {
+	values := make(map[string]string, len(observed.Items))
+	for _, i := range observed.Items {
+		values[i.Key] = ""
+		if i.Value != nil {
+			values[i.Key] = *i.Value
+		}
+	}
+	for k, v := range GenerateItems(in) {
+		o, ok := values[k]
+		if !ok {
+			return false
+		}
+		switch k {
+		case KeyEnableOSLogin, KeyBlockProjectSSHKeys:
+			if !strings.EqualFold(v, o) {
+				return false
+			}
+		default:
+			if v != o {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectmetadata
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func items(kv ...string) []*compute.MetadataItems {
+	out := make([]*compute.MetadataItems, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		v := kv[i+1]
+		out = append(out, &compute.MetadataItems{Key: kv[i], Value: &v})
+	}
+	return out
+}
+
+func TestGenerateItems(t *testing.T) {
+	in := v1alpha1.ProjectMetadataParameters{
+		EnableOSLogin:       gcp.BoolPtr(true),
+		BlockProjectSSHKeys: gcp.BoolPtr(false),
+		SSHKeys:             []string{"alice:ssh-ed25519 AAAA alice", "bob:ssh-ed25519 BBBB bob"},
+		Items:               map[string]string{"team": "platform"},
+	}
+	want := map[string]string{
+		KeyEnableOSLogin:       "TRUE",
+		KeyBlockProjectSSHKeys: "FALSE",
+		KeySSHKeys:             "alice:ssh-ed25519 AAAA alice\nbob:ssh-ed25519 BBBB bob",
+		"team":                 "platform",
+	}
+	if diff := cmp.Diff(want, GenerateItems(in)); diff != "" {
+		t.Errorf("GenerateItems(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateMetadata(t *testing.T) {
+	in := v1alpha1.ProjectMetadataParameters{
+		EnableOSLogin: gcp.BoolPtr(true),
+		Items:         map[string]string{"b": "2", "a": "1"},
+	}
+	observed := compute.Metadata{Fingerprint: "fp", Items: items("startup-script", "echo hi", KeyEnableOSLogin, "FALSE")}
+	want := &compute.Metadata{Fingerprint: "fp", Items: items("startup-script", "echo hi", KeyEnableOSLogin, "TRUE", "a", "1", "b", "2")}
+	if diff := cmp.Diff(want, GenerateMetadata(in, observed)); diff != "" {
+		t.Errorf("GenerateMetadata(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestRemoveItems(t *testing.T) {
+	in := v1alpha1.ProjectMetadataParameters{EnableOSLogin: gcp.BoolPtr(true)}
+
+	cases := map[string]struct {
+		observed    compute.Metadata
+		want        *compute.Metadata
+		wantRemoved bool
+	}{
+		"Removed": {
+			observed:    compute.Metadata{Fingerprint: "fp", Items: items(KeyEnableOSLogin, "TRUE", "other", "value")},
+			want:        &compute.Metadata{Fingerprint: "fp", Items: items("other", "value")},
+			wantRemoved: true,
+		},
+		"NotSet": {
+			observed: compute.Metadata{Fingerprint: "fp", Items: items("other", "value")},
+			want:     &compute.Metadata{Fingerprint: "fp", Items: items("other", "value")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, removed := RemoveItems(in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("RemoveItems(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemoved, removed); diff != "" {
+				t.Errorf("RemoveItems(...): -want removed, +got removed:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.ProjectMetadataParameters
+		observed compute.Metadata
+		want     bool
+	}{
+		"UpToDate": {
+			in:       v1alpha1.ProjectMetadataParameters{EnableOSLogin: gcp.BoolPtr(true), Items: map[string]string{"team": "platform"}},
+			observed: compute.Metadata{Items: items(KeyEnableOSLogin, "true", "team", "platform", "other", "value")},
+			want:     true,
+		},
+		"UndeclaredKeysIgnored": {
+			in:       v1alpha1.ProjectMetadataParameters{},
+			observed: compute.Metadata{Items: items(KeyEnableOSLogin, "FALSE")},
+			want:     true,
+		},
+		"BoolChanged": {
+			in:       v1alpha1.ProjectMetadataParameters{BlockProjectSSHKeys: gcp.BoolPtr(true)},
+			observed: compute.Metadata{Items: items(KeyBlockProjectSSHKeys, "FALSE")},
+			want:     false,
+		},
+		"SSHKeysChanged": {
+			in:       v1alpha1.ProjectMetadataParameters{SSHKeys: []string{"alice:ssh-ed25519 AAAA alice"}},
+			observed: compute.Metadata{Items: items(KeySSHKeys, "alice:ssh-ed25519 AAAA alice\nmallory:ssh-ed25519 MMMM mallory")},
+			want:     false,
+		},
+		"ItemMissing": {
+			in:       v1alpha1.ProjectMetadataParameters{Items: map[string]string{"team": "platform"}},
+			observed: compute.Metadata{},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+// Error strings.
+const (
+	errGetProvider       = "cannot get Provider"
+	errProviderSecretRef = "cannot find Secret reference on Provider"
+	errGetProviderSecret = "cannot get Provider Secret"
+	errNewClientOptions  = "cannot create client options"
+)
+
+// ProviderCredentials returns the referenced Provider and the JSON
+// credentials stored in the Secret it references. No credentials are returned
+// if the Provider's credentials are injected.
+func ProviderCredentials(ctx context.Context, kube client.Client, ref *corev1.ObjectReference) (*v1alpha3.Provider, []byte, error) {
+	p := &v1alpha3.Provider{}
+	if err := kube.Get(ctx, meta.NamespacedNameOf(ref), p); err != nil {
+		return nil, nil, errors.Wrap(err, errGetProvider)
+	}
+	if p.Spec.CredentialsSource == v1alpha3.CredentialsSourceInjectedIdentity {
+		return p, nil, nil
+	}
+
+	if p.GetCredentialsSecretReference() == nil {
+		return nil, nil, errors.New(errProviderSecretRef)
+	}
+	s := &corev1.Secret{}
+	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
+	if err := kube.Get(ctx, n, s); err != nil {
+		return nil, nil, errors.Wrap(err, errGetProviderSecret)
+	}
+	creds, err := CredentialsData(s, p.Spec.CredentialsSecretRef.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p, creds, nil
+}
+
+// ProviderClientOptions returns the options used to call GCP APIs with the
+// supplied scopes as the referenced Provider, which is also returned. Like
+// those returned by ClientOptions, the options honour the Provider's HTTP
+// client configuration.
+func ProviderClientOptions(ctx context.Context, kube client.Client, ref *corev1.ObjectReference, scopes ...string) ([]option.ClientOption, *v1alpha3.Provider, error) {
+	p, creds, err := ProviderCredentials(ctx, kube, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts, err := CredentialsClientOptions(ctx, creds, p.Spec.HTTPClient, scopes...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return opts, p, nil
+}
+
+// CredentialsClientOptions returns the options returned by ClientOptions for
+// the supplied credentials, or by InjectedIdentityClientOptions if there are
+// none, as is the case for a Provider whose credentials are injected.
+func CredentialsClientOptions(ctx context.Context, creds []byte, cfg *v1alpha3.HTTPClientConfig, scopes ...string) ([]option.ClientOption, error) {
+	var opts []option.ClientOption
+	var err error
+	if creds == nil {
+		opts, err = InjectedIdentityClientOptions(ctx, cfg, scopes...)
+	} else {
+		opts, err = ClientOptions(ctx, creds, cfg, scopes...)
+	}
+	return opts, errors.Wrap(err, errNewClientOptions)
+}
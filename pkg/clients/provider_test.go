@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+func TestProviderCredentials(t *testing.T) {
+	errBoom := errors.New("boom")
+	creds := []byte(`{"type":"service_account"}`)
+	ref := &corev1.ObjectReference{Name: "gcp"}
+	secretRef := &runtimev1alpha1.SecretKeySelector{
+		SecretReference: runtimev1alpha1.SecretReference{Namespace: "crossplane-system", Name: "gcp-creds"},
+	}
+	provider := func(m func(p *v1alpha3.Provider)) *v1alpha3.Provider {
+		p := &v1alpha3.Provider{ObjectMeta: metav1.ObjectMeta{Name: "gcp"}}
+		p.Spec.ProjectID = "perfect-project"
+		if m != nil {
+			m(p)
+		}
+		return p
+	}
+	get := func(p *v1alpha3.Provider, data map[string][]byte, secretErr error) client.Client {
+		return &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+			switch o := obj.(type) {
+			case *v1alpha3.Provider:
+				p.DeepCopyInto(o)
+			case *corev1.Secret:
+				o.SetNamespace("crossplane-system")
+				o.SetName("gcp-creds")
+				o.Data = data
+				return secretErr
+			}
+			return nil
+		}}
+	}
+
+	type want struct {
+		p     *v1alpha3.Provider
+		creds []byte
+		err   error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		want want
+	}{
+		"GetProviderFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{err: errors.Wrap(errBoom, errGetProvider)},
+		},
+		"NoSecretReference": {
+			kube: get(provider(nil), nil, nil),
+			want: want{err: errors.New(errProviderSecretRef)},
+		},
+		"GetSecretFailed": {
+			kube: get(provider(func(p *v1alpha3.Provider) { p.Spec.CredentialsSecretRef = secretRef }), nil, errBoom),
+			want: want{err: errors.Wrap(errBoom, errGetProviderSecret)},
+		},
+		"MissingCredentials": {
+			kube: get(provider(func(p *v1alpha3.Provider) { p.Spec.CredentialsSecretRef = secretRef }), map[string][]byte{"key.json": creds}, nil),
+			want: want{err: errors.Errorf(errMissingCredentialsData, "crossplane-system", "gcp-creds", DefaultCredentialsKey)},
+		},
+		"Credentials": {
+			kube: get(provider(func(p *v1alpha3.Provider) { p.Spec.CredentialsSecretRef = secretRef }), map[string][]byte{DefaultCredentialsKey: creds}, nil),
+			want: want{
+				p:     provider(func(p *v1alpha3.Provider) { p.Spec.CredentialsSecretRef = secretRef }),
+				creds: creds,
+			},
+		},
+		"InjectedIdentity": {
+			kube: get(provider(func(p *v1alpha3.Provider) { p.Spec.CredentialsSource = v1alpha3.CredentialsSourceInjectedIdentity }), nil, errBoom),
+			want: want{
+				p: provider(func(p *v1alpha3.Provider) { p.Spec.CredentialsSource = v1alpha3.CredentialsSourceInjectedIdentity }),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, creds, err := ProviderCredentials(context.Background(), tc.kube, ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ProviderCredentials(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.p, p); diff != "" {
+				t.Errorf("ProviderCredentials(...): -want provider, +got provider:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.creds, creds); diff != "" {
+				t.Errorf("ProviderCredentials(...): -want credentials, +got credentials:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pubsublite contains a client for the GCP Pub/Sub Lite admin API
+// and utilities to convert between its resources and managed resources.
+//
+// The Google API client library used by this provider predates Pub/Sub Lite,
+// so this package implements the small subset of the v1 admin REST API that
+// the Pub/Sub Lite controllers need on top of the same authenticated
+// transport. Unlike most Google APIs, Pub/Sub Lite is only served from
+// regional endpoints, so every call is sent to the endpoint of the region of
+// the resource it addresses.
+package pubsublite
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Client defaults.
+const (
+	DefaultEndpoint    = "https://pubsublite.googleapis.com/"
+	CloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// A Topic is a Pub/Sub Lite topic.
+type Topic struct {
+	Name              string             `json:"name,omitempty"`
+	PartitionConfig   *PartitionConfig   `json:"partitionConfig,omitempty"`
+	RetentionConfig   *RetentionConfig   `json:"retentionConfig,omitempty"`
+	ReservationConfig *ReservationConfig `json:"reservationConfig,omitempty"`
+}
+
+// A PartitionConfig configures the partitions of a Topic. The API encodes
+// 64 bit integers as strings.
+type PartitionConfig struct {
+	Count    int64     `json:"count,string,omitempty"`
+	Capacity *Capacity `json:"capacity,omitempty"`
+}
+
+// A Capacity is the throughput capacity of a partition.
+type Capacity struct {
+	PublishMibPerSec   int64 `json:"publishMibPerSec,omitempty"`
+	SubscribeMibPerSec int64 `json:"subscribeMibPerSec,omitempty"`
+}
+
+// A RetentionConfig configures how long the messages of a Topic are
+// retained.
+type RetentionConfig struct {
+	PerPartitionBytes int64  `json:"perPartitionBytes,string,omitempty"`
+	Period            string `json:"period,omitempty"`
+}
+
+// A ReservationConfig configures the reservation of a Topic.
+type ReservationConfig struct {
+	ThroughputReservation string `json:"throughputReservation,omitempty"`
+}
+
+// A Subscription is a Pub/Sub Lite subscription.
+type Subscription struct {
+	Name           string          `json:"name,omitempty"`
+	Topic          string          `json:"topic,omitempty"`
+	DeliveryConfig *DeliveryConfig `json:"deliveryConfig,omitempty"`
+}
+
+// A DeliveryConfig configures how a Subscription delivers messages.
+type DeliveryConfig struct {
+	DeliveryRequirement string `json:"deliveryRequirement,omitempty"`
+}
+
+// A Reservation is a Pub/Sub Lite reservation.
+type Reservation struct {
+	Name               string `json:"name,omitempty"`
+	ThroughputCapacity int64  `json:"throughputCapacity,string,omitempty"`
+}
+
+// TopicName returns the resource name of the supplied topic.
+func TopicName(project, location, topic string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/topics/%s", project, location, topic)
+}
+
+// SubscriptionName returns the resource name of the supplied subscription.
+func SubscriptionName(project, location, subscription string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/subscriptions/%s", project, location, subscription)
+}
+
+// ReservationName returns the resource name of the supplied reservation.
+func ReservationName(project, region, reservation string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/reservations/%s", project, region, reservation)
+}
+
+// Region returns the region of the supplied location, which may be either a
+// zone such as us-central1-a or a region such as us-central1.
+func Region(location string) string {
+	if parts := strings.Split(location, "-"); len(parts) == 3 {
+		return parts[0] + "-" + parts[1]
+	}
+	return location
+}
+
+// location returns the location segment of the supplied resource name.
+func location(name string) string {
+	parts := strings.Split(name, "/")
+	if len(parts) < 4 || parts[0] != "projects" || parts[2] != "locations" {
+		return ""
+	}
+	return parts[3]
+}
+
+// A Service calls the Pub/Sub Lite admin API.
+type Service struct {
+	json     *gcp.JSONClient
+	basePath string
+}
+
+// NewService returns a Service configured per the supplied options.
+func NewService(ctx context.Context, opts ...option.ClientOption) (*Service, error) {
+	c, err := gcp.NewJSONClient(ctx, DefaultEndpoint, append([]option.ClientOption{option.WithScopes(CloudPlatformScope)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{json: c, basePath: c.BasePath()}, nil
+}
+
+// GetTopic returns the named topic.
+func (s *Service) GetTopic(ctx context.Context, name string) (*Topic, error) {
+	t := &Topic{}
+	return t, s.do(ctx, http.MethodGet, name, nil, nil, t)
+}
+
+// CreateTopic creates the supplied topic in the supplied project and
+// location.
+func (s *Service) CreateTopic(ctx context.Context, project, location, id string, t *Topic) (*Topic, error) {
+	out := &Topic{}
+	parent := fmt.Sprintf("projects/%s/locations/%s", project, location)
+	return out, s.do(ctx, http.MethodPost, parent+"/topics", url.Values{"topicId": {id}}, t, out)
+}
+
+// PatchTopic updates the supplied fields of the named topic.
+func (s *Service) PatchTopic(ctx context.Context, name string, t *Topic, mask ...string) (*Topic, error) {
+	out := &Topic{}
+	return out, s.do(ctx, http.MethodPatch, name, url.Values{"updateMask": {strings.Join(mask, ",")}}, t, out)
+}
+
+// DeleteTopic deletes the named topic.
+func (s *Service) DeleteTopic(ctx context.Context, name string) error {
+	return s.do(ctx, http.MethodDelete, name, nil, nil, &struct{}{})
+}
+
+// GetSubscription returns the named subscription.
+func (s *Service) GetSubscription(ctx context.Context, name string) (*Subscription, error) {
+	sub := &Subscription{}
+	return sub, s.do(ctx, http.MethodGet, name, nil, nil, sub)
+}
+
+// CreateSubscription creates the supplied subscription in the supplied
+// project and location.
+func (s *Service) CreateSubscription(ctx context.Context, project, location, id string, sub *Subscription) (*Subscription, error) {
+	out := &Subscription{}
+	parent := fmt.Sprintf("projects/%s/locations/%s", project, location)
+	return out, s.do(ctx, http.MethodPost, parent+"/subscriptions", url.Values{"subscriptionId": {id}}, sub, out)
+}
+
+// PatchSubscription updates the supplied fields of the named subscription.
+func (s *Service) PatchSubscription(ctx context.Context, name string, sub *Subscription, mask ...string) (*Subscription, error) {
+	out := &Subscription{}
+	return out, s.do(ctx, http.MethodPatch, name, url.Values{"updateMask": {strings.Join(mask, ",")}}, sub, out)
+}
+
+// DeleteSubscription deletes the named subscription.
+func (s *Service) DeleteSubscription(ctx context.Context, name string) error {
+	return s.do(ctx, http.MethodDelete, name, nil, nil, &struct{}{})
+}
+
+// GetReservation returns the named reservation.
+func (s *Service) GetReservation(ctx context.Context, name string) (*Reservation, error) {
+	r := &Reservation{}
+	return r, s.do(ctx, http.MethodGet, name, nil, nil, r)
+}
+
+// CreateReservation creates the supplied reservation in the supplied project
+// and region.
+func (s *Service) CreateReservation(ctx context.Context, project, region, id string, r *Reservation) (*Reservation, error) {
+	out := &Reservation{}
+	parent := fmt.Sprintf("projects/%s/locations/%s", project, region)
+	return out, s.do(ctx, http.MethodPost, parent+"/reservations", url.Values{"reservationId": {id}}, r, out)
+}
+
+// PatchReservation updates the supplied fields of the named reservation.
+func (s *Service) PatchReservation(ctx context.Context, name string, r *Reservation, mask ...string) (*Reservation, error) {
+	out := &Reservation{}
+	return out, s.do(ctx, http.MethodPatch, name, url.Values{"updateMask": {strings.Join(mask, ",")}}, r, out)
+}
+
+// DeleteReservation deletes the named reservation.
+func (s *Service) DeleteReservation(ctx context.Context, name string) error {
+	return s.do(ctx, http.MethodDelete, name, nil, nil, &struct{}{})
+}
+
+// endpoint returns the endpoint that serves the supplied resource path. The
+// regional endpoint of the resource is used unless the Service was
+// configured with a custom endpoint.
+func (s *Service) endpoint(path string) string {
+	if s.basePath != DefaultEndpoint {
+		return s.basePath
+	}
+	return "https://" + Region(location(path)) + "-pubsublite.googleapis.com/"
+}
+
+// do sends a request for the supplied resource path and decodes the response
+// into out.
+func (s *Service) do(ctx context.Context, method, path string, q url.Values, in, out interface{}) error {
+	return s.json.WithBasePath(s.endpoint(path)).Do(ctx, method, "v1/admin/"+path, q, in, out)
+}
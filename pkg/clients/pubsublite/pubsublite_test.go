@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsublite
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegion(t *testing.T) {
+	cases := map[string]string{
+		"us-central1-a": "us-central1",
+		"us-central1":   "us-central1",
+	}
+
+	for location, want := range cases {
+		t.Run(location, func(t *testing.T) {
+			if diff := cmp.Diff(want, Region(location)); diff != "" {
+				t.Errorf("Region(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		basePath string
+		path     string
+		want     string
+	}{
+		"ZonalResource": {
+			basePath: DefaultEndpoint,
+			path:     TopicName("my-project", "europe-west1-b", "my-topic"),
+			want:     "https://europe-west1-pubsublite.googleapis.com/",
+		},
+		"RegionalResource": {
+			basePath: DefaultEndpoint,
+			path:     ReservationName("my-project", "us-east1", "my-reservation"),
+			want:     "https://us-east1-pubsublite.googleapis.com/",
+		},
+		"CustomEndpoint": {
+			basePath: "http://localhost:8080/",
+			path:     TopicName("my-project", "europe-west1-b", "my-topic"),
+			want:     "http://localhost:8080/",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &Service{basePath: tc.basePath}
+			if diff := cmp.Diff(tc.want, s.endpoint(tc.path)); diff != "" {
+				t.Errorf("s.endpoint(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsublite
+
+import (
+	"github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+)
+
+// ReservationUpdateMask lists the fields of a reservation that may be
+// updated.
+var ReservationUpdateMask = []string{"throughputCapacity"}
+
+// GenerateReservation takes LiteReservationParameters and returns a
+// Reservation.
+func GenerateReservation(in v1alpha1.LiteReservationParameters) *Reservation {
+	return &Reservation{ThroughputCapacity: in.ThroughputCapacity}
+}
+
+// GenerateReservationObservation takes a Reservation and returns a
+// LiteReservationObservation.
+func GenerateReservationObservation(in Reservation) v1alpha1.LiteReservationObservation {
+	return v1alpha1.LiteReservationObservation{Name: in.Name}
+}
+
+// IsReservationUpToDate returns true if the throughput capacity of the
+// supplied Reservation matches the supplied LiteReservationParameters.
+func IsReservationUpToDate(in v1alpha1.LiteReservationParameters, observed Reservation) bool {
+	return in.ThroughputCapacity == observed.ThroughputCapacity
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsublite
+
+import (
+	"github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// SubscriptionUpdateMask lists the fields of a subscription that may be
+// updated.
+var SubscriptionUpdateMask = []string{"deliveryConfig"}
+
+// GenerateSubscription takes LiteSubscriptionParameters and returns a
+// Subscription.
+func GenerateSubscription(in v1alpha1.LiteSubscriptionParameters) *Subscription {
+	s := &Subscription{Topic: gcp.StringValue(in.Topic)}
+	if in.DeliveryRequirement != nil {
+		s.DeliveryConfig = &DeliveryConfig{DeliveryRequirement: *in.DeliveryRequirement}
+	}
+	return s
+}
+
+// GenerateSubscriptionObservation takes a Subscription and returns a
+// LiteSubscriptionObservation.
+func GenerateSubscriptionObservation(in Subscription) v1alpha1.LiteSubscriptionObservation {
+	return v1alpha1.LiteSubscriptionObservation{Name: in.Name}
+}
+
+// LateInitializeSubscription fills the empty fields of the supplied
+// LiteSubscriptionParameters with those of the supplied Subscription.
+func LateInitializeSubscription(spec *v1alpha1.LiteSubscriptionParameters, in Subscription) {
+	spec.Topic = gcp.LateInitializeString(spec.Topic, in.Topic)
+	if in.DeliveryConfig != nil {
+		spec.DeliveryRequirement = gcp.LateInitializeString(spec.DeliveryRequirement, in.DeliveryConfig.DeliveryRequirement)
+	}
+}
+
+// IsSubscriptionUpToDate returns true if the delivery requirement of the
+// supplied Subscription matches the supplied LiteSubscriptionParameters.
+func IsSubscriptionUpToDate(in v1alpha1.LiteSubscriptionParameters, observed Subscription) bool {
+	if in.DeliveryRequirement == nil {
+		return true
+	}
+	return observed.DeliveryConfig != nil && *in.DeliveryRequirement == observed.DeliveryConfig.DeliveryRequirement
+}
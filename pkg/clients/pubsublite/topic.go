@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsublite
+
+import (
+	"strings"
+
+	"github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateTopic takes LiteTopicParameters and returns a Topic.
+func GenerateTopic(in v1alpha1.LiteTopicParameters) *Topic {
+	t := &Topic{PartitionConfig: &PartitionConfig{Count: in.PartitionConfig.Count}}
+	if c := in.PartitionConfig.Capacity; c != nil {
+		t.PartitionConfig.Capacity = &Capacity{PublishMibPerSec: c.PublishMiBPerSec, SubscribeMibPerSec: c.SubscribeMiBPerSec}
+	}
+	if r := in.RetentionConfig; r != nil {
+		t.RetentionConfig = &RetentionConfig{PerPartitionBytes: gcp.Int64Value(r.PerPartitionBytes), Period: gcp.StringValue(r.Period)}
+	}
+	if r := in.ReservationConfig; r != nil {
+		t.ReservationConfig = &ReservationConfig{ThroughputReservation: gcp.StringValue(r.ThroughputReservation)}
+	}
+	return t
+}
+
+// TopicUpdateMask returns the fields of a topic that are updated to match
+// the supplied LiteTopicParameters. Optional configuration is only updated
+// when it is specified, so that it is not reset to its default.
+func TopicUpdateMask(in v1alpha1.LiteTopicParameters) []string {
+	mask := []string{"partitionConfig.count"}
+	if in.PartitionConfig.Capacity != nil {
+		mask = append(mask, "partitionConfig.capacity")
+	}
+	if in.RetentionConfig != nil {
+		mask = append(mask, "retentionConfig")
+	}
+	if in.ReservationConfig != nil {
+		mask = append(mask, "reservationConfig")
+	}
+	return mask
+}
+
+// GenerateTopicObservation takes a Topic and returns a LiteTopicObservation.
+func GenerateTopicObservation(in Topic) v1alpha1.LiteTopicObservation {
+	return v1alpha1.LiteTopicObservation{Name: in.Name}
+}
+
+// LateInitializeTopic fills the empty fields of the supplied
+// LiteTopicParameters with those of the supplied Topic.
+func LateInitializeTopic(spec *v1alpha1.LiteTopicParameters, in Topic) {
+	if pc := in.PartitionConfig; pc != nil {
+		if spec.PartitionConfig.Count == 0 {
+			spec.PartitionConfig.Count = pc.Count
+		}
+		if spec.PartitionConfig.Capacity == nil && pc.Capacity != nil {
+			spec.PartitionConfig.Capacity = &v1alpha1.LitePartitionCapacity{
+				PublishMiBPerSec:   pc.Capacity.PublishMibPerSec,
+				SubscribeMiBPerSec: pc.Capacity.SubscribeMibPerSec,
+			}
+		}
+	}
+	if rc := in.RetentionConfig; rc != nil {
+		if spec.RetentionConfig == nil {
+			spec.RetentionConfig = &v1alpha1.LiteRetentionConfig{}
+		}
+		spec.RetentionConfig.PerPartitionBytes = gcp.LateInitializeInt64(spec.RetentionConfig.PerPartitionBytes, rc.PerPartitionBytes)
+		spec.RetentionConfig.Period = gcp.LateInitializeString(spec.RetentionConfig.Period, rc.Period)
+	}
+	if rc := in.ReservationConfig; rc != nil && rc.ThroughputReservation != "" {
+		if spec.ReservationConfig == nil {
+			spec.ReservationConfig = &v1alpha1.LiteReservationConfig{}
+		}
+		spec.ReservationConfig.ThroughputReservation = gcp.LateInitializeString(spec.ReservationConfig.ThroughputReservation, rc.ThroughputReservation)
+	}
+}
+
+// IsTopicUpToDate returns true if the partition count, throughput capacity,
+// retention and reservation of the supplied Topic match the supplied
+// LiteTopicParameters. Optional configuration that is not specified is
+// considered up to date.
+func IsTopicUpToDate(in v1alpha1.LiteTopicParameters, observed Topic) bool {
+	desired := GenerateTopic(in)
+	pc := observed.PartitionConfig
+	if pc == nil {
+		pc = &PartitionConfig{}
+	}
+	if desired.PartitionConfig.Count != pc.Count {
+		return false
+	}
+	if c := desired.PartitionConfig.Capacity; c != nil && (pc.Capacity == nil || *c != *pc.Capacity) {
+		return false
+	}
+	if r := desired.RetentionConfig; r != nil && (observed.RetentionConfig == nil || *r != *observed.RetentionConfig) {
+		return false
+	}
+	if r := desired.ReservationConfig; r != nil {
+		var current string
+		if observed.ReservationConfig != nil {
+			current = observed.ReservationConfig.ThroughputReservation
+		}
+		if withoutProject(r.ThroughputReservation) != withoutProject(current) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPartitionCountDecreased returns true if the supplied LiteTopicParameters
+// specify fewer partitions than the supplied Topic has. The partitions of a
+// topic can never be removed.
+func IsPartitionCountDecreased(in v1alpha1.LiteTopicParameters, observed Topic) bool {
+	return observed.PartitionConfig != nil && in.PartitionConfig.Count < observed.PartitionConfig.Count
+}
+
+// withoutProject returns the supplied resource name without its project
+// segment. The API may identify the project of a resource by its number
+// rather than its ID, so the project segment is not compared.
+func withoutProject(name string) string {
+	parts := strings.SplitN(name, "/", 3)
+	if len(parts) != 3 || parts[0] != "projects" {
+		return name
+	}
+	return parts[2]
+}
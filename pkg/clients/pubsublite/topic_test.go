@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsublite
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const reservation = "projects/my-project/locations/us-central1/reservations/my-reservation"
+
+func topicParams(m ...func(*v1alpha1.LiteTopicParameters)) v1alpha1.LiteTopicParameters {
+	p := v1alpha1.LiteTopicParameters{
+		Location: "us-central1-a",
+		PartitionConfig: v1alpha1.LitePartitionConfig{
+			Count:    2,
+			Capacity: &v1alpha1.LitePartitionCapacity{PublishMiBPerSec: 4, SubscribeMiBPerSec: 8},
+		},
+		RetentionConfig: &v1alpha1.LiteRetentionConfig{
+			PerPartitionBytes: gcp.Int64Ptr(32212254720),
+			Period:            gcp.StringPtr("86400s"),
+		},
+		ReservationConfig: &v1alpha1.LiteReservationConfig{ThroughputReservation: gcp.StringPtr(reservation)},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func topic(m ...func(*Topic)) *Topic {
+	t := &Topic{
+		PartitionConfig:   &PartitionConfig{Count: 2, Capacity: &Capacity{PublishMibPerSec: 4, SubscribeMibPerSec: 8}},
+		RetentionConfig:   &RetentionConfig{PerPartitionBytes: 32212254720, Period: "86400s"},
+		ReservationConfig: &ReservationConfig{ThroughputReservation: reservation},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGenerateTopic(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.LiteTopicParameters
+		want *Topic
+	}{
+		"Full": {
+			in:   topicParams(),
+			want: topic(),
+		},
+		"Minimal": {
+			in:   v1alpha1.LiteTopicParameters{PartitionConfig: v1alpha1.LitePartitionConfig{Count: 1}},
+			want: &Topic{PartitionConfig: &PartitionConfig{Count: 1}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTopic(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateTopic(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTopicUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.LiteTopicParameters
+		want []string
+	}{
+		"Full": {
+			in:   topicParams(),
+			want: []string{"partitionConfig.count", "partitionConfig.capacity", "retentionConfig", "reservationConfig"},
+		},
+		"Minimal": {
+			in:   v1alpha1.LiteTopicParameters{PartitionConfig: v1alpha1.LitePartitionConfig{Count: 1}},
+			want: []string{"partitionConfig.count"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TopicUpdateMask(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("TopicUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeTopic(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.LiteTopicParameters
+		in   Topic
+		want v1alpha1.LiteTopicParameters
+	}{
+		"AllEmpty": {
+			spec: v1alpha1.LiteTopicParameters{Location: "us-central1-a"},
+			in:   *topic(),
+			want: topicParams(),
+		},
+		"AllFilled": {
+			spec: topicParams(),
+			in: *topic(func(t *Topic) {
+				t.PartitionConfig.Count = 4
+				t.RetentionConfig.Period = "3600s"
+			}),
+			want: topicParams(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeTopic(&tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeTopic(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTopicUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.LiteTopicParameters
+		observed Topic
+		want     bool
+	}{
+		"UpToDate": {
+			in:       topicParams(),
+			observed: *topic(),
+			want:     true,
+		},
+		"ReservationByProjectNumber": {
+			in: topicParams(),
+			observed: *topic(func(t *Topic) {
+				t.ReservationConfig.ThroughputReservation = "projects/123456/locations/us-central1/reservations/my-reservation"
+			}),
+			want: true,
+		},
+		"UnspecifiedConfigIgnored": {
+			in:       v1alpha1.LiteTopicParameters{PartitionConfig: v1alpha1.LitePartitionConfig{Count: 2}},
+			observed: *topic(),
+			want:     true,
+		},
+		"PartitionCountIncreased": {
+			in:       topicParams(func(p *v1alpha1.LiteTopicParameters) { p.PartitionConfig.Count = 3 }),
+			observed: *topic(),
+			want:     false,
+		},
+		"CapacityChanged": {
+			in:       topicParams(func(p *v1alpha1.LiteTopicParameters) { p.PartitionConfig.Capacity.PublishMiBPerSec = 8 }),
+			observed: *topic(),
+			want:     false,
+		},
+		"RetentionChanged": {
+			in:       topicParams(func(p *v1alpha1.LiteTopicParameters) { p.RetentionConfig.Period = gcp.StringPtr("3600s") }),
+			observed: *topic(),
+			want:     false,
+		},
+		"ReservationChanged": {
+			in:       topicParams(),
+			observed: *topic(func(t *Topic) { t.ReservationConfig = nil }),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTopicUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsTopicUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPartitionCountDecreased(t *testing.T) {
+	cases := map[string]struct {
+		count int64
+		want  bool
+	}{
+		"Decreased": {count: 1, want: true},
+		"Unchanged": {count: 2, want: false},
+		"Increased": {count: 3, want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in := topicParams(func(p *v1alpha1.LiteTopicParameters) { p.PartitionConfig.Count = tc.count })
+			got := IsPartitionCountDecreased(in, *topic())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPartitionCountDecreased(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
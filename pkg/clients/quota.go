@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TypeQuotaExceeded indicates whether a GCP quota or limit prevented the
+// external resource from being created or updated.
+const TypeQuotaExceeded runtimev1alpha1.ConditionType = "QuotaExceeded"
+
+// Reasons a resource is or is not within its GCP quota.
+const (
+	ReasonQuotaExceeded runtimev1alpha1.ConditionReason = "QuotaExceeded"
+	ReasonWithinQuota   runtimev1alpha1.ConditionReason = "WithinQuota"
+)
+
+// quotaFailureType is the type URL of the error detail GCP APIs use to
+// describe the quota that was exceeded.
+const quotaFailureType = "type.googleapis.com/google.rpc.QuotaFailure"
+
+// quotaReasons are the googleapi.ErrorItem reasons that indicate a quota or
+// rate limit was exceeded.
+var quotaReasons = map[string]bool{
+	"quotaExceeded":         true,
+	"rateLimitExceeded":     true,
+	"userRateLimitExceeded": true,
+	"dailyLimitExceeded":    true,
+	"limitExceeded":         true,
+	"RESOURCE_EXHAUSTED":    true,
+}
+
+// quotaErrorBody is the subset of a GCP error response body that describes
+// an exceeded quota.
+type quotaErrorBody struct {
+	Error struct {
+		Status  string `json:"status"`
+		Details []struct {
+			Type       string `json:"@type"`
+			Violations []struct {
+				Subject     string `json:"subject"`
+				Description string `json:"description"`
+			} `json:"violations"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// IsErrorQuotaExceeded gets a value indicating whether the given error
+// represents a quota or limit being exceeded. It works for clients that use
+// either HTTP or gRPC as protocol.
+func IsErrorQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	if grpcErr, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return grpcErr.GRPCStatus().Code() == codes.ResourceExhausted
+	}
+	gErr, ok := err.(*googleapi.Error)
+	if !ok {
+		return false
+	}
+	if gErr.Code == http.StatusTooManyRequests {
+		return true
+	}
+	for _, e := range gErr.Errors {
+		if quotaReasons[e.Reason] {
+			return true
+		}
+	}
+	b := parseQuotaErrorBody(gErr.Body)
+	return b.Error.Status == "RESOURCE_EXHAUSTED" || len(quotaViolations(b)) > 0
+}
+
+// QuotaLimit returns a description of the quota or limit that the supplied
+// error reports as exceeded. It prefers the quota violations in the error's
+// details, and falls back to the error's message.
+func QuotaLimit(err error) string {
+	if grpcErr, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return grpcErr.GRPCStatus().Message()
+	}
+	gErr, ok := err.(*googleapi.Error)
+	if !ok {
+		return err.Error()
+	}
+	if v := quotaViolations(parseQuotaErrorBody(gErr.Body)); len(v) > 0 {
+		return strings.Join(v, "; ")
+	}
+	for _, e := range gErr.Errors {
+		if quotaReasons[e.Reason] && e.Message != "" {
+			return e.Message
+		}
+	}
+	if gErr.Message != "" {
+		return gErr.Message
+	}
+	return gErr.Error()
+}
+
+func parseQuotaErrorBody(body string) quotaErrorBody {
+	b := quotaErrorBody{}
+	_ = json.Unmarshal([]byte(body), &b)
+	return b
+}
+
+func quotaViolations(b quotaErrorBody) []string {
+	var v []string
+	for _, d := range b.Error.Details {
+		if d.Type != quotaFailureType {
+			continue
+		}
+		for _, qv := range d.Violations {
+			if qv.Description != "" {
+				v = append(v, qv.Description)
+			}
+		}
+	}
+	return v
+}
+
+// QuotaExceeded returns a condition that indicates a GCP quota or limit
+// prevented the external resource from being created or updated. Its message
+// describes the exceeded limit, so that users know to request a quota
+// increase.
+func QuotaExceeded(err error) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeQuotaExceeded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonQuotaExceeded,
+		Message:            QuotaLimit(err),
+	}
+}
+
+// WithinQuota returns a condition that indicates the external resource is no
+// longer prevented from being created or updated by a GCP quota or limit.
+func WithinQuota() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeQuotaExceeded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWithinQuota,
+	}
+}
+
+// SetQuotaCondition sets the QuotaExceeded condition of the supplied resource
+// according to the supplied error, which is typically returned by a create or
+// update call. A QuotaExceeded condition is only added when a quota is
+// exceeded; once added it is cleared by any subsequent call that is not
+// rejected due to quota.
+func SetQuotaCondition(o resource.Conditioned, err error) {
+	if IsErrorQuotaExceeded(err) {
+		o.SetConditions(QuotaExceeded(err))
+		return
+	}
+	if o.GetCondition(TypeQuotaExceeded).Status == corev1.ConditionTrue {
+		o.SetConditions(WithinQuota())
+	}
+}
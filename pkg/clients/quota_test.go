@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const (
+	quotaFailureBody = `{"error":{"code":429,"message":"Maximum number of service accounts on project reached.","status":"RESOURCE_EXHAUSTED","details":[{"@type":"type.googleapis.com/google.rpc.QuotaFailure","violations":[{"subject":"projects/example","description":"Service accounts per project limit of 100 reached."}]}]}}`
+	computeQuotaBody = `{"error":{"code":403,"message":"Quota 'NETWORKS' exceeded.  Limit: 5.0 globally.","errors":[{"message":"Quota 'NETWORKS' exceeded.  Limit: 5.0 globally.","domain":"usageLimits","reason":"quotaExceeded"}]}}`
+	forbiddenBody    = `{"error":{"code":403,"message":"The caller does not have permission","status":"PERMISSION_DENIED"}}`
+)
+
+// responseError returns the error the Google API clients return for a
+// response with the supplied status code and body.
+func responseError(code int, body string) error {
+	return googleapi.CheckResponse(&http.Response{
+		StatusCode: code,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	})
+}
+
+func TestIsErrorQuotaExceeded(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil": {
+			want: false,
+		},
+		"NotGoogleAPIError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+		"TooManyRequests": {
+			err:  responseError(http.StatusTooManyRequests, "{}"),
+			want: true,
+		},
+		"QuotaFailureDetails": {
+			err:  responseError(http.StatusTooManyRequests, quotaFailureBody),
+			want: true,
+		},
+		"QuotaExceededReason": {
+			err:  responseError(http.StatusForbidden, computeQuotaBody),
+			want: true,
+		},
+		"PermissionDenied": {
+			err:  responseError(http.StatusForbidden, forbiddenBody),
+			want: false,
+		},
+		"ResourceExhaustedGRPC": {
+			err:  status.Error(codes.ResourceExhausted, "quota exceeded"),
+			want: true,
+		},
+		"PermissionDeniedGRPC": {
+			err:  status.Error(codes.PermissionDenied, "denied"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsErrorQuotaExceeded(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsErrorQuotaExceeded(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestQuotaLimit(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want string
+	}{
+		"QuotaFailureDetails": {
+			err:  responseError(http.StatusTooManyRequests, quotaFailureBody),
+			want: "Service accounts per project limit of 100 reached.",
+		},
+		"QuotaExceededReason": {
+			err:  responseError(http.StatusForbidden, computeQuotaBody),
+			want: "Quota 'NETWORKS' exceeded.  Limit: 5.0 globally.",
+		},
+		"Message": {
+			err:  responseError(http.StatusTooManyRequests, `{"error":{"code":429,"message":"Rate limit exceeded."}}`),
+			want: "Rate limit exceeded.",
+		},
+		"GRPC": {
+			err:  status.Error(codes.ResourceExhausted, "Quota exceeded for instances per project."),
+			want: "Quota exceeded for instances per project.",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := QuotaLimit(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("QuotaLimit(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetQuotaCondition(t *testing.T) {
+	exceeded := runtimev1alpha1.Condition{
+		Type:    TypeQuotaExceeded,
+		Status:  corev1.ConditionTrue,
+		Reason:  ReasonQuotaExceeded,
+		Message: "Service accounts per project limit of 100 reached.",
+	}
+
+	cases := map[string]struct {
+		existing []runtimev1alpha1.Condition
+		err      error
+		want     []runtimev1alpha1.Condition
+	}{
+		"QuotaExceeded": {
+			err:  responseError(http.StatusTooManyRequests, quotaFailureBody),
+			want: []runtimev1alpha1.Condition{exceeded},
+		},
+		"NeverExceeded": {
+			err: nil,
+		},
+		"OtherError": {
+			err: responseError(http.StatusForbidden, forbiddenBody),
+		},
+		"NoLongerExceeded": {
+			existing: []runtimev1alpha1.Condition{exceeded},
+			err:      nil,
+			want:     []runtimev1alpha1.Condition{WithinQuota()},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &runtimev1alpha1.ConditionedStatus{Conditions: tc.existing}
+			SetQuotaCondition(got, tc.err)
+			want := &runtimev1alpha1.ConditionedStatus{Conditions: tc.want}
+			if diff := cmp.Diff(want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("SetQuotaCondition(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
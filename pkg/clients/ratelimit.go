@@ -0,0 +1,239 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Rate limit backoff defaults.
+const (
+	DefaultRateLimitBackoffBase = 30 * time.Second
+	DefaultRateLimitBackoffMax  = 10 * time.Minute
+)
+
+// IsErrorRateLimited gets a value indicating whether the given error, or an
+// error it wraps, represents a request that was rejected because a quota or
+// rate limit was exceeded, and that is likely to succeed if it is retried
+// later.
+func IsErrorRateLimited(err error) bool {
+	for ; err != nil; err = unwrap(err) {
+		if IsErrorQuotaExceeded(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryAfter returns how long the Google API asked the caller to wait before
+// retrying the request that returned the supplied error, per the Retry-After
+// header of its response. It returns false if the error, or an error it wraps,
+// is not a *googleapi.Error with a valid Retry-After header.
+func RetryAfter(err error, now time.Time) (time.Duration, bool) {
+	for ; err != nil; err = unwrap(err) {
+		gErr, ok := err.(*googleapi.Error)
+		if !ok {
+			continue
+		}
+		v := gErr.Header.Get("Retry-After")
+		if v == "" {
+			return 0, false
+		}
+		if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+			return time.Duration(s) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			if d := t.Sub(now); d > 0 {
+				return d, true
+			}
+			return 0, true
+		}
+		return 0, false
+	}
+	return 0, false
+}
+
+// A RateLimitBackoff requeues managed resources whose external operations
+// were rejected because a quota or rate limit was exceeded. The managed
+// reconciler otherwise requeues them after the same short wait as any other
+// error, regardless of how often they were rejected. A resource is instead
+// requeued after the delay the Google API asked for in its Retry-After header
+// or, absent one, after a delay that doubles each consecutive time it is
+// rejected.
+type RateLimitBackoff struct {
+	base time.Duration
+	max  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	limited map[types.NamespacedName]*rateLimit
+}
+
+// rateLimit tracks how often the operations on a managed resource were
+// rejected.
+type rateLimit struct {
+	failures   int
+	retryAfter time.Duration
+	pending    bool
+}
+
+// NewRateLimitBackoff returns a RateLimitBackoff whose delays start at the
+// supplied base and never exceed the supplied max.
+func NewRateLimitBackoff(base, max time.Duration) *RateLimitBackoff {
+	return &RateLimitBackoff{base: base, max: max, now: time.Now, limited: map[types.NamespacedName]*rateLimit{}}
+}
+
+// Connecter returns an ExternalConnecter whose ExternalClients record the
+// operations that were rejected because a quota or rate limit was exceeded.
+func (b *RateLimitBackoff) Connecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return managed.ExternalConnectorFn(func(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+		e, err := c.Connect(ctx, mg)
+		if err != nil {
+			return nil, err
+		}
+		return &rateLimitedExternal{ExternalClient: e, backoff: b}, nil
+	})
+}
+
+// Reconciler returns a Reconciler that requeues a managed resource after the
+// backoff delay if any of its operations was rejected because a quota or rate
+// limit was exceeded while the supplied Reconciler reconciled it.
+func (b *RateLimitBackoff) Reconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(req reconcile.Request) (reconcile.Result, error) {
+		res, err := r.Reconcile(req)
+		if d, ok := b.delay(req.NamespacedName); ok && err == nil {
+			res = reconcile.Result{RequeueAfter: d}
+		}
+		return res, err
+	})
+}
+
+// record records the result of an operation on the named managed resource.
+// Successful operations reset the backoff only if reset is true, so that a
+// successful observation does not reset the backoff of a resource whose
+// creation keeps being rejected.
+func (b *RateLimitBackoff) record(name types.NamespacedName, err error, reset bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !IsErrorRateLimited(err) {
+		if err == nil && reset {
+			delete(b.limited, name)
+		}
+		return
+	}
+	l, ok := b.limited[name]
+	if !ok {
+		l = &rateLimit{}
+		b.limited[name] = l
+	}
+	l.failures++
+	l.pending = true
+	l.retryAfter, _ = RetryAfter(err, b.now())
+}
+
+// forget stops tracking the named managed resource, whose external resource
+// no longer exists.
+func (b *RateLimitBackoff) forget(name types.NamespacedName) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.limited, name)
+}
+
+// delay returns how long to wait before reconciling the named managed
+// resource again, if any of its operations were rejected since delay was last
+// called.
+func (b *RateLimitBackoff) delay(name types.NamespacedName) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	l, ok := b.limited[name]
+	if !ok || !l.pending {
+		return 0, false
+	}
+	l.pending = false
+	if l.retryAfter > 0 {
+		return minDuration(l.retryAfter, b.max), true
+	}
+	d := b.base
+	for i := 1; i < l.failures && d < b.max; i++ {
+		d *= 2
+	}
+	return minDuration(d, b.max), true
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+type rateLimitedExternal struct {
+	managed.ExternalClient
+	backoff *RateLimitBackoff
+}
+
+func (e *rateLimitedExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err == nil && !o.ResourceExists && meta.WasDeleted(mg) {
+		// The managed resource is about to go away, and with it any reason
+		// to remember its backoff.
+		e.backoff.forget(nameOf(mg))
+		return o, err
+	}
+	e.backoff.record(nameOf(mg), err, o.ResourceExists && o.ResourceUpToDate)
+	return o, err
+}
+
+func (e *rateLimitedExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := e.ExternalClient.Create(ctx, mg)
+	e.backoff.record(nameOf(mg), err, true)
+	return c, err
+}
+
+func (e *rateLimitedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := e.ExternalClient.Update(ctx, mg)
+	e.backoff.record(nameOf(mg), err, true)
+	return u, err
+}
+
+func (e *rateLimitedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.ExternalClient.Delete(ctx, mg)
+	if err == nil || IsErrorNotFound(err) {
+		e.backoff.forget(nameOf(mg))
+		return err
+	}
+	e.backoff.record(nameOf(mg), err, true)
+	return err
+}
+
+// nameOf returns the name the managed reconciler is asked to reconcile the
+// supplied managed resource by.
+func nameOf(mg resource.Managed) types.NamespacedName {
+	return types.NamespacedName{Namespace: mg.GetNamespace(), Name: mg.GetName()}
+}
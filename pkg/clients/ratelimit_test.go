@@ -0,0 +1,273 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func tooManyRequests(retryAfter string) *googleapi.Error {
+	e := &googleapi.Error{Code: http.StatusTooManyRequests, Header: http.Header{}}
+	if retryAfter != "" {
+		e.Header.Set("Retry-After", retryAfter)
+	}
+	return e
+}
+
+func TestIsErrorRateLimited(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":               {err: nil, want: false},
+		"Other":             {err: errors.New("boom"), want: false},
+		"TooManyRequests":   {err: tooManyRequests(""), want: true},
+		"RateLimitExceeded": {err: &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}}, want: true},
+		"Forbidden":         {err: &googleapi.Error{Code: http.StatusForbidden}, want: false},
+		"Wrapped":           {err: pkgerrors.Wrap(tooManyRequests(""), "cannot create"), want: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsErrorRateLimited(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsErrorRateLimited(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	type want struct {
+		d  time.Duration
+		ok bool
+	}
+	cases := map[string]struct {
+		err  error
+		want want
+	}{
+		"Other":          {err: errors.New("boom"), want: want{}},
+		"NoRetryAfter":   {err: tooManyRequests(""), want: want{}},
+		"Seconds":        {err: tooManyRequests("120"), want: want{d: 2 * time.Minute, ok: true}},
+		"Date":           {err: tooManyRequests(now.Add(90 * time.Second).Format(http.TimeFormat)), want: want{d: 90 * time.Second, ok: true}},
+		"PastDate":       {err: tooManyRequests(now.Add(-time.Minute).Format(http.TimeFormat)), want: want{ok: true}},
+		"Invalid":        {err: tooManyRequests("soon"), want: want{}},
+		"WrappedSeconds": {err: pkgerrors.Wrap(tooManyRequests("30"), "cannot create"), want: want{d: 30 * time.Second, ok: true}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, ok := RetryAfter(tc.err, now)
+			if diff := cmp.Diff(tc.want, want{d: d, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("RetryAfter(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRateLimitBackoff(t *testing.T) {
+	errBoom := errors.New("boom")
+	cases := map[string]struct {
+		reason string
+		errs   []error
+		want   []reconcile.Result
+	}{
+		"NotRateLimited": {
+			reason: "Reconciles that are not rate limited should keep the result of the managed reconciler.",
+			errs:   []error{nil, errBoom},
+			want:   []reconcile.Result{{RequeueAfter: time.Second}, {RequeueAfter: time.Second}},
+		},
+		"WithoutRetryAfter": {
+			reason: "Consecutive rate limited reconciles should back off exponentially up to the max.",
+			errs:   []error{tooManyRequests(""), tooManyRequests(""), tooManyRequests(""), tooManyRequests(""), tooManyRequests("")},
+			want: []reconcile.Result{
+				{RequeueAfter: 30 * time.Second},
+				{RequeueAfter: time.Minute},
+				{RequeueAfter: 2 * time.Minute},
+				{RequeueAfter: 4 * time.Minute},
+				{RequeueAfter: 5 * time.Minute},
+			},
+		},
+		"WithRetryAfter": {
+			reason: "Rate limited reconciles should honor the Retry-After header.",
+			errs:   []error{tooManyRequests("7"), pkgerrors.Wrap(tooManyRequests("3600"), "cannot create")},
+			want:   []reconcile.Result{{RequeueAfter: 7 * time.Second}, {RequeueAfter: 5 * time.Minute}},
+		},
+		"ResetOnSuccess": {
+			reason: "A successful create should reset the backoff.",
+			errs:   []error{tooManyRequests(""), tooManyRequests(""), nil, tooManyRequests("")},
+			want: []reconcile.Result{
+				{RequeueAfter: 30 * time.Second},
+				{RequeueAfter: time.Minute},
+				{RequeueAfter: time.Second},
+				{RequeueAfter: 30 * time.Second},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := NewRateLimitBackoff(30*time.Second, 5*time.Minute)
+			var createErr error
+			c := b.Connecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, createErr
+					},
+				}, nil
+			}))
+			mg := &fake.Managed{}
+			mg.SetName("cool")
+			r := b.Reconciler(reconcile.Func(func(req reconcile.Request) (reconcile.Result, error) {
+				e, _ := c.Connect(context.Background(), mg)
+				_, _ = e.Create(context.Background(), mg)
+				return reconcile.Result{RequeueAfter: time.Second}, nil
+			}))
+
+			for i, err := range tc.errs {
+				createErr = err
+				got, _ := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: mg.GetName()}})
+				if diff := cmp.Diff(tc.want[i], got); diff != "" {
+					t.Errorf("\n%s\nReconcile %d: -want, +got:\n%s", tc.reason, i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitBackoffForget(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		deleted   bool
+		deleteErr error
+		op        func(e managed.ExternalClient, mg resource.Managed)
+		want      bool
+	}{
+		"DeleteSucceeded": {
+			reason: "A managed resource whose external resource was deleted should no longer be tracked.",
+			op: func(e managed.ExternalClient, mg resource.Managed) {
+				_ = e.Delete(context.Background(), mg)
+			},
+			want: false,
+		},
+		"DeleteNotFound": {
+			reason:    "A managed resource whose external resource was not found when deleting it should no longer be tracked.",
+			deleteErr: &googleapi.Error{Code: http.StatusNotFound},
+			op: func(e managed.ExternalClient, mg resource.Managed) {
+				_ = e.Delete(context.Background(), mg)
+			},
+			want: false,
+		},
+		"ObserveDeleted": {
+			reason:  "A deleted managed resource whose external resource no longer exists should no longer be tracked.",
+			deleted: true,
+			op: func(e managed.ExternalClient, mg resource.Managed) {
+				_, _ = e.Observe(context.Background(), mg)
+			},
+			want: false,
+		},
+		"ObserveNotCreated": {
+			reason: "A managed resource whose external resource has yet to be created should still be tracked.",
+			op: func(e managed.ExternalClient, mg resource.Managed) {
+				_, _ = e.Observe(context.Background(), mg)
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := NewRateLimitBackoff(30*time.Second, 5*time.Minute)
+			c := b.Connecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{ResourceExists: false}, nil
+					},
+					CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+						return managed.ExternalCreation{}, tooManyRequests("")
+					},
+					DeleteFn: func(_ context.Context, _ resource.Managed) error {
+						return tc.deleteErr
+					},
+				}, nil
+			}))
+			mg := &fake.Managed{}
+			mg.SetName("cool")
+			if tc.deleted {
+				now := metav1.Now()
+				mg.SetDeletionTimestamp(&now)
+			}
+
+			e, _ := c.Connect(context.Background(), mg)
+			_, _ = e.Create(context.Background(), mg)
+			tc.op(e, mg)
+
+			_, got := b.limited[types.NamespacedName{Name: "cool"}]
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nb.limited: -want tracked, +got tracked:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRateLimitBackoffNamespacedName(t *testing.T) {
+	b := NewRateLimitBackoff(30*time.Second, 5*time.Minute)
+	c := b.Connecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			CreateFn: func(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+				if mg.GetNamespace() == "limited" {
+					return managed.ExternalCreation{}, tooManyRequests("")
+				}
+				return managed.ExternalCreation{}, nil
+			},
+		}, nil
+	}))
+	r := b.Reconciler(reconcile.Func(func(req reconcile.Request) (reconcile.Result, error) {
+		mg := &fake.Managed{}
+		mg.SetNamespace(req.Namespace)
+		mg.SetName(req.Name)
+		e, _ := c.Connect(context.Background(), mg)
+		_, _ = e.Create(context.Background(), mg)
+		return reconcile.Result{RequeueAfter: time.Second}, nil
+	}))
+
+	reason := "Managed resources of the same name in different namespaces should be backed off independently."
+	got, _ := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "limited", Name: "cool"}})
+	if diff := cmp.Diff(reconcile.Result{RequeueAfter: 30 * time.Second}, got); diff != "" {
+		t.Errorf("\n%s\nReconcile limited: -want, +got:\n%s", reason, diff)
+	}
+	got, _ = r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "unlimited", Name: "cool"}})
+	if diff := cmp.Diff(reconcile.Result{RequeueAfter: time.Second}, got); diff != "" {
+		t.Errorf("\n%s\nReconcile unlimited: -want, +got:\n%s", reason, diff)
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationKeyRecreate is the key of an annotation that opts a managed
+// resource in to being deleted and recreated when one of its immutable fields
+// is changed. Recreation is only enabled when the annotation's value is
+// "true". Without it a change to an immutable field is reported as an error.
+//
+// Recreating a resource destroys it, along with anything that is only stored
+// within it. Controllers that honour this annotation must refuse to recreate
+// resources that still contain data.
+const AnnotationKeyRecreate = "crossplane.io/recreate"
+
+// ShouldRecreate returns true if the supplied object opts in to being
+// recreated when one of its immutable fields is changed.
+func ShouldRecreate(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyRecreate] == "true"
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestShouldRecreate(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		want        bool
+	}{
+		"NoAnnotation": {
+			want: false,
+		},
+		"True": {
+			annotations: map[string]string{AnnotationKeyRecreate: "true"},
+			want:        true,
+		},
+		"NotTrue": {
+			annotations: map[string]string{AnnotationKeyRecreate: "yes"},
+			want:        false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ShouldRecreate(&metav1.ObjectMeta{Annotations: tc.annotations})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ShouldRecreate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
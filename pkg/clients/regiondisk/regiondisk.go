@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package regiondisk
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Error strings.
+const (
+	errReplicaZoneCount  = "a regional disk must be replicated in exactly two zones"
+	errReplicaZoneDupe   = "the replica zones of a regional disk must differ"
+	errReplicaZoneRegion = "replica zone %q is not a zone of region %q"
+)
+
+var zoneRegexp = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+-[a-z]$`)
+
+// ValidateReplicaZones returns an error unless exactly two distinct zones of
+// the supplied region are supplied. Zones may be names or URLs.
+func ValidateReplicaZones(region string, zones []string) error {
+	if len(zones) != 2 {
+		return errors.New(errReplicaZoneCount)
+	}
+	if path.Base(zones[0]) == path.Base(zones[1]) {
+		return errors.New(errReplicaZoneDupe)
+	}
+	for _, z := range zones {
+		n := path.Base(z)
+		if !zoneRegexp.MatchString(n) || !strings.HasPrefix(n, region+"-") {
+			return errors.Errorf(errReplicaZoneRegion, z, region)
+		}
+	}
+	return nil
+}
+
+// GenerateRegionDisk creates a *compute.Disk from the supplied
+// RegionDiskParameters. Zone and disk type names are expanded into URLs
+// within the supplied project.
+func GenerateRegionDisk(project, name string, in v1alpha1.RegionDiskParameters) *compute.Disk {
+	d := &compute.Disk{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+		Region:      in.Region,
+		SizeGb:      gcp.Int64Value(in.SizeGb),
+	}
+	for _, z := range in.ReplicaZones {
+		d.ReplicaZones = append(d.ReplicaZones, qualify(z, fmt.Sprintf("projects/%s/zones/", project)))
+	}
+	if in.Type != nil {
+		d.Type = qualify(*in.Type, fmt.Sprintf("projects/%s/regions/%s/diskTypes/", project, in.Region))
+	}
+	if in.KMSKeyName != nil {
+		d.DiskEncryptionKey = &compute.CustomerEncryptionKey{KmsKeyName: *in.KMSKeyName}
+	}
+	return d
+}
+
+// qualify prefixes the supplied name, unless it is already a URL.
+func qualify(name, prefix string) string {
+	if strings.Contains(name, "/") {
+		return name
+	}
+	return prefix + name
+}
+
+// GenerateRegionDiskObservation creates a RegionDiskObservation from the
+// supplied compute.Disk.
+func GenerateRegionDiskObservation(in compute.Disk) v1alpha1.RegionDiskObservation {
+	o := v1alpha1.RegionDiskObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+		Users:             in.Users,
+	}
+	if in.DiskEncryptionKey != nil {
+		o.KMSKeyVersion = in.DiskEncryptionKey.KmsKeyName
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// compute.Disk.
+func LateInitializeSpec(spec *v1alpha1.RegionDiskParameters, in compute.Disk) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.SizeGb = gcp.LateInitializeInt64(spec.SizeGb, in.SizeGb)
+	if in.Type != "" {
+		spec.Type = gcp.LateInitializeString(spec.Type, path.Base(in.Type))
+	}
+}
+
+// IsUpToDate checks whether the observed compute.Disk matches the supplied
+// RegionDiskParameters. Only the size of a regional disk can be changed once
+// it has been created, so only the size is compared.
+func IsUpToDate(in v1alpha1.RegionDiskParameters, observed *compute.Disk) bool {
+	return in.SizeGb == nil || *in.SizeGb == observed.SizeGb
+}
+
+// Condition returns the condition that corresponds to the supplied status of
+// a regional disk.
+func Condition(status string) runtimev1alpha1.Condition {
+	switch status {
+	case v1alpha1.RegionDiskStatusReady:
+		return runtimev1alpha1.Available()
+	case v1alpha1.RegionDiskStatusCreating, v1alpha1.RegionDiskStatusRestoring:
+		return runtimev1alpha1.Creating()
+	case v1alpha1.RegionDiskStatusDeleting:
+		return runtimev1alpha1.Deleting()
+	default:
+		return runtimev1alpha1.Unavailable()
+	}
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package regiondisk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "some-project"
+	testName    = "some-disk"
+	testRegion  = "us-central1"
+)
+
+func params(m ...func(*v1alpha1.RegionDiskParameters)) *v1alpha1.RegionDiskParameters {
+	p := &v1alpha1.RegionDiskParameters{
+		Region:       testRegion,
+		ReplicaZones: []string{"us-central1-a", "us-central1-b"},
+		Description:  gcp.StringPtr("desc"),
+		SizeGb:       gcp.Int64Ptr(200),
+		Type:         gcp.StringPtr("pd-ssd"),
+		KMSKeyName:   gcp.StringPtr("projects/p/locations/us-central1/keyRings/r/cryptoKeys/k"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func disk(m ...func(*compute.Disk)) *compute.Disk {
+	d := &compute.Disk{
+		Name:        testName,
+		Region:      testRegion,
+		Description: "desc",
+		SizeGb:      200,
+		Type:        "projects/some-project/regions/us-central1/diskTypes/pd-ssd",
+		ReplicaZones: []string{
+			"projects/some-project/zones/us-central1-a",
+			"projects/some-project/zones/us-central1-b",
+		},
+		DiskEncryptionKey: &compute.CustomerEncryptionKey{
+			KmsKeyName: "projects/p/locations/us-central1/keyRings/r/cryptoKeys/k",
+		},
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestValidateReplicaZones(t *testing.T) {
+	cases := map[string]struct {
+		zones []string
+		want  error
+	}{
+		"Valid": {
+			zones: []string{"us-central1-a", "us-central1-f"},
+		},
+		"ValidURLs": {
+			zones: []string{
+				"https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a",
+				"projects/p/zones/us-central1-b",
+			},
+		},
+		"OneZone": {
+			zones: []string{"us-central1-a"},
+			want:  errors.New(errReplicaZoneCount),
+		},
+		"ThreeZones": {
+			zones: []string{"us-central1-a", "us-central1-b", "us-central1-c"},
+			want:  errors.New(errReplicaZoneCount),
+		},
+		"SameZone": {
+			zones: []string{"us-central1-a", "zones/us-central1-a"},
+			want:  errors.New(errReplicaZoneDupe),
+		},
+		"OtherRegion": {
+			zones: []string{"us-central1-a", "us-east1-b"},
+			want:  errors.Errorf(errReplicaZoneRegion, "us-east1-b", testRegion),
+		},
+		"SimilarRegion": {
+			zones: []string{"us-central1-a", "us-central10-b"},
+			want:  errors.Errorf(errReplicaZoneRegion, "us-central10-b", testRegion),
+		},
+		"NotAZone": {
+			zones: []string{"us-central1-a", "us-central1"},
+			want:  errors.Errorf(errReplicaZoneRegion, "us-central1", testRegion),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateReplicaZones(testRegion, tc.zones)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateReplicaZones(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRegionDisk(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.RegionDiskParameters
+		want *compute.Disk
+	}{
+		"Full": {
+			in:   *params(),
+			want: disk(),
+		},
+		"URLs": {
+			in: *params(func(p *v1alpha1.RegionDiskParameters) {
+				p.Type = gcp.StringPtr("projects/other/regions/us-central1/diskTypes/pd-ssd")
+				p.ReplicaZones = []string{"projects/other/zones/us-central1-a", "projects/other/zones/us-central1-b"}
+			}),
+			want: disk(func(d *compute.Disk) {
+				d.Type = "projects/other/regions/us-central1/diskTypes/pd-ssd"
+				d.ReplicaZones = []string{"projects/other/zones/us-central1-a", "projects/other/zones/us-central1-b"}
+			}),
+		},
+		"Minimal": {
+			in: v1alpha1.RegionDiskParameters{
+				Region:       testRegion,
+				ReplicaZones: []string{"us-central1-a", "us-central1-b"},
+			},
+			want: &compute.Disk{
+				Name:   testName,
+				Region: testRegion,
+				ReplicaZones: []string{
+					"projects/some-project/zones/us-central1-a",
+					"projects/some-project/zones/us-central1-b",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRegionDisk(testProject, testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateRegionDisk(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.RegionDiskParameters
+		observed compute.Disk
+		want     *v1alpha1.RegionDiskParameters
+	}{
+		"FillsDefaults": {
+			spec: params(func(p *v1alpha1.RegionDiskParameters) {
+				p.Description = nil
+				p.SizeGb = nil
+				p.Type = nil
+			}),
+			observed: *disk(func(d *compute.Disk) {
+				d.Type = "https://www.googleapis.com/compute/v1/projects/some-project/regions/us-central1/diskTypes/pd-ssd"
+			}),
+			want: params(),
+		},
+		"KeepsSpec": {
+			spec: params(),
+			observed: *disk(func(d *compute.Disk) {
+				d.SizeGb = 500
+			}),
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.RegionDiskParameters
+		observed *compute.Disk
+		want     bool
+	}{
+		"UpToDate": {
+			in:       *params(),
+			observed: disk(),
+			want:     true,
+		},
+		"NoSize": {
+			in: *params(func(p *v1alpha1.RegionDiskParameters) {
+				p.SizeGb = nil
+			}),
+			observed: disk(),
+			want:     true,
+		},
+		"Grown": {
+			in: *params(func(p *v1alpha1.RegionDiskParameters) {
+				p.SizeGb = gcp.Int64Ptr(300)
+			}),
+			observed: disk(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	cases := map[string]runtimev1alpha1.Condition{
+		v1alpha1.RegionDiskStatusReady:     runtimev1alpha1.Available(),
+		v1alpha1.RegionDiskStatusCreating:  runtimev1alpha1.Creating(),
+		v1alpha1.RegionDiskStatusRestoring: runtimev1alpha1.Creating(),
+		v1alpha1.RegionDiskStatusDeleting:  runtimev1alpha1.Deleting(),
+		v1alpha1.RegionDiskStatusFailed:    runtimev1alpha1.Unavailable(),
+	}
+
+	for status, want := range cases {
+		t.Run(status, func(t *testing.T) {
+			got := Condition(status)
+			if !want.Equal(got) {
+				t.Errorf("Condition(%s): want %v, got %v", status, want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// redacted replaces the values of query parameters that may contain
+// credentials.
+const redacted = "REDACTED"
+
+// sensitiveParams are substrings of the names of query parameters and
+// resource fields whose values are never logged, e.g. access_token or key.
+var sensitiveParams = []string{"token", "key", "secret", "signature", "password", "credential"}
+
+// requestLogger logs the GCP API calls of clients whose HTTPClientConfig asks
+// for requests to be logged.
+var requestLogger = logging.NewNopLogger()
+
+// SetRequestLogger sets the Logger used to log GCP API calls when an
+// HTTPClientConfig enables request logging. Such calls are not logged unless
+// a Logger is set.
+func SetRequestLogger(l logging.Logger) {
+	requestLogger = l
+}
+
+// A loggingTransport logs each request it sends at info level.
+type loggingTransport struct {
+	base http.RoundTripper
+	log  logging.Logger
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	rsp, err := t.base.RoundTrip(req)
+	kv := []interface{}{
+		"method", req.Method,
+		"host", req.URL.Host,
+		"path", req.URL.Path,
+		"query", redactQuery(req.URL.Query()),
+		"latency", time.Since(start).String(),
+	}
+	if err != nil {
+		t.log.Info("GCP API call failed", append(kv, "error", err)...)
+		return rsp, err
+	}
+	t.log.Info("GCP API call", append(kv, "code", rsp.StatusCode)...)
+	return rsp, nil
+}
+
+// redactQuery encodes the supplied query, replacing the values of any
+// parameters that may contain credentials.
+func redactQuery(q url.Values) string {
+	r := make(url.Values, len(q))
+	for k, v := range q {
+		if !isSensitiveParam(k) {
+			r[k] = v
+			continue
+		}
+		r[k] = make([]string, len(v))
+		for i := range v {
+			r[k][i] = redacted
+		}
+	}
+	return r.Encode()
+}
+
+func isSensitiveParam(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range sensitiveParams {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+type logEntry struct {
+	msg string
+	kv  map[string]interface{}
+}
+
+type recordingLogger struct {
+	entries []logEntry
+}
+
+func (l *recordingLogger) Info(msg string, keysAndValues ...interface{}) {
+	e := logEntry{msg: msg, kv: map[string]interface{}{}}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		e.kv[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+	l.entries = append(l.entries, e)
+}
+
+func (l *recordingLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.Info(msg, keysAndValues...)
+}
+
+func (l *recordingLogger) WithValues(keysAndValues ...interface{}) logging.Logger { return l }
+
+func TestRequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	l := &recordingLogger{}
+	SetRequestLogger(l)
+	defer SetRequestLogger(logging.NewNopLogger())
+
+	for _, enabled := range []bool{false, true} {
+		hc, err := NewHTTPClient(context.Background(), &v1alpha3.HTTPClientConfig{LogRequests: enabled}, option.WithoutAuthentication())
+		if err != nil {
+			t.Fatalf("NewHTTPClient(...): %s", err)
+		}
+		rsp, err := hc.Get(server.URL + "/v1/projects/p?access_token=secret&alt=json")
+		if err != nil {
+			t.Fatalf("Get(...): %s", err)
+		}
+		_ = rsp.Body.Close()
+	}
+
+	if len(l.entries) != 1 {
+		t.Fatalf("Get(...): want 1 logged request, got %d", len(l.entries))
+	}
+	e := l.entries[0]
+	delete(e.kv, "latency")
+	want := map[string]interface{}{
+		"method": http.MethodGet,
+		"host":   server.Listener.Addr().String(),
+		"path":   "/v1/projects/p",
+		"query":  "access_token=REDACTED&alt=json",
+		"code":   http.StatusNotFound,
+	}
+	if diff := cmp.Diff(want, e.kv); diff != "" {
+		t.Errorf("Get(...): -want logged values, +got logged values:\n%s", diff)
+	}
+}
+
+func TestRedactQuery(t *testing.T) {
+	cases := map[string]struct {
+		q    url.Values
+		want string
+	}{
+		"Empty": {
+			q:    url.Values{},
+			want: "",
+		},
+		"NothingSensitive": {
+			q:    url.Values{"alt": {"json"}, "pageSize": {"10"}},
+			want: "alt=json&pageSize=10",
+		},
+		"Sensitive": {
+			q:    url.Values{"key": {"k"}, "pageToken": {"t1", "t2"}, "X-Goog-Signature": {"s"}, "alt": {"json"}},
+			want: "X-Goog-Signature=REDACTED&alt=json&key=REDACTED&pageToken=REDACTED&pageToken=REDACTED",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, redactQuery(tc.q)); diff != "" {
+				t.Errorf("redactQuery(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourcepolicy contains a client for Compute Engine resource
+// policies and utilities to convert between them and ResourcePolicy managed
+// resources.
+//
+// The Google API client library used by this provider predates instance
+// schedule policies, so this package implements the few v1 resourcePolicies
+// REST calls the ResourcePolicy controller needs on top of the same
+// authenticated transport.
+package resourcepolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// DefaultEndpoint of the Compute Engine API.
+const DefaultEndpoint = "https://compute.googleapis.com/compute/v1/"
+
+// A ResourcePolicy is a Compute Engine resource policy.
+type ResourcePolicy struct {
+	Name                   string                  `json:"name,omitempty"`
+	Description            string                  `json:"description,omitempty"`
+	Region                 string                  `json:"region,omitempty"`
+	InstanceSchedulePolicy *InstanceSchedulePolicy `json:"instanceSchedulePolicy,omitempty"`
+	SnapshotSchedulePolicy *SnapshotSchedulePolicy `json:"snapshotSchedulePolicy,omitempty"`
+	CreationTimestamp      string                  `json:"creationTimestamp,omitempty"`
+	ID                     uint64                  `json:"id,omitempty,string"`
+	SelfLink               string                  `json:"selfLink,omitempty"`
+	Status                 string                  `json:"status,omitempty"`
+}
+
+// An InstanceSchedulePolicy starts and stops instances on a schedule.
+type InstanceSchedulePolicy struct {
+	VMStartSchedule *InstanceSchedule `json:"vmStartSchedule,omitempty"`
+	VMStopSchedule  *InstanceSchedule `json:"vmStopSchedule,omitempty"`
+	TimeZone        string            `json:"timeZone,omitempty"`
+	StartTime       string            `json:"startTime,omitempty"`
+	ExpirationTime  string            `json:"expirationTime,omitempty"`
+}
+
+// An InstanceSchedule is a cron schedule of an InstanceSchedulePolicy.
+type InstanceSchedule struct {
+	Schedule string `json:"schedule,omitempty"`
+}
+
+// A SnapshotSchedulePolicy takes snapshots of disks on a schedule.
+type SnapshotSchedulePolicy struct {
+	Schedule           *SnapshotSchedule   `json:"schedule,omitempty"`
+	RetentionPolicy    *RetentionPolicy    `json:"retentionPolicy,omitempty"`
+	SnapshotProperties *SnapshotProperties `json:"snapshotProperties,omitempty"`
+}
+
+// A SnapshotSchedule specifies when snapshots are taken.
+type SnapshotSchedule struct {
+	HourlySchedule *HourlyCycle `json:"hourlySchedule,omitempty"`
+	DailySchedule  *DailyCycle  `json:"dailySchedule,omitempty"`
+	WeeklySchedule *WeeklyCycle `json:"weeklySchedule,omitempty"`
+}
+
+// An HourlyCycle takes a snapshot every few hours.
+type HourlyCycle struct {
+	HoursInCycle int64  `json:"hoursInCycle,omitempty"`
+	StartTime    string `json:"startTime,omitempty"`
+}
+
+// A DailyCycle takes a snapshot every few days.
+type DailyCycle struct {
+	DaysInCycle int64  `json:"daysInCycle,omitempty"`
+	StartTime   string `json:"startTime,omitempty"`
+}
+
+// A WeeklyCycle takes snapshots on certain days of the week.
+type WeeklyCycle struct {
+	DayOfWeeks []*DayOfWeek `json:"dayOfWeeks,omitempty"`
+}
+
+// A DayOfWeek on which a snapshot is taken.
+type DayOfWeek struct {
+	Day       string `json:"day,omitempty"`
+	StartTime string `json:"startTime,omitempty"`
+}
+
+// A RetentionPolicy specifies how long snapshots are kept.
+type RetentionPolicy struct {
+	MaxRetentionDays   int64  `json:"maxRetentionDays,omitempty"`
+	OnSourceDiskDelete string `json:"onSourceDiskDelete,omitempty"`
+}
+
+// SnapshotProperties are the properties of scheduled snapshots.
+type SnapshotProperties struct {
+	Labels           map[string]string `json:"labels,omitempty"`
+	StorageLocations []string          `json:"storageLocations,omitempty"`
+	GuestFlush       bool              `json:"guestFlush,omitempty"`
+}
+
+// A Service calls the resourcePolicies methods of the Compute Engine API.
+type Service struct {
+	json *gcp.JSONClient
+}
+
+// NewService returns a Service configured per the supplied options.
+func NewService(ctx context.Context, opts ...option.ClientOption) (*Service, error) {
+	c, err := gcp.NewJSONClient(ctx, DefaultEndpoint, append([]option.ClientOption{option.WithScopes(compute.ComputeScope)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{json: c}, nil
+}
+
+// Get returns the named resource policy.
+func (s *Service) Get(ctx context.Context, project, region, name string) (*ResourcePolicy, error) {
+	rp := &ResourcePolicy{}
+	return rp, s.do(ctx, http.MethodGet, collection(project, region)+"/"+name, nil, rp)
+}
+
+// Insert creates the supplied resource policy.
+func (s *Service) Insert(ctx context.Context, project, region string, rp *ResourcePolicy) (*compute.Operation, error) {
+	op := &compute.Operation{}
+	return op, s.do(ctx, http.MethodPost, collection(project, region), rp, op)
+}
+
+// Delete deletes the named resource policy.
+func (s *Service) Delete(ctx context.Context, project, region, name string) (*compute.Operation, error) {
+	op := &compute.Operation{}
+	return op, s.do(ctx, http.MethodDelete, collection(project, region)+"/"+name, nil, op)
+}
+
+func collection(project, region string) string {
+	return fmt.Sprintf("projects/%s/regions/%s/resourcePolicies", project, region)
+}
+
+// do sends a request for the supplied resource path and decodes the response
+// into out.
+func (s *Service) do(ctx context.Context, method, path string, in, out interface{}) error {
+	return s.json.Do(ctx, method, path, nil, in, out)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicy
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateResourcePolicy creates a *ResourcePolicy from the supplied
+// ResourcePolicyParameters.
+func GenerateResourcePolicy(name string, in v1alpha1.ResourcePolicyParameters) *ResourcePolicy {
+	rp := &ResourcePolicy{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+	}
+	if p := in.InstanceSchedulePolicy; p != nil {
+		rp.InstanceSchedulePolicy = &InstanceSchedulePolicy{
+			VMStartSchedule: generateInstanceSchedule(p.VMStartSchedule),
+			VMStopSchedule:  generateInstanceSchedule(p.VMStopSchedule),
+			TimeZone:        p.TimeZone,
+			StartTime:       gcp.StringValue(p.StartTime),
+			ExpirationTime:  gcp.StringValue(p.ExpirationTime),
+		}
+	}
+	if p := in.SnapshotSchedulePolicy; p != nil {
+		rp.SnapshotSchedulePolicy = &SnapshotSchedulePolicy{Schedule: generateSnapshotSchedule(p.Schedule)}
+		if r := p.RetentionPolicy; r != nil {
+			rp.SnapshotSchedulePolicy.RetentionPolicy = &RetentionPolicy{
+				MaxRetentionDays:   r.MaxRetentionDays,
+				OnSourceDiskDelete: gcp.StringValue(r.OnSourceDiskDelete),
+			}
+		}
+		if sp := p.SnapshotProperties; sp != nil {
+			rp.SnapshotSchedulePolicy.SnapshotProperties = &SnapshotProperties{
+				Labels:           sp.Labels,
+				StorageLocations: sp.StorageLocations,
+				GuestFlush:       gcp.BoolValue(sp.GuestFlush),
+			}
+		}
+	}
+	return rp
+}
+
+func generateInstanceSchedule(in *v1alpha1.ResourcePolicyInstanceSchedule) *InstanceSchedule {
+	if in == nil {
+		return nil
+	}
+	return &InstanceSchedule{Schedule: in.Schedule}
+}
+
+func generateSnapshotSchedule(in v1alpha1.ResourcePolicySnapshotSchedule) *SnapshotSchedule {
+	s := &SnapshotSchedule{}
+	if c := in.HourlySchedule; c != nil {
+		s.HourlySchedule = &HourlyCycle{HoursInCycle: c.HoursInCycle, StartTime: c.StartTime}
+	}
+	if c := in.DailySchedule; c != nil {
+		s.DailySchedule = &DailyCycle{DaysInCycle: c.DaysInCycle, StartTime: c.StartTime}
+	}
+	if c := in.WeeklySchedule; c != nil {
+		s.WeeklySchedule = &WeeklyCycle{DayOfWeeks: make([]*DayOfWeek, len(c.DayOfWeeks))}
+		for i, d := range c.DayOfWeeks {
+			s.WeeklySchedule.DayOfWeeks[i] = &DayOfWeek{Day: d.Day, StartTime: d.StartTime}
+		}
+	}
+	return s
+}
+
+// GenerateResourcePolicyObservation creates a ResourcePolicyObservation from
+// the supplied ResourcePolicy.
+func GenerateResourcePolicyObservation(in ResourcePolicy) v1alpha1.ResourcePolicyObservation {
+	return v1alpha1.ResourcePolicyObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.ID,
+		SelfLink:          in.SelfLink,
+		Status:            in.Status,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// ResourcePolicy. Only fields that Compute Engine defaults are late
+// initialized.
+func LateInitializeSpec(spec *v1alpha1.ResourcePolicyParameters, in ResourcePolicy) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	p, o := spec.SnapshotSchedulePolicy, in.SnapshotSchedulePolicy
+	if p == nil || o == nil {
+		return
+	}
+	if p.RetentionPolicy != nil && o.RetentionPolicy != nil {
+		p.RetentionPolicy.OnSourceDiskDelete = gcp.LateInitializeString(p.RetentionPolicy.OnSourceDiskDelete, o.RetentionPolicy.OnSourceDiskDelete)
+	}
+	if o.SnapshotProperties != nil && len(o.SnapshotProperties.StorageLocations) > 0 {
+		if p.SnapshotProperties == nil {
+			p.SnapshotProperties = &v1alpha1.ResourcePolicySnapshotProperties{}
+		}
+		p.SnapshotProperties.StorageLocations = gcp.LateInitializeStringSlice(p.SnapshotProperties.StorageLocations, o.SnapshotProperties.StorageLocations)
+	}
+}
+
+// IsUpToDate returns true if the supplied ResourcePolicy matches the supplied
+// ResourcePolicyParameters. Unset and empty descriptions, labels and storage
+// locations are considered equal.
+func IsUpToDate(in v1alpha1.ResourcePolicyParameters, observed ResourcePolicy) bool {
+	return cmp.Equal(GenerateResourcePolicy(observed.Name, in), &observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(ResourcePolicy{}, "Region", "CreationTimestamp", "ID", "SelfLink", "Status"))
+}
+
+// Condition returns the condition that corresponds to the supplied status of
+// a resource policy.
+func Condition(status string) runtimev1alpha1.Condition {
+	switch status {
+	case v1alpha1.ResourcePolicyStatusReady:
+		return runtimev1alpha1.Available()
+	case v1alpha1.ResourcePolicyStatusCreating:
+		return runtimev1alpha1.Creating()
+	case v1alpha1.ResourcePolicyStatusDeleting:
+		return runtimev1alpha1.Deleting()
+	default:
+		return runtimev1alpha1.Unavailable()
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const testName = "office-hours"
+
+func params(m ...func(*v1alpha1.ResourcePolicyParameters)) *v1alpha1.ResourcePolicyParameters {
+	p := &v1alpha1.ResourcePolicyParameters{
+		Region:      "us-central1",
+		Description: gcp.StringPtr("desc"),
+		InstanceSchedulePolicy: &v1alpha1.ResourcePolicyInstanceSchedulePolicy{
+			VMStartSchedule: &v1alpha1.ResourcePolicyInstanceSchedule{Schedule: "0 8 * * 1-5"},
+			VMStopSchedule:  &v1alpha1.ResourcePolicyInstanceSchedule{Schedule: "0 18 * * 1-5"},
+			TimeZone:        "Europe/Zurich",
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func withSnapshotSchedule(p *v1alpha1.ResourcePolicyParameters) {
+	p.InstanceSchedulePolicy = nil
+	p.SnapshotSchedulePolicy = &v1alpha1.ResourcePolicySnapshotSchedulePolicy{
+		Schedule: v1alpha1.ResourcePolicySnapshotSchedule{
+			WeeklySchedule: &v1alpha1.ResourcePolicyWeeklyCycle{
+				DayOfWeeks: []v1alpha1.ResourcePolicyWeeklyCycleDayOfWeek{{Day: "MONDAY", StartTime: "04:00"}},
+			},
+		},
+		RetentionPolicy: &v1alpha1.ResourcePolicySnapshotRetentionPolicy{MaxRetentionDays: 14},
+	}
+}
+
+func TestGenerateResourcePolicy(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.ResourcePolicyParameters
+		want *ResourcePolicy
+	}{
+		"InstanceSchedule": {
+			in: *params(),
+			want: &ResourcePolicy{
+				Name:        testName,
+				Description: "desc",
+				InstanceSchedulePolicy: &InstanceSchedulePolicy{
+					VMStartSchedule: &InstanceSchedule{Schedule: "0 8 * * 1-5"},
+					VMStopSchedule:  &InstanceSchedule{Schedule: "0 18 * * 1-5"},
+					TimeZone:        "Europe/Zurich",
+				},
+			},
+		},
+		"SnapshotSchedule": {
+			in: *params(withSnapshotSchedule),
+			want: &ResourcePolicy{
+				Name:        testName,
+				Description: "desc",
+				SnapshotSchedulePolicy: &SnapshotSchedulePolicy{
+					Schedule: &SnapshotSchedule{
+						WeeklySchedule: &WeeklyCycle{DayOfWeeks: []*DayOfWeek{{Day: "MONDAY", StartTime: "04:00"}}},
+					},
+					RetentionPolicy: &RetentionPolicy{MaxRetentionDays: 14},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateResourcePolicy(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateResourcePolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	observed := *GenerateResourcePolicy(testName, *params(withSnapshotSchedule))
+	observed.SnapshotSchedulePolicy.RetentionPolicy.OnSourceDiskDelete = "KEEP_AUTO_SNAPSHOTS"
+	observed.SnapshotSchedulePolicy.SnapshotProperties = &SnapshotProperties{StorageLocations: []string{"us"}}
+
+	spec := params(withSnapshotSchedule, func(p *v1alpha1.ResourcePolicyParameters) { p.Description = nil })
+	want := params(withSnapshotSchedule, func(p *v1alpha1.ResourcePolicyParameters) {
+		p.SnapshotSchedulePolicy.RetentionPolicy.OnSourceDiskDelete = gcp.StringPtr("KEEP_AUTO_SNAPSHOTS")
+		p.SnapshotSchedulePolicy.SnapshotProperties = &v1alpha1.ResourcePolicySnapshotProperties{StorageLocations: []string{"us"}}
+	})
+
+	LateInitializeSpec(spec, observed)
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.ResourcePolicyParameters
+		observed ResourcePolicy
+		want     bool
+	}{
+		"UpToDate": {
+			in:       *params(),
+			observed: *GenerateResourcePolicy(testName, *params()),
+			want:     true,
+		},
+		"IgnoresOutputFields": {
+			in: *params(),
+			observed: func() ResourcePolicy {
+				rp := *GenerateResourcePolicy(testName, *params())
+				rp.Status = v1alpha1.ResourcePolicyStatusReady
+				rp.SelfLink = "https://compute.googleapis.com/compute/v1/projects/p/regions/us-central1/resourcePolicies/office-hours"
+				return rp
+			}(),
+			want: true,
+		},
+		"EmptyDescription": {
+			in:       *params(func(p *v1alpha1.ResourcePolicyParameters) { p.Description = gcp.StringPtr("") }),
+			observed: *GenerateResourcePolicy(testName, *params(func(p *v1alpha1.ResourcePolicyParameters) { p.Description = nil })),
+			want:     true,
+		},
+		"EmptySnapshotLabels": {
+			in: *params(withSnapshotSchedule, func(p *v1alpha1.ResourcePolicyParameters) {
+				p.SnapshotSchedulePolicy.SnapshotProperties = &v1alpha1.ResourcePolicySnapshotProperties{Labels: map[string]string{}}
+			}),
+			observed: func() ResourcePolicy {
+				rp := *GenerateResourcePolicy(testName, *params(withSnapshotSchedule))
+				rp.SnapshotSchedulePolicy.SnapshotProperties = &SnapshotProperties{}
+				return rp
+			}(),
+			want: true,
+		},
+		"DescriptionChanged": {
+			in:       *params(func(p *v1alpha1.ResourcePolicyParameters) { p.Description = gcp.StringPtr("new") }),
+			observed: *GenerateResourcePolicy(testName, *params()),
+			want:     false,
+		},
+		"ScheduleChanged": {
+			in: *params(func(p *v1alpha1.ResourcePolicyParameters) {
+				p.InstanceSchedulePolicy.VMStopSchedule.Schedule = "0 20 * * 1-5"
+			}),
+			observed: *GenerateResourcePolicy(testName, *params()),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"path"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateRouter creates a *compute.Router from the supplied
+// RouterParameters.
+func GenerateRouter(name string, in v1alpha1.RouterParameters) *compute.Router {
+	r := &compute.Router{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+		Network:     gcp.StringValue(in.Network),
+		Region:      in.Region,
+	}
+	if in.Bgp != nil {
+		r.Bgp = &compute.RouterBgp{Asn: gcp.Int64Value(in.Bgp.Asn)}
+	}
+	for _, n := range in.Nats {
+		r.Nats = append(r.Nats, GenerateRouterNat(n))
+	}
+	return r
+}
+
+// GenerateRouterNat creates a *compute.RouterNat from the supplied RouterNat.
+func GenerateRouterNat(in v1alpha1.RouterNat) *compute.RouterNat {
+	n := &compute.RouterNat{
+		Name:                          in.Name,
+		NatIpAllocateOption:           in.NatIPAllocateOption,
+		NatIps:                        in.NatIPs,
+		SourceSubnetworkIpRangesToNat: in.SourceSubnetworkIPRangesToNat,
+		MinPortsPerVm:                 gcp.Int64Value(in.MinPortsPerVM),
+		IcmpIdleTimeoutSec:            gcp.Int64Value(in.IcmpIdleTimeoutSec),
+		TcpEstablishedIdleTimeoutSec:  gcp.Int64Value(in.TCPEstablishedIdleTimeoutSec),
+		TcpTransitoryIdleTimeoutSec:   gcp.Int64Value(in.TCPTransitoryIdleTimeoutSec),
+		UdpIdleTimeoutSec:             gcp.Int64Value(in.UDPIdleTimeoutSec),
+	}
+	for _, s := range in.Subnetworks {
+		n.Subnetworks = append(n.Subnetworks, &compute.RouterNatSubnetworkToNat{
+			Name:                  gcp.StringValue(s.Subnetwork),
+			SourceIpRangesToNat:   s.SourceIPRangesToNat,
+			SecondaryIpRangeNames: s.SecondaryIPRangeNames,
+		})
+	}
+	if in.LogConfig != nil {
+		n.LogConfig = &compute.RouterNatLogConfig{
+			Enable: in.LogConfig.Enable,
+			Filter: gcp.StringValue(in.LogConfig.Filter),
+			// Logging must be explicitly disabled to turn it off.
+			ForceSendFields: []string{"Enable"},
+		}
+	}
+	return n
+}
+
+// GenerateRouterObservation creates a RouterObservation from the supplied
+// compute.Router.
+func GenerateRouterObservation(in compute.Router) v1alpha1.RouterObservation {
+	return v1alpha1.RouterObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// compute.Router. NATs are late initialized from the observed NAT of the same
+// name, if any.
+func LateInitializeSpec(spec *v1alpha1.RouterParameters, in compute.Router) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Network = gcp.LateInitializeString(spec.Network, in.Network)
+	if in.Bgp != nil {
+		if spec.Bgp == nil {
+			spec.Bgp = &v1alpha1.RouterBgp{}
+		}
+		spec.Bgp.Asn = gcp.LateInitializeInt64(spec.Bgp.Asn, in.Bgp.Asn)
+	}
+
+	observed := make(map[string]*compute.RouterNat, len(in.Nats))
+	for _, n := range in.Nats {
+		if n != nil {
+			observed[n.Name] = n
+		}
+	}
+	for i := range spec.Nats {
+		if o, ok := observed[spec.Nats[i].Name]; ok {
+			lateInitializeNat(&spec.Nats[i], *o)
+		}
+	}
+}
+
+func lateInitializeNat(spec *v1alpha1.RouterNat, in compute.RouterNat) {
+	spec.MinPortsPerVM = gcp.LateInitializeInt64(spec.MinPortsPerVM, in.MinPortsPerVm)
+	spec.IcmpIdleTimeoutSec = gcp.LateInitializeInt64(spec.IcmpIdleTimeoutSec, in.IcmpIdleTimeoutSec)
+	spec.TCPEstablishedIdleTimeoutSec = gcp.LateInitializeInt64(spec.TCPEstablishedIdleTimeoutSec, in.TcpEstablishedIdleTimeoutSec)
+	spec.TCPTransitoryIdleTimeoutSec = gcp.LateInitializeInt64(spec.TCPTransitoryIdleTimeoutSec, in.TcpTransitoryIdleTimeoutSec)
+	spec.UDPIdleTimeoutSec = gcp.LateInitializeInt64(spec.UDPIdleTimeoutSec, in.UdpIdleTimeoutSec)
+	if in.LogConfig != nil {
+		if spec.LogConfig == nil {
+			spec.LogConfig = &v1alpha1.RouterNatLogConfig{Enable: in.LogConfig.Enable}
+		}
+		spec.LogConfig.Filter = gcp.LateInitializeString(spec.LogConfig.Filter, in.LogConfig.Filter)
+	}
+}
+
+// IsUpToDate checks whether the observed compute.Router matches the supplied
+// RouterParameters. NATs are compared regardless of their order, as are the
+// subnetworks of each NAT and the IP ranges of each subnetwork. BGP peers and
+// interfaces are not managed by this resource and are ignored.
+func IsUpToDate(name string, in v1alpha1.RouterParameters, observed *compute.Router) bool {
+	desired := GenerateRouter(name, in)
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		gcp.EquateComputeURLs(),
+		cmpopts.SortSlices(func(i, j string) bool { return path.Base(i) < path.Base(j) }),
+		cmpopts.SortSlices(func(i, j *compute.RouterNat) bool { return i.Name < j.Name }),
+		cmpopts.SortSlices(func(i, j *compute.RouterNatSubnetworkToNat) bool { return path.Base(i.Name) < path.Base(j.Name) }),
+		cmpopts.IgnoreFields(compute.Router{}, "BgpPeers", "CreationTimestamp", "Id", "Interfaces", "Kind", "SelfLink", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.RouterBgp{}, "AdvertiseMode", "AdvertisedGroups", "AdvertisedIpRanges", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.RouterNat{}, "DrainNatIps", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.RouterNatSubnetworkToNat{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(compute.RouterNatLogConfig{}, "ForceSendFields", "NullFields"),
+	)
+}
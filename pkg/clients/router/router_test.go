@@ -0,0 +1,286 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testName   = "some-router"
+	testRegion = "us-central1"
+)
+
+func params(m ...func(*v1alpha1.RouterParameters)) *v1alpha1.RouterParameters {
+	p := &v1alpha1.RouterParameters{
+		Region:      testRegion,
+		Description: gcp.StringPtr("desc"),
+		Network:     gcp.StringPtr("global/networks/default"),
+		Bgp:         &v1alpha1.RouterBgp{Asn: gcp.Int64Ptr(64514)},
+		Nats: []v1alpha1.RouterNat{
+			{
+				Name:                          "nat-a",
+				NatIPAllocateOption:           "AUTO_ONLY",
+				SourceSubnetworkIPRangesToNat: v1alpha1.RouterNatListOfSubnetworks,
+				Subnetworks: []v1alpha1.RouterNatSubnetwork{
+					{
+						Subnetwork:            gcp.StringPtr("regions/us-central1/subnetworks/a"),
+						SourceIPRangesToNat:   []string{"PRIMARY_IP_RANGE", "LIST_OF_SECONDARY_IP_RANGES"},
+						SecondaryIPRangeNames: []string{"pods", "services"},
+					},
+					{
+						Subnetwork:          gcp.StringPtr("regions/us-central1/subnetworks/b"),
+						SourceIPRangesToNat: []string{"ALL_IP_RANGES"},
+					},
+				},
+				LogConfig: &v1alpha1.RouterNatLogConfig{
+					Enable: true,
+					Filter: gcp.StringPtr("ERRORS_ONLY"),
+				},
+				MinPortsPerVM:      gcp.Int64Ptr(64),
+				UDPIdleTimeoutSec:  gcp.Int64Ptr(30),
+				IcmpIdleTimeoutSec: gcp.Int64Ptr(30),
+			},
+			{
+				Name:                          "nat-b",
+				NatIPAllocateOption:           "MANUAL_ONLY",
+				NatIPs:                        []string{"regions/us-central1/addresses/ip"},
+				SourceSubnetworkIPRangesToNat: v1alpha1.RouterNatAllSubnetworksAllIPRanges,
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func router(m ...func(*compute.Router)) *compute.Router {
+	r := &compute.Router{
+		Name:        testName,
+		Region:      testRegion,
+		Description: "desc",
+		Network:     "global/networks/default",
+		Bgp:         &compute.RouterBgp{Asn: 64514},
+		Nats: []*compute.RouterNat{
+			{
+				Name:                          "nat-a",
+				NatIpAllocateOption:           "AUTO_ONLY",
+				SourceSubnetworkIpRangesToNat: v1alpha1.RouterNatListOfSubnetworks,
+				Subnetworks: []*compute.RouterNatSubnetworkToNat{
+					{
+						Name:                  "regions/us-central1/subnetworks/a",
+						SourceIpRangesToNat:   []string{"PRIMARY_IP_RANGE", "LIST_OF_SECONDARY_IP_RANGES"},
+						SecondaryIpRangeNames: []string{"pods", "services"},
+					},
+					{
+						Name:                "regions/us-central1/subnetworks/b",
+						SourceIpRangesToNat: []string{"ALL_IP_RANGES"},
+					},
+				},
+				LogConfig: &compute.RouterNatLogConfig{
+					Enable:          true,
+					Filter:          "ERRORS_ONLY",
+					ForceSendFields: []string{"Enable"},
+				},
+				MinPortsPerVm:      64,
+				UdpIdleTimeoutSec:  30,
+				IcmpIdleTimeoutSec: 30,
+			},
+			{
+				Name:                          "nat-b",
+				NatIpAllocateOption:           "MANUAL_ONLY",
+				NatIps:                        []string{"regions/us-central1/addresses/ip"},
+				SourceSubnetworkIpRangesToNat: v1alpha1.RouterNatAllSubnetworksAllIPRanges,
+			},
+		},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func TestGenerateRouter(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.RouterParameters
+		want *compute.Router
+	}{
+		"Full": {
+			in:   *params(),
+			want: router(),
+		},
+		"Minimal": {
+			in: v1alpha1.RouterParameters{
+				Region: testRegion,
+			},
+			want: &compute.Router{
+				Name:   testName,
+				Region: testRegion,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRouter(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateRouter(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.RouterParameters
+		observed compute.Router
+		want     *v1alpha1.RouterParameters
+	}{
+		"FillsDefaults": {
+			spec: params(func(p *v1alpha1.RouterParameters) {
+				p.Description = nil
+				p.Bgp = nil
+				p.Nats[0].MinPortsPerVM = nil
+				p.Nats[0].LogConfig.Filter = nil
+			}),
+			observed: *router(),
+			want:     params(),
+		},
+		"FillsLogConfig": {
+			spec: params(func(p *v1alpha1.RouterParameters) {
+				p.Nats[0].LogConfig = nil
+			}),
+			observed: *router(),
+			want:     params(),
+		},
+		"KeepsSpec": {
+			spec: params(),
+			observed: *router(func(r *compute.Router) {
+				r.Nats[0].MinPortsPerVm = 128
+				r.Nats[0].LogConfig.Filter = "ALL"
+			}),
+			want: params(),
+		},
+		"IgnoresUnknownNats": {
+			spec: params(func(p *v1alpha1.RouterParameters) {
+				p.Nats = p.Nats[1:]
+			}),
+			observed: *router(),
+			want: params(func(p *v1alpha1.RouterParameters) {
+				p.Nats = p.Nats[1:]
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.RouterParameters
+		observed *compute.Router
+		want     bool
+	}{
+		"UpToDate": {
+			in: *params(),
+			observed: router(func(r *compute.Router) {
+				r.Id = 42
+				r.SelfLink = "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1/routers/some-router"
+				r.Region = "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1"
+				r.Network = "https://www.googleapis.com/compute/v1/projects/p/global/networks/default"
+				r.Nats[0].LogConfig.ForceSendFields = nil
+			}),
+			want: true,
+		},
+		"ReorderedNats": {
+			in: *params(),
+			observed: router(func(r *compute.Router) {
+				r.Nats[0], r.Nats[1] = r.Nats[1], r.Nats[0]
+			}),
+			want: true,
+		},
+		"ReorderedSubnetworks": {
+			in: *params(),
+			observed: router(func(r *compute.Router) {
+				s := r.Nats[0].Subnetworks
+				s[0], s[1] = s[1], s[0]
+				s[1].Name = "https://www.googleapis.com/compute/v1/projects/p/regions/us-central1/subnetworks/a"
+				s[1].SourceIpRangesToNat = []string{"LIST_OF_SECONDARY_IP_RANGES", "PRIMARY_IP_RANGE"}
+				s[1].SecondaryIpRangeNames = []string{"services", "pods"}
+			}),
+			want: true,
+		},
+		"SecondaryRangeRemoved": {
+			in: *params(),
+			observed: router(func(r *compute.Router) {
+				r.Nats[0].Subnetworks[0].SecondaryIpRangeNames = []string{"pods"}
+			}),
+			want: false,
+		},
+		"SubnetworkRemoved": {
+			in: *params(),
+			observed: router(func(r *compute.Router) {
+				r.Nats[0].Subnetworks = r.Nats[0].Subnetworks[:1]
+			}),
+			want: false,
+		},
+		"LogFilterChanged": {
+			in: *params(),
+			observed: router(func(r *compute.Router) {
+				r.Nats[0].LogConfig.Filter = "ALL"
+			}),
+			want: false,
+		},
+		"LoggingDisabled": {
+			in: *params(),
+			observed: router(func(r *compute.Router) {
+				r.Nats[0].LogConfig.Enable = false
+			}),
+			want: false,
+		},
+		"NatRemoved": {
+			in: *params(),
+			observed: router(func(r *compute.Router) {
+				r.Nats = r.Nats[:1]
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(testName, tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemanagement
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	servicemanagement "google.golang.org/api/servicemanagement/v1"
+
+	"github.com/crossplane/provider-gcp/apis/servicemanagement/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// fileTypeDescriptorSet is the type of config files whose contents are
+// binary, and thus supplied base64 encoded.
+const fileTypeDescriptorSet = "FILE_DESCRIPTOR_SET_PROTO"
+
+const errParseSubmitResponse = "cannot parse config submission response"
+
+// GenerateManagedService produces a ManagedService that creates a service
+// with the supplied name. The service is owned by the supplied project unless
+// the ManagedServiceParameters specify another.
+func GenerateManagedService(name, projectID string, in v1alpha1.ManagedServiceParameters) *servicemanagement.ManagedService {
+	if in.ProducerProjectID != nil {
+		projectID = *in.ProducerProjectID
+	}
+	return &servicemanagement.ManagedService{
+		ServiceName:       name,
+		ProducerProjectId: projectID,
+	}
+}
+
+// GenerateSubmitConfigSourceRequest produces a request that submits the
+// config files of the supplied ManagedServiceParameters as a new config
+// version.
+func GenerateSubmitConfigSourceRequest(in v1alpha1.ManagedServiceParameters) *servicemanagement.SubmitConfigSourceRequest {
+	files := make([]*servicemanagement.ConfigFile, len(in.ConfigFiles))
+	for i, f := range in.ConfigFiles {
+		contents := f.FileContents
+		if f.FileType != fileTypeDescriptorSet {
+			contents = base64.StdEncoding.EncodeToString([]byte(contents))
+		}
+		files[i] = &servicemanagement.ConfigFile{
+			FilePath:     f.FilePath,
+			FileContents: contents,
+			FileType:     f.FileType,
+		}
+	}
+	return &servicemanagement.SubmitConfigSourceRequest{
+		ConfigSource: &servicemanagement.ConfigSource{Files: files},
+	}
+}
+
+// GenerateRollout produces a Rollout that sends all traffic to the supplied
+// config version.
+func GenerateRollout(configID string) *servicemanagement.Rollout {
+	return &servicemanagement.Rollout{
+		TrafficPercentStrategy: &servicemanagement.TrafficPercentStrategy{
+			Percentages: map[string]float64{configID: 100},
+		},
+	}
+}
+
+// ConfigFilesHash returns a hash of the config files of the supplied
+// ManagedServiceParameters.
+func ConfigFilesHash(in v1alpha1.ManagedServiceParameters) string {
+	// Marshalling a slice of structs of strings cannot fail.
+	b, _ := json.Marshal(in.ConfigFiles)
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
+
+// SubmittedConfigID returns the ID of the config version created by the
+// supplied, completed config submission operation.
+func SubmittedConfigID(op *servicemanagement.Operation) (string, error) {
+	rsp := &servicemanagement.SubmitConfigSourceResponse{}
+	if err := json.Unmarshal(op.Response, rsp); err != nil {
+		return "", errors.Wrap(err, errParseSubmitResponse)
+	}
+	if rsp.ServiceConfig == nil {
+		return "", errors.New(errParseSubmitResponse)
+	}
+	return rsp.ServiceConfig.Id, nil
+}
+
+// RolledOutConfigID returns the ID of the config version that receives most
+// traffic per the supplied Rollout.
+func RolledOutConfigID(r *servicemanagement.Rollout) string {
+	if r.TrafficPercentStrategy == nil {
+		return ""
+	}
+	id, max := "", -1.0
+	for c, p := range r.TrafficPercentStrategy.Percentages {
+		if p > max || (p == max && c > id) {
+			id, max = c, p
+		}
+	}
+	return id
+}
+
+// RollsOut returns true if the supplied Rollout sends traffic to the supplied
+// config version.
+func RollsOut(r *servicemanagement.Rollout, configID string) bool {
+	if r == nil || r.TrafficPercentStrategy == nil {
+		return false
+	}
+	_, ok := r.TrafficPercentStrategy.Percentages[configID]
+	return ok
+}
+
+// LateInitializeSpec fills the empty fields of the supplied
+// ManagedServiceParameters with those of the supplied ManagedService.
+func LateInitializeSpec(spec *v1alpha1.ManagedServiceParameters, in servicemanagement.ManagedService) {
+	spec.ProducerProjectID = gcp.LateInitializeString(spec.ProducerProjectID, in.ProducerProjectId)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemanagement
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	servicemanagement "google.golang.org/api/servicemanagement/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/servicemanagement/v1alpha1"
+)
+
+func TestGenerateSubmitConfigSourceRequest(t *testing.T) {
+	in := v1alpha1.ManagedServiceParameters{
+		ConfigFiles: []v1alpha1.ConfigFile{
+			{FilePath: "api_config.yaml", FileContents: "type: google.api.Service", FileType: "SERVICE_CONFIG_YAML"},
+			{FilePath: "api.pb", FileContents: "CgRhcGk=", FileType: fileTypeDescriptorSet},
+		},
+	}
+	want := &servicemanagement.SubmitConfigSourceRequest{
+		ConfigSource: &servicemanagement.ConfigSource{Files: []*servicemanagement.ConfigFile{
+			{FilePath: "api_config.yaml", FileContents: "dHlwZTogZ29vZ2xlLmFwaS5TZXJ2aWNl", FileType: "SERVICE_CONFIG_YAML"},
+			{FilePath: "api.pb", FileContents: "CgRhcGk=", FileType: fileTypeDescriptorSet},
+		}},
+	}
+	got := GenerateSubmitConfigSourceRequest(in)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateSubmitConfigSourceRequest(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestSubmittedConfigID(t *testing.T) {
+	type want struct {
+		id  string
+		err error
+	}
+
+	cases := map[string]struct {
+		op   *servicemanagement.Operation
+		want want
+	}{
+		"Successful": {
+			op:   &servicemanagement.Operation{Response: googleapi.RawMessage(`{"serviceConfig":{"id":"2020-10-01r0"}}`)},
+			want: want{id: "2020-10-01r0"},
+		},
+		"NoConfig": {
+			op:   &servicemanagement.Operation{Response: googleapi.RawMessage(`{}`)},
+			want: want{err: errors.New(errParseSubmitResponse)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := SubmittedConfigID(tc.op)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("SubmittedConfigID(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("SubmittedConfigID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRolledOutConfigID(t *testing.T) {
+	cases := map[string]struct {
+		r    *servicemanagement.Rollout
+		want string
+	}{
+		"NoStrategy": {
+			r: &servicemanagement.Rollout{},
+		},
+		"Single": {
+			r:    GenerateRollout("2020-10-01r0"),
+			want: "2020-10-01r0",
+		},
+		"Split": {
+			r: &servicemanagement.Rollout{TrafficPercentStrategy: &servicemanagement.TrafficPercentStrategy{
+				Percentages: map[string]float64{"2020-10-01r0": 20, "2020-10-01r1": 80},
+			}},
+			want: "2020-10-01r1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := RolledOutConfigID(tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("RolledOutConfigID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
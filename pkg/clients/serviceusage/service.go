@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+	serviceusage "google.golang.org/api/serviceusage/v1"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// ServiceName returns the resource name of the supplied service of the
+// supplied project.
+func ServiceName(project, service string) string {
+	return fmt.Sprintf("projects/%s/services/%s", project, service)
+}
+
+// GenerateDisableServiceRequest produces a request that disables a service
+// per the supplied ServiceParameters.
+func GenerateDisableServiceRequest(in v1alpha1.ServiceParameters) *serviceusage.DisableServiceRequest {
+	return &serviceusage.DisableServiceRequest{DisableDependentServices: gcp.BoolValue(in.DisableDependentServices)}
+}
+
+// GenerateServiceObservation produces a ServiceObservation from the supplied
+// service.
+func GenerateServiceObservation(in serviceusage.GoogleApiServiceusageV1Service) v1alpha1.ServiceObservation {
+	o := v1alpha1.ServiceObservation{
+		Name:  in.Name,
+		State: in.State,
+	}
+	if in.Config != nil {
+		o.Title = in.Config.Title
+	}
+	return o
+}
+
+// IsErrorDependentServices returns true if the supplied error indicates that
+// a service could not be disabled because enabled services depend on it.
+func IsErrorDependentServices(err error) bool {
+	gerr, ok := err.(*googleapi.Error)
+	return ok && gcp.IsErrorBadRequest(err) && strings.Contains(gerr.Message, "depended on")
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	serviceusage "google.golang.org/api/serviceusage/v1"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestGenerateDisableServiceRequest(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.ServiceParameters
+		want *serviceusage.DisableServiceRequest
+	}{
+		"Unset": {
+			in:   v1alpha1.ServiceParameters{},
+			want: &serviceusage.DisableServiceRequest{},
+		},
+		"DisableDependentServices": {
+			in:   v1alpha1.ServiceParameters{DisableDependentServices: gcp.BoolPtr(true)},
+			want: &serviceusage.DisableServiceRequest{DisableDependentServices: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateDisableServiceRequest(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateDisableServiceRequest(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsErrorDependentServices(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil": {},
+		"NotGoogleAPIError": {
+			err: errors.New("boom"),
+		},
+		"OtherBadRequest": {
+			err: &googleapi.Error{Code: http.StatusBadRequest, Message: "invalid service name"},
+		},
+		"DependentServices": {
+			err:  &googleapi.Error{Code: http.StatusBadRequest, Message: "The service compute.googleapis.com is depended on by the following active service(s): container.googleapis.com"},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsErrorDependentServices(tc.err); got != tc.want {
+				t.Errorf("IsErrorDependentServices(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
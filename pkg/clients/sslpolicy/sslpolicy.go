@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sslpolicy
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateSslPolicy creates a *compute.SslPolicy from the supplied
+// SslPolicyParameters.
+func GenerateSslPolicy(name string, in v1alpha1.SslPolicyParameters) *compute.SslPolicy {
+	return &compute.SslPolicy{
+		Name:           name,
+		Description:    gcp.StringValue(in.Description),
+		Profile:        gcp.StringValue(in.Profile),
+		MinTlsVersion:  gcp.StringValue(in.MinTLSVersion),
+		CustomFeatures: in.CustomFeatures,
+	}
+}
+
+// GenerateSslPolicyPatch creates the *compute.SslPolicy that updates the
+// profile, minimum TLS version and custom features of the observed policy to
+// those of the supplied SslPolicyParameters. Custom features are always sent,
+// so that they are cleared when the profile is no longer CUSTOM.
+func GenerateSslPolicyPatch(in v1alpha1.SslPolicyParameters, observed compute.SslPolicy) *compute.SslPolicy {
+	return &compute.SslPolicy{
+		Fingerprint:     observed.Fingerprint,
+		Profile:         gcp.StringValue(in.Profile),
+		MinTlsVersion:   gcp.StringValue(in.MinTLSVersion),
+		CustomFeatures:  in.CustomFeatures,
+		ForceSendFields: []string{"CustomFeatures"},
+	}
+}
+
+// GenerateSslPolicyObservation creates a SslPolicyObservation from the
+// supplied compute.SslPolicy.
+func GenerateSslPolicyObservation(in compute.SslPolicy) v1alpha1.SslPolicyObservation {
+	o := v1alpha1.SslPolicyObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+		Fingerprint:       in.Fingerprint,
+		EnabledFeatures:   in.EnabledFeatures,
+	}
+	for _, w := range in.Warnings {
+		if w != nil {
+			o.Warnings = append(o.Warnings, w.Message)
+		}
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// compute.SslPolicy.
+func LateInitializeSpec(spec *v1alpha1.SslPolicyParameters, in compute.SslPolicy) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Profile = gcp.LateInitializeString(spec.Profile, in.Profile)
+	spec.MinTLSVersion = gcp.LateInitializeString(spec.MinTLSVersion, in.MinTlsVersion)
+}
+
+// IsUpToDate checks whether the observed compute.SslPolicy matches the
+// supplied SslPolicyParameters. The order of custom features is not
+// significant.
+func IsUpToDate(in v1alpha1.SslPolicyParameters, observed compute.SslPolicy) bool {
+	if in.Profile != nil && *in.Profile != observed.Profile {
+		return false
+	}
+	if in.MinTLSVersion != nil && *in.MinTLSVersion != observed.MinTlsVersion {
+		return false
+	}
+	return cmp.Equal(in.CustomFeatures, observed.CustomFeatures,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sslpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const testName = "some-policy"
+
+func params(m ...func(*v1alpha1.SslPolicyParameters)) *v1alpha1.SslPolicyParameters {
+	p := &v1alpha1.SslPolicyParameters{
+		Description:   gcp.StringPtr("desc"),
+		Profile:       gcp.StringPtr(v1alpha1.SslPolicyProfileCustom),
+		MinTLSVersion: gcp.StringPtr("TLS_1_2"),
+		CustomFeatures: []string{
+			"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+			"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func policy(m ...func(*compute.SslPolicy)) *compute.SslPolicy {
+	p := &compute.SslPolicy{
+		Name:          testName,
+		Description:   "desc",
+		Profile:       v1alpha1.SslPolicyProfileCustom,
+		MinTlsVersion: "TLS_1_2",
+		CustomFeatures: []string{
+			"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+			"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGenerateSslPolicy(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.SslPolicyParameters
+		want *compute.SslPolicy
+	}{
+		"Full": {
+			in:   *params(),
+			want: policy(),
+		},
+		"Minimal": {
+			in:   v1alpha1.SslPolicyParameters{},
+			want: &compute.SslPolicy{Name: testName},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSslPolicy(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateSslPolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.SslPolicyParameters
+		observed compute.SslPolicy
+		want     *v1alpha1.SslPolicyParameters
+	}{
+		"FillsDefaults": {
+			spec: &v1alpha1.SslPolicyParameters{},
+			observed: *policy(func(p *compute.SslPolicy) {
+				p.Profile = v1alpha1.SslPolicyProfileCompatible
+				p.MinTlsVersion = "TLS_1_0"
+				p.CustomFeatures = nil
+			}),
+			want: &v1alpha1.SslPolicyParameters{
+				Description:   gcp.StringPtr("desc"),
+				Profile:       gcp.StringPtr(v1alpha1.SslPolicyProfileCompatible),
+				MinTLSVersion: gcp.StringPtr("TLS_1_0"),
+			},
+		},
+		"KeepsSpec": {
+			spec: params(),
+			observed: *policy(func(p *compute.SslPolicy) {
+				p.Profile = v1alpha1.SslPolicyProfileModern
+			}),
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.SslPolicyParameters
+		observed compute.SslPolicy
+		want     bool
+	}{
+		"UpToDate": {
+			in:       *params(),
+			observed: *policy(),
+			want:     true,
+		},
+		"CustomFeaturesReordered": {
+			in: *params(),
+			observed: *policy(func(p *compute.SslPolicy) {
+				p.CustomFeatures = []string{p.CustomFeatures[1], p.CustomFeatures[0]}
+			}),
+			want: true,
+		},
+		"CustomFeatureRemoved": {
+			in: *params(),
+			observed: *policy(func(p *compute.SslPolicy) {
+				p.CustomFeatures = p.CustomFeatures[:1]
+			}),
+			want: false,
+		},
+		"ProfileChanged": {
+			in: *params(func(p *v1alpha1.SslPolicyParameters) {
+				p.Profile = gcp.StringPtr(v1alpha1.SslPolicyProfileRestricted)
+				p.CustomFeatures = nil
+			}),
+			observed: *policy(),
+			want:     false,
+		},
+		"MinTLSVersionChanged": {
+			in: *params(func(p *v1alpha1.SslPolicyParameters) {
+				p.MinTLSVersion = gcp.StringPtr("TLS_1_1")
+			}),
+			observed: *policy(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
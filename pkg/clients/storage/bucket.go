@@ -19,18 +19,103 @@ package storage
 import (
 	"context"
 
+	"cloud.google.com/go/iam"
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
 )
 
 // Client bucket resource operations interface
 type Client interface {
 	Attrs(context.Context) (*storage.BucketAttrs, error)
 	Create(context.Context, string, *storage.BucketAttrs) error
-	Update(context.Context, storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error)
-	Delete(context.Context) error
+	Update(context.Context, storage.BucketAttrsToUpdate, int64) (*storage.BucketAttrs, error)
+	Delete(context.Context, int64) error
+	Empty(context.Context) (bool, error)
+	Relocate(context.Context, string) (*Operation, error)
+	GetRelocation(context.Context, string) (*Operation, error)
+	Policy(context.Context) (*iam.Policy, error)
+	SetPolicy(context.Context, *iam.Policy) error
+	RemoveLifecycle(context.Context, int64) (int64, error)
+	LockRetentionPolicy(context.Context, int64) error
 }
 
 // BucketClient implements Client interface
 type BucketClient struct {
 	*storage.BucketHandle
+
+	// Name of the bucket.
+	Name string
+
+	// Relocations relocates the bucket to a new location.
+	Relocations *RelocationService
+
+	// Patches patches attributes of the bucket that the client library
+	// cannot update.
+	Patches *PatchService
+}
+
+// Update the bucket's attributes. If metageneration is non-zero the update
+// only succeeds if the bucket's current metageneration matches it, so that an
+// update computed from stale attributes does not overwrite a newer change.
+func (c *BucketClient) Update(ctx context.Context, uattrs storage.BucketAttrsToUpdate, metageneration int64) (*storage.BucketAttrs, error) {
+	if metageneration == 0 {
+		return c.BucketHandle.Update(ctx, uattrs)
+	}
+	return c.BucketHandle.If(storage.BucketConditions{MetagenerationMatch: metageneration}).Update(ctx, uattrs)
+}
+
+// Delete the bucket. If metageneration is non-zero the bucket is only deleted
+// if its current metageneration matches it, so that a bucket that was changed
+// since it was last observed is not deleted by accident.
+func (c *BucketClient) Delete(ctx context.Context, metageneration int64) error {
+	if metageneration == 0 {
+		return c.BucketHandle.Delete(ctx)
+	}
+	return c.BucketHandle.If(storage.BucketConditions{MetagenerationMatch: metageneration}).Delete(ctx)
+}
+
+// Empty returns true if the bucket contains no objects, including noncurrent
+// object versions.
+func (c *BucketClient) Empty(ctx context.Context) (bool, error) {
+	_, err := c.Objects(ctx, &storage.Query{Versions: true}).Next()
+	if err == iterator.Done {
+		return true, nil
+	}
+	return false, err
+}
+
+// Relocate starts relocating the bucket to the supplied location.
+func (c *BucketClient) Relocate(ctx context.Context, location string) (*Operation, error) {
+	return c.Relocations.Relocate(ctx, c.Name, location)
+}
+
+// GetRelocation returns the named relocation operation of the bucket.
+func (c *BucketClient) GetRelocation(ctx context.Context, name string) (*Operation, error) {
+	return c.Relocations.GetOperation(ctx, c.Name, name)
+}
+
+// Policy returns the IAM policy of the bucket.
+func (c *BucketClient) Policy(ctx context.Context) (*iam.Policy, error) {
+	return c.IAM().Policy(ctx)
+}
+
+// SetPolicy sets the IAM policy of the bucket. The write fails if the policy
+// was changed since the supplied policy was read.
+func (c *BucketClient) SetPolicy(ctx context.Context, p *iam.Policy) error {
+	return c.IAM().SetPolicy(ctx, p)
+}
+
+// RemoveLifecycle removes all lifecycle rules of the bucket and returns its
+// new metageneration. Like Update, it only succeeds if the bucket's current
+// metageneration matches the supplied one, unless that is zero.
+func (c *BucketClient) RemoveLifecycle(ctx context.Context, metageneration int64) (int64, error) {
+	return c.Patches.RemoveLifecycle(ctx, c.Name, metageneration)
+}
+
+// LockRetentionPolicy locks the bucket's retention policy. The lock only
+// succeeds if the bucket's current metageneration matches the supplied one,
+// so that a policy is never locked with a retention period that was not
+// observed.
+func (c *BucketClient) LockRetentionPolicy(ctx context.Context, metageneration int64) error {
+	return c.BucketHandle.If(storage.BucketConditions{MetagenerationMatch: metageneration}).LockRetentionPolicy(ctx)
 }
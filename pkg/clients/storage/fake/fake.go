@@ -19,6 +19,7 @@ package fake
 import (
 	"context"
 
+	"cloud.google.com/go/iam"
 	"cloud.google.com/go/storage"
 
 	gcpstorage "github.com/crossplane/provider-gcp/pkg/clients/storage"
@@ -28,8 +29,18 @@ import (
 type MockBucketClient struct {
 	MockAttrs  func(context.Context) (*storage.BucketAttrs, error)
 	MockCreate func(context.Context, string, *storage.BucketAttrs) error
-	MockUpdate func(context.Context, storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error)
-	MockDelete func(context.Context) error
+	MockUpdate func(context.Context, storage.BucketAttrsToUpdate, int64) (*storage.BucketAttrs, error)
+	MockDelete func(context.Context, int64) error
+	MockEmpty  func(context.Context) (bool, error)
+
+	MockRelocate      func(context.Context, string) (*gcpstorage.Operation, error)
+	MockGetRelocation func(context.Context, string) (*gcpstorage.Operation, error)
+
+	MockPolicy    func(context.Context) (*iam.Policy, error)
+	MockSetPolicy func(context.Context, *iam.Policy) error
+
+	MockRemoveLifecycle     func(context.Context, int64) (int64, error)
+	MockLockRetentionPolicy func(context.Context, int64) error
 }
 
 // NewMockBucketClient returns new MockBucketClient with default mock implementations
@@ -37,10 +48,11 @@ func NewMockBucketClient() *MockBucketClient {
 	return &MockBucketClient{
 		MockAttrs:  func(i context.Context) (attrs *storage.BucketAttrs, e error) { return nil, nil },
 		MockCreate: func(i context.Context, s string, attrs *storage.BucketAttrs) error { return nil },
-		MockUpdate: func(i context.Context, update storage.BucketAttrsToUpdate) (attrs *storage.BucketAttrs, e error) {
+		MockUpdate: func(i context.Context, update storage.BucketAttrsToUpdate, metageneration int64) (attrs *storage.BucketAttrs, e error) {
 			return nil, nil
 		},
-		MockDelete: func(i context.Context) error { return nil },
+		MockDelete: func(i context.Context, metageneration int64) error { return nil },
+		MockEmpty:  func(i context.Context) (bool, error) { return true, nil },
 	}
 }
 
@@ -55,14 +67,65 @@ func (m *MockBucketClient) Create(ctx context.Context, projectID string, attrs *
 }
 
 // Update existing bucket resource
-func (m *MockBucketClient) Update(ctx context.Context, attrs storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
-	return m.MockUpdate(ctx, attrs)
+func (m *MockBucketClient) Update(ctx context.Context, attrs storage.BucketAttrsToUpdate, metageneration int64) (*storage.BucketAttrs, error) {
+	return m.MockUpdate(ctx, attrs, metageneration)
 }
 
 // Delete existing bucket resource
-func (m *MockBucketClient) Delete(ctx context.Context) error {
-	return m.MockDelete(ctx)
+func (m *MockBucketClient) Delete(ctx context.Context, metageneration int64) error {
+	return m.MockDelete(ctx, metageneration)
+}
+
+// Empty returns true if the bucket contains no objects
+func (m *MockBucketClient) Empty(ctx context.Context) (bool, error) {
+	return m.MockEmpty(ctx)
+}
+
+// Relocate starts relocating the bucket
+func (m *MockBucketClient) Relocate(ctx context.Context, location string) (*gcpstorage.Operation, error) {
+	return m.MockRelocate(ctx, location)
+}
+
+// GetRelocation returns a relocation operation of the bucket
+func (m *MockBucketClient) GetRelocation(ctx context.Context, name string) (*gcpstorage.Operation, error) {
+	return m.MockGetRelocation(ctx, name)
+}
+
+// Policy returns the IAM policy of the bucket
+func (m *MockBucketClient) Policy(ctx context.Context) (*iam.Policy, error) {
+	return m.MockPolicy(ctx)
+}
+
+// SetPolicy sets the IAM policy of the bucket
+func (m *MockBucketClient) SetPolicy(ctx context.Context, p *iam.Policy) error {
+	return m.MockSetPolicy(ctx, p)
+}
+
+// RemoveLifecycle removes all lifecycle rules of the bucket
+func (m *MockBucketClient) RemoveLifecycle(ctx context.Context, metageneration int64) (int64, error) {
+	return m.MockRemoveLifecycle(ctx, metageneration)
 }
 
 // assert interface
 var _ gcpstorage.Client = &MockBucketClient{}
+
+// LockRetentionPolicy locks the retention policy of the bucket
+func (m *MockBucketClient) LockRetentionPolicy(ctx context.Context, metageneration int64) error {
+	return m.MockLockRetentionPolicy(ctx, metageneration)
+}
+
+// MockPolicyClient is a mock implementation of PolicyClient.
+type MockPolicyClient struct {
+	MockPolicy    func(ctx context.Context, bucket string) (*iam.Policy, error)
+	MockSetPolicy func(ctx context.Context, bucket string, p *iam.Policy) error
+}
+
+// Policy returns the IAM policy of the named bucket.
+func (m *MockPolicyClient) Policy(ctx context.Context, bucket string) (*iam.Policy, error) {
+	return m.MockPolicy(ctx, bucket)
+}
+
+// SetPolicy sets the IAM policy of the named bucket.
+func (m *MockPolicyClient) SetPolicy(ctx context.Context, bucket string, p *iam.Policy) error {
+	return m.MockSetPolicy(ctx, bucket, p)
+}
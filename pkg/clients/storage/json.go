@@ -0,0 +1,52 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// JSON API client defaults.
+const (
+	DefaultJSONEndpoint = "https://storage.googleapis.com/storage/v1/"
+	FullControlScope    = "https://www.googleapis.com/auth/devstorage.full_control"
+)
+
+// A jsonClient calls the Cloud Storage JSON API directly, on top of the same
+// authenticated transport as the Cloud Storage client library, for the calls
+// that library does not support.
+type jsonClient struct {
+	json *gcp.JSONClient
+}
+
+func newJSONClient(ctx context.Context, opts ...option.ClientOption) (*jsonClient, error) {
+	c, err := gcp.NewJSONClient(ctx, DefaultJSONEndpoint, append([]option.ClientOption{option.WithScopes(FullControlScope)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonClient{json: c}, nil
+}
+
+// do sends a request for the supplied resource path and decodes the response
+// into out.
+func (s *jsonClient) do(ctx context.Context, method, p string, in, out interface{}) error {
+	return s.json.Do(ctx, method, p, nil, in, out)
+}
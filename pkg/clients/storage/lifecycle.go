@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
+)
+
+// actionSetStorageClass is the lifecycle action type that changes the storage
+// class of matching objects. It is the only action that uses a storage class.
+const actionSetStorageClass = "SetStorageClass"
+
+// IsLifecycleUpToDate returns true if the supplied lifecycle configurations
+// are semantically equal. GCS does not necessarily return lifecycle rules the
+// way they were sent, so both configurations are compared in their canonical
+// form rather than as is.
+func IsLifecycleUpToDate(desired, observed v1alpha3.Lifecycle) bool {
+	return reflect.DeepEqual(CanonicalLifecycle(desired), CanonicalLifecycle(observed))
+}
+
+// CanonicalLifecycle returns the canonical form of the supplied lifecycle
+// configuration. Rules are sorted and duplicate rules are dropped, because
+// neither their order nor their multiplicity changes which objects they
+// apply to. Within a rule, matched storage classes are upper-cased, sorted
+// and deduplicated, a storage class is dropped from any action that does not
+// set one, and the created-before date is truncated to the date GCS stores,
+// in UTC. A configuration without rules has nil rules.
+func CanonicalLifecycle(in v1alpha3.Lifecycle) v1alpha3.Lifecycle {
+	if len(in.Rules) == 0 {
+		return v1alpha3.Lifecycle{}
+	}
+	rules := make([]v1alpha3.LifecycleRule, 0, len(in.Rules))
+	keys := map[string]bool{}
+	for _, r := range in.Rules {
+		c := canonicalRule(r)
+		k := ruleKey(c)
+		if keys[k] {
+			continue
+		}
+		keys[k] = true
+		rules = append(rules, c)
+	}
+	sort.SliceStable(rules, func(i, j int) bool { return ruleKey(rules[i]) < ruleKey(rules[j]) })
+	return v1alpha3.Lifecycle{Rules: rules}
+}
+
+func canonicalRule(in v1alpha3.LifecycleRule) v1alpha3.LifecycleRule {
+	out := v1alpha3.LifecycleRule{
+		Action: v1alpha3.LifecycleAction{Type: in.Action.Type},
+		Condition: v1alpha3.LifecycleCondition{
+			AgeInDays:             in.Condition.AgeInDays,
+			CreatedBefore:         canonicalDate(in.Condition.CreatedBefore),
+			Liveness:              in.Condition.Liveness,
+			MatchesStorageClasses: canonicalStorageClasses(in.Condition.MatchesStorageClasses),
+			NumNewerVersions:      in.Condition.NumNewerVersions,
+		},
+	}
+	if in.Action.Type == actionSetStorageClass {
+		out.Action.StorageClass = strings.ToUpper(in.Action.StorageClass)
+	}
+	return out
+}
+
+// canonicalDate returns midnight UTC of the date of the supplied time, in the
+// time's own location. That is the date the storage client sends to GCS, and
+// the time it parses back.
+func canonicalDate(t metav1.Time) metav1.Time {
+	if t.IsZero() {
+		return metav1.Time{}
+	}
+	y, m, d := t.Date()
+	return metav1.Time{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+}
+
+func canonicalStorageClasses(in []string) []string {
+	if len(in) == 0 {
+		return nil
+	}
+	seen := map[string]bool{}
+	out := make([]string, 0, len(in))
+	for _, c := range in {
+		c = strings.ToUpper(c)
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		out = append(out, c)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ruleKey returns a key that is equal for equal canonical rules.
+func ruleKey(r v1alpha3.LifecycleRule) string {
+	// Marshalling a LifecycleRule cannot fail.
+	b, _ := json.Marshal(r)
+	return string(b)
+}
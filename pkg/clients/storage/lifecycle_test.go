@@ -0,0 +1,221 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
+)
+
+func rule(action, class string, c v1alpha3.LifecycleCondition) v1alpha3.LifecycleRule {
+	return v1alpha3.LifecycleRule{Action: v1alpha3.LifecycleAction{Type: action, StorageClass: class}, Condition: c}
+}
+
+func date(y int, m time.Month, d int, loc *time.Location) metav1.Time {
+	return metav1.Time{Time: time.Date(y, m, d, 0, 0, 0, 0, loc)}
+}
+
+func TestCanonicalLifecycle(t *testing.T) {
+	cet := time.FixedZone("CET", 60*60)
+
+	cases := map[string]struct {
+		in   v1alpha3.Lifecycle
+		want v1alpha3.Lifecycle
+	}{
+		"NoRules": {
+			in:   v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{}},
+			want: v1alpha3.Lifecycle{},
+		},
+		"SortsAndDeduplicatesStorageClasses": {
+			in: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("SetStorageClass", "coldline", v1alpha3.LifecycleCondition{MatchesStorageClasses: []string{"STANDARD", "nearline", "NEARLINE"}}),
+			}},
+			want: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("SetStorageClass", "COLDLINE", v1alpha3.LifecycleCondition{MatchesStorageClasses: []string{"NEARLINE", "STANDARD"}}),
+			}},
+		},
+		"DropsStorageClassOfDelete": {
+			in: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("Delete", "NEARLINE", v1alpha3.LifecycleCondition{AgeInDays: 30}),
+			}},
+			want: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("Delete", "", v1alpha3.LifecycleCondition{AgeInDays: 30}),
+			}},
+		},
+		"TruncatesCreatedBefore": {
+			in: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("Delete", "", v1alpha3.LifecycleCondition{CreatedBefore: metav1.Time{Time: time.Date(2020, 1, 1, 0, 30, 0, 0, cet)}}),
+			}},
+			want: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("Delete", "", v1alpha3.LifecycleCondition{CreatedBefore: date(2020, 1, 1, time.UTC)}),
+			}},
+		},
+		"SortsAndDeduplicatesRules": {
+			in: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("Delete", "", v1alpha3.LifecycleCondition{AgeInDays: 365}),
+				rule("SetStorageClass", "NEARLINE", v1alpha3.LifecycleCondition{AgeInDays: 30}),
+				rule("SetStorageClass", "NEARLINE", v1alpha3.LifecycleCondition{AgeInDays: 30}),
+			}},
+			want: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("SetStorageClass", "NEARLINE", v1alpha3.LifecycleCondition{AgeInDays: 30}),
+				rule("Delete", "", v1alpha3.LifecycleCondition{AgeInDays: 365}),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CanonicalLifecycle(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CanonicalLifecycle(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// observedLifecycle returns the lifecycle configuration the storage client
+// reads from the supplied bucket resource, as returned by GCS.
+func observedLifecycle(t *testing.T, bucket string) v1alpha3.Lifecycle {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(bucket))
+	}))
+	defer server.Close()
+
+	c, err := storage.NewClient(context.Background(), option.WithEndpoint(server.URL+"/storage/v1/"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("storage.NewClient(...): %s", err)
+	}
+	attrs, err := c.Bucket("test-bucket").Attrs(context.Background())
+	if err != nil {
+		t.Fatalf("Attrs(...): %s", err)
+	}
+	return *v1alpha3.NewLifecycle(attrs.Lifecycle)
+}
+
+func TestIsLifecycleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  v1alpha3.Lifecycle
+		observed string
+		want     bool
+	}{
+		"NoLifecycle": {
+			desired:  v1alpha3.Lifecycle{},
+			observed: `{"kind": "storage#bucket", "name": "test-bucket"}`,
+			want:     true,
+		},
+		"EmptyRules": {
+			desired:  v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{}},
+			observed: `{"kind": "storage#bucket", "name": "test-bucket", "lifecycle": {}}`,
+			want:     true,
+		},
+		"Reordered": {
+			desired: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("SetStorageClass", "NEARLINE", v1alpha3.LifecycleCondition{AgeInDays: 30, MatchesStorageClasses: []string{"STANDARD", "MULTI_REGIONAL"}}),
+				rule("Delete", "", v1alpha3.LifecycleCondition{AgeInDays: 365}),
+			}},
+			observed: `{
+				"kind": "storage#bucket",
+				"name": "test-bucket",
+				"lifecycle": {
+					"rule": [
+						{"action": {"type": "Delete"}, "condition": {"age": 365}},
+						{
+							"action": {"type": "SetStorageClass", "storageClass": "NEARLINE"},
+							"condition": {"age": 30, "matchesStorageClass": ["MULTI_REGIONAL", "STANDARD"]}
+						}
+					]
+				}
+			}`,
+			want: true,
+		},
+		"CreatedBeforeDate": {
+			desired: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("Delete", "", v1alpha3.LifecycleCondition{CreatedBefore: metav1.Time{Time: time.Date(2020, 3, 1, 8, 0, 0, 0, time.UTC)}}),
+			}},
+			observed: `{
+				"kind": "storage#bucket",
+				"name": "test-bucket",
+				"lifecycle": {"rule": [{"action": {"type": "Delete"}, "condition": {"createdBefore": "2020-03-01"}}]}
+			}`,
+			want: true,
+		},
+		"Liveness": {
+			desired: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("Delete", "", v1alpha3.LifecycleCondition{Liveness: storage.Archived, NumNewerVersions: 3}),
+			}},
+			observed: `{
+				"kind": "storage#bucket",
+				"name": "test-bucket",
+				"lifecycle": {"rule": [{"action": {"type": "Delete"}, "condition": {"isLive": false, "numNewerVersions": 3}}]}
+			}`,
+			want: true,
+		},
+		"LivenessChanged": {
+			desired: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("Delete", "", v1alpha3.LifecycleCondition{Liveness: storage.Live, AgeInDays: 7}),
+			}},
+			observed: `{
+				"kind": "storage#bucket",
+				"name": "test-bucket",
+				"lifecycle": {"rule": [{"action": {"type": "Delete"}, "condition": {"age": 7}}]}
+			}`,
+			want: false,
+		},
+		"AgeChanged": {
+			desired: v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+				rule("Delete", "", v1alpha3.LifecycleCondition{AgeInDays: 30}),
+			}},
+			observed: `{
+				"kind": "storage#bucket",
+				"name": "test-bucket",
+				"lifecycle": {"rule": [{"action": {"type": "Delete"}, "condition": {"age": 365}}]}
+			}`,
+			want: false,
+		},
+		"RuleRemoved": {
+			desired: v1alpha3.Lifecycle{},
+			observed: `{
+				"kind": "storage#bucket",
+				"name": "test-bucket",
+				"lifecycle": {"rule": [{"action": {"type": "Delete"}, "condition": {"age": 365}}]}
+			}`,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLifecycleUpToDate(tc.desired, observedLifecycle(t, tc.observed))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsLifecycleUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
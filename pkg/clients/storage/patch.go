@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/api/option"
+)
+
+// A PatchService patches bucket attributes that the Cloud Storage client
+// library cannot update, by calling the JSON API directly.
+type PatchService struct {
+	*jsonClient
+}
+
+// NewPatchService returns a PatchService configured per the supplied options.
+func NewPatchService(ctx context.Context, opts ...option.ClientOption) (*PatchService, error) {
+	c, err := newJSONClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &PatchService{jsonClient: c}, nil
+}
+
+// RemoveLifecycle removes all lifecycle rules of the named bucket and returns
+// its new metageneration. The bucket is only updated if its metageneration
+// matches the supplied one, unless that is zero.
+func (s *PatchService) RemoveLifecycle(ctx context.Context, bucket string, metageneration int64) (int64, error) {
+	p := "b/" + bucket
+	if metageneration != 0 {
+		p += "?ifMetagenerationMatch=" + strconv.FormatInt(metageneration, 10)
+	}
+	out := &struct {
+		Metageneration int64 `json:"metageneration,string"`
+	}{}
+	err := s.do(ctx, http.MethodPatch, p, map[string]interface{}{"lifecycle": nil}, out)
+	return out.Metageneration, err
+}
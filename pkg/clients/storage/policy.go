@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"sort"
+
+	"cloud.google.com/go/iam"
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/provider-gcp/apis/storage/v1alpha1"
+)
+
+// A PolicyClient reads and writes the IAM policies of buckets.
+type PolicyClient interface {
+	Policy(ctx context.Context, bucket string) (*iam.Policy, error)
+	SetPolicy(ctx context.Context, bucket string, p *iam.Policy) error
+}
+
+// NewPolicyClient returns a PolicyClient that calls the Cloud Storage API per
+// the supplied options.
+func NewPolicyClient(ctx context.Context, opts ...option.ClientOption) (PolicyClient, error) {
+	c, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &BucketPolicies{Client: c}, nil
+}
+
+// BucketPolicies is a PolicyClient backed by the Cloud Storage API.
+type BucketPolicies struct {
+	Client *storage.Client
+}
+
+// Policy returns the IAM policy of the named bucket.
+func (c *BucketPolicies) Policy(ctx context.Context, bucket string) (*iam.Policy, error) {
+	return c.Client.Bucket(bucket).IAM().Policy(ctx)
+}
+
+// SetPolicy sets the IAM policy of the named bucket. The write fails if the
+// policy was changed since the supplied policy was read, per its etag.
+func (c *BucketPolicies) SetPolicy(ctx context.Context, bucket string, p *iam.Policy) error {
+	return c.Client.Bucket(bucket).IAM().SetPolicy(ctx, p)
+}
+
+// HasBindings returns true if the supplied policy grants each role of the
+// supplied bindings to each of its members. Other bindings of the policy are
+// not considered.
+func HasBindings(p *iam.Policy, bindings []v1alpha1.BucketPolicyBinding) bool {
+	for _, b := range bindings {
+		for _, m := range b.Members {
+			if !p.HasRole(m, iam.RoleName(b.Role)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// HasAnyBinding returns true if the supplied policy grants any role of the
+// supplied bindings to any of its members.
+func HasAnyBinding(p *iam.Policy, bindings []v1alpha1.BucketPolicyBinding) bool {
+	for _, b := range bindings {
+		for _, m := range b.Members {
+			if p.HasRole(m, iam.RoleName(b.Role)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AddBindings grants each role of the supplied bindings to each of its
+// members in the supplied policy.
+func AddBindings(p *iam.Policy, bindings []v1alpha1.BucketPolicyBinding) {
+	for _, b := range bindings {
+		for _, m := range b.Members {
+			p.Add(m, iam.RoleName(b.Role))
+		}
+	}
+}
+
+// RemoveBindings revokes each role of the supplied bindings from each of its
+// members in the supplied policy. Roles that are left without members are
+// removed from the policy.
+func RemoveBindings(p *iam.Policy, bindings []v1alpha1.BucketPolicyBinding) {
+	for _, b := range bindings {
+		for _, m := range b.Members {
+			p.Remove(m, iam.RoleName(b.Role))
+		}
+	}
+}
+
+// StaleBindings returns the members of the supplied recorded bindings that
+// the supplied desired bindings no longer grant their role to. The order of
+// bindings and members does not matter. The result is sorted by role and
+// member.
+func StaleBindings(recorded, desired []v1alpha1.BucketPolicyBinding) []v1alpha1.BucketPolicyBinding {
+	want := bindingSet(desired)
+	stale := map[string]map[string]bool{}
+	for role, members := range bindingSet(recorded) {
+		for m := range members {
+			if want[role][m] {
+				continue
+			}
+			if stale[role] == nil {
+				stale[role] = map[string]bool{}
+			}
+			stale[role][m] = true
+		}
+	}
+	return bindingList(stale)
+}
+
+// NormalizeBindings returns the supplied bindings with roles merged and
+// members deduplicated, sorted by role and member.
+func NormalizeBindings(bindings []v1alpha1.BucketPolicyBinding) []v1alpha1.BucketPolicyBinding {
+	return bindingList(bindingSet(bindings))
+}
+
+func bindingSet(bindings []v1alpha1.BucketPolicyBinding) map[string]map[string]bool {
+	s := map[string]map[string]bool{}
+	for _, b := range bindings {
+		for _, m := range b.Members {
+			if s[b.Role] == nil {
+				s[b.Role] = map[string]bool{}
+			}
+			s[b.Role][m] = true
+		}
+	}
+	return s
+}
+
+func bindingList(s map[string]map[string]bool) []v1alpha1.BucketPolicyBinding {
+	if len(s) == 0 {
+		return nil
+	}
+	roles := make([]string, 0, len(s))
+	for r := range s {
+		roles = append(roles, r)
+	}
+	sort.Strings(roles)
+	bindings := make([]v1alpha1.BucketPolicyBinding, 0, len(roles))
+	for _, r := range roles {
+		members := make([]string, 0, len(s[r]))
+		for m := range s[r] {
+			members = append(members, m)
+		}
+		sort.Strings(members)
+		bindings = append(bindings, v1alpha1.BucketPolicyBinding{Role: r, Members: members})
+	}
+	return bindings
+}
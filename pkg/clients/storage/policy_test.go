@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-gcp/apis/storage/v1alpha1"
+)
+
+func TestStaleBindings(t *testing.T) {
+	cases := map[string]struct {
+		recorded []v1alpha1.BucketPolicyBinding
+		desired  []v1alpha1.BucketPolicyBinding
+		want     []v1alpha1.BucketPolicyBinding
+	}{
+		"NothingRecorded": {
+			desired: []v1alpha1.BucketPolicyBinding{{Role: "roles/a", Members: []string{"user:x"}}},
+		},
+		"StillDesiredInAnotherOrder": {
+			recorded: []v1alpha1.BucketPolicyBinding{{Role: "roles/a", Members: []string{"user:x", "user:y"}}},
+			desired: []v1alpha1.BucketPolicyBinding{
+				{Role: "roles/a", Members: []string{"user:y"}},
+				{Role: "roles/a", Members: []string{"user:x"}},
+			},
+		},
+		"MemberAndRoleRemoved": {
+			recorded: []v1alpha1.BucketPolicyBinding{
+				{Role: "roles/b", Members: []string{"user:x"}},
+				{Role: "roles/a", Members: []string{"user:y", "user:x"}},
+			},
+			desired: []v1alpha1.BucketPolicyBinding{{Role: "roles/a", Members: []string{"user:x"}}},
+			want: []v1alpha1.BucketPolicyBinding{
+				{Role: "roles/a", Members: []string{"user:y"}},
+				{Role: "roles/b", Members: []string{"user:x"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := StaleBindings(tc.recorded, tc.desired)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("StaleBindings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeBindings(t *testing.T) {
+	in := []v1alpha1.BucketPolicyBinding{
+		{Role: "roles/b", Members: []string{"user:y", "user:x"}},
+		{Role: "roles/a", Members: []string{"user:x"}},
+		{Role: "roles/b", Members: []string{"user:x"}},
+		{Role: "roles/c"},
+	}
+	want := []v1alpha1.BucketPolicyBinding{
+		{Role: "roles/a", Members: []string{"user:x"}},
+		{Role: "roles/b", Members: []string{"user:x", "user:y"}},
+	}
+	if diff := cmp.Diff(want, NormalizeBindings(in)); diff != "" {
+		t.Errorf("NormalizeBindings(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"path"
+
+	"google.golang.org/api/option"
+)
+
+// An Operation is a long running bucket relocation.
+type Operation struct {
+	Name     string              `json:"name,omitempty"`
+	Done     bool                `json:"done,omitempty"`
+	Error    *OperationError     `json:"error,omitempty"`
+	Metadata *RelocationMetadata `json:"metadata,omitempty"`
+}
+
+// An OperationError is the error of a failed Operation.
+type OperationError struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// RelocationMetadata describes the progress of a bucket relocation.
+type RelocationMetadata struct {
+	CommonMetadata      *CommonMetadata `json:"commonMetadata,omitempty"`
+	DestinationLocation string          `json:"destinationLocation,omitempty"`
+}
+
+// CommonMetadata is the metadata common to all storage operations.
+type CommonMetadata struct {
+	CreateTime      string `json:"createTime,omitempty"`
+	ProgressPercent int32  `json:"progressPercent,omitempty"`
+}
+
+// ProgressPercent returns the estimated progress of the Operation, if known.
+func (o *Operation) ProgressPercent() int32 {
+	if o.Metadata == nil || o.Metadata.CommonMetadata == nil {
+		return 0
+	}
+	return o.Metadata.CommonMetadata.ProgressPercent
+}
+
+// A RelocationService relocates buckets to a new location. The Cloud Storage
+// client library used by this provider predates bucket relocation, so it
+// calls the JSON API directly.
+type RelocationService struct {
+	*jsonClient
+}
+
+// NewRelocationService returns a RelocationService configured per the
+// supplied options.
+func NewRelocationService(ctx context.Context, opts ...option.ClientOption) (*RelocationService, error) {
+	c, err := newJSONClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &RelocationService{jsonClient: c}, nil
+}
+
+// Relocate starts relocating the named bucket to the supplied location.
+func (s *RelocationService) Relocate(ctx context.Context, bucket, location string) (*Operation, error) {
+	op := &Operation{}
+	in := map[string]string{"destinationLocation": location}
+	return op, s.do(ctx, http.MethodPost, "b/"+bucket+"/relocate", in, op)
+}
+
+// GetOperation returns the named operation of the named bucket. Operations
+// may be named by their ID or by their full resource name, i.e.
+// projects/_/buckets/{bucket}/operations/{id}.
+func (s *RelocationService) GetOperation(ctx context.Context, bucket, name string) (*Operation, error) {
+	op := &Operation{}
+	return op, s.do(ctx, http.MethodGet, "b/"+bucket+"/operations/"+path.Base(name), nil, op)
+}
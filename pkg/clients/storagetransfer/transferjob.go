@@ -0,0 +1,218 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package storagetransfer contains utilities to convert between GCP Storage
+// Transfer Service resources and TransferJob managed resources.
+package storagetransfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	storagetransfer "google.golang.org/api/storagetransfer/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-gcp/apis/storagetransfer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// UpdateMask is the field mask of the fields of a transfer job that can be
+// updated.
+const UpdateMask = "description,transfer_spec,status"
+
+// TransferJobName returns the resource name of the supplied transfer job.
+func TransferJobName(job string) string {
+	return "transferJobs/" + job
+}
+
+// OperationsFilter returns a filter that lists the transfer operations of
+// the supplied transfer job.
+func OperationsFilter(project, name string) string {
+	f, _ := json.Marshal(struct {
+		ProjectID string   `json:"project_id"`
+		JobNames  []string `json:"job_names"`
+	}{ProjectID: project, JobNames: []string{name}})
+	return string(f)
+}
+
+// GenerateTransferJob takes TransferJobParameters and returns a TransferJob
+// with the supplied resource name. The access key of an Amazon S3 source is
+// not set.
+func GenerateTransferJob(project, name string, in v1alpha1.TransferJobParameters) *storagetransfer.TransferJob {
+	status := v1alpha1.TransferJobStatusEnabled
+	if in.Status != nil {
+		status = *in.Status
+	}
+	return &storagetransfer.TransferJob{
+		Name:         name,
+		ProjectId:    project,
+		Description:  gcp.StringValue(in.Description),
+		Status:       status,
+		TransferSpec: generateTransferSpec(in.TransferSpec),
+		Schedule:     generateSchedule(in.Schedule),
+	}
+}
+
+func generateTransferSpec(in v1alpha1.TransferSpec) *storagetransfer.TransferSpec {
+	ts := &storagetransfer.TransferSpec{
+		GcsDataSink: &storagetransfer.GcsData{BucketName: gcp.StringValue(in.GCSDataSink.BucketName)},
+	}
+	if in.GCSDataSource != nil {
+		ts.GcsDataSource = &storagetransfer.GcsData{BucketName: gcp.StringValue(in.GCSDataSource.BucketName)}
+	}
+	if in.AWSS3DataSource != nil {
+		ts.AwsS3DataSource = &storagetransfer.AwsS3Data{BucketName: in.AWSS3DataSource.BucketName}
+	}
+	if in.HTTPDataSource != nil {
+		ts.HttpDataSource = &storagetransfer.HttpData{ListUrl: in.HTTPDataSource.ListURL}
+	}
+	if c := in.ObjectConditions; c != nil {
+		oc := &storagetransfer.ObjectConditions{
+			IncludePrefixes:                     c.IncludePrefixes,
+			ExcludePrefixes:                     c.ExcludePrefixes,
+			MinTimeElapsedSinceLastModification: gcp.StringValue(c.MinTimeElapsedSinceLastModification),
+			MaxTimeElapsedSinceLastModification: gcp.StringValue(c.MaxTimeElapsedSinceLastModification),
+			LastModifiedSince:                   gcp.StringValue(c.LastModifiedSince),
+			LastModifiedBefore:                  gcp.StringValue(c.LastModifiedBefore),
+		}
+		// Storage Transfer Service omits empty conditions.
+		if !cmp.Equal(oc, &storagetransfer.ObjectConditions{}, cmpopts.EquateEmpty()) {
+			ts.ObjectConditions = oc
+		}
+	}
+	if o := in.TransferOptions; o != nil {
+		to := &storagetransfer.TransferOptions{
+			OverwriteObjectsAlreadyExistingInSink: gcp.BoolValue(o.OverwriteObjectsAlreadyExistingInSink),
+			DeleteObjectsUniqueInSink:             gcp.BoolValue(o.DeleteObjectsUniqueInSink),
+			DeleteObjectsFromSourceAfterTransfer:  gcp.BoolValue(o.DeleteObjectsFromSourceAfterTransfer),
+		}
+		// Storage Transfer Service omits options that are all false.
+		if to.OverwriteObjectsAlreadyExistingInSink || to.DeleteObjectsUniqueInSink || to.DeleteObjectsFromSourceAfterTransfer {
+			ts.TransferOptions = to
+		}
+	}
+	return ts
+}
+
+func generateSchedule(in v1alpha1.Schedule) *storagetransfer.Schedule {
+	s := &storagetransfer.Schedule{ScheduleStartDate: generateDate(&in.ScheduleStartDate), ScheduleEndDate: generateDate(in.ScheduleEndDate)}
+	if t := in.StartTimeOfDay; t != nil {
+		s.StartTimeOfDay = &storagetransfer.TimeOfDay{Hours: t.Hours, Minutes: t.Minutes, Seconds: t.Seconds}
+	}
+	return s
+}
+
+func generateDate(in *v1alpha1.Date) *storagetransfer.Date {
+	if in == nil {
+		return nil
+	}
+	return &storagetransfer.Date{Year: in.Year, Month: in.Month, Day: in.Day}
+}
+
+// GenerateTransferJobObservation produces a TransferJobObservation from the
+// supplied TransferJob and its latest TransferOperation, if any.
+func GenerateTransferJobObservation(in storagetransfer.TransferJob, latest *storagetransfer.TransferOperation) v1alpha1.TransferJobObservation {
+	o := v1alpha1.TransferJobObservation{
+		Name:                 in.Name,
+		Status:               in.Status,
+		CreationTime:         gcp.TimeFromRFC3339(in.CreationTime),
+		LastModificationTime: gcp.TimeFromRFC3339(in.LastModificationTime),
+	}
+	if latest != nil {
+		o.LatestOperation = &v1alpha1.TransferOperationObservation{
+			Name:      latest.Name,
+			Status:    latest.Status,
+			StartTime: gcp.TimeFromRFC3339(latest.StartTime),
+			EndTime:   gcp.TimeFromRFC3339(latest.EndTime),
+		}
+		if c := latest.Counters; c != nil {
+			o.LatestOperation.ObjectsCopiedToSink = c.ObjectsCopiedToSink
+			o.LatestOperation.BytesCopiedToSink = c.BytesCopiedToSink
+			o.LatestOperation.ObjectsFromSourceFailed = c.ObjectsFromSourceFailed
+		}
+	}
+	return o
+}
+
+// LatestOperation returns the most recently started of the supplied transfer
+// operations, or nil if there are none.
+func LatestOperation(ops []*storagetransfer.Operation) (*storagetransfer.TransferOperation, error) {
+	var latest *storagetransfer.TransferOperation
+	for _, op := range ops {
+		to := &storagetransfer.TransferOperation{}
+		if err := json.Unmarshal(op.Metadata, to); err != nil {
+			return nil, fmt.Errorf("cannot decode metadata of transfer operation %s: %v", op.Name, err)
+		}
+		// RFC3339 timestamps in UTC sort lexically.
+		if latest == nil || strings.Compare(to.StartTime, latest.StartTime) > 0 {
+			latest = to
+		}
+	}
+	return latest, nil
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// TransferJob.
+func LateInitializeSpec(spec *v1alpha1.TransferJobParameters, in storagetransfer.TransferJob) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	if in.Status != v1alpha1.TransferJobStatusDeleted {
+		spec.Status = gcp.LateInitializeString(spec.Status, in.Status)
+	}
+}
+
+// IsUpToDate returns true if the supplied TransferJob matches the supplied
+// TransferJobParameters. Access keys are never returned by Storage Transfer
+// Service, so they are not compared.
+func IsUpToDate(in v1alpha1.TransferJobParameters, observed storagetransfer.TransferJob) bool {
+	desired := GenerateTransferJob(observed.ProjectId, observed.Name, in)
+	if desired.Description != observed.Description || desired.Status != observed.Status {
+		return false
+	}
+	return IsScheduleUpToDate(in, observed) && cmp.Equal(desired.TransferSpec, observed.TransferSpec,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(storagetransfer.TransferSpec{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(storagetransfer.GcsData{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(storagetransfer.AwsS3Data{}, "AwsAccessKey", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(storagetransfer.HttpData{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(storagetransfer.ObjectConditions{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(storagetransfer.TransferOptions{}, "ForceSendFields", "NullFields"))
+}
+
+// IsScheduleUpToDate returns true if the schedule of the supplied TransferJob
+// matches the supplied TransferJobParameters.
+func IsScheduleUpToDate(in v1alpha1.TransferJobParameters, observed storagetransfer.TransferJob) bool {
+	return cmp.Equal(generateSchedule(in.Schedule), observed.Schedule,
+		cmpopts.IgnoreFields(storagetransfer.Schedule{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(storagetransfer.Date{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(storagetransfer.TimeOfDay{}, "ForceSendFields", "NullFields"))
+}
+
+// Condition returns the condition that corresponds to the supplied status of
+// a transfer job.
+func Condition(status string) runtimev1alpha1.Condition {
+	switch status {
+	case v1alpha1.TransferJobStatusEnabled, v1alpha1.TransferJobStatusDisabled:
+		return runtimev1alpha1.Available()
+	case v1alpha1.TransferJobStatusDeleted:
+		return runtimev1alpha1.Deleting()
+	default:
+		return runtimev1alpha1.Unavailable()
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagetransfer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	storagetransfer "google.golang.org/api/storagetransfer/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+
+	"github.com/crossplane/provider-gcp/apis/storagetransfer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testProject = "some-project"
+	testName    = "transferJobs/nightly"
+)
+
+func params(m ...func(*v1alpha1.TransferJobParameters)) *v1alpha1.TransferJobParameters {
+	p := &v1alpha1.TransferJobParameters{
+		Description: gcp.StringPtr("nightly"),
+		TransferSpec: v1alpha1.TransferSpec{
+			AWSS3DataSource: &v1alpha1.AWSS3Data{
+				BucketName:               "s3-bucket",
+				AccessKeyIDSecretRef:     runtimev1alpha1.SecretKeySelector{Key: "id"},
+				SecretAccessKeySecretRef: runtimev1alpha1.SecretKeySelector{Key: "secret"},
+			},
+			GCSDataSink:      v1alpha1.GCSData{BucketName: gcp.StringPtr("gcs-bucket")},
+			ObjectConditions: &v1alpha1.ObjectConditions{IncludePrefixes: []string{"logs/"}},
+			TransferOptions:  &v1alpha1.TransferOptions{DeleteObjectsUniqueInSink: gcp.BoolPtr(true)},
+		},
+		Schedule: v1alpha1.Schedule{
+			ScheduleStartDate: v1alpha1.Date{Year: 2020, Month: 6, Day: 1},
+			StartTimeOfDay:    &v1alpha1.TimeOfDay{Hours: 2},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func job(m ...func(*storagetransfer.TransferJob)) *storagetransfer.TransferJob {
+	j := &storagetransfer.TransferJob{
+		Name:        testName,
+		ProjectId:   testProject,
+		Description: "nightly",
+		Status:      v1alpha1.TransferJobStatusEnabled,
+		TransferSpec: &storagetransfer.TransferSpec{
+			AwsS3DataSource:  &storagetransfer.AwsS3Data{BucketName: "s3-bucket"},
+			GcsDataSink:      &storagetransfer.GcsData{BucketName: "gcs-bucket"},
+			ObjectConditions: &storagetransfer.ObjectConditions{IncludePrefixes: []string{"logs/"}},
+			TransferOptions:  &storagetransfer.TransferOptions{DeleteObjectsUniqueInSink: true},
+		},
+		Schedule: &storagetransfer.Schedule{
+			ScheduleStartDate: &storagetransfer.Date{Year: 2020, Month: 6, Day: 1},
+			StartTimeOfDay:    &storagetransfer.TimeOfDay{Hours: 2},
+		},
+	}
+	for _, f := range m {
+		f(j)
+	}
+	return j
+}
+
+func TestGenerateTransferJob(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.TransferJobParameters
+		want *storagetransfer.TransferJob
+	}{
+		"Full": {
+			in:   *params(),
+			want: job(),
+		},
+		"EmptyConditionsAndOptions": {
+			in: *params(func(p *v1alpha1.TransferJobParameters) {
+				p.TransferSpec.ObjectConditions = &v1alpha1.ObjectConditions{}
+				p.TransferSpec.TransferOptions = &v1alpha1.TransferOptions{DeleteObjectsUniqueInSink: gcp.BoolPtr(false)}
+				p.Status = gcp.StringPtr(v1alpha1.TransferJobStatusDisabled)
+			}),
+			want: job(func(j *storagetransfer.TransferJob) {
+				j.TransferSpec.ObjectConditions = nil
+				j.TransferSpec.TransferOptions = nil
+				j.Status = v1alpha1.TransferJobStatusDisabled
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTransferJob(testProject, testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateTransferJob(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLatestOperation(t *testing.T) {
+	ops := []*storagetransfer.Operation{
+		{Name: "transferOperations/a", Metadata: googleapi.RawMessage(`{"name":"transferOperations/a","status":"SUCCESS","startTime":"2020-06-01T02:00:00Z"}`)},
+		{Name: "transferOperations/b", Metadata: googleapi.RawMessage(`{"name":"transferOperations/b","status":"IN_PROGRESS","startTime":"2020-06-02T02:00:00Z"}`)},
+	}
+	want := &storagetransfer.TransferOperation{Name: "transferOperations/b", Status: "IN_PROGRESS", StartTime: "2020-06-02T02:00:00Z"}
+
+	got, err := LatestOperation(ops)
+	if err != nil {
+		t.Fatalf("LatestOperation(...): %s", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LatestOperation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateTransferJobObservation(t *testing.T) {
+	in := job(func(j *storagetransfer.TransferJob) { j.CreationTime = "2020-06-01T00:00:00Z" })
+	latest := &storagetransfer.TransferOperation{
+		Name:      "transferOperations/b",
+		Status:    "SUCCESS",
+		StartTime: "2020-06-02T02:00:00Z",
+		Counters:  &storagetransfer.TransferCounters{ObjectsCopiedToSink: 3, BytesCopiedToSink: 42},
+	}
+	created := metav1.NewTime(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC))
+	started := metav1.NewTime(time.Date(2020, 6, 2, 2, 0, 0, 0, time.UTC))
+	want := v1alpha1.TransferJobObservation{
+		Name:         testName,
+		Status:       v1alpha1.TransferJobStatusEnabled,
+		CreationTime: &created,
+		LatestOperation: &v1alpha1.TransferOperationObservation{
+			Name:                "transferOperations/b",
+			Status:              "SUCCESS",
+			StartTime:           &started,
+			ObjectsCopiedToSink: 3,
+			BytesCopiedToSink:   42,
+		},
+	}
+	if diff := cmp.Diff(want, GenerateTransferJobObservation(*in, latest)); diff != "" {
+		t.Errorf("GenerateTransferJobObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       v1alpha1.TransferJobParameters
+		observed storagetransfer.TransferJob
+		want     bool
+	}{
+		"UpToDate": {
+			in:       *params(),
+			observed: *job(),
+			want:     true,
+		},
+		"StatusChanged": {
+			in:       *params(func(p *v1alpha1.TransferJobParameters) { p.Status = gcp.StringPtr(v1alpha1.TransferJobStatusDisabled) }),
+			observed: *job(),
+			want:     false,
+		},
+		"TransferSpecChanged": {
+			in: *params(func(p *v1alpha1.TransferJobParameters) {
+				p.TransferSpec.ObjectConditions.IncludePrefixes = []string{"logs/", "audit/"}
+			}),
+			observed: *job(),
+			want:     false,
+		},
+		"ScheduleChanged": {
+			in:       *params(func(p *v1alpha1.TransferJobParameters) { p.Schedule.StartTimeOfDay.Hours = 3 }),
+			observed: *job(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package subnetwork
 
 import (
+	"net"
+
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/mitchellh/copystructure"
@@ -27,7 +29,13 @@ import (
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 )
 
-const errCheckUpToDate = "unable to determine if external resource is up to date"
+// Error strings.
+const (
+	errCheckUpToDate           = "unable to determine if external resource is up to date"
+	errParseIPCidrRange        = "cannot parse IP CIDR range %q"
+	errIPCidrRangeNotExpansion = "ipCidrRange %s cannot be changed to %s: the range may only be expanded to a range that contains it, not shrunk or moved"
+	errSecondaryRangeChanged   = "secondary IP range %q cannot be changed or removed: secondary ranges may only be added"
+)
 
 // GenerateSubnetwork populates the supplied compute.Subnetwork with the
 // supplied SubnetworkParameters.
@@ -114,7 +122,15 @@ func LateInitializeSpec(spec *v1beta1.SubnetworkParameters, in compute.Subnetwor
 
 // IsUpToDate checks whether current state is up-to-date compared to the given
 // set of parameters.
+// It returns an error if the parameters shrink or move the IP CIDR range, or
+// change or remove a secondary IP range, because neither can be updated.
 func IsUpToDate(name string, in *v1beta1.SubnetworkParameters, observed *compute.Subnetwork) (upToDate bool, privateAccess bool, err error) {
+	if _, err := IsExpansion(in.IPCidrRange, observed.IpCidrRange); err != nil {
+		return true, false, err
+	}
+	if err := checkSecondaryRanges(in.SecondaryIPRanges, observed.SecondaryIpRanges); err != nil {
+		return true, false, err
+	}
 	generated, err := copystructure.Copy(observed)
 	if err != nil {
 		return true, false, errors.Wrap(err, errCheckUpToDate)
@@ -136,3 +152,50 @@ func IsUpToDate(name string, in *v1beta1.SubnetworkParameters, observed *compute
 func equateSecondaryRanges() cmp.Option {
 	return cmpopts.SortSlices(func(i, j *compute.SubnetworkSecondaryRange) bool { return i.RangeName > j.RangeName })
 }
+
+// IsExpansion returns true if the desired IP CIDR range expands the observed
+// one, i.e. it has a shorter prefix and contains the observed range. It
+// returns false if the ranges are the same, and an error if the desired range
+// would shrink or move the observed one.
+func IsExpansion(desired, observed string) (bool, error) {
+	if desired == observed {
+		return false, nil
+	}
+	_, d, err := net.ParseCIDR(desired)
+	if err != nil {
+		return false, errors.Wrapf(err, errParseIPCidrRange, desired)
+	}
+	_, o, err := net.ParseCIDR(observed)
+	if err != nil {
+		return false, errors.Wrapf(err, errParseIPCidrRange, observed)
+	}
+	dOnes, dBits := d.Mask.Size()
+	oOnes, oBits := o.Mask.Size()
+	if dBits != oBits || !d.Contains(o.IP) {
+		return false, errors.Errorf(errIPCidrRangeNotExpansion, observed, desired)
+	}
+	switch {
+	case dOnes < oOnes:
+		return true, nil
+	case dOnes == oOnes:
+		// The ranges are the same network, written differently.
+		return false, nil
+	default:
+		return false, errors.Errorf(errIPCidrRangeNotExpansion, observed, desired)
+	}
+}
+
+// checkSecondaryRanges returns an error unless every observed secondary range
+// is also desired, with the same IP CIDR range.
+func checkSecondaryRanges(desired []*v1beta1.SubnetworkSecondaryRange, observed []*compute.SubnetworkSecondaryRange) error {
+	ranges := make(map[string]string, len(desired))
+	for _, r := range desired {
+		ranges[r.RangeName] = r.IPCidrRange
+	}
+	for _, r := range observed {
+		if cidr, ok := ranges[r.RangeName]; !ok || cidr != r.IpCidrRange {
+			return errors.Errorf(errSecondaryRangeChanged, r.RangeName)
+		}
+	}
+	return nil
+}
@@ -17,12 +17,16 @@ limitations under the License.
 package subnetwork
 
 import (
+	"net"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	"google.golang.org/api/compute/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 )
@@ -292,6 +296,59 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{upToDate: false, privAcc: true},
 		},
+		"NotUpToDateExpandedRange": {
+			args: args{
+				name: testName,
+				in: params(func(p *v1beta1.SubnetworkParameters) {
+					p.IPCidrRange = "10.0.0.0/8"
+				}),
+				current: subnetwork(),
+			},
+			want: want{upToDate: false, privAcc: false},
+		},
+		"NotUpToDateAddedSecondaryRange": {
+			args: args{
+				name: testName,
+				in: params(func(p *v1beta1.SubnetworkParameters) {
+					p.SecondaryIPRanges = append(p.SecondaryIPRanges, &v1beta1.SubnetworkSecondaryRange{
+						RangeName:   "pods",
+						IPCidrRange: "10.4.0.0/14",
+					})
+				}),
+				current: subnetwork(),
+			},
+			want: want{upToDate: false, privAcc: false},
+		},
+		"ShrunkRange": {
+			args: args{
+				name: testName,
+				in: params(func(p *v1beta1.SubnetworkParameters) {
+					p.IPCidrRange = "10.0.0.0/10"
+				}),
+				current: subnetwork(),
+			},
+			want: want{upToDate: true, isErr: true},
+		},
+		"RemovedSecondaryRange": {
+			args: args{
+				name: testName,
+				in: params(func(p *v1beta1.SubnetworkParameters) {
+					p.SecondaryIPRanges = p.SecondaryIPRanges[:1]
+				}),
+				current: subnetwork(),
+			},
+			want: want{upToDate: true, isErr: true},
+		},
+		"ChangedSecondaryRange": {
+			args: args{
+				name: testName,
+				in: params(func(p *v1beta1.SubnetworkParameters) {
+					p.SecondaryIPRanges[0].IPCidrRange = "10.128.0.0/9"
+				}),
+				current: subnetwork(),
+			},
+			want: want{upToDate: true, isErr: true},
+		},
 	}
 
 	for name, tc := range cases {
@@ -300,6 +357,9 @@ func TestIsUpToDate(t *testing.T) {
 			if err != nil && !tc.want.isErr {
 				t.Error("IsUpToDate(...) unexpected error")
 			}
+			if err == nil && tc.want.isErr {
+				t.Error("IsUpToDate(...) expected error")
+			}
 			if diff := cmp.Diff(tc.want.upToDate, u); diff != "" {
 				t.Errorf("IsUpToDate(...) Up To Date: -want, +got:\n%s", diff)
 			}
@@ -309,3 +369,59 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestIsExpansion(t *testing.T) {
+	type args struct {
+		desired  string
+		observed string
+	}
+	type want struct {
+		expand bool
+		err    error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Same": {
+			args: args{desired: "10.0.0.0/16", observed: "10.0.0.0/16"},
+			want: want{expand: false},
+		},
+		"SameNetwork": {
+			args: args{desired: "10.0.0.1/16", observed: "10.0.0.0/16"},
+			want: want{expand: false},
+		},
+		"Expanded": {
+			args: args{desired: "10.0.0.0/15", observed: "10.0.0.0/16"},
+			want: want{expand: true},
+		},
+		"Shrunk": {
+			args: args{desired: "10.0.0.0/17", observed: "10.0.0.0/16"},
+			want: want{err: errors.Errorf(errIPCidrRangeNotExpansion, "10.0.0.0/16", "10.0.0.0/17")},
+		},
+		"Moved": {
+			args: args{desired: "10.1.0.0/16", observed: "10.0.0.0/16"},
+			want: want{err: errors.Errorf(errIPCidrRangeNotExpansion, "10.0.0.0/16", "10.1.0.0/16")},
+		},
+		"ExpandedElsewhere": {
+			args: args{desired: "10.2.0.0/15", observed: "10.0.0.0/16"},
+			want: want{err: errors.Errorf(errIPCidrRangeNotExpansion, "10.0.0.0/16", "10.2.0.0/15")},
+		},
+		"Invalid": {
+			args: args{desired: "10.0.0.0", observed: "10.0.0.0/16"},
+			want: want{err: errors.Wrapf(&net.ParseError{Type: "CIDR address", Text: "10.0.0.0"}, errParseIPCidrRange, "10.0.0.0")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			expand, err := IsExpansion(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("IsExpansion(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.expand, expand); diff != "" {
+				t.Errorf("IsExpansion(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetinstance
+
+import (
+	"google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateTargetInstance creates a *compute.TargetInstance from the supplied
+// TargetInstanceParameters.
+func GenerateTargetInstance(name string, in v1alpha1.TargetInstanceParameters) *compute.TargetInstance {
+	return &compute.TargetInstance{
+		Name:        name,
+		Description: gcp.StringValue(in.Description),
+		Instance:    in.Instance,
+		NatPolicy:   gcp.StringValue(in.NatPolicy),
+		Zone:        in.Zone,
+	}
+}
+
+// GenerateTargetInstanceObservation creates a TargetInstanceObservation from
+// the supplied compute.TargetInstance.
+func GenerateTargetInstanceObservation(in compute.TargetInstance) v1alpha1.TargetInstanceObservation {
+	return v1alpha1.TargetInstanceObservation{
+		CreationTimestamp: gcp.TimeFromRFC3339(in.CreationTimestamp),
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// compute.TargetInstance.
+func LateInitializeSpec(spec *v1alpha1.TargetInstanceParameters, in compute.TargetInstance) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.NatPolicy = gcp.LateInitializeString(spec.NatPolicy, in.NatPolicy)
+}
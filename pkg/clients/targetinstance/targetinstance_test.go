@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetinstance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testName     = "some-target"
+	testZone     = "us-central1-a"
+	testInstance = "zones/us-central1-a/instances/appliance"
+)
+
+func params(m ...func(*v1alpha1.TargetInstanceParameters)) *v1alpha1.TargetInstanceParameters {
+	p := &v1alpha1.TargetInstanceParameters{
+		Zone:        testZone,
+		Description: gcp.StringPtr("desc"),
+		Instance:    testInstance,
+		NatPolicy:   gcp.StringPtr("NO_NAT"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGenerateTargetInstance(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.TargetInstanceParameters
+		want *compute.TargetInstance
+	}{
+		"Full": {
+			in: *params(),
+			want: &compute.TargetInstance{
+				Name:        testName,
+				Zone:        testZone,
+				Description: "desc",
+				Instance:    testInstance,
+				NatPolicy:   "NO_NAT",
+			},
+		},
+		"Minimal": {
+			in: v1alpha1.TargetInstanceParameters{Zone: testZone, Instance: testInstance},
+			want: &compute.TargetInstance{
+				Name:     testName,
+				Zone:     testZone,
+				Instance: testInstance,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTargetInstance(testName, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateTargetInstance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTargetInstanceObservation(t *testing.T) {
+	in := compute.TargetInstance{
+		CreationTimestamp: "2020-06-01T12:00:00Z",
+		Id:                42,
+		SelfLink:          "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/targetInstances/some-target",
+	}
+	ts := metav1.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	want := v1alpha1.TargetInstanceObservation{
+		CreationTimestamp: &ts,
+		ID:                42,
+		SelfLink:          in.SelfLink,
+	}
+	if diff := cmp.Diff(want, GenerateTargetInstanceObservation(in)); diff != "" {
+		t.Errorf("GenerateTargetInstanceObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.TargetInstanceParameters
+		observed compute.TargetInstance
+		want     *v1alpha1.TargetInstanceParameters
+	}{
+		"FillsDefaults": {
+			spec: params(func(p *v1alpha1.TargetInstanceParameters) {
+				p.Description = nil
+				p.NatPolicy = nil
+			}),
+			observed: *GenerateTargetInstance(testName, *params()),
+			want:     params(),
+		},
+		"KeepsSpec": {
+			spec: params(),
+			observed: compute.TargetInstance{
+				Description: "other",
+				NatPolicy:   "OTHER",
+			},
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
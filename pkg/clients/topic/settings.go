@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topic
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/option"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// The Pub/Sub client library used by this provider predates message retention
+// and schemas on topics, so these settings are read and written through the
+// v1 REST API on top of the same authenticated transport.
+
+// Settings client defaults.
+const (
+	DefaultEndpoint = "https://pubsub.googleapis.com/"
+	PubSubScope     = "https://www.googleapis.com/auth/pubsub"
+)
+
+// Update mask paths of Settings.
+const (
+	PathMessageRetentionDuration = "messageRetentionDuration"
+	PathSchemaSettings           = "schemaSettings"
+)
+
+// Settings are the fields of a topic that the Pub/Sub client library does not
+// know about.
+type Settings struct {
+	MessageRetentionDuration string          `json:"messageRetentionDuration,omitempty"`
+	SchemaSettings           *SchemaSettings `json:"schemaSettings,omitempty"`
+}
+
+// SchemaSettings are the schema that messages published to a topic are
+// validated against.
+type SchemaSettings struct {
+	Schema   string `json:"schema,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// A SettingsClient reads and writes the Settings of a topic.
+type SettingsClient interface {
+	GetSettings(ctx context.Context, name string) (*Settings, error)
+	UpdateSettings(ctx context.Context, name string, s *Settings, mask ...string) error
+}
+
+// A SettingsService reads and writes the Settings of a topic through the
+// Pub/Sub REST API.
+type SettingsService struct {
+	json *gcp.JSONClient
+}
+
+// NewSettingsService returns a SettingsService configured per the supplied
+// options.
+func NewSettingsService(ctx context.Context, opts ...option.ClientOption) (*SettingsService, error) {
+	c, err := gcp.NewJSONClient(ctx, DefaultEndpoint, append([]option.ClientOption{option.WithScopes(PubSubScope)}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	return &SettingsService{json: c}, nil
+}
+
+// GetSettings returns the Settings of the named topic. Errors are returned as
+// *googleapi.Error, like those of the generated Google API clients.
+func (s *SettingsService) GetSettings(ctx context.Context, name string) (*Settings, error) {
+	out := &Settings{}
+	return out, s.do(ctx, http.MethodGet, name, nil, out)
+}
+
+// UpdateSettings updates the supplied fields of the Settings of the named
+// topic.
+func (s *SettingsService) UpdateSettings(ctx context.Context, name string, in *Settings, mask ...string) error {
+	req := struct {
+		Topic      *Settings `json:"topic"`
+		UpdateMask string    `json:"updateMask"`
+	}{Topic: in, UpdateMask: strings.Join(mask, ",")}
+	return s.do(ctx, http.MethodPatch, name, req, &Settings{})
+}
+
+func (s *SettingsService) do(ctx context.Context, method, path string, in, out interface{}) error {
+	return s.json.Do(ctx, method, "v1/"+path, nil, in, out)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+)
+
+func TestSettingsService(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/foo/topics/bar" {
+			t.Errorf("unexpected request path %q", r.URL.Path)
+		}
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Errorf("cannot decode request body: %s", err)
+			}
+		}
+		_ = r.Body.Close()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name": "projects/foo/topics/bar", "messageRetentionDuration": "600s"}`))
+	}))
+	defer server.Close()
+
+	s, err := NewSettingsService(context.Background(), option.WithEndpoint(server.URL+"/"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("NewSettingsService(...): %s", err)
+	}
+
+	st, err := s.GetSettings(context.Background(), "projects/foo/topics/bar")
+	if err != nil {
+		t.Fatalf("GetSettings(...): %s", err)
+	}
+	if diff := cmp.Diff(&Settings{MessageRetentionDuration: "600s"}, st); diff != "" {
+		t.Errorf("GetSettings(...): -want, +got:\n%s", diff)
+	}
+
+	err = s.UpdateSettings(context.Background(), "projects/foo/topics/bar", &Settings{MessageRetentionDuration: "86400s"}, PathMessageRetentionDuration, PathSchemaSettings)
+	if err != nil {
+		t.Fatalf("UpdateSettings(...): %s", err)
+	}
+	want := map[string]interface{}{
+		"topic":      map[string]interface{}{"messageRetentionDuration": "86400s"},
+		"updateMask": "messageRetentionDuration,schemaSettings",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("UpdateSettings(...): -want request, +got request:\n%s", diff)
+	}
+}
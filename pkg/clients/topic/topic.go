@@ -19,8 +19,10 @@ package topic
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/googleapis/gax-go/v2"
 	"google.golang.org/genproto/googleapis/pubsub/v1"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -38,10 +40,16 @@ type PublisherClient interface {
 	DeleteTopic(ctx context.Context, req *pubsub.DeleteTopicRequest, opts ...gax.CallOption) error
 }
 
+// FullyQualifiedName returns the resource name of the topic with the supplied
+// name in the supplied project.
+func FullyQualifiedName(projectID, name string) string {
+	return fmt.Sprintf("projects/%s/topics/%s", projectID, name)
+}
+
 // GenerateTopic produces a Topic that is configured via given TopicParameters.
 func GenerateTopic(projectID, name string, s v1alpha1.TopicParameters) *pubsub.Topic {
 	t := &pubsub.Topic{
-		Name:       fmt.Sprintf("projects/%s/topics/%s", projectID, name),
+		Name:       FullyQualifiedName(projectID, name),
 		Labels:     s.Labels,
 		KmsKeyName: gcp.StringValue(s.KmsKeyName),
 	}
@@ -69,10 +77,74 @@ func LateInitialize(s *v1alpha1.TopicParameters, t pubsub.Topic) {
 }
 
 // IsUpToDate checks whether Topic is configured with given TopicParameters.
+// Fields that are part of the Settings of a topic are not compared; see
+// IsSettingsUpToDate.
 func IsUpToDate(s v1alpha1.TopicParameters, t pubsub.Topic) bool {
 	observed := &v1alpha1.TopicParameters{}
 	LateInitialize(observed, t)
-	return cmp.Equal(observed, &s)
+	return cmp.Equal(observed, &s, cmpopts.IgnoreFields(v1alpha1.TopicParameters{}, "MessageRetentionDuration", "SchemaSettings"))
+}
+
+// LateInitializeSettings fills the empty fields of TopicParameters if the
+// corresponding fields are given in Settings.
+func LateInitializeSettings(s *v1alpha1.TopicParameters, st Settings) {
+	if s.MessageRetentionDuration == nil && st.MessageRetentionDuration != "" {
+		s.MessageRetentionDuration = gcp.StringPtr(st.MessageRetentionDuration)
+	}
+	if st.SchemaSettings == nil {
+		return
+	}
+	if s.SchemaSettings == nil {
+		s.SchemaSettings = &v1alpha1.SchemaSettings{Schema: st.SchemaSettings.Schema}
+	}
+	if s.SchemaSettings.Encoding == nil && st.SchemaSettings.Encoding != "" {
+		s.SchemaSettings.Encoding = gcp.StringPtr(st.SchemaSettings.Encoding)
+	}
+}
+
+// IsSettingsUpToDate checks whether the Settings of a topic are configured
+// with given TopicParameters.
+func IsSettingsUpToDate(s v1alpha1.TopicParameters, st Settings) bool {
+	_, mask := GenerateSettingsUpdate(s, st)
+	return len(mask) == 0
+}
+
+// GenerateSettingsUpdate produces the Settings and update mask paths that
+// configure a topic with given TopicParameters.
+func GenerateSettingsUpdate(s v1alpha1.TopicParameters, st Settings) (*Settings, []string) {
+	observed := &v1alpha1.TopicParameters{}
+	LateInitializeSettings(observed, st)
+	update := &Settings{}
+	var mask []string
+	if !equalDurations(s.MessageRetentionDuration, observed.MessageRetentionDuration) {
+		update.MessageRetentionDuration = gcp.StringValue(s.MessageRetentionDuration)
+		mask = append(mask, PathMessageRetentionDuration)
+	}
+	if !cmp.Equal(s.SchemaSettings, observed.SchemaSettings) {
+		if s.SchemaSettings != nil {
+			update.SchemaSettings = &SchemaSettings{
+				Schema:   s.SchemaSettings.Schema,
+				Encoding: gcp.StringValue(s.SchemaSettings.Encoding),
+			}
+		}
+		mask = append(mask, PathSchemaSettings)
+	}
+	return update, mask
+}
+
+// equalDurations returns true if the supplied durations are equal. The API
+// may return a duration in a different form than it was given, for example
+// "600.000s" rather than "600s".
+func equalDurations(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	da, errA := time.ParseDuration(*a)
+	db, errB := time.ParseDuration(*b)
+	if errA != nil || errB != nil {
+		return *a == *b
+	}
+	return da == db
 }
 
 // GenerateUpdateRequest produces an UpdateTopicRequest with the difference
@@ -80,7 +152,7 @@ func IsUpToDate(s v1alpha1.TopicParameters, t pubsub.Topic) bool {
 func GenerateUpdateRequest(projectID, name string, s v1alpha1.TopicParameters, t pubsub.Topic) *pubsub.UpdateTopicRequest {
 	observed := &v1alpha1.TopicParameters{}
 	LateInitialize(observed, t)
-	ut := &pubsub.UpdateTopicRequest{Topic: &pubsub.Topic{Name: fmt.Sprintf("projects/%s/topics/%s", projectID, name)}, UpdateMask: &field_mask.FieldMask{}}
+	ut := &pubsub.UpdateTopicRequest{Topic: &pubsub.Topic{Name: FullyQualifiedName(projectID, name)}, UpdateMask: &field_mask.FieldMask{}}
 	if !cmp.Equal(s.MessageStoragePolicy, observed.MessageStoragePolicy) {
 		ut.UpdateMask.Paths = append(ut.UpdateMask.Paths, "messageStoragePolicy")
 		if s.MessageStoragePolicy != nil {
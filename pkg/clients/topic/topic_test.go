@@ -143,6 +143,17 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			result: true,
 		},
+		"IgnoresSettings": {
+			args: args{
+				obs: *topic(),
+				param: func() v1alpha1.TopicParameters {
+					p := params()
+					p.MessageRetentionDuration = gcp.StringPtr("86400s")
+					return *p
+				}(),
+			},
+			result: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -194,3 +205,102 @@ func TestGenerateUpdateRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestLateInitializeSettings(t *testing.T) {
+	cases := map[string]struct {
+		st     Settings
+		param  v1alpha1.TopicParameters
+		result v1alpha1.TopicParameters
+	}{
+		"Empty": {
+			st:     Settings{},
+			param:  v1alpha1.TopicParameters{},
+			result: v1alpha1.TopicParameters{},
+		},
+		"FillsEmptyFields": {
+			st: Settings{
+				MessageRetentionDuration: "600s",
+				SchemaSettings:           &SchemaSettings{Schema: "projects/foo/schemas/bar", Encoding: v1alpha1.EncodingJSON},
+			},
+			param: v1alpha1.TopicParameters{},
+			result: v1alpha1.TopicParameters{
+				MessageRetentionDuration: gcp.StringPtr("600s"),
+				SchemaSettings:           &v1alpha1.SchemaSettings{Schema: "projects/foo/schemas/bar", Encoding: gcp.StringPtr(v1alpha1.EncodingJSON)},
+			},
+		},
+		"KeepsDeclaredFields": {
+			st: Settings{
+				MessageRetentionDuration: "600s",
+				SchemaSettings:           &SchemaSettings{Schema: "projects/foo/schemas/bar", Encoding: v1alpha1.EncodingJSON},
+			},
+			param: v1alpha1.TopicParameters{
+				MessageRetentionDuration: gcp.StringPtr("86400s"),
+				SchemaSettings:           &v1alpha1.SchemaSettings{Schema: "projects/foo/schemas/baz"},
+			},
+			result: v1alpha1.TopicParameters{
+				MessageRetentionDuration: gcp.StringPtr("86400s"),
+				SchemaSettings:           &v1alpha1.SchemaSettings{Schema: "projects/foo/schemas/baz", Encoding: gcp.StringPtr(v1alpha1.EncodingJSON)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSettings(&tc.param, tc.st)
+			if diff := cmp.Diff(tc.result, tc.param); diff != "" {
+				t.Errorf("LateInitializeSettings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSettingsUpdate(t *testing.T) {
+	type want struct {
+		update *Settings
+		mask   []string
+	}
+	cases := map[string]struct {
+		st    Settings
+		param v1alpha1.TopicParameters
+		want  want
+	}{
+		"UpToDate": {
+			st:    Settings{MessageRetentionDuration: "600.000s"},
+			param: v1alpha1.TopicParameters{MessageRetentionDuration: gcp.StringPtr("600s")},
+			want:  want{update: &Settings{}},
+		},
+		"RetentionChanged": {
+			st:    Settings{MessageRetentionDuration: "600s"},
+			param: v1alpha1.TopicParameters{MessageRetentionDuration: gcp.StringPtr("86400s")},
+			want: want{
+				update: &Settings{MessageRetentionDuration: "86400s"},
+				mask:   []string{PathMessageRetentionDuration},
+			},
+		},
+		"SchemaAdded": {
+			st: Settings{},
+			param: v1alpha1.TopicParameters{
+				SchemaSettings: &v1alpha1.SchemaSettings{Schema: "projects/foo/schemas/bar", Encoding: gcp.StringPtr(v1alpha1.EncodingBinary)},
+			},
+			want: want{
+				update: &Settings{SchemaSettings: &SchemaSettings{Schema: "projects/foo/schemas/bar", Encoding: v1alpha1.EncodingBinary}},
+				mask:   []string{PathSchemaSettings},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			update, mask := GenerateSettingsUpdate(tc.param, tc.st)
+			if diff := cmp.Diff(tc.want.update, update); diff != "" {
+				t.Errorf("GenerateSettingsUpdate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mask, mask); diff != "" {
+				t.Errorf("GenerateSettingsUpdate(...): -want mask, +got mask:\n%s", diff)
+			}
+			if diff := cmp.Diff(len(tc.want.mask) == 0, IsSettingsUpToDate(tc.param, tc.st)); diff != "" {
+				t.Errorf("IsSettingsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+
+	"go.opencensus.io/plugin/ochttp"
+	"go.opencensus.io/trace"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Span attributes.
+const (
+	AttributeResource     = "gcp.resource"
+	AttributeManaged      = "crossplane.managed"
+	AttributeExternalName = "crossplane.externalName"
+)
+
+// tracing is true if calls to GCP APIs are traced.
+var tracing bool
+
+// EnableTracing enables OpenCensus tracing of the calls made by clients built
+// using ClientOptions, and of the ExternalClient operations wrapped by
+// NewTracingConnecter. Tracing is disabled by default, in which case neither
+// adds any overhead.
+func EnableTracing() {
+	tracing = true
+}
+
+// newTracingTransport returns a transport that records a span for each
+// request sent via the supplied transport. The span is a child of the span in
+// the request's context, if any, and is named after the request's method and
+// the path of the resource it addresses.
+func newTracingTransport(base http.RoundTripper) http.RoundTripper {
+	return &ochttp.Transport{
+		Base:           &annotatingTransport{base: base},
+		FormatSpanName: func(r *http.Request) string { return r.Method + " " + r.URL.Path },
+	}
+}
+
+// An annotatingTransport adds the GCP resource a request addresses to the
+// span in the request's context.
+type annotatingTransport struct {
+	base http.RoundTripper
+}
+
+func (t *annotatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace.FromContext(req.Context()).AddAttributes(trace.StringAttribute(AttributeResource, req.URL.Host+req.URL.Path))
+	return t.base.RoundTrip(req)
+}
+
+// NewTracingConnecter returns an ExternalConnecter whose ExternalClients
+// record a span for each operation they perform, such that the spans of the
+// GCP API calls made by the operation are its children. The supplied
+// ExternalConnecter is returned unchanged unless tracing is enabled.
+func NewTracingConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	if !tracing {
+		return c
+	}
+	return &tracingConnecter{ExternalConnecter: c}
+}
+
+type tracingConnecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *tracingConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingExternal{ExternalClient: e}, nil
+}
+
+type tracingExternal struct {
+	managed.ExternalClient
+}
+
+// startSpan starts a span for the supplied operation on the supplied managed
+// resource.
+func startSpan(ctx context.Context, op string, mg resource.Managed) (context.Context, *trace.Span) {
+	// The TypeMeta of cached objects is not populated, so the kind of the
+	// managed resource is derived from its type.
+	ctx, span := trace.StartSpan(ctx, op+" "+reflect.TypeOf(mg).Elem().Name())
+	span.AddAttributes(
+		trace.StringAttribute(AttributeManaged, mg.GetName()),
+		trace.StringAttribute(AttributeExternalName, meta.GetExternalName(mg)),
+	)
+	return ctx, span
+}
+
+// endSpan ends the supplied span, recording the supplied error if any.
+func endSpan(span *trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(trace.Status{Code: trace.StatusCodeUnknown, Message: err.Error()})
+	}
+	span.End()
+}
+
+func (e *tracingExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ctx, span := startSpan(ctx, "Observe", mg)
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	endSpan(span, err)
+	return o, err
+}
+
+func (e *tracingExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	ctx, span := startSpan(ctx, "Create", mg)
+	c, err := e.ExternalClient.Create(ctx, mg)
+	endSpan(span, err)
+	return c, err
+}
+
+func (e *tracingExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	ctx, span := startSpan(ctx, "Update", mg)
+	u, err := e.ExternalClient.Update(ctx, mg)
+	endSpan(span, err)
+	return u, err
+}
+
+func (e *tracingExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	ctx, span := startSpan(ctx, "Delete", mg)
+	err := e.ExternalClient.Delete(ctx, mg)
+	endSpan(span, err)
+	return err
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opencensus.io/trace"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+// A spanRecorder is a trace.Exporter that records the spans it exports.
+type spanRecorder struct {
+	mx    sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(s *trace.SpanData) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.spans = append(r.spans, s)
+}
+
+type nopConnecter struct{}
+
+func (c *nopConnecter) Connect(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+	return nil, nil
+}
+
+func TestNewTracingConnecterDisabled(t *testing.T) {
+	c := &nopConnecter{}
+	if got := NewTracingConnecter(c); got != managed.ExternalConnecter(c) {
+		t.Errorf("NewTracingConnecter(...): want the supplied connecter when tracing is disabled, got %T", got)
+	}
+}
+
+func TestTracing(t *testing.T) {
+	tracing = true
+	defer func() { tracing = false }()
+
+	r := &spanRecorder{}
+	trace.RegisterExporter(r)
+	defer trace.UnregisterExporter(r)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	hc, err := NewHTTPClient(context.Background(), &v1alpha3.HTTPClientConfig{}, option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("NewHTTPClient(...): %s", err)
+	}
+
+	c := NewTracingConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/projects/p/things/cool", nil)
+				rsp, err := hc.Do(req.WithContext(ctx))
+				if err == nil {
+					_ = rsp.Body.Close()
+				}
+				return managed.ExternalObservation{}, err
+			},
+		}, nil
+	}))
+
+	mg := &fake.Managed{}
+	mg.SetName("cool-managed")
+	meta.SetExternalName(mg, "cool")
+
+	ctx, root := trace.StartSpan(context.Background(), "reconcile", trace.WithSampler(trace.AlwaysSample()))
+	e, err := c.Connect(ctx, mg)
+	if err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+	if _, err := e.Observe(ctx, mg); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	root.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	byName := map[string]*trace.SpanData{}
+	for _, s := range r.spans {
+		byName[s.Name] = s
+	}
+
+	observe, ok := byName["Observe Managed"]
+	if !ok {
+		t.Fatalf("want an Observe span, got %d spans", len(r.spans))
+	}
+	if diff := cmp.Diff(root.SpanContext().SpanID, observe.ParentSpanID); diff != "" {
+		t.Errorf("Observe span: -want parent, +got parent:\n%s", diff)
+	}
+	wantAttrs := map[string]interface{}{AttributeManaged: "cool-managed", AttributeExternalName: "cool"}
+	if diff := cmp.Diff(wantAttrs, observe.Attributes); diff != "" {
+		t.Errorf("Observe span: -want attributes, +got attributes:\n%s", diff)
+	}
+
+	call, ok := byName["GET /v1/projects/p/things/cool"]
+	if !ok {
+		t.Fatalf("want a span for the API call, got %d spans", len(r.spans))
+	}
+	if diff := cmp.Diff(observe.SpanID, call.ParentSpanID); diff != "" {
+		t.Errorf("API call span: -want parent, +got parent:\n%s", diff)
+	}
+	if diff := cmp.Diff(server.Listener.Addr().String()+"/v1/projects/p/things/cool", call.Attributes[AttributeResource]); diff != "" {
+		t.Errorf("API call span: -want resource, +got resource:\n%s", diff)
+	}
+}
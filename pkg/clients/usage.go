@@ -0,0 +1,79 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+// Error strings.
+const (
+	errGetUsageKind = "cannot determine kind of managed resource"
+	errApplyUsage   = "cannot apply ProviderConfigUsage"
+)
+
+// A ProviderConfigUsageTracker records which managed resources use which
+// ProviderConfig, so that a ProviderConfig is not deleted while it is used.
+type ProviderConfigUsageTracker struct {
+	client resource.Applicator
+	typer  runtime.ObjectTyper
+}
+
+// NewProviderConfigUsageTracker returns a ProviderConfigUsageTracker that
+// records usages using the supplied client. The supplied ObjectTyper must
+// know the kinds of the managed resources whose usages are tracked.
+func NewProviderConfigUsageTracker(c client.Client, t runtime.ObjectTyper) *ProviderConfigUsageTracker {
+	return &ProviderConfigUsageTracker{client: resource.NewAPIPatchingApplicator(c), typer: t}
+}
+
+// Track records that the supplied managed resource uses the referenced
+// ProviderConfig. The usage is named after the UID of the managed resource,
+// so that each managed resource uses one ProviderConfig at a time, and is
+// controlled by it, so that it is garbage collected once the managed resource
+// is deleted.
+func (t *ProviderConfigUsageTracker) Track(ctx context.Context, mg resource.Managed, ref runtimev1alpha1.Reference) error {
+	gvk, err := resource.GetKind(mg, t.typer)
+	if err != nil {
+		return errors.Wrap(err, errGetUsageKind)
+	}
+	u := &v1beta1.ProviderConfigUsage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            string(mg.GetUID()),
+			Labels:          map[string]string{v1beta1.LabelKeyProviderConfigName: ref.Name},
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.ReferenceTo(mg, gvk))},
+		},
+		ProviderConfigReference: ref,
+		ResourceReference: runtimev1alpha1.TypedReference{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Name:       mg.GetName(),
+			UID:        mg.GetUID(),
+		},
+	}
+	return errors.Wrap(t.client.Apply(ctx, u), errApplyUsage)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+func TestProviderConfigUsageTrackerTrack(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := runtimev1alpha1.Reference{Name: "cool-config"}
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool-resource", UID: "cool-uid"}}
+	gvk := fake.GVK(mg)
+	controller := true
+	_, errUnknownKind := resource.GetKind(mg, runtime.NewScheme())
+
+	type want struct {
+		usage *v1beta1.ProviderConfigUsage
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		typer  runtime.ObjectTyper
+		create error
+		want   want
+	}{
+		"Tracked": {
+			reason: "A usage named after the managed resource and controlled by it should be created",
+			typer:  fake.SchemeWith(&fake.Managed{}),
+			want: want{
+				usage: &v1beta1.ProviderConfigUsage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "cool-uid",
+						Labels: map[string]string{v1beta1.LabelKeyProviderConfigName: "cool-config"},
+						OwnerReferences: []metav1.OwnerReference{{
+							APIVersion: gvk.GroupVersion().String(),
+							Kind:       gvk.Kind,
+							Name:       "cool-resource",
+							UID:        "cool-uid",
+							Controller: &controller,
+						}},
+					},
+					ProviderConfigReference: ref,
+					ResourceReference: runtimev1alpha1.TypedReference{
+						APIVersion: gvk.GroupVersion().String(),
+						Kind:       gvk.Kind,
+						Name:       "cool-resource",
+						UID:        "cool-uid",
+					},
+				},
+			},
+		},
+		"UnknownKind": {
+			reason: "An error should be returned if the kind of the managed resource is unknown",
+			typer:  runtime.NewScheme(),
+			want:   want{err: errors.Wrap(errUnknownKind, errGetUsageKind)},
+		},
+		"ApplyFailed": {
+			reason: "Errors applying the usage should be returned",
+			typer:  fake.SchemeWith(&fake.Managed{}),
+			create: errBoom,
+			want:   want{err: errors.Wrap(errors.Wrap(errBoom, "cannot create object"), errApplyUsage)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *v1beta1.ProviderConfigUsage
+			c := &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+					got = obj.(*v1beta1.ProviderConfigUsage)
+					return tc.create
+				},
+			}
+			err := NewProviderConfigUsageTracker(c, tc.typer).Track(context.Background(), mg, ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nTrack(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.usage != nil {
+				if diff := cmp.Diff(tc.want.usage, got); diff != "" {
+					t.Errorf("\n%s\nTrack(...): -want, +got:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
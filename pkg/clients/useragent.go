@@ -0,0 +1,49 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+	"github.com/crossplane/provider-gcp/pkg/version"
+)
+
+// userAgentProduct identifies this provider in the user agent it sends to
+// GCP APIs, so that Google can attribute its traffic.
+const userAgentProduct = "crossplane-provider-gcp"
+
+// UserAgent returns the user agent sent with every call to a GCP API, e.g.
+// crossplane-provider-gcp/v0.10.0. The suffix configured by the supplied
+// HTTPClientConfig, if any, is appended to it.
+func UserAgent(cfg *v1alpha3.HTTPClientConfig) string {
+	v := version.Version
+	if v == "" {
+		v = "unknown"
+	}
+	ua := userAgentProduct + "/" + v
+	if cfg != nil && cfg.UserAgentSuffix != "" {
+		ua += " " + cfg.UserAgentSuffix
+	}
+	return ua
+}
+
+// UserAgentOption returns a client option that sets the user agent returned
+// by UserAgent. It is used by clients that are not built from ClientOptions.
+func UserAgentOption(cfg *v1alpha3.HTTPClientConfig) option.ClientOption {
+	return option.WithUserAgent(UserAgent(cfg))
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/provider-gcp/apis/v1alpha3"
+	"github.com/crossplane/provider-gcp/pkg/version"
+)
+
+func TestUserAgent(t *testing.T) {
+	cases := map[string]struct {
+		version string
+		cfg     *v1alpha3.HTTPClientConfig
+		want    string
+	}{
+		"NoConfig": {
+			version: "v0.10.0",
+			want:    "crossplane-provider-gcp/v0.10.0",
+		},
+		"NoSuffix": {
+			version: "v0.10.0",
+			cfg:     &v1alpha3.HTTPClientConfig{},
+			want:    "crossplane-provider-gcp/v0.10.0",
+		},
+		"Suffix": {
+			version: "v0.10.0",
+			cfg:     &v1alpha3.HTTPClientConfig{UserAgentSuffix: "team-data/prod"},
+			want:    "crossplane-provider-gcp/v0.10.0 team-data/prod",
+		},
+		"UnknownVersion": {
+			want: "crossplane-provider-gcp/unknown",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := version.Version
+			version.Version = tc.version
+			defer func() { version.Version = v }()
+
+			if diff := cmp.Diff(tc.want, UserAgent(tc.cfg)); diff != "" {
+				t.Errorf("UserAgent(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientUserAgent(t *testing.T) {
+	cfg := &v1alpha3.HTTPClientConfig{UserAgentSuffix: "team-data/prod"}
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.UserAgent()
+	}))
+	defer srv.Close()
+
+	hc, err := NewHTTPClient(context.Background(), cfg, option.WithoutAuthentication(), UserAgentOption(cfg))
+	if err != nil {
+		t.Fatalf("NewHTTPClient(...): %s", err)
+	}
+	rsp, err := hc.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("hc.Get(...): %s", err)
+	}
+	_ = rsp.Body.Close()
+
+	if diff := cmp.Diff(UserAgent(cfg), got); diff != "" {
+		t.Errorf("User-Agent: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/apigateway"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new API Gateway client"
+	errUpdateCR  = "cannot update API Gateway custom resource"
+
+	errNotAPI    = "managed resource is not an API Gateway API"
+	errGetAPI    = "cannot get API Gateway API"
+	errCreateAPI = "cannot create API Gateway API"
+	errUpdateAPI = "cannot update API Gateway API"
+	errDeleteAPI = "cannot delete API Gateway API"
+)
+
+type newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*apigateway.Service, error)
+
+// SetupAPI adds a controller that reconciles APIs.
+func SetupAPI(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.APIGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.API{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.APIGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&apiConnector{kube: mgr.GetClient(), newServiceFn: apigateway.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// setConditions sets the Ready condition of the supplied managed resource per
+// the supplied state of its API Gateway resource. Updating resources continue
+// to serve their previous configuration and are thus considered available.
+func setConditions(mg resource.Managed, state string) {
+	switch state {
+	case v1alpha1.StateActive, v1alpha1.StateUpdating:
+		mg.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.StateCreating, v1alpha1.StateActivating:
+		mg.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.StateDeleting:
+		mg.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		mg.SetConditions(runtimev1alpha1.Unavailable())
+	}
+}
+
+type apiConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *apiConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.API)
+	if !ok {
+		return nil, errors.New(errNotAPI)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, apigateway.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	return &apiExternal{kube: c.kube, gateway: s, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type apiExternal struct {
+	kube      client.Client
+	gateway   *apigateway.Service
+	projectID string
+}
+
+func (e *apiExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.API)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAPI)
+	}
+	observed, err := e.gateway.GetAPI(ctx, apigateway.APIName(e.projectID, meta.GetExternalName(cr)))
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAPI)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeAPI(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = apigateway.GenerateAPIObservation(*observed)
+	setConditions(cr, observed.State)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: apigateway.IsAPIUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *apiExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.API)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAPI)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.gateway.CreateAPI(ctx, e.projectID, meta.GetExternalName(cr), apigateway.GenerateAPI(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAPI)
+}
+
+func (e *apiExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.API)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAPI)
+	}
+	name := apigateway.APIName(e.projectID, meta.GetExternalName(cr))
+	_, err := e.gateway.PatchAPI(ctx, name, apigateway.GenerateAPI(cr.Spec.ForProvider), apigateway.APIUpdateMask...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAPI)
+}
+
+func (e *apiExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.API)
+	if !ok {
+		return errors.New(errNotAPI)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.gateway.DeleteAPI(ctx, apigateway.APIName(e.projectID, meta.GetExternalName(cr)))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAPI)
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/apigateway"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	providerName = "gcp-provider"
+	testAPI      = "test-api"
+)
+
+var (
+	_ managed.ExternalConnecter = &apiConnector{}
+	_ managed.ExternalClient    = &apiExternal{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type apiModifier func(*v1alpha1.API)
+
+func apiWithConditions(c ...runtimev1alpha1.Condition) apiModifier {
+	return func(a *v1alpha1.API) { a.Status.SetConditions(c...) }
+}
+
+func apiWithObservation(o v1alpha1.APIObservation) apiModifier {
+	return func(a *v1alpha1.API) { a.Status.AtProvider = o }
+}
+
+func apiWithDisplayName(n string) apiModifier {
+	return func(a *v1alpha1.API) { a.Spec.ForProvider.DisplayName = gcp.StringPtr(n) }
+}
+
+func apiObj(m ...apiModifier) *v1alpha1.API {
+	a := &v1alpha1.API{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testAPI,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: testAPI},
+		},
+		Spec: v1alpha1.APISpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.APIParameters{
+				Labels: map[string]string{"team": "payments"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func apiPath() string {
+	return "/v1/projects/" + projectID + "/locations/global/apis/" + testAPI
+}
+
+func TestSetConditions(t *testing.T) {
+	cases := map[string]runtimev1alpha1.Condition{
+		v1alpha1.StateActive:     runtimev1alpha1.Available(),
+		v1alpha1.StateUpdating:   runtimev1alpha1.Available(),
+		v1alpha1.StateCreating:   runtimev1alpha1.Creating(),
+		v1alpha1.StateActivating: runtimev1alpha1.Creating(),
+		v1alpha1.StateDeleting:   runtimev1alpha1.Deleting(),
+		v1alpha1.StateFailed:     runtimev1alpha1.Unavailable(),
+	}
+
+	for state, want := range cases {
+		t.Run(state, func(t *testing.T) {
+			mg := &fake.Managed{}
+			setConditions(mg, state)
+			if diff := cmp.Diff(want, mg.GetCondition(runtimev1alpha1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("setConditions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAPI": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotAPI),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(apiPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&apigateway.API{})
+			}),
+			mg: apiObj(),
+			want: want{
+				mg: apiObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&apigateway.API{})
+			}),
+			mg: apiObj(),
+			want: want{
+				mg:  apiObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetAPI),
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.API{
+					Name:        apigateway.APIName(projectID, testAPI),
+					DisplayName: testAPI,
+					Labels:      map[string]string{"team": "payments"},
+					State:       v1alpha1.StateActive,
+				})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   apiObj(),
+			want: want{
+				mg: apiObj(
+					apiWithDisplayName(testAPI),
+					apiWithObservation(v1alpha1.APIObservation{Name: apigateway.APIName(projectID, testAPI), State: v1alpha1.StateActive}),
+					apiWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.API{DisplayName: "old", State: v1alpha1.StateCreating})
+			}),
+			mg: apiObj(apiWithDisplayName("new")),
+			want: want{
+				mg: apiObj(
+					apiWithDisplayName("new"),
+					apiWithObservation(v1alpha1.APIObservation{State: v1alpha1.StateCreating}),
+					apiWithConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"UpdateCRFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.API{DisplayName: testAPI})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errors.New("boom"))},
+			mg:   apiObj(),
+			want: want{
+				mg:  apiObj(apiWithDisplayName(testAPI)),
+				err: errors.Wrap(errors.New("boom"), errUpdateCR),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := apiExternal{kube: tc.kube, gateway: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAPI": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotAPI),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(apiPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.Operation{})
+			}),
+			mg: apiObj(),
+			want: want{
+				mg: apiObj(apiWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&apigateway.Operation{})
+			}),
+			mg: apiObj(),
+			want: want{
+				mg: apiObj(apiWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&apigateway.Operation{})
+			}),
+			mg: apiObj(),
+			want: want{
+				mg:  apiObj(apiWithConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAPI),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := apiExternal{gateway: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
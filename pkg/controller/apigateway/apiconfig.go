@@ -0,0 +1,181 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"io/ioutil"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/apigateway"
+)
+
+// Error strings.
+const (
+	errNotAPIConfig    = "managed resource is not an API Gateway APIConfig"
+	errGetAPIConfig    = "cannot get API Gateway API config"
+	errCreateAPIConfig = "cannot create API Gateway API config"
+	errUpdateAPIConfig = "cannot update API Gateway API config"
+	errDeleteAPIConfig = "cannot delete API Gateway API config"
+)
+
+// SetupAPIConfig adds a controller that reconciles APIConfigs.
+func SetupAPIConfig(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.APIConfigGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.APIConfig{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.APIConfigGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&apiConfigConnector{kube: mgr.GetClient(), newServiceFn: apigateway.NewService})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// gcsReader returns an ObjectReader that reads Cloud Storage objects using the
+// supplied options.
+func gcsReader(opts []option.ClientOption) apigateway.ObjectReader {
+	return func(ctx context.Context, bucket, object string) ([]byte, error) {
+		c, err := storage.NewClient(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		r, err := c.Bucket(bucket).Object(object).NewReader(ctx)
+		if err != nil {
+			return nil, err
+		}
+		b, err := ioutil.ReadAll(r)
+		_ = r.Close()
+		return b, err
+	}
+}
+
+type apiConfigConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *apiConfigConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.APIConfig)
+	if !ok {
+		return nil, errors.New(errNotAPIConfig)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, apigateway.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	return &apiConfigExternal{kube: c.kube, gateway: s, read: gcsReader(opts), projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type apiConfigExternal struct {
+	kube      client.Client
+	gateway   *apigateway.Service
+	read      apigateway.ObjectReader
+	projectID string
+}
+
+func (e *apiConfigExternal) name(cr *v1alpha1.APIConfig) string {
+	return apigateway.APIConfigName(e.projectID, gcp.StringValue(cr.Spec.ForProvider.API), meta.GetExternalName(cr))
+}
+
+func (e *apiConfigExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.APIConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAPIConfig)
+	}
+	observed, err := e.gateway.GetAPIConfig(ctx, e.name(cr))
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAPIConfig)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeAPIConfig(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = apigateway.GenerateAPIConfigObservation(*observed)
+	setConditions(cr, observed.State)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: apigateway.IsAPIConfigUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *apiConfigExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.APIConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAPIConfig)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	c, err := apigateway.GenerateAPIConfig(ctx, cr.Spec.ForProvider, e.read)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAPIConfig)
+	}
+	_, err = e.gateway.CreateAPIConfig(ctx, e.projectID, gcp.StringValue(cr.Spec.ForProvider.API), meta.GetExternalName(cr), c)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAPIConfig)
+}
+
+// Update updates the display name and labels of the API config. Its other
+// fields are immutable.
+func (e *apiConfigExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.APIConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAPIConfig)
+	}
+	c := &apigateway.APIConfig{
+		DisplayName: gcp.StringValue(cr.Spec.ForProvider.DisplayName),
+		Labels:      cr.Spec.ForProvider.Labels,
+	}
+	_, err := e.gateway.PatchAPIConfig(ctx, e.name(cr), c, apigateway.APIConfigUpdateMask...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAPIConfig)
+}
+
+func (e *apiConfigExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.APIConfig)
+	if !ok {
+		return errors.New(errNotAPIConfig)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.gateway.DeleteAPIConfig(ctx, e.name(cr))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAPIConfig)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/connection"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/apigateway"
+)
+
+// Error strings.
+const (
+	errNotGateway    = "managed resource is not an API Gateway Gateway"
+	errGetGateway    = "cannot get API Gateway gateway"
+	errCreateGateway = "cannot create API Gateway gateway"
+	errUpdateGateway = "cannot update API Gateway gateway"
+	errDeleteGateway = "cannot delete API Gateway gateway"
+)
+
+// SetupGateway adds a controller that reconciles Gateways.
+func SetupGateway(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.GatewayGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Gateway{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.GatewayGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&gatewayConnector{kube: mgr.GetClient(), newServiceFn: apigateway.NewService})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type gatewayConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *gatewayConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Gateway)
+	if !ok {
+		return nil, errors.New(errNotGateway)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, apigateway.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	return &gatewayExternal{kube: c.kube, gateway: s, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type gatewayExternal struct {
+	kube      client.Client
+	gateway   *apigateway.Service
+	projectID string
+}
+
+func (e *gatewayExternal) name(cr *v1alpha1.Gateway) string {
+	return apigateway.GatewayName(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr))
+}
+
+func (e *gatewayExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Gateway)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGateway)
+	}
+	observed, err := e.gateway.GetGateway(ctx, e.name(cr))
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetGateway)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	apigateway.LateInitializeGateway(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = apigateway.GenerateGatewayObservation(*observed)
+	setConditions(cr, observed.State)
+	conn := managed.ConnectionDetails{}
+	if observed.DefaultHostname != "" {
+		conn[connection.KeyEndpoint] = []byte(observed.DefaultHostname)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  apigateway.IsGatewayUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *gatewayExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Gateway)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGateway)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.gateway.CreateGateway(ctx, e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), apigateway.GenerateGateway(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateGateway)
+}
+
+// Update updates the gateway, rolling it out to a new API config if the
+// config reference changed.
+func (e *gatewayExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Gateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGateway)
+	}
+	_, err := e.gateway.PatchGateway(ctx, e.name(cr), apigateway.GenerateGateway(cr.Spec.ForProvider), apigateway.GatewayUpdateMask...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateGateway)
+}
+
+func (e *gatewayExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Gateway)
+	if !ok {
+		return errors.New(errNotGateway)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.gateway.DeleteGateway(ctx, e.name(cr))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteGateway)
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/apigateway"
+)
+
+const (
+	testGateway = "test-gateway"
+	region      = "us-central1"
+	hostname    = "test-gateway-abc123.uc.gateway.dev"
+)
+
+var (
+	_ managed.ExternalConnecter = &gatewayConnector{}
+	_ managed.ExternalClient    = &gatewayExternal{}
+
+	testConfig = apigateway.APIConfigName(projectID, testAPI, "v1")
+)
+
+type gatewayModifier func(*v1alpha1.Gateway)
+
+func gatewayWithConditions(c ...runtimev1alpha1.Condition) gatewayModifier {
+	return func(g *v1alpha1.Gateway) { g.Status.SetConditions(c...) }
+}
+
+func gatewayWithObservation(o v1alpha1.GatewayObservation) gatewayModifier {
+	return func(g *v1alpha1.Gateway) { g.Status.AtProvider = o }
+}
+
+func gatewayWithAPIConfig(c string) gatewayModifier {
+	return func(g *v1alpha1.Gateway) { g.Spec.ForProvider.APIConfig = gcp.StringPtr(c) }
+}
+
+func gatewayObj(m ...gatewayModifier) *v1alpha1.Gateway {
+	g := &v1alpha1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testGateway,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: testGateway},
+		},
+		Spec: v1alpha1.GatewaySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.GatewayParameters{
+				Region:      region,
+				APIConfig:   gcp.StringPtr(testConfig),
+				DisplayName: gcp.StringPtr(testGateway),
+			},
+		},
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+func gatewayPath() string {
+	return "/v1/" + apigateway.GatewayName(projectID, region, testGateway)
+}
+
+func TestGatewayObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotGateway": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotGateway),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(gatewayPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&apigateway.Gateway{})
+			}),
+			mg: gatewayObj(),
+			want: want{
+				mg: gatewayObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&apigateway.Gateway{})
+			}),
+			mg: gatewayObj(),
+			want: want{
+				mg:  gatewayObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetGateway),
+			},
+		},
+		"Active": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.Gateway{
+					APIConfig:       "projects/123456/locations/global/apis/" + testAPI + "/configs/v1",
+					DisplayName:     testGateway,
+					DefaultHostname: hostname,
+					State:           v1alpha1.StateActive,
+				})
+			}),
+			mg: gatewayObj(),
+			want: want{
+				mg: gatewayObj(
+					gatewayWithObservation(v1alpha1.GatewayObservation{DefaultHostname: hostname, State: v1alpha1.StateActive}),
+					gatewayWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(hostname),
+					},
+				},
+			},
+		},
+		"RollingOut": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.Gateway{
+					APIConfig:   apigateway.APIConfigName(projectID, testAPI, "v0"),
+					DisplayName: testGateway,
+					State:       v1alpha1.StateCreating,
+				})
+			}),
+			mg: gatewayObj(),
+			want: want{
+				mg: gatewayObj(
+					gatewayWithObservation(v1alpha1.GatewayObservation{State: v1alpha1.StateCreating}),
+					gatewayWithConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := gatewayExternal{gateway: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGatewayCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotGateway": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotGateway),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/projects/"+projectID+"/locations/"+region+"/gateways", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(testGateway, r.URL.Query().Get("gatewayId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				g := &apigateway.Gateway{}
+				if err := json.NewDecoder(r.Body).Decode(g); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(apigateway.GenerateGateway(gatewayObj().Spec.ForProvider), g); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.Operation{})
+			}),
+			mg: gatewayObj(),
+			want: want{
+				mg: gatewayObj(gatewayWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&apigateway.Operation{})
+			}),
+			mg: gatewayObj(),
+			want: want{
+				mg:  gatewayObj(gatewayWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateGateway),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := gatewayExternal{gateway: s, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGatewayUpdate(t *testing.T) {
+	v2 := apigateway.APIConfigName(projectID, testAPI, "v2")
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotGateway": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotGateway),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(gatewayPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("apiConfig,displayName,labels", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				g := &apigateway.Gateway{}
+				if err := json.NewDecoder(r.Body).Decode(g); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(v2, g.APIConfig); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.Operation{})
+			}),
+			mg: gatewayObj(gatewayWithAPIConfig(v2)),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&apigateway.Operation{})
+			}),
+			mg:   gatewayObj(gatewayWithAPIConfig(v2)),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateGateway),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := gatewayExternal{gateway: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	bigquery "google.golang.org/api/bigquery/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	bq "github.com/crossplane/provider-gcp/pkg/clients/bigquery"
+)
+
+// Error strings.
+const (
+	errNotDataset       = "managed resource is not a BigQuery Dataset"
+	errGetDataset       = "cannot get BigQuery Dataset"
+	errCreateDataset    = "cannot create BigQuery Dataset"
+	errUpdateDataset    = "cannot update BigQuery Dataset"
+	errDeleteDataset    = "cannot delete BigQuery Dataset"
+	errUpdateDatasetCR  = "cannot update BigQuery Dataset custom resource"
+	errDatasetImmutable = "cannot change %s of an existing BigQuery Dataset"
+)
+
+// SetupDataset adds a controller that reconciles Datasets.
+func SetupDataset(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DatasetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Dataset{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DatasetGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&datasetConnector{kube: mgr.GetClient(), newServiceFn: bigquery.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type datasetConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *datasetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return nil, errors.New(errNotDataset)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, bigquery.BigqueryScope)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &datasetExternal{kube: c.kube, datasets: svc.Datasets, projectID: p.Spec.ProjectID}, nil
+}
+
+type datasetExternal struct {
+	kube      client.Client
+	datasets  *bigquery.DatasetsService
+	projectID string
+}
+
+func (e *datasetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDataset)
+	}
+	observed, err := e.datasets.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetDataset)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	bq.LateInitializeDataset(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateDatasetCR)
+		}
+	}
+
+	cr.Status.AtProvider = bq.GenerateDatasetObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: bq.IsDatasetUpToDate(e.projectID, cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *datasetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDataset)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	ds := &bigquery.Dataset{}
+	bq.GenerateDataset(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, ds)
+	_, err := e.datasets.Insert(e.projectID, ds).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDataset)
+}
+
+// Update replaces the observed dataset with one that has the declared
+// fields, so that access entries and labels that are no longer declared are
+// removed.
+func (e *datasetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDataset)
+	}
+	observed, err := e.datasets.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetDataset)
+	}
+	if changed := bq.ChangedImmutableDatasetFields(cr.Spec.ForProvider, *observed); len(changed) > 0 {
+		return managed.ExternalUpdate{}, errors.Errorf(errDatasetImmutable, strings.Join(changed, " and "))
+	}
+	bq.GenerateDataset(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, observed)
+	_, err = e.datasets.Update(e.projectID, meta.GetExternalName(cr), observed).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDataset)
+}
+
+// Delete deletes the dataset. BigQuery refuses to delete a dataset that
+// contains tables, so those must be deleted first.
+func (e *datasetExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return errors.New(errNotDataset)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	err := e.datasets.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDataset)
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	bigquery "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const testDatasetName = "test_dataset"
+
+var (
+	_ managed.ExternalConnecter = &datasetConnector{}
+	_ managed.ExternalClient    = &datasetExternal{}
+)
+
+type datasetModifier func(*v1alpha1.Dataset)
+
+func datasetWithConditions(c ...runtimev1alpha1.Condition) datasetModifier {
+	return func(d *v1alpha1.Dataset) { d.Status.SetConditions(c...) }
+}
+
+func datasetWithLocation(l string) datasetModifier {
+	return func(d *v1alpha1.Dataset) { d.Spec.ForProvider.Location = &l }
+}
+
+func datasetWithObservation(o v1alpha1.DatasetObservation) datasetModifier {
+	return func(d *v1alpha1.Dataset) { d.Status.AtProvider = o }
+}
+
+func datasetObj(m ...datasetModifier) *v1alpha1.Dataset {
+	d := &v1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testDatasetName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testDatasetName,
+			},
+		},
+		Spec: v1alpha1.DatasetSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DatasetParameters{
+				Description: gcp.StringPtr("desc"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestDatasetObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotDataset": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotDataset),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&bigquery.Dataset{})
+			}),
+			mg: datasetObj(),
+			want: want{
+				mg: datasetObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&bigquery.Dataset{})
+			}),
+			mg: datasetObj(),
+			want: want{
+				mg:  datasetObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetDataset),
+			},
+		},
+		"LateInitializedAndUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&bigquery.Dataset{Id: "p:ds", Location: "US", Description: "desc"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   datasetObj(),
+			want: want{
+				mg: datasetObj(
+					datasetWithLocation("US"),
+					datasetWithObservation(v1alpha1.DatasetObservation{ID: "p:ds"}),
+					datasetWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&bigquery.Dataset{Location: "US", Description: "old"})
+			}),
+			mg: datasetObj(datasetWithLocation("US")),
+			want: want{
+				mg:  datasetObj(datasetWithLocation("US"), datasetWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := datasetExternal{kube: tc.kube, datasets: s.Datasets, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDatasetUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&bigquery.Dataset{
+						Location: "US",
+						Labels:   map[string]string{"foo": "bar"},
+						Access:   []*bigquery.DatasetAccess{{Role: "OWNER", SpecialGroup: "projectOwners"}},
+					})
+				case http.MethodPut:
+					ds := &bigquery.Dataset{}
+					if err := json.NewDecoder(r.Body).Decode(ds); err != nil {
+						t.Errorf("cannot decode request body: %s", err)
+					}
+					_ = r.Body.Close()
+					want := &bigquery.Dataset{
+						DatasetReference: &bigquery.DatasetReference{ProjectId: projectID, DatasetId: testDatasetName},
+						Location:         "US",
+						Description:      "desc",
+						Labels:           map[string]string{"foo": "bar"},
+						Access:           []*bigquery.DatasetAccess{{Role: "OWNER", SpecialGroup: "projectOwners"}},
+					}
+					if diff := cmp.Diff(want, ds); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(ds)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+				}
+			}),
+			mg: datasetObj(datasetWithLocation("US")),
+		},
+		"LocationChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&bigquery.Dataset{Location: "US"})
+			}),
+			mg:  datasetObj(datasetWithLocation("EU")),
+			err: errors.Errorf(errDatasetImmutable, "location"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := datasetExternal{datasets: s.Datasets, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDatasetDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		},
+		"AlreadyDeleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&bigquery.Dataset{})
+			}),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDataset),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := datasetExternal{datasets: s.Datasets, projectID: projectID}
+			mg := datasetObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(datasetObj(datasetWithConditions(runtimev1alpha1.Deleting())), mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	bigquery "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	bq "github.com/crossplane/provider-gcp/pkg/clients/bigquery"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new BigQuery client"
+
+	errNotJob    = "managed resource is not a BigQuery Job"
+	errGetJob    = "cannot get BigQuery Job"
+	errCreateJob = "cannot create BigQuery Job"
+	errCancelJob = "cannot cancel BigQuery Job"
+)
+
+// SetupJob adds a controller that reconciles Jobs.
+func SetupJob(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.JobGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Job{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.JobGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&jobConnector{kube: mgr.GetClient(), newServiceFn: bigquery.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*bigquery.Service, error)
+
+type jobConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *jobConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return nil, errors.New(errNotJob)
+	}
+
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, bigquery.BigqueryScope)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &jobExternal{jobs: svc.Jobs, projectID: p.Spec.ProjectID}, nil
+}
+
+type jobExternal struct {
+	jobs      *bigquery.JobsService
+	projectID string
+}
+
+// Observe polls the state of the job and reflects it in the status of the
+// managed resource.
+func (e *jobExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotJob)
+	}
+
+	call := e.jobs.Get(e.projectID, meta.GetExternalName(cr))
+	if cr.Spec.ForProvider.Location != nil {
+		call = call.Location(*cr.Spec.ForProvider.Location)
+	}
+	j, err := call.Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetJob)
+	}
+
+	cr.Status.AtProvider = bq.GenerateJobObservation(*j)
+	switch {
+	case bq.IsFailed(*j):
+		cr.SetConditions(runtimev1alpha1.Unavailable().WithMessage(cr.Status.AtProvider.ErrorMessage))
+	case bq.IsDone(*j):
+		cr.SetConditions(runtimev1alpha1.Available())
+	default:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	}
+
+	// BigQuery does not support deleting jobs; they are retained in the job
+	// history. Once a finished job's managed resource is deleted we report it
+	// as non-existent so that the managed reconciler can remove its finalizer.
+	if meta.WasDeleted(cr) && bq.IsDone(*j) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Jobs run exactly once and cannot be modified, so they are always
+	// considered to be up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// Create submits the job.
+func (e *jobExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotJob)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.jobs.Insert(e.projectID, bq.GenerateJob(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateJob)
+}
+
+// Update is a no-op; jobs are immutable once submitted.
+func (e *jobExternal) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.Job); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotJob)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete cancels the job if it is still pending or running.
+func (e *jobExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return errors.New(errNotJob)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.JobStateDone {
+		return nil
+	}
+	call := e.jobs.Cancel(e.projectID, meta.GetExternalName(cr))
+	if cr.Spec.ForProvider.Location != nil {
+		call = call.Location(*cr.Spec.ForProvider.Location)
+	}
+	_, err := call.Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errCancelJob)
+}
@@ -0,0 +1,384 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	bigquery "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	testJobName  = "test-job"
+	providerName = "gcp-provider"
+)
+
+var (
+	_ managed.ExternalConnecter = &jobConnector{}
+	_ managed.ExternalClient    = &jobExternal{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type jobModifier func(*v1alpha1.Job)
+
+func jobWithConditions(c ...runtimev1alpha1.Condition) jobModifier {
+	return func(j *v1alpha1.Job) { j.Status.SetConditions(c...) }
+}
+
+func jobWithState(s string) jobModifier {
+	return func(j *v1alpha1.Job) { j.Status.AtProvider.State = s }
+}
+
+func jobWithError(reason, message string) jobModifier {
+	return func(j *v1alpha1.Job) {
+		j.Status.AtProvider.ErrorReason = reason
+		j.Status.AtProvider.ErrorMessage = message
+	}
+}
+
+func jobWithDeletionTimestamp() jobModifier {
+	return func(j *v1alpha1.Job) { j.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1, 0)}) }
+}
+
+func jobObj(m ...jobModifier) *v1alpha1.Job {
+	j := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testJobName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testJobName,
+			},
+		},
+		Spec: v1alpha1.JobSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.JobParameters{
+				Query: &v1alpha1.JobConfigurationQuery{Query: "SELECT 1"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(j)
+	}
+	return j
+}
+
+func TestJobObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotJob": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotJob),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&bigquery.Job{})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg: jobObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&bigquery.Job{})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg:  jobObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetJob),
+			},
+		},
+		"Running": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&bigquery.Job{Status: &bigquery.JobStatus{State: v1alpha1.JobStateRunning}})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg:  jobObj(jobWithState(v1alpha1.JobStateRunning), jobWithConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Done": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&bigquery.Job{Status: &bigquery.JobStatus{State: v1alpha1.JobStateDone}})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg:  jobObj(jobWithState(v1alpha1.JobStateDone), jobWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&bigquery.Job{Status: &bigquery.JobStatus{
+					State:       v1alpha1.JobStateDone,
+					ErrorResult: &bigquery.ErrorProto{Reason: "invalidQuery", Message: "boom"},
+				}})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg: jobObj(
+					jobWithState(v1alpha1.JobStateDone),
+					jobWithError("invalidQuery", "boom"),
+					jobWithConditions(runtimev1alpha1.Unavailable().WithMessage("boom"))),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DeletedAfterDone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&bigquery.Job{Status: &bigquery.JobStatus{State: v1alpha1.JobStateDone}})
+			}),
+			mg: jobObj(jobWithDeletionTimestamp()),
+			want: want{
+				mg: jobObj(
+					jobWithDeletionTimestamp(),
+					jobWithState(v1alpha1.JobStateDone),
+					jobWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{jobs: s.Jobs, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotJob": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotJob),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				j := &bigquery.Job{}
+				if err := json.NewDecoder(r.Body).Decode(j); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				want := &bigquery.JobReference{ProjectId: projectID, JobId: testJobName}
+				if diff := cmp.Diff(want, j.JobReference); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(j)
+			}),
+			mg: jobObj(),
+			want: want{
+				mg: jobObj(jobWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(&bigquery.Job{})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg:  jobObj(jobWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusConflict, ""), errCreateJob),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{jobs: s.Jobs, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotJob": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotJob),
+			},
+		},
+		"AlreadyDone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			}),
+			mg: jobObj(jobWithState(v1alpha1.JobStateDone)),
+			want: want{
+				mg: jobObj(jobWithState(v1alpha1.JobStateDone), jobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Cancelled": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&bigquery.JobCancelResponse{})
+			}),
+			mg: jobObj(jobWithState(v1alpha1.JobStateRunning)),
+			want: want{
+				mg: jobObj(jobWithState(v1alpha1.JobStateRunning), jobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&bigquery.JobCancelResponse{})
+			}),
+			mg: jobObj(jobWithState(v1alpha1.JobStateRunning)),
+			want: want{
+				mg: jobObj(jobWithState(v1alpha1.JobStateRunning), jobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&bigquery.JobCancelResponse{})
+			}),
+			mg: jobObj(jobWithState(v1alpha1.JobStateRunning)),
+			want: want{
+				mg:  jobObj(jobWithState(v1alpha1.JobStateRunning), jobWithConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCancelJob),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{jobs: s.Jobs, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	bigquery "google.golang.org/api/bigquery/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	bq "github.com/crossplane/provider-gcp/pkg/clients/bigquery"
+)
+
+// Error strings.
+const (
+	errNotTable       = "managed resource is not a BigQuery Table"
+	errGetTable       = "cannot get BigQuery Table"
+	errCreateTable    = "cannot create BigQuery Table"
+	errUpdateTable    = "cannot update BigQuery Table"
+	errDeleteTable    = "cannot delete BigQuery Table"
+	errTableImmutable = "cannot change %s of an existing BigQuery Table; columns can only be added"
+)
+
+// SetupTable adds a controller that reconciles Tables.
+func SetupTable(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TableGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Table{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TableGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&tableConnector{kube: mgr.GetClient(), newServiceFn: bigquery.NewService})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type tableConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *tableConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return nil, errors.New(errNotTable)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, bigquery.BigqueryScope)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &tableExternal{tables: svc.Tables, projectID: p.Spec.ProjectID}, nil
+}
+
+type tableExternal struct {
+	tables    *bigquery.TablesService
+	projectID string
+}
+
+func (e *tableExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTable)
+	}
+	observed, err := e.tables.Get(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Dataset), meta.GetExternalName(cr)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTable)
+	}
+
+	cr.Status.AtProvider = bq.GenerateTableObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: bq.IsTableUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *tableExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTable)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	t := &bigquery.Table{}
+	bq.GenerateTable(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, t)
+	_, err := e.tables.Insert(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Dataset), t).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTable)
+}
+
+// Update replaces the observed table with one that has the declared fields.
+// Changes to the partitioning of the table, and schema changes other than
+// added columns and relaxed column modes, are rejected before any call is
+// made because BigQuery cannot apply them to an existing table.
+func (e *tableExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTable)
+	}
+	dataset := gcp.StringValue(cr.Spec.ForProvider.Dataset)
+	observed, err := e.tables.Get(e.projectID, dataset, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTable)
+	}
+	if changed := bq.ChangedImmutableTableFields(cr.Spec.ForProvider, *observed); len(changed) > 0 {
+		return managed.ExternalUpdate{}, errors.Errorf(errTableImmutable, strings.Join(changed, " and "))
+	}
+	bq.GenerateTable(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, observed)
+	_, err = e.tables.Update(e.projectID, dataset, meta.GetExternalName(cr), observed).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTable)
+}
+
+func (e *tableExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return errors.New(errNotTable)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	err := e.tables.Delete(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Dataset), meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTable)
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bigquery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	bigquery "google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/bigquery/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const testTableName = "test_table"
+
+var (
+	_ managed.ExternalConnecter = &tableConnector{}
+	_ managed.ExternalClient    = &tableExternal{}
+)
+
+type tableModifier func(*v1alpha1.Table)
+
+func tableWithConditions(c ...runtimev1alpha1.Condition) tableModifier {
+	return func(tb *v1alpha1.Table) { tb.Status.SetConditions(c...) }
+}
+
+func tableWithSchema(s ...v1alpha1.TableFieldSchema) tableModifier {
+	return func(tb *v1alpha1.Table) { tb.Spec.ForProvider.Schema = s }
+}
+
+func tableWithObservation(o v1alpha1.TableObservation) tableModifier {
+	return func(tb *v1alpha1.Table) { tb.Status.AtProvider = o }
+}
+
+func tableObj(m ...tableModifier) *v1alpha1.Table {
+	tb := &v1alpha1.Table{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testTableName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testTableName,
+			},
+		},
+		Spec: v1alpha1.TableSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.TableParameters{
+				Dataset: gcp.StringPtr(testDatasetName),
+			},
+		},
+	}
+	for _, f := range m {
+		f(tb)
+	}
+	return tb
+}
+
+func observedTable(fields ...*bigquery.TableFieldSchema) *bigquery.Table {
+	return &bigquery.Table{Id: "p:ds.tbl", Type: "TABLE", Schema: &bigquery.TableSchema{Fields: fields}}
+}
+
+func TestTableObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTable": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotTable),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if !strings.HasSuffix(r.URL.Path, "/datasets/"+testDatasetName+"/tables/"+testTableName) {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&bigquery.Table{})
+			}),
+			mg: tableObj(),
+			want: want{
+				mg: tableObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&bigquery.Table{})
+			}),
+			mg: tableObj(),
+			want: want{
+				mg:  tableObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetTable),
+			},
+		},
+		"ColumnAdded": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedTable(&bigquery.TableFieldSchema{Name: "id", Type: "INTEGER"}))
+			}),
+			mg: tableObj(tableWithSchema(
+				v1alpha1.TableFieldSchema{Name: "id", Type: "INTEGER"},
+				v1alpha1.TableFieldSchema{Name: "name", Type: "STRING"},
+			)),
+			want: want{
+				mg: tableObj(
+					tableWithSchema(
+						v1alpha1.TableFieldSchema{Name: "id", Type: "INTEGER"},
+						v1alpha1.TableFieldSchema{Name: "name", Type: "STRING"},
+					),
+					tableWithObservation(v1alpha1.TableObservation{ID: "p:ds.tbl", Type: "TABLE"}),
+					tableWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := tableExternal{tables: s.Tables, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTableUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"ColumnAdded": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedTable(&bigquery.TableFieldSchema{Name: "id", Type: "INTEGER"}))
+				case http.MethodPut:
+					tb := &bigquery.Table{}
+					if err := json.NewDecoder(r.Body).Decode(tb); err != nil {
+						t.Errorf("cannot decode request body: %s", err)
+					}
+					_ = r.Body.Close()
+					want := &bigquery.TableSchema{Fields: []*bigquery.TableFieldSchema{
+						{Name: "id", Type: "INTEGER"},
+						{Name: "name", Type: "STRING"},
+					}}
+					if diff := cmp.Diff(want, tb.Schema); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(tb)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+				}
+			}),
+			mg: tableObj(tableWithSchema(
+				v1alpha1.TableFieldSchema{Name: "id", Type: "INTEGER"},
+				v1alpha1.TableFieldSchema{Name: "name", Type: "STRING"},
+			)),
+		},
+		"ColumnTypeChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedTable(&bigquery.TableFieldSchema{Name: "id", Type: "INTEGER"}))
+			}),
+			mg:  tableObj(tableWithSchema(v1alpha1.TableFieldSchema{Name: "id", Type: "STRING"})),
+			err: errors.Errorf(errTableImmutable, "column id"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := tableExternal{tables: s.Tables, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTableDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		},
+		"AlreadyDeleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&bigquery.Table{})
+			}),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTable),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := bigquery.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := tableExternal{tables: s.Tables, projectID: projectID}
+			mg := tableObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tableObj(tableWithConditions(runtimev1alpha1.Deleting())), mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	binauthz "github.com/crossplane/provider-gcp/pkg/clients/binaryauthorization"
+)
+
+// Error strings.
+const (
+	errUpdateCR = "cannot update Binary Authorization custom resource"
+
+	errNotAttestor    = "managed resource is not a Binary Authorization Attestor"
+	errGetAttestor    = "cannot get Binary Authorization attestor"
+	errCreateAttestor = "cannot create Binary Authorization attestor"
+	errUpdateAttestor = "cannot update Binary Authorization attestor"
+	errDeleteAttestor = "cannot delete Binary Authorization attestor"
+)
+
+// SetupAttestor adds a controller that reconciles Binary Authorization
+// Attestors.
+func SetupAttestor(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AttestorGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Attestor{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AttestorGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&attestorConnector{kube: mgr.GetClient(), newServiceFn: binaryauthorization.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type attestorConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *attestorConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Attestor)
+	if !ok {
+		return nil, errors.New(errNotAttestor)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, binaryauthorization.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	return &attestorExternal{kube: c.kube, binauthz: s, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type attestorExternal struct {
+	kube      client.Client
+	binauthz  *binaryauthorization.Service
+	projectID string
+}
+
+func (e *attestorExternal) name(cr *v1alpha1.Attestor) string {
+	return binauthz.AttestorName(e.projectID, meta.GetExternalName(cr))
+}
+
+func (e *attestorExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Attestor)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAttestor)
+	}
+	observed, err := e.binauthz.Projects.Attestors.Get(e.name(cr)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAttestor)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	binauthz.LateInitializeAttestor(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = binauthz.GenerateAttestorObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: binauthz.IsAttestorUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *attestorExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Attestor)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAttestor)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	a := binauthz.GenerateAttestor(e.name(cr), cr.Spec.ForProvider)
+	_, err := e.binauthz.Projects.Attestors.Create(binauthz.AttestorParent(e.projectID), a).AttestorId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAttestor)
+}
+
+func (e *attestorExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Attestor)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAttestor)
+	}
+	_, err := e.binauthz.Projects.Attestors.Update(e.name(cr), binauthz.GenerateAttestor(e.name(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAttestor)
+}
+
+func (e *attestorExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Attestor)
+	if !ok {
+		return errors.New(errNotAttestor)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.binauthz.Projects.Attestors.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAttestor)
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+)
+
+const (
+	attestorName = "build"
+	attestorPath = "/v1/projects/" + projectID + "/attestors/" + attestorName
+	note         = "projects/" + projectID + "/notes/build"
+)
+
+var (
+	_ managed.ExternalConnecter = &attestorConnector{}
+	_ managed.ExternalClient    = &attestorExternal{}
+)
+
+type attestorModifier func(*v1alpha1.Attestor)
+
+func attestorWithConditions(c ...runtimev1alpha1.Condition) attestorModifier {
+	return func(a *v1alpha1.Attestor) { a.Status.SetConditions(c...) }
+}
+
+func attestorWithDescription(d string) attestorModifier {
+	return func(a *v1alpha1.Attestor) { a.Spec.ForProvider.Description = &d }
+}
+
+func attestorWithObservation(o v1alpha1.AttestorObservation) attestorModifier {
+	return func(a *v1alpha1.Attestor) { a.Status.AtProvider = o }
+}
+
+func attestorObj(m ...attestorModifier) *v1alpha1.Attestor {
+	a := &v1alpha1.Attestor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        attestorName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: attestorName},
+		},
+		Spec: v1alpha1.AttestorSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.AttestorParameters{
+				UserOwnedGrafeasNote: v1alpha1.UserOwnedGrafeasNote{NoteReference: note},
+			},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestAttestorObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAttestor": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotAttestor),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Empty{})
+			}),
+			mg: attestorObj(),
+			want: want{
+				mg:  attestorObj(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(attestorPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Attestor{
+					Name:        attestor,
+					Description: "builds",
+					UserOwnedGrafeasNote: &binaryauthorization.UserOwnedGrafeasNote{
+						NoteReference:                 note,
+						DelegationServiceAccountEmail: "sa@example.org",
+					},
+				})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   attestorObj(),
+			want: want{
+				mg: attestorObj(
+					attestorWithDescription("builds"),
+					attestorWithObservation(v1alpha1.AttestorObservation{Name: attestor, DelegationServiceAccountEmail: "sa@example.org"}),
+					attestorWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Attestor{Name: attestor, Description: "builds"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   attestorObj(),
+			want: want{
+				mg:  attestorObj(attestorWithDescription("builds")),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Attestor{
+					Name:                 attestor,
+					Description:          "old",
+					UserOwnedGrafeasNote: &binaryauthorization.UserOwnedGrafeasNote{NoteReference: note},
+				})
+			}),
+			mg: attestorObj(attestorWithDescription("new")),
+			want: want{
+				mg: attestorObj(
+					attestorWithDescription("new"),
+					attestorWithObservation(v1alpha1.AttestorObservation{Name: attestor}),
+					attestorWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := attestorExternal{kube: tc.kube, binauthz: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAttestorCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(attestorName, r.URL.Query().Get("attestorId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				a := &binaryauthorization.Attestor{}
+				if err := json.NewDecoder(r.Body).Decode(a); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(note, a.UserOwnedGrafeasNote.NoteReference); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(a)
+			}),
+			mg: attestorObj(),
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Empty{})
+			}),
+			mg:  attestorObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAttestor),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := attestorExternal{binauthz: s, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAttestorUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(attestorPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				a := &binaryauthorization.Attestor{}
+				if err := json.NewDecoder(r.Body).Decode(a); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff("new", a.Description); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(a)
+			}),
+			mg: attestorObj(attestorWithDescription("new")),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Empty{})
+			}),
+			mg:  attestorObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAttestor),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := attestorExternal{binauthz: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAttestorDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Empty{})
+			}),
+			mg: attestorObj(),
+			want: want{
+				mg: attestorObj(attestorWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Empty{})
+			}),
+			mg: attestorObj(),
+			want: want{
+				mg: attestorObj(attestorWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Empty{})
+			}),
+			mg: attestorObj(),
+			want: want{
+				mg:  attestorObj(attestorWithConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAttestor),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := attestorExternal{binauthz: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
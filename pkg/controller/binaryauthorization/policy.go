@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	binauthz "github.com/crossplane/provider-gcp/pkg/clients/binaryauthorization"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new Binary Authorization client"
+
+	errNotPolicy    = "managed resource is not a Binary Authorization Policy"
+	errGetPolicy    = "cannot get Binary Authorization policy"
+	errUpdatePolicy = "cannot update Binary Authorization policy"
+	errResetPolicy  = "cannot reset Binary Authorization policy to its default"
+)
+
+type newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*binaryauthorization.Service, error)
+
+// SetupPolicy adds a controller that reconciles Binary Authorization Policies.
+func SetupPolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.PolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Policy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PolicyGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&policyConnector{kube: mgr.GetClient(), newServiceFn: binaryauthorization.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type policyConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *policyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return nil, errors.New(errNotPolicy)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, binaryauthorization.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	return &policyExternal{binauthz: s, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+// A policyExternal manages the Binary Authorization policy of a project. Each
+// project always has exactly one policy, so the policy is never created or
+// deleted; it is updated, and reset to its default when the Policy is deleted.
+type policyExternal struct {
+	binauthz  *binaryauthorization.Service
+	projectID string
+}
+
+func (e *policyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicy)
+	}
+	observed, err := e.binauthz.Projects.GetPolicy(binauthz.PolicyName(e.projectID)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPolicy)
+	}
+	cr.Status.AtProvider = binauthz.GeneratePolicyObservation(*observed)
+
+	// The policy of a deleted Policy is considered gone once it has been
+	// reset to the default.
+	if meta.WasDeleted(cr) && binauthz.IsDefaultPolicy(*observed) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: binauthz.IsPolicyUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+// Create is never called by the managed reconciler, because a project's
+// policy always exists. It updates the policy nonetheless.
+func (e *policyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	_, err := e.Update(ctx, mg)
+	return managed.ExternalCreation{}, err
+}
+
+func (e *policyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicy)
+	}
+	name := binauthz.PolicyName(e.projectID)
+	_, err := e.binauthz.Projects.UpdatePolicy(name, binauthz.GeneratePolicy(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicy)
+}
+
+// Delete resets the policy to its default, which admits all images.
+func (e *policyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return errors.New(errNotPolicy)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.binauthz.Projects.UpdatePolicy(binauthz.PolicyName(e.projectID), binauthz.DefaultPolicy()).Context(ctx).Do()
+	return errors.Wrap(err, errResetPolicy)
+}
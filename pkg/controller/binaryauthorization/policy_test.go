@@ -0,0 +1,327 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	providerName = "gcp-provider"
+	policyPath   = "/v1/projects/" + projectID + "/policy"
+	attestor     = "projects/" + projectID + "/attestors/build"
+)
+
+var (
+	_ managed.ExternalConnecter = &policyConnector{}
+	_ managed.ExternalClient    = &policyExternal{}
+
+	errBoom = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type policyModifier func(*v1alpha1.Policy)
+
+func policyWithConditions(c ...runtimev1alpha1.Condition) policyModifier {
+	return func(p *v1alpha1.Policy) { p.Status.SetConditions(c...) }
+}
+
+func policyWithName(name string) policyModifier {
+	return func(p *v1alpha1.Policy) { p.Status.AtProvider.Name = name }
+}
+
+func policyWithDeletionTimestamp(t metav1.Time) policyModifier {
+	return func(p *v1alpha1.Policy) { p.SetDeletionTimestamp(&t) }
+}
+
+func policy(m ...policyModifier) *v1alpha1.Policy {
+	p := &v1alpha1.Policy{
+		Spec: v1alpha1.PolicySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.PolicyParameters{
+				DefaultAdmissionRule: v1alpha1.AdmissionRule{
+					EvaluationMode:        v1alpha1.EvaluationModeRequireAttestation,
+					EnforcementMode:       v1alpha1.EnforcementModeEnforcedBlockAndAuditLog,
+					RequireAttestationsBy: []string{attestor},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func defaultPolicy(w http.ResponseWriter) {
+	_ = json.NewEncoder(w).Encode(&binaryauthorization.Policy{
+		Name: "projects/" + projectID + "/policy",
+		DefaultAdmissionRule: &binaryauthorization.AdmissionRule{
+			EvaluationMode:  v1alpha1.EvaluationModeAlwaysAllow,
+			EnforcementMode: v1alpha1.EnforcementModeEnforcedBlockAndAuditLog,
+		},
+	})
+}
+
+func TestPolicyObserve(t *testing.T) {
+	deleted := metav1.Now()
+
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicy": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotPolicy),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(policyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Policy{
+					Name: "projects/" + projectID + "/policy",
+					DefaultAdmissionRule: &binaryauthorization.AdmissionRule{
+						EvaluationMode:        v1alpha1.EvaluationModeRequireAttestation,
+						EnforcementMode:       v1alpha1.EnforcementModeEnforcedBlockAndAuditLog,
+						RequireAttestationsBy: []string{attestor},
+					},
+				})
+			}),
+			mg: policy(),
+			want: want{
+				mg: policy(
+					policyWithName("projects/"+projectID+"/policy"),
+					policyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				defaultPolicy(w)
+			}),
+			mg: policy(),
+			want: want{
+				mg: policy(
+					policyWithName("projects/"+projectID+"/policy"),
+					policyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"DeletedAndReset": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				defaultPolicy(w)
+			}),
+			mg: policy(policyWithDeletionTimestamp(deleted)),
+			want: want{
+				mg: policy(
+					policyWithDeletionTimestamp(deleted),
+					policyWithName("projects/"+projectID+"/policy")),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Empty{})
+			}),
+			mg: policy(),
+			want: want{
+				mg:  policy(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyExternal{binauthz: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				p := &binaryauthorization.Policy{}
+				if err := json.NewDecoder(r.Body).Decode(p); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				want := []string{attestor}
+				if diff := cmp.Diff(want, p.DefaultAdmissionRule.RequireAttestationsBy); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			mg: policy(),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Empty{})
+			}),
+			mg:  policy(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdatePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyExternal{binauthz: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Reset": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(policyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				p := &binaryauthorization.Policy{}
+				if err := json.NewDecoder(r.Body).Decode(p); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				want := &binaryauthorization.AdmissionRule{
+					EvaluationMode:  v1alpha1.EvaluationModeAlwaysAllow,
+					EnforcementMode: v1alpha1.EnforcementModeEnforcedBlockAndAuditLog,
+				}
+				if diff := cmp.Diff(want, p.DefaultAdmissionRule); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			mg: policy(),
+			want: want{
+				mg: policy(policyWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ResetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&binaryauthorization.Empty{})
+			}),
+			mg: policy(),
+			want: want{
+				mg:  policy(policyWithConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errResetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyExternal{binauthz: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,36 +22,36 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
+	"google.golang.org/api/option"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
-	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-gcp/apis/cache/v1beta1"
-	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	"github.com/crossplane/provider-gcp/apis/connection"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/cloudmemorystore"
 )
 
 // Error strings.
 const (
-	errGetProvider       = "cannot get Provider"
-	errProviderSecretNil = "cannot find Secret reference on Provider"
-	errGetProviderSecret = "cannot get Provider Secret"
-	errNewClient         = "cannot create new CloudMemorystore client"
-	errNotInstance       = "managed resource is not an CloudMemorystore instance"
-	errUpdateCR          = "cannot update CloudMemorystore custom resource"
-	errGetInstance       = "cannot get CloudMemorystore instance"
-	errCreateInstance    = "cannot create CloudMemorystore instance"
-	errUpdateInstance    = "cannot update CloudMemorystore instance"
-	errDeleteInstance    = "cannot delete CloudMemorystore instance"
-	errCheckUpToDate     = "cannot determine if CloudMemorystore instance is up to date"
+	errNewClient      = "cannot create new CloudMemorystore client"
+	errNewAuthClient  = "cannot create new CloudMemorystore AUTH client"
+	errNotInstance    = "managed resource is not an CloudMemorystore instance"
+	errUpdateCR       = "cannot update CloudMemorystore custom resource"
+	errGetInstance    = "cannot get CloudMemorystore instance"
+	errCreateInstance = "cannot create CloudMemorystore instance"
+	errUpdateInstance = "cannot update CloudMemorystore instance"
+	errDeleteInstance = "cannot delete CloudMemorystore instance"
+	errCheckUpToDate  = "cannot determine if CloudMemorystore instance is up to date"
+	errGetAuthEnabled = "cannot determine if AUTH is enabled for CloudMemorystore instance"
+	errGetAuthString  = "cannot get AUTH string of CloudMemorystore instance"
+	errUpdateAuth     = "cannot update AUTH of CloudMemorystore instance"
 )
 
 // SetupCloudMemorystoreInstance adds a controller that reconciles
@@ -64,14 +64,15 @@ func SetupCloudMemorystoreInstance(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1beta1.CloudMemorystoreInstance{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CloudMemorystoreInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newCMS: cloudmemorystore.NewClient}),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&connecter{client: mgr.GetClient(), newCMS: cloudmemorystore.NewClient, newAuth: cloudmemorystore.NewAuthClient})),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
 type connecter struct {
-	client client.Client
-	newCMS func(ctx context.Context, creds []byte) (cloudmemorystore.Client, error)
+	client  client.Client
+	newCMS  func(ctx context.Context, opts ...option.ClientOption) (cloudmemorystore.Client, error)
+	newAuth func(ctx context.Context, opts ...option.ClientOption) (cloudmemorystore.AuthClient, error)
 }
 
 func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -80,28 +81,37 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.New(errNotInstance)
 	}
 
-	p := &gcpv1alpha3.Provider{}
-	if err := c.client.Get(ctx, meta.NamespacedNameOf(i.Spec.ProviderReference), p); err != nil {
-		return nil, errors.Wrap(err, errGetProvider)
+	p, creds, err := gcp.ProviderCredentials(ctx, c.client, i.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
 	}
 
-	if p.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretNil)
+	// The Cloud Memorystore client uses gRPC, so it is built from the
+	// credentials directly rather than from an HTTP client.
+	opts := []option.ClientOption{gcp.UserAgentOption(p.Spec.HTTPClient)}
+	if creds != nil {
+		opts = append(opts, option.WithCredentialsJSON(creds))
 	}
-
-	s := &corev1.Secret{}
-	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
-	if err := c.client.Get(ctx, n, s); err != nil {
-		return nil, errors.Wrap(err, errGetProviderSecret)
+	authOpts, err := gcp.CredentialsClientOptions(ctx, creds, p.Spec.HTTPClient)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewAuthClient)
 	}
 
-	cms, err := c.newCMS(ctx, s.Data[p.Spec.CredentialsSecretRef.Key])
-	return &external{cms: cms, projectID: p.Spec.ProjectID, kube: c.client}, errors.Wrap(err, errNewClient)
+	cms, err := c.newCMS(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	auth, err := c.newAuth(ctx, authOpts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewAuthClient)
+	}
+	return &external{cms: cms, auth: auth, projectID: p.Spec.ProjectID, kube: c.client}, nil
 }
 
 type external struct {
 	kube      client.Client
 	cms       cloudmemorystore.Client
+	auth      cloudmemorystore.AuthClient
 	projectID string
 }
 
@@ -127,12 +137,26 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 	cr.Status.AtProvider = cloudmemorystore.GenerateObservation(*existing)
+	if cr.Spec.ForProvider.AuthEnabled != nil {
+		enabled, err := e.auth.GetAuthEnabled(ctx, id.Name())
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetAuthEnabled)
+		}
+		cr.Status.AtProvider.AuthEnabled = &enabled
+	}
 	conn := managed.ConnectionDetails{}
 	switch cr.Status.AtProvider.State {
 	case cloudmemorystore.StateReady:
 		cr.Status.SetConditions(runtimev1alpha1.Available())
-		conn[runtimev1alpha1.ResourceCredentialsSecretEndpointKey] = []byte(cr.Status.AtProvider.Host)
-		conn[runtimev1alpha1.ResourceCredentialsSecretPortKey] = []byte(strconv.Itoa(int(cr.Status.AtProvider.Port)))
+		conn[connection.KeyEndpoint] = []byte(cr.Status.AtProvider.Host)
+		conn[connection.KeyPort] = []byte(strconv.Itoa(int(cr.Status.AtProvider.Port)))
+		if gcp.BoolValue(cr.Status.AtProvider.AuthEnabled) {
+			auth, err := e.auth.GetAuthString(ctx, id.Name())
+			if err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errGetAuthString)
+			}
+			conn[connection.KeyPassword] = []byte(auth)
+		}
 		resource.SetBindable(cr)
 	case cloudmemorystore.StateCreating:
 		cr.Status.SetConditions(runtimev1alpha1.Creating())
@@ -147,6 +171,12 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	}
 
+	// Scaling memory and changing AUTH are long running operations. The
+	// instance is not up to date until they are done, even if it already
+	// reports the desired values.
+	u = u && cloudmemorystore.IsAuthUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider.AuthEnabled)
+	u = u && cr.Status.AtProvider.State != cloudmemorystore.StateUpdating
+
 	o := managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  u,
@@ -175,7 +205,16 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotInstance)
 	}
+	// An instance accepts no updates while an update is in progress. We'll
+	// send the next one once Observe finds it is done.
+	if i.Status.AtProvider.State == cloudmemorystore.StateUpdating {
+		return managed.ExternalUpdate{}, nil
+	}
 	id := cloudmemorystore.NewInstanceID(e.projectID, i)
+	if !cloudmemorystore.IsAuthUpToDate(i.Spec.ForProvider, i.Status.AtProvider.AuthEnabled) {
+		err := e.auth.SetAuthEnabled(ctx, id.Name(), *i.Spec.ForProvider.AuthEnabled)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAuth)
+	}
 	_, err := e.cms.UpdateInstance(ctx, cloudmemorystore.NewUpdateInstanceRequest(id, i))
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	gax "github.com/googleapis/gax-go"
 	"github.com/pkg/errors"
+	"google.golang.org/api/option"
 	redisv1pb "google.golang.org/genproto/googleapis/cloud/redis/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -41,6 +42,7 @@ import (
 
 	"github.com/crossplane/provider-gcp/apis/cache/v1beta1"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/cloudmemorystore"
 	"github.com/crossplane/provider-gcp/pkg/clients/cloudmemorystore/fake"
 )
@@ -67,6 +69,7 @@ var (
 	authorizedNetwork = "default"
 
 	errorBoom    = errors.New("boom")
+	authString   = "sosecret"
 	redisConfigs = map[string]string{"cool": "socool"}
 )
 
@@ -100,6 +103,13 @@ func withPort(p int) instanceModifier {
 	return func(i *v1beta1.CloudMemorystoreInstance) { i.Status.AtProvider.Port = int32(p) }
 }
 
+func withAuthEnabled(spec, observed *bool) instanceModifier {
+	return func(i *v1beta1.CloudMemorystoreInstance) {
+		i.Spec.ForProvider.AuthEnabled = spec
+		i.Status.AtProvider.AuthEnabled = observed
+	}
+}
+
 func withTier(tier string) instanceModifier {
 	return func(i *v1beta1.CloudMemorystoreInstance) { i.Spec.ForProvider.Tier = tier }
 }
@@ -189,7 +199,12 @@ func TestConnect(t *testing.T) {
 					}
 					return nil
 				}},
-				newCMS: func(_ context.Context, _ []byte) (cloudmemorystore.Client, error) { return nil, nil },
+				newCMS: func(_ context.Context, _ ...option.ClientOption) (cloudmemorystore.Client, error) {
+					return nil, nil
+				},
+				newAuth: func(_ context.Context, _ ...option.ClientOption) (cloudmemorystore.AuthClient, error) {
+					return nil, nil
+				},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -199,6 +214,30 @@ func TestConnect(t *testing.T) {
 				err: nil,
 			},
 		},
+		"ConnectedWithInjectedIdentity": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						p := provider
+						p.Spec.CredentialsSource = gcpv1alpha3.CredentialsSourceInjectedIdentity
+						p.SetCredentialsSecretReference(nil)
+						*obj.(*gcpv1alpha3.Provider) = p
+					default:
+						return errorBoom
+					}
+					return nil
+				}},
+				newCMS: func(_ context.Context, _ ...option.ClientOption) (cloudmemorystore.Client, error) {
+					return nil, nil
+				},
+				newAuth: func(_ context.Context, _ ...option.ClientOption) (cloudmemorystore.AuthClient, error) {
+					return nil, nil
+				},
+			},
+			args: args{ctx: context.Background(), mg: instance()},
+			want: want{err: nil},
+		},
 		"NotCloudMemorystoreInstance": {
 			conn: &connecter{},
 			args: args{ctx: context.Background(), mg: &strange{}},
@@ -211,7 +250,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{ctx: context.Background(), mg: instance()},
-			want: want{err: errors.Wrap(errorBoom, errGetProvider)},
+			want: want{err: errors.Wrap(errorBoom, "cannot get Provider")},
 		},
 		"FailedToGetProviderSecret": {
 			conn: &connecter{
@@ -226,7 +265,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{ctx: context.Background(), mg: instance()},
-			want: want{err: errors.Wrap(errorBoom, errGetProviderSecret)},
+			want: want{err: errors.Wrap(errorBoom, "cannot get Provider Secret")},
 		},
 		"ProviderSecretNil": {
 			conn: &connecter{
@@ -243,7 +282,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{ctx: context.Background(), mg: instance()},
-			want: want{err: errors.New(errProviderSecretNil)},
+			want: want{err: errors.New("cannot find Secret reference on Provider")},
 		},
 		"FailedToCreateCloudMemorystoreClient": {
 			conn: &connecter{
@@ -256,11 +295,34 @@ func TestConnect(t *testing.T) {
 					}
 					return nil
 				}},
-				newCMS: func(_ context.Context, _ []byte) (cloudmemorystore.Client, error) { return nil, errorBoom },
+				newCMS: func(_ context.Context, _ ...option.ClientOption) (cloudmemorystore.Client, error) {
+					return nil, errorBoom
+				},
 			},
 			args: args{ctx: context.Background(), mg: instance()},
 			want: want{err: errors.Wrap(errorBoom, errNewClient)},
 		},
+		"FailedToCreateAuthClient": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						*obj.(*gcpv1alpha3.Provider) = provider
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = secret
+					}
+					return nil
+				}},
+				newCMS: func(_ context.Context, _ ...option.ClientOption) (cloudmemorystore.Client, error) {
+					return nil, nil
+				},
+				newAuth: func(_ context.Context, _ ...option.ClientOption) (cloudmemorystore.AuthClient, error) {
+					return nil, errorBoom
+				},
+			},
+			args: args{ctx: context.Background(), mg: instance()},
+			want: want{err: errors.Wrap(errorBoom, errNewAuthClient)},
+		},
 	}
 
 	for name, tc := range cases {
@@ -326,6 +388,142 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ObservedInstanceAuthEnabled": {
+			client: &external{cms: &fake.MockClient{
+				MockGetInstance: func(_ context.Context, _ *redisv1pb.GetInstanceRequest, _ ...gax.CallOption) (*redisv1pb.Instance, error) {
+					return &redisv1pb.Instance{
+						State:        redisv1pb.Instance_READY,
+						Host:         host,
+						Port:         port,
+						Name:         qualifiedName,
+						MemorySizeGb: memorySizeGB,
+						RedisConfigs: redisConfigs,
+					}, nil
+				}},
+				auth: &fake.MockAuthClient{
+					MockGetAuthEnabled: func(_ context.Context, name string) (bool, error) { return true, nil },
+					MockGetAuthString:  func(_ context.Context, name string) (string, error) { return authString, nil },
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  instance(withAuthEnabled(gcp.BoolPtr(true), nil)),
+			},
+			want: want{
+				mg: instance(
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+					withState(cloudmemorystore.StateReady),
+					withHost(host),
+					withPort(port),
+					withFullName(qualifiedName),
+					withAuthEnabled(gcp.BoolPtr(true), gcp.BoolPtr(true)),
+					withTier(redisv1pb.Instance_TIER_UNSPECIFIED.String())),
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(host),
+						runtimev1alpha1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+						runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(authString),
+					},
+				},
+			},
+		},
+		"ObservedInstanceAuthNotUpToDate": {
+			client: &external{cms: &fake.MockClient{
+				MockGetInstance: func(_ context.Context, _ *redisv1pb.GetInstanceRequest, _ ...gax.CallOption) (*redisv1pb.Instance, error) {
+					return &redisv1pb.Instance{
+						State: redisv1pb.Instance_CREATING,
+						Name:  qualifiedName,
+					}, nil
+				}},
+				auth: &fake.MockAuthClient{
+					MockGetAuthEnabled: func(_ context.Context, name string) (bool, error) { return false, nil },
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  instance(withAuthEnabled(gcp.BoolPtr(true), nil)),
+			},
+			want: want{
+				mg: instance(
+					withConditions(runtimev1alpha1.Creating()),
+					withState(cloudmemorystore.StateCreating),
+					withFullName(qualifiedName),
+					withAuthEnabled(gcp.BoolPtr(true), gcp.BoolPtr(false)),
+					withTier(redisv1pb.Instance_TIER_UNSPECIFIED.String())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"ObservedInstanceUpdating": {
+			client: &external{cms: &fake.MockClient{
+				MockGetInstance: func(_ context.Context, _ *redisv1pb.GetInstanceRequest, _ ...gax.CallOption) (*redisv1pb.Instance, error) {
+					return &redisv1pb.Instance{
+						State:        redisv1pb.Instance_UPDATING,
+						Name:         qualifiedName,
+						MemorySizeGb: memorySizeGB,
+						RedisConfigs: redisConfigs,
+					}, nil
+				}},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  instance(),
+			},
+			want: want{
+				mg: instance(
+					withConditions(runtimev1alpha1.Unavailable()),
+					withState(cloudmemorystore.StateUpdating),
+					withFullName(qualifiedName),
+					withTier(redisv1pb.Instance_TIER_UNSPECIFIED.String())),
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"FailedToGetAuthEnabled": {
+			client: &external{cms: &fake.MockClient{
+				MockGetInstance: func(_ context.Context, _ *redisv1pb.GetInstanceRequest, _ ...gax.CallOption) (*redisv1pb.Instance, error) {
+					return &redisv1pb.Instance{
+						State: redisv1pb.Instance_READY,
+						Name:  qualifiedName,
+					}, nil
+				}},
+				auth: &fake.MockAuthClient{
+					MockGetAuthEnabled: func(_ context.Context, name string) (bool, error) { return false, errorBoom },
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  instance(withAuthEnabled(gcp.BoolPtr(true), nil)),
+			},
+			want: want{
+				mg: instance(
+					withFullName(qualifiedName),
+					withState(cloudmemorystore.StateReady),
+					withAuthEnabled(gcp.BoolPtr(true), nil),
+					withTier(redisv1pb.Instance_TIER_UNSPECIFIED.String())),
+				err: errors.Wrap(errorBoom, errGetAuthEnabled),
+			},
+		},
 		"ObservedInstanceCreating": {
 			client: &external{cms: &fake.MockClient{
 				MockGetInstance: func(_ context.Context, _ *redisv1pb.GetInstanceRequest, _ ...gax.CallOption) (*redisv1pb.Instance, error) {
@@ -552,6 +750,56 @@ func TestUpdate(t *testing.T) {
 				mg: instance(withConditions()),
 			},
 		},
+		"UpdateInProgress": {
+			client: &external{cms: &fake.MockClient{}},
+			args: args{
+				ctx: context.Background(),
+				mg:  instance(withState(cloudmemorystore.StateUpdating)),
+			},
+			want: want{
+				mg: instance(withState(cloudmemorystore.StateUpdating)),
+			},
+		},
+		"EnabledAuth": {
+			client: &external{
+				projectID: project,
+				cms:       &fake.MockClient{},
+				auth: &fake.MockAuthClient{
+					MockSetAuthEnabled: func(_ context.Context, name string, enabled bool) error {
+						if diff := cmp.Diff(cloudmemorystore.NewInstanceID(project, instance()).Name(), name); diff != "" {
+							t.Errorf("SetAuthEnabled(...): -want name, +got name:\n%s", diff)
+						}
+						if !enabled {
+							t.Errorf("SetAuthEnabled(...): want enabled")
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  instance(withState(cloudmemorystore.StateReady), withAuthEnabled(gcp.BoolPtr(true), gcp.BoolPtr(false))),
+			},
+			want: want{
+				mg: instance(withState(cloudmemorystore.StateReady), withAuthEnabled(gcp.BoolPtr(true), gcp.BoolPtr(false))),
+			},
+		},
+		"FailedToUpdateAuth": {
+			client: &external{
+				cms: &fake.MockClient{},
+				auth: &fake.MockAuthClient{
+					MockSetAuthEnabled: func(_ context.Context, _ string, _ bool) error { return errorBoom },
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				mg:  instance(withAuthEnabled(gcp.BoolPtr(false), gcp.BoolPtr(true))),
+			},
+			want: want{
+				mg:  instance(withAuthEnabled(gcp.BoolPtr(false), gcp.BoolPtr(true))),
+				err: errors.Wrap(errorBoom, errUpdateAuth),
+			},
+		},
 		"NotCloudMemorystoreInstance": {
 			client: &external{},
 			args: args{
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	composer "google.golang.org/api/composer/v1beta1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/connection"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cc "github.com/crossplane/provider-gcp/pkg/clients/composer"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new Cloud Composer client"
+
+	errNotEnvironment    = "managed resource is not a Cloud Composer Environment"
+	errUpdateCR          = "cannot update Cloud Composer Environment custom resource"
+	errGetEnvironment    = "cannot get Cloud Composer environment"
+	errCreateEnvironment = "cannot create Cloud Composer environment"
+	errUpdateEnvironment = "cannot update Cloud Composer environment"
+	errDeleteEnvironment = "cannot delete Cloud Composer environment"
+)
+
+// SetupEnvironment adds a controller that reconciles Environments.
+func SetupEnvironment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.EnvironmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Environment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EnvironmentGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&environmentConnector{kube: mgr.GetClient(), newServiceFn: composer.NewService})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type environmentConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*composer.Service, error)
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *environmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return nil, errors.New(errNotEnvironment)
+	}
+
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, composer.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &environmentExternal{kube: c.kube, environments: svc.Projects.Locations.Environments, projectID: p.Spec.ProjectID}, nil
+}
+
+type environmentExternal struct {
+	kube         client.Client
+	environments *composer.ProjectsLocationsEnvironmentsService
+	projectID    string
+}
+
+func (e *environmentExternal) name(cr *v1alpha1.Environment) string {
+	return cc.EnvironmentName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+}
+
+func (e *environmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEnvironment)
+	}
+	observed, err := e.environments.Get(e.name(cr)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetEnvironment)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	cc.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = cc.GenerateObservation(*observed)
+	setConditions(cr, observed.State)
+
+	conn := managed.ConnectionDetails{}
+	if o := cr.Status.AtProvider; o.AirflowURI != "" {
+		conn[connection.KeyEndpoint] = []byte(o.AirflowURI)
+		conn[v1alpha1.ConnectionAirflowURIKey] = []byte(o.AirflowURI)
+	}
+	if o := cr.Status.AtProvider; o.DAGGCSPrefix != "" {
+		conn[v1alpha1.ConnectionDAGGCSPrefixKey] = []byte(o.DAGGCSPrefix)
+	}
+
+	// An environment can only be patched while it is running; any other
+	// state is either transient or requires intervention.
+	upToDate := observed.State != v1alpha1.StateRunning || cc.IsUpToDate(cr.Spec.ForProvider, *observed)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *environmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEnvironment)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	env := cc.GenerateEnvironment(e.name(cr), cr.Spec.ForProvider)
+	_, err := e.environments.Create(cc.LocationName(e.projectID, cr.Spec.ForProvider.Location), env).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateEnvironment)
+}
+
+// Update patches the first kind of change the environment needs. Each patch
+// is a long running operation during which the environment is updating;
+// further changes are patched once it is running again.
+func (e *environmentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEnvironment)
+	}
+	observed, err := e.environments.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetEnvironment)
+	}
+	mask := cc.UpdateMask(cr.Spec.ForProvider, *observed)
+	if len(mask) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	env := cc.GenerateEnvironment(e.name(cr), cr.Spec.ForProvider)
+	_, err = e.environments.Patch(e.name(cr), env).UpdateMask(strings.Join(mask, ",")).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEnvironment)
+}
+
+func (e *environmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return errors.New(errNotEnvironment)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.environments.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteEnvironment)
+}
+
+// setConditions sets the Ready condition of the supplied managed resource per
+// the supplied state of its environment. Updating environments continue to
+// run Airflow and are thus considered available.
+func setConditions(mg resource.Managed, state string) {
+	switch state {
+	case v1alpha1.StateRunning, v1alpha1.StateUpdating:
+		mg.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.StateCreating:
+		mg.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.StateDeleting:
+		mg.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		mg.SetConditions(runtimev1alpha1.Unavailable())
+	}
+}
@@ -0,0 +1,462 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	composer "google.golang.org/api/composer/v1beta1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cc "github.com/crossplane/provider-gcp/pkg/clients/composer"
+)
+
+const (
+	projectID       = "myproject-id-1234"
+	providerName    = "gcp-provider"
+	testEnvironment = "test-environment"
+	testLocation    = "us-central1"
+	testAirflowURI  = "https://example-tp.appspot.com"
+	testDAGPrefix   = "gs://us-central1-example-bucket/dags"
+)
+
+var (
+	_ managed.ExternalConnecter = &environmentConnector{}
+	_ managed.ExternalClient    = &environmentExternal{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type environmentModifier func(*v1alpha1.Environment)
+
+func withConditions(c ...runtimev1alpha1.Condition) environmentModifier {
+	return func(e *v1alpha1.Environment) { e.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.EnvironmentObservation) environmentModifier {
+	return func(e *v1alpha1.Environment) { e.Status.AtProvider = o }
+}
+
+func withNodeCount(n int64) environmentModifier {
+	return func(e *v1alpha1.Environment) { e.Spec.ForProvider.NodeCount = gcp.Int64Ptr(n) }
+}
+
+func environment(m ...environmentModifier) *v1alpha1.Environment {
+	e := &v1alpha1.Environment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testEnvironment,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: testEnvironment},
+		},
+		Spec: v1alpha1.EnvironmentSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.EnvironmentParameters{
+				Location: testLocation,
+				Labels:   map[string]string{"team": "data"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func environmentName() string {
+	return cc.EnvironmentName(projectID, testLocation, testEnvironment)
+}
+
+func environmentPath() string {
+	return "/v1beta1/" + environmentName()
+}
+
+func observed(state string, nodeCount int64) *composer.Environment {
+	return &composer.Environment{
+		Name:   environmentName(),
+		State:  state,
+		Labels: map[string]string{"team": "data"},
+		Config: &composer.EnvironmentConfig{
+			NodeCount:    nodeCount,
+			AirflowUri:   testAirflowURI,
+			DagGcsPrefix: testDAGPrefix,
+		},
+	}
+}
+
+func observation(state string) v1alpha1.EnvironmentObservation {
+	return v1alpha1.EnvironmentObservation{
+		Name:         environmentName(),
+		State:        state,
+		AirflowURI:   testAirflowURI,
+		DAGGCSPrefix: testDAGPrefix,
+	}
+}
+
+func connectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(testAirflowURI),
+		v1alpha1.ConnectionAirflowURIKey:                     []byte(testAirflowURI),
+		v1alpha1.ConnectionDAGGCSPrefixKey:                   []byte(testDAGPrefix),
+	}
+}
+
+func newExternal(server *httptest.Server, kube *test.MockClient) *environmentExternal {
+	s, _ := composer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return &environmentExternal{kube: kube, environments: s.Projects.Locations.Environments, projectID: projectID}
+}
+
+func TestSetConditions(t *testing.T) {
+	cases := map[string]runtimev1alpha1.Condition{
+		v1alpha1.StateRunning:  runtimev1alpha1.Available(),
+		v1alpha1.StateUpdating: runtimev1alpha1.Available(),
+		v1alpha1.StateCreating: runtimev1alpha1.Creating(),
+		v1alpha1.StateDeleting: runtimev1alpha1.Deleting(),
+		v1alpha1.StateError:    runtimev1alpha1.Unavailable(),
+	}
+
+	for state, want := range cases {
+		t.Run(state, func(t *testing.T) {
+			mg := &fake.Managed{}
+			setConditions(mg, state)
+			if diff := cmp.Diff(want, mg.GetCondition(runtimev1alpha1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("setConditions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotEnvironment": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+			mg:      &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotEnvironment),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(environmentPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&composer.Environment{})
+			}),
+			mg: environment(),
+			want: want{
+				mg: environment(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&composer.Environment{})
+			}),
+			mg: environment(),
+			want: want{
+				mg:  environment(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetEnvironment),
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observed(v1alpha1.StateRunning, 3))
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   environment(),
+			want: want{
+				mg: environment(
+					withNodeCount(3),
+					withObservation(observation(v1alpha1.StateRunning)),
+					withConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observed(v1alpha1.StateRunning, 3))
+			}),
+			mg: environment(withNodeCount(5)),
+			want: want{
+				mg: environment(
+					withNodeCount(5),
+					withObservation(observation(v1alpha1.StateRunning)),
+					withConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"NotUpdatedWhileUpdating": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observed(v1alpha1.StateUpdating, 3))
+			}),
+			mg: environment(withNodeCount(5)),
+			want: want{
+				mg: environment(
+					withNodeCount(5),
+					withObservation(observation(v1alpha1.StateUpdating)),
+					withConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connectionDetails()},
+			},
+		},
+		"UpdateCRFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observed(v1alpha1.StateCreating, 3))
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errors.New("boom"))},
+			mg:   environment(),
+			want: want{
+				mg:  environment(withNodeCount(3)),
+				err: errors.Wrap(errors.New("boom"), errUpdateCR),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			obs, err := newExternal(server, tc.kube).Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1beta1/"+cc.LocationName(projectID, testLocation)+"/environments", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &composer.Environment{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				if diff := cmp.Diff(environmentName(), got.Name); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&composer.Operation{})
+			}),
+			mg: environment(),
+			want: want{
+				mg: environment(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&composer.Operation{})
+			}),
+			mg: environment(),
+			want: want{
+				mg:  environment(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateEnvironment),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			_, err := newExternal(server, nil).Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"PatchesNodeCount": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observed(v1alpha1.StateRunning, 3))
+				case http.MethodPatch:
+					if diff := cmp.Diff("config.nodeCount", r.URL.Query().Get("updateMask")); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&composer.Operation{})
+				}
+			}),
+			mg: environment(withNodeCount(5)),
+		},
+		"NothingToPatch": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method != http.MethodGet {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observed(v1alpha1.StateRunning, 3))
+			}),
+			mg: environment(withNodeCount(3)),
+		},
+		"PatchFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observed(v1alpha1.StateRunning, 3))
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&composer.Operation{})
+			}),
+			mg:   environment(withNodeCount(5)),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateEnvironment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			_, err := newExternal(server, nil).Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&composer.Operation{})
+			}),
+			mg: environment(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&composer.Operation{})
+			}),
+			mg: environment(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&composer.Operation{})
+			}),
+			mg:   environment(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteEnvironment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			err := newExternal(server, nil).Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/autoscaler"
+)
+
+// Error strings.
+const (
+	errNotAutoscaler           = "managed resource is not an Autoscaler resource"
+	errManagedAutoscalerUpdate = "cannot update Autoscaler managed resource"
+
+	errAutoscalerLocation = "cannot determine Autoscaler location"
+	errGetAutoscaler      = "cannot get GCP Autoscaler"
+	errCreateAutoscaler   = "cannot create GCP Autoscaler"
+	errUpdateAutoscaler   = "cannot update GCP Autoscaler"
+	errDeleteAutoscaler   = "cannot delete GCP Autoscaler"
+	errUpToDateAutoscaler = "cannot determine whether GCP Autoscaler is up to date"
+)
+
+// SetupAutoscaler adds a controller that reconciles Autoscaler managed
+// resources.
+func SetupAutoscaler(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.AutoscalerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Autoscaler{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AutoscalerGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&autoscalerConnector{kube: mgr.GetClient()})),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type autoscalerConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *autoscalerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return nil, errors.New(errNotAutoscaler)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &autoscalerExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID}, nil
+}
+
+type autoscalerExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+}
+
+func (e *autoscalerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAutoscaler)
+	}
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAutoscaler)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	autoscaler.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedAutoscalerUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = autoscaler.GenerateAutoscalerObservation(*observed)
+	switch observed.Status {
+	case v1alpha1.AutoscalerStatusActive:
+		cr.Status.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.AutoscalerStatusPending:
+		cr.Status.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha1.AutoscalerStatusDeleting:
+		cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.Status.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	u, err := autoscaler.IsUpToDate(cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateAutoscaler)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: u,
+	}, nil
+}
+
+func (e *autoscalerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAutoscaler)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	a, err := autoscaler.GenerateAutoscaler(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAutoscaler)
+	}
+	location, regional, err := autoscaler.Location(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errAutoscalerLocation)
+	}
+	if regional {
+		_, err = e.RegionAutoscalers.Insert(e.projectID, location, a).Context(ctx).Do()
+	} else {
+		_, err = e.Autoscalers.Insert(e.projectID, location, a).Context(ctx).Do()
+	}
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAutoscaler)
+}
+
+func (e *autoscalerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAutoscaler)
+	}
+	name := meta.GetExternalName(cr)
+	a, err := autoscaler.GenerateAutoscaler(name, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAutoscaler)
+	}
+	location, regional, err := autoscaler.Location(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAutoscalerLocation)
+	}
+	if regional {
+		_, err = e.RegionAutoscalers.Patch(e.projectID, location, a).Autoscaler(name).Context(ctx).Do()
+	} else {
+		_, err = e.Autoscalers.Patch(e.projectID, location, a).Autoscaler(name).Context(ctx).Do()
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAutoscaler)
+}
+
+func (e *autoscalerExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Autoscaler)
+	if !ok {
+		return errors.New(errNotAutoscaler)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	location, regional, err := autoscaler.Location(cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errAutoscalerLocation)
+	}
+	if regional {
+		_, err = e.RegionAutoscalers.Delete(e.projectID, location, meta.GetExternalName(cr)).Context(ctx).Do()
+	} else {
+		_, err = e.Autoscalers.Delete(e.projectID, location, meta.GetExternalName(cr)).Context(ctx).Do()
+	}
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAutoscaler)
+}
+
+// get returns the zonal or regional autoscaler represented by the supplied
+// Autoscaler.
+func (e *autoscalerExternal) get(ctx context.Context, cr *v1alpha1.Autoscaler) (*googlecompute.Autoscaler, error) {
+	location, regional, err := autoscaler.Location(cr.Spec.ForProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, errAutoscalerLocation)
+	}
+	var a *googlecompute.Autoscaler
+	if regional {
+		a, err = e.RegionAutoscalers.Get(e.projectID, location, meta.GetExternalName(cr)).Context(ctx).Do()
+	} else {
+		a, err = e.Autoscalers.Get(e.projectID, location, meta.GetExternalName(cr)).Context(ctx).Do()
+	}
+	return a, err
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/autoscaler"
+)
+
+const (
+	testAutoscalerName          = "test-autoscaler"
+	testZonalAutoscalersPath    = "/" + projectID + "/zones/us-central1-a/autoscalers"
+	testZonalAutoscalerPath     = testZonalAutoscalersPath + "/" + testAutoscalerName
+	testRegionalAutoscalersPath = "/" + projectID + "/regions/us-central1/autoscalers"
+	testRegionalAutoscalerPath  = testRegionalAutoscalersPath + "/" + testAutoscalerName
+)
+
+var _ managed.ExternalConnecter = &autoscalerConnector{}
+var _ managed.ExternalClient = &autoscalerExternal{}
+
+type autoscalerModifier func(*v1alpha1.Autoscaler)
+
+func autoscalerWithConditions(c ...runtimev1alpha1.Condition) autoscalerModifier {
+	return func(i *v1alpha1.Autoscaler) { i.Status.SetConditions(c...) }
+}
+
+func autoscalerWithRegion(r string) autoscalerModifier {
+	return func(i *v1alpha1.Autoscaler) {
+		i.Spec.ForProvider.Zone = nil
+		i.Spec.ForProvider.Region = &r
+	}
+}
+
+func autoscalerWithMaxNumReplicas(n int64) autoscalerModifier {
+	return func(i *v1alpha1.Autoscaler) { i.Spec.ForProvider.AutoscalingPolicy.MaxNumReplicas = n }
+}
+
+func autoscalerWithObservation(o v1alpha1.AutoscalerObservation) autoscalerModifier {
+	return func(i *v1alpha1.Autoscaler) { i.Status.AtProvider = o }
+}
+
+func autoscalerObj(im ...autoscalerModifier) *v1alpha1.Autoscaler {
+	i := &v1alpha1.Autoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testAutoscalerName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testAutoscalerName,
+			},
+		},
+		Spec: v1alpha1.AutoscalerSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.AutoscalerParameters{
+				Zone:        gcp.StringPtr("us-central1-a"),
+				Description: gcp.StringPtr("scales web"),
+				Target:      "zones/us-central1-a/instanceGroupManagers/web",
+				AutoscalingPolicy: v1alpha1.AutoscalingPolicy{
+					MinNumReplicas:    gcp.Int64Ptr(1),
+					MaxNumReplicas:    5,
+					CoolDownPeriodSec: gcp.Int64Ptr(60),
+					Mode:              gcp.StringPtr("ON"),
+					CPUUtilization:    &v1alpha1.AutoscalingPolicyUtilization{UtilizationTarget: "0.6"},
+				},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestAutoscalerObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	serve := func(path, status string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = r.Body.Close()
+			if diff := cmp.Diff(path, r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			a, _ := autoscaler.GenerateAutoscaler(testAutoscalerName, autoscalerObj().Spec.ForProvider)
+			a.Status = status
+			a.RecommendedSize = 3
+			_ = json.NewEncoder(w).Encode(a)
+		})
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAutoscaler": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotAutoscaler),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Autoscaler{})
+			}),
+			mg: autoscalerObj(),
+			want: want{
+				mg: autoscalerObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Autoscaler{})
+			}),
+			mg: autoscalerObj(),
+			want: want{
+				mg:  autoscalerObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetAutoscaler),
+			},
+		},
+		"NoLocation": {
+			mg: autoscalerObj(func(i *v1alpha1.Autoscaler) { i.Spec.ForProvider.Zone = nil }),
+			want: want{
+				mg:  autoscalerObj(func(i *v1alpha1.Autoscaler) { i.Spec.ForProvider.Zone = nil }),
+				err: errors.Wrap(errors.Wrap(errors.New("exactly one of zone and region must be set"), errAutoscalerLocation), errGetAutoscaler),
+			},
+		},
+		"ZonalUpToDate": {
+			handler: serve(testZonalAutoscalerPath, v1alpha1.AutoscalerStatusActive),
+			mg:      autoscalerObj(),
+			want: want{
+				mg: autoscalerObj(
+					autoscalerWithObservation(v1alpha1.AutoscalerObservation{Status: v1alpha1.AutoscalerStatusActive, RecommendedSize: 3}),
+					autoscalerWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RegionalPending": {
+			handler: serve(testRegionalAutoscalerPath, v1alpha1.AutoscalerStatusPending),
+			mg:      autoscalerObj(autoscalerWithRegion("us-central1")),
+			want: want{
+				mg: autoscalerObj(
+					autoscalerWithRegion("us-central1"),
+					autoscalerWithObservation(v1alpha1.AutoscalerObservation{Status: v1alpha1.AutoscalerStatusPending, RecommendedSize: 3}),
+					autoscalerWithConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PolicyChanged": {
+			handler: serve(testZonalAutoscalerPath, v1alpha1.AutoscalerStatusActive),
+			mg:      autoscalerObj(autoscalerWithMaxNumReplicas(10)),
+			want: want{
+				mg: autoscalerObj(
+					autoscalerWithMaxNumReplicas(10),
+					autoscalerWithObservation(v1alpha1.AutoscalerObservation{Status: v1alpha1.AutoscalerStatusActive, RecommendedSize: 3}),
+					autoscalerWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalerUpdate(t *testing.T) {
+	patch := func(path string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = r.Body.Close()
+			if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(path, r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(testAutoscalerName, r.URL.Query().Get("autoscaler")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(&compute.Operation{})
+		})
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Zonal": {
+			handler: patch(testZonalAutoscalersPath),
+			mg:      autoscalerObj(),
+		},
+		"Regional": {
+			handler: patch(testRegionalAutoscalersPath),
+			mg:      autoscalerObj(autoscalerWithRegion("us-central1")),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:   autoscalerObj(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAutoscaler),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutoscalerDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Regional": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testRegionalAutoscalerPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: autoscalerObj(autoscalerWithRegion("us-central1")),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: autoscalerObj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := autoscalerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/firewall"
+)
+
+// Error strings.
+const (
+	errNotFirewall           = "managed resource is not a Firewall resource"
+	errManagedFirewallUpdate = "cannot update Firewall managed resource"
+
+	errGetFirewall       = "cannot get GCP Firewall"
+	errCreateFirewall    = "cannot create GCP Firewall"
+	errUpdateFirewall    = "cannot update GCP Firewall"
+	errDeleteFirewall    = "cannot delete GCP Firewall"
+	errGetFirewallOp     = "cannot get the operation that creates or updates the GCP Firewall"
+	errFirewallOpFailed  = "the operation that creates or updates the GCP Firewall has failed"
+	errFirewallImmutable = "cannot change %s of an existing GCP Firewall"
+)
+
+// SetupFirewall adds a controller that reconciles Firewall managed resources.
+func SetupFirewall(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.FirewallGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Firewall{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FirewallGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&firewallConnector{kube: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type firewallConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *firewallConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Firewall)
+	if !ok {
+		return nil, errors.New(errNotFirewall)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &firewallExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID}, nil
+}
+
+type firewallExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+}
+
+func (e *firewallExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Firewall)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFirewall)
+	}
+	op := cr.Status.AtProvider.Operation
+	observed, err := e.Firewalls.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil && (op == "" || !gcp.IsErrorNotFound(err)) {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFirewall)
+	}
+
+	// A firewall rule that is being created may not be found yet, and one
+	// that is being created or updated is not updated again until its
+	// operation is done.
+	if op != "" {
+		o, err := e.GlobalOperations.Get(e.projectID, op).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetFirewallOp)
+		}
+		gop := gcp.ComputeOperation(o)
+		if !gop.Done {
+			if observed == nil {
+				cr.Status.SetConditions(runtimev1alpha1.Creating())
+			}
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+		cr.Status.AtProvider.Operation = ""
+		if gop.Error != "" {
+			return managed.ExternalObservation{}, errors.Wrap(errors.New(gop.Error), errFirewallOpFailed)
+		}
+		if observed == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	firewall.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedFirewallUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = firewall.GenerateFirewallObservation(*observed)
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: firewall.IsUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *firewallExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Firewall)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFirewall)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	op, err := e.Firewalls.Insert(e.projectID, firewall.GenerateFirewall(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFirewall)
+	}
+	cr.Status.AtProvider.Operation = op.Name
+	return managed.ExternalCreation{}, nil
+}
+
+// Update patches the firewall rule in place. The network and direction of a
+// firewall rule cannot be changed, so the rule is read first to report an
+// error rather than patch a rule that can never become up to date.
+func (e *firewallExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Firewall)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFirewall)
+	}
+	name := meta.GetExternalName(cr)
+	observed, err := e.Firewalls.Get(e.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFirewall)
+	}
+	if changed := firewall.ChangedImmutableFields(cr.Spec.ForProvider, *observed); len(changed) > 0 {
+		return managed.ExternalUpdate{}, errors.Errorf(errFirewallImmutable, strings.Join(changed, " and "))
+	}
+	op, err := e.Firewalls.Patch(e.projectID, name, firewall.GenerateFirewallPatch(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFirewall)
+	}
+	cr.Status.AtProvider.Operation = op.Name
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *firewallExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Firewall)
+	if !ok {
+		return errors.New(errNotFirewall)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.Firewalls.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteFirewall)
+}
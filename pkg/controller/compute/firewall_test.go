@@ -0,0 +1,533 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/firewall"
+)
+
+const (
+	testFirewallName = "test-firewall"
+	testFirewallPath = "/" + projectID + "/global/firewalls/" + testFirewallName
+	testFirewallOp   = "operation-5678"
+)
+
+var _ managed.ExternalConnecter = &firewallConnector{}
+var _ managed.ExternalClient = &firewallExternal{}
+
+type firewallModifier func(*v1alpha1.Firewall)
+
+func firewallWithConditions(c ...runtimev1alpha1.Condition) firewallModifier {
+	return func(i *v1alpha1.Firewall) { i.Status.SetConditions(c...) }
+}
+
+func firewallWithPriority(p int64) firewallModifier {
+	return func(i *v1alpha1.Firewall) { i.Spec.ForProvider.Priority = &p }
+}
+
+func firewallWithOperation(op string) firewallModifier {
+	return func(i *v1alpha1.Firewall) { i.Status.AtProvider.Operation = op }
+}
+
+func firewallObj(im ...firewallModifier) *v1alpha1.Firewall {
+	i := &v1alpha1.Firewall{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testFirewallName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testFirewallName,
+			},
+		},
+		Spec: v1alpha1.FirewallSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.FirewallParameters{
+				Network:      gcp.StringPtr("global/networks/default"),
+				Direction:    gcp.StringPtr(v1alpha1.FirewallDirectionIngress),
+				Allowed:      []v1alpha1.FirewallRule{{IPProtocol: "tcp", Ports: []string{"443"}}},
+				SourceRanges: []string{"10.0.0.0/8"},
+				TargetTags:   []string{"web"},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestFirewallObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotFirewall": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotFirewall),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testFirewallPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Firewall{})
+			}),
+			mg: firewallObj(),
+			want: want{
+				mg: firewallObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Firewall{})
+			}),
+			mg: firewallObj(),
+			want: want{
+				mg:  firewallObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetFirewall),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				f := firewall.GenerateFirewall(testFirewallName, firewallObj().Spec.ForProvider)
+				f.Priority = 1000
+				_ = json.NewEncoder(w).Encode(f)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   firewallObj(),
+			want: want{
+				mg:  firewallObj(firewallWithPriority(1000)),
+				err: errors.Wrap(errBoom, errManagedFirewallUpdate),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				f := firewall.GenerateFirewall(testFirewallName, firewallObj(firewallWithPriority(1000)).Spec.ForProvider)
+				// Compute Engine returns rules in its own order and case.
+				f.Allowed[0].IPProtocol = "TCP"
+				_ = json.NewEncoder(w).Encode(f)
+			}),
+			mg: firewallObj(firewallWithPriority(1000)),
+			want: want{
+				mg:  firewallObj(firewallWithPriority(1000), firewallWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				f := firewall.GenerateFirewall(testFirewallName, firewallObj(firewallWithPriority(1000)).Spec.ForProvider)
+				_ = json.NewEncoder(w).Encode(f)
+			}),
+			mg: firewallObj(firewallWithPriority(100)),
+			want: want{
+				mg:  firewallObj(firewallWithPriority(100), firewallWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"CreationPending": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testFirewallOp, Status: "RUNNING"})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Firewall{})
+			}),
+			mg: firewallObj(firewallWithOperation(testFirewallOp)),
+			want: want{
+				mg:  firewallObj(firewallWithOperation(testFirewallOp), firewallWithConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UpdatePending": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testFirewallOp, Status: "RUNNING"})
+					return
+				}
+				f := firewall.GenerateFirewall(testFirewallName, firewallObj(firewallWithPriority(1000)).Spec.ForProvider)
+				_ = json.NewEncoder(w).Encode(f)
+			}),
+			mg: firewallObj(firewallWithPriority(100), firewallWithOperation(testFirewallOp)),
+			want: want{
+				mg:  firewallObj(firewallWithPriority(100), firewallWithOperation(testFirewallOp)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"OperationFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{
+						Name:   testFirewallOp,
+						Status: "DONE",
+						Error:  &compute.OperationError{Errors: []*compute.OperationErrorErrors{{Code: "INVALID_USAGE", Message: "boom"}}},
+					})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Firewall{})
+			}),
+			mg: firewallObj(firewallWithOperation(testFirewallOp)),
+			want: want{
+				mg:  firewallObj(),
+				err: errors.Wrap(errors.New("boom"), errFirewallOpFailed),
+			},
+		},
+		"OperationDoneNotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testFirewallOp, Status: "DONE"})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Firewall{})
+			}),
+			mg: firewallObj(firewallWithOperation(testFirewallOp)),
+			want: want{
+				mg: firewallObj(),
+			},
+		},
+		"OperationDone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testFirewallOp, Status: "DONE"})
+					return
+				}
+				f := firewall.GenerateFirewall(testFirewallName, firewallObj(firewallWithPriority(1000)).Spec.ForProvider)
+				_ = json.NewEncoder(w).Encode(f)
+			}),
+			mg: firewallObj(firewallWithPriority(1000), firewallWithOperation(testFirewallOp)),
+			want: want{
+				mg:  firewallObj(firewallWithPriority(1000), firewallWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GetOperationFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: firewallObj(firewallWithOperation(testFirewallOp)),
+			want: want{
+				mg:  firewallObj(firewallWithOperation(testFirewallOp)),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetFirewall),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := firewallExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFirewallCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testFirewallOp})
+			}),
+			mg: firewallObj(),
+			want: want{
+				mg: firewallObj(firewallWithConditions(runtimev1alpha1.Creating()), firewallWithOperation(testFirewallOp)),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: firewallObj(),
+			want: want{
+				mg:  firewallObj(firewallWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateFirewall),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := firewallExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFirewallUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	observed := func(w http.ResponseWriter) {
+		f := firewall.GenerateFirewall(testFirewallName, firewallObj(firewallWithPriority(1000)).Spec.ForProvider)
+		f.Network = "https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/networks/default"
+		_ = json.NewEncoder(w).Encode(f)
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Patched": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				if diff := cmp.Diff(testFirewallPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if r.Method == http.MethodGet {
+					observed(w)
+					return
+				}
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := map[string]interface{}{}
+				_ = json.NewDecoder(r.Body).Decode(&got)
+				if diff := cmp.Diff(float64(0), got["priority"]); diff != "" {
+					t.Errorf("r: -want priority, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff([]interface{}{}, got["denied"]); diff != "" {
+					t.Errorf("r: -want denied, +got:\n%s", diff)
+				}
+				if _, ok := got["network"]; ok {
+					t.Errorf("r: unexpected network in patch")
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testFirewallOp})
+			}),
+			mg: firewallObj(firewallWithPriority(0)),
+			want: want{
+				mg: firewallObj(firewallWithPriority(0), firewallWithOperation(testFirewallOp)),
+			},
+		},
+		"ImmutableFieldsChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method != http.MethodGet {
+					t.Errorf("r: unexpected %s request", r.Method)
+				}
+				observed(w)
+			}),
+			mg: firewallObj(func(i *v1alpha1.Firewall) {
+				i.Spec.ForProvider.Network = gcp.StringPtr("global/networks/other")
+				i.Spec.ForProvider.Direction = gcp.StringPtr(v1alpha1.FirewallDirectionEgress)
+			}),
+			want: want{
+				mg: firewallObj(func(i *v1alpha1.Firewall) {
+					i.Spec.ForProvider.Network = gcp.StringPtr("global/networks/other")
+					i.Spec.ForProvider.Direction = gcp.StringPtr(v1alpha1.FirewallDirectionEgress)
+				}),
+				err: errors.Errorf(errFirewallImmutable, "network and direction"),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Firewall{})
+			}),
+			mg: firewallObj(),
+			want: want{
+				mg:  firewallObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetFirewall),
+			},
+		},
+		"PatchFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					observed(w)
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: firewallObj(),
+			want: want{
+				mg:  firewallObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateFirewall),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := firewallExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFirewallDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: firewallObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: firewallObj(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  firewallObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteFirewall),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := firewallExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
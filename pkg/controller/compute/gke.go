@@ -39,6 +39,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	gcpcomputev1alpha3 "github.com/crossplane/provider-gcp/apis/compute/v1alpha3"
+	"github.com/crossplane/provider-gcp/apis/connection"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/gke"
@@ -126,13 +127,14 @@ func connectionDetails(cluster *container.Cluster) (managed.ConnectionDetails, e
 		return nil, err
 	}
 	cd := managed.ConnectionDetails{
-		runtimev1alpha1.ResourceCredentialsSecretEndpointKey:   []byte(config.Clusters[cluster.Name].Server),
-		runtimev1alpha1.ResourceCredentialsSecretUserKey:       []byte(config.AuthInfos[cluster.Name].Username),
-		runtimev1alpha1.ResourceCredentialsSecretPasswordKey:   []byte(config.AuthInfos[cluster.Name].Password),
-		runtimev1alpha1.ResourceCredentialsSecretCAKey:         config.Clusters[cluster.Name].CertificateAuthorityData,
-		runtimev1alpha1.ResourceCredentialsSecretClientCertKey: config.AuthInfos[cluster.Name].ClientCertificateData,
-		runtimev1alpha1.ResourceCredentialsSecretClientKeyKey:  config.AuthInfos[cluster.Name].ClientKeyData,
-		runtimev1alpha1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
+		connection.KeyEndpoint:             []byte(config.Clusters[cluster.Name].Server),
+		connection.KeyUsername:             []byte(config.AuthInfos[cluster.Name].Username),
+		connection.KeyPassword:             []byte(config.AuthInfos[cluster.Name].Password),
+		connection.KeyClusterCA:            config.Clusters[cluster.Name].CertificateAuthorityData,
+		connection.KeyClusterCACertificate: config.Clusters[cluster.Name].CertificateAuthorityData,
+		connection.KeyClientCertificate:    config.AuthInfos[cluster.Name].ClientCertificateData,
+		connection.KeyClientKey:            config.AuthInfos[cluster.Name].ClientKeyData,
+		connection.KeyKubeconfig:           rawConfig,
 	}
 	return cd, nil
 }
@@ -148,20 +150,27 @@ func (r *Reconciler) _connect(instance *gcpcomputev1alpha3.GKECluster) (gke.Clie
 		return nil, errors.New(errProviderSecretNil)
 	}
 
+	// The defaulted zone is persisted by the next update of the instance.
+	zone, err := gcp.Zone(instance.Spec.Zone, p)
+	if err != nil {
+		return nil, err
+	}
+	instance.Spec.Zone = zone
+
 	secret := &corev1.Secret{}
 	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
 	if err := r.Client.Get(ctx, n, secret); err != nil {
 		return nil, err
 	}
-	data, ok := secret.Data[p.Spec.CredentialsSecretRef.Key]
-	if !ok {
-		return nil, fmt.Errorf("secret data is not found for key [%s]", p.Spec.CredentialsSecretRef.Key)
+	data, err := gcp.CredentialsData(secret, p.Spec.CredentialsSecretRef.Key)
+	if err != nil {
+		return nil, err
 	}
 	creds, err := google.CredentialsFromJSON(context.Background(), data, gke.DefaultScope)
 	if err != nil {
 		return nil, err
 	}
-	return gke.NewClusterClient(ctx, creds)
+	return gke.NewClusterClient(ctx, creds, gcp.UserAgent(p.Spec.HTTPClient))
 }
 
 func (r *Reconciler) _create(instance *gcpcomputev1alpha3.GKECluster, client gke.Client) (reconcile.Result, error) {
@@ -44,6 +44,7 @@ import (
 
 	"github.com/crossplane/provider-gcp/apis"
 	. "github.com/crossplane/provider-gcp/apis/compute/v1alpha3"
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/pkg/clients/fake"
 	"github.com/crossplane/provider-gcp/pkg/clients/gke"
 )
@@ -473,6 +474,7 @@ func TestConnectionDetails(t *testing.T) {
 		runtimev1alpha1.ResourceCredentialsSecretUserKey:       []byte(username),
 		runtimev1alpha1.ResourceCredentialsSecretPasswordKey:   []byte(password),
 		runtimev1alpha1.ResourceCredentialsSecretCAKey:         []byte(clusterCA),
+		connection.KeyClusterCACertificate:                     []byte(clusterCA),
 		runtimev1alpha1.ResourceCredentialsSecretClientCertKey: []byte(clientCert),
 		runtimev1alpha1.ResourceCredentialsSecretClientKeyKey:  []byte(clientKey),
 		runtimev1alpha1.ResourceCredentialsSecretKubeconfigKey: kubeconfig,
@@ -23,8 +23,6 @@ import (
 	"github.com/pkg/errors"
 	compute "google.golang.org/api/compute/v1"
 	"google.golang.org/api/option"
-	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,7 +34,6 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
-	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/globaladdress"
 )
@@ -61,7 +58,7 @@ func SetupGlobalAddress(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1beta1.GlobalAddress{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.GlobalAddressGroupVersionKind),
-			managed.WithExternalConnecter(&gaConnector{kube: mgr.GetClient(), newServiceFn: compute.NewService}),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&gaConnector{kube: mgr.GetClient(), newServiceFn: compute.NewService})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -79,23 +76,11 @@ func (c *gaConnector) Connect(ctx context.Context, mg resource.Managed) (managed
 		return nil, errors.New(errNotGlobalAddress)
 	}
 
-	p := &gcpv1alpha3.Provider{}
-	if err := c.kube.Get(ctx, meta.NamespacedNameOf(cr.Spec.ProviderReference), p); err != nil {
-		return nil, errors.Wrap(err, errProviderNotRetrieved)
-	}
-
-	if p.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretNil)
-	}
-
-	s := &v1.Secret{}
-	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}, s); err != nil {
-		return nil, errors.Wrap(err, errProviderSecretNotRetrieved)
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, compute.ComputeScope)
+	if err != nil {
+		return nil, err
 	}
-
-	svc, err := c.newServiceFn(ctx,
-		option.WithCredentialsJSON(s.Data[p.Spec.CredentialsSecretRef.Key]),
-		option.WithScopes(compute.ComputeScope))
+	svc, err := c.newServiceFn(ctx, opts...)
 	return &gaExternal{kube: c.kube, Service: svc, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
 }
 
@@ -168,7 +168,7 @@ func TestGlobalAddressConnect(t *testing.T) {
 				mg: addressObj(),
 			},
 			want: want{
-				err: errors.Wrap(errBoom, errProviderNotRetrieved),
+				err: errors.Wrap(errBoom, "cannot get Provider"),
 			},
 		},
 		"ProviderSecretNil": {
@@ -186,7 +186,7 @@ func TestGlobalAddressConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: addressObj()},
-			want: want{err: errors.New(errProviderSecretNil)},
+			want: want{err: errors.New("cannot find Secret reference on Provider")},
 		},
 		"FailedToGetProviderSecret": {
 			conn: &gaConnector{
@@ -201,7 +201,7 @@ func TestGlobalAddressConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: addressObj()},
-			want: want{err: errors.Wrap(errBoom, errProviderSecretNotRetrieved)},
+			want: want{err: errors.Wrap(errBoom, "cannot get Provider Secret")},
 		},
 		"FailedToCreateComputeClient": {
 			conn: &gaConnector{
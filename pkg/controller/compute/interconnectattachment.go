@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/interconnectattachment"
+)
+
+// Error strings.
+const (
+	errNotInterconnectAttachment           = "managed resource is not an InterconnectAttachment resource"
+	errManagedInterconnectAttachmentUpdate = "cannot update InterconnectAttachment managed resource"
+
+	errGetInterconnectAttachment    = "cannot get GCP InterconnectAttachment"
+	errCreateInterconnectAttachment = "cannot create GCP InterconnectAttachment"
+	errUpdateInterconnectAttachment = "cannot update GCP InterconnectAttachment"
+	errDeleteInterconnectAttachment = "cannot delete GCP InterconnectAttachment"
+)
+
+// SetupInterconnectAttachment adds a controller that reconciles
+// InterconnectAttachment managed resources.
+func SetupInterconnectAttachment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.InterconnectAttachmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.InterconnectAttachment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InterconnectAttachmentGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&interconnectAttachmentConnector{kube: mgr.GetClient()})),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type interconnectAttachmentConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *interconnectAttachmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return nil, errors.New(errNotInterconnectAttachment)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &interconnectAttachmentExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID, locations: gcp.ComputeLocations}, nil
+}
+
+type interconnectAttachmentExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+	locations *gcp.LocationValidator
+}
+
+func (e *interconnectAttachmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInterconnectAttachment)
+	}
+	observed, err := e.InterconnectAttachments.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInterconnectAttachment)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	interconnectattachment.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedInterconnectAttachmentUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = interconnectattachment.GenerateInterconnectAttachmentObservation(*observed)
+	switch observed.State {
+	case v1alpha1.InterconnectAttachmentStateActive:
+		cr.Status.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.InterconnectAttachmentStatePendingPartner,
+		v1alpha1.InterconnectAttachmentStatePartnerRequestReceived,
+		v1alpha1.InterconnectAttachmentStatePendingCustomer:
+		// A PARTNER attachment waits for the service provider to provision
+		// it using the pairing key, and then for the customer to enable it.
+		cr.Status.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.Status.SetConditions(runtimev1alpha1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: interconnectattachment.IsUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *interconnectAttachmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInterconnectAttachment)
+	}
+	if err := e.locations.ValidateRegion(ctx, e.Service, e.projectID, cr.Spec.ForProvider.Region); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateInterconnectAttachment)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	ia := interconnectattachment.GenerateInterconnectAttachment(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.InterconnectAttachments.Insert(e.projectID, cr.Spec.ForProvider.Region, ia).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateInterconnectAttachment)
+}
+
+func (e *interconnectAttachmentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInterconnectAttachment)
+	}
+	ia := interconnectattachment.GenerateInterconnectAttachmentUpdate(cr.Spec.ForProvider)
+	_, err := e.InterconnectAttachments.Patch(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), ia).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInterconnectAttachment)
+}
+
+func (e *interconnectAttachmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InterconnectAttachment)
+	if !ok {
+		return errors.New(errNotInterconnectAttachment)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.InterconnectAttachments.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInterconnectAttachment)
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/interconnectattachment"
+)
+
+const (
+	testInterconnectAttachmentName = "test-attachment"
+	testInterconnectAttachmentPath = "/" + projectID + "/regions/us-central1/interconnectAttachments/" + testInterconnectAttachmentName
+)
+
+var _ managed.ExternalConnecter = &interconnectAttachmentConnector{}
+var _ managed.ExternalClient = &interconnectAttachmentExternal{}
+
+type interconnectAttachmentModifier func(*v1alpha1.InterconnectAttachment)
+
+func interconnectAttachmentWithConditions(c ...runtimev1alpha1.Condition) interconnectAttachmentModifier {
+	return func(i *v1alpha1.InterconnectAttachment) { i.Status.SetConditions(c...) }
+}
+
+func interconnectAttachmentWithBandwidth(b string) interconnectAttachmentModifier {
+	return func(i *v1alpha1.InterconnectAttachment) { i.Spec.ForProvider.Bandwidth = &b }
+}
+
+func interconnectAttachmentWithObservation(o v1alpha1.InterconnectAttachmentObservation) interconnectAttachmentModifier {
+	return func(i *v1alpha1.InterconnectAttachment) { i.Status.AtProvider = o }
+}
+
+func interconnectAttachmentObj(im ...interconnectAttachmentModifier) *v1alpha1.InterconnectAttachment {
+	i := &v1alpha1.InterconnectAttachment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testInterconnectAttachmentName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testInterconnectAttachmentName,
+			},
+		},
+		Spec: v1alpha1.InterconnectAttachmentSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.InterconnectAttachmentParameters{
+				Region:                 "us-central1",
+				Description:            gcp.StringPtr("partner attachment"),
+				Type:                   gcp.StringPtr("PARTNER"),
+				Router:                 "regions/us-central1/routers/router",
+				Bandwidth:              gcp.StringPtr("BPS_1G"),
+				EdgeAvailabilityDomain: gcp.StringPtr("AVAILABILITY_DOMAIN_1"),
+				AdminEnabled:           gcp.BoolPtr(true),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestInterconnectAttachmentObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	serve := func(state, bandwidth, pairingKey string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = r.Body.Close()
+			if diff := cmp.Diff(testInterconnectAttachmentPath, r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			ia := interconnectattachment.GenerateInterconnectAttachment(testInterconnectAttachmentName, interconnectAttachmentObj().Spec.ForProvider)
+			ia.State = state
+			ia.Bandwidth = bandwidth
+			ia.PairingKey = pairingKey
+			_ = json.NewEncoder(w).Encode(ia)
+		})
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotInterconnectAttachment": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotInterconnectAttachment),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.InterconnectAttachment{})
+			}),
+			mg: interconnectAttachmentObj(),
+			want: want{
+				mg: interconnectAttachmentObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.InterconnectAttachment{})
+			}),
+			mg: interconnectAttachmentObj(),
+			want: want{
+				mg:  interconnectAttachmentObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetInterconnectAttachment),
+			},
+		},
+		"PendingPartner": {
+			handler: serve(v1alpha1.InterconnectAttachmentStatePendingPartner, "BPS_1G", "key/us-central1/1"),
+			mg:      interconnectAttachmentObj(),
+			want: want{
+				mg: interconnectAttachmentObj(
+					interconnectAttachmentWithObservation(v1alpha1.InterconnectAttachmentObservation{
+						State:      v1alpha1.InterconnectAttachmentStatePendingPartner,
+						PairingKey: "key/us-central1/1",
+					}),
+					interconnectAttachmentWithConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Active": {
+			handler: serve(v1alpha1.InterconnectAttachmentStateActive, "BPS_1G", ""),
+			mg:      interconnectAttachmentObj(),
+			want: want{
+				mg: interconnectAttachmentObj(
+					interconnectAttachmentWithObservation(v1alpha1.InterconnectAttachmentObservation{State: v1alpha1.InterconnectAttachmentStateActive}),
+					interconnectAttachmentWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"BandwidthChanged": {
+			handler: serve(v1alpha1.InterconnectAttachmentStateActive, "BPS_1G", ""),
+			mg:      interconnectAttachmentObj(interconnectAttachmentWithBandwidth("BPS_2G")),
+			want: want{
+				mg: interconnectAttachmentObj(
+					interconnectAttachmentWithBandwidth("BPS_2G"),
+					interconnectAttachmentWithObservation(v1alpha1.InterconnectAttachmentObservation{State: v1alpha1.InterconnectAttachmentStateActive}),
+					interconnectAttachmentWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := interconnectAttachmentExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInterconnectAttachmentUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Updated": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				body := map[string]interface{}{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				want := map[string]interface{}{"description": "partner attachment", "bandwidth": "BPS_2G", "adminEnabled": true}
+				if diff := cmp.Diff(want, body); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: interconnectAttachmentObj(interconnectAttachmentWithBandwidth("BPS_2G")),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:   interconnectAttachmentObj(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateInterconnectAttachment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := interconnectAttachmentExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
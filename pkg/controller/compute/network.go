@@ -23,8 +23,6 @@ import (
 	"github.com/pkg/errors"
 	compute "google.golang.org/api/compute/v1"
 	"google.golang.org/api/option"
-	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,24 +34,25 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
-	apiv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	"github.com/crossplane/provider-gcp/apis/connection"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/network"
 )
 
 const (
 	// Error strings.
-	errNewClient                  = "cannot create new Compute Service"
-	errNotNetwork                 = "managed resource is not a Network resource"
-	errGetNetwork                 = "cannot get GCP network"
-	errProviderNotRetrieved       = "provider could not be retrieved"
-	errProviderSecretNotRetrieved = "secret referred in provider could not be retrieved"
-	errManagedNetworkUpdate       = "unable to update Network managed resource"
-
-	errNetworkUpdateFailed  = "update of Network resource has failed"
-	errNetworkCreateFailed  = "creation of Network resource has failed"
-	errNetworkDeleteFailed  = "deletion of Network resource has failed"
-	errCheckNetworkUpToDate = "cannot determine if GCP Network is up to date"
+	errNewClient            = "cannot create new Compute Service"
+	errNotNetwork           = "managed resource is not a Network resource"
+	errGetNetwork           = "cannot get GCP network"
+	errProviderNotRetrieved = "provider could not be retrieved"
+	errManagedNetworkUpdate = "unable to update Network managed resource"
+
+	errNetworkUpdateFailed   = "update of Network resource has failed"
+	errNetworkCreateFailed   = "creation of Network resource has failed"
+	errGetNetworkCreateOp    = "cannot get the operation that creates the Network resource"
+	errNetworkCreateOpFailed = "the operation that creates the Network resource has failed"
+	errNetworkDeleteFailed   = "deletion of Network resource has failed"
+	errCheckNetworkUpToDate  = "cannot determine if GCP Network is up to date"
 )
 
 // SetupNetwork adds a controller that reconciles Network managed
@@ -66,9 +65,9 @@ func SetupNetwork(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1beta1.Network{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.NetworkGroupVersionKind),
-			managed.WithExternalConnecter(&networkConnector{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&networkConnector{kube: mgr.GetClient()})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithConnectionPublishers(),
+			managed.WithConnectionPublishers(managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -84,27 +83,14 @@ func (c *networkConnector) Connect(ctx context.Context, mg resource.Managed) (ma
 		return nil, errors.New(errNotNetwork)
 	}
 
-	provider := &apiv1alpha3.Provider{}
-	if err := c.kube.Get(ctx, meta.NamespacedNameOf(cr.Spec.ProviderReference), provider); err != nil {
-		return nil, errors.Wrap(err, errProviderNotRetrieved)
-	}
-
-	if provider.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretNil)
-	}
-
-	secret := &v1.Secret{}
-	n := types.NamespacedName{Namespace: provider.Spec.CredentialsSecretRef.Namespace, Name: provider.Spec.CredentialsSecretRef.Name}
-	if err := c.kube.Get(ctx, n, secret); err != nil {
-		return nil, errors.Wrap(err, errProviderSecretNotRetrieved)
-	}
-
 	if c.newServiceFn == nil {
 		c.newServiceFn = compute.NewService
 	}
-	s, err := c.newServiceFn(ctx,
-		option.WithCredentialsJSON(secret.Data[provider.Spec.CredentialsSecretRef.Key]),
-		option.WithScopes(compute.ComputeScope))
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, compute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -122,11 +108,33 @@ func (c *networkExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotNetwork)
 	}
+	op := cr.Status.AtProvider.Operation
 	observed, err := c.Networks.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
-	if err != nil {
+	if err != nil && (op == "" || !gcp.IsErrorNotFound(err)) {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNetwork)
 	}
 
+	// A network that is being created may not be found yet. It exists, but
+	// is neither available nor to be updated until its creation is done.
+	if op != "" {
+		o, err := c.GlobalOperations.Get(c.projectID, op).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetNetworkCreateOp)
+		}
+		gop := gcp.ComputeOperation(o)
+		if !gop.Done {
+			cr.Status.SetConditions(runtimev1alpha1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+		cr.Status.AtProvider.Operation = ""
+		if gop.Error != "" {
+			return managed.ExternalObservation{}, errors.Wrap(errors.New(gop.Error), errNetworkCreateOpFailed)
+		}
+		if observed == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+	}
+
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	network.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
@@ -145,8 +153,9 @@ func (c *networkExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: u,
+		ResourceExists:    true,
+		ResourceUpToDate:  u,
+		ConnectionDetails: managed.ConnectionDetails{connection.KeySelfLink: []byte(observed.SelfLink)},
 	}, nil
 }
 
@@ -160,10 +169,14 @@ func (c *networkExternal) Create(ctx context.Context, mg resource.Managed) (mana
 
 	net := &compute.Network{}
 	network.GenerateNetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, net)
-	_, err := c.Networks.Insert(c.projectID, net).
+	op, err := c.Networks.Insert(c.projectID, net).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errNetworkCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errNetworkCreateFailed)
+	}
+	cr.Status.AtProvider.Operation = op.Name
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
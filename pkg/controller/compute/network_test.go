@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -41,12 +42,15 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/apis/connection"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	"github.com/crossplane/provider-gcp/pkg/clients/network"
 )
 
 const (
-	testNetworkName = "test-network"
+	testNetworkName     = "test-network"
+	testNetworkOp       = "operation-1234"
+	testNetworkSelfLink = "https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/networks/" + testNetworkName
 
 	projectID          = "myproject-id-1234"
 	providerSecretName = "gcp-creds"
@@ -74,6 +78,10 @@ func networkWithDescription(d string) networkModifier {
 	return func(i *v1beta1.Network) { i.Spec.ForProvider.Description = &d }
 }
 
+func networkWithOperation(op string) networkModifier {
+	return func(i *v1beta1.Network) { i.Status.AtProvider.Operation = op }
+}
+
 func networkObj(im ...networkModifier) *v1beta1.Network {
 	i := &v1beta1.Network{
 		ObjectMeta: metav1.ObjectMeta{
@@ -173,7 +181,7 @@ func TestNetworkConnect(t *testing.T) {
 				mg: networkObj(),
 			},
 			want: want{
-				err: errors.Wrap(errBoom, errProviderNotRetrieved),
+				err: errors.Wrap(errBoom, "cannot get Provider"),
 			},
 		},
 		"ProviderSecretNil": {
@@ -191,7 +199,7 @@ func TestNetworkConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: networkObj()},
-			want: want{err: errors.New(errProviderSecretNil)},
+			want: want{err: errors.New("cannot find Secret reference on Provider")},
 		},
 		"FailedToGetProviderSecret": {
 			conn: &networkConnector{
@@ -206,7 +214,7 @@ func TestNetworkConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: networkObj()},
-			want: want{err: errors.Wrap(errBoom, errProviderSecretNotRetrieved)},
+			want: want{err: errors.Wrap(errBoom, "cannot get Provider Secret")},
 		},
 		"FailedToCreateComputeClient": {
 			conn: &networkConnector{
@@ -339,12 +347,76 @@ func TestNetworkObserve(t *testing.T) {
 			},
 			want: want{
 				obs: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{connection.KeySelfLink: []byte("")},
 				},
 				mg: networkObj(networkWithConditions(runtimev1alpha1.Available())),
 			},
 		},
+		"CreationPending": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testNetworkOp, Status: "RUNNING"})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Network{})
+			}),
+			args: args{
+				mg: networkObj(networkWithOperation(testNetworkOp)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg:  networkObj(networkWithOperation(testNetworkOp), networkWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreationFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{
+						Name:   testNetworkOp,
+						Status: "DONE",
+						Error:  &compute.OperationError{Errors: []*compute.OperationErrorErrors{{Code: "QUOTA_EXCEEDED", Message: "boom"}}},
+					})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Network{})
+			}),
+			args: args{
+				mg: networkObj(networkWithOperation(testNetworkOp)),
+			},
+			want: want{
+				mg:  networkObj(),
+				err: errors.Wrap(errors.New("boom"), errNetworkCreateOpFailed),
+			},
+		},
+		"CreationDone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testNetworkOp, Status: "DONE"})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Network{Name: testNetworkName, SelfLink: testNetworkSelfLink})
+			}),
+			args: args{
+				mg: networkObj(networkWithOperation(testNetworkOp)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{connection.KeySelfLink: []byte(testNetworkSelfLink)},
+				},
+				mg: networkObj(
+					networkWithConditions(runtimev1alpha1.Available()),
+					func(n *v1beta1.Network) { n.Status.AtProvider.SelfLink = testNetworkSelfLink }),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -414,13 +486,13 @@ func TestNetworkCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testNetworkOp})
 			}),
 			args: args{
 				mg: networkObj(),
 			},
 			want: want{
-				mg:  networkObj(networkWithConditions(runtimev1alpha1.Creating())),
+				mg:  networkObj(networkWithConditions(runtimev1alpha1.Creating()), networkWithOperation(testNetworkOp)),
 				cre: managed.ExternalCreation{},
 				err: nil,
 			},
@@ -0,0 +1,322 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	neg "github.com/crossplane/provider-gcp/pkg/clients/networkendpointgroup"
+)
+
+// Error strings.
+const (
+	errNotNetworkEndpointGroup = "managed resource is not a NetworkEndpointGroup resource"
+
+	errGetNetworkEndpointGroup    = "cannot get GCP NetworkEndpointGroup"
+	errListNetworkEndpoints       = "cannot list network endpoints of GCP NetworkEndpointGroup"
+	errCreateNetworkEndpointGroup = "cannot create GCP NetworkEndpointGroup"
+	errDeleteNetworkEndpointGroup = "cannot delete GCP NetworkEndpointGroup"
+	errAttachNetworkEndpoints     = "cannot attach network endpoints to GCP NetworkEndpointGroup"
+	errDetachNetworkEndpoints     = "cannot detach network endpoints from GCP NetworkEndpointGroup"
+	errGetNetworkEndpointsOp      = "cannot get network endpoint operation of GCP NetworkEndpointGroup"
+	errNetworkEndpointsOpFailed   = "network endpoint operation of GCP NetworkEndpointGroup failed"
+	errNetworkEndpointsOpTimeout  = "network endpoint operation %s of GCP NetworkEndpointGroup did not complete within %s"
+	errInvalidNetworkEndpoints    = "invalid network endpoints"
+)
+
+// SetupNetworkEndpointGroup adds a controller that reconciles
+// NetworkEndpointGroup managed resources.
+func SetupNetworkEndpointGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.NetworkEndpointGroupGroupKind)
+	rec := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	p := gcp.NewOperationPoller()
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.NetworkEndpointGroup{}).
+		Complete(p.Reconciler(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NetworkEndpointGroupGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&negConnector{kube: mgr.GetClient(), record: rec, poller: p})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(rec))))
+}
+
+type negConnector struct {
+	kube         client.Client
+	record       event.Recorder
+	poller       *gcp.OperationPoller
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *negConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NetworkEndpointGroup)
+	if !ok {
+		return nil, errors.New(errNotNetworkEndpointGroup)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &negExternal{Service: s, projectID: provider.Spec.ProjectID, locations: gcp.ComputeLocations, record: c.record, poller: c.poller}, nil
+}
+
+// negExternal manages zonal network endpoint groups when a zone is specified,
+// and global network endpoint groups otherwise. The attach and detach
+// operations it starts and polls are recorded as events, so that they can be
+// looked up in the Cloud Console. Pending operations are polled per the
+// operation poll interval and timeout annotations of the NetworkEndpointGroup.
+type negExternal struct {
+	*googlecompute.Service
+	projectID string
+	locations *gcp.LocationValidator
+	record    event.Recorder
+	poller    *gcp.OperationPoller
+	now       func() time.Time
+}
+
+func (e *negExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NetworkEndpointGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNetworkEndpointGroup)
+	}
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNetworkEndpointGroup)
+	}
+	op := cr.Status.AtProvider.Operation
+	cr.Status.AtProvider = neg.GenerateNetworkEndpointGroupObservation(*observed)
+	cr.Status.AtProvider.Operation = op
+
+	// Attach and detach operations on the same network endpoint group may
+	// not overlap, so nothing is changed until the pending one is done. The
+	// network endpoint group is not up to date while it is pending; Update
+	// does nothing until Observe has seen it through.
+	if op != "" {
+		o, err := e.operation(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetNetworkEndpointsOp)
+		}
+		gop := gcp.ComputeOperation(o)
+		gcp.RecordOperation(e.record, cr, gop)
+		if !gop.Done {
+			return e.pending(cr, gop)
+		}
+		cr.Status.AtProvider.Operation = ""
+		if gop.Error != "" {
+			return managed.ExternalObservation{}, errors.Wrap(errors.New(gop.Error), errNetworkEndpointsOpFailed)
+		}
+	}
+
+	endpoints, err := e.listEndpoints(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListNetworkEndpoints)
+	}
+	attach, detach := neg.DiffNetworkEndpoints(observed.DefaultPort, cr.Spec.ForProvider.NetworkEndpoints, endpoints)
+
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(attach) == 0 && len(detach) == 0,
+	}, nil
+}
+
+// pending observes a network endpoint group whose operation is pending. Once
+// the operation has been pending for longer than its timeout it is no longer
+// waited for, so that the next reconcile compares the network endpoints anew.
+func (e *negExternal) pending(cr *v1alpha1.NetworkEndpointGroup, op gcp.Operation) (managed.ExternalObservation, error) {
+	timeout, err := gcp.OperationTimeout(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	now := time.Now
+	if e.now != nil {
+		now = e.now
+	}
+	if gcp.OperationTimedOut(op, timeout, now()) {
+		cr.Status.AtProvider.Operation = ""
+		return managed.ExternalObservation{}, errors.Errorf(errNetworkEndpointsOpTimeout, op.Name, timeout)
+	}
+	interval, err := gcp.OperationPollInterval(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	e.poller.Pending(cr, interval)
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+}
+
+func (e *negExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NetworkEndpointGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNetworkEndpointGroup)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	if err := neg.ValidateNetworkEndpoints(cr.Spec.ForProvider.NetworkEndpoints); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidNetworkEndpoints)
+	}
+	if cr.Spec.ForProvider.Zone != nil {
+		if err := e.locations.ValidateZone(ctx, e.Service, e.projectID, *cr.Spec.ForProvider.Zone, ""); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateNetworkEndpointGroup)
+		}
+	}
+
+	// Network endpoints are attached by a subsequent Update once the
+	// network endpoint group exists.
+	g := neg.GenerateNetworkEndpointGroup(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	var err error
+	if cr.Spec.ForProvider.Zone != nil {
+		_, err = e.NetworkEndpointGroups.Insert(e.projectID, *cr.Spec.ForProvider.Zone, g).Context(ctx).Do()
+	} else {
+		_, err = e.GlobalNetworkEndpointGroups.Insert(e.projectID, g).Context(ctx).Do()
+	}
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNetworkEndpointGroup)
+}
+
+func (e *negExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NetworkEndpointGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNetworkEndpointGroup)
+	}
+	if cr.Status.AtProvider.Operation != "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	if err := neg.ValidateNetworkEndpoints(cr.Spec.ForProvider.NetworkEndpoints); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidNetworkEndpoints)
+	}
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetNetworkEndpointGroup)
+	}
+	endpoints, err := e.listEndpoints(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListNetworkEndpoints)
+	}
+	attach, detach := neg.DiffNetworkEndpoints(observed.DefaultPort, cr.Spec.ForProvider.NetworkEndpoints, endpoints)
+
+	// Only one operation is started per update. New endpoints are attached
+	// before stale ones are detached, which are detached once Observe has seen
+	// the attach operation through.
+	switch {
+	case len(attach) > 0:
+		op, err := e.attach(ctx, cr, attach)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAttachNetworkEndpoints)
+		}
+		gcp.RecordOperation(e.record, cr, gcp.ComputeOperation(op))
+		cr.Status.AtProvider.Operation = op.Name
+	case len(detach) > 0:
+		op, err := e.detach(ctx, cr, detach)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDetachNetworkEndpoints)
+		}
+		gcp.RecordOperation(e.record, cr, gcp.ComputeOperation(op))
+		cr.Status.AtProvider.Operation = op.Name
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *negExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NetworkEndpointGroup)
+	if !ok {
+		return errors.New(errNotNetworkEndpointGroup)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	var err error
+	if cr.Spec.ForProvider.Zone != nil {
+		_, err = e.NetworkEndpointGroups.Delete(e.projectID, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	} else {
+		_, err = e.GlobalNetworkEndpointGroups.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	}
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNetworkEndpointGroup)
+}
+
+func (e *negExternal) get(ctx context.Context, cr *v1alpha1.NetworkEndpointGroup) (*googlecompute.NetworkEndpointGroup, error) {
+	if cr.Spec.ForProvider.Zone != nil {
+		return e.NetworkEndpointGroups.Get(e.projectID, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	}
+	return e.GlobalNetworkEndpointGroups.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+}
+
+func (e *negExternal) listEndpoints(ctx context.Context, cr *v1alpha1.NetworkEndpointGroup) ([]*googlecompute.NetworkEndpoint, error) {
+	var endpoints []*googlecompute.NetworkEndpoint
+	collect := func(l *googlecompute.NetworkEndpointGroupsListNetworkEndpoints) error {
+		for _, i := range l.Items {
+			if i != nil && i.NetworkEndpoint != nil {
+				endpoints = append(endpoints, i.NetworkEndpoint)
+			}
+		}
+		return nil
+	}
+	var err error
+	if cr.Spec.ForProvider.Zone != nil {
+		err = e.NetworkEndpointGroups.ListNetworkEndpoints(e.projectID, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr), &googlecompute.NetworkEndpointGroupsListEndpointsRequest{}).Pages(ctx, collect)
+	} else {
+		err = e.GlobalNetworkEndpointGroups.ListNetworkEndpoints(e.projectID, meta.GetExternalName(cr)).Pages(ctx, collect)
+	}
+	return endpoints, err
+}
+
+func (e *negExternal) attach(ctx context.Context, cr *v1alpha1.NetworkEndpointGroup, endpoints []*googlecompute.NetworkEndpoint) (*googlecompute.Operation, error) {
+	if cr.Spec.ForProvider.Zone != nil {
+		rq := &googlecompute.NetworkEndpointGroupsAttachEndpointsRequest{NetworkEndpoints: endpoints}
+		return e.NetworkEndpointGroups.AttachNetworkEndpoints(e.projectID, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr), rq).Context(ctx).Do()
+	}
+	rq := &googlecompute.GlobalNetworkEndpointGroupsAttachEndpointsRequest{NetworkEndpoints: endpoints}
+	return e.GlobalNetworkEndpointGroups.AttachNetworkEndpoints(e.projectID, meta.GetExternalName(cr), rq).Context(ctx).Do()
+}
+
+func (e *negExternal) detach(ctx context.Context, cr *v1alpha1.NetworkEndpointGroup, endpoints []*googlecompute.NetworkEndpoint) (*googlecompute.Operation, error) {
+	if cr.Spec.ForProvider.Zone != nil {
+		rq := &googlecompute.NetworkEndpointGroupsDetachEndpointsRequest{NetworkEndpoints: endpoints}
+		return e.NetworkEndpointGroups.DetachNetworkEndpoints(e.projectID, *cr.Spec.ForProvider.Zone, meta.GetExternalName(cr), rq).Context(ctx).Do()
+	}
+	rq := &googlecompute.GlobalNetworkEndpointGroupsDetachEndpointsRequest{NetworkEndpoints: endpoints}
+	return e.GlobalNetworkEndpointGroups.DetachNetworkEndpoints(e.projectID, meta.GetExternalName(cr), rq).Context(ctx).Do()
+}
+
+func (e *negExternal) operation(ctx context.Context, cr *v1alpha1.NetworkEndpointGroup) (*googlecompute.Operation, error) {
+	if cr.Spec.ForProvider.Zone != nil {
+		return e.ZoneOperations.Get(e.projectID, *cr.Spec.ForProvider.Zone, cr.Status.AtProvider.Operation).Context(ctx).Do()
+	}
+	return e.GlobalOperations.Get(e.projectID, cr.Status.AtProvider.Operation).Context(ctx).Do()
+}
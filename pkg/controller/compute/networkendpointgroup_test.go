@@ -0,0 +1,602 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testNEGName = "test-neg"
+	testNEGZone = "us-central1-a"
+	testNEGOp   = "test-op"
+)
+
+var (
+	testNEGOpStarted = time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	testNEGNow       = testNEGOpStarted.Add(10 * time.Minute)
+)
+
+var _ managed.ExternalConnecter = &negConnector{}
+var _ managed.ExternalClient = &negExternal{}
+
+// eventRecorder remembers the events it records.
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+type negModifier func(*v1alpha1.NetworkEndpointGroup)
+
+func negWithConditions(c ...runtimev1alpha1.Condition) negModifier {
+	return func(i *v1alpha1.NetworkEndpointGroup) { i.Status.SetConditions(c...) }
+}
+
+func negWithZone(z string) negModifier {
+	return func(i *v1alpha1.NetworkEndpointGroup) { i.Spec.ForProvider.Zone = &z }
+}
+
+func negWithEndpoints(e ...v1alpha1.NetworkEndpoint) negModifier {
+	return func(i *v1alpha1.NetworkEndpointGroup) { i.Spec.ForProvider.NetworkEndpoints = e }
+}
+
+func negWithAnnotation(k, v string) negModifier {
+	return func(i *v1alpha1.NetworkEndpointGroup) { i.Annotations[k] = v }
+}
+
+func negWithObservation(o v1alpha1.NetworkEndpointGroupObservation) negModifier {
+	return func(i *v1alpha1.NetworkEndpointGroup) { i.Status.AtProvider = o }
+}
+
+func negObj(im ...negModifier) *v1alpha1.NetworkEndpointGroup {
+	i := &v1alpha1.NetworkEndpointGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testNEGName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testNEGName,
+			},
+		},
+		Spec: v1alpha1.NetworkEndpointGroupSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.NetworkEndpointGroupParameters{
+				NetworkEndpointType: v1alpha1.NetworkEndpointTypeGCEVMIPPort,
+				DefaultPort:         gcp.Int64Ptr(80),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// negHandler serves a network endpoint group with the supplied endpoints and
+// operation, and records the endpoints that are attached and detached.
+type negHandler struct {
+	t         *testing.T
+	endpoints []*compute.NetworkEndpoint
+	operation *compute.Operation
+	attached  []*compute.NetworkEndpoint
+	detached  []*compute.NetworkEndpoint
+}
+
+func (h *negHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	defer func() { _ = r.Body.Close() }()
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/listNetworkEndpoints"):
+		_ = json.NewEncoder(w).Encode(&compute.NetworkEndpointGroupsListNetworkEndpoints{Items: withHealth(h.endpoints)})
+	case strings.HasSuffix(r.URL.Path, "/attachNetworkEndpoints"):
+		rq := &compute.NetworkEndpointGroupsAttachEndpointsRequest{}
+		_ = json.NewDecoder(r.Body).Decode(rq)
+		h.attached = append(h.attached, rq.NetworkEndpoints...)
+		_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testNEGOp})
+	case strings.HasSuffix(r.URL.Path, "/detachNetworkEndpoints"):
+		rq := &compute.NetworkEndpointGroupsDetachEndpointsRequest{}
+		_ = json.NewDecoder(r.Body).Decode(rq)
+		h.detached = append(h.detached, rq.NetworkEndpoints...)
+		_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testNEGOp})
+	case strings.HasSuffix(r.URL.Path, "/operations/"+testNEGOp) && h.operation != nil:
+		_ = json.NewEncoder(w).Encode(h.operation)
+	case r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(&compute.NetworkEndpointGroup{Name: testNEGName, DefaultPort: 80, Size: int64(len(h.endpoints))})
+	default:
+		h.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(&compute.Operation{})
+	}
+}
+
+func withHealth(e []*compute.NetworkEndpoint) []*compute.NetworkEndpointWithHealthStatus {
+	out := make([]*compute.NetworkEndpointWithHealthStatus, len(e))
+	for i := range e {
+		out[i] = &compute.NetworkEndpointWithHealthStatus{NetworkEndpoint: e[i]}
+	}
+	return out
+}
+
+func TestNetworkEndpointGroupObserve(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg       resource.Managed
+		obs      managed.ExternalObservation
+		events   []event.Event
+		interval time.Duration
+		err      error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotNetworkEndpointGroup": {
+			args: args{
+				mg: &v1beta1.Network{},
+			},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotNetworkEndpointGroup),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/"+projectID+"/zones/"+testNEGZone+"/networkEndpointGroups/"+testNEGName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.NetworkEndpointGroup{})
+			}),
+			args: args{
+				mg: negObj(negWithZone(testNEGZone)),
+			},
+			want: want{
+				mg: negObj(negWithZone(testNEGZone)),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.NetworkEndpointGroup{})
+			}),
+			args: args{
+				mg: negObj(),
+			},
+			want: want{
+				mg:  negObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetNetworkEndpointGroup),
+			},
+		},
+		"UpToDate": {
+			handler: &negHandler{t: t, endpoints: []*compute.NetworkEndpoint{{Instance: "vm", IpAddress: "10.0.0.1", Port: 80}}},
+			args: args{
+				mg: negObj(negWithZone(testNEGZone), negWithEndpoints(v1alpha1.NetworkEndpoint{Instance: gcp.StringPtr("vm")})),
+			},
+			want: want{
+				mg: negObj(negWithZone(testNEGZone),
+					negWithEndpoints(v1alpha1.NetworkEndpoint{Instance: gcp.StringPtr("vm")}),
+					negWithObservation(v1alpha1.NetworkEndpointGroupObservation{Size: 1}),
+					negWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"EndpointMissing": {
+			handler: &negHandler{t: t},
+			args: args{
+				mg: negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{Fqdn: gcp.StringPtr("example.com")})),
+			},
+			want: want{
+				mg: negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{Fqdn: gcp.StringPtr("example.com")}),
+					negWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"OperationPending": {
+			handler: &negHandler{t: t, operation: &compute.Operation{Name: testNEGOp, Status: "RUNNING", InsertTime: testNEGOpStarted.Format(time.RFC3339)}},
+			args: args{
+				mg: negObj(negWithZone(testNEGZone),
+					negWithEndpoints(v1alpha1.NetworkEndpoint{Instance: gcp.StringPtr("vm")}),
+					negWithObservation(v1alpha1.NetworkEndpointGroupObservation{Operation: testNEGOp})),
+			},
+			want: want{
+				mg: negObj(negWithZone(testNEGZone),
+					negWithEndpoints(v1alpha1.NetworkEndpoint{Instance: gcp.StringPtr("vm")}),
+					negWithObservation(v1alpha1.NetworkEndpointGroupObservation{Operation: testNEGOp}),
+					negWithConditions(runtimev1alpha1.Available())),
+				obs:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				events:   []event.Event{gcp.OperationEvent(gcp.Operation{Name: testNEGOp})},
+				interval: gcp.DefaultOperationPollInterval,
+			},
+		},
+		"OperationPendingWithPollInterval": {
+			handler: &negHandler{t: t, operation: &compute.Operation{Name: testNEGOp, Status: "RUNNING"}},
+			args: args{
+				mg: negObj(negWithAnnotation(gcp.AnnotationKeyOperationPollInterval, "5s"),
+					negWithObservation(v1alpha1.NetworkEndpointGroupObservation{Operation: testNEGOp})),
+			},
+			want: want{
+				mg: negObj(negWithAnnotation(gcp.AnnotationKeyOperationPollInterval, "5s"),
+					negWithObservation(v1alpha1.NetworkEndpointGroupObservation{Operation: testNEGOp}),
+					negWithConditions(runtimev1alpha1.Available())),
+				obs:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				events:   []event.Event{gcp.OperationEvent(gcp.Operation{Name: testNEGOp})},
+				interval: 5 * time.Second,
+			},
+		},
+		"OperationTimedOut": {
+			handler: &negHandler{t: t, operation: &compute.Operation{Name: testNEGOp, Status: "RUNNING", InsertTime: testNEGOpStarted.Format(time.RFC3339)}},
+			args: args{
+				mg: negObj(negWithAnnotation(gcp.AnnotationKeyOperationTimeout, "5m"),
+					negWithObservation(v1alpha1.NetworkEndpointGroupObservation{Operation: testNEGOp})),
+			},
+			want: want{
+				mg:     negObj(negWithAnnotation(gcp.AnnotationKeyOperationTimeout, "5m")),
+				events: []event.Event{gcp.OperationEvent(gcp.Operation{Name: testNEGOp})},
+				err:    errors.Errorf(errNetworkEndpointsOpTimeout, testNEGOp, 5*time.Minute),
+			},
+		},
+		"OperationDone": {
+			handler: &negHandler{t: t, operation: &compute.Operation{Name: testNEGOp, Status: "DONE"}},
+			args: args{
+				mg: negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{Fqdn: gcp.StringPtr("example.com")}),
+					negWithObservation(v1alpha1.NetworkEndpointGroupObservation{Operation: testNEGOp})),
+			},
+			want: want{
+				mg: negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{Fqdn: gcp.StringPtr("example.com")}),
+					negWithConditions(runtimev1alpha1.Available())),
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				events: []event.Event{gcp.OperationEvent(gcp.Operation{Name: testNEGOp, Done: true})},
+			},
+		},
+		"OperationFailed": {
+			handler: &negHandler{t: t, operation: &compute.Operation{
+				Name:   testNEGOp,
+				Status: "DONE",
+				Error:  &compute.OperationError{Errors: []*compute.OperationErrorErrors{{Message: "boom"}}},
+			}},
+			args: args{
+				mg: negObj(negWithObservation(v1alpha1.NetworkEndpointGroupObservation{Operation: testNEGOp})),
+			},
+			want: want{
+				mg:     negObj(),
+				events: []event.Event{gcp.OperationEvent(gcp.Operation{Name: testNEGOp, Done: true, Error: "boom"})},
+				err:    errors.Wrap(errors.New("boom"), errNetworkEndpointsOpFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			rec := &eventRecorder{}
+			p := gcp.NewOperationPoller()
+			e := negExternal{
+				projectID: projectID,
+				Service:   s,
+				record:    rec,
+				poller:    p,
+				now:       func() time.Time { return testNEGNow },
+			}
+			obs, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, rec.events); diff != "" {
+				t.Errorf("Observe(...): -want events, +got events:\n%s", diff)
+			}
+			res, _ := p.Reconciler(reconcile.Func(func(reconcile.Request) (reconcile.Result, error) {
+				return reconcile.Result{}, nil
+			})).Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: testNEGName}})
+			if diff := cmp.Diff(tc.want.interval, res.RequeueAfter); diff != "" {
+				t.Errorf("Observe(...): -want poll interval, +got poll interval:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNetworkEndpointGroupCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"CreatedZonal": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/"+projectID+"/zones/"+testNEGZone+"/networkEndpointGroups", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: negObj(negWithZone(testNEGZone)),
+			want: want{
+				mg: negObj(negWithZone(testNEGZone), negWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreatedGlobal": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/"+projectID+"/global/networkEndpointGroups", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: negObj(),
+			want: want{
+				mg: negObj(negWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: negObj(),
+			want: want{
+				mg:  negObj(negWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateNetworkEndpointGroup),
+			},
+		},
+		"InvalidEndpoint": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}),
+			mg: negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{IPAddress: gcp.StringPtr("1.2.3")})),
+			want: want{
+				mg: negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{IPAddress: gcp.StringPtr("1.2.3")}),
+					negWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errors.New(`"1.2.3" is not an IPv4 address`), errInvalidNetworkEndpoints),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := negExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNetworkEndpointGroupUpdate(t *testing.T) {
+	type want struct {
+		attached  []*compute.NetworkEndpoint
+		detached  []*compute.NetworkEndpoint
+		operation string
+		events    []event.Event
+		err       error
+	}
+
+	cases := map[string]struct {
+		handler *negHandler
+		mg      resource.Managed
+		want    want
+	}{
+		"AttachBeforeDetach": {
+			handler: &negHandler{t: t, endpoints: []*compute.NetworkEndpoint{
+				{Instance: "vm-1", IpAddress: "10.0.0.1", Port: 80},
+				{Instance: "vm-2", IpAddress: "10.0.0.2", Port: 80},
+			}},
+			mg: negObj(negWithZone(testNEGZone), negWithEndpoints(
+				v1alpha1.NetworkEndpoint{Instance: gcp.StringPtr("vm-1")},
+				v1alpha1.NetworkEndpoint{Instance: gcp.StringPtr("vm-3"), Port: gcp.Int64Ptr(8080)},
+			)),
+			want: want{
+				attached:  []*compute.NetworkEndpoint{{Instance: "vm-3", Port: 8080}},
+				operation: testNEGOp,
+				events:    []event.Event{gcp.OperationEvent(gcp.Operation{Name: testNEGOp})},
+			},
+		},
+		"Detach": {
+			handler: &negHandler{t: t, endpoints: []*compute.NetworkEndpoint{
+				{IpAddress: "203.0.113.1", Port: 443},
+				{IpAddress: "203.0.113.2", Port: 443},
+			}},
+			mg: negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{IPAddress: gcp.StringPtr("203.0.113.1"), Port: gcp.Int64Ptr(443)})),
+			want: want{
+				detached:  []*compute.NetworkEndpoint{{IpAddress: "203.0.113.2", Port: 443}},
+				operation: testNEGOp,
+				events:    []event.Event{gcp.OperationEvent(gcp.Operation{Name: testNEGOp})},
+			},
+		},
+		"InvalidEndpoint": {
+			handler: &negHandler{t: t},
+			mg:      negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{Fqdn: gcp.StringPtr("example.com"), Port: gcp.Int64Ptr(70000)})),
+			want: want{
+				err: errors.Wrap(errors.New("70000 is not a valid port; expected a port between 1 and 65535"), errInvalidNetworkEndpoints),
+			},
+		},
+		"OperationPending": {
+			handler: &negHandler{t: t, endpoints: []*compute.NetworkEndpoint{{Fqdn: "example.com", Port: 80}}},
+			mg: negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{Fqdn: gcp.StringPtr("example.org")}),
+				negWithObservation(v1alpha1.NetworkEndpointGroupObservation{Operation: testNEGOp})),
+			want: want{operation: testNEGOp},
+		},
+		"NothingToDo": {
+			handler: &negHandler{t: t, endpoints: []*compute.NetworkEndpoint{{Fqdn: "example.com", Port: 80}}},
+			mg:      negObj(negWithEndpoints(v1alpha1.NetworkEndpoint{Fqdn: gcp.StringPtr("example.com")})),
+			want:    want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			rec := &eventRecorder{}
+			e := negExternal{
+				projectID: projectID,
+				Service:   s,
+				record:    rec,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.attached, tc.handler.attached); diff != "" {
+				t.Errorf("Update(...): -want attached, +got attached:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detached, tc.handler.detached); diff != "" {
+				t.Errorf("Update(...): -want detached, +got detached:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.operation, tc.mg.(*v1alpha1.NetworkEndpointGroup).Status.AtProvider.Operation); diff != "" {
+				t.Errorf("Update(...): -want operation, +got operation:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, rec.events); diff != "" {
+				t.Errorf("Update(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNetworkEndpointGroupDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: negObj(negWithZone(testNEGZone)),
+			want: want{
+				mg: negObj(negWithZone(testNEGZone), negWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: negObj(),
+			want: want{
+				mg: negObj(negWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: negObj(),
+			want: want{
+				mg:  negObj(negWithConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteNetworkEndpointGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := negExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
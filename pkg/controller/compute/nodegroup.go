@@ -0,0 +1,209 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/nodegroup"
+)
+
+// Error strings.
+const (
+	errNotNodeGroup           = "managed resource is not a NodeGroup resource"
+	errManagedNodeGroupUpdate = "cannot update NodeGroup managed resource"
+	errNodeGroupNodesInUse    = "cannot remove %d nodes from node group: only %d of its nodes have no instances"
+
+	errGetNodeGroup         = "cannot get GCP NodeGroup"
+	errCreateNodeGroup      = "cannot create GCP NodeGroup"
+	errSetNodeGroupTemplate = "cannot set node template of GCP NodeGroup"
+	errPatchNodeGroup       = "cannot update autoscaling policy of GCP NodeGroup"
+	errAddNodeGroupNodes    = "cannot add nodes to GCP NodeGroup"
+	errListNodeGroupNodes   = "cannot list nodes of GCP NodeGroup"
+	errDeleteNodeGroupNodes = "cannot remove nodes from GCP NodeGroup"
+	errDeleteNodeGroup      = "cannot delete GCP NodeGroup"
+)
+
+// SetupNodeGroup adds a controller that reconciles NodeGroup managed
+// resources.
+func SetupNodeGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.NodeGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.NodeGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NodeGroupGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&nodeGroupConnector{kube: mgr.GetClient()})),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type nodeGroupConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *nodeGroupConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NodeGroup)
+	if !ok {
+		return nil, errors.New(errNotNodeGroup)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &nodeGroupExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID, locations: gcp.ComputeLocations}, nil
+}
+
+type nodeGroupExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+	locations *gcp.LocationValidator
+}
+
+func (e *nodeGroupExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NodeGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNodeGroup)
+	}
+	observed, err := e.NodeGroups.Get(e.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNodeGroup)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	nodegroup.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedNodeGroupUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = nodegroup.GenerateNodeGroupObservation(*observed)
+	cr.Status.SetConditions(nodegroup.Condition(observed.Status))
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: nodegroup.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *nodeGroupExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NodeGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNodeGroup)
+	}
+	if err := e.locations.ValidateZone(ctx, e.Service, e.projectID, cr.Spec.ForProvider.Zone, ""); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNodeGroup)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	ng := nodegroup.GenerateNodeGroup(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.NodeGroups.Insert(e.projectID, cr.Spec.ForProvider.Zone, cr.Spec.ForProvider.Size, ng).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNodeGroup)
+}
+
+// Update applies the first of the node template, autoscaling policy and size
+// of a node group that is not up to date. Each is changed by an operation
+// that must complete before the node group can be changed again, so any
+// remaining changes are applied by subsequent reconciles.
+func (e *nodeGroupExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NodeGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNodeGroup)
+	}
+	zone, name := cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)
+	observed, err := e.NodeGroups.Get(e.projectID, zone, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetNodeGroup)
+	}
+
+	switch {
+	case !nodegroup.IsNodeTemplateUpToDate(cr.Spec.ForProvider, *observed):
+		req := &googlecompute.NodeGroupsSetNodeTemplateRequest{NodeTemplate: gcp.StringValue(cr.Spec.ForProvider.NodeTemplate)}
+		_, err := e.NodeGroups.SetNodeTemplate(e.projectID, zone, name, req).Context(ctx).Do()
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSetNodeGroupTemplate)
+	case !nodegroup.IsAutoscalingPolicyUpToDate(cr.Spec.ForProvider, *observed):
+		ng := &googlecompute.NodeGroup{AutoscalingPolicy: nodegroup.GenerateAutoscalingPolicy(cr.Spec.ForProvider.AutoscalingPolicy)}
+		_, err := e.NodeGroups.Patch(e.projectID, zone, name, ng).Context(ctx).Do()
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPatchNodeGroup)
+	case cr.Spec.ForProvider.Size > observed.Size:
+		req := &googlecompute.NodeGroupsAddNodesRequest{AdditionalNodeCount: cr.Spec.ForProvider.Size - observed.Size}
+		_, err := e.NodeGroups.AddNodes(e.projectID, zone, name, req).Context(ctx).Do()
+		return managed.ExternalUpdate{}, errors.Wrap(err, errAddNodeGroupNodes)
+	case !nodegroup.IsSizeUpToDate(cr.Spec.ForProvider, *observed):
+		return managed.ExternalUpdate{}, e.removeNodes(ctx, cr, observed.Size-cr.Spec.ForProvider.Size)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// removeNodes removes count nodes that no instances are scheduled on from
+// the supplied node group. No nodes are removed unless enough of them are
+// empty.
+func (e *nodeGroupExternal) removeNodes(ctx context.Context, cr *v1alpha1.NodeGroup, count int64) error {
+	zone, name := cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)
+	var nodes []*googlecompute.NodeGroupNode
+	err := e.NodeGroups.ListNodes(e.projectID, zone, name).Pages(ctx, func(l *googlecompute.NodeGroupsListNodes) error {
+		nodes = append(nodes, l.Items...)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, errListNodeGroupNodes)
+	}
+	names := nodegroup.RemovableNodes(nodes, count)
+	if int64(len(names)) < count {
+		return errors.Errorf(errNodeGroupNodesInUse, count, len(names))
+	}
+	req := &googlecompute.NodeGroupsDeleteNodesRequest{Nodes: names}
+	_, err = e.NodeGroups.DeleteNodes(e.projectID, zone, name, req).Context(ctx).Do()
+	return errors.Wrap(err, errDeleteNodeGroupNodes)
+}
+
+func (e *nodeGroupExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NodeGroup)
+	if !ok {
+		return errors.New(errNotNodeGroup)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.NodeGroups.Delete(e.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNodeGroup)
+}
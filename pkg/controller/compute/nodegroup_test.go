@@ -0,0 +1,408 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/nodegroup"
+)
+
+const (
+	testNodeGroupName     = "test-nodegroup"
+	testNodeGroupPath     = "/" + projectID + "/zones/us-central1-a/nodeGroups/" + testNodeGroupName
+	testNodeGroupTemplate = "projects/" + projectID + "/regions/us-central1/nodeTemplates/licensed"
+)
+
+var _ managed.ExternalConnecter = &nodeGroupConnector{}
+var _ managed.ExternalClient = &nodeGroupExternal{}
+
+type nodeGroupModifier func(*v1alpha1.NodeGroup)
+
+func nodeGroupWithConditions(c ...runtimev1alpha1.Condition) nodeGroupModifier {
+	return func(i *v1alpha1.NodeGroup) { i.Status.SetConditions(c...) }
+}
+
+func nodeGroupWithObservation(o v1alpha1.NodeGroupObservation) nodeGroupModifier {
+	return func(i *v1alpha1.NodeGroup) { i.Status.AtProvider = o }
+}
+
+func nodeGroupWithSize(s int64) nodeGroupModifier {
+	return func(i *v1alpha1.NodeGroup) { i.Spec.ForProvider.Size = s }
+}
+
+func nodeGroupObj(im ...nodeGroupModifier) *v1alpha1.NodeGroup {
+	i := &v1alpha1.NodeGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testNodeGroupName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testNodeGroupName,
+			},
+		},
+		Spec: v1alpha1.NodeGroupSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.NodeGroupParameters{
+				Zone:              "us-central1-a",
+				NodeTemplate:      gcp.StringPtr(testNodeGroupTemplate),
+				Size:              2,
+				MaintenancePolicy: gcp.StringPtr("DEFAULT"),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// observedNodeGroup returns the node group described by the supplied
+// NodeGroup, as returned by Compute Engine.
+func observedNodeGroup(cr *v1alpha1.NodeGroup) *compute.NodeGroup {
+	ng := nodegroup.GenerateNodeGroup(testNodeGroupName, cr.Spec.ForProvider)
+	ng.NodeTemplate = "https://www.googleapis.com/compute/v1/" + ng.NodeTemplate
+	ng.Size = cr.Spec.ForProvider.Size
+	ng.Status = v1alpha1.NodeGroupStatusReady
+	return ng
+}
+
+func TestNodeGroupObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotNodeGroup": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotNodeGroup),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testNodeGroupPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.NodeGroup{})
+			}),
+			mg: nodeGroupObj(),
+			want: want{
+				mg: nodeGroupObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.NodeGroup{})
+			}),
+			mg: nodeGroupObj(),
+			want: want{
+				mg:  nodeGroupObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetNodeGroup),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedNodeGroup(nodeGroupObj()))
+			}),
+			mg: nodeGroupObj(),
+			want: want{
+				mg: nodeGroupObj(
+					nodeGroupWithObservation(v1alpha1.NodeGroupObservation{Status: v1alpha1.NodeGroupStatusReady, Size: 2}),
+					nodeGroupWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SizeDiffers": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedNodeGroup(nodeGroupObj()))
+			}),
+			mg: nodeGroupObj(nodeGroupWithSize(3)),
+			want: want{
+				mg: nodeGroupObj(
+					nodeGroupWithSize(3),
+					nodeGroupWithObservation(v1alpha1.NodeGroupObservation{Status: v1alpha1.NodeGroupStatusReady, Size: 2}),
+					nodeGroupWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := nodeGroupExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeGroupCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("2", r.URL.Query().Get("initialNodeCount")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: nodeGroupObj(),
+			want: want{
+				mg: nodeGroupObj(nodeGroupWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: nodeGroupObj(),
+			want: want{
+				mg:  nodeGroupObj(nodeGroupWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateNodeGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := nodeGroupExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// nodeGroupHandler serves the supplied node group and its nodes, and
+// records the path of any other request.
+func nodeGroupHandler(ng *compute.NodeGroup, nodes []*compute.NodeGroupNode, called *string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == testNodeGroupPath:
+			_ = json.NewEncoder(w).Encode(ng)
+		case r.URL.Path == testNodeGroupPath+"/listNodes":
+			_ = json.NewEncoder(w).Encode(&compute.NodeGroupsListNodes{Items: nodes})
+		default:
+			*called = r.Method + " " + r.URL.Path
+			_ = json.NewEncoder(w).Encode(&compute.Operation{})
+		}
+	})
+}
+
+func TestNodeGroupUpdate(t *testing.T) {
+	type want struct {
+		called string
+		err    error
+	}
+
+	cases := map[string]struct {
+		observed *compute.NodeGroup
+		nodes    []*compute.NodeGroupNode
+		mg       resource.Managed
+		want     want
+	}{
+		"UpToDate": {
+			observed: observedNodeGroup(nodeGroupObj()),
+			mg:       nodeGroupObj(),
+			want:     want{},
+		},
+		"SetNodeTemplate": {
+			observed: func() *compute.NodeGroup {
+				ng := observedNodeGroup(nodeGroupObj(nodeGroupWithSize(1)))
+				ng.NodeTemplate = "projects/" + projectID + "/regions/us-central1/nodeTemplates/other"
+				return ng
+			}(),
+			mg:   nodeGroupObj(),
+			want: want{called: http.MethodPost + " " + testNodeGroupPath + "/setNodeTemplate"},
+		},
+		"PatchAutoscalingPolicy": {
+			observed: observedNodeGroup(nodeGroupObj(nodeGroupWithSize(1))),
+			mg: nodeGroupObj(func(i *v1alpha1.NodeGroup) {
+				i.Spec.ForProvider.AutoscalingPolicy = &v1alpha1.NodeGroupAutoscalingPolicy{MaxNodes: 4}
+			}),
+			want: want{called: http.MethodPatch + " " + testNodeGroupPath},
+		},
+		"AddNodes": {
+			observed: observedNodeGroup(nodeGroupObj(nodeGroupWithSize(1))),
+			mg:       nodeGroupObj(),
+			want:     want{called: http.MethodPost + " " + testNodeGroupPath + "/addNodes"},
+		},
+		"DeleteNodes": {
+			observed: observedNodeGroup(nodeGroupObj(nodeGroupWithSize(3))),
+			nodes:    []*compute.NodeGroupNode{{Name: "busy", Instances: []string{"vm"}}, {Name: "empty"}},
+			mg:       nodeGroupObj(),
+			want:     want{called: http.MethodPost + " " + testNodeGroupPath + "/deleteNodes"},
+		},
+		"NodesInUse": {
+			observed: observedNodeGroup(nodeGroupObj(nodeGroupWithSize(3))),
+			nodes:    []*compute.NodeGroupNode{{Name: "busy", Instances: []string{"vm"}}, {Name: "empty"}},
+			mg:       nodeGroupObj(nodeGroupWithSize(1)),
+			want:     want{err: errors.Errorf(errNodeGroupNodesInUse, 2, 1)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := ""
+			server := httptest.NewServer(nodeGroupHandler(tc.observed, tc.nodes, &called))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := nodeGroupExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("Update(...): -want call, +got call:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeGroupDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: nodeGroupObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: nodeGroupObj(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  nodeGroupObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteNodeGroup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := nodeGroupExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/nodetemplate"
+)
+
+// Error strings.
+const (
+	errNotNodeTemplate           = "managed resource is not a NodeTemplate resource"
+	errManagedNodeTemplateUpdate = "cannot update NodeTemplate managed resource"
+	errNodeTemplateImmutable     = "cannot change an existing node template; annotate the NodeTemplate with %s: \"true\" to delete and recreate it"
+
+	errGetNodeTemplate    = "cannot get GCP NodeTemplate"
+	errCreateNodeTemplate = "cannot create GCP NodeTemplate"
+	errDeleteNodeTemplate = "cannot delete GCP NodeTemplate"
+)
+
+// SetupNodeTemplate adds a controller that reconciles NodeTemplate
+// managed resources.
+func SetupNodeTemplate(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.NodeTemplateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.NodeTemplate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NodeTemplateGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&nodeTemplateConnector{kube: mgr.GetClient()})),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type nodeTemplateConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*nodetemplate.Service, error)
+}
+
+func (c *nodeTemplateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.NodeTemplate)
+	if !ok {
+		return nil, errors.New(errNotNodeTemplate)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = nodetemplate.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &nodeTemplateExternal{templates: s, kube: c.kube, projectID: provider.Spec.ProjectID}, nil
+}
+
+type nodeTemplateExternal struct {
+	kube      client.Client
+	templates *nodetemplate.Service
+	projectID string
+}
+
+func (e *nodeTemplateExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NodeTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNodeTemplate)
+	}
+	observed, err := e.templates.Get(ctx, e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNodeTemplate)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	nodetemplate.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedNodeTemplateUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = nodetemplate.GenerateNodeTemplateObservation(*observed)
+	cr.Status.SetConditions(nodetemplate.Condition(observed.Status))
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: nodetemplate.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *nodeTemplateExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NodeTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNodeTemplate)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	nt := nodetemplate.GenerateNodeTemplate(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.templates.Insert(ctx, e.projectID, cr.Spec.ForProvider.Region, nt)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNodeTemplate)
+}
+
+// Update handles changes to a node template, none of which can be applied
+// to an existing one. The template is deleted, to be created again per its
+// spec, only if the NodeTemplate asks to be recreated.
+func (e *nodeTemplateExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NodeTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNodeTemplate)
+	}
+	if !gcp.ShouldRecreate(cr) {
+		return managed.ExternalUpdate{}, errors.Errorf(errNodeTemplateImmutable, gcp.AnnotationKeyRecreate)
+	}
+	return managed.ExternalUpdate{}, e.Delete(ctx, cr)
+}
+
+func (e *nodeTemplateExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NodeTemplate)
+	if !ok {
+		return errors.New(errNotNodeTemplate)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.templates.Delete(ctx, e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNodeTemplate)
+}
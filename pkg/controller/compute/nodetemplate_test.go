@@ -0,0 +1,385 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/nodetemplate"
+)
+
+const (
+	testNodeTemplateName = "test-nodetemplate"
+	testNodeTemplatePath = "/projects/" + projectID + "/regions/us-central1/nodeTemplates/" + testNodeTemplateName
+)
+
+var _ managed.ExternalConnecter = &nodeTemplateConnector{}
+var _ managed.ExternalClient = &nodeTemplateExternal{}
+
+type nodeTemplateModifier func(*v1alpha1.NodeTemplate)
+
+func nodeTemplateWithConditions(c ...runtimev1alpha1.Condition) nodeTemplateModifier {
+	return func(i *v1alpha1.NodeTemplate) { i.Status.SetConditions(c...) }
+}
+
+func nodeTemplateWithDescription(d string) nodeTemplateModifier {
+	return func(i *v1alpha1.NodeTemplate) { i.Spec.ForProvider.Description = &d }
+}
+
+func nodeTemplateWithStatus(s string) nodeTemplateModifier {
+	return func(i *v1alpha1.NodeTemplate) { i.Status.AtProvider.Status = s }
+}
+
+func nodeTemplateWithRecreate() nodeTemplateModifier {
+	return func(i *v1alpha1.NodeTemplate) {
+		meta.AddAnnotations(i, map[string]string{gcp.AnnotationKeyRecreate: "true"})
+	}
+}
+
+func nodeTemplateObj(im ...nodeTemplateModifier) *v1alpha1.NodeTemplate {
+	i := &v1alpha1.NodeTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testNodeTemplateName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testNodeTemplateName,
+			},
+		},
+		Spec: v1alpha1.NodeTemplateSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.NodeTemplateParameters{
+				Region:            "us-central1",
+				NodeType:          gcp.StringPtr("n1-node-96-624"),
+				ServerBinding:     gcp.StringPtr("RESTART_NODE_ON_MINIMAL_SERVERS"),
+				CPUOvercommitType: gcp.StringPtr("ENABLED"),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func newNodeTemplateService(t *testing.T, h http.Handler) (*nodetemplate.Service, func()) {
+	server := httptest.NewServer(h)
+	s, err := nodetemplate.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("nodetemplate.NewService(...): %s", err)
+	}
+	return s, server.Close
+}
+
+func TestNodeTemplateObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotNodeTemplate": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotNodeTemplate),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testNodeTemplatePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&nodetemplate.NodeTemplate{})
+			}),
+			mg: nodeTemplateObj(),
+			want: want{
+				mg: nodeTemplateObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&nodetemplate.NodeTemplate{})
+			}),
+			mg: nodeTemplateObj(),
+			want: want{
+				mg:  nodeTemplateObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetNodeTemplate),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				nt := nodetemplate.GenerateNodeTemplate(testNodeTemplateName, nodeTemplateObj().Spec.ForProvider)
+				nt.Description = "licensed"
+				_ = json.NewEncoder(w).Encode(nt)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   nodeTemplateObj(),
+			want: want{
+				mg:  nodeTemplateObj(nodeTemplateWithDescription("licensed")),
+				err: errors.Wrap(errBoom, errManagedNodeTemplateUpdate),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				nt := nodetemplate.GenerateNodeTemplate(testNodeTemplateName, nodeTemplateObj().Spec.ForProvider)
+				nt.Status = v1alpha1.NodeTemplateStatusReady
+				_ = json.NewEncoder(w).Encode(nt)
+			}),
+			mg: nodeTemplateObj(),
+			want: want{
+				mg: nodeTemplateObj(
+					nodeTemplateWithStatus(v1alpha1.NodeTemplateStatusReady),
+					nodeTemplateWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				nt := nodetemplate.GenerateNodeTemplate(testNodeTemplateName, nodeTemplateObj().Spec.ForProvider)
+				nt.CPUOvercommitType = "NONE"
+				nt.Status = v1alpha1.NodeTemplateStatusReady
+				_ = json.NewEncoder(w).Encode(nt)
+			}),
+			mg: nodeTemplateObj(),
+			want: want{
+				mg: nodeTemplateObj(
+					nodeTemplateWithStatus(v1alpha1.NodeTemplateStatusReady),
+					nodeTemplateWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, done := newNodeTemplateService(t, tc.handler)
+			defer done()
+			e := nodeTemplateExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				templates: s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeTemplateCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &nodetemplate.NodeTemplate{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				_ = r.Body.Close()
+				want := nodetemplate.GenerateNodeTemplate(testNodeTemplateName, nodeTemplateObj().Spec.ForProvider)
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: nodeTemplateObj(),
+			want: want{
+				mg: nodeTemplateObj(nodeTemplateWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: nodeTemplateObj(),
+			want: want{
+				mg:  nodeTemplateObj(nodeTemplateWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateNodeTemplate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, done := newNodeTemplateService(t, tc.handler)
+			defer done()
+			e := nodeTemplateExternal{
+				projectID: projectID,
+				templates: s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeTemplateUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Immutable": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected %s request", r.Method)
+			}),
+			mg:  nodeTemplateObj(),
+			err: errors.Errorf(errNodeTemplateImmutable, gcp.AnnotationKeyRecreate),
+		},
+		"Recreate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: nodeTemplateObj(nodeTemplateWithRecreate()),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, done := newNodeTemplateService(t, tc.handler)
+			defer done()
+			e := nodeTemplateExternal{
+				projectID: projectID,
+				templates: s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNodeTemplateDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(testNodeTemplatePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: nodeTemplateObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: nodeTemplateObj(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  nodeTemplateObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteNodeTemplate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, done := newNodeTemplateService(t, tc.handler)
+			defer done()
+			e := nodeTemplateExternal{
+				projectID: projectID,
+				templates: s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
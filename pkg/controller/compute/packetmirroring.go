@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/packetmirroring"
+)
+
+// Error strings.
+const (
+	errNotPacketMirroring           = "managed resource is not a PacketMirroring resource"
+	errManagedPacketMirroringUpdate = "cannot update PacketMirroring managed resource"
+
+	errGetPacketMirroring    = "cannot get GCP PacketMirroring"
+	errCreatePacketMirroring = "cannot create GCP PacketMirroring"
+	errUpdatePacketMirroring = "cannot update GCP PacketMirroring"
+	errDeletePacketMirroring = "cannot delete GCP PacketMirroring"
+)
+
+// SetupPacketMirroring adds a controller that reconciles PacketMirroring
+// managed resources.
+func SetupPacketMirroring(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.PacketMirroringGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.PacketMirroring{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PacketMirroringGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&packetMirroringConnector{kube: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type packetMirroringConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *packetMirroringConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return nil, errors.New(errNotPacketMirroring)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &packetMirroringExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID, locations: gcp.ComputeLocations}, nil
+}
+
+type packetMirroringExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+	locations *gcp.LocationValidator
+}
+
+func (e *packetMirroringExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPacketMirroring)
+	}
+	observed, err := e.PacketMirrorings.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPacketMirroring)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	packetmirroring.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedPacketMirroringUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = packetmirroring.GeneratePacketMirroringObservation(*observed)
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: packetmirroring.IsUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *packetMirroringExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPacketMirroring)
+	}
+	if err := e.locations.ValidateRegion(ctx, e.Service, e.projectID, cr.Spec.ForProvider.Region); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePacketMirroring)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	pm := packetmirroring.GeneratePacketMirroring(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.PacketMirrorings.Insert(e.projectID, cr.Spec.ForProvider.Region, pm).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreatePacketMirroring)
+}
+
+func (e *packetMirroringExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPacketMirroring)
+	}
+	pm := packetmirroring.GeneratePacketMirroring(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.PacketMirrorings.Patch(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), pm).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePacketMirroring)
+}
+
+func (e *packetMirroringExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PacketMirroring)
+	if !ok {
+		return errors.New(errNotPacketMirroring)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.PacketMirrorings.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePacketMirroring)
+}
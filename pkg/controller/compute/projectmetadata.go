@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/projectmetadata"
+)
+
+// Error strings.
+const (
+	errNotProjectMetadata = "managed resource is not a ProjectMetadata resource"
+	errGetProject         = "cannot get GCP project"
+	errSetProjectMetadata = "cannot set GCP project common instance metadata"
+)
+
+// setMetadataAttempts is how often setting the common instance metadata of a
+// project is attempted when it was changed concurrently.
+const setMetadataAttempts = 3
+
+// SetupProjectMetadata adds a controller that reconciles ProjectMetadata
+// managed resources.
+func SetupProjectMetadata(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ProjectMetadataGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ProjectMetadata{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProjectMetadataGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&projectMetadataConnector{kube: mgr.GetClient()})),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type projectMetadataConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *projectMetadataConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectMetadata)
+	if !ok {
+		return nil, errors.New(errNotProjectMetadata)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &projectMetadataExternal{Service: s, projectID: provider.Spec.ProjectID}, nil
+}
+
+type projectMetadataExternal struct {
+	*googlecompute.Service
+	projectID string
+}
+
+// Observe considers the metadata to exist if any of the keys it declares is
+// set, so that deleting it completes once all of them were removed.
+func (e *projectMetadataExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectMetadata)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectMetadata)
+	}
+	md, err := e.metadata(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = projectmetadata.GenerateObservation(md)
+	if !projectmetadata.Exists(cr.Spec.ForProvider, md) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: projectmetadata.IsUpToDate(cr.Spec.ForProvider, md),
+	}, nil
+}
+
+func (e *projectMetadataExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectMetadata)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectMetadata)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	return managed.ExternalCreation{}, e.set(ctx, func(md googlecompute.Metadata) (*googlecompute.Metadata, bool) {
+		return projectmetadata.GenerateMetadata(cr.Spec.ForProvider, md), true
+	})
+}
+
+func (e *projectMetadataExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectMetadata)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProjectMetadata)
+	}
+	return managed.ExternalUpdate{}, e.set(ctx, func(md googlecompute.Metadata) (*googlecompute.Metadata, bool) {
+		return projectmetadata.GenerateMetadata(cr.Spec.ForProvider, md), true
+	})
+}
+
+// Delete removes the keys declared by the metadata from the project. Keys
+// that are not declared are left untouched.
+func (e *projectMetadataExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectMetadata)
+	if !ok {
+		return errors.New(errNotProjectMetadata)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	return e.set(ctx, func(md googlecompute.Metadata) (*googlecompute.Metadata, bool) {
+		return projectmetadata.RemoveItems(cr.Spec.ForProvider, md)
+	})
+}
+
+func (e *projectMetadataExternal) metadata(ctx context.Context) (googlecompute.Metadata, error) {
+	p, err := e.Projects.Get(e.projectID).Context(ctx).Do()
+	if err != nil {
+		return googlecompute.Metadata{}, errors.Wrap(err, errGetProject)
+	}
+	if p.CommonInstanceMetadata == nil {
+		return googlecompute.Metadata{}, nil
+	}
+	return *p.CommonInstanceMetadata, nil
+}
+
+// set reads the common instance metadata of the project, modifies it using
+// the supplied function and writes it back if the function reports a change.
+// The metadata is written with the fingerprint it was read with; if it was
+// changed concurrently it is read, modified and written again.
+func (e *projectMetadataExternal) set(ctx context.Context, modify func(md googlecompute.Metadata) (*googlecompute.Metadata, bool)) error {
+	var err error
+	for i := 0; i < setMetadataAttempts; i++ {
+		md, gerr := e.metadata(ctx)
+		if gerr != nil {
+			return gerr
+		}
+		desired, changed := modify(md)
+		if !changed {
+			return nil
+		}
+		_, err = e.Projects.SetCommonInstanceMetadata(e.projectID, desired).Context(ctx).Do()
+		if !gcp.IsErrorPreconditionFailed(err) {
+			break
+		}
+	}
+	return errors.Wrap(err, errSetProjectMetadata)
+}
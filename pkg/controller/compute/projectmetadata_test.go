@@ -0,0 +1,412 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testProjectPath            = "/" + projectID
+	testSetProjectMetadataPath = "/" + projectID + "/setCommonInstanceMetadata"
+)
+
+var _ managed.ExternalConnecter = &projectMetadataConnector{}
+var _ managed.ExternalClient = &projectMetadataExternal{}
+
+type projectMetadataModifier func(*v1alpha1.ProjectMetadata)
+
+func projectMetadataWithConditions(c ...runtimev1alpha1.Condition) projectMetadataModifier {
+	return func(i *v1alpha1.ProjectMetadata) { i.Status.SetConditions(c...) }
+}
+
+func projectMetadataWithFingerprint(f string) projectMetadataModifier {
+	return func(i *v1alpha1.ProjectMetadata) { i.Status.AtProvider.Fingerprint = f }
+}
+
+func projectMetadataObj(im ...projectMetadataModifier) *v1alpha1.ProjectMetadata {
+	i := &v1alpha1.ProjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-metadata"},
+		Spec: v1alpha1.ProjectMetadataSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ProjectMetadataParameters{
+				EnableOSLogin: gcp.BoolPtr(true),
+				Items:         map[string]string{"team": "platform"},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// metadataItems returns the supplied keys and values as metadata items.
+func metadataItems(kv ...string) []*compute.MetadataItems {
+	items := make([]*compute.MetadataItems, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		v := kv[i+1]
+		items = append(items, &compute.MetadataItems{Key: kv[i], Value: &v})
+	}
+	return items
+}
+
+// projectWithMetadata returns a project whose common instance metadata has
+// the supplied fingerprint, keys and values.
+func projectWithMetadata(fingerprint string, kv ...string) *compute.Project {
+	return &compute.Project{
+		Name:                   projectID,
+		CommonInstanceMetadata: &compute.Metadata{Fingerprint: fingerprint, Items: metadataItems(kv...)},
+	}
+}
+
+func TestProjectMetadataObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotProjectMetadata": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotProjectMetadata),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Project{})
+			}),
+			mg: projectMetadataObj(),
+			want: want{
+				mg:  projectMetadataObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetProject),
+			},
+		},
+		"NotSet": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testProjectPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(projectWithMetadata("fp", "other", "value"))
+			}),
+			mg: projectMetadataObj(),
+			want: want{
+				mg:  projectMetadataObj(projectMetadataWithFingerprint("fp")),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(projectWithMetadata("fp", "enable-oslogin", "true", "team", "platform", "other", "value"))
+			}),
+			mg: projectMetadataObj(),
+			want: want{
+				mg:  projectMetadataObj(projectMetadataWithFingerprint("fp"), projectMetadataWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ItemMissing": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(projectWithMetadata("fp", "enable-oslogin", "TRUE"))
+			}),
+			mg: projectMetadataObj(),
+			want: want{
+				mg:  projectMetadataObj(projectMetadataWithFingerprint("fp"), projectMetadataWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := projectMetadataExternal{projectID: projectID, Service: s}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectMetadataCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				switch r.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(projectWithMetadata("fp", "other", "value"))
+				case http.MethodPost:
+					if diff := cmp.Diff(testSetProjectMetadataPath, r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					got := &compute.Metadata{}
+					_ = json.NewDecoder(r.Body).Decode(got)
+					want := &compute.Metadata{Fingerprint: "fp", Items: metadataItems("other", "value", "enable-oslogin", "TRUE", "team", "platform")}
+					if diff := cmp.Diff(want, got); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				}
+			}),
+			mg: projectMetadataObj(),
+			want: want{
+				mg: projectMetadataObj(projectMetadataWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"SetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(projectWithMetadata("fp"))
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: projectMetadataObj(),
+			want: want{
+				mg:  projectMetadataObj(projectMetadataWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errSetProjectMetadata),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := projectMetadataExternal{projectID: projectID, Service: s}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectMetadataUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler func(gets, sets *int) http.Handler
+		mg      resource.Managed
+		gets    int
+		sets    int
+		err     error
+	}{
+		"Updated": {
+			handler: func(gets, sets *int) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					defer func() { _ = r.Body.Close() }()
+					if r.Method == http.MethodGet {
+						*gets++
+						_ = json.NewEncoder(w).Encode(projectWithMetadata("fp", "enable-oslogin", "FALSE", "team", "platform"))
+						return
+					}
+					*sets++
+					got := &compute.Metadata{}
+					_ = json.NewDecoder(r.Body).Decode(got)
+					want := &compute.Metadata{Fingerprint: "fp", Items: metadataItems("enable-oslogin", "TRUE", "team", "platform")}
+					if diff := cmp.Diff(want, got); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				})
+			},
+			mg:   projectMetadataObj(),
+			gets: 1,
+			sets: 1,
+		},
+		"ConcurrentChange": {
+			handler: func(gets, sets *int) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					defer func() { _ = r.Body.Close() }()
+					if r.Method == http.MethodGet {
+						*gets++
+						if *gets == 1 {
+							_ = json.NewEncoder(w).Encode(projectWithMetadata("fp1", "enable-oslogin", "FALSE"))
+							return
+						}
+						_ = json.NewEncoder(w).Encode(projectWithMetadata("fp2", "enable-oslogin", "FALSE", "new", "value"))
+						return
+					}
+					*sets++
+					got := &compute.Metadata{}
+					_ = json.NewDecoder(r.Body).Decode(got)
+					if got.Fingerprint == "fp1" {
+						w.WriteHeader(http.StatusPreconditionFailed)
+						_ = json.NewEncoder(w).Encode(&compute.Operation{})
+						return
+					}
+					want := &compute.Metadata{Fingerprint: "fp2", Items: metadataItems("enable-oslogin", "TRUE", "new", "value", "team", "platform")}
+					if diff := cmp.Diff(want, got); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				})
+			},
+			mg:   projectMetadataObj(),
+			gets: 2,
+			sets: 2,
+		},
+		"PersistentConflict": {
+			handler: func(gets, sets *int) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					_ = r.Body.Close()
+					if r.Method == http.MethodGet {
+						*gets++
+						_ = json.NewEncoder(w).Encode(projectWithMetadata("fp"))
+						return
+					}
+					*sets++
+					w.WriteHeader(http.StatusPreconditionFailed)
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				})
+			},
+			mg:   projectMetadataObj(),
+			gets: setMetadataAttempts,
+			sets: setMetadataAttempts,
+			err:  errors.Wrap(gError(http.StatusPreconditionFailed, ""), errSetProjectMetadata),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gets, sets int
+			server := httptest.NewServer(tc.handler(&gets, &sets))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := projectMetadataExternal{projectID: projectID, Service: s}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.gets, gets); diff != "" {
+				t.Errorf("Update(...): -want gets, +got gets:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.sets, sets); diff != "" {
+				t.Errorf("Update(...): -want sets, +got sets:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectMetadataDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Removed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(projectWithMetadata("fp", "enable-oslogin", "TRUE", "other", "value"))
+					return
+				}
+				got := &compute.Metadata{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				want := &compute.Metadata{Fingerprint: "fp", Items: metadataItems("other", "value")}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: projectMetadataObj(),
+		},
+		"AlreadyRemoved": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method != http.MethodGet {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				_ = json.NewEncoder(w).Encode(projectWithMetadata("fp", "other", "value"))
+			}),
+			mg: projectMetadataObj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := projectMetadataExternal{projectID: projectID, Service: s}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcpapis "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/regiondisk"
+)
+
+// Error strings.
+const (
+	errNotRegionDisk           = "managed resource is not a RegionDisk resource"
+	errManagedRegionDiskUpdate = "cannot update RegionDisk managed resource"
+	errInvalidReplicaZones     = "invalid replica zones"
+
+	errGetRegionDisk    = "cannot get GCP RegionDisk"
+	errCreateRegionDisk = "cannot create GCP RegionDisk"
+	errUpdateRegionDisk = "cannot update GCP RegionDisk"
+	errDeleteRegionDisk = "cannot delete GCP RegionDisk"
+	errShrinkRegionDisk = "cannot shrink GCP RegionDisk; the size of a regional disk can only be increased"
+	errRegionDiskRegion = "cannot determine region of RegionDisk"
+)
+
+// SetupRegionDisk adds a controller that reconciles RegionDisk managed
+// resources.
+func SetupRegionDisk(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.RegionDiskGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.RegionDisk{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RegionDiskGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&regionDiskConnector{kube: mgr.GetClient()})),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &regionDiskRegionDefaulter{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type regionDiskRegionDefaulter struct {
+	kube client.Client
+}
+
+// Initialize defaults the region of the RegionDisk to the default region of
+// its Provider and ensures that it is a region. The default is persisted so
+// that the disk does not move if the Provider's default later changes.
+func (d *regionDiskRegionDefaulter) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RegionDisk)
+	if !ok {
+		return errors.New(errNotRegionDisk)
+	}
+	p := &gcpapis.Provider{}
+	if err := d.kube.Get(ctx, meta.NamespacedNameOf(cr.Spec.ProviderReference), p); err != nil {
+		return errors.Wrap(err, errProviderNotRetrieved)
+	}
+	r, err := gcp.Region(cr.Spec.ForProvider.Region, p)
+	if err != nil {
+		return errors.Wrap(err, errRegionDiskRegion)
+	}
+	if cr.Spec.ForProvider.Region == r {
+		return nil
+	}
+	cr.Spec.ForProvider.Region = r
+	return errors.Wrap(d.kube.Update(ctx, cr), errManagedRegionDiskUpdate)
+}
+
+type regionDiskConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *regionDiskConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RegionDisk)
+	if !ok {
+		return nil, errors.New(errNotRegionDisk)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &regionDiskExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID, locations: gcp.ComputeLocations}, nil
+}
+
+type regionDiskExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+	locations *gcp.LocationValidator
+}
+
+func (e *regionDiskExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RegionDisk)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRegionDisk)
+	}
+	observed, err := e.RegionDisks.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetRegionDisk)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	regiondisk.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedRegionDiskUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = regiondisk.GenerateRegionDiskObservation(*observed)
+	cr.Status.SetConditions(regiondisk.Condition(observed.Status))
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: regiondisk.IsUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *regionDiskExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RegionDisk)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRegionDisk)
+	}
+	if err := regiondisk.ValidateReplicaZones(cr.Spec.ForProvider.Region, cr.Spec.ForProvider.ReplicaZones); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidReplicaZones)
+	}
+	if err := e.locations.ValidateRegion(ctx, e.Service, e.projectID, cr.Spec.ForProvider.Region); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRegionDisk)
+	}
+	for _, z := range cr.Spec.ForProvider.ReplicaZones {
+		if err := e.locations.ValidateZone(ctx, e.Service, e.projectID, z, cr.Spec.ForProvider.Region); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errInvalidReplicaZones)
+		}
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	d := regiondisk.GenerateRegionDisk(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.RegionDisks.Insert(e.projectID, cr.Spec.ForProvider.Region, d).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateRegionDisk)
+}
+
+func (e *regionDiskExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RegionDisk)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRegionDisk)
+	}
+	// Only the size of a regional disk can be changed, and only increased.
+	observed, err := e.RegionDisks.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetRegionDisk)
+	}
+	size := gcp.Int64Value(cr.Spec.ForProvider.SizeGb)
+	if size < observed.SizeGb {
+		return managed.ExternalUpdate{}, errors.New(errShrinkRegionDisk)
+	}
+	rr := &googlecompute.RegionDisksResizeRequest{SizeGb: size}
+	_, err = e.RegionDisks.Resize(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), rr).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRegionDisk)
+}
+
+func (e *regionDiskExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RegionDisk)
+	if !ok {
+		return errors.New(errNotRegionDisk)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.RegionDisks.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteRegionDisk)
+}
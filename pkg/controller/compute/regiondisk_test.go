@@ -0,0 +1,426 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/regiondisk"
+)
+
+const (
+	testRegionDiskName = "test-regiondisk"
+	testRegionDiskPath = "/" + projectID + "/regions/us-central1/disks/" + testRegionDiskName
+)
+
+var _ managed.ExternalConnecter = &regionDiskConnector{}
+var _ managed.ExternalClient = &regionDiskExternal{}
+
+type regionDiskModifier func(*v1alpha1.RegionDisk)
+
+func regionDiskWithConditions(c ...runtimev1alpha1.Condition) regionDiskModifier {
+	return func(i *v1alpha1.RegionDisk) { i.Status.SetConditions(c...) }
+}
+
+func regionDiskWithSizeGb(p int64) regionDiskModifier {
+	return func(i *v1alpha1.RegionDisk) { i.Spec.ForProvider.SizeGb = &p }
+}
+
+func regionDiskWithStatus(status string) regionDiskModifier {
+	return func(i *v1alpha1.RegionDisk) { i.Status.AtProvider.Status = status }
+}
+
+func regionDiskWithType(t string) regionDiskModifier {
+	return func(i *v1alpha1.RegionDisk) { i.Spec.ForProvider.Type = &t }
+}
+
+func regionDiskWithReplicaZones(z ...string) regionDiskModifier {
+	return func(i *v1alpha1.RegionDisk) { i.Spec.ForProvider.ReplicaZones = z }
+}
+
+func regionDiskObj(im ...regionDiskModifier) *v1alpha1.RegionDisk {
+	i := &v1alpha1.RegionDisk{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testRegionDiskName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testRegionDiskName,
+			},
+		},
+		Spec: v1alpha1.RegionDiskSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.RegionDiskParameters{
+				Region:       "us-central1",
+				ReplicaZones: []string{"us-central1-a", "us-central1-b"},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestRegionDiskObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotRegionDisk": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotRegionDisk),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testRegionDiskPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Disk{})
+			}),
+			mg: regionDiskObj(),
+			want: want{
+				mg: regionDiskObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Disk{})
+			}),
+			mg: regionDiskObj(),
+			want: want{
+				mg:  regionDiskObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetRegionDisk),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				d := regiondisk.GenerateRegionDisk(projectID, testRegionDiskName, regionDiskObj().Spec.ForProvider)
+				d.Type = "pd-ssd"
+				_ = json.NewEncoder(w).Encode(d)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   regionDiskObj(),
+			want: want{
+				mg:  regionDiskObj(regionDiskWithType("pd-ssd")),
+				err: errors.Wrap(errBoom, errManagedRegionDiskUpdate),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				d := regiondisk.GenerateRegionDisk(projectID, testRegionDiskName, regionDiskObj(regionDiskWithSizeGb(1000)).Spec.ForProvider)
+				d.Status = v1alpha1.RegionDiskStatusReady
+				_ = json.NewEncoder(w).Encode(d)
+			}),
+			mg: regionDiskObj(regionDiskWithSizeGb(1000)),
+			want: want{
+				mg:  regionDiskObj(regionDiskWithSizeGb(1000), regionDiskWithStatus(v1alpha1.RegionDiskStatusReady), regionDiskWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Creating": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				d := regiondisk.GenerateRegionDisk(projectID, testRegionDiskName, regionDiskObj(regionDiskWithSizeGb(1000)).Spec.ForProvider)
+				d.Status = v1alpha1.RegionDiskStatusCreating
+				_ = json.NewEncoder(w).Encode(d)
+			}),
+			mg: regionDiskObj(regionDiskWithSizeGb(1000)),
+			want: want{
+				mg:  regionDiskObj(regionDiskWithSizeGb(1000), regionDiskWithStatus(v1alpha1.RegionDiskStatusCreating), regionDiskWithConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				d := regiondisk.GenerateRegionDisk(projectID, testRegionDiskName, regionDiskObj(regionDiskWithSizeGb(1000)).Spec.ForProvider)
+				d.Status = v1alpha1.RegionDiskStatusReady
+				_ = json.NewEncoder(w).Encode(d)
+			}),
+			mg: regionDiskObj(regionDiskWithSizeGb(2000)),
+			want: want{
+				mg:  regionDiskObj(regionDiskWithSizeGb(2000), regionDiskWithStatus(v1alpha1.RegionDiskStatusReady), regionDiskWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := regionDiskExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRegionDiskCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: regionDiskObj(),
+			want: want{
+				mg: regionDiskObj(regionDiskWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"InvalidReplicaZones": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			mg: regionDiskObj(regionDiskWithReplicaZones("us-central1-a", "us-east1-b")),
+			want: want{
+				mg:  regionDiskObj(regionDiskWithReplicaZones("us-central1-a", "us-east1-b")),
+				err: errors.Wrap(regiondisk.ValidateReplicaZones("us-central1", []string{"us-central1-a", "us-east1-b"}), errInvalidReplicaZones),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: regionDiskObj(),
+			want: want{
+				mg:  regionDiskObj(regionDiskWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateRegionDisk),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := regionDiskExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRegionDiskUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Resized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				switch r.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(&compute.Disk{SizeGb: 100})
+				case http.MethodPost:
+					if diff := cmp.Diff(testRegionDiskPath+"/resize", r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					got := &compute.RegionDisksResizeRequest{}
+					_ = json.NewDecoder(r.Body).Decode(got)
+					if diff := cmp.Diff(int64(200), got.SizeGb); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}),
+			mg: regionDiskObj(regionDiskWithSizeGb(200)),
+		},
+		"Shrink": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method != http.MethodGet {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Disk{SizeGb: 500})
+			}),
+			mg:  regionDiskObj(regionDiskWithSizeGb(200)),
+			err: errors.New(errShrinkRegionDisk),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Disk{})
+			}),
+			mg:  regionDiskObj(regionDiskWithSizeGb(200)),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetRegionDisk),
+		},
+		"ResizeFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(&compute.Disk{SizeGb: 100})
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  regionDiskObj(regionDiskWithSizeGb(200)),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateRegionDisk),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := regionDiskExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRegionDiskDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: regionDiskObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: regionDiskObj(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  regionDiskObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteRegionDisk),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := regionDiskExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/instancegroupmanager"
+)
+
+// Error strings.
+const (
+	errNotRegionInstanceGroupManager           = "managed resource is not a RegionInstanceGroupManager resource"
+	errManagedRegionInstanceGroupManagerUpdate = "cannot update RegionInstanceGroupManager managed resource"
+	errInvalidDistributionZones                = "invalid distribution policy zones"
+
+	errGetRegionInstanceGroupManager    = "cannot get GCP RegionInstanceGroupManager"
+	errCreateRegionInstanceGroupManager = "cannot create GCP RegionInstanceGroupManager"
+	errUpdateRegionInstanceGroupManager = "cannot update GCP RegionInstanceGroupManager"
+	errDeleteRegionInstanceGroupManager = "cannot delete GCP RegionInstanceGroupManager"
+)
+
+// SetupRegionInstanceGroupManager adds a controller that reconciles
+// RegionInstanceGroupManager managed resources.
+func SetupRegionInstanceGroupManager(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.RegionInstanceGroupManagerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.RegionInstanceGroupManager{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RegionInstanceGroupManagerGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&regionInstanceGroupManagerConnector{kube: mgr.GetClient()})),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type regionInstanceGroupManagerConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *regionInstanceGroupManagerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RegionInstanceGroupManager)
+	if !ok {
+		return nil, errors.New(errNotRegionInstanceGroupManager)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &regionInstanceGroupManagerExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID, locations: gcp.ComputeLocations}, nil
+}
+
+type regionInstanceGroupManagerExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+	locations *gcp.LocationValidator
+}
+
+func (e *regionInstanceGroupManagerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RegionInstanceGroupManager)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRegionInstanceGroupManager)
+	}
+	observed, err := e.RegionInstanceGroupManagers.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetRegionInstanceGroupManager)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	instancegroupmanager.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedRegionInstanceGroupManagerUpdate)
+		}
+	}
+
+	// A managed instance group serves traffic while it creates, recreates
+	// or updates instances, so it is available as soon as it exists.
+	cr.Status.AtProvider = instancegroupmanager.GenerateRegionInstanceGroupManagerObservation(*observed)
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: instancegroupmanager.IsUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *regionInstanceGroupManagerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RegionInstanceGroupManager)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRegionInstanceGroupManager)
+	}
+	if err := e.locations.ValidateRegion(ctx, e.Service, e.projectID, cr.Spec.ForProvider.Region); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRegionInstanceGroupManager)
+	}
+	if dp := cr.Spec.ForProvider.DistributionPolicy; dp != nil {
+		for _, z := range dp.Zones {
+			if err := e.locations.ValidateZone(ctx, e.Service, e.projectID, z, cr.Spec.ForProvider.Region); err != nil {
+				return managed.ExternalCreation{}, errors.Wrap(err, errInvalidDistributionZones)
+			}
+		}
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	m := instancegroupmanager.GenerateRegionInstanceGroupManager(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.RegionInstanceGroupManagers.Insert(e.projectID, cr.Spec.ForProvider.Region, m).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateRegionInstanceGroupManager)
+}
+
+func (e *regionInstanceGroupManagerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RegionInstanceGroupManager)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRegionInstanceGroupManager)
+	}
+	name := meta.GetExternalName(cr)
+	observed, err := e.RegionInstanceGroupManagers.Get(e.projectID, cr.Spec.ForProvider.Region, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetRegionInstanceGroupManager)
+	}
+
+	// Only the fields that IsUpToDate compares are patched. The fingerprint
+	// makes the patch fail rather than overwrite a concurrent change.
+	in := cr.Spec.ForProvider
+	m := &googlecompute.InstanceGroupManager{
+		Fingerprint:         observed.Fingerprint,
+		InstanceTemplate:    in.InstanceTemplate,
+		AutoHealingPolicies: instancegroupmanager.GenerateAutoHealingPolicies(in.AutoHealingPolicies),
+		UpdatePolicy:        instancegroupmanager.GenerateUpdatePolicy(in.UpdatePolicy),
+		ForceSendFields:     []string{"AutoHealingPolicies"},
+	}
+	if in.TargetSize != nil && (observed.Status == nil || observed.Status.Autoscaler == "") {
+		m.TargetSize = *in.TargetSize
+		m.ForceSendFields = append(m.ForceSendFields, "TargetSize")
+	}
+	_, err = e.RegionInstanceGroupManagers.Patch(e.projectID, in.Region, name, m).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRegionInstanceGroupManager)
+}
+
+func (e *regionInstanceGroupManagerExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RegionInstanceGroupManager)
+	if !ok {
+		return errors.New(errNotRegionInstanceGroupManager)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.RegionInstanceGroupManagers.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteRegionInstanceGroupManager)
+}
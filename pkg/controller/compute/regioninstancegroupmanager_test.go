@@ -0,0 +1,462 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/instancegroupmanager"
+)
+
+const (
+	testRegionInstanceGroupManagerName     = "test-rigm"
+	testRegionInstanceGroupManagerPath     = "/" + projectID + "/regions/us-central1/instanceGroupManagers/" + testRegionInstanceGroupManagerName
+	testRegionInstanceGroupManagerTemplate = "https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/instanceTemplates/test"
+)
+
+var _ managed.ExternalConnecter = &regionInstanceGroupManagerConnector{}
+var _ managed.ExternalClient = &regionInstanceGroupManagerExternal{}
+
+type regionInstanceGroupManagerModifier func(*v1alpha1.RegionInstanceGroupManager)
+
+func rigmWithConditions(c ...runtimev1alpha1.Condition) regionInstanceGroupManagerModifier {
+	return func(i *v1alpha1.RegionInstanceGroupManager) { i.Status.SetConditions(c...) }
+}
+
+func rigmWithTargetSize(s int64) regionInstanceGroupManagerModifier {
+	return func(i *v1alpha1.RegionInstanceGroupManager) { i.Spec.ForProvider.TargetSize = &s }
+}
+
+func rigmWithDescription(d string) regionInstanceGroupManagerModifier {
+	return func(i *v1alpha1.RegionInstanceGroupManager) { i.Spec.ForProvider.Description = &d }
+}
+
+func rigmWithDistributionZones(z ...string) regionInstanceGroupManagerModifier {
+	return func(i *v1alpha1.RegionInstanceGroupManager) {
+		i.Spec.ForProvider.DistributionPolicy = &v1alpha1.DistributionPolicy{Zones: z}
+	}
+}
+
+func rigmWithObservation(o v1alpha1.RegionInstanceGroupManagerObservation) regionInstanceGroupManagerModifier {
+	return func(i *v1alpha1.RegionInstanceGroupManager) { i.Status.AtProvider = o }
+}
+
+func rigmObj(im ...regionInstanceGroupManagerModifier) *v1alpha1.RegionInstanceGroupManager {
+	i := &v1alpha1.RegionInstanceGroupManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testRegionInstanceGroupManagerName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testRegionInstanceGroupManagerName,
+			},
+		},
+		Spec: v1alpha1.RegionInstanceGroupManagerSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.RegionInstanceGroupManagerParameters{
+				Region:           "us-central1",
+				BaseInstanceName: "test",
+				InstanceTemplate: testRegionInstanceGroupManagerTemplate,
+				Description:      gcp.StringPtr(""),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// rigmObserved returns the instance group manager the API would report for
+// the supplied managed resource.
+func rigmObserved(cr *v1alpha1.RegionInstanceGroupManager) *compute.InstanceGroupManager {
+	m := instancegroupmanager.GenerateRegionInstanceGroupManager(projectID, testRegionInstanceGroupManagerName, cr.Spec.ForProvider)
+	m.Fingerprint = "fp"
+	m.Status = &compute.InstanceGroupManagerStatus{IsStable: true}
+	return m
+}
+
+func TestRegionInstanceGroupManagerObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	stable := v1alpha1.RegionInstanceGroupManagerObservation{Fingerprint: "fp", IsStable: true}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotRegionInstanceGroupManager": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotRegionInstanceGroupManager),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testRegionInstanceGroupManagerPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.InstanceGroupManager{})
+			}),
+			mg: rigmObj(),
+			want: want{
+				mg: rigmObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.InstanceGroupManager{})
+			}),
+			mg: rigmObj(),
+			want: want{
+				mg:  rigmObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetRegionInstanceGroupManager),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				m := rigmObserved(rigmObj())
+				m.TargetSize = 3
+				_ = json.NewEncoder(w).Encode(m)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   rigmObj(),
+			want: want{
+				mg:  rigmObj(rigmWithTargetSize(3)),
+				err: errors.Wrap(errBoom, errManagedRegionInstanceGroupManagerUpdate),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(rigmObserved(rigmObj(rigmWithTargetSize(3))))
+			}),
+			mg: rigmObj(rigmWithTargetSize(3)),
+			want: want{
+				mg:  rigmObj(rigmWithTargetSize(3), rigmWithObservation(stable), rigmWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(rigmObserved(rigmObj(rigmWithTargetSize(3))))
+			}),
+			mg: rigmObj(rigmWithTargetSize(5)),
+			want: want{
+				mg:  rigmObj(rigmWithTargetSize(5), rigmWithObservation(stable), rigmWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Autoscaled": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				m := rigmObserved(rigmObj(rigmWithTargetSize(3)))
+				m.Status.Autoscaler = "autoscaler"
+				_ = json.NewEncoder(w).Encode(m)
+			}),
+			mg: rigmObj(rigmWithTargetSize(5)),
+			want: want{
+				mg: rigmObj(rigmWithTargetSize(5), rigmWithObservation(v1alpha1.RegionInstanceGroupManagerObservation{
+					Fingerprint: "fp",
+					IsStable:    true,
+					Autoscaler:  "autoscaler",
+				}), rigmWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := regionInstanceGroupManagerExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRegionInstanceGroupManagerCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &compute.InstanceGroupManager{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				want := &compute.InstanceGroupManager{
+					Name:             testRegionInstanceGroupManagerName,
+					BaseInstanceName: "test",
+					InstanceTemplate: testRegionInstanceGroupManagerTemplate,
+					DistributionPolicy: &compute.DistributionPolicy{Zones: []*compute.DistributionPolicyZoneConfiguration{
+						{Zone: "projects/" + projectID + "/zones/us-central1-a"},
+						{Zone: "projects/" + projectID + "/zones/us-central1-b"},
+					}},
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: rigmObj(rigmWithDistributionZones("us-central1-a", "us-central1-b")),
+			want: want{
+				mg: rigmObj(rigmWithDistributionZones("us-central1-a", "us-central1-b"), rigmWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: rigmObj(),
+			want: want{
+				mg:  rigmObj(rigmWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateRegionInstanceGroupManager),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := regionInstanceGroupManagerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRegionInstanceGroupManagerUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Patched": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				switch r.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(rigmObserved(rigmObj(rigmWithTargetSize(3))))
+				case http.MethodPatch:
+					if diff := cmp.Diff(testRegionInstanceGroupManagerPath, r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					got := &compute.InstanceGroupManager{}
+					_ = json.NewDecoder(r.Body).Decode(got)
+					want := &compute.InstanceGroupManager{
+						Fingerprint:         "fp",
+						InstanceTemplate:    testRegionInstanceGroupManagerTemplate,
+						TargetSize:          5,
+						AutoHealingPolicies: []*compute.InstanceGroupManagerAutoHealingPolicy{},
+					}
+					if diff := cmp.Diff(want, got); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}),
+			mg: rigmObj(rigmWithTargetSize(5)),
+		},
+		"Autoscaled": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				switch r.Method {
+				case http.MethodGet:
+					m := rigmObserved(rigmObj(rigmWithTargetSize(3)))
+					m.Status.Autoscaler = "autoscaler"
+					_ = json.NewEncoder(w).Encode(m)
+				case http.MethodPatch:
+					got := map[string]interface{}{}
+					_ = json.NewDecoder(r.Body).Decode(&got)
+					if _, ok := got["targetSize"]; ok {
+						t.Errorf("r: unexpected targetSize in patch of autoscaled group")
+					}
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}),
+			mg: rigmObj(rigmWithTargetSize(5)),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.InstanceGroupManager{})
+			}),
+			mg:  rigmObj(rigmWithTargetSize(5)),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetRegionInstanceGroupManager),
+		},
+		"PatchFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(rigmObserved(rigmObj()))
+					return
+				}
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  rigmObj(rigmWithTargetSize(5)),
+			err: errors.Wrap(gError(http.StatusPreconditionFailed, ""), errUpdateRegionInstanceGroupManager),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := regionInstanceGroupManagerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRegionInstanceGroupManagerDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: rigmObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: rigmObj(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  rigmObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteRegionInstanceGroupManager),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := regionInstanceGroupManagerExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/resourcepolicy"
+)
+
+// Error strings.
+const (
+	errNotResourcePolicy           = "managed resource is not a ResourcePolicy resource"
+	errManagedResourcePolicyUpdate = "cannot update ResourcePolicy managed resource"
+	errResourcePolicyImmutable     = "cannot change an existing resource policy; annotate the ResourcePolicy with %s: \"true\" to delete and recreate it"
+
+	errGetResourcePolicy    = "cannot get GCP ResourcePolicy"
+	errCreateResourcePolicy = "cannot create GCP ResourcePolicy"
+	errDeleteResourcePolicy = "cannot delete GCP ResourcePolicy"
+)
+
+// SetupResourcePolicy adds a controller that reconciles ResourcePolicy
+// managed resources.
+func SetupResourcePolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ResourcePolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ResourcePolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ResourcePolicyGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&resourcePolicyConnector{kube: mgr.GetClient()})),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type resourcePolicyConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*resourcepolicy.Service, error)
+}
+
+func (c *resourcePolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ResourcePolicy)
+	if !ok {
+		return nil, errors.New(errNotResourcePolicy)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = resourcepolicy.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &resourcePolicyExternal{policies: s, kube: c.kube, projectID: provider.Spec.ProjectID}, nil
+}
+
+type resourcePolicyExternal struct {
+	kube      client.Client
+	policies  *resourcepolicy.Service
+	projectID string
+}
+
+func (e *resourcePolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ResourcePolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotResourcePolicy)
+	}
+	observed, err := e.policies.Get(ctx, e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetResourcePolicy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	resourcepolicy.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedResourcePolicyUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = resourcepolicy.GenerateResourcePolicyObservation(*observed)
+	cr.Status.SetConditions(resourcepolicy.Condition(observed.Status))
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: resourcepolicy.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *resourcePolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ResourcePolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotResourcePolicy)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	rp := resourcepolicy.GenerateResourcePolicy(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.policies.Insert(ctx, e.projectID, cr.Spec.ForProvider.Region, rp)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateResourcePolicy)
+}
+
+// Update handles changes to a resource policy, none of which can be applied
+// to an existing one. The policy is deleted, to be created again per its
+// spec, only if the ResourcePolicy asks to be recreated.
+func (e *resourcePolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ResourcePolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotResourcePolicy)
+	}
+	if !gcp.ShouldRecreate(cr) {
+		return managed.ExternalUpdate{}, errors.Errorf(errResourcePolicyImmutable, gcp.AnnotationKeyRecreate)
+	}
+	return managed.ExternalUpdate{}, e.Delete(ctx, cr)
+}
+
+func (e *resourcePolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ResourcePolicy)
+	if !ok {
+		return errors.New(errNotResourcePolicy)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.policies.Delete(ctx, e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteResourcePolicy)
+}
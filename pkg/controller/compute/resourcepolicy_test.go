@@ -0,0 +1,387 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/resourcepolicy"
+)
+
+const (
+	testResourcePolicyName = "test-resourcepolicy"
+	testResourcePolicyPath = "/projects/" + projectID + "/regions/us-central1/resourcePolicies/" + testResourcePolicyName
+)
+
+var _ managed.ExternalConnecter = &resourcePolicyConnector{}
+var _ managed.ExternalClient = &resourcePolicyExternal{}
+
+type resourcePolicyModifier func(*v1alpha1.ResourcePolicy)
+
+func resourcePolicyWithConditions(c ...runtimev1alpha1.Condition) resourcePolicyModifier {
+	return func(i *v1alpha1.ResourcePolicy) { i.Status.SetConditions(c...) }
+}
+
+func resourcePolicyWithDescription(d string) resourcePolicyModifier {
+	return func(i *v1alpha1.ResourcePolicy) { i.Spec.ForProvider.Description = &d }
+}
+
+func resourcePolicyWithStatus(s string) resourcePolicyModifier {
+	return func(i *v1alpha1.ResourcePolicy) { i.Status.AtProvider.Status = s }
+}
+
+func resourcePolicyWithRecreate() resourcePolicyModifier {
+	return func(i *v1alpha1.ResourcePolicy) {
+		meta.AddAnnotations(i, map[string]string{gcp.AnnotationKeyRecreate: "true"})
+	}
+}
+
+func resourcePolicyObj(im ...resourcePolicyModifier) *v1alpha1.ResourcePolicy {
+	i := &v1alpha1.ResourcePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testResourcePolicyName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testResourcePolicyName,
+			},
+		},
+		Spec: v1alpha1.ResourcePolicySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ResourcePolicyParameters{
+				Region: "us-central1",
+				InstanceSchedulePolicy: &v1alpha1.ResourcePolicyInstanceSchedulePolicy{
+					VMStartSchedule: &v1alpha1.ResourcePolicyInstanceSchedule{Schedule: "0 8 * * 1-5"},
+					VMStopSchedule:  &v1alpha1.ResourcePolicyInstanceSchedule{Schedule: "0 18 * * 1-5"},
+					TimeZone:        "Europe/Zurich",
+				},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func newResourcePolicyService(t *testing.T, h http.Handler) (*resourcepolicy.Service, func()) {
+	server := httptest.NewServer(h)
+	s, err := resourcepolicy.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("resourcepolicy.NewService(...): %s", err)
+	}
+	return s, server.Close
+}
+
+func TestResourcePolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotResourcePolicy": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotResourcePolicy),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testResourcePolicyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&resourcepolicy.ResourcePolicy{})
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg: resourcePolicyObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&resourcepolicy.ResourcePolicy{})
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg:  resourcePolicyObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetResourcePolicy),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				rp := resourcepolicy.GenerateResourcePolicy(testResourcePolicyName, resourcePolicyObj().Spec.ForProvider)
+				rp.Description = "office hours"
+				_ = json.NewEncoder(w).Encode(rp)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   resourcePolicyObj(),
+			want: want{
+				mg:  resourcePolicyObj(resourcePolicyWithDescription("office hours")),
+				err: errors.Wrap(errBoom, errManagedResourcePolicyUpdate),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				rp := resourcepolicy.GenerateResourcePolicy(testResourcePolicyName, resourcePolicyObj().Spec.ForProvider)
+				rp.Status = v1alpha1.ResourcePolicyStatusReady
+				_ = json.NewEncoder(w).Encode(rp)
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg: resourcePolicyObj(
+					resourcePolicyWithStatus(v1alpha1.ResourcePolicyStatusReady),
+					resourcePolicyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				rp := resourcepolicy.GenerateResourcePolicy(testResourcePolicyName, resourcePolicyObj().Spec.ForProvider)
+				rp.InstanceSchedulePolicy.TimeZone = "UTC"
+				rp.Status = v1alpha1.ResourcePolicyStatusReady
+				_ = json.NewEncoder(w).Encode(rp)
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg: resourcePolicyObj(
+					resourcePolicyWithStatus(v1alpha1.ResourcePolicyStatusReady),
+					resourcePolicyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, done := newResourcePolicyService(t, tc.handler)
+			defer done()
+			e := resourcePolicyExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				policies:  s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourcePolicyCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &resourcepolicy.ResourcePolicy{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				_ = r.Body.Close()
+				want := resourcepolicy.GenerateResourcePolicy(testResourcePolicyName, resourcePolicyObj().Spec.ForProvider)
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg: resourcePolicyObj(resourcePolicyWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg:  resourcePolicyObj(resourcePolicyWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateResourcePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, done := newResourcePolicyService(t, tc.handler)
+			defer done()
+			e := resourcePolicyExternal{
+				projectID: projectID,
+				policies:  s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourcePolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Immutable": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected %s request", r.Method)
+			}),
+			mg:  resourcePolicyObj(),
+			err: errors.Errorf(errResourcePolicyImmutable, gcp.AnnotationKeyRecreate),
+		},
+		"Recreate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: resourcePolicyObj(resourcePolicyWithRecreate()),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, done := newResourcePolicyService(t, tc.handler)
+			defer done()
+			e := resourcePolicyExternal{
+				projectID: projectID,
+				policies:  s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourcePolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(testResourcePolicyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: resourcePolicyObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: resourcePolicyObj(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  resourcePolicyObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteResourcePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, done := newResourcePolicyService(t, tc.handler)
+			defer done()
+			e := resourcePolicyExternal{
+				projectID: projectID,
+				policies:  s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/router"
+)
+
+// Error strings.
+const (
+	errNotRouter           = "managed resource is not a Router resource"
+	errManagedRouterUpdate = "cannot update Router managed resource"
+
+	errGetRouter    = "cannot get GCP Router"
+	errCreateRouter = "cannot create GCP Router"
+	errUpdateRouter = "cannot update GCP Router"
+	errDeleteRouter = "cannot delete GCP Router"
+)
+
+// SetupRouter adds a controller that reconciles Router managed resources.
+func SetupRouter(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.RouterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Router{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RouterGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&routerConnector{kube: mgr.GetClient()})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type routerConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *routerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Router)
+	if !ok {
+		return nil, errors.New(errNotRouter)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &routerExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID, locations: gcp.ComputeLocations}, nil
+}
+
+type routerExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+	locations *gcp.LocationValidator
+}
+
+func (e *routerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Router)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRouter)
+	}
+	observed, err := e.Routers.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetRouter)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	router.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedRouterUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = router.GenerateRouterObservation(*observed)
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: router.IsUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *routerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Router)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRouter)
+	}
+	if err := e.locations.ValidateRegion(ctx, e.Service, e.projectID, cr.Spec.ForProvider.Region); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRouter)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	rt := router.GenerateRouter(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.Routers.Insert(e.projectID, cr.Spec.ForProvider.Region, rt).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateRouter)
+}
+
+func (e *routerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Router)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRouter)
+	}
+	rt := router.GenerateRouter(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	// An empty list of NATs is omitted from the request unless forced, which
+	// would leave any NATs that were removed from the spec in place.
+	if len(rt.Nats) == 0 {
+		rt.ForceSendFields = []string{"Nats"}
+	}
+	_, err := e.Routers.Patch(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), rt).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRouter)
+}
+
+func (e *routerExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Router)
+	if !ok {
+		return errors.New(errNotRouter)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.Routers.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteRouter)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/sslpolicy"
+)
+
+// Error strings.
+const (
+	errNotSslPolicy           = "managed resource is not a SslPolicy resource"
+	errManagedSslPolicyUpdate = "cannot update SslPolicy managed resource"
+
+	errGetSslPolicy    = "cannot get GCP SslPolicy"
+	errCreateSslPolicy = "cannot create GCP SslPolicy"
+	errUpdateSslPolicy = "cannot update GCP SslPolicy"
+	errDeleteSslPolicy = "cannot delete GCP SslPolicy"
+)
+
+// SetupSslPolicy adds a controller that reconciles SslPolicy managed
+// resources.
+func SetupSslPolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.SslPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.SslPolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SslPolicyGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&sslPolicyConnector{kube: mgr.GetClient()})),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type sslPolicyConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *sslPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SslPolicy)
+	if !ok {
+		return nil, errors.New(errNotSslPolicy)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &sslPolicyExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID}, nil
+}
+
+type sslPolicyExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+}
+
+func (e *sslPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SslPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSslPolicy)
+	}
+	observed, err := e.SslPolicies.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetSslPolicy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	sslpolicy.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedSslPolicyUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = sslpolicy.GenerateSslPolicyObservation(*observed)
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: sslpolicy.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *sslPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SslPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSslPolicy)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.SslPolicies.Insert(e.projectID, sslpolicy.GenerateSslPolicy(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSslPolicy)
+}
+
+// Update patches the profile, minimum TLS version and custom features of the
+// SSL policy. The fingerprint of the policy is read first, so that the patch
+// fails rather than overwrite a concurrent change.
+func (e *sslPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SslPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSslPolicy)
+	}
+	name := meta.GetExternalName(cr)
+	observed, err := e.SslPolicies.Get(e.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetSslPolicy)
+	}
+	_, err = e.SslPolicies.Patch(e.projectID, name, sslpolicy.GenerateSslPolicyPatch(cr.Spec.ForProvider, *observed)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSslPolicy)
+}
+
+func (e *sslPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SslPolicy)
+	if !ok {
+		return errors.New(errNotSslPolicy)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.SslPolicies.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSslPolicy)
+}
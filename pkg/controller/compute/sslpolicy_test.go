@@ -0,0 +1,420 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/sslpolicy"
+)
+
+const (
+	testSslPolicyName = "test-sslpolicy"
+	testSslPolicyPath = "/" + projectID + "/global/sslPolicies/" + testSslPolicyName
+)
+
+var _ managed.ExternalConnecter = &sslPolicyConnector{}
+var _ managed.ExternalClient = &sslPolicyExternal{}
+
+type sslPolicyModifier func(*v1alpha1.SslPolicy)
+
+func sslPolicyWithConditions(c ...runtimev1alpha1.Condition) sslPolicyModifier {
+	return func(i *v1alpha1.SslPolicy) { i.Status.SetConditions(c...) }
+}
+
+func sslPolicyWithProfile(p string) sslPolicyModifier {
+	return func(i *v1alpha1.SslPolicy) { i.Spec.ForProvider.Profile = &p }
+}
+
+func sslPolicyWithMinTLSVersion(v string) sslPolicyModifier {
+	return func(i *v1alpha1.SslPolicy) { i.Spec.ForProvider.MinTLSVersion = &v }
+}
+
+func sslPolicyWithCustomFeatures(f ...string) sslPolicyModifier {
+	return func(i *v1alpha1.SslPolicy) { i.Spec.ForProvider.CustomFeatures = f }
+}
+
+func sslPolicyWithFingerprint(f string) sslPolicyModifier {
+	return func(i *v1alpha1.SslPolicy) { i.Status.AtProvider.Fingerprint = f }
+}
+
+func sslPolicyObj(im ...sslPolicyModifier) *v1alpha1.SslPolicy {
+	i := &v1alpha1.SslPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testSslPolicyName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testSslPolicyName,
+			},
+		},
+		Spec: v1alpha1.SslPolicySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.SslPolicyParameters{
+				Description:   gcp.StringPtr(""),
+				Profile:       gcp.StringPtr(v1alpha1.SslPolicyProfileModern),
+				MinTLSVersion: gcp.StringPtr("TLS_1_2"),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// sslPolicyObserved returns the SSL policy the API would report for the
+// supplied managed resource.
+func sslPolicyObserved(cr *v1alpha1.SslPolicy) *compute.SslPolicy {
+	p := sslpolicy.GenerateSslPolicy(testSslPolicyName, cr.Spec.ForProvider)
+	p.Fingerprint = "fp"
+	return p
+}
+
+func TestSslPolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotSslPolicy": {
+			mg: &v1beta1.Network{},
+			want: want{
+				mg:  &v1beta1.Network{},
+				err: errors.New(errNotSslPolicy),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testSslPolicyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.SslPolicy{})
+			}),
+			mg: sslPolicyObj(),
+			want: want{
+				mg: sslPolicyObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.SslPolicy{})
+			}),
+			mg: sslPolicyObj(),
+			want: want{
+				mg:  sslPolicyObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetSslPolicy),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(sslPolicyObserved(sslPolicyObj(sslPolicyWithMinTLSVersion("TLS_1_0"))))
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg: sslPolicyObj(func(i *v1alpha1.SslPolicy) {
+				i.Spec.ForProvider.MinTLSVersion = nil
+			}),
+			want: want{
+				mg:  sslPolicyObj(sslPolicyWithMinTLSVersion("TLS_1_0")),
+				err: errors.Wrap(errBoom, errManagedSslPolicyUpdate),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(sslPolicyObserved(sslPolicyObj(
+					sslPolicyWithProfile(v1alpha1.SslPolicyProfileCustom),
+					sslPolicyWithCustomFeatures("b", "a"),
+				)))
+			}),
+			mg: sslPolicyObj(sslPolicyWithProfile(v1alpha1.SslPolicyProfileCustom), sslPolicyWithCustomFeatures("a", "b")),
+			want: want{
+				mg: sslPolicyObj(
+					sslPolicyWithProfile(v1alpha1.SslPolicyProfileCustom),
+					sslPolicyWithCustomFeatures("a", "b"),
+					sslPolicyWithFingerprint("fp"),
+					sslPolicyWithConditions(runtimev1alpha1.Available()),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ProfileChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(sslPolicyObserved(sslPolicyObj(sslPolicyWithProfile(v1alpha1.SslPolicyProfileCompatible))))
+			}),
+			mg: sslPolicyObj(),
+			want: want{
+				mg:  sslPolicyObj(sslPolicyWithFingerprint("fp"), sslPolicyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sslPolicyExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSslPolicyCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Created": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &compute.SslPolicy{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				want := &compute.SslPolicy{Name: testSslPolicyName, Profile: "MODERN", MinTlsVersion: "TLS_1_2"}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: sslPolicyObj(),
+			want: want{
+				mg: sslPolicyObj(sslPolicyWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: sslPolicyObj(),
+			want: want{
+				mg:  sslPolicyObj(sslPolicyWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateSslPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sslPolicyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSslPolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Patched": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				switch r.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(sslPolicyObserved(sslPolicyObj(
+						sslPolicyWithProfile(v1alpha1.SslPolicyProfileCustom),
+						sslPolicyWithCustomFeatures("a"),
+					)))
+				case http.MethodPatch:
+					if diff := cmp.Diff(testSslPolicyPath, r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					got := map[string]interface{}{}
+					_ = json.NewDecoder(r.Body).Decode(&got)
+					want := map[string]interface{}{
+						"fingerprint":    "fp",
+						"profile":        "MODERN",
+						"minTlsVersion":  "TLS_1_2",
+						"customFeatures": []interface{}{},
+					}
+					if diff := cmp.Diff(want, got); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}),
+			mg: sslPolicyObj(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.SslPolicy{})
+			}),
+			mg:  sslPolicyObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetSslPolicy),
+		},
+		"PatchFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(sslPolicyObserved(sslPolicyObj()))
+					return
+				}
+				w.WriteHeader(http.StatusPreconditionFailed)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  sslPolicyObj(),
+			err: errors.Wrap(gError(http.StatusPreconditionFailed, ""), errUpdateSslPolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sslPolicyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSslPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: sslPolicyObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg: sslPolicyObj(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  sslPolicyObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteSslPolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sslPolicyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
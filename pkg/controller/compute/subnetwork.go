@@ -23,8 +23,6 @@ import (
 	"github.com/pkg/errors"
 	googlecompute "google.golang.org/api/compute/v1"
 	"google.golang.org/api/option"
-	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,7 +34,6 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
-	gcpapis "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/subnetwork"
 )
@@ -50,6 +47,9 @@ const (
 	errUpdateSubnetworkFailed   = "update of GCP Subnetwork has failed"
 	errUpdateSubnetworkPAFailed = "unable to update GCP Subnetwork Private IP Google Access"
 	errCreateSubnetworkFailed   = "creation of GCP Subnetwork resource has failed"
+	errExpandSubnetworkFailed   = "expansion of GCP Subnetwork IP CIDR range has failed"
+	errGetSubnetworkOp          = "cannot get the pending operation of the GCP Subnetwork"
+	errSubnetworkOpFailed       = "the pending operation of the GCP Subnetwork has failed"
 	errDeleteSubnetworkFailed   = "deletion of GCP Subnetwork resource has failed"
 	errCheckSubnetworkUpToDate  = "cannot determine if GCP Subnetwork is up to date"
 )
@@ -64,7 +64,7 @@ func SetupSubnetwork(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1beta1.Subnetwork{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.SubnetworkGroupVersionKind),
-			managed.WithExternalConnecter(&subnetworkConnector{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&subnetworkConnector{kube: mgr.GetClient()})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -82,37 +82,25 @@ func (c *subnetworkConnector) Connect(ctx context.Context, mg resource.Managed)
 		return nil, errors.New(errNotSubnetwork)
 	}
 
-	provider := &gcpapis.Provider{}
-	if err := c.kube.Get(ctx, meta.NamespacedNameOf(cr.Spec.ProviderReference), provider); err != nil {
-		return nil, errors.Wrap(err, errProviderNotRetrieved)
-	}
-
-	if provider.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretNil)
-	}
-
-	secret := &v1.Secret{}
-	n := types.NamespacedName{Namespace: provider.Spec.CredentialsSecretRef.Namespace, Name: provider.Spec.CredentialsSecretRef.Name}
-	if err := c.kube.Get(ctx, n, secret); err != nil {
-		return nil, errors.Wrap(err, errProviderSecretNotRetrieved)
-	}
-
 	if c.newServiceFn == nil {
 		c.newServiceFn = googlecompute.NewService
 	}
-	s, err := c.newServiceFn(ctx,
-		option.WithCredentialsJSON(secret.Data[provider.Spec.CredentialsSecretRef.Key]),
-		option.WithScopes(googlecompute.ComputeScope))
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &subnetworkExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID}, nil
+	return &subnetworkExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID, locations: gcp.ComputeLocations}, nil
 }
 
 type subnetworkExternal struct {
 	kube client.Client
 	*googlecompute.Service
 	projectID string
+	locations *gcp.LocationValidator
 }
 
 func (c *subnetworkExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -120,11 +108,32 @@ func (c *subnetworkExternal) Observe(ctx context.Context, mg resource.Managed) (
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotSubnetwork)
 	}
+	op := cr.Status.AtProvider.Operation
 	observed, err := c.Subnetworks.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
-	if err != nil {
+	if err != nil && (op == "" || !gcp.IsErrorNotFound(err)) {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetSubnetwork)
 	}
 
+	// The subnetwork is not updated again, or created again if it is not found
+	// yet, until its pending creation or expansion is done.
+	if op != "" {
+		o, err := c.RegionOperations.Get(c.projectID, cr.Spec.ForProvider.Region, op).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetSubnetworkOp)
+		}
+		gop := gcp.ComputeOperation(o)
+		if !gop.Done {
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+		cr.Status.AtProvider.Operation = ""
+		if gop.Error != "" {
+			return managed.ExternalObservation{}, errors.Wrap(errors.New(gop.Error), errSubnetworkOpFailed)
+		}
+		if observed == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+	}
+
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	subnetwork.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
@@ -152,15 +161,22 @@ func (c *subnetworkExternal) Create(ctx context.Context, mg resource.Managed) (m
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotSubnetwork)
 	}
+	if err := c.locations.ValidateRegion(ctx, c.Service, c.projectID, cr.Spec.ForProvider.Region); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetworkFailed)
+	}
 
 	cr.Status.SetConditions(runtimev1alpha1.Creating())
 
 	subnet := &googlecompute.Subnetwork{}
 	subnetwork.GenerateSubnetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, subnet)
-	_, err := c.Subnetworks.Insert(c.projectID, cr.Spec.ForProvider.Region, subnet).
+	op, err := c.Subnetworks.Insert(c.projectID, cr.Spec.ForProvider.Region, subnet).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetworkFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetworkFailed)
+	}
+	cr.Status.AtProvider.Operation = op.Name
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *subnetworkExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -187,6 +203,22 @@ func (c *subnetworkExternal) Update(ctx context.Context, mg resource.Managed) (m
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkPAFailed)
 	}
 
+	// The IP CIDR range cannot be patched, and other fields are patched with
+	// the range the subnetwork has once it is expanded.
+	expand, err := subnetwork.IsExpansion(cr.Spec.ForProvider.IPCidrRange, observed.IpCidrRange)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckSubnetworkUpToDate)
+	}
+	if expand {
+		req := &googlecompute.SubnetworksExpandIpCidrRangeRequest{IpCidrRange: cr.Spec.ForProvider.IPCidrRange}
+		op, err := c.Subnetworks.ExpandIpCidrRange(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), req).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errExpandSubnetworkFailed)
+		}
+		cr.Status.AtProvider.Operation = op.Name
+		return managed.ExternalUpdate{}, nil
+	}
+
 	subnetUpdate := subnetwork.GenerateSubnetworkForUpdate(*cr, meta.GetExternalName(cr))
 	_, err = c.Subnetworks.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), subnetUpdate).Context(ctx).Do()
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkFailed)
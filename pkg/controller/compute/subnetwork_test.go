@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -46,6 +47,7 @@ import (
 
 const (
 	testSubnetworkName = "test-subnetwork"
+	testSubnetworkOp   = "operation-5678"
 )
 
 var _ managed.ExternalConnecter = &subnetworkConnector{}
@@ -65,6 +67,14 @@ func subnetworkWithPrivateAccess(p bool) subnetworkModifier {
 	return func(i *v1beta1.Subnetwork) { i.Spec.ForProvider.PrivateIPGoogleAccess = &p }
 }
 
+func subnetworkWithIPCidrRange(r string) subnetworkModifier {
+	return func(i *v1beta1.Subnetwork) { i.Spec.ForProvider.IPCidrRange = r }
+}
+
+func subnetworkWithOperation(op string) subnetworkModifier {
+	return func(i *v1beta1.Subnetwork) { i.Status.AtProvider.Operation = op }
+}
+
 func subnetworkObj(im ...subnetworkModifier) *v1beta1.Subnetwork {
 	i := &v1beta1.Subnetwork{
 		ObjectMeta: metav1.ObjectMeta{
@@ -164,7 +174,7 @@ func TestSubnetworkConnect(t *testing.T) {
 				mg: subnetworkObj(),
 			},
 			want: want{
-				err: errors.Wrap(errBoom, errProviderNotRetrieved),
+				err: errors.Wrap(errBoom, "cannot get Provider"),
 			},
 		},
 		"ProviderSecretNil": {
@@ -182,7 +192,7 @@ func TestSubnetworkConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: subnetworkObj()},
-			want: want{err: errors.New(errProviderSecretNil)},
+			want: want{err: errors.New("cannot find Secret reference on Provider")},
 		},
 		"FailedToGetProviderSecret": {
 			conn: &subnetworkConnector{
@@ -197,7 +207,7 @@ func TestSubnetworkConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: subnetworkObj()},
-			want: want{err: errors.Wrap(errBoom, errProviderSecretNotRetrieved)},
+			want: want{err: errors.Wrap(errBoom, "cannot get Provider Secret")},
 		},
 		"FailedToCreateComputeClient": {
 			conn: &subnetworkConnector{
@@ -336,6 +346,79 @@ func TestSubnetworkObserve(t *testing.T) {
 				mg: subnetworkObj(subnetworkWithConditions(runtimev1alpha1.Available())),
 			},
 		},
+		"OperationPending": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testSubnetworkOp, Status: "RUNNING"})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Subnetwork{})
+			}),
+			args: args{
+				mg: subnetworkObj(subnetworkWithOperation(testSubnetworkOp)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg:  subnetworkObj(subnetworkWithOperation(testSubnetworkOp)),
+			},
+		},
+		"OperationFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{
+						Name:   testSubnetworkOp,
+						Status: "DONE",
+						Error:  &compute.OperationError{Errors: []*compute.OperationErrorErrors{{Message: "boom"}}},
+					})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Subnetwork{})
+			}),
+			args: args{
+				mg: subnetworkObj(subnetworkWithOperation(testSubnetworkOp)),
+			},
+			want: want{
+				mg:  subnetworkObj(),
+				err: errors.Wrap(errors.New("boom"), errSubnetworkOpFailed),
+			},
+		},
+		"OperationDone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testSubnetworkOp, Status: "DONE"})
+					return
+				}
+				c := &compute.Subnetwork{}
+				subnetwork.GenerateSubnetwork(testSubnetworkName, subnetworkObj().Spec.ForProvider, c)
+				_ = json.NewEncoder(w).Encode(c)
+			}),
+			args: args{
+				mg: subnetworkObj(subnetworkWithOperation(testSubnetworkOp)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg:  subnetworkObj(subnetworkWithConditions(runtimev1alpha1.Available())),
+			},
+		},
+		"RangeShrunk": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&compute.Subnetwork{IpCidrRange: "10.0.0.0/16"})
+			}),
+			args: args{
+				mg: subnetworkObj(subnetworkWithIPCidrRange("10.0.0.0/20")),
+			},
+			want: want{
+				mg: subnetworkObj(subnetworkWithIPCidrRange("10.0.0.0/20")),
+				err: errors.Wrap(errors.Errorf("ipCidrRange 10.0.0.0/16 cannot be changed to 10.0.0.0/20: the range may only be expanded to a range that contains it, not shrunk or moved"),
+					errCheckSubnetworkUpToDate),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -405,13 +488,13 @@ func TestSubnetworkCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testSubnetworkOp})
 			}),
 			args: args{
 				mg: subnetworkObj(),
 			},
 			want: want{
-				mg:  subnetworkObj(subnetworkWithConditions(runtimev1alpha1.Creating())),
+				mg:  subnetworkObj(subnetworkWithConditions(runtimev1alpha1.Creating()), subnetworkWithOperation(testSubnetworkOp)),
 				cre: managed.ExternalCreation{},
 				err: nil,
 			},
@@ -732,6 +815,51 @@ func TestSubnetworkUpdate(t *testing.T) {
 				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateSubnetworkPAFailed),
 			},
 		},
+		"SuccessfulExpansion": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch {
+				case r.Method == http.MethodGet:
+					_ = json.NewEncoder(w).Encode(&compute.Subnetwork{IpCidrRange: "10.0.0.0/20"})
+				case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/expandIpCidrRange"):
+					_ = json.NewEncoder(w).Encode(&compute.Operation{Name: testSubnetworkOp})
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: subnetworkObj(subnetworkWithIPCidrRange("10.0.0.0/16")),
+			},
+			want: want{
+				mg: subnetworkObj(subnetworkWithIPCidrRange("10.0.0.0/16"), subnetworkWithOperation(testSubnetworkOp)),
+			},
+		},
+		"ExpansionFails": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(&compute.Subnetwork{IpCidrRange: "10.0.0.0/20"})
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: subnetworkObj(subnetworkWithIPCidrRange("10.0.0.0/16")),
+			},
+			want: want{
+				mg:  subnetworkObj(subnetworkWithIPCidrRange("10.0.0.0/16")),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errExpandSubnetworkFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
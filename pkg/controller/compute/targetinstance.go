@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	googlecompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/targetinstance"
+)
+
+// Error strings.
+const (
+	errNotTargetInstance           = "managed resource is not a TargetInstance resource"
+	errManagedTargetInstanceUpdate = "cannot update TargetInstance managed resource"
+
+	errGetTargetInstance    = "cannot get GCP TargetInstance"
+	errCreateTargetInstance = "cannot create GCP TargetInstance"
+	errDeleteTargetInstance = "cannot delete GCP TargetInstance"
+)
+
+// SetupTargetInstance adds a controller that reconciles TargetInstance managed
+// resources.
+func SetupTargetInstance(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TargetInstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TargetInstance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TargetInstanceGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&targetInstanceConnector{kube: mgr.GetClient()})),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type targetInstanceConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*googlecompute.Service, error)
+}
+
+func (c *targetInstanceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TargetInstance)
+	if !ok {
+		return nil, errors.New(errNotTargetInstance)
+	}
+
+	if c.newServiceFn == nil {
+		c.newServiceFn = googlecompute.NewService
+	}
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, googlecompute.ComputeScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &targetInstanceExternal{Service: s, kube: c.kube, projectID: provider.Spec.ProjectID, locations: gcp.ComputeLocations}, nil
+}
+
+type targetInstanceExternal struct {
+	kube client.Client
+	*googlecompute.Service
+	projectID string
+	locations *gcp.LocationValidator
+}
+
+func (e *targetInstanceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TargetInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTargetInstance)
+	}
+	observed, err := e.TargetInstances.Get(e.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTargetInstance)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	targetinstance.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedTargetInstanceUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = targetinstance.GenerateTargetInstanceObservation(*observed)
+	cr.Status.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// Target instances cannot be changed once created.
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *targetInstanceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TargetInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTargetInstance)
+	}
+	if err := e.locations.ValidateZone(ctx, e.Service, e.projectID, cr.Spec.ForProvider.Zone, ""); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTargetInstance)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	ti := targetinstance.GenerateTargetInstance(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.TargetInstances.Insert(e.projectID, cr.Spec.ForProvider.Zone, ti).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTargetInstance)
+}
+
+func (e *targetInstanceExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *targetInstanceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TargetInstance)
+	if !ok {
+		return errors.New(errNotTargetInstance)
+	}
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.TargetInstances.Delete(e.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTargetInstance)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config contains the controller of ProviderConfigs.
+package config
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+// Error strings.
+const (
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errListUsages        = "cannot list ProviderConfigUsages"
+	errAddFinalizer      = "cannot add finalizer to ProviderConfig"
+	errRemoveFinalizer   = "cannot remove finalizer from ProviderConfig"
+	errUpdateStatus      = "cannot update status of ProviderConfig"
+	errDeleteWhileInUse  = "cannot delete ProviderConfig while it is used by managed resources"
+)
+
+const (
+	finalizer             = "in-use.crossplane.io"
+	reconcileTimeout      = 1 * time.Minute
+	requeueAfterWhileUsed = 30 * time.Second
+)
+
+// Setup adds a controller that reconciles ProviderConfigs.
+func Setup(mgr ctrl.Manager, l logging.Logger) error {
+	name := "providerconfig/" + strings.ToLower(v1beta1.ProviderConfigGroupKind)
+
+	r := &Reconciler{
+		client:    mgr.GetClient(),
+		finalizer: resource.NewAPIFinalizer(mgr.GetClient(), finalizer),
+		log:       l.WithValues("controller", name),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.ProviderConfig{}).
+		Watches(&source.Kind{Type: &v1beta1.ProviderConfigUsage{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(usedProviderConfig)}).
+		Complete(r)
+}
+
+// usedProviderConfig returns a request to reconcile the ProviderConfig used
+// by the supplied ProviderConfigUsage, if any.
+func usedProviderConfig(o handler.MapObject) []reconcile.Request {
+	name := o.Meta.GetLabels()[v1beta1.LabelKeyProviderConfigName]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name}}}
+}
+
+// A Reconciler counts the managed resources that use a ProviderConfig, and
+// holds a finalizer on it until none do, so that a ProviderConfig that is
+// still used cannot be deleted.
+type Reconciler struct {
+	client    client.Client
+	finalizer resource.Finalizer
+	log       logging.Logger
+}
+
+// Reconcile a ProviderConfig.
+func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		// The ProviderConfig may have been deleted since it was queued.
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProviderConfig)
+	}
+
+	l := &v1beta1.ProviderConfigUsageList{}
+	if err := r.client.List(ctx, l, client.MatchingLabels{v1beta1.LabelKeyProviderConfigName: pc.GetName()}); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errListUsages)
+	}
+	users := int64(len(l.Items))
+
+	if meta.WasDeleted(pc) {
+		if users > 0 {
+			// Usages are deleted with the managed resources that own them,
+			// which enqueues this ProviderConfig; the requeue is a backstop.
+			log.Debug(errDeleteWhileInUse, "users", users)
+			pc.Status.Users = users
+			return reconcile.Result{RequeueAfter: requeueAfterWhileUsed}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+		}
+		return reconcile.Result{}, errors.Wrap(r.finalizer.RemoveFinalizer(ctx, pc), errRemoveFinalizer)
+	}
+
+	if err := r.finalizer.AddFinalizer(ctx, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errAddFinalizer)
+	}
+	pc.Status.Users = users
+	return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+var _ reconcile.Reconciler = &Reconciler{}
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := metav1.Now()
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool-config"}}
+
+	// kube returns a client whose ProviderConfig is deleted or not, and that
+	// lists the supplied number of usages of it. The users of the last status
+	// update are recorded.
+	kube := func(deleted bool, usages int, users *int64) *test.MockClient {
+		return &test.MockClient{
+			MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				pc := obj.(*v1beta1.ProviderConfig)
+				pc.SetName("cool-config")
+				if deleted {
+					pc.SetDeletionTimestamp(&now)
+				}
+				return nil
+			},
+			MockList: func(_ context.Context, obj runtime.Object, opts ...client.ListOption) error {
+				lo := &client.ListOptions{}
+				for _, o := range opts {
+					o.ApplyToList(lo)
+				}
+				if !lo.LabelSelector.Matches(labels.Set(labelsOf("cool-config"))) {
+					t.Errorf("List(...): unexpected label selector %s", lo.LabelSelector)
+				}
+				obj.(*v1beta1.ProviderConfigUsageList).Items = make([]v1beta1.ProviderConfigUsage, usages)
+				return nil
+			},
+			MockStatusUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+				*users = obj.(*v1beta1.ProviderConfig).Status.Users
+				return nil
+			},
+		}
+	}
+
+	type want struct {
+		result  reconcile.Result
+		err     error
+		users   int64
+		added   bool
+		removed bool
+	}
+
+	cases := map[string]struct {
+		reason    string
+		deleted   bool
+		usages    int
+		get       error
+		finalizer error
+		want      want
+	}{
+		"NotFound": {
+			reason: "A ProviderConfig that no longer exists should be ignored",
+			get:    kerrors.NewNotFound(schema.GroupResource{}, "cool-config"),
+		},
+		"GetFailed": {
+			reason: "Errors getting the ProviderConfig should be returned",
+			get:    errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGetProviderConfig)},
+		},
+		"InUse": {
+			reason: "A used ProviderConfig should be finalized and count its users",
+			usages: 2,
+			want:   want{users: 2, added: true},
+		},
+		"AddFinalizerFailed": {
+			reason:    "Errors adding the finalizer should be returned",
+			finalizer: errBoom,
+			want:      want{err: errors.Wrap(errBoom, errAddFinalizer), added: true},
+		},
+		"DeletedWhileInUse": {
+			reason:  "A deleted ProviderConfig that is still used should keep its finalizer",
+			deleted: true,
+			usages:  1,
+			want:    want{result: reconcile.Result{RequeueAfter: requeueAfterWhileUsed}, users: 1},
+		},
+		"DeletedUnused": {
+			reason:  "A deleted ProviderConfig that is no longer used should lose its finalizer",
+			deleted: true,
+			want:    want{removed: true},
+		},
+		"RemoveFinalizerFailed": {
+			reason:    "Errors removing the finalizer should be returned",
+			deleted:   true,
+			finalizer: errBoom,
+			want:      want{err: errors.Wrap(errBoom, errRemoveFinalizer), removed: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var users int64
+			var added, removed bool
+			c := kube(tc.deleted, tc.usages, &users)
+			if tc.get != nil {
+				c.MockGet = test.NewMockGetFn(tc.get)
+			}
+			r := &Reconciler{
+				client: c,
+				finalizer: resource.FinalizerFns{
+					AddFinalizerFn: func(_ context.Context, _ resource.Object) error {
+						added = true
+						return tc.finalizer
+					},
+					RemoveFinalizerFn: func(_ context.Context, _ resource.Object) error {
+						removed = true
+						return tc.finalizer
+					},
+				},
+				log: logging.NewNopLogger(),
+			}
+			got, err := r.Reconcile(req)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.users, users); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want users, +got users:\n%s", tc.reason, diff)
+			}
+			if added != tc.want.added || removed != tc.want.removed {
+				t.Errorf("\n%s\nReconcile(...): want finalizer added %t and removed %t, got %t and %t", tc.reason, tc.want.added, tc.want.removed, added, removed)
+			}
+		})
+	}
+}
+
+func TestUsedProviderConfig(t *testing.T) {
+	cases := map[string]struct {
+		labels map[string]string
+		want   []reconcile.Request
+	}{
+		"Labelled": {
+			labels: labelsOf("cool-config"),
+			want:   []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "cool-config"}}},
+		},
+		"Unlabelled": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u := &v1beta1.ProviderConfigUsage{ObjectMeta: metav1.ObjectMeta{Labels: tc.labels}}
+			got := usedProviderConfig(handler.MapObject{Meta: u, Object: u})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("usedProviderConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func labelsOf(name string) map[string]string {
+	return map[string]string{v1beta1.LabelKeyProviderConfigName: name}
+}
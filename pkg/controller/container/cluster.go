@@ -23,8 +23,6 @@ import (
 	"github.com/pkg/errors"
 	container "google.golang.org/api/container/v1beta1"
 	"google.golang.org/api/option"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,17 +35,14 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/apis/container/v1beta1"
-	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	gke "github.com/crossplane/provider-gcp/pkg/clients/cluster"
 )
 
 // Error strings.
 const (
-	errGetProvider          = "cannot get Provider"
-	errProviderSecretNil    = "cannot find Secret reference on Provider"
-	errGetProviderSecret    = "cannot get Provider Secret"
 	errNewClient            = "cannot create new GKE container client"
 	errManagedUpdateFailed  = "cannot update GKECluster custom resource"
 	errNotCluster           = "managed resource is not a GKECluster"
@@ -56,8 +51,13 @@ const (
 	errUpdateCluster        = "cannot update GKE cluster"
 	errDeleteCluster        = "cannot delete GKE cluster"
 	errCheckClusterUpToDate = "cannot determine if GKE cluster is up to date"
+	errGetClusterOp         = "cannot get the pending GKE cluster operation"
+	errClusterOpFailed      = "the pending GKE cluster operation has failed"
 )
 
+// operationCreateCluster is the type of the operation that creates a cluster.
+const operationCreateCluster = "CREATE_CLUSTER"
+
 // SetupGKECluster adds a controller that reconciles GKECluster
 // managed resources.
 func SetupGKECluster(mgr ctrl.Manager, l logging.Logger) error {
@@ -68,7 +68,7 @@ func SetupGKECluster(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1beta1.GKECluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.GKEClusterGroupVersionKind),
-			managed.WithExternalConnecter(&clusterConnector{kube: mgr.GetClient(), newServiceFn: container.NewService}),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&clusterConnector{kube: mgr.GetClient(), newServiceFn: container.NewService})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -85,24 +85,11 @@ func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (ma
 		return nil, errors.New(errNotCluster)
 	}
 
-	p := &gcpv1alpha3.Provider{}
-	if err := c.kube.Get(ctx, meta.NamespacedNameOf(i.Spec.ProviderReference), p); err != nil {
-		return nil, errors.Wrap(err, errGetProvider)
-	}
-
-	if p.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretNil)
-	}
-
-	s := &corev1.Secret{}
-	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
-	if err := c.kube.Get(ctx, n, s); err != nil {
-		return nil, errors.Wrap(err, errGetProviderSecret)
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, i.Spec.ProviderReference, container.CloudPlatformScope)
+	if err != nil {
+		return nil, err
 	}
-
-	client, err := c.newServiceFn(ctx,
-		option.WithCredentialsJSON(s.Data[p.Spec.CredentialsSecretRef.Key]),
-		option.WithScopes(container.CloudPlatformScope))
+	client, err := c.newServiceFn(ctx, opts...)
 	return &clusterExternal{cluster: client, projectID: p.Spec.ProjectID, kube: c.kube}, errors.Wrap(err, errNewClient)
 }
 
@@ -118,12 +105,38 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalObservation{}, errors.New(errNotCluster)
 	}
 
+	op := cr.Status.AtProvider.Operation
 	existing, err := e.cluster.Projects.Locations.Clusters.Get(gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
-	if err != nil {
+	if err != nil && (op == "" || !gcp.IsErrorNotFound(err)) {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCluster)
 	}
 
-	cr.Status.AtProvider = gke.GenerateObservation(*existing)
+	// Poll the operation that creates or updates the cluster until it is
+	// done. A cluster that is being created may not be found yet.
+	creating := false
+	if op != "" {
+		o, err := e.cluster.Projects.Locations.Operations.Get(gke.GetFullyQualifiedOperation(e.projectID, cr.Spec.ForProvider, op)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetClusterOp)
+		}
+		gop := gcp.ContainerOperation(o)
+		if gop.Done {
+			op = ""
+			cr.Status.AtProvider.Operation = ""
+		}
+		if gop.Error != "" {
+			return managed.ExternalObservation{}, errors.Wrap(errors.New(gop.Error), errClusterOpFailed)
+		}
+		creating = !gop.Done && o.OperationType == operationCreateCluster
+	}
+	if existing == nil {
+		if op == "" {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cr.Status.SetConditions(v1alpha1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	gke.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
@@ -131,14 +144,16 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 		}
 	}
+	cr.Status.AtProvider = gke.GenerateObservation(*existing)
+	cr.Status.AtProvider.Operation = op
 
-	switch cr.Status.AtProvider.Status {
-	case v1beta1.ClusterStateRunning, v1beta1.ClusterStateReconciling:
+	switch status := cr.Status.AtProvider.Status; {
+	case creating, status == v1beta1.ClusterStateProvisioning:
+		cr.Status.SetConditions(v1alpha1.Creating())
+	case status == v1beta1.ClusterStateRunning, status == v1beta1.ClusterStateReconciling:
 		cr.Status.SetConditions(v1alpha1.Available())
 		resource.SetBindable(cr)
-	case v1beta1.ClusterStateProvisioning:
-		cr.Status.SetConditions(v1alpha1.Creating())
-	case v1beta1.ClusterStateUnspecified, v1beta1.ClusterStateDegraded, v1beta1.ClusterStateError:
+	case status == v1beta1.ClusterStateUnspecified, status == v1beta1.ClusterStateDegraded, status == v1beta1.ClusterStateError:
 		cr.Status.SetConditions(v1alpha1.Unavailable())
 	}
 
@@ -147,9 +162,10 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckClusterUpToDate)
 	}
 
+	// The cluster is not updated again until the pending operation is done.
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  u,
+		ResourceUpToDate:  u || op != "",
 		ConnectionDetails: connectionDetails(existing),
 	}, nil
 }
@@ -180,8 +196,12 @@ func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (mana
 		Cluster: cluster,
 	}
 
-	_, err := e.cluster.Projects.Locations.Clusters.Create(gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), create).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	op, err := e.cluster.Projects.Locations.Clusters.Create(gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), create).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	}
+	cr.Status.AtProvider.Operation = op.Name
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -212,8 +232,14 @@ func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (mana
 	// the difference in the desired and existing spec. Only one field can be
 	// updated at a time, so if there are multiple diffs, the next one will be
 	// handled after the current one is completed.
-	_, err = fn(ctx, e.cluster, gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+	op, err := fn(ctx, e.cluster, gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+	}
+	if op != nil {
+		cr.Status.AtProvider.Operation = op.Name
+	}
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -242,13 +268,14 @@ func connectionDetails(cluster *container.Cluster) managed.ConnectionDetails {
 		return nil
 	}
 	cd := managed.ConnectionDetails{
-		runtimev1alpha1.ResourceCredentialsSecretEndpointKey:   []byte(config.Clusters[cluster.Name].Server),
-		runtimev1alpha1.ResourceCredentialsSecretUserKey:       []byte(config.AuthInfos[cluster.Name].Username),
-		runtimev1alpha1.ResourceCredentialsSecretPasswordKey:   []byte(config.AuthInfos[cluster.Name].Password),
-		runtimev1alpha1.ResourceCredentialsSecretCAKey:         config.Clusters[cluster.Name].CertificateAuthorityData,
-		runtimev1alpha1.ResourceCredentialsSecretClientCertKey: config.AuthInfos[cluster.Name].ClientCertificateData,
-		runtimev1alpha1.ResourceCredentialsSecretClientKeyKey:  config.AuthInfos[cluster.Name].ClientKeyData,
-		runtimev1alpha1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
+		connection.KeyEndpoint:             []byte(config.Clusters[cluster.Name].Server),
+		connection.KeyUsername:             []byte(config.AuthInfos[cluster.Name].Username),
+		connection.KeyPassword:             []byte(config.AuthInfos[cluster.Name].Password),
+		connection.KeyClusterCA:            config.Clusters[cluster.Name].CertificateAuthorityData,
+		connection.KeyClusterCACertificate: config.Clusters[cluster.Name].CertificateAuthorityData,
+		connection.KeyClientCertificate:    config.AuthInfos[cluster.Name].ClientCertificateData,
+		connection.KeyClientKey:            config.AuthInfos[cluster.Name].ClientKeyData,
+		connection.KeyKubeconfig:           rawConfig,
 	}
 	return cd
 }
@@ -24,6 +24,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -42,6 +43,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/apis/container/v1beta1"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gke "github.com/crossplane/provider-gcp/pkg/clients/cluster"
@@ -80,6 +82,10 @@ func withProviderStatus(s string) clusterModifier {
 	return func(i *v1beta1.GKECluster) { i.Status.AtProvider.Status = s }
 }
 
+func withOperation(op string) clusterModifier {
+	return func(i *v1beta1.GKECluster) { i.Status.AtProvider.Operation = op }
+}
+
 func withBindingPhase(p runtimev1alpha1.BindingPhase) clusterModifier {
 	return func(i *v1beta1.GKECluster) { i.Status.SetBindingPhase(p) }
 }
@@ -186,7 +192,7 @@ func TestConnect(t *testing.T) {
 				mg: cluster(),
 			},
 			want: want{
-				err: errors.Wrap(errBoom, errGetProvider),
+				err: errors.Wrap(errBoom, "cannot get Provider"),
 			},
 		},
 		"FailedToGetProviderSecret": {
@@ -202,7 +208,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: cluster()},
-			want: want{err: errors.Wrap(errBoom, errGetProviderSecret)},
+			want: want{err: errors.Wrap(errBoom, "cannot get Provider Secret")},
 		},
 		"ProviderSecretNil": {
 			conn: &nodePoolConnector{
@@ -219,7 +225,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: nodePool()},
-			want: want{err: errors.New(errProviderSecretNil)},
+			want: want{err: errors.New("cannot find Secret reference on Provider")},
 		},
 		"FailedToCreateContainerClient": {
 			conn: &clusterConnector{
@@ -299,6 +305,115 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetCluster),
 			},
 		},
+		"CreateOperationPending": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/operations/op") {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op", OperationType: operationCreateCluster, Status: "RUNNING"})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&container.Cluster{})
+			}),
+			args: args{
+				mg: cluster(withOperation("op")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				mg:  cluster(withOperation("op"), withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateOperationFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/operations/op") {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op", OperationType: operationCreateCluster, Status: "DONE", StatusMessage: "no quota"})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&container.Cluster{})
+			}),
+			args: args{
+				mg: cluster(withOperation("op")),
+			},
+			want: want{
+				mg:  cluster(),
+				err: errors.Wrap(errors.New("no quota"), errClusterOpFailed),
+			},
+		},
+		"CreateOperationDoneNotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/operations/op") {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op", OperationType: operationCreateCluster, Status: "DONE"})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&container.Cluster{})
+			}),
+			args: args{
+				mg: cluster(withOperation("op")),
+			},
+			want: want{
+				mg: cluster(),
+			},
+		},
+		"GetOperationFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/operations/op") {
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&container.Operation{})
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&container.Cluster{})
+			}),
+			args: args{
+				mg: cluster(withOperation("op")),
+			},
+			want: want{
+				mg:  cluster(withOperation("op")),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetClusterOp),
+			},
+		},
+		"RunningCreateOperationPending": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/operations/op") {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op", OperationType: operationCreateCluster, Status: "RUNNING"})
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				c := &container.Cluster{}
+				gke.GenerateCluster(name, cluster().Spec.ForProvider, c)
+				c.Status = v1beta1.ClusterStateRunning
+				c.Locations = []string{"loc-1"}
+				_ = json.NewEncoder(w).Encode(c)
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				mg: cluster(withOperation("op")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(&container.Cluster{}),
+				},
+				mg: cluster(
+					withLocations([]string{"loc-1"}),
+					withProviderStatus(v1beta1.ClusterStateRunning),
+					withOperation("op"),
+					withConditions(runtimev1alpha1.Creating())),
+			},
+		},
 		"NotUpToDateSpecUpdateFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -512,13 +627,13 @@ func TestCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				_ = json.NewEncoder(w).Encode(&container.Operation{})
+				_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op"})
 			}),
 			args: args{
 				mg: cluster(),
 			},
 			want: want{
-				mg: cluster(withConditions(runtimev1alpha1.Creating())),
+				mg: cluster(withConditions(runtimev1alpha1.Creating()), withOperation("op")),
 				cre: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
 					runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(wantRandom),
 				}},
@@ -764,7 +879,7 @@ func TestUpdate(t *testing.T) {
 					_ = json.NewEncoder(w).Encode(&container.Cluster{})
 				case http.MethodPut:
 					w.WriteHeader(http.StatusOK)
-					_ = json.NewEncoder(w).Encode(&container.Operation{})
+					_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op"})
 				default:
 					w.WriteHeader(http.StatusBadRequest)
 					_ = json.NewEncoder(w).Encode(&container.Operation{})
@@ -777,7 +892,7 @@ func TestUpdate(t *testing.T) {
 				mg: cluster(withLocations([]string{"loc-1"})),
 			},
 			want: want{
-				mg:  cluster(withLocations([]string{"loc-1"})),
+				mg:  cluster(withLocations([]string{"loc-1"}), withOperation("op")),
 				err: nil,
 			},
 		},
@@ -1026,6 +1141,7 @@ users:
 				runtimev1alpha1.ResourceCredentialsSecretUserKey:       []byte(username),
 				runtimev1alpha1.ResourceCredentialsSecretPasswordKey:   []byte(password),
 				runtimev1alpha1.ResourceCredentialsSecretCAKey:         clusterCA,
+				connection.KeyClusterCACertificate:                     clusterCA,
 				runtimev1alpha1.ResourceCredentialsSecretClientCertKey: clientCert,
 				runtimev1alpha1.ResourceCredentialsSecretClientKeyKey:  clientKey,
 				runtimev1alpha1.ResourceCredentialsSecretKubeconfigKey: []byte(rawConfig),
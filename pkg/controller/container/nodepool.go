@@ -23,8 +23,6 @@ import (
 	"github.com/pkg/errors"
 	container "google.golang.org/api/container/v1beta1"
 	"google.golang.org/api/option"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,7 +34,6 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-gcp/apis/container/v1alpha1"
-	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	np "github.com/crossplane/provider-gcp/pkg/clients/nodepool"
 )
@@ -62,7 +59,7 @@ func SetupNodePool(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.NodePool{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.NodePoolGroupVersionKind),
-			managed.WithExternalConnecter(&nodePoolConnector{kube: mgr.GetClient(), newServiceFn: container.NewService}),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&nodePoolConnector{kube: mgr.GetClient(), newServiceFn: container.NewService})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -79,24 +76,11 @@ func (c *nodePoolConnector) Connect(ctx context.Context, mg resource.Managed) (m
 		return nil, errors.New(errNotNodePool)
 	}
 
-	p := &gcpv1alpha3.Provider{}
-	if err := c.kube.Get(ctx, meta.NamespacedNameOf(i.Spec.ProviderReference), p); err != nil {
-		return nil, errors.Wrap(err, errGetProvider)
-	}
-
-	if p.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretNil)
-	}
-
-	s := &corev1.Secret{}
-	n := types.NamespacedName{Namespace: p.Spec.ProviderSpec.CredentialsSecretRef.Namespace, Name: p.Spec.ProviderSpec.CredentialsSecretRef.Name}
-	if err := c.kube.Get(ctx, n, s); err != nil {
-		return nil, errors.Wrap(err, errGetProviderSecret)
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, i.Spec.ProviderReference, container.CloudPlatformScope)
+	if err != nil {
+		return nil, err
 	}
-
-	client, err := c.newServiceFn(ctx,
-		option.WithCredentialsJSON(s.Data[p.Spec.ProviderSpec.CredentialsSecretRef.Key]),
-		option.WithScopes(container.CloudPlatformScope))
+	client, err := c.newServiceFn(ctx, opts...)
 	return &nodePoolExternal{container: client, projectID: p.Spec.ProjectID, kube: c.kube}, errors.Wrap(err, errNewClient)
 }
 
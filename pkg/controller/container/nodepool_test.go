@@ -152,7 +152,7 @@ func TestNodePoolConnect(t *testing.T) {
 				mg: nodePool(),
 			},
 			want: want{
-				err: errors.Wrap(errBoom, errGetProvider),
+				err: errors.Wrap(errBoom, "cannot get Provider"),
 			},
 		},
 		"FailedToGetProviderSecret": {
@@ -168,7 +168,7 @@ func TestNodePoolConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: nodePool()},
-			want: want{err: errors.Wrap(errBoom, errGetProviderSecret)},
+			want: want{err: errors.Wrap(errBoom, "cannot get Provider Secret")},
 		},
 		"ProviderSecretNil": {
 			conn: &nodePoolConnector{
@@ -185,7 +185,7 @@ func TestNodePoolConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: nodePool()},
-			want: want{err: errors.New(errProviderSecretNil)},
+			want: want{err: errors.New("cannot find Secret reference on Provider")},
 		},
 		"FailedToCreateContainerClient": {
 			conn: &nodePoolConnector{
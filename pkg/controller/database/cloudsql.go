@@ -24,8 +24,6 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/api/option"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
-	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -37,18 +35,15 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
-	apisv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
 )
 
 const (
-	errNotCloudSQL                = "managed resource is not a CloudSQLInstance custom resource"
-	errProviderNotRetrieved       = "provider could not be retrieved"
-	errProviderSecretNil          = "cannot find Secret reference on Provider"
-	errProviderSecretNotRetrieved = "secret referred in provider could not be retrieved"
-	errManagedUpdateFailed        = "cannot update CloudSQLInstance custom resource"
+	errNotCloudSQL         = "managed resource is not a CloudSQLInstance custom resource"
+	errManagedUpdateFailed = "cannot update CloudSQLInstance custom resource"
 
 	errNewClient        = "cannot create new Sqladmin Service"
 	errCreateFailed     = "cannot create new CloudSQL instance"
@@ -57,6 +52,7 @@ const (
 	errUpdateFailed     = "cannot update the CloudSQL instance"
 	errGetFailed        = "cannot get the CloudSQL instance"
 	errGeneratePassword = "cannot generate root password"
+	errImmutable        = "cannot change %s of an existing CloudSQL instance"
 	errCheckUpToDate    = "cannot determine if CloudSQL instance is up to date"
 )
 
@@ -67,7 +63,7 @@ func SetupCloudSQLInstance(mgr ctrl.Manager, l logging.Logger) error {
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.CloudSQLInstanceGroupVersionKind),
-		managed.WithExternalConnecter(&cloudsqlConnector{kube: mgr.GetClient(), newServiceFn: sqladmin.NewService}),
+		managed.WithExternalConnecter(gcp.NewTracingConnecter(&cloudsqlConnector{kube: mgr.GetClient(), newServiceFn: sqladmin.NewService})),
 		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &cloudsqlTagger{kube: mgr.GetClient()}),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(l.WithValues("controller", name)),
@@ -90,24 +86,11 @@ func (c *cloudsqlConnector) Connect(ctx context.Context, mg resource.Managed) (m
 		return nil, errors.New(errNotCloudSQL)
 	}
 
-	provider := &apisv1alpha3.Provider{}
-	if err := c.kube.Get(ctx, meta.NamespacedNameOf(cr.Spec.ProviderReference), provider); err != nil {
-		return nil, errors.Wrap(err, errProviderNotRetrieved)
-	}
-
-	if provider.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretNil)
-	}
-
-	secret := &v1.Secret{}
-	n := types.NamespacedName{Namespace: provider.Spec.CredentialsSecretRef.Namespace, Name: provider.Spec.CredentialsSecretRef.Name}
-	if err := c.kube.Get(ctx, n, secret); err != nil {
-		return nil, errors.Wrap(err, errProviderSecretNotRetrieved)
+	opts, provider, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, sqladmin.SqlserviceAdminScope)
+	if err != nil {
+		return nil, err
 	}
-
-	s, err := c.newServiceFn(ctx,
-		option.WithCredentialsJSON(secret.Data[provider.Spec.CredentialsSecretRef.Key]),
-		option.WithScopes(sqladmin.SqlserviceAdminScope))
+	s, err := c.newServiceFn(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -185,7 +168,7 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (man
 	}
 
 	cd := managed.ConnectionDetails{
-		v1alpha1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		connection.KeyPassword: []byte(pw),
 	}
 	return managed.ExternalCreation{ConnectionDetails: cd}, nil
 }
@@ -198,11 +181,18 @@ func (c *cloudsqlExternal) Update(ctx context.Context, mg resource.Managed) (man
 	if cr.Status.AtProvider.State == v1beta1.StateCreating {
 		return managed.ExternalUpdate{}, nil
 	}
+	observed, err := c.db.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFailed)
+	}
+	if changed := cloudsql.ChangedImmutableFields(cr.Spec.ForProvider, *observed); len(changed) > 0 {
+		return managed.ExternalUpdate{}, errors.Errorf(errImmutable, strings.Join(changed, " and "))
+	}
 	instance := &sqladmin.DatabaseInstance{}
 	cloudsql.GenerateDatabaseInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, instance)
 	// TODO(muvaf): the returned operation handle could help us not to send Patch
 	// request aggressively.
-	_, err := c.db.Patch(c.projectID, meta.GetExternalName(cr), instance).Context(ctx).Do()
+	_, err = c.db.Patch(c.projectID, meta.GetExternalName(cr), instance).Context(ctx).Do()
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
 }
 
@@ -221,8 +211,9 @@ func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) erro
 
 func getConnectionDetails(cr *v1beta1.CloudSQLInstance, instance *sqladmin.DatabaseInstance) managed.ConnectionDetails {
 	m := managed.ConnectionDetails{
-		v1alpha1.ResourceCredentialsSecretUserKey: []byte(cloudsql.DatabaseUserName(cr.Spec.ForProvider)),
-		v1beta1.CloudSQLSecretConnectionName:      []byte(instance.ConnectionName),
+		connection.KeyUsername:               []byte(cloudsql.DatabaseUserName(cr.Spec.ForProvider)),
+		connection.KeyPort:                   []byte(cloudsql.DatabasePort(cr.Spec.ForProvider)),
+		v1beta1.CloudSQLSecretConnectionName: []byte(instance.ConnectionName),
 	}
 
 	// TODO(muvaf): There might be cases where more than 1 private and/or public IP address has been assigned. We should
@@ -232,12 +223,12 @@ func getConnectionDetails(cr *v1beta1.CloudSQLInstance, instance *sqladmin.Datab
 			m[v1beta1.PrivateIPKey] = []byte(ip.IPAddress)
 			// TODO(muvaf): we explicitly enforce use of private IP if it's available. But this should be configured
 			// by resource class or claim.
-			m[v1alpha1.ResourceCredentialsSecretEndpointKey] = []byte(ip.IPAddress)
+			m[connection.KeyEndpoint] = []byte(ip.IPAddress)
 		}
 		if ip.Type == v1beta1.PublicIPType {
 			m[v1beta1.PublicIPKey] = []byte(ip.IPAddress)
-			if len(m[v1alpha1.ResourceCredentialsSecretEndpointKey]) == 0 {
-				m[v1alpha1.ResourceCredentialsSecretEndpointKey] = []byte(ip.IPAddress)
+			if len(m[connection.KeyEndpoint]) == 0 {
+				m[connection.KeyEndpoint] = []byte(ip.IPAddress)
 			}
 		}
 	}
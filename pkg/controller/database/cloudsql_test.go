@@ -40,6 +40,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
@@ -106,6 +107,18 @@ func withBackupConfigurationStartTime(h string) instanceModifier {
 	}
 }
 
+func withRegion(r string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.Region = r
+	}
+}
+
+func withDatabaseVersion(v string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.DatabaseVersion = &v
+	}
+}
+
 func instance(im ...instanceModifier) *v1beta1.CloudSQLInstance {
 	i := &v1beta1.CloudSQLInstance{
 		ObjectMeta: metav1.ObjectMeta{
@@ -132,8 +145,9 @@ func instance(im ...instanceModifier) *v1beta1.CloudSQLInstance {
 
 func connDetails(privateIP, publicIP string, additions ...map[string][]byte) managed.ConnectionDetails {
 	m := managed.ConnectionDetails{
-		runtimev1alpha1.ResourceCredentialsSecretUserKey: []byte(v1beta1.MysqlDefaultUser),
-		v1beta1.CloudSQLSecretConnectionName:             []byte(""),
+		connection.KeyUsername:               []byte(v1beta1.MysqlDefaultUser),
+		connection.KeyPort:                   []byte(v1beta1.MysqlDefaultPort),
+		v1beta1.CloudSQLSecretConnectionName: []byte(""),
 	}
 	if publicIP != "" {
 		m[v1beta1.PublicIPKey] = []byte(publicIP)
@@ -228,7 +242,7 @@ func TestConnect(t *testing.T) {
 				mg: instance(),
 			},
 			want: want{
-				err: errors.Wrap(errBoom, errProviderNotRetrieved),
+				err: errors.Wrap(errBoom, "cannot get Provider"),
 			},
 		},
 		"FailedToGetProviderSecret": {
@@ -244,7 +258,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: instance()},
-			want: want{err: errors.Wrap(errBoom, errProviderSecretNotRetrieved)},
+			want: want{err: errors.Wrap(errBoom, "cannot get Provider Secret")},
 		},
 		"ProviderSecretNil": {
 			conn: &cloudsqlConnector{
@@ -261,7 +275,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: instance()},
-			want: want{err: errors.New(errProviderSecretNil)},
+			want: want{err: errors.New("cannot find Secret reference on Provider")},
 		},
 		"FailedToCreateCloudSQLInstanceClient": {
 			conn: &cloudsqlConnector{
@@ -730,6 +744,10 @@ func TestUpdate(t *testing.T) {
 		"Successful": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(&sqladmin.DatabaseInstance{})
+					return
+				}
 				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
 					t.Errorf("r: -want, +got:\n%s", diff)
 				}
@@ -756,9 +774,41 @@ func TestUpdate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"ImmutableFieldChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.DatabaseInstance{Region: "us-east1", DatabaseVersion: "POSTGRES_11"})
+			}),
+			args: args{
+				mg: instance(withRegion("us-west1"), withDatabaseVersion("POSTGRES_12")),
+			},
+			want: want{
+				err: errors.Errorf(errImmutable, "region and databaseVersion"),
+			},
+		},
+		"GetFails": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.DatabaseInstance{})
+			}),
+			args: args{
+				mg: instance(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetFailed),
+			},
+		},
 		"PatchFails": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(&sqladmin.DatabaseInstance{})
+					return
+				}
 				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
 					t.Errorf("r: -want, +got:\n%s", diff)
 				}
@@ -850,6 +900,7 @@ func TestGetConnectionDetails(t *testing.T) {
 			},
 			want: want{
 				conn: connDetails(privateIP, publicIP, map[string][]byte{
+					connection.KeyServerCACertificate:                            []byte(cert),
 					v1beta1.CloudSQLSecretServerCACertificateCertKey:             []byte(cert),
 					v1beta1.CloudSQLSecretServerCACertificateCommonNameKey:       []byte(commonName),
 					v1beta1.CloudSQLSecretServerCACertificateCertSerialNumberKey: []byte(""),
@@ -0,0 +1,274 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	dataflow "google.golang.org/api/dataflow/v1b3"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	df "github.com/crossplane/provider-gcp/pkg/clients/dataflow"
+)
+
+// Error strings.
+const (
+	errGetProvider = "cannot get Provider"
+	errNewClient   = "cannot create new Dataflow client"
+
+	errNotJob    = "managed resource is not a Dataflow Job"
+	errLocation  = "cannot determine Dataflow Job location"
+	errUpdateCR  = "cannot update Dataflow Job custom resource"
+	errGetJob    = "cannot get Dataflow Job"
+	errListJobs  = "cannot list active Dataflow Jobs"
+	errLaunchJob = "cannot launch Dataflow flex template Job"
+	errUpdateJob = "cannot update Dataflow Job"
+	errStopJob   = "cannot stop Dataflow Job"
+)
+
+// filterActive restricts a job listing to jobs that are not yet terminated.
+const filterActive = "ACTIVE"
+
+// SetupJob adds a controller that reconciles Jobs.
+func SetupJob(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.JobGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Job{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.JobGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&jobConnector{kube: mgr.GetClient(), newServiceFn: dataflow.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &locationDefaulter{kube: mgr.GetClient()}),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type locationDefaulter struct {
+	kube client.Client
+}
+
+// Initialize defaults the location of the Job to the default region of its
+// Provider and ensures that it is a region. The default is persisted so that
+// the Job does not move if the Provider's default later changes.
+func (d *locationDefaulter) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return errors.New(errNotJob)
+	}
+	p := &gcpv1alpha3.Provider{}
+	if err := d.kube.Get(ctx, meta.NamespacedNameOf(cr.Spec.ProviderReference), p); err != nil {
+		return errors.Wrap(err, errGetProvider)
+	}
+	l, err := gcp.Region(cr.Spec.ForProvider.Location, p)
+	if err != nil {
+		return errors.Wrap(err, errLocation)
+	}
+	if cr.Spec.ForProvider.Location == l {
+		return nil
+	}
+	cr.Spec.ForProvider.Location = l
+	return errors.Wrap(d.kube.Update(ctx, cr), errUpdateCR)
+}
+
+type jobConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*dataflow.Service, error)
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *jobConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return nil, errors.New(errNotJob)
+	}
+
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, dataflow.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &jobExternal{
+		jobs:      svc.Projects.Locations.Jobs,
+		templates: svc.Projects.Locations.FlexTemplates,
+		projectID: p.Spec.ProjectID,
+	}, nil
+}
+
+type jobExternal struct {
+	jobs      *dataflow.ProjectsLocationsJobsService
+	templates *dataflow.ProjectsLocationsFlexTemplatesService
+	projectID string
+}
+
+// Observe polls the state of the job and reflects it in the status of the
+// managed resource.
+func (e *jobExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotJob)
+	}
+
+	j, err := e.find(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if j == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Preserve the hash of the spec the job was launched with. A job that we
+	// did not launch ourselves is assumed to match the current spec.
+	h := cr.Status.AtProvider.LaunchedSpecHash
+	if h == "" {
+		h = df.LaunchedSpecHash(cr.Spec.ForProvider)
+	}
+	cr.Status.AtProvider = df.GenerateJobObservation(*j)
+	cr.Status.AtProvider.LaunchedSpecHash = h
+
+	switch j.CurrentState {
+	case v1alpha1.JobStateRunning, v1alpha1.JobStateDone:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.JobStateDraining, v1alpha1.JobStateCancelling:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case v1alpha1.JobStateFailed, v1alpha1.JobStateCancelled, v1alpha1.JobStateDrained:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	default:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	}
+
+	// Dataflow does not support deleting jobs; they are retained in the job
+	// history. Once a stopped job's managed resource is deleted we report it
+	// as non-existent so that the managed reconciler can remove its finalizer.
+	if meta.WasDeleted(cr) && df.IsTerminal(j.CurrentState) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// Only running streaming jobs can be updated. Batch jobs run once and
+	// are never relaunched.
+	upToDate := true
+	if j.Type == v1alpha1.JobTypeStreaming && j.CurrentState == v1alpha1.JobStateRunning {
+		upToDate = h == df.LaunchedSpecHash(cr.Spec.ForProvider)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// find returns the job managed by the supplied resource, following any
+// replacements made by updates, or nil if it does not exist.
+func (e *jobExternal) find(ctx context.Context, cr *v1alpha1.Job) (*dataflow.Job, error) {
+	id := cr.Status.AtProvider.JobID
+	if id == "" {
+		// We may have launched the job but failed to record its ID.
+		return e.findActive(ctx, cr)
+	}
+	for {
+		j, err := e.jobs.Get(e.projectID, cr.Spec.ForProvider.Location, id).Context(ctx).Do()
+		if gcp.IsErrorNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errGetJob)
+		}
+		if j.CurrentState != v1alpha1.JobStateUpdated || j.ReplacedByJobId == "" {
+			return j, nil
+		}
+		id = j.ReplacedByJobId
+	}
+}
+
+// findActive returns the active job whose name matches the external name of
+// the supplied resource, or nil if there is none.
+func (e *jobExternal) findActive(ctx context.Context, cr *v1alpha1.Job) (*dataflow.Job, error) {
+	var found *dataflow.Job
+	err := e.jobs.List(e.projectID, cr.Spec.ForProvider.Location).Filter(filterActive).Pages(ctx, func(r *dataflow.ListJobsResponse) error {
+		for _, j := range r.Jobs {
+			if j.Name == meta.GetExternalName(cr) {
+				found = j
+			}
+		}
+		return nil
+	})
+	return found, errors.Wrap(err, errListJobs)
+}
+
+// Create launches the flex template.
+func (e *jobExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotJob)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	return managed.ExternalCreation{}, errors.Wrap(e.launch(ctx, cr, false), errLaunchJob)
+}
+
+// Update replaces a running streaming job with one launched from the current
+// spec.
+func (e *jobExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotJob)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.launch(ctx, cr, true), errUpdateJob)
+}
+
+func (e *jobExternal) launch(ctx context.Context, cr *v1alpha1.Job, update bool) error {
+	rq := df.GenerateLaunchRequest(meta.GetExternalName(cr), cr.Spec.ForProvider, update)
+	rsp, err := e.templates.Launch(e.projectID, cr.Spec.ForProvider.Location, rq).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if rsp.Job != nil {
+		cr.Status.AtProvider = df.GenerateJobObservation(*rsp.Job)
+	}
+	cr.Status.AtProvider.LaunchedSpecHash = df.LaunchedSpecHash(cr.Spec.ForProvider)
+	return nil
+}
+
+// Delete cancels or drains the job if it is still running.
+func (e *jobExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return errors.New(errNotJob)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	o := cr.Status.AtProvider
+	if o.JobID == "" || df.IsTerminal(o.State) || o.State == v1alpha1.JobStateDraining || o.State == v1alpha1.JobStateCancelling {
+		return nil
+	}
+	j := &dataflow.Job{RequestedState: df.CancelRequestedState(cr.Spec.ForProvider, o)}
+	_, err := e.jobs.Update(e.projectID, cr.Spec.ForProvider.Location, o.JobID, j).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errStopJob)
+}
@@ -0,0 +1,706 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	dataflow "google.golang.org/api/dataflow/v1b3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	df "github.com/crossplane/provider-gcp/pkg/clients/dataflow"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	location     = "us-central1"
+	testJobName  = "test-job"
+	testJobID    = "2020-01-01_00_00_00-1234"
+	providerName = "gcp-provider"
+	templatePath = "gs://bucket/templates/wordcount.json"
+)
+
+var (
+	_ managed.ExternalConnecter = &jobConnector{}
+	_ managed.ExternalClient    = &jobExternal{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type jobModifier func(*v1alpha1.Job)
+
+func jobWithConditions(c ...runtimev1alpha1.Condition) jobModifier {
+	return func(j *v1alpha1.Job) { j.Status.SetConditions(c...) }
+}
+
+func jobWithObservation(id, typ, state string) jobModifier {
+	return func(j *v1alpha1.Job) {
+		j.Status.AtProvider.JobID = id
+		j.Status.AtProvider.Type = typ
+		j.Status.AtProvider.State = state
+	}
+}
+
+func jobWithHash(h string) jobModifier {
+	return func(j *v1alpha1.Job) { j.Status.AtProvider.LaunchedSpecHash = h }
+}
+
+func jobWithParameter(k, v string) jobModifier {
+	return func(j *v1alpha1.Job) { j.Spec.ForProvider.Parameters[k] = v }
+}
+
+func jobWithLocation(l string) jobModifier {
+	return func(j *v1alpha1.Job) { j.Spec.ForProvider.Location = l }
+}
+
+func jobWithDrainOnDelete() jobModifier {
+	return func(j *v1alpha1.Job) { j.Spec.ForProvider.DrainOnDelete = gcp.BoolPtr(true) }
+}
+
+func jobWithDeletionTimestamp() jobModifier {
+	return func(j *v1alpha1.Job) { j.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1, 0)}) }
+}
+
+func jobObj(m ...jobModifier) *v1alpha1.Job {
+	j := &v1alpha1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testJobName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testJobName,
+			},
+		},
+		Spec: v1alpha1.JobSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.JobParameters{
+				Location:             location,
+				ContainerSpecGCSPath: templatePath,
+				Parameters:           map[string]string{"input": "gs://bucket/in"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(j)
+	}
+	return j
+}
+
+// hash returns the launched spec hash of a job built with the supplied
+// modifiers.
+func hash(m ...jobModifier) string {
+	return df.LaunchedSpecHash(jobObj(m...).Spec.ForProvider)
+}
+
+func jobPath(id string) string {
+	return "/v1b3/projects/" + projectID + "/locations/" + location + "/jobs/" + id
+}
+
+func TestLocationDefaulterInitialize(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	getProvider := func(defaultRegion string) test.MockGetFn {
+		return func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+			if diff := cmp.Diff(client.ObjectKey{Name: providerName}, key); diff != "" {
+				t.Errorf("key: -want, +got:\n%s", diff)
+			}
+			obj.(*gcpv1alpha3.Provider).Spec.DefaultRegion = defaultRegion
+			return nil
+		}
+	}
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		mg   resource.Managed
+		want want
+	}{
+		"NotJob": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotJob),
+			},
+		},
+		"GetProviderFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:   jobObj(jobWithLocation("")),
+			want: want{
+				mg:  jobObj(jobWithLocation("")),
+				err: errors.Wrap(errBoom, errGetProvider),
+			},
+		},
+		"LocationSet": {
+			kube: &test.MockClient{
+				MockGet: getProvider("europe-west1"),
+				MockUpdate: func(_ context.Context, _ runtime.Object, _ ...client.UpdateOption) error {
+					t.Errorf("unexpected Update")
+					return nil
+				},
+			},
+			mg: jobObj(),
+			want: want{
+				mg: jobObj(),
+			},
+		},
+		"LocationDefaulted": {
+			kube: &test.MockClient{
+				MockGet:    getProvider("europe-west1"),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			mg: jobObj(jobWithLocation("")),
+			want: want{
+				mg: jobObj(jobWithLocation("europe-west1")),
+			},
+		},
+		"NoLocation": {
+			kube: &test.MockClient{MockGet: getProvider("")},
+			mg:   jobObj(jobWithLocation("")),
+			want: want{
+				mg:  jobObj(jobWithLocation("")),
+				err: errors.Wrap(errors.New("neither the managed resource nor its Provider's defaultRegion specify a region"), errLocation),
+			},
+		},
+		"ZoneAsLocation": {
+			kube: &test.MockClient{MockGet: getProvider("")},
+			mg:   jobObj(jobWithLocation("us-central1-a")),
+			want: want{
+				mg:  jobObj(jobWithLocation("us-central1-a")),
+				err: errors.Wrap(errors.New(`"us-central1-a" is not a region; expected a region such as us-central1`), errLocation),
+			},
+		},
+		"UpdateFailed": {
+			kube: &test.MockClient{
+				MockGet:    getProvider("europe-west1"),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			mg: jobObj(jobWithLocation("")),
+			want: want{
+				mg:  jobObj(jobWithLocation("europe-west1")),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := &locationDefaulter{kube: tc.kube}
+			err := d.Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Initialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotJob": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotJob),
+			},
+		},
+		"NotLaunched": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1b3/projects/"+projectID+"/locations/"+location+"/jobs", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(filterActive, r.URL.Query().Get("filter")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.ListJobsResponse{Jobs: []*dataflow.Job{{Id: "other", Name: "other-job"}}})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg: jobObj(),
+			},
+		},
+		"FoundActiveWithoutID": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.ListJobsResponse{Jobs: []*dataflow.Job{{
+					Id:           testJobID,
+					Name:         testJobName,
+					Type:         v1alpha1.JobTypeStreaming,
+					CurrentState: v1alpha1.JobStateRunning,
+				}}})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg: jobObj(
+					jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning),
+					jobWithHash(hash()),
+					jobWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{})
+			}),
+			mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning)),
+			want: want{
+				mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning)),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{})
+			}),
+			mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning)),
+			want: want{
+				mg:  jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning)),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetJob),
+			},
+		},
+		"Pending": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{Id: testJobID, Type: v1alpha1.JobTypeBatch, CurrentState: v1alpha1.JobStatePending})
+			}),
+			mg: jobObj(jobWithObservation(testJobID, "", ""), jobWithHash(hash())),
+			want: want{
+				mg: jobObj(
+					jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStatePending),
+					jobWithHash(hash()),
+					jobWithConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"StreamingSpecChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{Id: testJobID, Type: v1alpha1.JobTypeStreaming, CurrentState: v1alpha1.JobStateRunning})
+			}),
+			mg: jobObj(
+				jobWithParameter("input", "gs://bucket/other"),
+				jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning),
+				jobWithHash(hash())),
+			want: want{
+				mg: jobObj(
+					jobWithParameter("input", "gs://bucket/other"),
+					jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning),
+					jobWithHash(hash()),
+					jobWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"BatchSpecChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{Id: testJobID, Type: v1alpha1.JobTypeBatch, CurrentState: v1alpha1.JobStateRunning})
+			}),
+			mg: jobObj(
+				jobWithParameter("input", "gs://bucket/other"),
+				jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning),
+				jobWithHash(hash())),
+			want: want{
+				mg: jobObj(
+					jobWithParameter("input", "gs://bucket/other"),
+					jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning),
+					jobWithHash(hash()),
+					jobWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"FollowsReplacement": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				switch r.URL.Path {
+				case jobPath(testJobID):
+					_ = json.NewEncoder(w).Encode(&dataflow.Job{
+						Id:              testJobID,
+						Type:            v1alpha1.JobTypeStreaming,
+						CurrentState:    v1alpha1.JobStateUpdated,
+						ReplacedByJobId: "replacement",
+					})
+				case jobPath("replacement"):
+					_ = json.NewEncoder(w).Encode(&dataflow.Job{Id: "replacement", Type: v1alpha1.JobTypeStreaming, CurrentState: v1alpha1.JobStateRunning})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+				}
+			}),
+			mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning), jobWithHash(hash())),
+			want: want{
+				mg: jobObj(
+					jobWithObservation("replacement", v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning),
+					jobWithHash(hash()),
+					jobWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DeletedAfterCancelled": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{Id: testJobID, Type: v1alpha1.JobTypeBatch, CurrentState: v1alpha1.JobStateCancelled})
+			}),
+			mg: jobObj(
+				jobWithDeletionTimestamp(),
+				jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateCancelling),
+				jobWithHash(hash())),
+			want: want{
+				mg: jobObj(
+					jobWithDeletionTimestamp(),
+					jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateCancelled),
+					jobWithHash(hash()),
+					jobWithConditions(runtimev1alpha1.Unavailable())),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataflow.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{jobs: s.Projects.Locations.Jobs, templates: s.Projects.Locations.FlexTemplates, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotJob": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotJob),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				rq := &dataflow.LaunchFlexTemplateRequest{}
+				if err := json.NewDecoder(r.Body).Decode(rq); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(df.GenerateLaunchRequest(testJobName, jobObj().Spec.ForProvider, false), rq); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.LaunchFlexTemplateResponse{Job: &dataflow.Job{
+					Id:           testJobID,
+					Type:         v1alpha1.JobTypeBatch,
+					CurrentState: v1alpha1.JobStateQueued,
+				}})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg: jobObj(
+					jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateQueued),
+					jobWithHash(hash()),
+					jobWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dataflow.LaunchFlexTemplateResponse{})
+			}),
+			mg: jobObj(),
+			want: want{
+				mg:  jobObj(jobWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errLaunchJob),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataflow.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{jobs: s.Projects.Locations.Jobs, templates: s.Projects.Locations.FlexTemplates, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	changed := jobWithParameter("input", "gs://bucket/other")
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotJob": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotJob),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				rq := &dataflow.LaunchFlexTemplateRequest{}
+				if err := json.NewDecoder(r.Body).Decode(rq); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff("true", rq.LaunchParameter.Parameters["update"]); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.LaunchFlexTemplateResponse{Job: &dataflow.Job{
+					Id:           "replacement",
+					Type:         v1alpha1.JobTypeStreaming,
+					CurrentState: v1alpha1.JobStatePending,
+				}})
+			}),
+			mg: jobObj(changed, jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning), jobWithHash(hash())),
+			want: want{
+				mg: jobObj(changed, jobWithObservation("replacement", v1alpha1.JobTypeStreaming, v1alpha1.JobStatePending), jobWithHash(hash(changed))),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dataflow.LaunchFlexTemplateResponse{})
+			}),
+			mg: jobObj(changed, jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning), jobWithHash(hash())),
+			want: want{
+				mg:  jobObj(changed, jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning), jobWithHash(hash())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateJob),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataflow.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{jobs: s.Projects.Locations.Jobs, templates: s.Projects.Locations.FlexTemplates, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	requestedState := func(want string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			j := &dataflow.Job{}
+			if err := json.NewDecoder(r.Body).Decode(j); err != nil {
+				t.Errorf("cannot decode request body: %s", err)
+			}
+			_ = r.Body.Close()
+			if diff := cmp.Diff(want, j.RequestedState); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(j)
+		})
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotJob": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotJob),
+			},
+		},
+		"AlreadyDone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			}),
+			mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateDone)),
+			want: want{
+				mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateDone), jobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyDraining": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			}),
+			mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateDraining)),
+			want: want{
+				mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateDraining), jobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Cancelled": {
+			handler: requestedState(v1alpha1.JobStateCancelled),
+			mg:      jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning)),
+			want: want{
+				mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning), jobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Drained": {
+			handler: requestedState(v1alpha1.JobStateDrained),
+			mg:      jobObj(jobWithDrainOnDelete(), jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning)),
+			want: want{
+				mg: jobObj(jobWithDrainOnDelete(), jobWithObservation(testJobID, v1alpha1.JobTypeStreaming, v1alpha1.JobStateRunning), jobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{})
+			}),
+			mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning)),
+			want: want{
+				mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning), jobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{})
+			}),
+			mg: jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning)),
+			want: want{
+				mg:  jobObj(jobWithObservation(testJobID, v1alpha1.JobTypeBatch, v1alpha1.JobStateRunning), jobWithConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errStopJob),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataflow.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{jobs: s.Projects.Locations.Jobs, templates: s.Projects.Locations.FlexTemplates, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
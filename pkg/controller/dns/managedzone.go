@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	dnsv1 "google.golang.org/api/dns/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/connection"
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	dns "github.com/crossplane/provider-gcp/pkg/clients/dns"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new Cloud DNS client"
+	errUpdateCR  = "cannot update Cloud DNS custom resource"
+
+	errNotManagedZone    = "managed resource is not a Cloud DNS ManagedZone"
+	errGetManagedZone    = "cannot get Cloud DNS managed zone"
+	errCreateManagedZone = "cannot create Cloud DNS managed zone"
+	errUpdateManagedZone = "cannot update Cloud DNS managed zone"
+	errDeleteManagedZone = "cannot delete Cloud DNS managed zone"
+)
+
+type newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*dnsv1.Service, error)
+
+// SetupManagedZone adds a controller that reconciles Cloud DNS ManagedZones.
+func SetupManagedZone(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ManagedZoneGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ManagedZone{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ManagedZoneGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&managedZoneConnector{kube: mgr.GetClient(), newServiceFn: dnsv1.NewService})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type managedZoneConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *managedZoneConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return nil, errors.New(errNotManagedZone)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, dnsv1.NdevClouddnsReadwriteScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &managedZoneExternal{kube: c.kube, managedZones: s.ManagedZones, projectID: p.Spec.ProjectID}, nil
+}
+
+type managedZoneExternal struct {
+	kube         client.Client
+	managedZones *dnsv1.ManagedZonesService
+	projectID    string
+}
+
+func (e *managedZoneExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotManagedZone)
+	}
+	observed, err := e.managedZones.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetManagedZone)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	dns.LateInitializeManagedZone(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = dns.GenerateManagedZoneObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  dns.IsManagedZoneUpToDate(cr.Spec.ForProvider, *observed),
+		ConnectionDetails: managed.ConnectionDetails{connection.KeyNameServers: []byte(strings.Join(observed.NameServers, ","))},
+	}, nil
+}
+
+func (e *managedZoneExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotManagedZone)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.managedZones.Create(e.projectID, dns.GenerateManagedZone(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateManagedZone)
+}
+
+func (e *managedZoneExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotManagedZone)
+	}
+	_, err := e.managedZones.Patch(e.projectID, meta.GetExternalName(cr), dns.GenerateManagedZonePatch(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateManagedZone)
+}
+
+// Delete deletes the managed zone. Cloud DNS refuses to delete a zone that
+// has record sets other than its SOA and NS record sets, so those must be
+// deleted first.
+func (e *managedZoneExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return errors.New(errNotManagedZone)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	err := e.managedZones.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteManagedZone)
+}
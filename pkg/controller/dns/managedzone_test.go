@@ -0,0 +1,376 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	dnsv1 "google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/connection"
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	providerName = "gcp-provider"
+	zoneName     = "example-zone"
+	dnsName      = "example.com."
+	zonePath     = "/" + projectID + "/managedZones/" + zoneName
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	_ managed.ExternalConnecter = &managedZoneConnector{}
+	_ managed.ExternalClient    = &managedZoneExternal{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type managedZoneModifier func(*v1alpha1.ManagedZone)
+
+func managedZoneWithConditions(c ...runtimev1alpha1.Condition) managedZoneModifier {
+	return func(mz *v1alpha1.ManagedZone) { mz.Status.SetConditions(c...) }
+}
+
+func managedZoneWithObservation(o v1alpha1.ManagedZoneObservation) managedZoneModifier {
+	return func(mz *v1alpha1.ManagedZone) { mz.Status.AtProvider = o }
+}
+
+func managedZoneWithDescription(d string) managedZoneModifier {
+	return func(mz *v1alpha1.ManagedZone) { mz.Spec.ForProvider.Description = &d }
+}
+
+func managedZoneObj(m ...managedZoneModifier) *v1alpha1.ManagedZone {
+	mz := &v1alpha1.ManagedZone{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        zoneName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: zoneName},
+		},
+		Spec: v1alpha1.ManagedZoneSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ManagedZoneParameters{
+				DNSName:     dnsName,
+				Description: gcp.StringPtr("an example zone"),
+				Visibility:  gcp.StringPtr(v1alpha1.VisibilityPublic),
+			},
+		},
+	}
+	for _, f := range m {
+		f(mz)
+	}
+	return mz
+}
+
+func TestManagedZoneObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	nameServers := []string{"ns-cloud-a1.googledomains.com.", "ns-cloud-a2.googledomains.com."}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotManagedZone": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotManagedZone),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&dnsv1.ManagedZone{})
+			}),
+			mg: managedZoneObj(),
+			want: want{
+				mg:  managedZoneObj(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dnsv1.ManagedZone{})
+			}),
+			mg: managedZoneObj(),
+			want: want{
+				mg:  managedZoneObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetManagedZone),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&dnsv1.ManagedZone{Name: zoneName, DnsName: dnsName, Description: "an example zone", Labels: map[string]string{"team": "dns"}})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   managedZoneObj(),
+			want: want{
+				mg: managedZoneObj(func(mz *v1alpha1.ManagedZone) {
+					mz.Spec.ForProvider.Labels = map[string]string{"team": "dns"}
+				}),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"Available": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(zonePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&dnsv1.ManagedZone{
+					Id:          42,
+					Name:        zoneName,
+					DnsName:     dnsName,
+					Description: "an example zone",
+					Visibility:  v1alpha1.VisibilityPublic,
+					NameServers: nameServers,
+				})
+			}),
+			mg: managedZoneObj(),
+			want: want{
+				mg: managedZoneObj(
+					managedZoneWithObservation(v1alpha1.ManagedZoneObservation{ID: 42, NameServers: nameServers}),
+					managedZoneWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						connection.KeyNameServers: []byte("ns-cloud-a1.googledomains.com.,ns-cloud-a2.googledomains.com."),
+					},
+				},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&dnsv1.ManagedZone{
+					Name:        zoneName,
+					DnsName:     dnsName,
+					Description: "an old description",
+					Visibility:  v1alpha1.VisibilityPublic,
+				})
+			}),
+			mg: managedZoneObj(),
+			want: want{
+				mg: managedZoneObj(managedZoneWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{connection.KeyNameServers: []byte("")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dnsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{kube: tc.kube, managedZones: s.ManagedZones, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManagedZoneCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				mz := &dnsv1.ManagedZone{}
+				_ = json.NewDecoder(r.Body).Decode(mz)
+				want := &dnsv1.ManagedZone{Name: zoneName, DnsName: dnsName, Description: "an example zone", Visibility: v1alpha1.VisibilityPublic}
+				if diff := cmp.Diff(want, mz); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(mz)
+			}),
+			mg: managedZoneObj(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(&dnsv1.ManagedZone{})
+			}),
+			mg:  managedZoneObj(),
+			err: errors.Wrap(gError(http.StatusConflict, ""), errCreateManagedZone),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dnsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{managedZones: s.ManagedZones, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManagedZoneUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPatch+" "+zonePath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				body := map[string]interface{}{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				// The description is sent even though it is cleared.
+				if diff := cmp.Diff(map[string]interface{}{"description": ""}, body); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&dnsv1.Operation{})
+			}),
+			mg: managedZoneObj(managedZoneWithDescription("")),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dnsv1.Operation{})
+			}),
+			mg:  managedZoneObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateManagedZone),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dnsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{managedZones: s.ManagedZones, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManagedZoneDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodDelete+" "+zonePath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNoContent)
+			}),
+			mg: managedZoneObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&dnsv1.ManagedZone{})
+			}),
+			mg: managedZoneObj(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dnsv1.ManagedZone{})
+			}),
+			mg:  managedZoneObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteManagedZone),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dnsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{managedZones: s.ManagedZones, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	dnsv1 "google.golang.org/api/dns/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	dns "github.com/crossplane/provider-gcp/pkg/clients/dns"
+)
+
+// Error strings.
+const (
+	errNotResourceRecordSet    = "managed resource is not a Cloud DNS ResourceRecordSet"
+	errListResourceRecordSets  = "cannot list Cloud DNS resource record sets"
+	errGetChange               = "cannot get the pending change of the Cloud DNS resource record set"
+	errCreateResourceRecordSet = "cannot create Cloud DNS resource record set"
+	errUpdateResourceRecordSet = "cannot replace Cloud DNS resource record set"
+	errDeleteResourceRecordSet = "cannot delete Cloud DNS resource record set"
+)
+
+// SetupResourceRecordSet adds a controller that reconciles Cloud DNS
+// ResourceRecordSets.
+func SetupResourceRecordSet(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ResourceRecordSetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ResourceRecordSet{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ResourceRecordSetGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&resourceRecordSetConnector{kube: mgr.GetClient(), newServiceFn: dnsv1.NewService})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type resourceRecordSetConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *resourceRecordSetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return nil, errors.New(errNotResourceRecordSet)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, dnsv1.NdevClouddnsReadwriteScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &resourceRecordSetExternal{kube: c.kube, rrsets: s.ResourceRecordSets, changes: s.Changes, projectID: p.Spec.ProjectID}, nil
+}
+
+type resourceRecordSetExternal struct {
+	kube      client.Client
+	rrsets    *dnsv1.ResourceRecordSetsService
+	changes   *dnsv1.ChangesService
+	projectID string
+}
+
+// find returns the record set of the supplied ResourceRecordSet, or nil if
+// it, or its managed zone, does not exist.
+func (e *resourceRecordSetExternal) find(ctx context.Context, cr *v1alpha1.ResourceRecordSet) (*dnsv1.ResourceRecordSet, error) {
+	rsp, err := e.rrsets.List(e.projectID, gcp.StringValue(cr.Spec.ForProvider.ManagedZone)).
+		Name(dns.FQDN(cr.Spec.ForProvider.Name)).
+		Type(cr.Spec.ForProvider.Type).
+		Context(ctx).
+		Do()
+	if gcp.IsErrorNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errListResourceRecordSets)
+	}
+	if len(rsp.Rrsets) == 0 {
+		return nil, nil
+	}
+	return rsp.Rrsets[0], nil
+}
+
+// change applies the supplied change, and records it until it is done.
+func (e *resourceRecordSetExternal) change(ctx context.Context, cr *v1alpha1.ResourceRecordSet, c *dnsv1.Change) error {
+	rsp, err := e.changes.Create(e.projectID, gcp.StringValue(cr.Spec.ForProvider.ManagedZone), c).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if rsp.Status != dns.ChangeStatusDone {
+		cr.Status.AtProvider.ChangeID = rsp.Id
+	}
+	return nil
+}
+
+func (e *resourceRecordSetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotResourceRecordSet)
+	}
+
+	// The record set is not changed again until its pending change is done.
+	if id := cr.Status.AtProvider.ChangeID; id != "" {
+		c, err := e.changes.Get(e.projectID, gcp.StringValue(cr.Spec.ForProvider.ManagedZone), id).Context(ctx).Do()
+		if err != nil && !gcp.IsErrorNotFound(err) {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetChange)
+		}
+		if err == nil && c.Status != dns.ChangeStatusDone {
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+		cr.Status.AtProvider.ChangeID = ""
+	}
+
+	observed, err := e.find(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	dns.LateInitializeResourceRecordSet(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: dns.IsResourceRecordSetUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *resourceRecordSetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotResourceRecordSet)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	c := &dnsv1.Change{Additions: []*dnsv1.ResourceRecordSet{dns.GenerateResourceRecordSet(cr.Spec.ForProvider)}}
+	return managed.ExternalCreation{}, errors.Wrap(e.change(ctx, cr, c), errCreateResourceRecordSet)
+}
+
+// Update replaces the record set, because Cloud DNS cannot update a record
+// set in place. The observed record set is deleted and the desired one added
+// by a single change, which Cloud DNS applies atomically.
+func (e *resourceRecordSetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotResourceRecordSet)
+	}
+	observed, err := e.find(ctx, cr)
+	if err != nil || observed == nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.change(ctx, cr, dns.GenerateReplaceChange(cr.Spec.ForProvider, observed)), errUpdateResourceRecordSet)
+}
+
+func (e *resourceRecordSetExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ResourceRecordSet)
+	if !ok {
+		return errors.New(errNotResourceRecordSet)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	observed, err := e.find(ctx, cr)
+	if err != nil || observed == nil {
+		return err
+	}
+	c := &dnsv1.Change{Deletions: []*dnsv1.ResourceRecordSet{observed}}
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, e.change(ctx, cr, c)), errDeleteResourceRecordSet)
+}
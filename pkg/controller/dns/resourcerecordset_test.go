@@ -0,0 +1,381 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	dnsv1 "google.golang.org/api/dns/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	recordSetName = "www.example.com."
+	changeID      = "7"
+	rrsetsPath    = zonePath + "/rrsets"
+	changesPath   = zonePath + "/changes"
+)
+
+var (
+	_ managed.ExternalConnecter = &resourceRecordSetConnector{}
+	_ managed.ExternalClient    = &resourceRecordSetExternal{}
+)
+
+type recordSetModifier func(*v1alpha1.ResourceRecordSet)
+
+func recordSetWithConditions(c ...runtimev1alpha1.Condition) recordSetModifier {
+	return func(rrs *v1alpha1.ResourceRecordSet) { rrs.Status.SetConditions(c...) }
+}
+
+func recordSetWithChangeID(id string) recordSetModifier {
+	return func(rrs *v1alpha1.ResourceRecordSet) { rrs.Status.AtProvider.ChangeID = id }
+}
+
+func recordSetWithRRDatas(d ...string) recordSetModifier {
+	return func(rrs *v1alpha1.ResourceRecordSet) { rrs.Spec.ForProvider.RRDatas = d }
+}
+
+func recordSetObj(m ...recordSetModifier) *v1alpha1.ResourceRecordSet {
+	ttl := int64(300)
+	rrs := &v1alpha1.ResourceRecordSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "www"},
+		Spec: v1alpha1.ResourceRecordSetSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ResourceRecordSetParameters{
+				ManagedZone: gcp.StringPtr(zoneName),
+				Name:        strings.TrimSuffix(recordSetName, "."),
+				Type:        "A",
+				TTL:         &ttl,
+				RRDatas:     []string{"10.0.0.1"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(rrs)
+	}
+	return rrs
+}
+
+func observedRecordSet(rrdatas ...string) *dnsv1.ResourceRecordSet {
+	return &dnsv1.ResourceRecordSet{Name: recordSetName, Type: "A", Ttl: 300, Rrdatas: rrdatas}
+}
+
+// listHandler serves the supplied record sets, and the supplied change.
+func listHandler(t *testing.T, change *dnsv1.Change, rrsets ...*dnsv1.ResourceRecordSet) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		switch {
+		case r.URL.Path == rrsetsPath:
+			if diff := cmp.Diff(recordSetName, r.URL.Query().Get("name")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(&dnsv1.ResourceRecordSetsListResponse{Rrsets: rrsets})
+		case strings.HasPrefix(r.URL.Path, changesPath) && change != nil:
+			_ = json.NewEncoder(w).Encode(change)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+}
+
+func TestResourceRecordSetObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotResourceRecordSet": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotResourceRecordSet),
+			},
+		},
+		"NotFound": {
+			handler: listHandler(t, nil),
+			mg:      recordSetObj(),
+			want: want{
+				mg:  recordSetObj(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ManagedZoneNotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&dnsv1.ResourceRecordSetsListResponse{})
+			}),
+			mg: recordSetObj(),
+			want: want{
+				mg:  recordSetObj(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ListFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dnsv1.ResourceRecordSetsListResponse{})
+			}),
+			mg: recordSetObj(),
+			want: want{
+				mg:  recordSetObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errListResourceRecordSets),
+			},
+		},
+		"Available": {
+			handler: listHandler(t, nil, observedRecordSet("10.0.0.1")),
+			mg:      recordSetObj(),
+			want: want{
+				mg:  recordSetObj(recordSetWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: listHandler(t, nil, observedRecordSet("10.0.0.2")),
+			mg:      recordSetObj(),
+			want: want{
+				mg:  recordSetObj(recordSetWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ChangePending": {
+			handler: listHandler(t, &dnsv1.Change{Id: changeID, Status: "pending"}),
+			mg:      recordSetObj(recordSetWithChangeID(changeID)),
+			want: want{
+				mg:  recordSetObj(recordSetWithChangeID(changeID)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ChangeDone": {
+			handler: listHandler(t, &dnsv1.Change{Id: changeID, Status: "done"}, observedRecordSet("10.0.0.1")),
+			mg:      recordSetObj(recordSetWithChangeID(changeID)),
+			want: want{
+				mg:  recordSetObj(recordSetWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dnsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := resourceRecordSetExternal{rrsets: s.ResourceRecordSets, changes: s.Changes, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// changeHandler serves the supplied record sets, and expects the supplied
+// change to be created.
+func changeHandler(t *testing.T, want *dnsv1.Change, rrsets ...*dnsv1.ResourceRecordSet) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case http.MethodGet + " " + rrsetsPath:
+			_ = r.Body.Close()
+			_ = json.NewEncoder(w).Encode(&dnsv1.ResourceRecordSetsListResponse{Rrsets: rrsets})
+		case http.MethodPost + " " + changesPath:
+			got := &dnsv1.Change{}
+			_ = json.NewDecoder(r.Body).Decode(got)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("r: -want change, +got:\n%s", diff)
+			}
+			got.Id = changeID
+			got.Status = "pending"
+			_ = json.NewEncoder(w).Encode(got)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+}
+
+func TestResourceRecordSetCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: changeHandler(t, &dnsv1.Change{Additions: []*dnsv1.ResourceRecordSet{observedRecordSet("10.0.0.1")}}),
+			mg:      recordSetObj(),
+			want: want{
+				mg: recordSetObj(recordSetWithConditions(runtimev1alpha1.Creating()), recordSetWithChangeID(changeID)),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(&dnsv1.Change{})
+			}),
+			mg: recordSetObj(),
+			want: want{
+				mg:  recordSetObj(recordSetWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusConflict, ""), errCreateResourceRecordSet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dnsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := resourceRecordSetExternal{rrsets: s.ResourceRecordSets, changes: s.Changes, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceRecordSetUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Replaced": {
+			handler: changeHandler(t, &dnsv1.Change{
+				Deletions: []*dnsv1.ResourceRecordSet{observedRecordSet("10.0.0.1")},
+				Additions: []*dnsv1.ResourceRecordSet{observedRecordSet("10.0.0.2", "10.0.0.3")},
+			}, observedRecordSet("10.0.0.1")),
+			mg: recordSetObj(recordSetWithRRDatas("10.0.0.2", "10.0.0.3")),
+			want: want{
+				mg: recordSetObj(recordSetWithRRDatas("10.0.0.2", "10.0.0.3"), recordSetWithChangeID(changeID)),
+			},
+		},
+		"Gone": {
+			handler: changeHandler(t, nil),
+			mg:      recordSetObj(recordSetWithRRDatas("10.0.0.2")),
+			want: want{
+				mg: recordSetObj(recordSetWithRRDatas("10.0.0.2")),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dnsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := resourceRecordSetExternal{rrsets: s.ResourceRecordSets, changes: s.Changes, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceRecordSetDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: changeHandler(t, &dnsv1.Change{Deletions: []*dnsv1.ResourceRecordSet{observedRecordSet("10.0.0.1")}}, observedRecordSet("10.0.0.1")),
+			mg:      recordSetObj(),
+			want: want{
+				mg: recordSetObj(recordSetWithConditions(runtimev1alpha1.Deleting()), recordSetWithChangeID(changeID)),
+			},
+		},
+		"AlreadyGone": {
+			handler: changeHandler(t, nil),
+			mg:      recordSetObj(),
+			want: want{
+				mg: recordSetObj(recordSetWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dnsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := resourceRecordSetExternal{rrsets: s.ResourceRecordSets, changes: s.Changes, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
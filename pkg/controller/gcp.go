@@ -21,31 +21,69 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
+	"github.com/crossplane/provider-gcp/pkg/controller/apigateway"
+	"github.com/crossplane/provider-gcp/pkg/controller/bigquery"
+	"github.com/crossplane/provider-gcp/pkg/controller/binaryauthorization"
 	"github.com/crossplane/provider-gcp/pkg/controller/cache"
+	"github.com/crossplane/provider-gcp/pkg/controller/composer"
 	"github.com/crossplane/provider-gcp/pkg/controller/compute"
+	"github.com/crossplane/provider-gcp/pkg/controller/config"
 	"github.com/crossplane/provider-gcp/pkg/controller/container"
 	"github.com/crossplane/provider-gcp/pkg/controller/database"
+	"github.com/crossplane/provider-gcp/pkg/controller/dataflow"
+	"github.com/crossplane/provider-gcp/pkg/controller/dns"
 	"github.com/crossplane/provider-gcp/pkg/controller/iam"
+	"github.com/crossplane/provider-gcp/pkg/controller/kms"
+	"github.com/crossplane/provider-gcp/pkg/controller/orgpolicy"
+	"github.com/crossplane/provider-gcp/pkg/controller/osconfig"
 	"github.com/crossplane/provider-gcp/pkg/controller/pubsub"
+	"github.com/crossplane/provider-gcp/pkg/controller/pubsublite"
+	"github.com/crossplane/provider-gcp/pkg/controller/servicemanagement"
 	"github.com/crossplane/provider-gcp/pkg/controller/servicenetworking"
+	"github.com/crossplane/provider-gcp/pkg/controller/serviceusage"
 	"github.com/crossplane/provider-gcp/pkg/controller/storage"
+	"github.com/crossplane/provider-gcp/pkg/controller/storagetransfer"
 )
 
 // Setup creates all GCP controllers with the supplied logger and adds them to
 // the supplied manager.
 func Setup(mgr ctrl.Manager, l logging.Logger) error {
 	for _, setup := range []func(ctrl.Manager, logging.Logger) error{
+		config.Setup,
+		apigateway.SetupAPI,
+		apigateway.SetupAPIConfig,
+		apigateway.SetupGateway,
+		bigquery.SetupDataset,
+		bigquery.SetupJob,
+		bigquery.SetupTable,
+		binaryauthorization.SetupPolicy,
+		binaryauthorization.SetupAttestor,
 		cache.SetupCloudMemorystoreInstanceClaimScheduling,
 		cache.SetupCloudMemorystoreInstanceClaimDefaulting,
 		cache.SetupCloudMemorystoreInstanceClaimBinding,
 		cache.SetupCloudMemorystoreInstance,
+		composer.SetupEnvironment,
 		compute.SetupGlobalAddress,
+		compute.SetupInterconnectAttachment,
+		compute.SetupAutoscaler,
 		compute.SetupGKEClusterClaimScheduling,
 		compute.SetupGKEClusterClaimDefaulting,
 		compute.SetupGKEClusterClaimBinding,
 		compute.SetupGKEClusterTarget,
 		compute.SetupGKECluster,
 		compute.SetupNetwork,
+		compute.SetupNetworkEndpointGroup,
+		compute.SetupPacketMirroring,
+		compute.SetupRouter,
+		compute.SetupRegionDisk,
+		compute.SetupRegionInstanceGroupManager,
+		compute.SetupSslPolicy,
+		compute.SetupFirewall,
+		compute.SetupProjectMetadata,
+		compute.SetupTargetInstance,
+		compute.SetupResourcePolicy,
+		compute.SetupNodeTemplate,
+		compute.SetupNodeGroup,
 		compute.SetupSubnetwork,
 		container.SetupGKEClusterClaimScheduling,
 		container.SetupGKEClusterClaimDefaulting,
@@ -61,13 +99,31 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		database.SetupMySQLInstanceClaimDefaulting,
 		database.SetupMySQLInstanceClaimBinding,
 		database.SetupCloudSQLInstance,
+		dataflow.SetupJob,
+		dns.SetupManagedZone,
+		dns.SetupResourceRecordSet,
 		iam.SetupServiceAccount,
+		iam.SetupServiceAccountKey,
+		iam.SetupServiceAccountKeyHardening,
+		iam.SetupDenyPolicy,
+		iam.SetupProjectAuditConfig,
+		kms.SetupKeyRing,
+		kms.SetupCryptoKey,
+		orgpolicy.SetupPolicy,
+		osconfig.SetupOSPolicyAssignment,
 		pubsub.SetupTopic,
+		pubsublite.SetupLiteReservation,
+		pubsublite.SetupLiteTopic,
+		pubsublite.SetupLiteSubscription,
+		servicemanagement.SetupManagedService,
 		servicenetworking.SetupConnection,
+		serviceusage.SetupService,
 		storage.SetupBucketClaimScheduling,
 		storage.SetupBucketClaimDefaulting,
 		storage.SetupBucketClaimBinding,
 		storage.SetupBucket,
+		storage.SetupBucketPolicy,
+		storagetransfer.SetupTransferJob,
 	} {
 		if err := setup(mgr, l); err != nil {
 			return err
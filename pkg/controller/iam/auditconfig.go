@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/auditconfig"
+)
+
+// Error strings.
+const (
+	errNotProjectAuditConfig = "managed resource is not a GCP ProjectAuditConfig"
+	errGetIAMPolicy          = "cannot get IAM policy of project"
+	errSetIAMPolicy          = "cannot set IAM policy of project"
+)
+
+// SetupProjectAuditConfig adds a controller that reconciles
+// ProjectAuditConfigs.
+func SetupProjectAuditConfig(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ProjectAuditConfigGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ProjectAuditConfig{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProjectAuditConfigGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&auditConfigConnecter{client: mgr.GetClient(), newService: crm.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type auditConfigConnecter struct {
+	client     client.Client
+	newService func(ctx context.Context, opts ...option.ClientOption) (*crm.Service, error)
+}
+
+// Connect sets up a Resource Manager client using credentials from the
+// provider.
+func (c *auditConfigConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ProjectAuditConfig)
+	if !ok {
+		return nil, errors.New(errNotProjectAuditConfig)
+	}
+
+	opts, _, err := clientOptions(ctx, c.client, cr.Spec.ProviderReference, gcp.APIResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newService(ctx, opts...)
+	return &auditConfigExternal{crm: s}, errors.Wrap(err, errNewClient)
+}
+
+type auditConfigExternal struct {
+	crm *crm.Service
+}
+
+// Observe reports whether the project's IAM policy has an audit config for
+// the service, and whether it matches the desired audit config.
+func (e *auditConfigExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectAuditConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectAuditConfig)
+	}
+	p, err := e.getPolicy(ctx, cr.Spec.ForProvider.Project)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetIAMPolicy)
+	}
+	cr.Status.AtProvider = auditconfig.GenerateObservation(*p)
+
+	ac := auditconfig.Find(p, cr.Spec.ForProvider.Service)
+	if ac == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: auditconfig.IsUpToDate(cr.Spec.ForProvider, *ac),
+	}, nil
+}
+
+// Create adds the audit config to the project's IAM policy.
+func (e *auditConfigExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectAuditConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectAuditConfig)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	return managed.ExternalCreation{}, e.modifyPolicy(ctx, cr.Spec.ForProvider.Project, func(p *crm.Policy) bool {
+		auditconfig.Set(p, auditconfig.GenerateAuditConfig(cr.Spec.ForProvider))
+		return true
+	})
+}
+
+// Update replaces the audit config in the project's IAM policy.
+func (e *auditConfigExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectAuditConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProjectAuditConfig)
+	}
+	return managed.ExternalUpdate{}, e.modifyPolicy(ctx, cr.Spec.ForProvider.Project, func(p *crm.Policy) bool {
+		auditconfig.Set(p, auditconfig.GenerateAuditConfig(cr.Spec.ForProvider))
+		return true
+	})
+}
+
+// Delete removes the audit config from the project's IAM policy.
+func (e *auditConfigExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectAuditConfig)
+	if !ok {
+		return errors.New(errNotProjectAuditConfig)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	return e.modifyPolicy(ctx, cr.Spec.ForProvider.Project, func(p *crm.Policy) bool {
+		return auditconfig.Remove(p, cr.Spec.ForProvider.Service)
+	})
+}
+
+func (e *auditConfigExternal) getPolicy(ctx context.Context, project string) (*crm.Policy, error) {
+	req := &crm.GetIamPolicyRequest{Options: &crm.GetPolicyOptions{RequestedPolicyVersion: auditconfig.PolicyVersion}}
+	return e.crm.Projects.GetIamPolicy(project, req).Context(ctx).Do()
+}
+
+// modifyPolicy reads the project's IAM policy, applies the supplied change to
+// it and, if the change returns true, writes it back. The policy is written
+// with the etag it was read with, so that the write fails rather than
+// overwrite a concurrent change to the policy. Only the audit configs of the
+// policy are written.
+func (e *auditConfigExternal) modifyPolicy(ctx context.Context, project string, modify func(p *crm.Policy) bool) error {
+	p, err := e.getPolicy(ctx, project)
+	if err != nil {
+		return errors.Wrap(err, errGetIAMPolicy)
+	}
+	if !modify(p) {
+		return nil
+	}
+	p.Version = auditconfig.PolicyVersion
+	req := &crm.SetIamPolicyRequest{Policy: p, UpdateMask: auditconfig.UpdateMask}
+	_, err = e.crm.Projects.SetIamPolicy(project, req).Context(ctx).Do()
+	return errors.Wrap(err, errSetIAMPolicy)
+}
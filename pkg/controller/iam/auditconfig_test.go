@@ -0,0 +1,374 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+const (
+	auditConfigService = "storage.googleapis.com"
+	auditConfigEtag    = "BwWKmjvelug="
+)
+
+var (
+	_ managed.ExternalConnecter = &auditConfigConnecter{}
+	_ managed.ExternalClient    = &auditConfigExternal{}
+)
+
+var auditConfigBinding = &crm.Binding{Role: "roles/owner", Members: []string{"user:jane@example.com"}}
+
+type auditConfigModifier func(*v1alpha1.ProjectAuditConfig)
+
+func auditConfigWithConditions(c ...runtimev1alpha1.Condition) auditConfigModifier {
+	return func(a *v1alpha1.ProjectAuditConfig) { a.Status.SetConditions(c...) }
+}
+
+func auditConfigWithEtag(etag string) auditConfigModifier {
+	return func(a *v1alpha1.ProjectAuditConfig) { a.Status.AtProvider.Etag = etag }
+}
+
+func auditConfigWithLogConfigs(c ...v1alpha1.AuditLogConfig) auditConfigModifier {
+	return func(a *v1alpha1.ProjectAuditConfig) { a.Spec.ForProvider.AuditLogConfigs = c }
+}
+
+func auditConfig(m ...auditConfigModifier) *v1alpha1.ProjectAuditConfig {
+	a := &v1alpha1.ProjectAuditConfig{
+		Spec: v1alpha1.ProjectAuditConfigSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ProjectAuditConfigParameters{
+				Project: project,
+				Service: auditConfigService,
+				AuditLogConfigs: []v1alpha1.AuditLogConfig{
+					{LogType: v1alpha1.AuditLogTypeDataRead, ExemptedMembers: []string{"user:jane@example.com"}},
+					{LogType: v1alpha1.AuditLogTypeDataWrite},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+// observedIAMPolicy returns a project IAM policy with a binding and the
+// supplied audit configs.
+func observedIAMPolicy(ac ...*crm.AuditConfig) *crm.Policy {
+	return &crm.Policy{
+		Bindings:     []*crm.Binding{auditConfigBinding},
+		AuditConfigs: ac,
+		Etag:         auditConfigEtag,
+		Version:      3,
+	}
+}
+
+// observedAuditConfig returns the audit config the API returns for an up to
+// date ProjectAuditConfig, with its log types in a different order.
+func observedAuditConfig() *crm.AuditConfig {
+	return &crm.AuditConfig{
+		Service: auditConfigService,
+		AuditLogConfigs: []*crm.AuditLogConfig{
+			{LogType: v1alpha1.AuditLogTypeDataWrite},
+			{LogType: v1alpha1.AuditLogTypeDataRead, ExemptedMembers: []string{"user:jane@example.com"}},
+		},
+	}
+}
+
+// iamPolicyHandler serves the supplied IAM policy, and checks that any policy
+// that is set equals want.
+func iamPolicyHandler(t *testing.T, observed *crm.Policy, want *crm.SetIamPolicyRequest) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() { _ = r.Body.Close() }()
+		switch r.URL.Path {
+		case "/v1/projects/" + project + ":getIamPolicy":
+			got := &crm.GetIamPolicyRequest{}
+			_ = json.NewDecoder(r.Body).Decode(got)
+			if diff := cmp.Diff(int64(3), got.Options.RequestedPolicyVersion); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(observed)
+		case "/v1/projects/" + project + ":setIamPolicy":
+			got := &crm.SetIamPolicyRequest{}
+			_ = json.NewDecoder(r.Body).Decode(got)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(got.Policy)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+}
+
+func TestProjectAuditConfigObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotProjectAuditConfig": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotProjectAuditConfig),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(&crm.Policy{})
+			}),
+			mg: auditConfig(),
+			want: want{
+				mg:  auditConfig(),
+				err: errors.Wrap(gError(http.StatusForbidden), errGetIAMPolicy),
+			},
+		},
+		"NotConfigured": {
+			handler: iamPolicyHandler(t, observedIAMPolicy(&crm.AuditConfig{Service: v1alpha1.AuditConfigAllServices}), nil),
+			mg:      auditConfig(),
+			want: want{
+				mg: auditConfig(auditConfigWithEtag(auditConfigEtag)),
+			},
+		},
+		"UpToDate": {
+			handler: iamPolicyHandler(t, observedIAMPolicy(observedAuditConfig()), nil),
+			mg:      auditConfig(),
+			want: want{
+				mg:  auditConfig(auditConfigWithEtag(auditConfigEtag), auditConfigWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ExemptedMembersChanged": {
+			handler: iamPolicyHandler(t, observedIAMPolicy(observedAuditConfig()), nil),
+			mg: auditConfig(auditConfigWithLogConfigs(
+				v1alpha1.AuditLogConfig{LogType: v1alpha1.AuditLogTypeDataRead},
+				v1alpha1.AuditLogConfig{LogType: v1alpha1.AuditLogTypeDataWrite},
+			)),
+			want: want{
+				mg: auditConfig(auditConfigWithLogConfigs(
+					v1alpha1.AuditLogConfig{LogType: v1alpha1.AuditLogTypeDataRead},
+					v1alpha1.AuditLogConfig{LogType: v1alpha1.AuditLogTypeDataWrite},
+				), auditConfigWithEtag(auditConfigEtag), auditConfigWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := crm.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := auditConfigExternal{crm: s}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectAuditConfigCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	desired := &crm.AuditConfig{
+		Service: auditConfigService,
+		AuditLogConfigs: []*crm.AuditLogConfig{
+			{LogType: v1alpha1.AuditLogTypeDataRead, ExemptedMembers: []string{"user:jane@example.com"}},
+			{LogType: v1alpha1.AuditLogTypeDataWrite},
+		},
+	}
+	allServices := &crm.AuditConfig{
+		Service:         v1alpha1.AuditConfigAllServices,
+		AuditLogConfigs: []*crm.AuditLogConfig{{LogType: v1alpha1.AuditLogTypeAdminRead}},
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Created": {
+			handler: iamPolicyHandler(t, observedIAMPolicy(allServices), &crm.SetIamPolicyRequest{
+				Policy:     observedIAMPolicy(allServices, desired),
+				UpdateMask: "auditConfigs",
+			}),
+			mg: auditConfig(),
+			want: want{
+				mg: auditConfig(auditConfigWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"SetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.URL.Path == "/v1/projects/"+project+":getIamPolicy" {
+					_ = json.NewEncoder(w).Encode(observedIAMPolicy())
+					return
+				}
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(&crm.Policy{})
+			}),
+			mg: auditConfig(),
+			want: want{
+				mg:  auditConfig(auditConfigWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusConflict), errSetIAMPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := crm.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := auditConfigExternal{crm: s}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectAuditConfigUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Updated": {
+			handler: iamPolicyHandler(t, observedIAMPolicy(observedAuditConfig()), &crm.SetIamPolicyRequest{
+				Policy: observedIAMPolicy(&crm.AuditConfig{
+					Service:         auditConfigService,
+					AuditLogConfigs: []*crm.AuditLogConfig{{LogType: v1alpha1.AuditLogTypeDataRead}},
+				}),
+				UpdateMask: "auditConfigs",
+			}),
+			mg: auditConfig(auditConfigWithLogConfigs(v1alpha1.AuditLogConfig{LogType: v1alpha1.AuditLogTypeDataRead})),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(&crm.Policy{})
+			}),
+			mg:  auditConfig(),
+			err: errors.Wrap(gError(http.StatusForbidden), errGetIAMPolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := crm.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := auditConfigExternal{crm: s}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectAuditConfigDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Deleted": {
+			handler: iamPolicyHandler(t, observedIAMPolicy(observedAuditConfig()), &crm.SetIamPolicyRequest{
+				Policy:     observedIAMPolicy(),
+				UpdateMask: "auditConfigs",
+			}),
+			mg: auditConfig(),
+			want: want{
+				mg: auditConfig(auditConfigWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handler: iamPolicyHandler(t, observedIAMPolicy(), nil),
+			mg:      auditConfig(),
+			want: want{
+				mg: auditConfig(auditConfigWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := crm.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := auditConfigExternal{crm: s}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/auditconfig"
+)
+
+// Error strings.
+const (
+	errNewResourceManagerClient = "cannot create new Resource Manager client"
+	errRemoveProjectBindings    = "cannot remove service account from bindings of project IAM policy"
+)
+
+// bindingsUpdateMask limits setting an IAM policy to its bindings.
+const bindingsUpdateMask = "bindings"
+
+// A projectBindingRemover is a PreDeleteHook that removes a ServiceAccount
+// from the bindings of its project's IAM policy, if the ServiceAccount asks
+// for its bindings to be removed.
+type projectBindingRemover struct {
+	newService func(ctx context.Context) (*crm.Service, error)
+}
+
+// PreDelete removes the supplied ServiceAccount from every binding of its
+// project's IAM policy. The policy is written with the etag it was read with,
+// so that the write fails rather than overwrite a concurrent change. Nothing
+// is written if the service account is not bound to any role, or if it is
+// retained, in which case it keeps the roles it was granted.
+func (r *projectBindingRemover) PreDelete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceAccount)
+	if !ok {
+		return errors.New(errNotServiceAccount)
+	}
+	project, email := cr.Status.AtProvider.ProjectID, cr.Status.AtProvider.Email
+	if !gcp.BoolValue(cr.Spec.RemoveProjectBindings) || project == "" || email == "" {
+		return nil
+	}
+	if cr.GetReclaimPolicy() == runtimev1alpha1.ReclaimRetain {
+		return nil
+	}
+
+	s, err := r.newService(ctx)
+	if err != nil {
+		return errors.Wrap(err, errNewResourceManagerClient)
+	}
+	get := &crm.GetIamPolicyRequest{Options: &crm.GetPolicyOptions{RequestedPolicyVersion: auditconfig.PolicyVersion}}
+	p, err := s.Projects.GetIamPolicy(project, get).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errRemoveProjectBindings)
+	}
+	if !removeMember(p, "serviceAccount:"+email) {
+		return nil
+	}
+	p.Version = auditconfig.PolicyVersion
+	set := &crm.SetIamPolicyRequest{Policy: p, UpdateMask: bindingsUpdateMask}
+	_, err = s.Projects.SetIamPolicy(project, set).Context(ctx).Do()
+	return errors.Wrap(err, errRemoveProjectBindings)
+}
+
+// removeMember removes the supplied member from the bindings of the supplied
+// policy, removing any bindings that are left without members. It returns
+// false if the member was not bound to any role.
+func removeMember(p *crm.Policy, member string) bool {
+	removed := false
+	bindings := make([]*crm.Binding, 0, len(p.Bindings))
+	for _, b := range p.Bindings {
+		members := make([]string, 0, len(b.Members))
+		for _, m := range b.Members {
+			if m == member {
+				removed = true
+				continue
+			}
+			members = append(members, m)
+		}
+		if len(members) == 0 {
+			continue
+		}
+		b.Members = members
+		bindings = append(bindings, b)
+	}
+	p.Bindings = bindings
+	return removed
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+var _ gcp.PreDeleteHook = &projectBindingRemover{}
+
+func withRemoveProjectBindings() valueModifier {
+	return func(i *v1alpha1.ServiceAccount) { i.Spec.RemoveProjectBindings = gcp.BoolPtr(true) }
+}
+
+func TestProjectBindingRemoverPreDelete(t *testing.T) {
+	member := "serviceAccount:" + accountEmail
+	other := "user:jane@example.com"
+
+	observed := func() *crm.Policy {
+		return &crm.Policy{
+			Bindings: []*crm.Binding{
+				{Role: "roles/viewer", Members: []string{other, member}},
+				{Role: "roles/editor", Members: []string{member}},
+				{Role: "roles/owner", Members: []string{other}},
+			},
+			Etag:    auditConfigEtag,
+			Version: 3,
+		}
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotServiceAccount": {
+			mg:  &fake.Managed{},
+			err: errors.New(errNotServiceAccount),
+		},
+		"NotRequested": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			mg: serviceAccount(withProjectID(project), withEmail(accountEmail)),
+		},
+		"NeverObserved": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			mg: serviceAccount(withRemoveProjectBindings()),
+		},
+		"Retained": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			mg: serviceAccount(withRemoveProjectBindings(), withProjectID(project), withEmail(accountEmail),
+				withReclaimPolicy(runtimev1alpha1.ReclaimRetain)),
+		},
+		"Removed": {
+			handler: iamPolicyHandler(t, observed(), &crm.SetIamPolicyRequest{
+				Policy: &crm.Policy{
+					Bindings: []*crm.Binding{
+						{Role: "roles/viewer", Members: []string{other}},
+						{Role: "roles/owner", Members: []string{other}},
+					},
+					Etag:    auditConfigEtag,
+					Version: 3,
+				},
+				UpdateMask: "bindings",
+			}),
+			mg: serviceAccount(withRemoveProjectBindings(), withProjectID(project), withEmail(accountEmail)),
+		},
+		"NotBound": {
+			handler: iamPolicyHandler(t, &crm.Policy{
+				Bindings: []*crm.Binding{{Role: "roles/owner", Members: []string{other}}},
+				Etag:     auditConfigEtag,
+			}, nil),
+			mg: serviceAccount(withRemoveProjectBindings(), withProjectID(project), withEmail(accountEmail)),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(&crm.Policy{})
+			}),
+			mg:  serviceAccount(withRemoveProjectBindings(), withProjectID(project), withEmail(accountEmail)),
+			err: errors.Wrap(gError(http.StatusForbidden), errRemoveProjectBindings),
+		},
+		"SetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.URL.Path == "/v1/projects/"+project+":getIamPolicy" {
+					_ = json.NewEncoder(w).Encode(observed())
+					return
+				}
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(&crm.Policy{})
+			}),
+			mg:  serviceAccount(withRemoveProjectBindings(), withProjectID(project), withEmail(accountEmail)),
+			err: errors.Wrap(gError(http.StatusConflict), errRemoveProjectBindings),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			r := &projectBindingRemover{newService: func(ctx context.Context) (*crm.Service, error) {
+				return crm.NewService(ctx, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			}}
+			err := r.PreDelete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("PreDelete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
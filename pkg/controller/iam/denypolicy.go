@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/denypolicy"
+)
+
+// Error strings.
+const (
+	errNotDenyPolicy    = "managed resource is not a GCP DenyPolicy"
+	errUpdateDenyPolicy = "cannot update DenyPolicy managed resource"
+	errGetPolicy        = "cannot get GCP deny policy via IAM API"
+	errCreatePolicy     = "cannot create GCP deny policy via IAM API"
+	errUpdatePolicy     = "cannot update GCP deny policy via IAM API"
+	errDeletePolicy     = "cannot delete GCP deny policy via IAM API"
+)
+
+// SetupDenyPolicy adds a controller that reconciles DenyPolicies.
+func SetupDenyPolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.DenyPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.DenyPolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DenyPolicyGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&denyPolicyConnecter{client: mgr.GetClient(), newService: denypolicy.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type denyPolicyConnecter struct {
+	client     client.Client
+	newService func(ctx context.Context, opts ...option.ClientOption) (*denypolicy.Service, error)
+}
+
+// Connect sets up an IAM v2 client using credentials from the provider.
+func (c *denyPolicyConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DenyPolicy)
+	if !ok {
+		return nil, errors.New(errNotDenyPolicy)
+	}
+
+	opts, _, err := clientOptions(ctx, c.client, cr.Spec.ProviderReference, gcp.APIIAM)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newService(ctx, opts...)
+	return &denyPolicyExternal{kube: c.client, policies: s}, errors.Wrap(err, errNewClient)
+}
+
+type denyPolicyExternal struct {
+	kube     client.Client
+	policies *denypolicy.Service
+}
+
+// Observe reports whether the deny policy exists and matches the desired
+// policy. In dry run the policy is reported to exist and be up to date, so
+// that it is never created or updated, and a preview of the change that
+// would be made is reported instead.
+func (e *denyPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DenyPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDenyPolicy)
+	}
+	dryRun := gcp.BoolValue(cr.Spec.ForProvider.DryRun)
+	if dryRun && meta.WasDeleted(cr) {
+		// Leave any existing policy in place.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	name := denypolicy.PolicyName(cr.Spec.ForProvider.Parent, meta.GetExternalName(cr))
+	observed, err := e.policies.GetPolicy(ctx, name)
+	if err != nil && !gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPolicy)
+	}
+	exists := err == nil
+
+	if dryRun {
+		cr.Status.AtProvider = v1alpha1.DenyPolicyObservation{}
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+		if exists {
+			cr.Status.AtProvider = denypolicy.GenerateDenyPolicyObservation(*observed)
+			cr.SetConditions(runtimev1alpha1.Available())
+		} else {
+			observed = nil
+		}
+		cr.Status.AtProvider.Preview = denypolicy.GeneratePreview(cr.Spec.ForProvider, observed)
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if !exists {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	denypolicy.LateInitializeDenyPolicy(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateDenyPolicy)
+		}
+	}
+
+	cr.Status.AtProvider = denypolicy.GenerateDenyPolicyObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: denypolicy.IsDenyPolicyUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+// Create starts creating the deny policy. Creation is a long running
+// operation; until it completes the policy cannot be observed, and creating
+// it again returns a conflict that is not treated as an error.
+func (e *denyPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DenyPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDenyPolicy)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.policies.CreatePolicy(ctx, cr.Spec.ForProvider.Parent, meta.GetExternalName(cr), denypolicy.GeneratePolicy(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(gcp.IsErrorAlreadyExists, err), errCreatePolicy)
+}
+
+// Update replaces the rules and display name of the deny policy. The etag of
+// the observed policy is sent so that concurrent changes are not overwritten.
+func (e *denyPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DenyPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDenyPolicy)
+	}
+	p := denypolicy.GeneratePolicy(cr.Spec.ForProvider)
+	p.Name = denypolicy.PolicyName(cr.Spec.ForProvider.Parent, meta.GetExternalName(cr))
+	p.Etag = cr.Status.AtProvider.Etag
+	_, err := e.policies.UpdatePolicy(ctx, p)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicy)
+}
+
+// Delete starts deleting the deny policy.
+func (e *denyPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DenyPolicy)
+	if !ok {
+		return errors.New(errNotDenyPolicy)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.policies.DeletePolicy(ctx, denypolicy.PolicyName(cr.Spec.ForProvider.Parent, meta.GetExternalName(cr)))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePolicy)
+}
@@ -0,0 +1,448 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/denypolicy"
+)
+
+var deleted = metav1.Now()
+
+const (
+	denyPolicyParent = "projects/" + project
+	denyPolicyID     = "deny-key-creation"
+	denyPolicyEtag   = "BwWWja0YfJA="
+)
+
+var (
+	_ managed.ExternalConnecter = &denyPolicyConnecter{}
+	_ managed.ExternalClient    = &denyPolicyExternal{}
+)
+
+var denyPolicyRule = v1alpha1.DenyRule{
+	DeniedPrincipals:  []string{"principalSet://goog/public:all"},
+	DeniedPermissions: []string{"iam.googleapis.com/serviceAccountKeys.create"},
+}
+
+type denyPolicyModifier func(*v1alpha1.DenyPolicy)
+
+func denyPolicyWithConditions(c ...runtimev1alpha1.Condition) denyPolicyModifier {
+	return func(p *v1alpha1.DenyPolicy) { p.Status.SetConditions(c...) }
+}
+
+func denyPolicyWithObservation(o v1alpha1.DenyPolicyObservation) denyPolicyModifier {
+	return func(p *v1alpha1.DenyPolicy) { p.Status.AtProvider = o }
+}
+
+func denyPolicyWithDisplayName(n string) denyPolicyModifier {
+	return func(p *v1alpha1.DenyPolicy) { p.Spec.ForProvider.DisplayName = gcp.StringPtr(n) }
+}
+
+func denyPolicyWithRules(r ...v1alpha1.DenyRule) denyPolicyModifier {
+	return func(p *v1alpha1.DenyPolicy) { p.Spec.ForProvider.Rules = r }
+}
+
+func denyPolicyWithDryRun() denyPolicyModifier {
+	return func(p *v1alpha1.DenyPolicy) { p.Spec.ForProvider.DryRun = gcp.BoolPtr(true) }
+}
+
+func denyPolicyWithDeletionTimestamp(t metav1.Time) denyPolicyModifier {
+	return func(p *v1alpha1.DenyPolicy) { p.SetDeletionTimestamp(&t) }
+}
+
+func denyPolicy(m ...denyPolicyModifier) *v1alpha1.DenyPolicy {
+	p := &v1alpha1.DenyPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        denyPolicyID,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: denyPolicyID},
+		},
+		Spec: v1alpha1.DenyPolicySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.DenyPolicyParameters{
+				Parent: denyPolicyParent,
+				Rules:  []v1alpha1.DenyRule{denyPolicyRule},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+// observedDenyPolicy returns the deny policy the API returns for an up to
+// date DenyPolicy with the supplied display name.
+func observedDenyPolicy(displayName string) *denypolicy.Policy {
+	p := denypolicy.GeneratePolicy(denyPolicy().Spec.ForProvider)
+	p.Name = denypolicy.PolicyName(denyPolicyParent, denyPolicyID)
+	p.DisplayName = displayName
+	p.Etag = denyPolicyEtag
+	return p
+}
+
+func denyPolicyPath() string {
+	return "/v2/policies/cloudresourcemanager.googleapis.com%2Fprojects%2F" + project + "/denypolicies/" + denyPolicyID
+}
+
+func gError(code int) *googleapi.Error {
+	return &googleapi.Error{Code: code, Body: "{}\n"}
+}
+
+func TestDenyPolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotDenyPolicy": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotDenyPolicy),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(denyPolicyPath(), r.URL.EscapedPath()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Policy{})
+			}),
+			mg: denyPolicy(),
+			want: want{
+				mg: denyPolicy(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Policy{})
+			}),
+			mg: denyPolicy(),
+			want: want{
+				mg:  denyPolicy(),
+				err: errors.Wrap(gError(http.StatusBadRequest), errGetPolicy),
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDenyPolicy(displayName))
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   denyPolicy(),
+			want: want{
+				mg: denyPolicy(
+					denyPolicyWithDisplayName(displayName),
+					denyPolicyWithObservation(v1alpha1.DenyPolicyObservation{Name: denypolicy.PolicyName(denyPolicyParent, denyPolicyID), Etag: denyPolicyEtag}),
+					denyPolicyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RulesChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDenyPolicy(displayName))
+			}),
+			mg: denyPolicy(denyPolicyWithDisplayName(displayName), denyPolicyWithRules(denyPolicyRule, denyPolicyRule)),
+			want: want{
+				mg: denyPolicy(
+					denyPolicyWithDisplayName(displayName),
+					denyPolicyWithRules(denyPolicyRule, denyPolicyRule),
+					denyPolicyWithObservation(v1alpha1.DenyPolicyObservation{Name: denypolicy.PolicyName(denyPolicyParent, denyPolicyID), Etag: denyPolicyEtag}),
+					denyPolicyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"DryRunPreviewsCreate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Policy{})
+			}),
+			mg: denyPolicy(denyPolicyWithDryRun()),
+			want: want{
+				mg: denyPolicy(
+					denyPolicyWithDryRun(),
+					denyPolicyWithObservation(v1alpha1.DenyPolicyObservation{
+						Preview: &v1alpha1.DenyPolicyPreview{Action: v1alpha1.DenyPolicyPreviewCreate, RulesAdded: 1},
+					}),
+					denyPolicyWithConditions(runtimev1alpha1.Unavailable())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DryRunPreviewsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDenyPolicy(displayName))
+			}),
+			mg: denyPolicy(denyPolicyWithDryRun(), denyPolicyWithDisplayName(displayName), denyPolicyWithRules()),
+			want: want{
+				mg: denyPolicy(
+					denyPolicyWithDryRun(),
+					denyPolicyWithDisplayName(displayName),
+					denyPolicyWithRules(),
+					denyPolicyWithObservation(v1alpha1.DenyPolicyObservation{
+						Name:    denypolicy.PolicyName(denyPolicyParent, denyPolicyID),
+						Etag:    denyPolicyEtag,
+						Preview: &v1alpha1.DenyPolicyPreview{Action: v1alpha1.DenyPolicyPreviewUpdate, RulesRemoved: 1},
+					}),
+					denyPolicyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DryRunDeleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			}),
+			mg: denyPolicy(denyPolicyWithDryRun(), denyPolicyWithDeletionTimestamp(deleted)),
+			want: want{
+				mg: denyPolicy(denyPolicyWithDryRun(), denyPolicyWithDeletionTimestamp(deleted)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := denypolicy.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := denyPolicyExternal{kube: tc.kube, policies: s}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDenyPolicyCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(denyPolicyID, r.URL.Query().Get("policyId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &denypolicy.Policy{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				if diff := cmp.Diff(denypolicy.GeneratePolicy(denyPolicy().Spec.ForProvider), got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Operation{})
+			}),
+			mg: denyPolicy(),
+			want: want{
+				mg: denyPolicy(denyPolicyWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"AlreadyCreating": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Operation{})
+			}),
+			mg: denyPolicy(),
+			want: want{
+				mg: denyPolicy(denyPolicyWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Operation{})
+			}),
+			mg: denyPolicy(),
+			want: want{
+				mg:  denyPolicy(denyPolicyWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest), errCreatePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := denypolicy.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := denyPolicyExternal{policies: s}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDenyPolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(denyPolicyPath(), r.URL.EscapedPath()); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &denypolicy.Policy{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				if diff := cmp.Diff(denyPolicyEtag, got.Etag); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Operation{})
+			}),
+			mg: denyPolicy(denyPolicyWithObservation(v1alpha1.DenyPolicyObservation{Etag: denyPolicyEtag})),
+		},
+		"EtagMismatch": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Operation{})
+			}),
+			mg:   denyPolicy(),
+			want: errors.Wrap(gError(http.StatusConflict), errUpdatePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := denypolicy.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := denyPolicyExternal{policies: s}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDenyPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Operation{})
+			}),
+			mg: denyPolicy(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Operation{})
+			}),
+			mg: denyPolicy(),
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&denypolicy.Operation{})
+			}),
+			mg:   denyPolicy(),
+			want: errors.Wrap(gError(http.StatusBadRequest), errDeletePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := denypolicy.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := denyPolicyExternal{policies: s}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
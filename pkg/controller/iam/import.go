@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	iamv1 "google.golang.org/api/iam/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+const errList = "cannot list GCP ServiceAccounts via IAM API"
+
+// ImportServiceAccounts lists the service accounts of the project named by
+// the supplied RelativeResourceNamer and returns a ServiceAccount managed
+// resource for each of them. The returned resources carry the external name
+// of the account they represent, so that they adopt rather than recreate it
+// once applied. Their reclaim policy is Retain, so deleting an imported
+// resource does not delete the account it adopted.
+//
+// Service accounts that cannot be addressed by the RelativeResourceNamer,
+// such as the Google-managed accounts of the project, are skipped.
+func ImportServiceAccounts(ctx context.Context, sas *iamv1.ProjectsServiceAccountsService, rrn RelativeResourceNamer, providerRef *corev1.ObjectReference) ([]v1alpha1.ServiceAccount, error) {
+	var imported []v1alpha1.ServiceAccount
+	err := sas.List(rrn.ProjectName()).Pages(ctx, func(rsp *iamv1.ListServiceAccountsResponse) error {
+		for _, sa := range rsp.Accounts {
+			cr, ok := importServiceAccount(rrn, sa, providerRef)
+			if ok {
+				imported = append(imported, *cr)
+			}
+		}
+		return nil
+	})
+	return imported, errors.Wrap(err, errList)
+}
+
+// importServiceAccount returns a ServiceAccount managed resource that adopts
+// the supplied service account, or false if the RelativeResourceNamer cannot
+// address it.
+func importServiceAccount(rrn RelativeResourceNamer, sa *iamv1.ServiceAccount, providerRef *corev1.ObjectReference) (*v1alpha1.ServiceAccount, bool) {
+	// The account ID is the part of the email before the @.
+	id := strings.SplitN(sa.Email, "@", 2)[0]
+	cr := &v1alpha1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       v1alpha1.ServiceAccountKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: id},
+		Spec: v1alpha1.ServiceAccountSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: providerRef,
+				ReclaimPolicy:     runtimev1alpha1.ReclaimRetain,
+			},
+		},
+	}
+	meta.SetExternalName(cr, id)
+	// The IAM API names accounts by project ID even when they are listed by
+	// project number, so accounts are matched by email rather than name.
+	if rrn.Email(cr) != sa.Email {
+		return nil, false
+	}
+
+	if sa.DisplayName != "" {
+		cr.Spec.ForProvider.DisplayName = &sa.DisplayName
+	}
+	if d, _ := unmarkDescription(sa.Description); d != "" {
+		cr.Spec.ForProvider.Description = &d
+	}
+	return cr, true
+}
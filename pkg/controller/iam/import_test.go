@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	iamv1 "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+func imported(id string, displayName, description *string) v1alpha1.ServiceAccount {
+	sa := v1alpha1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       v1alpha1.ServiceAccountKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: id},
+		Spec: v1alpha1.ServiceAccountSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+				ReclaimPolicy:     runtimev1alpha1.ReclaimRetain,
+			},
+			ForProvider: v1alpha1.ServiceAccountParameters{
+				DisplayName: displayName,
+				Description: description,
+			},
+		},
+	}
+	meta.SetExternalName(&sa, id)
+	return sa
+}
+
+func TestImportServiceAccounts(t *testing.T) {
+	display := "Some display name"
+	desc := "Some description"
+
+	cases := map[string]struct {
+		rrn     RelativeResourceNamer
+		handler http.Handler
+		want    []v1alpha1.ServiceAccount
+		err     error
+	}{
+		"Imported": {
+			rrn: NewRelativeResourceNamer("perfect-project"),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/projects/perfect-project/serviceAccounts", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				rsp := &iamv1.ListServiceAccountsResponse{}
+				switch r.URL.Query().Get("pageToken") {
+				case "":
+					rsp.Accounts = []*iamv1.ServiceAccount{
+						{
+							Name:        "projects/perfect-project/serviceAccounts/first@perfect-project.iam.gserviceaccount.com",
+							Email:       "first@perfect-project.iam.gserviceaccount.com",
+							DisplayName: display,
+							Description: markDescription(desc),
+						},
+						{
+							Name:  "projects/perfect-project/serviceAccounts/123-compute@developer.gserviceaccount.com",
+							Email: "123-compute@developer.gserviceaccount.com",
+						},
+					}
+					rsp.NextPageToken = "next"
+				case "next":
+					rsp.Accounts = []*iamv1.ServiceAccount{
+						{
+							Name:        "projects/perfect-project/serviceAccounts/second@perfect-project.iam.gserviceaccount.com",
+							Email:       "second@perfect-project.iam.gserviceaccount.com",
+							Description: desc,
+						},
+					}
+				}
+				_ = json.NewEncoder(w).Encode(rsp)
+			}),
+			want: []v1alpha1.ServiceAccount{
+				imported("first", &display, &desc),
+				imported("second", nil, &desc),
+			},
+		},
+		"ImportedByProjectNumber": {
+			rrn: NewProjectNumberResourceNamer("123456789012", "perfect-project"),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/projects/123456789012/serviceAccounts", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				rsp := &iamv1.ListServiceAccountsResponse{
+					Accounts: []*iamv1.ServiceAccount{
+						{
+							Name:  "projects/perfect-project/serviceAccounts/first@perfect-project.iam.gserviceaccount.com",
+							Email: "first@perfect-project.iam.gserviceaccount.com",
+						},
+						{
+							Name:  "projects/perfect-project/serviceAccounts/123456789012-compute@developer.gserviceaccount.com",
+							Email: "123456789012-compute@developer.gserviceaccount.com",
+						},
+					},
+				}
+				_ = json.NewEncoder(w).Encode(rsp)
+			}),
+			want: []v1alpha1.ServiceAccount{
+				imported("first", nil, nil),
+			},
+		},
+		"ListFailed": {
+			rrn: NewRelativeResourceNamer("perfect-project"),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&iamv1.ListServiceAccountsResponse{})
+			}),
+			err: errors.Wrap(err500, errList),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			got, err := ImportServiceAccounts(context.Background(), iamv1.NewProjectsService(s).ServiceAccounts, tc.rrn, &corev1.ObjectReference{Name: providerName})
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ImportServiceAccounts(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ImportServiceAccounts(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
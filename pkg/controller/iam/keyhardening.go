@@ -0,0 +1,171 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	iamv1 "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Error strings.
+const (
+	errNotKeyHardening = "managed resource is not a GCP ServiceAccountKeyHardening"
+	errListKeys        = "cannot list GCP ServiceAccount keys via IAM API"
+	errDeleteKey       = "cannot delete GCP ServiceAccount key via IAM API"
+)
+
+// keyTypeUserManaged is the type of keys that are created, and thus must be
+// rotated and protected, by users rather than by Google.
+const keyTypeUserManaged = "USER_MANAGED"
+
+// SetupServiceAccountKeyHardening adds a controller that reconciles
+// ServiceAccountKeyHardenings.
+func SetupServiceAccountKeyHardening(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ServiceAccountKeyHardeningGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ServiceAccountKeyHardening{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceAccountKeyHardeningGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&keyHardeningConnecter{client: mgr.GetClient(), newKeys: newServiceAccountKeysAPI})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// newServiceAccountKeysAPI returns a new IAM client responsible for Service
+// Account key management.
+func newServiceAccountKeysAPI(ctx context.Context, opts ...option.ClientOption) (*iamv1.ProjectsServiceAccountsKeysService, error) {
+	service, err := iamv1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return iamv1.NewProjectsServiceAccountsKeysService(service), nil
+}
+
+type keyHardeningConnecter struct {
+	client  client.Client
+	newKeys func(ctx context.Context, opts ...option.ClientOption) (*iamv1.ProjectsServiceAccountsKeysService, error)
+}
+
+// Connect sets up iam client using credentials from the provider
+func (c *keyHardeningConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountKeyHardening)
+	if !ok {
+		return nil, errors.New(errNotKeyHardening)
+	}
+
+	opts, _, err := clientOptions(ctx, c.client, cr.Spec.ProviderReference, gcp.APIIAM)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := c.newKeys(ctx, opts...)
+	return &keyHardeningExternal{keys: keys}, errors.Wrap(err, errNewClient)
+}
+
+type keyHardeningExternal struct {
+	keys *iamv1.ProjectsServiceAccountsKeysService
+}
+
+// Observe counts the user-managed keys of the service account. There is no
+// external resource to create or delete, so the resource is reported to exist
+// until it is deleted.
+func (e *keyHardeningExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountKeyHardening)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKeyHardening)
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	keys, err := e.userManagedKeys(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.AtProvider.UserManagedKeys = len(keys)
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !gcp.BoolValue(cr.Spec.ForProvider.DeleteUserManagedKeys) || len(keys) == 0,
+	}, nil
+}
+
+// Create is a no-op; Observe always reports that the resource exists.
+func (e *keyHardeningExternal) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update deletes all user-managed keys of the service account.
+func (e *keyHardeningExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountKeyHardening)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKeyHardening)
+	}
+
+	keys, err := e.userManagedKeys(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	for _, k := range keys {
+		_, err := e.keys.Delete(k.Name).Context(ctx).Do()
+		if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteKey)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete is a no-op; the service account and its keys are left untouched.
+func (e *keyHardeningExternal) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
+
+// userManagedKeys lists the user-managed keys of the service account. The
+// listing is filtered by the IAM API, but the type of each key is checked too
+// so that a Google-managed key is never returned, and thus never deleted.
+func (e *keyHardeningExternal) userManagedKeys(ctx context.Context, cr *v1alpha1.ServiceAccountKeyHardening) ([]*iamv1.ServiceAccountKey, error) {
+	name := "projects/-/serviceAccounts/" + gcp.StringValue(cr.Spec.ForProvider.ServiceAccount)
+	rsp, err := e.keys.List(name).KeyTypes(keyTypeUserManaged).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, errListKeys)
+	}
+	keys := make([]*iamv1.ServiceAccountKey, 0, len(rsp.Keys))
+	for _, k := range rsp.Keys {
+		if k.KeyType == keyTypeUserManaged {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
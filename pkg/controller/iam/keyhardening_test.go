@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	iamv1 "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	defaultComputeEmail = "123456789012-compute@developer.gserviceaccount.com"
+	keysPath            = "/v1/projects/-/serviceAccounts/" + defaultComputeEmail + "/keys"
+	userKey             = "projects/-/serviceAccounts/" + defaultComputeEmail + "/keys/user"
+	systemKey           = "projects/-/serviceAccounts/" + defaultComputeEmail + "/keys/system"
+)
+
+var (
+	_ managed.ExternalConnecter = &keyHardeningConnecter{}
+	_ managed.ExternalClient    = &keyHardeningExternal{}
+)
+
+type keyHardeningModifier func(*v1alpha1.ServiceAccountKeyHardening)
+
+func withDeleteUserManagedKeys() keyHardeningModifier {
+	return func(h *v1alpha1.ServiceAccountKeyHardening) {
+		h.Spec.ForProvider.DeleteUserManagedKeys = gcp.BoolPtr(true)
+	}
+}
+
+func withUserManagedKeys(n int) keyHardeningModifier {
+	return func(h *v1alpha1.ServiceAccountKeyHardening) { h.Status.AtProvider.UserManagedKeys = n }
+}
+
+func withKeyHardeningConditions(c ...runtimev1alpha1.Condition) keyHardeningModifier {
+	return func(h *v1alpha1.ServiceAccountKeyHardening) { h.Status.SetConditions(c...) }
+}
+
+func withKeyHardeningDeletionTimestamp() keyHardeningModifier {
+	return func(h *v1alpha1.ServiceAccountKeyHardening) {
+		h.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1, 0)})
+	}
+}
+
+func keyHardening(m ...keyHardeningModifier) *v1alpha1.ServiceAccountKeyHardening {
+	h := &v1alpha1.ServiceAccountKeyHardening{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-compute"},
+		Spec: v1alpha1.ServiceAccountKeyHardeningSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ServiceAccountKeyHardeningParameters{
+				ServiceAccount: gcp.StringPtr(defaultComputeEmail),
+			},
+		},
+	}
+	for _, f := range m {
+		f(h)
+	}
+	return h
+}
+
+// keysHandler lists one user-managed and one system-managed key, and records
+// the names of deleted keys.
+func keysHandler(t *testing.T, deleted *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		switch r.Method {
+		case http.MethodGet:
+			if diff := cmp.Diff(keysPath, r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(keyTypeUserManaged, r.URL.Query().Get("keyTypes")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			// The system-managed key would not be listed by the real API;
+			// it verifies that such keys are never deleted.
+			_ = json.NewEncoder(w).Encode(&iamv1.ListServiceAccountKeysResponse{Keys: []*iamv1.ServiceAccountKey{
+				{Name: userKey, KeyType: keyTypeUserManaged},
+				{Name: systemKey, KeyType: "SYSTEM_MANAGED"},
+			}})
+		case http.MethodDelete:
+			*deleted = append(*deleted, r.URL.Path)
+			_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+}
+
+func TestKeyHardeningObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"ObserveOnly": {
+			mg: keyHardening(),
+			want: want{
+				mg:  keyHardening(withUserManagedKeys(1), withKeyHardeningConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UserManagedKeysExist": {
+			mg: keyHardening(withDeleteUserManagedKeys()),
+			want: want{
+				mg: keyHardening(
+					withDeleteUserManagedKeys(),
+					withUserManagedKeys(1),
+					withKeyHardeningConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Deleted": {
+			mg: keyHardening(withKeyHardeningDeletionTimestamp()),
+			want: want{
+				mg: keyHardening(withKeyHardeningDeletionTimestamp()),
+			},
+		},
+		"ListFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(&iamv1.ListServiceAccountKeysResponse{})
+			}),
+			mg: keyHardening(),
+			want: want{
+				mg:  keyHardening(),
+				err: errors.Wrap(&googleapi.Error{Code: http.StatusForbidden, Body: "{}\n"}, errListKeys),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := tc.handler
+			if h == nil {
+				h = keysHandler(t, &[]string{})
+			}
+			server := httptest.NewServer(h)
+			defer server.Close()
+			s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := keyHardeningExternal{keys: iamv1.NewProjectsServiceAccountsKeysService(s)}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyHardeningUpdate(t *testing.T) {
+	deleted := []string{}
+	server := httptest.NewServer(keysHandler(t, &deleted))
+	defer server.Close()
+	s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := keyHardeningExternal{keys: iamv1.NewProjectsServiceAccountsKeysService(s)}
+
+	if _, err := e.Update(context.Background(), keyHardening(withDeleteUserManagedKeys())); err != nil {
+		t.Errorf("Update(...): %s", err)
+	}
+	if diff := cmp.Diff([]string{"/v1/" + userKey}, deleted); diff != "" {
+		t.Errorf("Update(...): -want deleted keys, +got deleted keys:\n%s", diff)
+	}
+}
@@ -19,24 +19,39 @@ package iam
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
 	iamv1 "google.golang.org/api/iam/v1"
 	"google.golang.org/api/option"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	gcpv1beta1 "github.com/crossplane/provider-gcp/apis/v1beta1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	gcpiam "github.com/crossplane/provider-gcp/pkg/clients/iam"
 )
 
 // Error strings.
@@ -45,41 +60,191 @@ const (
 	errProviderSecretRef = "cannot find Secret reference on Provider"
 	errGetProviderSecret = "cannot get Provider Secret"
 	errNewClient         = "cannot create new GCP IAM API client"
+	errSelectProvider    = "cannot select Provider"
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errTrackUsage        = "cannot track ProviderConfig usage"
+	errImpersonateTarget = "cannot impersonate %q: not the email of a service account"
+	errImpersonate       = "cannot impersonate the service account configured on the Provider"
+	errResolveProjectID  = "cannot resolve the ID of the GCP project configured by number"
+	errProjectChanged    = "cannot move GCP ServiceAccount from project %q to project %q"
 	errNotServiceAccount = "managed resource is not a GCP ServiceAccount"
 	errGet               = "cannot get GCP ServiceAccount object via IAM API"
 	errCreate            = "cannot create GCP ServiceAccount object via IAM API"
+	errCreateOrgPolicy   = "cannot create GCP ServiceAccount: creation is blocked by the " + constraintDisableCreation + " organization policy; ask an organization policy administrator to exempt this project"
+	errCreateQuota       = "cannot create GCP ServiceAccount: a project quota or limit was exceeded; request a quota increase or delete unused service accounts"
 	errUpdate            = "cannot update GCP ServiceAccount object via IAM API"
 	errDelete            = "cannot delete GCP ServiceAccount object via IAM API"
+	errDisable           = "cannot disable GCP ServiceAccount via IAM API"
+	errEnable            = "cannot enable GCP ServiceAccount via IAM API"
+	errGetSAPolicy       = "cannot get IAM policy of GCP ServiceAccount via IAM API"
+	errSetSAPolicy       = "cannot set IAM policy of GCP ServiceAccount via IAM API"
+	errUnmarked          = "refusing to adopt GCP ServiceAccount that is not marked as managed by Crossplane; set spec.adoptUnmarked to true to adopt it"
+	errRecordCreated     = "cannot record creation time of GCP ServiceAccount"
+	errInvalidAccountID  = "cannot create GCP ServiceAccount: the external name annotation is not a valid account ID"
+	errUpdateManaged     = "cannot update managed resource"
 )
 
+// reasonUpdateNeeded is the reason of the event that explains why a
+// ServiceAccount is about to be updated.
+const reasonUpdateNeeded event.Reason = "UpdateNeeded"
+
+// msgDisabled explains why a disabled service account is unavailable.
+const msgDisabled = "service account is disabled"
+
+// constraintDisableCreation is the organization policy constraint that, when
+// enforced, prevents service accounts from being created.
+const constraintDisableCreation = "constraints/iam.disableServiceAccountCreation"
+
+// GCP service accounts do not support labels, so ownership is recorded by
+// appending ownershipMarker to their description.
+const ownershipMarker = "[managed-by: crossplane]"
+
+// Newly created service accounts are not always immediately visible to the
+// IAM API. visibilityBackoff bounds how long Create waits for a service
+// account to become readable (roughly 7.5 seconds in total) before handing
+// control back to the managed reconciler.
+var visibilityBackoff = wait.Backoff{
+	Duration: 500 * time.Millisecond,
+	Factor:   2,
+	Steps:    5,
+}
+
+// AnnotationKeyCreated is the key of the annotation in which the time a
+// ServiceAccount was last created is recorded, in RFC 3339 format.
+const AnnotationKeyCreated = "iam.gcp.crossplane.io/created-at"
+
+// defaultConnectTimeout bounds Connect when it is called with a context that
+// has no deadline, so that a hung metadata server or token endpoint cannot
+// block a reconcile worker indefinitely.
+const defaultConnectTimeout = 30 * time.Second
+
+// DefaultCreateGracePeriod is the default period after a ServiceAccount is
+// created during which it is considered to exist even if the IAM API cannot
+// yet find it.
+const DefaultCreateGracePeriod = 30 * time.Second
+
+var createGracePeriod = DefaultCreateGracePeriod
+
+// SetCreateGracePeriod sets the period after a ServiceAccount is created
+// during which it is considered to exist even if the IAM API cannot yet find
+// it, rather than being created again. It must be called before the
+// ServiceAccount controller is set up.
+func SetCreateGracePeriod(d time.Duration) {
+	createGracePeriod = d
+}
+
+// A ServiceAccountOption configures how the ServiceAccount controller
+// connects to the IAM API.
+type ServiceAccountOption func(*connecter)
+
+// WithProviderSelector configures the ServiceAccount controller to connect
+// to the IAM API using the credentials of the Provider chosen by the
+// supplied function, rather than those of the Provider each ServiceAccount
+// references.
+func WithProviderSelector(fn ProviderSelectorFn) ServiceAccountOption {
+	return func(c *connecter) { c.selectProvider = fn }
+}
+
 // SetupServiceAccount adds a controller that reconciles ServiceAccounts.
 func SetupServiceAccount(mgr ctrl.Manager, l logging.Logger) error {
+	return SetupServiceAccountWithOptions(mgr, l)
+}
+
+// SetupServiceAccountWithOptions adds a controller that reconciles
+// ServiceAccounts, configured by the supplied options.
+func SetupServiceAccountWithOptions(mgr ctrl.Manager, l logging.Logger, o ...ServiceAccountOption) error {
 	name := managed.ControllerName(v1alpha1.ServiceAccountGroupKind)
 
+	r := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	log := l.WithValues("controller", name)
+	c := &connecter{client: mgr.GetClient(), newSAS: gcpiam.NewServiceAccountClient, createGrace: createGracePeriod, record: r, log: log, resolveProjectID: ResolveProjectID,
+		usage: gcp.NewProviderConfigUsageTracker(mgr.GetClient(), mgr.GetScheme())}
+	for _, fn := range o {
+		fn(c)
+	}
+
+	// The IAM API rejects bursts of requests with 429s, so service accounts
+	// whose operations are rate limited back off rather than being retried
+	// every short wait.
+	b := gcp.NewRateLimitBackoff(gcp.DefaultRateLimitBackoffBase, gcp.DefaultRateLimitBackoffMax)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&v1alpha1.ServiceAccount{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(b.Reconciler(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ServiceAccountGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient(), newSAS: newServiceAccountsAPI}),
-			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(b.Connecter(c))),
+			managed.WithLogger(log),
+			managed.WithInitializers(&accountIDAsExternalName{client: mgr.GetClient()}),
+			managed.WithRecorder(r))))
 }
 
-// newServiceAccountsAPI returns a new IAM Admin Client (responsible for Service Account management).
-// Credentials must be passed as JSON encoded data.
-func newServiceAccountsAPI(ctx context.Context, credentials []byte) (*iamv1.ProjectsServiceAccountsService, error) {
-	service, err := iamv1.NewService(ctx, option.WithCredentialsJSON(credentials))
-	if err != nil {
-		return nil, err
+// An accountIDAsExternalName sets the external name of a ServiceAccount that
+// has none to an account ID derived from its metadata.name. Kubernetes names
+// may be longer or shorter than account IDs, and may contain dots or start
+// with a digit, none of which the IAM API accepts. The external name records
+// the account ID a ServiceAccount was created with.
+type accountIDAsExternalName struct {
+	client client.Client
+}
+
+func (a *accountIDAsExternalName) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.GetExternalName(mg) != "" {
+		return nil
 	}
-	return iamv1.NewProjectsService(service).ServiceAccounts, nil
+	meta.SetExternalName(mg, gcpiam.AccountID(mg.GetName()))
+	return errors.Wrap(a.client.Update(ctx, mg), errUpdateManaged)
 }
 
 type connecter struct {
 	client client.Client
-	newSAS func(ctx context.Context, creds []byte) (*iamv1.ProjectsServiceAccountsService, error)
+	newSAS func(ctx context.Context, opts ...option.ClientOption) (gcpiam.ServiceAccountClient, error)
+
+	// selectProvider chooses the Provider whose credentials are used. The
+	// Provider a ServiceAccount references is used if it is nil.
+	selectProvider ProviderSelectorFn
+
+	// createGrace is how long a newly created ServiceAccount is considered
+	// to exist before the IAM API can find it.
+	createGrace time.Duration
+
+	// record explains why ServiceAccounts are updated. Nothing is recorded
+	// if it is nil.
+	record event.Recorder
+
+	// log details at debug level which fields of ServiceAccounts differ.
+	// Nothing is logged if it is nil.
+	log logging.Logger
+
+	// resolveProjectID returns the ID of a project configured by number,
+	// which is needed to build the email of its service accounts. Resolved
+	// IDs are cached in projectIDs, keyed by project number.
+	resolveProjectID func(ctx context.Context, number string, opts ...option.ClientOption) (string, error)
+	projectIDs       sync.Map
+
+	// newCRM creates the Resource Manager client used to remove deleted
+	// service accounts from the bindings of their project's IAM policy.
+	// crm.NewService is used if it is nil.
+	newCRM func(ctx context.Context, opts ...option.ClientOption) (*crm.Service, error)
+
+	// clients caches the clients built from the credentials of each
+	// Provider and ProviderConfig, keyed by clientsKey.
+	clients sync.Map
+
+	// usage tracks the ServiceAccounts that use each ProviderConfig. Usages
+	// are not tracked if it is nil.
+	usage usageTracker
+}
+
+// A usageTracker records that a managed resource uses a ProviderConfig.
+type usageTracker interface {
+	Track(ctx context.Context, mg resource.Managed, ref runtimev1alpha1.Reference) error
+}
+
+// clientsKey returns the key of the clients built for the supplied kind and
+// name of Provider or ProviderConfig, which may share names.
+func clientsKey(kind, name string) string {
+	return kind + "/" + name
 }
 
 // Connect sets up iam client using credentials from the provider
@@ -89,9 +254,310 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.New(errNotServiceAccount)
 	}
 
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultConnectTimeout)
+		defer cancel()
+	}
+
+	p, s, key, err := c.credentials(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	cl, err := c.clientsFor(ctx, key, p, s)
+	if err != nil {
+		return nil, err
+	}
+	opts := cl.opts
+	rrn, err := c.resourceNamer(ctx, cr, p.Spec.ProjectID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	record := c.record
+	if record == nil {
+		record = event.NewNopRecorder()
+	}
+	log := c.log
+	if log == nil {
+		log = logging.NewNopLogger()
+	}
+	e := &external{
+		kube:            c.client,
+		serviceAccounts: cl.serviceAccounts,
+		rrn:             rrn,
+		visibility:      visibilityBackoff,
+		createGrace:     c.createGrace,
+		now:             time.Now,
+		record:          record,
+		log:             log,
+	}
+	newCRM := c.newCRM
+	if newCRM == nil {
+		newCRM = crm.NewService
+	}
+	bindings := &projectBindingRemover{newService: func(ctx context.Context) (*crm.Service, error) { return newCRM(ctx, opts...) }}
+
+	// Deletions are deferred until the reconcile window opens before any
+	// bindings are removed, and bindings are removed before the service
+	// account is deleted.
+	w := &windowedExternal{ExternalClient: gcp.WithPreDeleteHooks(e, bindings), now: time.Now}
+	return gcp.WithDryRun(&errorRecorder{ExternalClient: w, now: time.Now}, record, e), nil
+}
+
+// cachedClients are the Resource Manager API client options and IAM API client
+// built from the credentials of a Provider, as of version.
+type cachedClients struct {
+	version         string
+	opts            []option.ClientOption
+	serviceAccounts gcpiam.ServiceAccountClient
+}
+
+// credentials returns the Provider and credentials Secret a ServiceAccount
+// connects with, and the key of the clients built from them. The referenced
+// ProviderConfig is used in favour of the referenced or selected Provider,
+// and its usage is tracked so that it cannot be deleted while it is used.
+func (c *connecter) credentials(ctx context.Context, cr *v1alpha1.ServiceAccount) (*gcpv1alpha3.Provider, *corev1.Secret, string, error) {
+	if pc := cr.Spec.ProviderConfigReference; pc != nil {
+		if c.usage != nil {
+			if err := c.usage.Track(ctx, cr, *pc); err != nil {
+				return nil, nil, "", errors.Wrap(err, errTrackUsage)
+			}
+		}
+		p, s, err := providerConfigCredentials(ctx, c.client, pc.Name)
+		return p, s, clientsKey(gcpv1beta1.ProviderConfigKind, pc.Name), err
+	}
+
+	ref := cr.Spec.ProviderReference
+	if c.selectProvider != nil {
+		r, err := c.selectProvider(ctx, c.client, cr)
+		if err != nil {
+			return nil, nil, "", errors.Wrap(err, errSelectProvider)
+		}
+		ref = r
+	}
+	p, s, err := providerCredentials(ctx, c.client, ref)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return p, s, clientsKey(gcpv1alpha3.ProviderKind, p.GetName()), nil
+}
+
+// clientsFor returns the clients for the supplied Provider and credentials
+// Secret, building them only if they have not been built for the current
+// versions of both before. Building clients parses the credentials and sets
+// up a new HTTP transport, so reusing them saves doing so every reconcile.
+//
+// Cached clients outlive the reconcile that built them, so they are built
+// with a background context; each API call is bound to the context of the
+// reconcile that makes it. Building clients may call the metadata server or
+// mint an access token, so clientsFor stops waiting for them once the
+// supplied context is done. Clients that are built after that are still
+// cached for the next reconcile.
+func (c *connecter) clientsFor(ctx context.Context, key string, p *gcpv1alpha3.Provider, s *corev1.Secret) (*cachedClients, error) {
+	version := p.GetResourceVersion()
+	if s != nil {
+		version += "/" + s.GetResourceVersion()
+	}
+	if v, ok := c.clients.Load(key); ok && v.(*cachedClients).version == version {
+		return v.(*cachedClients), nil
+	}
+
+	type built struct {
+		clients *cachedClients
+		err     error
+	}
+	done := make(chan built, 1)
+	go func() {
+		cl, err := c.buildClients(key, p, s, version)
+		done <- built{clients: cl, err: err}
+	}()
+	select {
+	case b := <-done:
+		return b.clients, b.err
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), errNewClient)
+	}
+}
+
+// buildClients builds and caches the clients for the supplied Provider and
+// credentials Secret, as of the supplied version.
+func (c *connecter) buildClients(key string, p *gcpv1alpha3.Provider, s *corev1.Secret, version string) (*cachedClients, error) {
+	ctx := context.Background()
+	opts, err := providerClientOptions(ctx, p, s)
+	if err != nil {
+		return nil, err
+	}
+	sas, err := c.newSAS(ctx, gcp.WithEndpoint(opts, p.Spec.Endpoints, gcp.APIIAM)...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	cl := &cachedClients{version: version, opts: gcp.WithEndpoint(opts, p.Spec.Endpoints, gcp.APIResourceManager), serviceAccounts: sas}
+
+	// Concurrent reconciles may build clients for the same version; which
+	// of them is cached does not matter.
+	c.clients.Store(key, cl)
+	return cl, nil
+}
+
+// resourceNamer returns the RelativeResourceNamer of the project the supplied
+// service account belongs to; the project of its parameters if set, or else
+// the supplied project of its Provider. A service account cannot be moved to
+// another project once it was observed, because the controller would create
+// a new service account in that project rather than move it.
+func (c *connecter) resourceNamer(ctx context.Context, cr *v1alpha1.ServiceAccount, providerProject string, opts ...option.ClientOption) (RelativeResourceNamer, error) {
+	projectID := providerProject
+	if p := gcp.StringValue(cr.Spec.ForProvider.ProjectID); p != "" {
+		projectID = p
+	}
+	rrn := NewRelativeResourceNamer(projectID)
+	if IsProjectNumber(projectID) {
+		id, err := c.projectID(ctx, projectID, opts...)
+		if err != nil {
+			return RelativeResourceNamer{}, errors.Wrap(err, errResolveProjectID)
+		}
+		rrn = NewProjectNumberResourceNamer(projectID, id)
+	}
+	if observed := cr.Status.AtProvider.ProjectID; observed != "" && observed != rrn.projectID {
+		return RelativeResourceNamer{}, errors.Errorf(errProjectChanged, observed, rrn.projectID)
+	}
+	return rrn, nil
+}
+
+// projectID returns the ID of the project with the supplied number, resolving
+// it only if it has not been resolved before. The ID of a project never
+// changes, so it is safe to cache for the lifetime of the controller.
+func (c *connecter) projectID(ctx context.Context, number string, opts ...option.ClientOption) (string, error) {
+	if id, ok := c.projectIDs.Load(number); ok {
+		return id.(string), nil
+	}
+	id, err := c.resolveProjectID(ctx, number, opts...)
+	if err != nil {
+		return "", err
+	}
+	c.projectIDs.Store(number, id)
+	return id, nil
+}
+
+// ResolveProjectID returns the ID of the GCP project with the supplied
+// number, as reported by the Resource Manager API.
+func ResolveProjectID(ctx context.Context, number string, opts ...option.ClientOption) (string, error) {
+	s, err := crm.NewService(ctx, opts...)
+	if err != nil {
+		return "", err
+	}
+	p, err := s.Projects.Get(number).Context(ctx).Do()
+	if err != nil {
+		return "", err
+	}
+	return p.ProjectId, nil
+}
+
+// IsProjectNumber returns true if the supplied project is identified by its
+// number rather than its ID. Project IDs must start with a letter, so a
+// project consisting only of digits is a number.
+func IsProjectNumber(project string) bool {
+	if project == "" {
+		return false
+	}
+	for _, r := range project {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// clientOptions returns the options used to call the supplied API using the
+// credentials and endpoint overrides of the referenced Provider, and the ID
+// of its project.
+func clientOptions(ctx context.Context, kube client.Client, ref *corev1.ObjectReference, api string) ([]option.ClientOption, string, error) {
+	p, s, err := providerCredentials(ctx, kube, ref)
+	if err != nil {
+		return nil, "", err
+	}
+	opts, err := providerClientOptions(ctx, p, s)
+	return gcp.WithEndpoint(opts, p.Spec.Endpoints, api), p.Spec.ProjectID, err
+}
+
+// providerClientOptions returns the options used to build clients that
+// authenticate as the supplied Provider, or as the service account it
+// impersonates. The Secret is nil if the Provider's credentials are injected.
+// An access token is minted up front when impersonating, so that a Provider
+// that may not impersonate its service account fails to connect.
+func providerClientOptions(ctx context.Context, p *gcpv1alpha3.Provider, s *corev1.Secret) ([]option.ClientOption, error) {
+	var opts []option.ClientOption
+	var err error
+	if s == nil {
+		opts, err = gcp.InjectedIdentityClientOptions(ctx, p.Spec.HTTPClient)
+	} else {
+		creds, cerr := gcp.CredentialsData(s, p.Spec.CredentialsSecretRef.Key)
+		if cerr != nil {
+			return nil, cerr
+		}
+		opts, err = gcp.ClientOptions(ctx, creds, p.Spec.HTTPClient)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	target := p.Spec.ImpersonateServiceAccount
+	if target == "" {
+		return opts, nil
+	}
+	if !gcp.IsServiceAccountEmail(target) {
+		return nil, errors.Errorf(errImpersonateTarget, target)
+	}
+	ts, err := gcp.ImpersonatedTokenSource(ctx, target, nil, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	if _, err := ts.Token(); err != nil {
+		return nil, errors.Wrap(err, errImpersonate)
+	}
+	opts, err = gcp.ImpersonatedClientOptions(ctx, ts, p.Spec.HTTPClient)
+	return opts, errors.Wrap(err, errNewClient)
+}
+
+// providerCredentials returns the referenced Provider and the Secret that
+// holds its credentials. No Secret is returned if the Provider's credentials
+// are injected.
+func providerCredentials(ctx context.Context, kube client.Client, ref *corev1.ObjectReference) (*gcpv1alpha3.Provider, *corev1.Secret, error) {
 	p := &gcpv1alpha3.Provider{}
-	if err := c.client.Get(ctx, meta.NamespacedNameOf(cr.Spec.ProviderReference), p); err != nil {
-		return nil, errors.Wrap(err, errGetProvider)
+	if err := kube.Get(ctx, meta.NamespacedNameOf(ref), p); err != nil {
+		return nil, nil, errors.Wrap(err, errGetProvider)
+	}
+	s, err := providerSecret(ctx, kube, p)
+	return p, s, err
+}
+
+// providerConfigCredentials returns a Provider configured like the named
+// ProviderConfig, so that clients are built the same way for both, and the
+// Secret that holds its credentials. No Secret is returned if the
+// ProviderConfig's credentials are injected.
+func providerConfigCredentials(ctx context.Context, kube client.Client, name string) (*gcpv1alpha3.Provider, *corev1.Secret, error) {
+	pc := &gcpv1beta1.ProviderConfig{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+		return nil, nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	p := &gcpv1alpha3.Provider{
+		ObjectMeta: pc.ObjectMeta,
+		Spec: gcpv1alpha3.ProviderSpec{
+			ProviderSpec:              runtimev1alpha1.ProviderSpec{CredentialsSecretRef: pc.Spec.Credentials.SecretRef},
+			CredentialsSource:         gcpv1alpha3.CredentialsSource(pc.Spec.Credentials.Source),
+			ImpersonateServiceAccount: pc.Spec.ImpersonateServiceAccount,
+			ProjectID:                 pc.Spec.ProjectID,
+			Endpoints:                 pc.Spec.Endpoints,
+		},
+	}
+	s, err := providerSecret(ctx, kube, p)
+	return p, s, err
+}
+
+// providerSecret returns the Secret that holds the credentials of the
+// supplied Provider, or nil if its credentials are injected.
+func providerSecret(ctx context.Context, kube client.Client, p *gcpv1alpha3.Provider) (*corev1.Secret, error) {
+	if p.Spec.CredentialsSource == gcpv1alpha3.CredentialsSourceInjectedIdentity {
+		return nil, nil
 	}
 
 	if p.GetCredentialsSecretReference() == nil {
@@ -100,18 +566,21 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 
 	s := &corev1.Secret{}
 	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
-	if err := c.client.Get(ctx, n, s); err != nil {
+	if err := kube.Get(ctx, n, s); err != nil {
 		return nil, errors.Wrap(err, errGetProviderSecret)
 	}
-
-	saAPI, err := c.newSAS(ctx, s.Data[p.Spec.CredentialsSecretRef.Key])
-	rrn := NewRelativeResourceNamer(p.Spec.ProjectID)
-	return &external{serviceAccounts: saAPI, rrn: rrn}, errors.Wrap(err, errNewClient)
+	return s, nil
 }
 
 type external struct {
-	serviceAccounts *iamv1.ProjectsServiceAccountsService
+	kube            client.Client
+	serviceAccounts gcpiam.ServiceAccountClient
 	rrn             RelativeResourceNamer
+	visibility      wait.Backoff
+	createGrace     time.Duration
+	now             func() time.Time
+	record          event.Recorder
+	log             logging.Logger
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -120,50 +589,179 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotServiceAccount)
 	}
 
-	req := e.serviceAccounts.Get(e.rrn.ResourceName(cr))
-	fromProvider, err := req.Context(ctx).Do()
+	fromProvider, err := e.serviceAccounts.Get(ctx, e.rrn.ResourceName(cr))
 	if gcp.IsErrorNotFound(err) {
+		// A service account we recently created may not be visible yet.
+		// Report it as pending rather than creating it again.
+		if e.pending(cr) {
+			cr.SetConditions(runtimev1alpha1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+		}
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errGet)
 	}
 
+	if !mayManage(cr, fromProvider) {
+		return managed.ExternalObservation{}, errors.New(errUnmarked)
+	}
+
+	// Updating the managed resource overwrites its status, so it must be
+	// late initialized before its status is populated.
+	if lateInitialize(&cr.Spec.ForProvider, fromProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateManaged)
+		}
+	}
+
 	populateCRFromProvider(cr, fromProvider)
+	cr.SetConditions(availability(fromProvider))
+	in, err := expandParameters(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	var policy *iamv1.Policy
+	if in.Policy != nil {
+		if policy, err = e.serviceAccounts.GetIamPolicy(ctx, e.rrn.ResourceName(cr)); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetSAPolicy)
+		}
+	}
+	upToDate, changed := isUpToDate(in, fromProvider, policy)
+	if !upToDate {
+		e.record.Event(cr, event.Normal(reasonUpdateNeeded, fmt.Sprintf("fields differ: %s", strings.Join(changed, ", "))))
+		logDiff(e.log.WithValues("name", cr.GetName()), in, fromProvider, policy, changed)
+	}
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  isUpToDate(&cr.Spec.ForProvider, fromProvider),
-		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: connectionDetails(cr.Status.AtProvider),
 	}, nil
 }
 
 // https://cloud.google.com/iam/docs/reference/rest/v1/projects.serviceAccounts/create
-// Note that the metadata.Name from the Kubernetes custom resource is used as the AccountID parameter
-// All other API methods use the external-name annotation
-// (set via the RelativeResourceNameAsExternalName Initializer)
+// Note that the external-name annotation is used as the AccountID parameter
+// (set via the accountIDAsExternalName Initializer)
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1alpha1.ServiceAccount)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotServiceAccount)
 	}
 
+	if err := gcpiam.ValidateAccountID(meta.GetExternalName(cr)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidAccountID)
+	}
+	in, err := expandParameters(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	csar := &iamv1.CreateServiceAccountRequest{
-		AccountId: meta.GetExternalName(cr),
-		ServiceAccount: &iamv1.ServiceAccount{
-			DisplayName: gcp.StringValue(cr.Spec.ForProvider.DisplayName),
-			Description: gcp.StringValue(cr.Spec.ForProvider.Description),
-		},
+		AccountId:      meta.GetExternalName(cr),
+		ServiceAccount: generateServiceAccount(in),
 	}
 
 	// The first parameter to the Create method is the resource name of the GCP project
 	// where the service account should be created
-	req := e.serviceAccounts.Create(e.rrn.ProjectName(), csar)
-	fromProvider, err := req.Context(ctx).Do()
+	fromProvider, err := e.serviceAccounts.Create(ctx, e.rrn.ProjectName(), csar)
+	gcp.SetQuotaCondition(cr, err)
+
+	// A previous Create call may have succeeded even though we never observed
+	// the service account, for example because it was not yet visible to the
+	// Get call in Observe. Creating it again is not an error, but the account
+	// may just as well have been created by other tooling, so it is only
+	// treated as ours if we may manage it.
+	if gcp.IsErrorAlreadyExists(err) {
+		fromProvider, err = e.serviceAccounts.Get(ctx, e.rrn.ResourceName(cr))
+		if err != nil && !gcp.IsErrorNotFound(err) {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGet)
+		}
+		if err == nil && !mayManage(cr, fromProvider) {
+			return managed.ExternalCreation{}, errors.New(errUnmarked)
+		}
+		err = nil
+	}
 	if err != nil {
+		if isOrgPolicyViolation(err) {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateOrgPolicy)
+		}
+		if gcp.IsErrorQuotaExceeded(err) {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreateQuota)
+		}
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
-	populateCRFromProvider(cr, fromProvider)
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	if fromProvider != nil {
+		populateCRFromProvider(cr, fromProvider)
+	}
+
+	e.waitUntilVisible(ctx, cr)
+	return managed.ExternalCreation{}, errors.Wrap(e.recordCreated(ctx, cr), errRecordCreated)
+}
+
+// recordCreated records the current time in the AnnotationKeyCreated
+// annotation of the supplied ServiceAccount. Only the annotation is persisted;
+// the status populated by Create is left for the managed reconciler to update.
+func (e *external) recordCreated(ctx context.Context, cr *v1alpha1.ServiceAccount) error {
+	u := cr.DeepCopy()
+	meta.AddAnnotations(u, map[string]string{AnnotationKeyCreated: e.now().Format(time.RFC3339)})
+	if err := e.kube.Update(ctx, u); err != nil {
+		return err
+	}
+	cr.SetAnnotations(u.GetAnnotations())
+	cr.SetResourceVersion(u.GetResourceVersion())
+	return nil
+}
+
+// pending returns true if the supplied ServiceAccount was created within the
+// create grace period, and is not being deleted.
+func (e *external) pending(cr *v1alpha1.ServiceAccount) bool {
+	if meta.WasDeleted(cr) {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, cr.GetAnnotations()[AnnotationKeyCreated])
+	if err != nil {
+		return false
+	}
+	return e.now().Sub(t) < e.createGrace
+}
+
+// isOrgPolicyViolation returns true if the supplied error indicates that the
+// iam.disableServiceAccountCreation organization policy prevented a service
+// account from being created. The IAM API reports this as a failed
+// precondition whose details name the violated constraint, which distinguishes
+// it from a plain permission denial.
+func isOrgPolicyViolation(err error) bool {
+	gErr, ok := err.(*googleapi.Error)
+	if !ok {
+		return false
+	}
+	if gErr.Code != http.StatusBadRequest && gErr.Code != http.StatusForbidden {
+		return false
+	}
+	return strings.Contains(gErr.Body, constraintDisableCreation) || strings.Contains(gErr.Message, constraintDisableCreation)
+}
+
+// waitUntilVisible polls the IAM API until the supplied service account can be
+// read, or until the visibility backoff is exhausted. The service account has
+// already been created at this point, so failing to observe it is not an
+// error; any subsequent Create will be treated as a no-op. Polling stops as
+// soon as the supplied context is done.
+func (e *external) waitUntilVisible(ctx context.Context, cr *v1alpha1.ServiceAccount) {
+	_ = wait.ExponentialBackoff(e.visibility, func() (bool, error) {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		default:
+		}
+		fromProvider, err := e.serviceAccounts.Get(ctx, e.rrn.ResourceName(cr))
+		if gcp.IsErrorNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		populateCRFromProvider(cr, fromProvider)
+		return true, nil
+	})
 }
 
 // https://cloud.google.com/iam/docs/reference/rest/v1/projects.serviceAccounts/patch
@@ -173,17 +771,97 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotServiceAccount)
 	}
 
-	sa := &iamv1.ServiceAccount{}
-	populateProviderFromCR(sa, cr)
-	psar := &iamv1.PatchServiceAccountRequest{
-		ServiceAccount: sa,
-		UpdateMask:     "description,displayName",
+	in, err := expandParameters(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	observed, err := e.serviceAccounts.Get(ctx, e.rrn.ResourceName(cr))
+	if gcp.IsErrorNotFound(err) && e.pending(cr) {
+		// The service account is not visible yet. It will be updated once it
+		// is, if it still needs to be.
+		return managed.ExternalUpdate{}, nil
+	}
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+	// Only the fields that differ are patched, so that a field that is not
+	// declared, or was changed out of band, is left as it is.
+	_, changed := isUpToDate(in, observed, nil)
+	if psar := generatePatch(in, changed); psar != nil {
+		// we don't pay attention to the result of the patch request because it is only guaranteed to contain
+		// the mutable fields ie the fields we are trying to change
+		if _, err := e.serviceAccounts.Patch(ctx, e.rrn.ResourceName(cr), psar); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+	if err := e.updateDisabled(ctx, cr, in); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, e.updatePolicy(ctx, cr, in)
+}
+
+// updateDisabled disables or enables the service account if the supplied
+// parameters ask for it to be in a different state than it was observed in.
+// Disabling and enabling are separate IAM API methods; neither can be done
+// by patching the service account.
+func (e *external) updateDisabled(ctx context.Context, cr *v1alpha1.ServiceAccount, in *v1alpha1.ServiceAccountParameters) error {
+	if in.Disabled == nil || *in.Disabled == cr.Status.AtProvider.Disabled {
+		return nil
+	}
+	if *in.Disabled {
+		return errors.Wrap(e.serviceAccounts.Disable(ctx, e.rrn.ResourceName(cr)), errDisable)
 	}
-	req := e.serviceAccounts.Patch(e.rrn.ResourceName(cr), psar)
-	// we don't pay attention to the result of the patch request because it is only guaranteed to contain
-	// `description` and `displayName` ie the fields we are trying to change
-	_, err := req.Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	return errors.Wrap(e.serviceAccounts.Enable(ctx, e.rrn.ResourceName(cr)), errEnable)
+}
+
+// updatePolicy replaces the bindings of the service account's IAM policy
+// with those of the supplied parameters, if they declare a policy. The policy
+// is written with the etag it was read with, so that the write fails rather
+// than overwrite a concurrent change; the next reconcile reads it again.
+func (e *external) updatePolicy(ctx context.Context, cr *v1alpha1.ServiceAccount, in *v1alpha1.ServiceAccountParameters) error {
+	if in.Policy == nil {
+		return nil
+	}
+	p, err := e.serviceAccounts.GetIamPolicy(ctx, e.rrn.ResourceName(cr))
+	if err != nil {
+		return errors.Wrap(err, errGetSAPolicy)
+	}
+	if isBindingsUpToDate(in.Policy, p.Bindings) {
+		return nil
+	}
+	p.Bindings = generateBindings(in.Policy)
+	_, err = e.serviceAccounts.SetIamPolicy(ctx, e.rrn.ResourceName(cr), p)
+	return errors.Wrap(err, errSetSAPolicy)
+}
+
+// PlanUpdate describes the patch Update would send and the fields that differ
+// from the observed service account, without changing it.
+func (e *external) PlanUpdate(ctx context.Context, mg resource.Managed) (string, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccount)
+	if !ok {
+		return "", errors.New(errNotServiceAccount)
+	}
+	in, err := expandParameters(cr)
+	if err != nil {
+		return "", err
+	}
+	observed, err := e.serviceAccounts.Get(ctx, e.rrn.ResourceName(cr))
+	if err != nil {
+		return "", errors.Wrap(err, errGet)
+	}
+	var policy *iamv1.Policy
+	if in.Policy != nil {
+		if policy, err = e.serviceAccounts.GetIamPolicy(ctx, e.rrn.ResourceName(cr)); err != nil {
+			return "", errors.Wrap(err, errGetSAPolicy)
+		}
+	}
+	_, changed := isUpToDate(in, observed, policy)
+	mask := ""
+	if psar := generatePatch(in, changed); psar != nil {
+		mask = psar.UpdateMask
+	}
+	return fmt.Sprintf("would patch service account %s with update mask %q; changed fields: %s",
+		e.rrn.ResourceName(cr), mask, strings.Join(changed, ", ")), nil
 }
 
 // https://cloud.google.com/iam/docs/reference/rest/v1/projects.serviceAccounts/delete
@@ -193,56 +871,360 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotServiceAccount)
 	}
 
-	req := e.serviceAccounts.Delete(e.rrn.ResourceName(cr))
-	_, err := req.Context(ctx).Do()
+	// The managed reconciler only deletes external resources whose reclaim
+	// policy is Delete, but a ServiceAccount that is retained (or orphaned)
+	// must never be deleted, so this is checked here too.
+	if cr.GetReclaimPolicy() == runtimev1alpha1.ReclaimRetain {
+		return nil
+	}
 
+	err := e.serviceAccounts.Delete(ctx, e.rrn.ResourceName(cr))
 	if gcp.IsErrorNotFound(err) {
 		return nil
 	}
 	return errors.Wrap(err, errDelete)
 }
 
+// A persistent error is not replaced by a transient one until it is at least
+// this old, so that a brief outage does not hide why a resource is stuck.
+const persistentErrorTTL = 10 * time.Minute
+
+// An errorRecorder records the errors returned by the ExternalClient it wraps
+// in the status of the ServiceAccount being reconciled, so that they can be
+// inspected without reading the controller's logs.
+type errorRecorder struct {
+	managed.ExternalClient
+	now func() time.Time
+}
+
+func (r *errorRecorder) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := r.ExternalClient.Observe(ctx, mg)
+	cr, ok := mg.(*v1alpha1.ServiceAccount)
+	if !ok {
+		return o, err
+	}
+	if err == nil && o.ResourceExists && o.ResourceUpToDate {
+		cr.Status.AtProvider.LastReconcileError = nil
+	}
+	recordError(&cr.Status.AtProvider, err, r.now())
+	return o, err
+}
+
+func (r *errorRecorder) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := r.ExternalClient.Create(ctx, mg)
+	if cr, ok := mg.(*v1alpha1.ServiceAccount); ok {
+		recordError(&cr.Status.AtProvider, err, r.now())
+	}
+	return c, err
+}
+
+func (r *errorRecorder) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := r.ExternalClient.Update(ctx, mg)
+	if cr, ok := mg.(*v1alpha1.ServiceAccount); ok {
+		recordError(&cr.Status.AtProvider, err, r.now())
+	}
+	return u, err
+}
+
+func (r *errorRecorder) Delete(ctx context.Context, mg resource.Managed) error {
+	err := r.ExternalClient.Delete(ctx, mg)
+	if cr, ok := mg.(*v1alpha1.ServiceAccount); ok {
+		recordError(&cr.Status.AtProvider, err, r.now())
+	}
+	return err
+}
+
+// recordError records the supplied error, if any, as the last reconcile error
+// of the supplied observation. A transient error does not replace a recent
+// persistent one.
+func recordError(o *v1alpha1.ServiceAccountObservation, err error, now time.Time) {
+	if err == nil {
+		return
+	}
+	transient := gcp.IsErrorTransient(errors.Cause(err))
+	if last := o.LastReconcileError; last != nil && transient && !last.Transient && now.Sub(last.Time.Time) < persistentErrorTTL {
+		return
+	}
+	o.LastReconcileError = &v1alpha1.ReconcileError{Message: err.Error(), Time: metav1.NewTime(now), Transient: transient}
+}
+
 // isUpToDate returns true if the supplied Kubernetes resource does not differ
 //  from the supplied GCP resource. It considers only fields that can be
 //  modified in place without deleting and recreating the Service Account.
-func isUpToDate(in *v1alpha1.ServiceAccountParameters, observed *iamv1.ServiceAccount) bool {
+//  It also returns the paths of the fields that differ. An unmarked
+//  description differs, because it is marked by the next update. The
+//  observed policy is only compared if the parameters declare one and it is
+//  supplied.
+func isUpToDate(in *v1alpha1.ServiceAccountParameters, observed *iamv1.ServiceAccount, policy *iamv1.Policy) (bool, []string) {
+	var changed []string
 	// see comment in serviceaccount_types.go
 	if in.DisplayName != nil && *in.DisplayName != observed.DisplayName {
-		return false
+		changed = append(changed, "displayName")
 	}
-	if in.Description != nil && *in.Description != observed.Description {
-		return false
+	if d, marked := unmarkDescription(observed.Description); !marked || (in.Description != nil && *in.Description != d) {
+		changed = append(changed, "description")
 	}
-	return true
+	if in.Disabled != nil && *in.Disabled != observed.Disabled {
+		changed = append(changed, "disabled")
+	}
+	if in.Policy != nil && policy != nil && !isBindingsUpToDate(in.Policy, policy.Bindings) {
+		changed = append(changed, "policy")
+	}
+	return len(changed) == 0, changed
+}
+
+// logDiff logs the observed and desired values of the supplied changed
+// fields, as returned by isUpToDate.
+func logDiff(log logging.Logger, in *v1alpha1.ServiceAccountParameters, observed *iamv1.ServiceAccount, policy *iamv1.Policy, changed []string) {
+	for _, f := range changed {
+		switch f {
+		case "displayName":
+			gcp.LogFieldDiff(log, f, observed.DisplayName, gcp.StringValue(in.DisplayName))
+		case "description":
+			// The marker is not part of the desired description, so it is
+			// not logged as part of the observed one; an unmarked
+			// description differs only in that it is unmarked.
+			d, marked := unmarkDescription(observed.Description)
+			desired := d
+			if in.Description != nil {
+				desired = *in.Description
+			}
+			gcp.LogFieldDiff(log.WithValues("marked", marked), f, d, desired)
+		case "disabled":
+			gcp.LogFieldDiff(log, f, observed.Disabled, gcp.BoolValue(in.Disabled))
+		case "policy":
+			gcp.LogFieldDiff(log, f, bindingMembers(policy.Bindings), bindingMembers(generateBindings(in.Policy)))
+		}
+	}
+}
+
+// bindingMembers returns the members of the supplied bindings, keyed by role.
+func bindingMembers(bindings []*iamv1.Binding) map[string][]string {
+	m := make(map[string][]string, len(bindings))
+	for _, b := range bindings {
+		m[b.Role] = append(m[b.Role], b.Members...)
+	}
+	return m
+}
+
+// generateBindings returns the IAM policy bindings declared by the supplied
+// policy. Bindings of the same role are merged, members are deduplicated and
+// sorted, and bindings without members are pruned, because the IAM API
+// rejects them.
+func generateBindings(in *v1alpha1.ServiceAccountPolicy) []*iamv1.Binding {
+	members := map[string]map[string]bool{}
+	for _, b := range in.Bindings {
+		for _, m := range b.Members {
+			if members[b.Role] == nil {
+				members[b.Role] = map[string]bool{}
+			}
+			members[b.Role][m] = true
+		}
+	}
+	roles := make([]string, 0, len(members))
+	for r := range members {
+		roles = append(roles, r)
+	}
+	sort.Strings(roles)
+
+	bindings := make([]*iamv1.Binding, 0, len(roles))
+	for _, r := range roles {
+		b := &iamv1.Binding{Role: r}
+		for m := range members[r] {
+			b.Members = append(b.Members, m)
+		}
+		sort.Strings(b.Members)
+		bindings = append(bindings, b)
+	}
+	return bindings
+}
+
+// isBindingsUpToDate returns true if the observed bindings grant the same
+// roles to the same members as the supplied policy, regardless of order.
+// Conditional bindings are never declared, so any observed conditional
+// binding makes the bindings differ.
+func isBindingsUpToDate(in *v1alpha1.ServiceAccountPolicy, observed []*iamv1.Binding) bool {
+	o := &v1alpha1.ServiceAccountPolicy{Bindings: make([]v1alpha1.ServiceAccountBinding, len(observed))}
+	for i, b := range observed {
+		if b.Condition != nil {
+			return false
+		}
+		o.Bindings[i] = v1alpha1.ServiceAccountBinding{Role: b.Role, Members: b.Members}
+	}
+	return cmp.Equal(generateBindings(in), generateBindings(o), cmpopts.EquateEmpty())
+}
+
+// markDescription appends the ownership marker to the supplied description.
+func markDescription(d string) string {
+	if d == "" {
+		return ownershipMarker
+	}
+	return d + " " + ownershipMarker
+}
+
+// unmarkDescription strips the ownership marker from the supplied description
+// and reports whether it was present.
+func unmarkDescription(d string) (string, bool) {
+	if !strings.HasSuffix(d, ownershipMarker) {
+		return d, false
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(d, ownershipMarker), " "), true
 }
 
+// adoptUnmarked returns true unless the supplied ServiceAccount explicitly
+// refuses to adopt unmarked service accounts.
+func adoptUnmarked(cr *v1alpha1.ServiceAccount) bool {
+	return cr.Spec.AdoptUnmarked == nil || *cr.Spec.AdoptUnmarked
+}
+
+// mayManage returns true if the supplied ServiceAccount may manage the
+// observed service account. An account we have observed before was adopted or
+// created by us even if it predates the ownership marker; it is marked on the
+// next update.
+func mayManage(cr *v1alpha1.ServiceAccount, observed *iamv1.ServiceAccount) bool {
+	_, marked := unmarkDescription(observed.Description)
+	adopted := cr.Status.AtProvider.UniqueID != "" && cr.Status.AtProvider.UniqueID == observed.UniqueId
+	return marked || adopted || adoptUnmarked(cr)
+}
+
+// NOTE: Unlike most GCP APIs the v1 IAM API does not report when a service
+// account was created, so there is no creation time to observe.
 func populateCRFromProvider(cr *v1alpha1.ServiceAccount, fromProvider *iamv1.ServiceAccount) {
+	cr.Status.AtProvider.ProjectID = fromProvider.ProjectId
 	cr.Status.AtProvider.UniqueID = fromProvider.UniqueId
 	cr.Status.AtProvider.Email = fromProvider.Email
 	cr.Status.AtProvider.Oauth2ClientID = fromProvider.Oauth2ClientId
 	cr.Status.AtProvider.Disabled = fromProvider.Disabled
+	cr.Status.AtProvider.Etag = fromProvider.Etag
 	cr.Status.AtProvider.Name = fromProvider.Name
 }
 
-func populateProviderFromCR(forProvider *iamv1.ServiceAccount, cr *v1alpha1.ServiceAccount) {
-	forProvider.DisplayName = gcp.StringValue(cr.Spec.ForProvider.DisplayName)
-	forProvider.Description = gcp.StringValue(cr.Spec.ForProvider.Description)
+// lateInitialize sets the display name and description of the supplied
+// parameters that are not set to those observed, without the ownership
+// marker. It returns true if any of them was set.
+func lateInitialize(in *v1alpha1.ServiceAccountParameters, observed *iamv1.ServiceAccount) bool {
+	displayName, description := in.DisplayName, in.Description
+	unmarked, _ := unmarkDescription(observed.Description)
+	in.DisplayName = gcp.LateInitializeString(in.DisplayName, observed.DisplayName)
+	in.Description = gcp.LateInitializeString(in.Description, unmarked)
+	return in.DisplayName != displayName || in.Description != description
+}
+
+// availability returns the Ready condition of the supplied service account. A
+// disabled service account exists, but cannot authenticate, so it is not
+// available. The condition only changes, and with it its transition time, when
+// the service account is disabled or enabled.
+func availability(sa *iamv1.ServiceAccount) runtimev1alpha1.Condition {
+	if sa.Disabled {
+		return runtimev1alpha1.Unavailable().WithMessage(msgDisabled)
+	}
+	return runtimev1alpha1.Available()
+}
+
+// connectionDetails returns the identity of the observed service account, so
+// that it can be granted roles by consumers of its connection secret.
+func connectionDetails(o v1alpha1.ServiceAccountObservation) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if o.Email != "" {
+		cd[connection.KeyEmail] = []byte(o.Email)
+	}
+	if o.UniqueID != "" {
+		cd[connection.KeyUniqueID] = []byte(o.UniqueID)
+	}
+	return cd
+}
+
+// A mutableField is a service account field that can be updated in place.
+type mutableField struct {
+	// mask is the name of the field in an update mask.
+	mask string
+
+	// field is the name of the iamv1.ServiceAccount struct field.
+	field string
+
+	// set sets the field of the supplied service account.
+	set func(sa *iamv1.ServiceAccount, in *v1alpha1.ServiceAccountParameters)
+}
+
+// mutableFields are the service account fields that are set on creation and
+// patched by Update. Registering a field here adds it to both the body and
+// the update mask of the patch request.
+var mutableFields = []mutableField{
+	{
+		mask:  "displayName",
+		field: "DisplayName",
+		set: func(sa *iamv1.ServiceAccount, in *v1alpha1.ServiceAccountParameters) {
+			sa.DisplayName = gcp.StringValue(in.DisplayName)
+		},
+	},
+	{
+		mask:  "description",
+		field: "Description",
+		set: func(sa *iamv1.ServiceAccount, in *v1alpha1.ServiceAccountParameters) {
+			sa.Description = markDescription(gcp.StringValue(in.Description))
+		},
+	},
+}
+
+// generateServiceAccount returns a service account with all mutable fields
+// set per the supplied parameters.
+func generateServiceAccount(in *v1alpha1.ServiceAccountParameters) *iamv1.ServiceAccount {
+	sa := &iamv1.ServiceAccount{}
+	for _, f := range mutableFields {
+		f.set(sa, in)
+	}
+	return sa
+}
+
+// generatePatch returns a request that patches the mutable fields among the
+// supplied changed fields per the supplied parameters, or nil if none of them
+// is mutable. Only masked fields are sent, and every masked field is sent even
+// when empty, so that the body always matches the mask.
+func generatePatch(in *v1alpha1.ServiceAccountParameters, changed []string) *iamv1.PatchServiceAccountRequest {
+	c := make(map[string]bool, len(changed))
+	for _, f := range changed {
+		c[f] = true
+	}
+	sa := &iamv1.ServiceAccount{}
+	var mask []string
+	for _, f := range mutableFields {
+		if !c[f.mask] {
+			continue
+		}
+		f.set(sa, in)
+		mask = append(mask, f.mask)
+		sa.ForceSendFields = append(sa.ForceSendFields, f.field)
+	}
+	if len(mask) == 0 {
+		return nil
+	}
+	return &iamv1.PatchServiceAccountRequest{ServiceAccount: sa, UpdateMask: strings.Join(mask, ",")}
 }
 
 // NewRelativeResourceNamer makes an instance of the RelativeResourceNamer
 // which is the only type that is allowed to know how to construct GCP resource names
-// for the IAM type.
+// for the IAM type. The supplied projectName must be a project ID; use
+// NewProjectNumberResourceNamer for projects identified by number.
 func NewRelativeResourceNamer(projectName string) RelativeResourceNamer {
-	return RelativeResourceNamer{projectName: projectName}
+	return RelativeResourceNamer{projectName: projectName, projectID: projectName}
+}
+
+// NewProjectNumberResourceNamer makes an instance of the RelativeResourceNamer
+// for a project identified by number. The number is used in resource names,
+// which the IAM API accepts, while the ID is used to build service account
+// emails, whose domain is always derived from the project ID.
+func NewProjectNumberResourceNamer(projectNumber, projectID string) RelativeResourceNamer {
+	return RelativeResourceNamer{projectName: projectNumber, projectID: projectID}
 }
 
 // RelativeResourceNamer allows the controller to generate the "relative resource name"
 // for the service account and GCP project based on the external-name annotation.
 // https://cloud.google.com/apis/design/resource_names#relative_resource_name
 // The relative resource name for service accounts has the following format:
-// projects/{project_id}/serviceAccounts/{account name}
+// projects/{project_id or project_number}/serviceAccounts/{account email}
 type RelativeResourceNamer struct {
 	projectName string
+	projectID   string
 }
 
 // ProjectName yields the relative resource name for a GCP project
@@ -252,6 +1234,10 @@ func (rrn RelativeResourceNamer) ProjectName() string {
 
 // ResourceName yields the relative resource name for the Service Account resource
 func (rrn RelativeResourceNamer) ResourceName(sa *v1alpha1.ServiceAccount) string {
-	return fmt.Sprintf("projects/%s/serviceAccounts/%s@%s.iam.gserviceaccount.com",
-		rrn.projectName, meta.GetExternalName(sa), rrn.projectName)
+	return fmt.Sprintf("projects/%s/serviceAccounts/%s", rrn.projectName, rrn.Email(sa))
+}
+
+// Email yields the email address of the Service Account resource
+func (rrn RelativeResourceNamer) Email(sa *v1alpha1.ServiceAccount) string {
+	return fmt.Sprintf("%s@%s.iam.gserviceaccount.com", meta.GetExternalName(sa), rrn.projectID)
 }
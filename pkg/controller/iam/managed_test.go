@@ -18,30 +18,47 @@ package iam
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
 	"google.golang.org/api/googleapi"
 	iamv1 "google.golang.org/api/iam/v1"
 	"google.golang.org/api/option"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	gcpv1beta1 "github.com/crossplane/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	gcpiam "github.com/crossplane/provider-gcp/pkg/clients/iam"
+	"github.com/crossplane/provider-gcp/pkg/clients/iam/fake"
 )
 
 const (
@@ -49,14 +66,18 @@ const (
 	project   = "someProject"
 
 	providerName       = "some-provider"
+	providerConfigName = "some-provider-config"
 	providerSecretName = "some-provider-secret"
 	providerSecretKey  = "credentials.json"
 	providerSecretData = "definitelyjson"
 
 	connectionSecretName = "some-connection-secret"
-	metadataName         = "beautiful-serviceAccount"
-	accountEmail         = "beautiful-serviceAccount@someProject.iam.gserviceaccount.com"
+	metadataName         = "beautiful-serviceaccount"
+	accountEmail         = "beautiful-serviceaccount@someProject.iam.gserviceaccount.com"
 	wtfConst             = "crossplane.io/external-name"
+
+	saResourceName         = "projects/perfect-project/serviceAccounts/" + metadataName + "@perfect-project.iam.gserviceaccount.com"
+	roleServiceAccountUser = "roles/iam.serviceAccountUser"
 )
 
 var (
@@ -66,8 +87,20 @@ var (
 	description = "A perfect description"
 	fqName      = fmt.Sprintf("projects/%s/serviceAccounts/%s", project, accountEmail)
 	uniqueID    = fqName
+	createdAt   = time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// orgPolicyDeniedBody is the response of the IAM API when the
+	// iam.disableServiceAccountCreation constraint is enforced.
+	quotaExceededBody   = `{"error":{"code":429,"message":"Maximum number of service accounts on project reached.","status":"RESOURCE_EXHAUSTED","details":[{"@type":"type.googleapis.com/google.rpc.QuotaFailure","violations":[{"subject":"projects/perfect-project","description":"Service accounts per project limit of 100 reached."}]}]}}`
+	orgPolicyDeniedBody = `{"error":{"code":400,"message":"Precondition check failed.","status":"FAILED_PRECONDITION","details":[{"@type":"type.googleapis.com/google.rpc.PreconditionFailure","violations":[{"type":"constraints/iam.disableServiceAccountCreation","subject":"projects/perfect-project"}]}]}}`
 )
 
+type usageTrackerFn func(ctx context.Context, mg resource.Managed, ref runtimev1alpha1.Reference) error
+
+func (fn usageTrackerFn) Track(ctx context.Context, mg resource.Managed, ref runtimev1alpha1.Reference) error {
+	return fn(ctx, mg, ref)
+}
+
 type strange struct {
 	resource.Managed
 }
@@ -82,6 +115,16 @@ func withProjectID(s string) valueModifier {
 	return func(i *v1alpha1.ServiceAccount) { i.Status.AtProvider.ProjectID = s }
 }
 
+func withProviderConfigRef(name string) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) {
+		i.Spec.ProviderConfigReference = &runtimev1alpha1.Reference{Name: name}
+	}
+}
+
+func withParametersProjectID(s string) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) { i.Spec.ForProvider.ProjectID = &s }
+}
+
 func withDisplayName(s string) valueModifier {
 	return func(i *v1alpha1.ServiceAccount) { i.Spec.ForProvider.DisplayName = &s }
 }
@@ -94,6 +137,10 @@ func withUniqueID(s string) valueModifier {
 	return func(i *v1alpha1.ServiceAccount) { i.Status.AtProvider.UniqueID = s }
 }
 
+func withEtag(s string) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) { i.Status.AtProvider.Etag = s }
+}
+
 func withEmail(s string) valueModifier {
 	return func(i *v1alpha1.ServiceAccount) { i.Status.AtProvider.Email = s }
 }
@@ -102,6 +149,24 @@ func withDisabled(b bool) valueModifier {
 	return func(i *v1alpha1.ServiceAccount) { i.Status.AtProvider.Disabled = b }
 }
 
+func withDisabledParameter(b bool) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) { i.Spec.ForProvider.Disabled = &b }
+}
+
+func withAdoptUnmarked(b bool) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) { i.Spec.AdoptUnmarked = &b }
+}
+
+func withPolicy(b ...v1alpha1.ServiceAccountBinding) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) {
+		i.Spec.ForProvider.Policy = &v1alpha1.ServiceAccountPolicy{Bindings: b}
+	}
+}
+
+func withConditions(c ...runtimev1alpha1.Condition) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) { i.Status.SetConditions(c...) }
+}
+
 func withExternalNameAnnotation(externalName string) valueModifier {
 	return func(i *v1alpha1.ServiceAccount) {
 		if i.ObjectMeta.Annotations == nil {
@@ -111,6 +176,16 @@ func withExternalNameAnnotation(externalName string) valueModifier {
 	}
 }
 
+func withReclaimPolicy(p runtimev1alpha1.ReclaimPolicy) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) { i.Spec.ReclaimPolicy = p }
+}
+
+func withCreatedAt(t time.Time) valueModifier {
+	return func(i *v1alpha1.ServiceAccount) {
+		meta.AddAnnotations(i, map[string]string{AnnotationKeyCreated: t.Format(time.RFC3339)})
+	}
+}
+
 func serviceAccount(im ...valueModifier) *v1alpha1.ServiceAccount {
 	sa := &v1alpha1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
@@ -141,6 +216,14 @@ func serviceAccount(im ...valueModifier) *v1alpha1.ServiceAccount {
 var _ managed.ExternalClient = &external{}
 var _ managed.ExternalConnecter = &connecter{}
 
+// missingCredentials returns the error CredentialsData returns when the
+// supplied key of the supplied Secret holds no credentials.
+func missingCredentials(s corev1.Secret, key string) error {
+	s.Data = nil
+	_, err := gcp.CredentialsData(&s, key)
+	return err
+}
+
 func TestConnect(t *testing.T) {
 	provider := gcpv1alpha3.Provider{
 		ObjectMeta: metav1.ObjectMeta{Name: providerName},
@@ -163,6 +246,23 @@ func TestConnect(t *testing.T) {
 		Data:       map[string][]byte{providerSecretKey: []byte(providerSecretData)},
 	}
 
+	config := gcpv1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: providerConfigName},
+		Spec: gcpv1beta1.ProviderConfigSpec{
+			ProjectID: project,
+			Credentials: gcpv1beta1.ProviderCredentials{
+				Source: gcpv1beta1.CredentialsSourceSecret,
+				SecretRef: &runtimev1alpha1.SecretKeySelector{
+					SecretReference: runtimev1alpha1.SecretReference{
+						Namespace: namespace,
+						Name:      providerSecretName,
+					},
+					Key: providerSecretKey,
+				},
+			},
+		},
+	}
+
 	type strange struct {
 		resource.Managed
 	}
@@ -191,7 +291,9 @@ func TestConnect(t *testing.T) {
 					}
 					return nil
 				}},
-				newSAS: func(_ context.Context, _ []byte) (*iamv1.ProjectsServiceAccountsService, error) { return nil, nil },
+				newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+					return nil, nil
+				},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -201,6 +303,118 @@ func TestConnect(t *testing.T) {
 				err: nil,
 			},
 		},
+		"InjectedIdentity": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						injected := provider
+						injected.Spec.CredentialsSource = gcpv1alpha3.CredentialsSourceInjectedIdentity
+						injected.SetCredentialsSecretReference(nil)
+						*obj.(*gcpv1alpha3.Provider) = injected
+					default:
+						return errorBoom
+					}
+					return nil
+				}},
+				newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+					return nil, nil
+				},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount()},
+			want: want{err: nil},
+		},
+		"InvalidImpersonationTarget": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						impersonating := provider
+						impersonating.Spec.ImpersonateServiceAccount = "sa"
+						*obj.(*gcpv1alpha3.Provider) = impersonating
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = secret
+					}
+					return nil
+				}},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount()},
+			want: want{err: errors.Errorf(errImpersonateTarget, "sa")},
+		},
+		"SelectedProvider": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: "selected-provider"}:
+						*obj.(*gcpv1alpha3.Provider) = provider
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = secret
+					default:
+						return errorBoom
+					}
+					return nil
+				}},
+				newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+					return nil, nil
+				},
+				selectProvider: func(_ context.Context, _ client.Client, _ resource.Managed) (*corev1.ObjectReference, error) {
+					return &corev1.ObjectReference{Name: "selected-provider"}, nil
+				},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount()},
+			want: want{err: nil},
+		},
+		"ProviderConfig": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerConfigName}:
+						*obj.(*gcpv1beta1.ProviderConfig) = config
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = secret
+					default:
+						return errorBoom
+					}
+					return nil
+				}},
+				newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+					return nil, nil
+				},
+				usage: usageTrackerFn(func(_ context.Context, _ resource.Managed, ref runtimev1alpha1.Reference) error {
+					if ref.Name != providerConfigName {
+						t.Errorf("Track(...): want ProviderConfig %q, got %q", providerConfigName, ref.Name)
+					}
+					return nil
+				}),
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount(withProviderConfigRef(providerConfigName))},
+			want: want{err: nil},
+		},
+		"FailedToTrackUsage": {
+			conn: &connecter{
+				usage: usageTrackerFn(func(_ context.Context, _ resource.Managed, _ runtimev1alpha1.Reference) error {
+					return errorBoom
+				}),
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount(withProviderConfigRef(providerConfigName))},
+			want: want{err: errors.Wrap(errorBoom, errTrackUsage)},
+		},
+		"FailedToGetProviderConfig": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: test.NewMockGetFn(errorBoom)},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount(withProviderConfigRef(providerConfigName))},
+			want: want{err: errors.Wrap(errorBoom, errGetProviderConfig)},
+		},
+		"FailedToSelectProvider": {
+			conn: &connecter{
+				selectProvider: func(_ context.Context, _ client.Client, _ resource.Managed) (*corev1.ObjectReference, error) {
+					return nil, errorBoom
+				},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount()},
+			want: want{err: errors.Wrap(errorBoom, errSelectProvider)},
+		},
 		"NotServiceAccount": {
 			conn: &connecter{},
 			args: args{ctx: context.Background(), mg: &strange{}},
@@ -247,6 +461,109 @@ func TestConnect(t *testing.T) {
 			args: args{ctx: context.Background(), mg: serviceAccount()},
 			want: want{err: errors.New(errProviderSecretRef)},
 		},
+		"DefaultCredentialsKey": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						unkeyed := *provider.DeepCopy()
+						unkeyed.Spec.CredentialsSecretRef.Key = ""
+						*obj.(*gcpv1alpha3.Provider) = unkeyed
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = secret
+					}
+					return nil
+				}},
+				newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+					return nil, nil
+				},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount()},
+			want: want{err: nil},
+		},
+		"MissingCredentialsKey": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						misKeyed := *provider.DeepCopy()
+						misKeyed.Spec.CredentialsSecretRef.Key = "key.json"
+						*obj.(*gcpv1alpha3.Provider) = misKeyed
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = secret
+					}
+					return nil
+				}},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount()},
+			want: want{err: missingCredentials(secret, "key.json")},
+		},
+		"EmptyCredentials": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						*obj.(*gcpv1alpha3.Provider) = provider
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						empty := *secret.DeepCopy()
+						empty.Data[providerSecretKey] = []byte{}
+						*obj.(*corev1.Secret) = empty
+					}
+					return nil
+				}},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount()},
+			want: want{err: missingCredentials(secret, providerSecretKey)},
+		},
+		"ProjectNumber": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						numbered := provider
+						numbered.Spec.ProjectID = "123456789012"
+						*obj.(*gcpv1alpha3.Provider) = numbered
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = secret
+					}
+					return nil
+				}},
+				newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+					return nil, nil
+				},
+				resolveProjectID: func(_ context.Context, number string, _ ...option.ClientOption) (string, error) {
+					if number != "123456789012" {
+						return "", errorBoom
+					}
+					return project, nil
+				},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount()},
+			want: want{err: nil},
+		},
+		"FailedToResolveProjectID": {
+			conn: &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						numbered := provider
+						numbered.Spec.ProjectID = "123456789012"
+						*obj.(*gcpv1alpha3.Provider) = numbered
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = secret
+					}
+					return nil
+				}},
+				newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+					return nil, nil
+				},
+				resolveProjectID: func(_ context.Context, _ string, _ ...option.ClientOption) (string, error) {
+					return "", errorBoom
+				},
+			},
+			args: args{ctx: context.Background(), mg: serviceAccount()},
+			want: want{err: errors.Wrap(errorBoom, errResolveProjectID)},
+		},
 		"FailedToCreateClient": {
 			conn: &connecter{
 				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
@@ -258,7 +575,7 @@ func TestConnect(t *testing.T) {
 					}
 					return nil
 				}},
-				newSAS: func(_ context.Context, _ []byte) (*iamv1.ProjectsServiceAccountsService, error) {
+				newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
 					return nil, errorBoom
 				},
 			},
@@ -278,6 +595,185 @@ func TestConnect(t *testing.T) {
 	}
 }
 
+func TestConnectCachesClients(t *testing.T) {
+	provider := gcpv1alpha3.Provider{
+		ObjectMeta: metav1.ObjectMeta{Name: providerName, ResourceVersion: "1"},
+		Spec: gcpv1alpha3.ProviderSpec{
+			ProjectID: project,
+			ProviderSpec: runtimev1alpha1.ProviderSpec{
+				CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+					SecretReference: runtimev1alpha1.SecretReference{
+						Namespace: namespace,
+						Name:      providerSecretName,
+					},
+					Key: providerSecretKey,
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		secretVersions []string
+		want           int
+	}{
+		"CredentialsUnchanged": {
+			secretVersions: []string{"1", "1"},
+			want:           1,
+		},
+		"CredentialsChanged": {
+			secretVersions: []string{"1", "2"},
+			want:           2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			connects := 0
+			c := &connecter{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						*obj.(*gcpv1alpha3.Provider) = provider
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: providerSecretName, ResourceVersion: tc.secretVersions[connects]},
+							Data:       map[string][]byte{providerSecretKey: []byte(providerSecretData)},
+						}
+					}
+					return nil
+				}},
+			}
+			calls := 0
+			c.newSAS = func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+				calls++
+				return nil, nil
+			}
+
+			for connects = range tc.secretVersions {
+				if _, err := c.Connect(context.Background(), serviceAccount()); err != nil {
+					t.Fatalf("c.Connect(...): %s", err)
+				}
+			}
+			if calls != tc.want {
+				t.Errorf("c.Connect(...): want %d new clients, got %d", tc.want, calls)
+			}
+		})
+	}
+}
+
+func TestConnectEndpoint(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey(...): %s", err)
+	}
+	pk := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "token", "token_type": "Bearer", "expires_in": 3600})
+			return
+		}
+		paths = append(paths, r.URL.Path)
+		_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{Name: saResourceName})
+	}))
+	defer server.Close()
+
+	creds, _ := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "crossplane@perfect-project.iam.gserviceaccount.com",
+		"private_key":  string(pk),
+		"token_uri":    server.URL + "/token",
+	})
+	provider := gcpv1alpha3.Provider{
+		ObjectMeta: metav1.ObjectMeta{Name: providerName},
+		Spec: gcpv1alpha3.ProviderSpec{
+			ProjectID: "perfect-project",
+			ProviderSpec: runtimev1alpha1.ProviderSpec{
+				CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+					SecretReference: runtimev1alpha1.SecretReference{Namespace: namespace, Name: providerSecretName},
+					Key:             providerSecretKey,
+				},
+			},
+			Endpoints: map[string]string{gcp.APIIAM: server.URL + "/"},
+		},
+	}
+	kube := test.NewMockClient()
+	kube.MockGet = func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		switch o := obj.(type) {
+		case *gcpv1alpha3.Provider:
+			*o = provider
+		case *corev1.Secret:
+			o.Data = map[string][]byte{providerSecretKey: creds}
+		}
+		return nil
+	}
+	c := &connecter{client: kube, newSAS: gcpiam.NewServiceAccountClient}
+
+	cr := serviceAccount(withExternalNameAnnotation(metadataName))
+	e, err := c.Connect(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("c.Connect(...): %s", err)
+	}
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("e.Observe(...): %s", err)
+	}
+	if diff := cmp.Diff([]string{"/v1/" + saResourceName}, paths); diff != "" {
+		t.Errorf("e.Observe(...): -want requests to endpoint, +got:\n%s", diff)
+	}
+}
+
+func TestConnectCanceled(t *testing.T) {
+	provider := gcpv1alpha3.Provider{
+		ObjectMeta: metav1.ObjectMeta{Name: providerName},
+		Spec: gcpv1alpha3.ProviderSpec{
+			ProjectID: "perfect-project",
+			ProviderSpec: runtimev1alpha1.ProviderSpec{
+				CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+					SecretReference: runtimev1alpha1.SecretReference{Namespace: namespace, Name: providerSecretName},
+					Key:             providerSecretKey,
+				},
+			},
+		},
+	}
+
+	// hung simulates a metadata server that never responds.
+	hung := make(chan struct{})
+	defer close(hung)
+	c := &connecter{
+		client: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+			switch o := obj.(type) {
+			case *gcpv1alpha3.Provider:
+				*o = provider
+			case *corev1.Secret:
+				o.Data = map[string][]byte{providerSecretKey: []byte(providerSecretData)}
+			}
+			return nil
+		}},
+		newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+			<-hung
+			return nil, errorBoom
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	returned := make(chan error, 1)
+	go func() {
+		_, err := c.Connect(ctx, serviceAccount())
+		returned <- err
+	}()
+	select {
+	case err := <-returned:
+		if diff := cmp.Diff(errors.Wrap(context.Canceled, errNewClient), err, test.EquateErrors()); diff != "" {
+			t.Errorf("c.Connect(...): -want error, +got error:\n%s", diff)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("c.Connect(...): did not return after its context was canceled")
+	}
+}
+
 func TestRelativeResourceNamer(t *testing.T) {
 	type args struct {
 		rrn RelativeResourceNamer
@@ -313,6 +809,16 @@ func TestRelativeResourceNamer(t *testing.T) {
 				resourceName: "projects/perfect/serviceAccounts/my-sa@perfect.iam.gserviceaccount.com",
 			},
 		},
+		"ProjectNumber": {
+			args: args{
+				rrn: NewProjectNumberResourceNamer("123456789012", "perfect"),
+				mg:  serviceAccount(withExternalNameAnnotation("my-sa")),
+			},
+			want: want{
+				projectName:  "projects/123456789012",
+				resourceName: "projects/123456789012/serviceAccounts/my-sa@perfect.iam.gserviceaccount.com",
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -330,6 +836,114 @@ func TestRelativeResourceNamer(t *testing.T) {
 
 }
 
+func TestResourceNamer(t *testing.T) {
+	type want struct {
+		project  string
+		resource string
+		err      error
+	}
+
+	cases := map[string]struct {
+		sa   *v1alpha1.ServiceAccount
+		want want
+	}{
+		"ProviderProject": {
+			sa: serviceAccount(withExternalNameAnnotation(metadataName)),
+			want: want{
+				project:  "projects/perfect-project",
+				resource: "projects/perfect-project/serviceAccounts/" + metadataName + "@perfect-project.iam.gserviceaccount.com",
+			},
+		},
+		"OverriddenProject": {
+			sa: serviceAccount(withExternalNameAnnotation(metadataName), withParametersProjectID("other-project")),
+			want: want{
+				project:  "projects/other-project",
+				resource: "projects/other-project/serviceAccounts/" + metadataName + "@other-project.iam.gserviceaccount.com",
+			},
+		},
+		"OverriddenProjectNumber": {
+			sa: serviceAccount(withExternalNameAnnotation(metadataName), withParametersProjectID("123456789012")),
+			want: want{
+				project:  "projects/123456789012",
+				resource: "projects/123456789012/serviceAccounts/" + metadataName + "@resolved-project.iam.gserviceaccount.com",
+			},
+		},
+		"ObservedInSameProject": {
+			sa: serviceAccount(withExternalNameAnnotation(metadataName), withParametersProjectID("other-project"), withProjectID("other-project")),
+			want: want{
+				project:  "projects/other-project",
+				resource: "projects/other-project/serviceAccounts/" + metadataName + "@other-project.iam.gserviceaccount.com",
+			},
+		},
+		"ProjectChanged": {
+			sa: serviceAccount(withExternalNameAnnotation(metadataName), withParametersProjectID("other-project"), withProjectID("perfect-project")),
+			want: want{
+				err: errors.Errorf(errProjectChanged, "perfect-project", "other-project"),
+			},
+		},
+	}
+
+	c := &connecter{resolveProjectID: func(_ context.Context, _ string, _ ...option.ClientOption) (string, error) {
+		return "resolved-project", nil
+	}}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rrn, err := c.resourceNamer(context.Background(), tc.sa, "perfect-project")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("c.resourceNamer(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.project, rrn.ProjectName()); diff != "" {
+				t.Errorf("rrn.ProjectName(): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.resource, rrn.ResourceName(tc.sa)); diff != "" {
+				t.Errorf("rrn.ResourceName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProjectID(t *testing.T) {
+	calls := 0
+	c := &connecter{resolveProjectID: func(_ context.Context, _ string, _ ...option.ClientOption) (string, error) {
+		calls++
+		return "perfect", nil
+	}}
+
+	for i := 0; i < 2; i++ {
+		got, err := c.projectID(context.Background(), "123456789012")
+		if err != nil {
+			t.Fatalf("c.projectID(...): %s", err)
+		}
+		if diff := cmp.Diff("perfect", got); diff != "" {
+			t.Errorf("c.projectID(...): -want, +got:\n%s", diff)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("c.projectID(...): want 1 resolution, got %d", calls)
+	}
+}
+
+func TestIsProjectNumber(t *testing.T) {
+	cases := map[string]bool{
+		"":             false,
+		"123456789012": true,
+		"perfect":      false,
+		"perfect-123":  false,
+		"123-perfect":  false,
+	}
+
+	for project, want := range cases {
+		t.Run(project, func(t *testing.T) {
+			if got := IsProjectNumber(project); got != want {
+				t.Errorf("IsProjectNumber(%q): want %t, got %t", project, want, got)
+			}
+		})
+	}
+}
+
 func TestObserve(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -357,7 +971,9 @@ func TestObserve(t *testing.T) {
 					Name:        fqName,
 					UniqueId:    uniqueID,
 					Email:       accountEmail,
+					Etag:        "BwWeGBpLbb0=",
 					DisplayName: displayName,
+					Description: ownershipMarker,
 				}
 				_ = json.NewEncoder(w).Encode(sa)
 			}),
@@ -373,13 +989,135 @@ func TestObserve(t *testing.T) {
 					withName(fqName),
 					withUniqueID(uniqueID),
 					withEmail(accountEmail),
+					withEtag("BwWeGBpLbb0="),
 					withDisplayName(displayName),
 					withExternalNameAnnotation(fqName),
-					withDisabled(false)),
+					withDisabled(false),
+					withConditions(runtimev1alpha1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						connection.KeyEmail:    []byte(accountEmail),
+						connection.KeyUniqueID: []byte(uniqueID),
+					},
+				},
+			},
+		},
+		"PolicyBindingsDiffer": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				if r.URL.Path == "/v1/"+saResourceName+":getIamPolicy" {
+					_ = json.NewEncoder(w).Encode(&iamv1.Policy{Bindings: []*iamv1.Binding{
+						{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com"}},
+					}})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+					Name:        fqName,
+					UniqueId:    uniqueID,
+					DisplayName: displayName,
+					Description: ownershipMarker,
+				})
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withExternalNameAnnotation(metadataName),
+					withPolicy(v1alpha1.ServiceAccountBinding{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com", "group:devs@example.com"}})),
+			},
+			want: want{
+				mg: serviceAccount(
+					withExternalNameAnnotation(metadataName),
+					withPolicy(v1alpha1.ServiceAccountBinding{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com", "group:devs@example.com"}}),
+					withName(fqName),
+					withUniqueID(uniqueID),
+					withConditions(runtimev1alpha1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						connection.KeyUniqueID: []byte(uniqueID),
+					},
+				},
+			},
+		},
+		"UnmarkedAccountAdopted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+					Name:        fqName,
+					UniqueId:    uniqueID,
+					DisplayName: displayName,
+					Description: description,
+				})
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withDescription(description)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withName(fqName),
+					withUniqueID(uniqueID),
+					withDescription(description),
+					withConditions(runtimev1alpha1.Available())),
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						connection.KeyUniqueID: []byte(uniqueID),
+					},
+				},
+			},
+		},
+		"UnmarkedAccountRefused": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+					Name:        fqName,
+					UniqueId:    uniqueID,
+					DisplayName: displayName,
+				})
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withAdoptUnmarked(false)),
+			},
+			want: want{
+				mg:  serviceAccount(withAdoptUnmarked(false)),
+				err: errors.New(errUnmarked),
+			},
+		},
+		"UnmarkedAccountPreviouslyObserved": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+					Name:        fqName,
+					UniqueId:    uniqueID,
+					DisplayName: displayName,
+				})
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withAdoptUnmarked(false), withUniqueID(uniqueID)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withAdoptUnmarked(false),
+					withName(fqName),
+					withUniqueID(uniqueID),
+					withConditions(runtimev1alpha1.Available())),
 				observation: managed.ExternalObservation{
-					ResourceExists:    true,
-					ResourceUpToDate:  true,
-					ConnectionDetails: managed.ConnectionDetails{},
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						connection.KeyUniqueID: []byte(uniqueID),
+					},
 				},
 			},
 		},
@@ -397,6 +1135,36 @@ func TestObserve(t *testing.T) {
 				observation: managed.ExternalObservation{ResourceExists: false},
 			},
 		},
+		"RecentlyCreatedAccountNotYetVisible": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withCreatedAt(createdAt.Add(-10 * time.Second))),
+			},
+			want: want{
+				mg: serviceAccount(
+					withCreatedAt(createdAt.Add(-10*time.Second)),
+					withConditions(runtimev1alpha1.Creating())),
+				observation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"CreateGracePeriodExpired": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withCreatedAt(createdAt.Add(-time.Minute))),
+			},
+			want: want{
+				mg:          serviceAccount(withCreatedAt(createdAt.Add(-time.Minute))),
+				observation: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
 		"NotServiceAccount": {
 			args: args{
 				ctx: context.Background(),
@@ -414,9 +1182,9 @@ func TestObserve(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
-			serviceAccounts := iamv1.NewProjectsService(s).ServiceAccounts
+			serviceAccounts := &gcpiam.ServiceAccounts{Service: iamv1.NewProjectsService(s).ServiceAccounts}
 			rrn := NewRelativeResourceNamer("perfect-project")
-			e := &external{serviceAccounts: serviceAccounts, rrn: rrn}
+			e := &external{serviceAccounts: serviceAccounts, rrn: rrn, createGrace: 30 * time.Second, now: func() time.Time { return createdAt }, record: event.NewNopRecorder(), log: logging.NewNopLogger()}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 
 			if err != nil {
@@ -444,6 +1212,150 @@ func TestObserve(t *testing.T) {
 	}
 }
 
+func TestObserveErrors(t *testing.T) {
+	cases := map[string]struct {
+		sas gcpiam.ServiceAccountClient
+		mg  resource.Managed
+		err error
+	}{
+		"GetFailed": {
+			sas: &fake.MockServiceAccountClient{MockGet: func(_ context.Context, _ string) (*iamv1.ServiceAccount, error) {
+				return nil, errorBoom
+			}},
+			mg:  serviceAccount(),
+			err: errors.Wrap(errorBoom, errGet),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{serviceAccounts: tc.sas, rrn: NewRelativeResourceNamer("perfect-project"), now: func() time.Time { return createdAt }, record: event.NewNopRecorder(), log: logging.NewNopLogger()}
+			_, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserveLateInitialize(t *testing.T) {
+	withoutDisplayName := func(i *v1alpha1.ServiceAccount) { i.Spec.ForProvider.DisplayName = nil }
+	observed := &iamv1.ServiceAccount{Name: fqName, UniqueId: uniqueID, DisplayName: displayName, Description: description + " " + ownershipMarker}
+
+	type want struct {
+		mg      resource.Managed
+		updated bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"LateInitialized": {
+			reason: "Unset parameters should be set to the observed values, without the ownership marker",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     serviceAccount(withoutDisplayName),
+			want: want{
+				mg: serviceAccount(withDisplayName(displayName), withDescription(description),
+					withName(fqName), withUniqueID(uniqueID), withConditions(runtimev1alpha1.Available())),
+				updated: true,
+			},
+		},
+		"NotOverwritten": {
+			reason: "Parameters that are set should not be overwritten",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errorBoom)},
+			mg:     serviceAccount(withDisplayName("intended"), withDescription("intended")),
+			want: want{
+				mg: serviceAccount(withDisplayName("intended"), withDescription("intended"),
+					withName(fqName), withUniqueID(uniqueID), withConditions(runtimev1alpha1.Available())),
+			},
+		},
+		"UpdateFailed": {
+			reason: "Errors updating the late initialized managed resource should be returned",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errorBoom)},
+			mg:     serviceAccount(withoutDisplayName),
+			want: want{
+				mg:      serviceAccount(withDisplayName(displayName), withDescription(description)),
+				updated: true,
+				err:     errors.Wrap(errorBoom, errUpdateManaged),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			updated := false
+			kube := tc.kube.(*test.MockClient)
+			update := kube.MockUpdate
+			kube.MockUpdate = func(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+				updated = true
+				return update(ctx, obj, opts...)
+			}
+			sas := &fake.MockServiceAccountClient{MockGet: func(_ context.Context, _ string) (*iamv1.ServiceAccount, error) {
+				return observed, nil
+			}}
+			e := &external{kube: kube, serviceAccounts: sas, rrn: NewRelativeResourceNamer("perfect-project"), now: func() time.Time { return createdAt }, record: event.NewNopRecorder(), log: logging.NewNopLogger()}
+			_, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want updated, +got updated:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveAvailability(t *testing.T) {
+	since := metav1.NewTime(createdAt.Add(-time.Hour))
+	available := runtimev1alpha1.Available()
+	available.LastTransitionTime = since
+
+	cases := map[string]struct {
+		reason   string
+		disabled bool
+		want     runtimev1alpha1.Condition
+		changed  bool
+	}{
+		"StillAvailable": {
+			reason: "An enabled service account that was available should keep the time it became available",
+			want:   available,
+		},
+		"Disabled": {
+			reason:   "A service account that was disabled should become unavailable",
+			disabled: true,
+			want:     runtimev1alpha1.Unavailable().WithMessage(msgDisabled),
+			changed:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sas := &fake.MockServiceAccountClient{MockGet: func(_ context.Context, _ string) (*iamv1.ServiceAccount, error) {
+				return &iamv1.ServiceAccount{Name: fqName, DisplayName: displayName, Description: ownershipMarker, Disabled: tc.disabled}, nil
+			}}
+			e := &external{serviceAccounts: sas, rrn: NewRelativeResourceNamer("perfect-project"), now: func() time.Time { return createdAt }, record: event.NewNopRecorder(), log: logging.NewNopLogger()}
+			cr := serviceAccount(withConditions(available))
+			if _, err := e.Observe(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\nObserve(...): %s", tc.reason, err)
+			}
+			got := cr.GetCondition(runtimev1alpha1.TypeReady)
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if changed := !got.LastTransitionTime.Equal(&since); changed != tc.changed {
+				t.Errorf("\n%s\nObserve(...): want transition time changed %t, got %t", tc.reason, tc.changed, changed)
+			}
+		})
+	}
+}
+
 func TestCreate(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -465,12 +1377,25 @@ func TestCreate(t *testing.T) {
 
 	cases := map[string]struct {
 		handler http.Handler
+		kube    client.Client
 		args    args
 		want    want
 	}{
 		"CreatedAccount": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				defer r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+						Name:        fqName,
+						Email:       accountEmail,
+						DisplayName: displayName,
+						Description: description,
+						ProjectId:   project,
+						UniqueId:    uniqueID,
+					})
+					return
+				}
 				ur := &createRequest{}
 				b, err := ioutil.ReadAll(r.Body)
 				if diff := cmp.Diff(err, nil); diff != "" {
@@ -491,7 +1416,7 @@ func TestCreate(t *testing.T) {
 					AccountID: metadataName,
 					ServiceAccount: createSA{
 						DisplayName: displayName,
-						Description: description,
+						Description: description + " " + ownershipMarker,
 					},
 				}
 				if diff := cmp.Diff(ur, expected); diff != "" {
@@ -519,50 +1444,309 @@ func TestCreate(t *testing.T) {
 			want: want{
 				mg: serviceAccount(
 					withProjectID(project), withName(fqName),
-					withExternalNameAnnotation(metadataName),
+					withExternalNameAnnotation(metadataName), withCreatedAt(createdAt),
 					withDisplayName(displayName), withDescription(description),
 					withEmail(accountEmail), withUniqueID(uniqueID)),
 			},
 		},
-		"NotServiceAccount": {
+		"CreatedAccountEventuallyVisible": {
+			handler: func() http.Handler {
+				gets := 0
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					defer r.Body.Close()
+					switch r.Method {
+					case http.MethodPost:
+						w.WriteHeader(http.StatusOK)
+						_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{Name: fqName})
+					case http.MethodGet:
+						// The service account only becomes visible on the
+						// third read.
+						gets++
+						if gets < 3 {
+							w.WriteHeader(http.StatusNotFound)
+							_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+							return
+						}
+						w.WriteHeader(http.StatusOK)
+						_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+							Name:      fqName,
+							Email:     accountEmail,
+							UniqueId:  uniqueID,
+							ProjectId: project,
+						})
+					}
+				})
+			}(),
 			args: args{
 				ctx: context.Background(),
-				mg:  &strange{},
+				mg: serviceAccount(
+					withProjectID(project),
+					withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
 			},
 			want: want{
-				mg:  &strange{},
-				err: errors.New(errNotServiceAccount),
+				mg: serviceAccount(
+					withProjectID(project), withName(fqName),
+					withExternalNameAnnotation(metadataName), withCreatedAt(createdAt),
+					withDisplayName(displayName), withDescription(description),
+					withEmail(accountEmail), withUniqueID(uniqueID)),
 			},
 		},
-		"FailedToCreateAccount": {
+		"AccountAlreadyExists": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				defer r.Body.Close()
-				w.WriteHeader(http.StatusInternalServerError)
-				_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+				switch r.Method {
+				case http.MethodPost:
+					w.WriteHeader(http.StatusConflict)
+					_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+						Name:      fqName,
+						Email:     accountEmail,
+						UniqueId:  uniqueID,
+						ProjectId: project,
+					})
+				}
 			}),
 			args: args{
 				ctx: context.Background(),
 				mg: serviceAccount(
-					withName(metadataName), withProjectID(project),
+					withProjectID(project),
+					withExternalNameAnnotation(metadataName),
 					withDisplayName(displayName), withDescription(description)),
 			},
 			want: want{
 				mg: serviceAccount(
-					withName(metadataName), withProjectID(project),
-					withDisplayName(displayName), withDescription(description)),
-				err: errors.Wrap(err500, errCreate),
+					withProjectID(project), withName(fqName),
+					withExternalNameAnnotation(metadataName), withCreatedAt(createdAt),
+					withDisplayName(displayName), withDescription(description),
+					withEmail(accountEmail), withUniqueID(uniqueID)),
 			},
 		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			server := httptest.NewServer(tc.handler)
-			defer server.Close()
-			s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
-			serviceAccounts := iamv1.NewProjectsService(s).ServiceAccounts
+		"AccountAlreadyExistsUnmarked": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.Method {
+				case http.MethodPost:
+					w.WriteHeader(http.StatusConflict)
+					_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+						Name:        fqName,
+						Email:       accountEmail,
+						UniqueId:    uniqueID,
+						ProjectId:   project,
+						Description: "Created by other tooling",
+					})
+				}
+			}),
+			kube: &test.MockClient{MockUpdate: func(_ context.Context, _ runtime.Object, _ ...client.UpdateOption) error {
+				t.Errorf("unexpected Update: an unmarked account must not be recorded as created")
+				return nil
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withProjectID(project),
+					withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description),
+					withAdoptUnmarked(false)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withProjectID(project),
+					withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description),
+					withAdoptUnmarked(false)),
+				err: errors.New(errUnmarked),
+			},
+		},
+		"AccountAlreadyExistsGetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.Method {
+				case http.MethodPost:
+					w.WriteHeader(http.StatusConflict)
+				case http.MethodGet:
+					w.WriteHeader(http.StatusForbidden)
+				}
+				_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withProjectID(project),
+					withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withProjectID(project),
+					withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+				err: errors.Wrap(gError(http.StatusForbidden), errGet),
+			},
+		},
+		"RecordCreatedFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+					Name:      fqName,
+					Email:     accountEmail,
+					UniqueId:  uniqueID,
+					ProjectId: project,
+				})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errorBoom)},
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withProjectID(project),
+					withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withProjectID(project), withName(fqName),
+					withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description),
+					withEmail(accountEmail), withUniqueID(uniqueID)),
+				err: errors.Wrap(errorBoom, errRecordCreated),
+			},
+		},
+		"NotServiceAccount": {
+			args: args{
+				ctx: context.Background(),
+				mg:  &strange{},
+			},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotServiceAccount),
+			},
+		},
+		"BlockedByOrgPolicy": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(orgPolicyDeniedBody))
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withName(metadataName), withProjectID(project), withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withName(metadataName), withProjectID(project), withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+				err: errors.Wrap(&googleapi.Error{Code: http.StatusBadRequest, Message: "Precondition check failed.", Body: orgPolicyDeniedBody}, errCreateOrgPolicy),
+			},
+		},
+		"QuotaExceeded": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(quotaExceededBody))
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withName(metadataName), withProjectID(project), withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withName(metadataName), withProjectID(project), withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description),
+					withConditions(runtimev1alpha1.Condition{
+						Type:    gcp.TypeQuotaExceeded,
+						Status:  corev1.ConditionTrue,
+						Reason:  gcp.ReasonQuotaExceeded,
+						Message: "Service accounts per project limit of 100 reached.",
+					})),
+				err: errors.Wrap(&googleapi.Error{Code: http.StatusTooManyRequests, Message: "Maximum number of service accounts on project reached.", Body: quotaExceededBody}, errCreateQuota),
+			},
+		},
+		"PermissionDenied": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withName(metadataName), withProjectID(project), withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withName(metadataName), withProjectID(project), withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+				err: errors.Wrap(&googleapi.Error{Code: http.StatusForbidden, Body: "{}\n"}, errCreate),
+			},
+		},
+		"InvalidAccountID": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withProjectID(project), withExternalNameAnnotation("1-Account"),
+					withDisplayName(displayName), withDescription(description)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withProjectID(project), withExternalNameAnnotation("1-Account"),
+					withDisplayName(displayName), withDescription(description)),
+				err: errors.Wrap(gcpiam.ValidateAccountID("1-Account"), errInvalidAccountID),
+			},
+		},
+		"FailedToCreateAccount": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(
+					withName(metadataName), withProjectID(project), withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+			},
+			want: want{
+				mg: serviceAccount(
+					withName(metadataName), withProjectID(project), withExternalNameAnnotation(metadataName),
+					withDisplayName(displayName), withDescription(description)),
+				err: errors.Wrap(err500, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			serviceAccounts := &gcpiam.ServiceAccounts{Service: iamv1.NewProjectsService(s).ServiceAccounts}
 			rrn := NewRelativeResourceNamer("perfect-project")
-			e := &external{serviceAccounts: serviceAccounts, rrn: rrn}
+			kube := tc.kube
+			if kube == nil {
+				kube = &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+			}
+			e := &external{
+				kube:            kube,
+				serviceAccounts: serviceAccounts,
+				rrn:             rrn,
+				visibility:      wait.Backoff{Steps: 5},
+				now:             func() time.Time { return createdAt },
+			}
 			_, err := e.Create(context.Background(), tc.args.mg)
 
 			if err != nil {
@@ -580,13 +1764,101 @@ func TestCreate(t *testing.T) {
 				}
 			}
 
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, test.EquateConditions()); diff != "" {
 				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
 
+func TestCreateCanceled(t *testing.T) {
+	e := &external{
+		kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+		serviceAccounts: &fake.MockServiceAccountClient{
+			MockCreate: func(_ context.Context, _ string, _ *iamv1.CreateServiceAccountRequest) (*iamv1.ServiceAccount, error) {
+				return &iamv1.ServiceAccount{Name: fqName, Email: accountEmail, UniqueId: uniqueID}, nil
+			},
+			MockGet: func(_ context.Context, _ string) (*iamv1.ServiceAccount, error) {
+				t.Errorf("unexpected Get: the service account must not be polled once the context is canceled")
+				return nil, &googleapi.Error{Code: http.StatusNotFound}
+			},
+		},
+		rrn: NewRelativeResourceNamer("perfect-project"),
+		// The backoff would wait for hours if it were not stopped.
+		visibility: wait.Backoff{Duration: time.Hour, Steps: 5},
+		now:        func() time.Time { return createdAt },
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	returned := make(chan error, 1)
+	go func() {
+		_, err := e.Create(ctx, serviceAccount(withProjectID(project), withExternalNameAnnotation(metadataName)))
+		returned <- err
+	}()
+	select {
+	case err := <-returned:
+		if err != nil {
+			t.Errorf("e.Create(...): unexpected error %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("e.Create(...): did not return after its context was canceled")
+	}
+}
+
+func TestAccountIDAsExternalName(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+	withMetadataName := func(n string) valueModifier {
+		return func(i *v1alpha1.ServiceAccount) { i.SetName(n) }
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		mg   resource.Managed
+		want want
+	}{
+		"ValidName": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   serviceAccount(),
+			want: want{mg: serviceAccount(withExternalNameAnnotation(metadataName))},
+		},
+		"InvalidName": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   serviceAccount(withMetadataName("sa.example")),
+			want: want{mg: serviceAccount(withMetadataName("sa.example"), withExternalNameAnnotation(gcpiam.AccountID("sa.example")))},
+		},
+		"ExternalNameSet": {
+			mg:   serviceAccount(withMetadataName("sa.example"), withExternalNameAnnotation("my-service-account")),
+			want: want{mg: serviceAccount(withMetadataName("sa.example"), withExternalNameAnnotation("my-service-account"))},
+		},
+		"UpdateFailed": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errorBoom)},
+			mg:   serviceAccount(),
+			want: want{
+				mg:  serviceAccount(withExternalNameAnnotation(metadataName)),
+				err: errors.Wrap(errorBoom, errUpdateManaged),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			i := &accountIDAsExternalName{client: tc.kube}
+			err := i.Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Initialize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Initialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -642,6 +1914,10 @@ func TestUpdate(t *testing.T) {
 						t.Errorf("unexpected displayName, got=%s want=%s", req.ServiceAccount.DisplayName, updatedDisplayName)
 						respondWith(w, http.StatusInternalServerError, &iamv1.ServiceAccount{})
 					}
+					if want := description + " " + ownershipMarker; req.ServiceAccount.Description != want {
+						t.Errorf("unexpected description, got=%s want=%s", req.ServiceAccount.Description, want)
+						respondWith(w, http.StatusInternalServerError, &iamv1.ServiceAccount{})
+					}
 					w.WriteHeader(http.StatusOK)
 					_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
 				}
@@ -668,6 +1944,137 @@ func TestUpdate(t *testing.T) {
 				),
 			},
 		},
+		"OnlyDescriptionChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.Method + " " + r.URL.Path {
+				case http.MethodGet + " /v1/" + saResourceName:
+					_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{
+						Name:        saResourceName,
+						DisplayName: displayName,
+						Description: markDescription("old"),
+					})
+				case http.MethodPatch + " /v1/" + saResourceName:
+					req := &struct {
+						ServiceAccount map[string]interface{} `json:"serviceAccount"`
+						UpdateMask     string                 `json:"updateMask"`
+					}{}
+					_ = json.NewDecoder(r.Body).Decode(req)
+					if diff := cmp.Diff("description", req.UpdateMask); diff != "" {
+						t.Errorf("r: -want update mask, +got:\n%s", diff)
+					}
+					// The display name is unchanged, so it is not sent.
+					want := map[string]interface{}{"description": description + " " + ownershipMarker}
+					if diff := cmp.Diff(want, req.ServiceAccount); diff != "" {
+						t.Errorf("r: -want service account, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withExternalNameAnnotation(metadataName), withDescription(description)),
+			},
+			want: want{
+				mg: serviceAccount(withExternalNameAnnotation(metadataName), withDescription(description)),
+			},
+		},
+		"Disabled": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.Method + " " + r.URL.Path {
+				case http.MethodGet + " /v1/" + saResourceName, http.MethodPatch + " /v1/" + saResourceName:
+					_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{})
+				case http.MethodPost + " /v1/" + saResourceName + ":disable":
+					_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withExternalNameAnnotation(metadataName), withDisabledParameter(true)),
+			},
+			want: want{
+				mg: serviceAccount(withExternalNameAnnotation(metadataName), withDisabledParameter(true)),
+			},
+		},
+		"EnableFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.Method + " " + r.URL.Path {
+				case http.MethodGet + " /v1/" + saResourceName, http.MethodPatch + " /v1/" + saResourceName:
+					_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{})
+				case http.MethodPost + " /v1/" + saResourceName + ":enable":
+					w.WriteHeader(http.StatusInternalServerError)
+					_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withExternalNameAnnotation(metadataName), withDisabledParameter(false), withDisabled(true)),
+			},
+			want: want{
+				mg:  serviceAccount(withExternalNameAnnotation(metadataName), withDisabledParameter(false), withDisabled(true)),
+				err: errors.Wrap(err500, errEnable),
+			},
+		},
+		"UpdatedPolicy": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				switch r.URL.Path {
+				case "/v1/" + saResourceName:
+					_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccount{})
+				case "/v1/" + saResourceName + ":getIamPolicy":
+					_ = json.NewEncoder(w).Encode(&iamv1.Policy{Etag: "BwWeGBpLbb0=", Bindings: []*iamv1.Binding{
+						{Role: "roles/iam.serviceAccountTokenCreator", Members: []string{"group:admins@example.com"}},
+					}})
+				case "/v1/" + saResourceName + ":setIamPolicy":
+					req := &iamv1.SetIamPolicyRequest{}
+					_ = json.NewDecoder(r.Body).Decode(req)
+					want := &iamv1.SetIamPolicyRequest{Policy: &iamv1.Policy{Version: 3, Etag: "BwWeGBpLbb0=", Bindings: []*iamv1.Binding{
+						{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com", "group:devs@example.com"}},
+					}}}
+					if diff := cmp.Diff(want, req); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(req.Policy)
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: serviceAccount(withExternalNameAnnotation(metadataName), withPolicy(
+					v1alpha1.ServiceAccountBinding{Role: roleServiceAccountUser, Members: []string{"group:devs@example.com", "group:admins@example.com"}},
+					v1alpha1.ServiceAccountBinding{Role: "roles/iam.workloadIdentityUser"},
+				)),
+			},
+			want: want{
+				mg: serviceAccount(withExternalNameAnnotation(metadataName), withPolicy(
+					v1alpha1.ServiceAccountBinding{Role: roleServiceAccountUser, Members: []string{"group:devs@example.com", "group:admins@example.com"}},
+					v1alpha1.ServiceAccountBinding{Role: "roles/iam.workloadIdentityUser"},
+				)),
+			},
+		},
+		"RecentlyCreatedAccountNotYetVisible": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withCreatedAt(createdAt.Add(-10 * time.Second))),
+			},
+			want: want{
+				mg: serviceAccount(withCreatedAt(createdAt.Add(-10 * time.Second))),
+			},
+		},
 		"NotServiceAccount": {
 			args: args{
 				ctx: context.Background(),
@@ -685,9 +2092,9 @@ func TestUpdate(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
-			serviceAccounts := iamv1.NewProjectsService(s).ServiceAccounts
+			serviceAccounts := &gcpiam.ServiceAccounts{Service: iamv1.NewProjectsService(s).ServiceAccounts}
 			rrn := NewRelativeResourceNamer("perfect-project")
-			e := &external{serviceAccounts: serviceAccounts, rrn: rrn}
+			e := &external{serviceAccounts: serviceAccounts, rrn: rrn, createGrace: 30 * time.Second, now: func() time.Time { return createdAt }}
 			_, err := e.Update(context.Background(), tc.args.mg)
 
 			if err != nil {
@@ -712,33 +2119,98 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateErrors(t *testing.T) {
+	observed := func(_ context.Context, _ string) (*iamv1.ServiceAccount, error) {
+		return &iamv1.ServiceAccount{Name: fqName, DisplayName: "other", Description: ownershipMarker}, nil
+	}
+
+	cases := map[string]struct {
+		sas gcpiam.ServiceAccountClient
+		mg  resource.Managed
+		err error
+	}{
+		"GetFailed": {
+			sas: &fake.MockServiceAccountClient{MockGet: func(_ context.Context, _ string) (*iamv1.ServiceAccount, error) {
+				return nil, errorBoom
+			}},
+			mg:  serviceAccount(),
+			err: errors.Wrap(errorBoom, errGet),
+		},
+		"NotFound": {
+			sas: &fake.MockServiceAccountClient{MockGet: func(_ context.Context, _ string) (*iamv1.ServiceAccount, error) {
+				return nil, &googleapi.Error{Code: http.StatusNotFound}
+			}},
+			mg:  serviceAccount(withCreatedAt(createdAt.Add(-time.Minute))),
+			err: errors.Wrap(&googleapi.Error{Code: http.StatusNotFound}, errGet),
+		},
+		"PatchFailed": {
+			sas: &fake.MockServiceAccountClient{
+				MockGet: observed,
+				MockPatch: func(_ context.Context, _ string, _ *iamv1.PatchServiceAccountRequest) (*iamv1.ServiceAccount, error) {
+					return nil, errorBoom
+				},
+			},
+			mg:  serviceAccount(),
+			err: errors.Wrap(errorBoom, errUpdate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{serviceAccounts: tc.sas, rrn: NewRelativeResourceNamer("perfect-project"), createGrace: 30 * time.Second, now: func() time.Time { return createdAt }}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
+	saName := "projects/perfect-project/serviceAccounts/" + metadataName + "@perfect-project.iam.gserviceaccount.com"
+
 	type args struct {
 		ctx context.Context
 		mg  resource.Managed
 	}
 	type want struct {
-		mg  resource.Managed
 		err error
 	}
 
 	cases := map[string]struct {
-		handler http.Handler
-		args    args
-		want    want
+		sas  gcpiam.ServiceAccountClient
+		args args
+		want want
 	}{
 		"DeletedServiceAccount": {
-			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				defer r.Body.Close()
-				w.WriteHeader(http.StatusOK)
-				_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
-			}),
+			sas: &fake.MockServiceAccountClient{MockDelete: func(_ context.Context, n string) error {
+				if n != saName {
+					t.Errorf("Delete(...): want name %q, got %q", saName, n)
+				}
+				return nil
+			}},
 			args: args{
 				ctx: context.Background(),
-				mg:  serviceAccount(),
+				mg:  serviceAccount(withExternalNameAnnotation(metadataName)),
 			},
-			want: want{
-				mg: serviceAccount(),
+		},
+		"ReclaimDelete": {
+			sas: &fake.MockServiceAccountClient{MockDelete: func(_ context.Context, _ string) error {
+				return nil
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withReclaimPolicy(runtimev1alpha1.ReclaimDelete)),
+			},
+		},
+		"ReclaimRetain": {
+			sas: &fake.MockServiceAccountClient{MockDelete: func(_ context.Context, _ string) error {
+				t.Errorf("Delete(...): unexpected call with reclaim policy %q", runtimev1alpha1.ReclaimRetain)
+				return errorBoom
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(withReclaimPolicy(runtimev1alpha1.ReclaimRetain)),
 			},
 		},
 		"NotServiceAccount": {
@@ -747,33 +2219,35 @@ func TestDelete(t *testing.T) {
 				mg:  &strange{},
 			},
 			want: want{
-				mg:  &strange{},
 				err: errors.New(errNotServiceAccount),
 			},
 		},
 		"DeleteServiceAccountNotFound": {
-			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				r.Body.Close()
-				w.WriteHeader(http.StatusNotFound)
-			}),
+			sas: &fake.MockServiceAccountClient{MockDelete: func(_ context.Context, _ string) error {
+				return &googleapi.Error{Code: http.StatusNotFound}
+			}},
+			args: args{
+				ctx: context.Background(),
+				mg:  serviceAccount(),
+			},
+		},
+		"DeleteServiceAccountFailed": {
+			sas: &fake.MockServiceAccountClient{MockDelete: func(_ context.Context, _ string) error {
+				return errorBoom
+			}},
 			args: args{
 				ctx: context.Background(),
 				mg:  serviceAccount(),
 			},
 			want: want{
-				mg: serviceAccount(),
+				err: errors.Wrap(errorBoom, errDelete),
 			},
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			server := httptest.NewServer(tc.handler)
-			defer server.Close()
-			s, _ := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
-			serviceAccounts := iamv1.NewProjectsService(s).ServiceAccounts
-			rrn := NewRelativeResourceNamer("perfect-project")
-			e := &external{serviceAccounts: serviceAccounts, rrn: rrn}
+			e := &external{serviceAccounts: tc.sas, rrn: NewRelativeResourceNamer("perfect-project")}
 			err := e.Delete(tc.args.ctx, tc.args.mg)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -782,3 +2256,487 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarkDescription(t *testing.T) {
+	type want struct {
+		description string
+		marked      bool
+	}
+
+	cases := map[string]struct {
+		in   string
+		want want
+	}{
+		"MarkedEmpty": {
+			in:   markDescription(""),
+			want: want{description: "", marked: true},
+		},
+		"Marked": {
+			in:   markDescription(description),
+			want: want{description: description, marked: true},
+		},
+		"Unmarked": {
+			in:   description,
+			want: want{description: description, marked: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, marked := unmarkDescription(tc.in)
+			if diff := cmp.Diff(tc.want, want{description: d, marked: marked}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("unmarkDescription(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		changed  []string
+	}
+
+	policy := &v1alpha1.ServiceAccountPolicy{Bindings: []v1alpha1.ServiceAccountBinding{
+		{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com", "group:devs@example.com"}},
+		{Role: "roles/iam.workloadIdentityUser"},
+	}}
+
+	cases := map[string]struct {
+		in       *v1alpha1.ServiceAccountParameters
+		observed *iamv1.ServiceAccount
+		policy   *iamv1.Policy
+		want     want
+	}{
+		"UpToDate": {
+			in:       &v1alpha1.ServiceAccountParameters{DisplayName: &displayName, Description: &description},
+			observed: &iamv1.ServiceAccount{DisplayName: displayName, Description: markDescription(description)},
+			want:     want{upToDate: true},
+		},
+		"DisplayNameDiffers": {
+			in:       &v1alpha1.ServiceAccountParameters{DisplayName: &displayName},
+			observed: &iamv1.ServiceAccount{DisplayName: "other", Description: markDescription("")},
+			want:     want{changed: []string{"displayName"}},
+		},
+		"Unmarked": {
+			in:       &v1alpha1.ServiceAccountParameters{},
+			observed: &iamv1.ServiceAccount{},
+			want:     want{changed: []string{"description"}},
+		},
+		"DescriptionDiffers": {
+			in:       &v1alpha1.ServiceAccountParameters{Description: &description},
+			observed: &iamv1.ServiceAccount{Description: markDescription("other")},
+			want:     want{changed: []string{"description"}},
+		},
+		"DisabledDiffers": {
+			in:       &v1alpha1.ServiceAccountParameters{Disabled: gcp.BoolPtr(true)},
+			observed: &iamv1.ServiceAccount{Description: markDescription("")},
+			want:     want{changed: []string{"disabled"}},
+		},
+		"DisabledUpToDate": {
+			in:       &v1alpha1.ServiceAccountParameters{Disabled: gcp.BoolPtr(false)},
+			observed: &iamv1.ServiceAccount{Description: markDescription("")},
+			want:     want{upToDate: true},
+		},
+		"PolicyUpToDate": {
+			in:       &v1alpha1.ServiceAccountParameters{Policy: policy},
+			observed: &iamv1.ServiceAccount{Description: markDescription("")},
+			policy: &iamv1.Policy{Bindings: []*iamv1.Binding{
+				{Role: roleServiceAccountUser, Members: []string{"group:devs@example.com", "group:admins@example.com"}},
+			}},
+			want: want{upToDate: true},
+		},
+		"PolicyMemberMissing": {
+			in:       &v1alpha1.ServiceAccountParameters{Policy: policy},
+			observed: &iamv1.ServiceAccount{Description: markDescription("")},
+			policy: &iamv1.Policy{Bindings: []*iamv1.Binding{
+				{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com"}},
+			}},
+			want: want{changed: []string{"policy"}},
+		},
+		"PolicyConditionalBinding": {
+			in:       &v1alpha1.ServiceAccountParameters{Policy: policy},
+			observed: &iamv1.ServiceAccount{Description: markDescription("")},
+			policy: &iamv1.Policy{Bindings: []*iamv1.Binding{
+				{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com", "group:devs@example.com"}, Condition: &iamv1.Expr{Expression: "true"}},
+			}},
+			want: want{changed: []string{"policy"}},
+		},
+		"PolicyNotDeclared": {
+			in:       &v1alpha1.ServiceAccountParameters{},
+			observed: &iamv1.ServiceAccount{Description: markDescription("")},
+			want:     want{upToDate: true},
+		},
+		"PolicyNotSupplied": {
+			in:       &v1alpha1.ServiceAccountParameters{Policy: policy},
+			observed: &iamv1.ServiceAccount{Description: markDescription("")},
+			want:     want{upToDate: true},
+		},
+		"SeveralFieldsDiffer": {
+			in:       &v1alpha1.ServiceAccountParameters{DisplayName: &displayName, Description: &description, Disabled: gcp.BoolPtr(true)},
+			observed: &iamv1.ServiceAccount{DisplayName: "other", Description: markDescription(description)},
+			want:     want{changed: []string{"displayName", "disabled"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, changed := isUpToDate(tc.in, tc.observed, tc.policy)
+			if diff := cmp.Diff(tc.want, want{upToDate: upToDate, changed: changed}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateBindings(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.ServiceAccountPolicy
+		want []*iamv1.Binding
+	}{
+		"Empty": {
+			in:   &v1alpha1.ServiceAccountPolicy{},
+			want: []*iamv1.Binding{},
+		},
+		"MergedAndSorted": {
+			in: &v1alpha1.ServiceAccountPolicy{Bindings: []v1alpha1.ServiceAccountBinding{
+				{Role: "roles/iam.workloadIdentityUser", Members: []string{"serviceAccount:p.svc.id.goog[ns/ksa]"}},
+				{Role: roleServiceAccountUser, Members: []string{"group:devs@example.com"}},
+				{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com", "group:devs@example.com"}},
+			}},
+			want: []*iamv1.Binding{
+				{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com", "group:devs@example.com"}},
+				{Role: "roles/iam.workloadIdentityUser", Members: []string{"serviceAccount:p.svc.id.goog[ns/ksa]"}},
+			},
+		},
+		"EmptyBindingPruned": {
+			in: &v1alpha1.ServiceAccountPolicy{Bindings: []v1alpha1.ServiceAccountBinding{
+				{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com"}},
+				{Role: "roles/iam.workloadIdentityUser", Members: []string{}},
+			}},
+			want: []*iamv1.Binding{
+				{Role: roleServiceAccountUser, Members: []string{"group:admins@example.com"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, generateBindings(tc.in)); diff != "" {
+				t.Errorf("generateBindings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePatch(t *testing.T) {
+	type args struct {
+		in      *v1alpha1.ServiceAccountParameters
+		changed []string
+	}
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"AllFieldsChanged": {
+			args: args{in: &v1alpha1.ServiceAccountParameters{DisplayName: &displayName, Description: &description}, changed: []string{"displayName", "description"}},
+			want: []string{"description", "displayName"},
+		},
+		"NoFieldsSet": {
+			args: args{in: &v1alpha1.ServiceAccountParameters{}, changed: []string{"displayName", "description"}},
+			want: []string{"description", "displayName"},
+		},
+		"OnlyDescriptionChanged": {
+			args: args{in: &v1alpha1.ServiceAccountParameters{DisplayName: &displayName, Description: &description}, changed: []string{"description"}},
+			want: []string{"description"},
+		},
+		"OnlyImmutableFieldsChanged": {
+			args: args{in: &v1alpha1.ServiceAccountParameters{Disabled: gcp.BoolPtr(true)}, changed: []string{"disabled", "policy"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			psar := generatePatch(tc.args.in, tc.args.changed)
+			if tc.want == nil {
+				if psar != nil {
+					t.Errorf("generatePatch(...): want nil, got %+v", psar)
+				}
+				return
+			}
+			b, err := json.Marshal(psar.ServiceAccount)
+			if err != nil {
+				t.Fatalf("json.Marshal(...): %s", err)
+			}
+			body := map[string]interface{}{}
+			if err := json.Unmarshal(b, &body); err != nil {
+				t.Fatalf("json.Unmarshal(...): %s", err)
+			}
+			sent := make([]string, 0, len(body))
+			for k := range body {
+				sent = append(sent, k)
+			}
+			sort.Strings(sent)
+
+			mask := strings.Split(psar.UpdateMask, ",")
+			sort.Strings(mask)
+			if diff := cmp.Diff(tc.want, mask); diff != "" {
+				t.Errorf("generatePatch(...): -want update mask, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(mask, sent); diff != "" {
+				t.Errorf("generatePatch(...): -update mask, +fields sent:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordError(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	persistent := &v1alpha1.ReconcileError{Message: "cannot update: 400", Time: metav1.NewTime(now.Add(-time.Minute))}
+	unavailable := errors.Wrap(&googleapi.Error{Code: http.StatusServiceUnavailable, Body: "{}\n"}, errGet)
+
+	cases := map[string]struct {
+		last *v1alpha1.ReconcileError
+		err  error
+		want *v1alpha1.ReconcileError
+	}{
+		"NoError": {
+			last: persistent,
+			want: persistent,
+		},
+		"FirstError": {
+			err:  errorBoom,
+			want: &v1alpha1.ReconcileError{Message: errorBoom.Error(), Time: metav1.NewTime(now)},
+		},
+		"TransientKeepsRecentPersistent": {
+			last: persistent,
+			err:  unavailable,
+			want: persistent,
+		},
+		"TransientReplacesOldPersistent": {
+			last: &v1alpha1.ReconcileError{Message: "cannot update: 400", Time: metav1.NewTime(now.Add(-persistentErrorTTL))},
+			err:  unavailable,
+			want: &v1alpha1.ReconcileError{Message: unavailable.Error(), Time: metav1.NewTime(now), Transient: true},
+		},
+		"PersistentReplacesPersistent": {
+			last: persistent,
+			err:  errorBoom,
+			want: &v1alpha1.ReconcileError{Message: errorBoom.Error(), Time: metav1.NewTime(now)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &v1alpha1.ServiceAccountObservation{LastReconcileError: tc.last}
+			recordError(o, tc.err, now)
+			if diff := cmp.Diff(tc.want, o.LastReconcileError); diff != "" {
+				t.Errorf("recordError(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestErrorRecorder(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	last := &v1alpha1.ReconcileError{Message: "old", Time: metav1.NewTime(now.Add(-time.Hour))}
+	withLastError := func(e *v1alpha1.ReconcileError) valueModifier {
+		return func(sa *v1alpha1.ServiceAccount) { sa.Status.AtProvider.LastReconcileError = e }
+	}
+
+	cases := map[string]struct {
+		client managed.ExternalClient
+		call   func(r *errorRecorder, mg resource.Managed)
+		want   *v1alpha1.ReconcileError
+	}{
+		"ObserveUpToDateClears": {
+			client: &managed.ExternalClientFns{ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+			}},
+			call: func(r *errorRecorder, mg resource.Managed) { _, _ = r.Observe(context.Background(), mg) },
+		},
+		"ObserveNeedsUpdateKeeps": {
+			client: &managed.ExternalClientFns{ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: true}, nil
+			}},
+			call: func(r *errorRecorder, mg resource.Managed) { _, _ = r.Observe(context.Background(), mg) },
+			want: last,
+		},
+		"UpdateFailed": {
+			client: &managed.ExternalClientFns{UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+				return managed.ExternalUpdate{}, errors.Wrap(errorBoom, errUpdate)
+			}},
+			call: func(r *errorRecorder, mg resource.Managed) { _, _ = r.Update(context.Background(), mg) },
+			want: &v1alpha1.ReconcileError{Message: errors.Wrap(errorBoom, errUpdate).Error(), Time: metav1.NewTime(now)},
+		},
+		"DeleteFailed": {
+			client: &managed.ExternalClientFns{DeleteFn: func(_ context.Context, _ resource.Managed) error {
+				return errors.Wrap(errorBoom, errDelete)
+			}},
+			call: func(r *errorRecorder, mg resource.Managed) { _ = r.Delete(context.Background(), mg) },
+			want: &v1alpha1.ReconcileError{Message: errors.Wrap(errorBoom, errDelete).Error(), Time: metav1.NewTime(now)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := serviceAccount(withLastError(last))
+			tc.call(&errorRecorder{ExternalClient: tc.client, now: func() time.Time { return now }}, cr)
+			if diff := cmp.Diff(tc.want, cr.Status.AtProvider.LastReconcileError); diff != "" {
+				t.Errorf("errorRecorder: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	provider := gcpv1alpha3.Provider{
+		ObjectMeta: metav1.ObjectMeta{Name: providerName},
+		Spec: gcpv1alpha3.ProviderSpec{
+			ProjectID: "perfect-project",
+			ProviderSpec: runtimev1alpha1.ProviderSpec{
+				CredentialsSecretRef: &runtimev1alpha1.SecretKeySelector{
+					SecretReference: runtimev1alpha1.SecretReference{Namespace: namespace, Name: providerSecretName},
+					Key:             providerSecretKey,
+				},
+			},
+		},
+	}
+	kube := &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+		switch o := obj.(type) {
+		case *gcpv1alpha3.Provider:
+			*o = provider
+		case *corev1.Secret:
+			o.Data = map[string][]byte{providerSecretKey: []byte(providerSecretData)}
+		}
+		return nil
+	}}
+	mutated := func(method string) error {
+		t.Errorf("unexpected %s call in dry run mode", method)
+		return errorBoom
+	}
+	sas := &fake.MockServiceAccountClient{
+		MockGet: func(_ context.Context, _ string) (*iamv1.ServiceAccount, error) {
+			return &iamv1.ServiceAccount{Name: saResourceName, DisplayName: "old", Description: markDescription("")}, nil
+		},
+		MockCreate: func(_ context.Context, _ string, _ *iamv1.CreateServiceAccountRequest) (*iamv1.ServiceAccount, error) {
+			return nil, mutated("Create")
+		},
+		MockPatch: func(_ context.Context, _ string, _ *iamv1.PatchServiceAccountRequest) (*iamv1.ServiceAccount, error) {
+			return nil, mutated("Patch")
+		},
+		MockDelete:  func(_ context.Context, _ string) error { return mutated("Delete") },
+		MockDisable: func(_ context.Context, _ string) error { return mutated("Disable") },
+		MockEnable:  func(_ context.Context, _ string) error { return mutated("Enable") },
+		MockSetIamPolicy: func(_ context.Context, _ string, _ *iamv1.Policy) (*iamv1.Policy, error) {
+			return nil, mutated("SetIamPolicy")
+		},
+	}
+	c := &connecter{
+		client: kube,
+		newSAS: func(_ context.Context, _ ...option.ClientOption) (gcpiam.ServiceAccountClient, error) {
+			return sas, nil
+		},
+		newCRM: func(_ context.Context, _ ...option.ClientOption) (*crm.Service, error) {
+			return nil, mutated("Resource Manager")
+		},
+	}
+	withDryRun := func(sa *v1alpha1.ServiceAccount) {
+		meta.AddAnnotations(sa, map[string]string{gcp.AnnotationKeyDryRun: "true"})
+	}
+	plan := fmt.Sprintf("would patch service account %s with update mask %q; changed fields: displayName, disabled",
+		saResourceName, "displayName")
+
+	cases := map[string]struct {
+		call func(e managed.ExternalClient, mg resource.Managed) error
+		want runtimev1alpha1.Condition
+	}{
+		"Create": {
+			call: func(e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: gcp.PlannedChange(gcp.ReasonWouldCreate, fmt.Sprintf("would create external resource %q", metadataName)),
+		},
+		"Update": {
+			call: func(e managed.ExternalClient, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: gcp.PlannedChange(gcp.ReasonWouldUpdate, plan),
+		},
+		"Delete": {
+			call: func(e managed.ExternalClient, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: gcp.PlannedChange(gcp.ReasonWouldDelete, fmt.Sprintf("would delete external resource %q", metadataName)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := serviceAccount(withExternalNameAnnotation(metadataName), withDisabledParameter(true), withDryRun)
+			e, err := c.Connect(context.Background(), cr)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			if err := tc.call(e, cr); err != nil {
+				t.Errorf("%s(...): unexpected error: %s", name, err)
+			}
+			if diff := cmp.Diff(tc.want, cr.GetCondition(gcp.TypeDryRun), test.EquateConditions()); diff != "" {
+				t.Errorf("%s(...): -want condition, +got condition:\n%s", name, diff)
+			}
+		})
+	}
+}
+
+// debugLogger records the structured data of the messages it logs at debug
+// level, including that supplied to WithValues.
+type debugLogger struct {
+	logging.Logger
+	values  []interface{}
+	entries *[]map[string]interface{}
+}
+
+func (l *debugLogger) Debug(_ string, keysAndValues ...interface{}) {
+	e := map[string]interface{}{}
+	kv := append(append([]interface{}{}, l.values...), keysAndValues...)
+	for i := 0; i+1 < len(kv); i += 2 {
+		e[kv[i].(string)] = kv[i+1]
+	}
+	*l.entries = append(*l.entries, e)
+}
+
+func (l *debugLogger) WithValues(keysAndValues ...interface{}) logging.Logger {
+	return &debugLogger{values: append(append([]interface{}{}, l.values...), keysAndValues...), entries: l.entries}
+}
+
+func TestLogDiff(t *testing.T) {
+	in := &v1alpha1.ServiceAccountParameters{
+		DisplayName: gcp.StringPtr("new name"),
+		Description: gcp.StringPtr("new description"),
+		Disabled:    gcp.BoolPtr(true),
+		Policy: &v1alpha1.ServiceAccountPolicy{Bindings: []v1alpha1.ServiceAccountBinding{
+			{Role: "roles/iam.serviceAccountUser", Members: []string{"user:new@example.org"}},
+		}},
+	}
+	observed := &iamv1.ServiceAccount{DisplayName: "old name", Description: "old description"}
+	policy := &iamv1.Policy{Bindings: []*iamv1.Binding{{Role: "roles/iam.serviceAccountUser", Members: []string{"user:old@example.org"}}}}
+	_, changed := isUpToDate(in, observed, policy)
+
+	var got []map[string]interface{}
+	logDiff(&debugLogger{entries: &got}, in, observed, policy, changed)
+
+	want := []map[string]interface{}{
+		{"field": "displayName", "observed": "old name", "desired": "new name"},
+		{"field": "description", "observed": "old description", "desired": "new description", "marked": false},
+		{"field": "disabled", "observed": false, "desired": true},
+		{
+			"field":    "policy",
+			"observed": map[string][]string{"roles/iam.serviceAccountUser": {"user:old@example.org"}},
+			"desired":  map[string][]string{"roles/iam.serviceAccountUser": {"user:new@example.org"}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("logDiff(...): -want, +got:\n%s", diff)
+	}
+}
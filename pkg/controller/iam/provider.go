@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+// Error strings.
+const (
+	errListProviders = "cannot list Providers"
+	errNoProviders   = "no Provider matches the shard selector"
+)
+
+// A ProviderSelectorFn returns a reference to the Provider whose credentials
+// should be used to reconcile the supplied managed resource. It allows an
+// operator to shard managed resources across the credentials of several
+// Providers, for example one per organization, without changing the
+// resources themselves.
+type ProviderSelectorFn func(ctx context.Context, kube client.Client, mg resource.Managed) (*corev1.ObjectReference, error)
+
+// ShardByLabels returns a ProviderSelectorFn that selects one of the
+// Providers matching the supplied label selector. Each managed resource is
+// consistently assigned the same Provider by hashing its name, for as long as
+// the set of matching Providers does not change.
+func ShardByLabels(sel labels.Selector) ProviderSelectorFn {
+	return func(ctx context.Context, kube client.Client, mg resource.Managed) (*corev1.ObjectReference, error) {
+		l := &gcpv1alpha3.ProviderList{}
+		if err := kube.List(ctx, l, client.MatchingLabelsSelector{Selector: sel}); err != nil {
+			return nil, errors.Wrap(err, errListProviders)
+		}
+		if len(l.Items) == 0 {
+			return nil, errors.New(errNoProviders)
+		}
+
+		names := make([]string, len(l.Items))
+		for i := range l.Items {
+			names[i] = l.Items[i].GetName()
+		}
+		sort.Strings(names)
+
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(mg.GetName()))
+		return &corev1.ObjectReference{Name: names[h.Sum32()%uint32(len(names))]}, nil
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+)
+
+func TestShardByLabels(t *testing.T) {
+	sel := labels.SelectorFromSet(labels.Set{"shard": "iam"})
+	listProviders := func(names ...string) test.MockListFn {
+		return func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+			l := obj.(*gcpv1alpha3.ProviderList)
+			for _, n := range names {
+				l.Items = append(l.Items, gcpv1alpha3.Provider{ObjectMeta: metav1.ObjectMeta{Name: n}})
+			}
+			return nil
+		}
+	}
+	named := func(n string) resource.Managed {
+		mg := &fake.Managed{}
+		mg.SetName(n)
+		return mg
+	}
+
+	type want struct {
+		ref *corev1.ObjectReference
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		mg   resource.Managed
+		want want
+	}{
+		"SingleProvider": {
+			kube: &test.MockClient{MockList: listProviders("only")},
+			mg:   named(metadataName),
+			want: want{ref: &corev1.ObjectReference{Name: "only"}},
+		},
+		"ListOrderIsIrrelevant": {
+			kube: &test.MockClient{MockList: listProviders("c", "a", "b")},
+			mg:   named(metadataName),
+			want: want{ref: &corev1.ObjectReference{Name: "c"}},
+		},
+		"NoProviders": {
+			kube: &test.MockClient{MockList: listProviders()},
+			mg:   named(metadataName),
+			want: want{err: errors.New(errNoProviders)},
+		},
+		"ListFailed": {
+			kube: &test.MockClient{MockList: test.NewMockListFn(errorBoom)},
+			mg:   named(metadataName),
+			want: want{err: errors.Wrap(errorBoom, errListProviders)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ref, err := ShardByLabels(sel)(context.Background(), tc.kube, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ShardByLabels(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ref, ref); diff != "" {
+				t.Errorf("ShardByLabels(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
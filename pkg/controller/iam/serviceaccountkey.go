@@ -0,0 +1,367 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/base64"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	iamv1 "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/connection"
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// Error strings.
+const (
+	errNotServiceAccountKey = "managed resource is not a GCP ServiceAccountKey"
+	errGetKey               = "cannot get GCP ServiceAccount key via IAM API"
+	errCreateKey            = "cannot create GCP ServiceAccount key via IAM API"
+	errDecodeKey            = "cannot decode the private key of GCP ServiceAccount key"
+	errUpdateKeyCR          = "cannot update ServiceAccountKey custom resource"
+	errUpdateKeyStatus      = "cannot record retired key in ServiceAccountKey status"
+	errPrivateKeyType       = "unsupported private key type %q; expected TYPE_GOOGLE_CREDENTIALS_FILE or TYPE_PKCS12_FILE"
+	errDeleteRetiredKey     = "cannot delete retired GCP ServiceAccount key %s via IAM API"
+)
+
+// pkcs12Passphrase is the passphrase GCP protects every PKCS #12 key with.
+const pkcs12Passphrase = "notasecret"
+
+// defaultKeyGracePeriod is how long a key that was replaced by a rotation
+// remains valid if its rotation policy specifies no grace period.
+const defaultKeyGracePeriod = 1 * time.Hour
+
+// SetupServiceAccountKey adds a controller that reconciles
+// ServiceAccountKeys.
+func SetupServiceAccountKey(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ServiceAccountKeyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ServiceAccountKey{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceAccountKeyGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&keyConnecter{client: mgr.GetClient(), newKeys: newServiceAccountKeysAPI})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			// The ID of a key is chosen by GCP, so the external name is set
+			// when the key is created rather than defaulted to the name of
+			// the ServiceAccountKey.
+			managed.WithInitializers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type keyConnecter struct {
+	client  client.Client
+	newKeys func(ctx context.Context, opts ...option.ClientOption) (*iamv1.ProjectsServiceAccountsKeysService, error)
+}
+
+// Connect sets up iam client using credentials from the provider
+func (c *keyConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountKey)
+	if !ok {
+		return nil, errors.New(errNotServiceAccountKey)
+	}
+
+	opts, _, err := clientOptions(ctx, c.client, cr.Spec.ProviderReference, gcp.APIIAM)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := c.newKeys(ctx, opts...)
+	return &keyExternal{kube: c.client, keys: keys, now: time.Now}, errors.Wrap(err, errNewClient)
+}
+
+type keyExternal struct {
+	kube client.Client
+	keys *iamv1.ProjectsServiceAccountsKeysService
+	now  func() time.Time
+}
+
+// Observe reports whether the key still exists. GCP returns the private key
+// only when a key is created, so Observe returns no connection details; the
+// connection secret keeps the private key written by Create or Update. A key
+// is not up to date once it is due to be rotated, or once a key it replaced
+// is due to be deleted.
+func (e *keyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceAccountKey)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	k, err := e.keys.Get(keyName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetKey)
+	}
+
+	retired := cr.Status.AtProvider.RetiredKeys
+	cr.Status.AtProvider = generateKeyObservation(k)
+	cr.Status.AtProvider.RetiredKeys = retired
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	if cr.Spec.ForProvider.RotationPolicy == nil && len(retired) == 0 {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	now := e.now()
+	u := !rotationDue(cr, now) && len(expiredKeys(retired, now)) == 0
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: u}, nil
+}
+
+// Create creates a key and returns its private key as connection details.
+// The ID of the new key is recorded as the external name.
+func (e *keyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceAccountKey)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	conn, err := e.createKey(ctx, cr)
+	return managed.ExternalCreation{ConnectionDetails: conn}, err
+}
+
+// Update rotates a key that is due to be rotated, and deletes the keys it
+// replaced once their grace period has passed. The new key's private key is
+// only returned by this Update, so the keys it replaced are deleted by a
+// later one; an error deleting them must not prevent the new private key
+// from being written to the connection secret.
+func (e *keyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceAccountKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceAccountKey)
+	}
+	now := e.now()
+
+	if rotationDue(cr, now) {
+		// The replaced key remains a valid credential until it is deleted,
+		// so it is recorded as retired before its replacement is created.
+		// Otherwise it would never be deleted if the status written after
+		// this Update were lost.
+		current := cr.Status.AtProvider.Name
+		if !isRetired(cr.Status.AtProvider.RetiredKeys, current) {
+			cr.Status.AtProvider.RetiredKeys = append(cr.Status.AtProvider.RetiredKeys, v1alpha1.RetiredServiceAccountKey{
+				Name:       current,
+				DeleteTime: metav1.NewTime(now.Add(gracePeriod(cr))),
+			})
+			if err := e.kube.Status().Update(ctx, cr); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateKeyStatus)
+			}
+		}
+		conn, err := e.createKey(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		return managed.ExternalUpdate{ConnectionDetails: conn}, nil
+	}
+
+	expired := expiredKeys(cr.Status.AtProvider.RetiredKeys, now)
+	for _, k := range expired {
+		// A key whose replacement could not be created is still in use, so
+		// it is no longer considered retired rather than deleted.
+		if k != cr.Status.AtProvider.Name {
+			_, err := e.keys.Delete(k).Context(ctx).Do()
+			if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+				return managed.ExternalUpdate{}, errors.Wrapf(err, errDeleteRetiredKey, k)
+			}
+		}
+		cr.Status.AtProvider.RetiredKeys = withoutKey(cr.Status.AtProvider.RetiredKeys, k)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete deletes the key, and any keys it replaced. A key that no longer
+// exists is considered deleted.
+func (e *keyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceAccountKey)
+	if !ok {
+		return errors.New(errNotServiceAccountKey)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	for _, k := range cr.Status.AtProvider.RetiredKeys {
+		_, err := e.keys.Delete(k.Name).Context(ctx).Do()
+		if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+			return errors.Wrapf(err, errDeleteRetiredKey, k.Name)
+		}
+		cr.Status.AtProvider.RetiredKeys = withoutKey(cr.Status.AtProvider.RetiredKeys, k.Name)
+	}
+
+	_, err := e.keys.Delete(keyName(cr)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteKey)
+}
+
+// createKey creates a new key for the supplied ServiceAccountKey, records its
+// ID as the external name, and returns its private key as connection details.
+func (e *keyExternal) createKey(ctx context.Context, cr *v1alpha1.ServiceAccountKey) (managed.ConnectionDetails, error) {
+	// The private key is only returned once, so a key whose private key
+	// could not be written to the connection secret must not be created.
+	keyType := privateKeyType(cr)
+	if keyType != v1alpha1.PrivateKeyTypeGoogleCredentialsFile && keyType != v1alpha1.PrivateKeyTypePKCS12File {
+		return nil, errors.Errorf(errPrivateKeyType, keyType)
+	}
+
+	req := &iamv1.CreateServiceAccountKeyRequest{
+		KeyAlgorithm:   gcp.StringValue(cr.Spec.ForProvider.KeyAlgorithm),
+		PrivateKeyType: keyType,
+	}
+	k, err := e.keys.Create(serviceAccountName(cr), req).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateKey)
+	}
+	key, err := base64.StdEncoding.DecodeString(k.PrivateKeyData)
+	if err != nil {
+		return nil, errors.Wrap(err, errDecodeKey)
+	}
+
+	// The key cannot be observed, nor its private key retrieved, without
+	// its ID. If recording the ID fails the key is orphaned and a new one
+	// is created on the next reconcile.
+	retired := cr.Status.AtProvider.RetiredKeys
+	meta.SetExternalName(cr, path.Base(k.Name))
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errUpdateKeyCR)
+	}
+	cr.Status.AtProvider = generateKeyObservation(k)
+	cr.Status.AtProvider.RetiredKeys = retired
+
+	return keyConnectionDetails(keyType, key), nil
+}
+
+// rotationDue returns true if the key of the supplied ServiceAccountKey has
+// reached the maximum age of its rotation policy at the supplied time.
+func rotationDue(cr *v1alpha1.ServiceAccountKey, now time.Time) bool {
+	p := cr.Spec.ForProvider.RotationPolicy
+	if p == nil {
+		return false
+	}
+	created, err := time.Parse(time.RFC3339, cr.Status.AtProvider.ValidAfterTime)
+	if err != nil {
+		return false
+	}
+	return !now.Before(created.Add(p.MaxAge.Duration))
+}
+
+// gracePeriod returns how long the keys that are replaced by a rotation of
+// the supplied ServiceAccountKey remain valid.
+func gracePeriod(cr *v1alpha1.ServiceAccountKey) time.Duration {
+	p := cr.Spec.ForProvider.RotationPolicy
+	if p == nil || p.GracePeriod == nil {
+		return defaultKeyGracePeriod
+	}
+	return p.GracePeriod.Duration
+}
+
+// expiredKeys returns the names of the supplied retired keys whose grace
+// period has passed at the supplied time.
+func expiredKeys(retired []v1alpha1.RetiredServiceAccountKey, now time.Time) []string {
+	var expired []string
+	for _, k := range retired {
+		if !now.Before(k.DeleteTime.Time) {
+			expired = append(expired, k.Name)
+		}
+	}
+	return expired
+}
+
+// isRetired returns true if the named key is one of the supplied retired keys.
+func isRetired(retired []v1alpha1.RetiredServiceAccountKey, name string) bool {
+	for _, k := range retired {
+		if k.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutKey returns the supplied retired keys, less the named key.
+func withoutKey(retired []v1alpha1.RetiredServiceAccountKey, name string) []v1alpha1.RetiredServiceAccountKey {
+	var out []v1alpha1.RetiredServiceAccountKey
+	for _, k := range retired {
+		if k.Name != name {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// privateKeyType returns the private key type of the supplied
+// ServiceAccountKey, defaulting to a JSON key file.
+func privateKeyType(cr *v1alpha1.ServiceAccountKey) string {
+	if cr.Spec.ForProvider.PrivateKeyType == nil {
+		return v1alpha1.PrivateKeyTypeGoogleCredentialsFile
+	}
+	return *cr.Spec.ForProvider.PrivateKeyType
+}
+
+// keyConnectionDetails returns the connection details of a private key of the
+// supplied type.
+func keyConnectionDetails(keyType string, key []byte) managed.ConnectionDetails {
+	if keyType == v1alpha1.PrivateKeyTypePKCS12File {
+		return managed.ConnectionDetails{
+			connection.KeyPKCS12:           key,
+			connection.KeyPKCS12Passphrase: []byte(pkcs12Passphrase),
+		}
+	}
+	return managed.ConnectionDetails{connection.KeyCredentials: key}
+}
+
+// serviceAccountName returns the resource name of the service account of the
+// supplied ServiceAccountKey. The project is inferred by the IAM API from the
+// service account's email or unique ID.
+func serviceAccountName(cr *v1alpha1.ServiceAccountKey) string {
+	sa := gcp.StringValue(cr.Spec.ForProvider.ServiceAccount)
+	if strings.HasPrefix(sa, "projects/") {
+		return sa
+	}
+	return "projects/-/serviceAccounts/" + sa
+}
+
+// keyName returns the resource name of the supplied ServiceAccountKey.
+func keyName(cr *v1alpha1.ServiceAccountKey) string {
+	return serviceAccountName(cr) + "/keys/" + meta.GetExternalName(cr)
+}
+
+func generateKeyObservation(k *iamv1.ServiceAccountKey) v1alpha1.ServiceAccountKeyObservation {
+	return v1alpha1.ServiceAccountKeyObservation{
+		Name:            k.Name,
+		KeyOrigin:       k.KeyOrigin,
+		KeyType:         k.KeyType,
+		ValidAfterTime:  k.ValidAfterTime,
+		ValidBeforeTime: k.ValidBeforeTime,
+	}
+}
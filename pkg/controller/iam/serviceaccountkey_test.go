@@ -0,0 +1,827 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	iamv1 "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/connection"
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	keyID          = "0123456789abcdef"
+	keyPath        = keysPath + "/" + keyID
+	keyCredentials = `{"type": "service_account"}`
+
+	rotatedKeyID   = "fedcba9876543210"
+	rotatedKeyPath = keysPath + "/" + rotatedKeyID
+)
+
+// keyNow is 30 days after the ValidAfterTime of observedKey.
+var keyNow = time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)
+
+var (
+	_ managed.ExternalConnecter = &keyConnecter{}
+	_ managed.ExternalClient    = &keyExternal{}
+)
+
+type keyModifier func(*v1alpha1.ServiceAccountKey)
+
+func withKeyID(id string) keyModifier {
+	return func(k *v1alpha1.ServiceAccountKey) { meta.SetExternalName(k, id) }
+}
+
+func withKeyObservation(o v1alpha1.ServiceAccountKeyObservation) keyModifier {
+	return func(k *v1alpha1.ServiceAccountKey) { k.Status.AtProvider = o }
+}
+
+func withPrivateKeyType(t string) keyModifier {
+	return func(k *v1alpha1.ServiceAccountKey) { k.Spec.ForProvider.PrivateKeyType = &t }
+}
+
+func withRotationPolicy(maxAge, grace time.Duration) keyModifier {
+	return func(k *v1alpha1.ServiceAccountKey) {
+		k.Spec.ForProvider.RotationPolicy = &v1alpha1.ServiceAccountKeyRotationPolicy{
+			MaxAge:      metav1.Duration{Duration: maxAge},
+			GracePeriod: &metav1.Duration{Duration: grace},
+		}
+	}
+}
+
+func withRetiredKeys(r ...v1alpha1.RetiredServiceAccountKey) keyModifier {
+	return func(k *v1alpha1.ServiceAccountKey) { k.Status.AtProvider.RetiredKeys = r }
+}
+
+func retiredKey(deleteTime time.Time) v1alpha1.RetiredServiceAccountKey {
+	return v1alpha1.RetiredServiceAccountKey{Name: observedKey().Name, DeleteTime: metav1.NewTime(deleteTime)}
+}
+
+func withKeyConditions(c ...runtimev1alpha1.Condition) keyModifier {
+	return func(k *v1alpha1.ServiceAccountKey) { k.Status.SetConditions(c...) }
+}
+
+func serviceAccountKey(m ...keyModifier) *v1alpha1.ServiceAccountKey {
+	k := &v1alpha1.ServiceAccountKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-compute-key"},
+		Spec: v1alpha1.ServiceAccountKeySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ServiceAccountKeyParameters{
+				ServiceAccount: gcp.StringPtr(defaultComputeEmail),
+				KeyAlgorithm:   gcp.StringPtr(v1alpha1.KeyAlgorithmRSA2048),
+			},
+		},
+	}
+	for _, f := range m {
+		f(k)
+	}
+	return k
+}
+
+func observedKey() *iamv1.ServiceAccountKey {
+	return &iamv1.ServiceAccountKey{
+		Name:            "projects/example/serviceAccounts/" + defaultComputeEmail + "/keys/" + keyID,
+		KeyAlgorithm:    v1alpha1.KeyAlgorithmRSA2048,
+		KeyOrigin:       "GOOGLE_PROVIDED",
+		KeyType:         keyTypeUserManaged,
+		ValidAfterTime:  "2020-06-01T00:00:00Z",
+		ValidBeforeTime: "9999-12-31T23:59:59Z",
+	}
+}
+
+// rotatedKey is the key that replaces observedKey when it is rotated at
+// keyNow.
+func rotatedKey() *iamv1.ServiceAccountKey {
+	k := observedKey()
+	k.Name = "projects/example/serviceAccounts/" + defaultComputeEmail + "/keys/" + rotatedKeyID
+	k.ValidAfterTime = keyNow.Format(time.RFC3339)
+	return k
+}
+
+func rotatedKeyObservation() v1alpha1.ServiceAccountKeyObservation {
+	k := rotatedKey()
+	return v1alpha1.ServiceAccountKeyObservation{
+		Name:            k.Name,
+		KeyOrigin:       k.KeyOrigin,
+		KeyType:         k.KeyType,
+		ValidAfterTime:  k.ValidAfterTime,
+		ValidBeforeTime: k.ValidBeforeTime,
+	}
+}
+
+func keyObservation() v1alpha1.ServiceAccountKeyObservation {
+	k := observedKey()
+	return v1alpha1.ServiceAccountKeyObservation{
+		Name:            k.Name,
+		KeyOrigin:       k.KeyOrigin,
+		KeyType:         k.KeyType,
+		ValidAfterTime:  k.ValidAfterTime,
+		ValidBeforeTime: k.ValidBeforeTime,
+	}
+}
+
+func keyService(t *testing.T, h http.Handler) (*iamv1.ProjectsServiceAccountsKeysService, func()) {
+	t.Helper()
+	server := httptest.NewServer(h)
+	s, err := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return iamv1.NewProjectsServiceAccountsKeysService(s), server.Close
+}
+
+func TestServiceAccountKeyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotCreated": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			mg: serviceAccountKey(),
+			want: want{
+				mg: serviceAccountKey(),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccountKey{})
+			}),
+			mg: serviceAccountKey(withKeyID(keyID)),
+			want: want{
+				mg: serviceAccountKey(withKeyID(keyID)),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccountKey{})
+			}),
+			mg: serviceAccountKey(withKeyID(keyID)),
+			want: want{
+				mg:  serviceAccountKey(withKeyID(keyID)),
+				err: errors.Wrap(&googleapi.Error{Code: http.StatusForbidden, Body: "{}\n"}, errGetKey),
+			},
+		},
+		"Exists": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+keyPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(observedKey())
+			}),
+			mg: serviceAccountKey(withKeyID(keyID)),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(keyID),
+					withKeyObservation(keyObservation()),
+					withKeyConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RotationNotDue": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedKey())
+			}),
+			mg: serviceAccountKey(withKeyID(keyID), withRotationPolicy(31*24*time.Hour, time.Hour)),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(keyID),
+					withRotationPolicy(31*24*time.Hour, time.Hour),
+					withKeyObservation(keyObservation()),
+					withKeyConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RotationDue": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedKey())
+			}),
+			mg: serviceAccountKey(withKeyID(keyID), withRotationPolicy(30*24*time.Hour, time.Hour)),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(keyID),
+					withRotationPolicy(30*24*time.Hour, time.Hour),
+					withKeyObservation(keyObservation()),
+					withKeyConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RetiredKeyInGracePeriod": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedKey())
+			}),
+			mg: serviceAccountKey(withKeyID(keyID), withRetiredKeys(retiredKey(keyNow.Add(time.Minute)))),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(keyID),
+					withKeyObservation(keyObservation()),
+					withRetiredKeys(retiredKey(keyNow.Add(time.Minute))),
+					withKeyConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RetiredKeyExpired": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedKey())
+			}),
+			mg: serviceAccountKey(withKeyID(keyID), withRetiredKeys(retiredKey(keyNow))),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(keyID),
+					withKeyObservation(keyObservation()),
+					withRetiredKeys(retiredKey(keyNow)),
+					withKeyConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			keys, done := keyService(t, tc.handler)
+			defer done()
+			e := keyExternal{keys: keys, now: func() time.Time { return keyNow }}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountKeyCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		cre managed.ExternalCreation
+		err error
+	}
+
+	created := observedKey()
+	created.PrivateKeyData = base64.StdEncoding.EncodeToString([]byte(keyCredentials))
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost+" "+keysPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				req := &iamv1.CreateServiceAccountKeyRequest{}
+				_ = json.NewDecoder(r.Body).Decode(req)
+				want := &iamv1.CreateServiceAccountKeyRequest{KeyAlgorithm: v1alpha1.KeyAlgorithmRSA2048, PrivateKeyType: v1alpha1.PrivateKeyTypeGoogleCredentialsFile}
+				if diff := cmp.Diff(want, req); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(created)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   serviceAccountKey(),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(keyID),
+					withKeyObservation(keyObservation()),
+					withKeyConditions(runtimev1alpha1.Creating())),
+				cre: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					"credentials.json": []byte(keyCredentials),
+				}},
+			},
+		},
+		"PKCS12": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				req := &iamv1.CreateServiceAccountKeyRequest{}
+				_ = json.NewDecoder(r.Body).Decode(req)
+				want := &iamv1.CreateServiceAccountKeyRequest{KeyAlgorithm: v1alpha1.KeyAlgorithmRSA2048, PrivateKeyType: v1alpha1.PrivateKeyTypePKCS12File}
+				if diff := cmp.Diff(want, req); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				k := observedKey()
+				k.PrivateKeyData = base64.StdEncoding.EncodeToString([]byte("p12"))
+				_ = json.NewEncoder(w).Encode(k)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   serviceAccountKey(withPrivateKeyType(v1alpha1.PrivateKeyTypePKCS12File)),
+			want: want{
+				mg: serviceAccountKey(
+					withPrivateKeyType(v1alpha1.PrivateKeyTypePKCS12File),
+					withKeyID(keyID),
+					withKeyObservation(keyObservation()),
+					withKeyConditions(runtimev1alpha1.Creating())),
+				cre: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					"key.p12":                      []byte("p12"),
+					connection.KeyPKCS12Passphrase: []byte("notasecret"),
+				}},
+			},
+		},
+		"UnsupportedPrivateKeyType": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			mg: serviceAccountKey(withPrivateKeyType("TYPE_UNSPECIFIED")),
+			want: want{
+				mg: serviceAccountKey(
+					withPrivateKeyType("TYPE_UNSPECIFIED"),
+					withKeyConditions(runtimev1alpha1.Creating())),
+				err: errors.Errorf(errPrivateKeyType, "TYPE_UNSPECIFIED"),
+			},
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccountKey{})
+			}),
+			mg: serviceAccountKey(),
+			want: want{
+				mg:  serviceAccountKey(withKeyConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(&googleapi.Error{Code: http.StatusForbidden, Body: "{}\n"}, errCreateKey),
+			},
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(created)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errorBoom)},
+			mg:   serviceAccountKey(),
+			want: want{
+				mg:  serviceAccountKey(withKeyID(keyID), withKeyConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errorBoom, errUpdateKeyCR),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			keys, done := keyService(t, tc.handler)
+			defer done()
+			e := keyExternal{kube: tc.kube, keys: keys}
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountKeyUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+
+	rotated := rotatedKey()
+	rotated.PrivateKeyData = base64.StdEncoding.EncodeToString([]byte(keyCredentials))
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"RotationNotDue": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			mg: serviceAccountKey(withKeyID(keyID), withRotationPolicy(31*24*time.Hour, time.Hour), withKeyObservation(keyObservation())),
+			want: want{
+				mg: serviceAccountKey(withKeyID(keyID), withRotationPolicy(31*24*time.Hour, time.Hour), withKeyObservation(keyObservation())),
+			},
+		},
+		"Rotated": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" "+keysPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(rotated)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil), MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
+			mg:   serviceAccountKey(withKeyID(keyID), withRotationPolicy(30*24*time.Hour, 30*time.Minute), withKeyObservation(keyObservation())),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(rotatedKeyID),
+					withRotationPolicy(30*24*time.Hour, 30*time.Minute),
+					withKeyObservation(rotatedKeyObservation()),
+					withRetiredKeys(retiredKey(keyNow.Add(30*time.Minute)))),
+				upd: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					connection.KeyCredentials: []byte(keyCredentials),
+				}},
+			},
+		},
+		"RotationFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(&iamv1.ServiceAccountKey{})
+			}),
+			kube: &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
+			mg:   serviceAccountKey(withKeyID(keyID), withRotationPolicy(30*24*time.Hour, time.Hour), withKeyObservation(keyObservation())),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(keyID),
+					withRotationPolicy(30*24*time.Hour, time.Hour),
+					withKeyObservation(keyObservation()),
+					withRetiredKeys(retiredKey(keyNow.Add(time.Hour)))),
+				err: errors.Wrap(&googleapi.Error{Code: http.StatusForbidden, Body: "{}\n"}, errCreateKey),
+			},
+		},
+		"RotationRetried": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(rotated)
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+				MockStatusUpdate: func(_ context.Context, _ runtime.Object, _ ...client.UpdateOption) error {
+					t.Errorf("unexpected status update: the replaced key is already retired")
+					return nil
+				},
+			},
+			mg: serviceAccountKey(
+				withKeyID(keyID),
+				withRotationPolicy(30*24*time.Hour, time.Hour),
+				withKeyObservation(keyObservation()),
+				withRetiredKeys(retiredKey(keyNow))),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(rotatedKeyID),
+					withRotationPolicy(30*24*time.Hour, time.Hour),
+					withKeyObservation(rotatedKeyObservation()),
+					withRetiredKeys(retiredKey(keyNow))),
+				upd: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					connection.KeyCredentials: []byte(keyCredentials),
+				}},
+			},
+		},
+		"RecordRetiredFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			kube: &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(errorBoom)},
+			mg:   serviceAccountKey(withKeyID(keyID), withRotationPolicy(30*24*time.Hour, time.Hour), withKeyObservation(keyObservation())),
+			want: want{
+				mg: serviceAccountKey(
+					withKeyID(keyID),
+					withRotationPolicy(30*24*time.Hour, time.Hour),
+					withKeyObservation(keyObservation()),
+					withRetiredKeys(retiredKey(keyNow.Add(time.Hour)))),
+				err: errors.Wrap(errorBoom, errUpdateKeyStatus),
+			},
+		},
+		"CurrentKeyNotRetired": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			mg: serviceAccountKey(withKeyID(keyID), withKeyObservation(keyObservation()), withRetiredKeys(retiredKey(keyNow))),
+			want: want{
+				mg: serviceAccountKey(withKeyID(keyID), withKeyObservation(keyObservation())),
+			},
+		},
+		"RetiredKeyDeleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete+" /v1/"+observedKey().Name, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+			}),
+			mg: serviceAccountKey(withKeyID(rotatedKeyID), withKeyObservation(rotatedKeyObservation()), withRetiredKeys(retiredKey(keyNow))),
+			want: want{
+				mg: serviceAccountKey(withKeyID(rotatedKeyID), withKeyObservation(rotatedKeyObservation())),
+			},
+		},
+		"RetiredKeyInGracePeriod": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			}),
+			mg: serviceAccountKey(withKeyID(rotatedKeyID), withKeyObservation(rotatedKeyObservation()), withRetiredKeys(retiredKey(keyNow.Add(time.Minute)))),
+			want: want{
+				mg: serviceAccountKey(withKeyID(rotatedKeyID), withKeyObservation(rotatedKeyObservation()), withRetiredKeys(retiredKey(keyNow.Add(time.Minute)))),
+			},
+		},
+		"DeleteRetiredKeyFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+			}),
+			mg: serviceAccountKey(withKeyID(rotatedKeyID), withKeyObservation(rotatedKeyObservation()), withRetiredKeys(retiredKey(keyNow))),
+			want: want{
+				mg:  serviceAccountKey(withKeyID(rotatedKeyID), withKeyObservation(rotatedKeyObservation()), withRetiredKeys(retiredKey(keyNow))),
+				err: errors.Wrapf(&googleapi.Error{Code: http.StatusForbidden, Body: "{}\n"}, errDeleteRetiredKey, observedKey().Name),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			keys, done := keyService(t, tc.handler)
+			defer done()
+			e := keyExternal{kube: tc.kube, keys: keys, now: func() time.Time { return keyNow }}
+			upd, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestServiceAccountKeyRotation reconciles an aged key through a rotation:
+// the key is replaced, and the replaced key is deleted only once its grace
+// period has passed.
+func TestServiceAccountKeyRotation(t *testing.T) {
+	rotated := rotatedKey()
+	rotated.PrivateKeyData = base64.StdEncoding.EncodeToString([]byte(keyCredentials))
+	deleted := false
+
+	keys, done := keyService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		switch r.Method + " " + r.URL.Path {
+		case http.MethodGet + " " + keyPath:
+			_ = json.NewEncoder(w).Encode(observedKey())
+		case http.MethodGet + " " + rotatedKeyPath:
+			_ = json.NewEncoder(w).Encode(rotatedKey())
+		case http.MethodPost + " " + keysPath:
+			_ = json.NewEncoder(w).Encode(rotated)
+		case http.MethodDelete + " /v1/" + observedKey().Name:
+			deleted = true
+			_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer done()
+
+	now := keyNow
+	e := keyExternal{
+		kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil), MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
+		keys: keys,
+		now:  func() time.Time { return now },
+	}
+	cr := serviceAccountKey(withKeyID(keyID), withRotationPolicy(30*24*time.Hour, time.Hour))
+	ctx := context.Background()
+
+	observe := func(wantUpToDate bool) {
+		t.Helper()
+		obs, err := e.Observe(ctx, cr)
+		if err != nil {
+			t.Fatalf("Observe(...): %s", err)
+		}
+		if obs.ResourceUpToDate != wantUpToDate {
+			t.Fatalf("Observe(...): want ResourceUpToDate %t, got %t", wantUpToDate, obs.ResourceUpToDate)
+		}
+	}
+	update := func() managed.ExternalUpdate {
+		t.Helper()
+		upd, err := e.Update(ctx, cr)
+		if err != nil {
+			t.Fatalf("Update(...): %s", err)
+		}
+		return upd
+	}
+
+	// The aged key is rotated, and the new key is published.
+	observe(false)
+	upd := update()
+	if diff := cmp.Diff(managed.ConnectionDetails{connection.KeyCredentials: []byte(keyCredentials)}, upd.ConnectionDetails); diff != "" {
+		t.Errorf("Update(...): -want connection details, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(rotatedKeyID, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("Update(...): -want external name, +got:\n%s", diff)
+	}
+
+	// The replaced key is kept during its grace period.
+	now = keyNow.Add(59 * time.Minute)
+	observe(true)
+	if deleted {
+		t.Errorf("Update(...): replaced key deleted during its grace period")
+	}
+
+	// The replaced key is deleted once its grace period has passed.
+	now = keyNow.Add(time.Hour)
+	observe(false)
+	update()
+	if !deleted {
+		t.Errorf("Update(...): replaced key not deleted after its grace period")
+	}
+	observe(true)
+	if len(cr.Status.AtProvider.RetiredKeys) != 0 {
+		t.Errorf("Update(...): want no retired keys, got %v", cr.Status.AtProvider.RetiredKeys)
+	}
+}
+
+// TestServiceAccountKeyRotationStatusLost reconciles an aged key through a
+// rotation whose status is not written after the rotation, for example
+// because the status update failed or the controller restarted. The replaced
+// key must still be deleted once its grace period has passed.
+func TestServiceAccountKeyRotationStatusLost(t *testing.T) {
+	rotated := rotatedKey()
+	rotated.PrivateKeyData = base64.StdEncoding.EncodeToString([]byte(keyCredentials))
+	deleted := false
+
+	keys, done := keyService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		switch r.Method + " " + r.URL.Path {
+		case http.MethodGet + " " + keyPath:
+			_ = json.NewEncoder(w).Encode(observedKey())
+		case http.MethodGet + " " + rotatedKeyPath:
+			_ = json.NewEncoder(w).Encode(rotatedKey())
+		case http.MethodPost + " " + keysPath:
+			_ = json.NewEncoder(w).Encode(rotated)
+		case http.MethodDelete + " /v1/" + observedKey().Name:
+			deleted = true
+			_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer done()
+
+	cr := serviceAccountKey(withKeyID(keyID), withRotationPolicy(30*24*time.Hour, time.Hour))
+
+	// stored is the ServiceAccountKey as persisted by the API server.
+	stored := cr.DeepCopy()
+	now := keyNow
+	e := keyExternal{
+		kube: &test.MockClient{
+			MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+				stored.ObjectMeta = *obj.(*v1alpha1.ServiceAccountKey).ObjectMeta.DeepCopy()
+				return nil
+			},
+			MockStatusUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+				stored.Status = *obj.(*v1alpha1.ServiceAccountKey).Status.DeepCopy()
+				return nil
+			},
+		},
+		keys: keys,
+		now:  func() time.Time { return now },
+	}
+	ctx := context.Background()
+
+	if _, err := e.Observe(ctx, cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+
+	// The status of the rotated key is never written, so the next reconcile
+	// starts from the ServiceAccountKey as it was persisted during Update.
+	cr = stored.DeepCopy()
+	now = keyNow.Add(time.Hour)
+	obs, err := e.Observe(ctx, cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if obs.ResourceUpToDate {
+		t.Fatalf("Observe(...): want ResourceUpToDate false, got true")
+	}
+	if _, err := e.Update(ctx, cr); err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+	if !deleted {
+		t.Errorf("Update(...): replaced key not deleted after its grace period")
+	}
+}
+
+func TestServiceAccountKeyDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"NotFound": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusForbidden,
+			want:   errors.Wrap(&googleapi.Error{Code: http.StatusForbidden, Body: "{}\n"}, errDeleteKey),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			keys, done := keyService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete+" "+keyPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+			}))
+			defer done()
+			e := keyExternal{keys: keys}
+			err := e.Delete(context.Background(), serviceAccountKey(withKeyID(keyID)))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountKeyDeleteRetired(t *testing.T) {
+	var got []string
+	keys, done := keyService(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		got = append(got, r.Method+" "+r.URL.Path)
+		_ = json.NewEncoder(w).Encode(&iamv1.Empty{})
+	}))
+	defer done()
+
+	e := keyExternal{keys: keys}
+	cr := serviceAccountKey(withKeyID(rotatedKeyID), withRetiredKeys(retiredKey(keyNow.Add(time.Hour))))
+	if err := e.Delete(context.Background(), cr); err != nil {
+		t.Fatalf("Delete(...): %s", err)
+	}
+	want := []string{
+		http.MethodDelete + " /v1/" + observedKey().Name,
+		http.MethodDelete + " " + rotatedKeyPath,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Delete(...): -want requests, +got:\n%s", diff)
+	}
+	if len(cr.Status.AtProvider.RetiredKeys) != 0 {
+		t.Errorf("Delete(...): want no retired keys, got %v", cr.Status.AtProvider.RetiredKeys)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+// Error strings.
+const (
+	errParseTemplate   = "cannot parse %s template"
+	errExpandTemplate  = "cannot expand %s template"
+	errExpandedTooLong = "expanded %s is %d characters long; at most %d are allowed"
+	errExpandedControl = "expanded %s must not contain control characters"
+	errExpandedMarker  = "expanded %s must not contain the ownership marker"
+)
+
+// The maximum lengths of the templated fields once expanded. The description
+// is limited to leave room for the ownership marker.
+const (
+	maxDisplayName = 100
+	maxDescription = 231
+)
+
+// templateMetadata is the metadata of a ServiceAccount that DisplayName and
+// Description templates may refer to. Only metadata is exposed, so that a
+// template cannot leak the spec or status of the ServiceAccount.
+type templateMetadata struct {
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// templateData is the data DisplayName and Description templates are
+// expanded against, e.g. {{ .ObjectMeta.Name }}.
+type templateData struct {
+	ObjectMeta templateMetadata
+}
+
+// expandParameters returns a copy of the parameters of the supplied
+// ServiceAccount with their DisplayName and Description templates expanded
+// against its metadata. Fields that are not templates are copied as is.
+func expandParameters(cr *v1alpha1.ServiceAccount) (*v1alpha1.ServiceAccountParameters, error) {
+	in := cr.Spec.ForProvider.DeepCopy()
+	data := templateData{ObjectMeta: templateMetadata{
+		Name:        cr.GetName(),
+		Namespace:   cr.GetNamespace(),
+		Labels:      cr.GetLabels(),
+		Annotations: cr.GetAnnotations(),
+	}}
+	var err error
+	if in.DisplayName, err = expandField("displayName", in.DisplayName, maxDisplayName, data); err != nil {
+		return nil, err
+	}
+	if in.Description, err = expandField("description", in.Description, maxDescription, data); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+// expandField expands the supplied template and validates the result. Values
+// of the metadata are inserted verbatim and never expanded themselves, but
+// they are free-form, so the result is rejected if it is too long, contains
+// control characters, or could be mistaken for the ownership marker.
+func expandField(field string, in *string, max int, data templateData) (*string, error) {
+	if in == nil || !strings.Contains(*in, "{{") {
+		return in, nil
+	}
+	t, err := template.New(field).Option("missingkey=error").Parse(*in)
+	if err != nil {
+		return nil, errors.Wrapf(err, errParseTemplate, field)
+	}
+	b := &strings.Builder{}
+	if err := t.Execute(b, data); err != nil {
+		return nil, errors.Wrapf(err, errExpandTemplate, field)
+	}
+	out := b.String()
+	if n := utf8.RuneCountInString(out); n > max {
+		return nil, errors.Errorf(errExpandedTooLong, field, n, max)
+	}
+	if strings.IndexFunc(out, unicode.IsControl) >= 0 {
+		return nil, errors.Errorf(errExpandedControl, field)
+	}
+	if strings.Contains(out, ownershipMarker) {
+		return nil, errors.Errorf(errExpandedMarker, field)
+	}
+	return &out, nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestExpandParameters(t *testing.T) {
+	type want struct {
+		params *v1alpha1.ServiceAccountParameters
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		meta   metav1.ObjectMeta
+		params v1alpha1.ServiceAccountParameters
+		want   want
+	}{
+		"NoTemplates": {
+			reason: "Fields that are not templates should be copied as is.",
+			params: v1alpha1.ServiceAccountParameters{DisplayName: gcp.StringPtr("ci"), Description: gcp.StringPtr("Runs CI jobs")},
+			want: want{
+				params: &v1alpha1.ServiceAccountParameters{DisplayName: gcp.StringPtr("ci"), Description: gcp.StringPtr("Runs CI jobs")},
+			},
+		},
+		"Unset": {
+			reason: "Unset fields should remain unset.",
+			want: want{
+				params: &v1alpha1.ServiceAccountParameters{},
+			},
+		},
+		"Expanded": {
+			reason: "Templates should be expanded against the metadata of the ServiceAccount.",
+			meta: metav1.ObjectMeta{
+				Name:        "ci",
+				Labels:      map[string]string{"team": "platform"},
+				Annotations: map[string]string{"cluster": "prod-1"},
+			},
+			params: v1alpha1.ServiceAccountParameters{
+				DisplayName: gcp.StringPtr("{{ .ObjectMeta.Name }} ({{ .ObjectMeta.Labels.team }})"),
+				Description: gcp.StringPtr("Managed by Crossplane in {{ .ObjectMeta.Annotations.cluster }}"),
+			},
+			want: want{
+				params: &v1alpha1.ServiceAccountParameters{
+					DisplayName: gcp.StringPtr("ci (platform)"),
+					Description: gcp.StringPtr("Managed by Crossplane in prod-1"),
+				},
+			},
+		},
+		"ValuesNotExpanded": {
+			reason: "Metadata values should be inserted verbatim rather than expanded themselves.",
+			meta:   metav1.ObjectMeta{Labels: map[string]string{"team": "{{ .ObjectMeta.Name }}"}},
+			params: v1alpha1.ServiceAccountParameters{Description: gcp.StringPtr("{{ .ObjectMeta.Labels.team }}")},
+			want: want{
+				params: &v1alpha1.ServiceAccountParameters{Description: gcp.StringPtr("{{ .ObjectMeta.Name }}")},
+			},
+		},
+		"ParseError": {
+			reason: "Templates that cannot be parsed should return an error.",
+			params: v1alpha1.ServiceAccountParameters{DisplayName: gcp.StringPtr("{{ .ObjectMeta.Name ")},
+			want: want{
+				err: errors.Wrapf(errors.New(`template: displayName:1: unclosed action`), errParseTemplate, "displayName"),
+			},
+		},
+		"SpecNotAvailable": {
+			reason: "Templates should not be able to refer to anything but metadata.",
+			params: v1alpha1.ServiceAccountParameters{Description: gcp.StringPtr("{{ .Spec.ForProvider }}")},
+			want: want{
+				err: errors.Wrapf(errors.New(`template: description:1:8: executing "description" at <.Spec.ForProvider>: can't evaluate field Spec in type iam.templateData`), errExpandTemplate, "description"),
+			},
+		},
+		"MissingLabel": {
+			reason: "Referring to a label that is not set should return an error.",
+			params: v1alpha1.ServiceAccountParameters{Description: gcp.StringPtr("{{ .ObjectMeta.Labels.team }}")},
+			want: want{
+				err: errors.Wrapf(errors.New(`template: description:1:14: executing "description" at <.ObjectMeta.Labels.team>: map has no entry for key "team"`), errExpandTemplate, "description"),
+			},
+		},
+		"TooLong": {
+			reason: "Expanded values that exceed the length limit should return an error.",
+			meta:   metav1.ObjectMeta{Labels: map[string]string{"team": strings.Repeat("a", 101)}},
+			params: v1alpha1.ServiceAccountParameters{DisplayName: gcp.StringPtr("{{ .ObjectMeta.Labels.team }}")},
+			want: want{
+				err: errors.Errorf(errExpandedTooLong, "displayName", 101, maxDisplayName),
+			},
+		},
+		"ControlCharacters": {
+			reason: "Expanded values that contain control characters should return an error.",
+			meta:   metav1.ObjectMeta{Annotations: map[string]string{"note": "a\nb"}},
+			params: v1alpha1.ServiceAccountParameters{Description: gcp.StringPtr("{{ .ObjectMeta.Annotations.note }}")},
+			want: want{
+				err: errors.Errorf(errExpandedControl, "description"),
+			},
+		},
+		"OwnershipMarker": {
+			reason: "Expanded values that contain the ownership marker should return an error.",
+			meta:   metav1.ObjectMeta{Labels: map[string]string{"team": ownershipMarker}},
+			params: v1alpha1.ServiceAccountParameters{Description: gcp.StringPtr("{{ .ObjectMeta.Labels.team }}")},
+			want: want{
+				err: errors.Errorf(errExpandedMarker, "description"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.ServiceAccount{ObjectMeta: tc.meta}
+			cr.Spec.ForProvider = tc.params
+			got, err := expandParameters(cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nexpandParameters(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.params, got); diff != "" {
+				t.Errorf("\n%s\nexpandParameters(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+// Error strings.
+const (
+	errWindowTime     = "cannot parse reconcile window time of day"
+	errWindowTimeZone = "cannot load reconcile window time zone"
+	errWindowDay      = "unknown reconcile window day"
+)
+
+// TypeDeferred indicates whether changes to the external resource are being
+// deferred until its reconcile window opens.
+const TypeDeferred runtimev1alpha1.ConditionType = "Deferred"
+
+// Reasons changes to the external resource are or are not deferred.
+const (
+	ReasonOutsideWindow runtimev1alpha1.ConditionReason = "OutsideReconcileWindow"
+	ReasonInsideWindow  runtimev1alpha1.ConditionReason = "InsideReconcileWindow"
+)
+
+// Deferred returns a condition that indicates changes to the external
+// resource are deferred until the supplied time.
+func Deferred(until time.Time) runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeDeferred,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOutsideWindow,
+		Message:            fmt.Sprintf("changes are deferred until the reconcile window opens at %s", until.Format(time.RFC3339)),
+	}
+}
+
+// NotDeferred returns a condition that indicates changes to the external
+// resource are no longer deferred.
+func NotDeferred() runtimev1alpha1.Condition {
+	return runtimev1alpha1.Condition{
+		Type:               TypeDeferred,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonInsideWindow,
+	}
+}
+
+var weekdays = map[v1alpha1.Weekday]time.Weekday{
+	"Sunday":    time.Sunday,
+	"Monday":    time.Monday,
+	"Tuesday":   time.Tuesday,
+	"Wednesday": time.Wednesday,
+	"Thursday":  time.Thursday,
+	"Friday":    time.Friday,
+	"Saturday":  time.Saturday,
+}
+
+// A window is a parsed ReconcileWindow.
+type window struct {
+	// days on which the window opens. The window opens every day if empty.
+	days map[time.Weekday]bool
+
+	// start is the offset from midnight at which the window opens, and
+	// length how long it stays open.
+	start  time.Duration
+	length time.Duration
+
+	loc *time.Location
+}
+
+func parseWindow(in *v1alpha1.ReconcileWindow) (*window, error) {
+	w := &window{days: map[time.Weekday]bool{}, loc: time.UTC}
+	for _, d := range in.Days {
+		wd, ok := weekdays[d]
+		if !ok {
+			return nil, errors.Errorf("%s: %s", errWindowDay, d)
+		}
+		w.days[wd] = true
+	}
+	if in.TimeZone != nil {
+		loc, err := time.LoadLocation(*in.TimeZone)
+		if err != nil {
+			return nil, errors.Wrap(err, errWindowTimeZone)
+		}
+		w.loc = loc
+	}
+	start, err := parseTimeOfDay(in.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseTimeOfDay(in.End)
+	if err != nil {
+		return nil, err
+	}
+	w.start = start
+	w.length = end - start
+	if w.length <= 0 {
+		w.length += 24 * time.Hour
+	}
+	return w, nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, errors.Wrap(err, errWindowTime)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// opening returns the time at which the window opens on the day of the
+// supplied time, and whether it opens on that day at all.
+func (w *window) opening(day time.Time) (time.Time, bool) {
+	y, m, d := day.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, w.loc)
+	return midnight.Add(w.start), len(w.days) == 0 || w.days[day.Weekday()]
+}
+
+// Open returns true if the window is open at the supplied time. A window
+// that closes on the day after it opens may have opened yesterday.
+func (w *window) Open(t time.Time) bool {
+	t = t.In(w.loc)
+	for _, day := range []time.Time{t, t.AddDate(0, 0, -1)} {
+		o, ok := w.opening(day)
+		if ok && !t.Before(o) && t.Before(o.Add(w.length)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the time at which the window next opens after the supplied
+// time.
+func (w *window) Next(t time.Time) time.Time {
+	t = t.In(w.loc)
+	for i := 0; i <= 7; i++ {
+		o, ok := w.opening(t.AddDate(0, 0, i))
+		if ok && o.After(t) {
+			return o
+		}
+	}
+	// Unreachable; a window opens at least once a week.
+	return t
+}
+
+// A windowedExternal defers updates and deletions of the ServiceAccounts it
+// reconciles until their reconcile window, if any, is open. Observations and
+// creations are never deferred.
+type windowedExternal struct {
+	managed.ExternalClient
+	now func() time.Time
+}
+
+// deferred returns true if changes to the supplied ServiceAccount must be
+// deferred, and sets its Deferred condition accordingly.
+func (e *windowedExternal) deferred(cr *v1alpha1.ServiceAccount) (bool, error) {
+	if cr.Spec.ReconcileWindow == nil {
+		return false, nil
+	}
+	w, err := parseWindow(cr.Spec.ReconcileWindow)
+	if err != nil {
+		return false, err
+	}
+	now := e.now()
+	if !w.Open(now) {
+		cr.Status.SetConditions(Deferred(w.Next(now)))
+		return true, nil
+	}
+	if cr.Status.GetCondition(TypeDeferred).Status == corev1.ConditionTrue {
+		cr.Status.SetConditions(NotDeferred())
+	}
+	return false, nil
+}
+
+func (e *windowedExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if cr, ok := mg.(*v1alpha1.ServiceAccount); ok {
+		if d, err := e.deferred(cr); d || err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+func (e *windowedExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	if cr, ok := mg.(*v1alpha1.ServiceAccount); ok {
+		if d, err := e.deferred(cr); d || err != nil {
+			return err
+		}
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+func TestWindow(t *testing.T) {
+	// 2020-06-01 is a Monday.
+	monday := func(hour, min int) time.Time { return time.Date(2020, 6, 1, hour, min, 0, 0, time.UTC) }
+	zone := "Europe/Zurich"
+
+	type want struct {
+		open bool
+		next time.Time
+	}
+
+	cases := map[string]struct {
+		w    v1alpha1.ReconcileWindow
+		now  time.Time
+		want want
+	}{
+		"BeforeDailyWindow": {
+			w:    v1alpha1.ReconcileWindow{Start: "02:00", End: "04:00"},
+			now:  monday(1, 0),
+			want: want{open: false, next: monday(2, 0)},
+		},
+		"InsideDailyWindow": {
+			w:    v1alpha1.ReconcileWindow{Start: "02:00", End: "04:00"},
+			now:  monday(2, 0),
+			want: want{open: true, next: monday(2, 0).AddDate(0, 0, 1)},
+		},
+		"AfterDailyWindow": {
+			w:    v1alpha1.ReconcileWindow{Start: "02:00", End: "04:00"},
+			now:  monday(4, 0),
+			want: want{open: false, next: monday(2, 0).AddDate(0, 0, 1)},
+		},
+		"OvernightWindowOpenedYesterday": {
+			w:    v1alpha1.ReconcileWindow{Days: []v1alpha1.Weekday{"Sunday"}, Start: "22:00", End: "03:00"},
+			now:  monday(1, 0),
+			want: want{open: true, next: monday(22, 0).AddDate(0, 0, 6)},
+		},
+		"OvernightWindowNotOpenedYesterday": {
+			w:    v1alpha1.ReconcileWindow{Days: []v1alpha1.Weekday{"Saturday"}, Start: "22:00", End: "03:00"},
+			now:  monday(1, 0),
+			want: want{open: false, next: monday(22, 0).AddDate(0, 0, 5)},
+		},
+		"WrongDay": {
+			w:    v1alpha1.ReconcileWindow{Days: []v1alpha1.Weekday{"Tuesday", "Thursday"}, Start: "00:00", End: "23:59"},
+			now:  monday(12, 0),
+			want: want{open: false, next: monday(0, 0).AddDate(0, 0, 1)},
+		},
+		"TimeZone": {
+			// 12:30 in Zurich is 10:30 UTC in summer.
+			w:    v1alpha1.ReconcileWindow{Start: "12:00", End: "13:00", TimeZone: &zone},
+			now:  monday(10, 30),
+			want: want{open: true, next: monday(10, 0).AddDate(0, 0, 1)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w, err := parseWindow(&tc.w)
+			if err != nil {
+				t.Fatalf("parseWindow(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.open, w.Open(tc.now)); diff != "" {
+				t.Errorf("Open(...): -want, +got:\n%s", diff)
+			}
+			if got := w.Next(tc.now); !got.Equal(tc.want.next) {
+				t.Errorf("Next(...): want %s, got %s", tc.want.next, got)
+			}
+		})
+	}
+}
+
+func TestParseWindow(t *testing.T) {
+	invalidZone := "Nowhere/Special"
+
+	cases := map[string]struct {
+		w       v1alpha1.ReconcileWindow
+		wantErr bool
+	}{
+		"Valid": {
+			w: v1alpha1.ReconcileWindow{Days: []v1alpha1.Weekday{"Monday"}, Start: "01:00", End: "02:00"},
+		},
+		"InvalidTime": {
+			w:       v1alpha1.ReconcileWindow{Start: "1am", End: "02:00"},
+			wantErr: true,
+		},
+		"InvalidDay": {
+			w:       v1alpha1.ReconcileWindow{Days: []v1alpha1.Weekday{"Caturday"}, Start: "01:00", End: "02:00"},
+			wantErr: true,
+		},
+		"InvalidTimeZone": {
+			w:       v1alpha1.ReconcileWindow{Start: "01:00", End: "02:00", TimeZone: &invalidZone},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseWindow(&tc.w)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("parseWindow(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowedExternal(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	opens := time.Date(2020, 6, 2, 2, 0, 0, 0, time.UTC)
+	withWindow := func(start, end string) valueModifier {
+		return func(sa *v1alpha1.ServiceAccount) {
+			sa.Spec.ReconcileWindow = &v1alpha1.ReconcileWindow{Start: start, End: end}
+		}
+	}
+	withConditions := func(c ...runtimev1alpha1.Condition) valueModifier {
+		return func(sa *v1alpha1.ServiceAccount) { sa.Status.SetConditions(c...) }
+	}
+
+	type want struct {
+		mg     resource.Managed
+		called bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		mg   *v1alpha1.ServiceAccount
+		call func(e *windowedExternal, mg resource.Managed) error
+		want want
+	}{
+		"UpdateWithoutWindow": {
+			mg: serviceAccount(),
+			call: func(e *windowedExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: want{mg: serviceAccount(), called: true},
+		},
+		"UpdateInsideWindow": {
+			mg: serviceAccount(withWindow("11:00", "13:00"), withConditions(Deferred(opens))),
+			call: func(e *windowedExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: want{mg: serviceAccount(withWindow("11:00", "13:00"), withConditions(NotDeferred())), called: true},
+		},
+		"UpdateOutsideWindow": {
+			mg: serviceAccount(withWindow("02:00", "04:00")),
+			call: func(e *windowedExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: want{mg: serviceAccount(withWindow("02:00", "04:00"), withConditions(Deferred(opens)))},
+		},
+		"DeleteOutsideWindow": {
+			mg: serviceAccount(withWindow("02:00", "04:00")),
+			call: func(e *windowedExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: want{mg: serviceAccount(withWindow("02:00", "04:00"), withConditions(Deferred(opens)))},
+		},
+		"DeleteInsideWindow": {
+			mg: serviceAccount(withWindow("11:00", "13:00")),
+			call: func(e *windowedExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: want{mg: serviceAccount(withWindow("11:00", "13:00")), called: true},
+		},
+		"InvalidWindow": {
+			mg: serviceAccount(withWindow("noon", "13:00")),
+			call: func(e *windowedExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: want{
+				mg:  serviceAccount(withWindow("noon", "13:00")),
+				err: errors.Wrap(errors.New(`parsing time "noon" as "15:04": cannot parse "noon" as "15"`), errWindowTime),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			client := &managed.ExternalClientFns{
+				UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+					called = true
+					return managed.ExternalUpdate{}, nil
+				},
+				DeleteFn: func(_ context.Context, _ resource.Managed) error {
+					called = true
+					return nil
+				},
+			}
+			e := &windowedExternal{ExternalClient: client, now: func() time.Time { return now }}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("windowedExternal: -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("windowedExternal: -want called, +got called:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("windowedExternal: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/connection"
+	"github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	kms "github.com/crossplane/provider-gcp/pkg/clients/kms"
+)
+
+// Error strings.
+const (
+	errNotCryptoKey    = "managed resource is not a Cloud KMS CryptoKey"
+	errNoKeyRing       = "crypto key does not specify a key ring"
+	errGetCryptoKey    = "cannot get Cloud KMS crypto key"
+	errCreateCryptoKey = "cannot create Cloud KMS crypto key"
+	errUpdateCryptoKey = "cannot update Cloud KMS crypto key"
+	errDestroyPrimary  = "cannot schedule the primary version of Cloud KMS crypto key for destruction"
+)
+
+// Log messages.
+const (
+	msgCryptoKeyNotDeleted = "GCP does not allow crypto keys to be deleted; leaving the crypto key in place"
+)
+
+// States of crypto key versions that are, or will be, destroyed.
+const (
+	stateDestroyScheduled = "DESTROY_SCHEDULED"
+	stateDestroyed        = "DESTROYED"
+)
+
+// SetupCryptoKey adds a controller that reconciles Cloud KMS CryptoKeys.
+func SetupCryptoKey(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.CryptoKeyGroupKind)
+	log := l.WithValues("controller", name)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.CryptoKey{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CryptoKeyGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&cryptoKeyConnector{kube: mgr.GetClient(), log: log, newServiceFn: kmsv1.NewService})),
+			managed.WithLogger(log),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type cryptoKeyConnector struct {
+	kube         client.Client
+	log          logging.Logger
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *cryptoKeyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CryptoKey)
+	if !ok {
+		return nil, errors.New(errNotCryptoKey)
+	}
+	opts, _, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, kmsv1.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &cryptoKeyExternal{kube: c.kube, cryptoKeys: s.Projects.Locations.KeyRings.CryptoKeys, log: c.log, now: time.Now}, nil
+}
+
+type cryptoKeyExternal struct {
+	kube       client.Client
+	cryptoKeys *kmsv1.ProjectsLocationsKeyRingsCryptoKeysService
+	log        logging.Logger
+	now        func() time.Time
+}
+
+func (e *cryptoKeyExternal) name(cr *v1alpha1.CryptoKey) string {
+	return kms.CryptoKeyName(gcp.StringValue(cr.Spec.ForProvider.KeyRing), meta.GetExternalName(cr))
+}
+
+func (e *cryptoKeyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CryptoKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCryptoKey)
+	}
+	if deleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if cr.Spec.ForProvider.KeyRing == nil {
+		return managed.ExternalObservation{}, errors.New(errNoKeyRing)
+	}
+	observed, err := e.cryptoKeys.Get(e.name(cr)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetCryptoKey)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	kms.LateInitializeCryptoKey(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = kms.GenerateCryptoKeyObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  kms.IsCryptoKeyUpToDate(cr.Spec.ForProvider, *observed, e.now()),
+		ConnectionDetails: managed.ConnectionDetails{connection.KeyCryptoKeyName: []byte(observed.Name)},
+	}, nil
+}
+
+func (e *cryptoKeyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CryptoKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCryptoKey)
+	}
+	if cr.Spec.ForProvider.KeyRing == nil {
+		return managed.ExternalCreation{}, errors.New(errNoKeyRing)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.cryptoKeys.Create(*cr.Spec.ForProvider.KeyRing, kms.GenerateCryptoKey(cr.Spec.ForProvider)).CryptoKeyId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCryptoKey)
+}
+
+// Update patches the rotation period, next rotation time and labels of the
+// crypto key; its other fields cannot be changed once it has been created.
+func (e *cryptoKeyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CryptoKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCryptoKey)
+	}
+	observed, err := e.cryptoKeys.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCryptoKey)
+	}
+	mask := kms.UpdateMask(cr.Spec.ForProvider, *observed, e.now())
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.cryptoKeys.Patch(e.name(cr), kms.GenerateCryptoKey(cr.Spec.ForProvider)).UpdateMask(mask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCryptoKey)
+}
+
+// Delete leaves the crypto key in place, because GCP does not allow crypto
+// keys to be deleted. If requested, the primary version of the crypto key is
+// scheduled for destruction, so that data encrypted with it can no longer be
+// decrypted once the scheduled destruction has passed.
+func (e *cryptoKeyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CryptoKey)
+	if !ok {
+		return errors.New(errNotCryptoKey)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	e.log.Info(msgCryptoKeyNotDeleted, "name", cr.Status.AtProvider.Name)
+
+	v := cr.Status.AtProvider.PrimaryVersion
+	if !gcp.BoolValue(cr.Spec.ForProvider.DestroyPrimaryVersionOnDeletion) || v == "" {
+		return nil
+	}
+	switch cr.Status.AtProvider.PrimaryVersionState {
+	case stateDestroyScheduled, stateDestroyed:
+		return nil
+	}
+	_, err := e.cryptoKeys.CryptoKeyVersions.Destroy(v, &kmsv1.DestroyCryptoKeyVersionRequest{}).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDestroyPrimary)
+}
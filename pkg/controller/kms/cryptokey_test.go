@@ -0,0 +1,462 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/connection"
+	"github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	cryptoKeyName  = "envelope"
+	cryptoKey      = keyRing + "/cryptoKeys/" + cryptoKeyName
+	cryptoKeyPath  = "/v1/" + cryptoKey
+	primaryVersion = cryptoKey + "/cryptoKeyVersions/1"
+	period         = "7776000s"
+)
+
+var (
+	_ managed.ExternalConnecter = &cryptoKeyConnector{}
+	_ managed.ExternalClient    = &cryptoKeyExternal{}
+
+	now = time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type cryptoKeyModifier func(*v1alpha1.CryptoKey)
+
+func cryptoKeyWithConditions(c ...runtimev1alpha1.Condition) cryptoKeyModifier {
+	return func(ck *v1alpha1.CryptoKey) { ck.Status.SetConditions(c...) }
+}
+
+func cryptoKeyWithObservation(o v1alpha1.CryptoKeyObservation) cryptoKeyModifier {
+	return func(ck *v1alpha1.CryptoKey) { ck.Status.AtProvider = o }
+}
+
+func cryptoKeyWithParameters(p v1alpha1.CryptoKeyParameters) cryptoKeyModifier {
+	return func(ck *v1alpha1.CryptoKey) {
+		p.KeyRing = ck.Spec.ForProvider.KeyRing
+		ck.Spec.ForProvider = p
+	}
+}
+
+func cryptoKeyObj(m ...cryptoKeyModifier) *v1alpha1.CryptoKey {
+	ck := &v1alpha1.CryptoKey{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cryptoKeyName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: cryptoKeyName},
+		},
+		Spec: v1alpha1.CryptoKeySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.CryptoKeyParameters{KeyRing: gcp.StringPtr(keyRing)},
+		},
+	}
+	for _, f := range m {
+		f(ck)
+	}
+	return ck
+}
+
+func newCryptoKeyExternal(kube client.Client, server *httptest.Server) *cryptoKeyExternal {
+	s, _ := kmsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return &cryptoKeyExternal{
+		kube:       kube,
+		cryptoKeys: s.Projects.Locations.KeyRings.CryptoKeys,
+		log:        logging.NewNopLogger(),
+		now:        func() time.Time { return now },
+	}
+}
+
+func TestCryptoKeyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	observed := &kmsv1.CryptoKey{
+		Name:             cryptoKey,
+		Purpose:          "ENCRYPT_DECRYPT",
+		RotationPeriod:   period,
+		NextRotationTime: "2020-07-01T00:00:00Z",
+		Primary:          &kmsv1.CryptoKeyVersion{Name: primaryVersion, State: "ENABLED"},
+	}
+	lateInitialized := v1alpha1.CryptoKeyParameters{
+		Purpose:          gcp.StringPtr("ENCRYPT_DECRYPT"),
+		RotationPeriod:   gcp.StringPtr(period),
+		NextRotationTime: gcp.StringPtr("2020-07-01T00:00:00Z"),
+	}
+	observation := v1alpha1.CryptoKeyObservation{
+		Name:                cryptoKey,
+		PrimaryVersion:      primaryVersion,
+		PrimaryVersionState: "ENABLED",
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotCryptoKey": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotCryptoKey),
+			},
+		},
+		"NoKeyRing": {
+			mg: cryptoKeyObj(func(ck *v1alpha1.CryptoKey) { ck.Spec.ForProvider.KeyRing = nil }),
+			want: want{
+				mg:  cryptoKeyObj(func(ck *v1alpha1.CryptoKey) { ck.Spec.ForProvider.KeyRing = nil }),
+				err: errors.New(errNoKeyRing),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&kmsv1.CryptoKey{})
+			}),
+			mg: cryptoKeyObj(),
+			want: want{
+				mg:  cryptoKeyObj(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(cryptoKeyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observed)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   cryptoKeyObj(),
+			want: want{
+				mg: cryptoKeyObj(
+					cryptoKeyWithParameters(lateInitialized),
+					cryptoKeyWithObservation(observation),
+					cryptoKeyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{connection.KeyCryptoKeyName: []byte(cryptoKey)},
+				},
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observed)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   cryptoKeyObj(),
+			want: want{
+				mg:  cryptoKeyObj(cryptoKeyWithParameters(lateInitialized)),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observed)
+			}),
+			mg: cryptoKeyObj(cryptoKeyWithParameters(v1alpha1.CryptoKeyParameters{
+				Purpose:          gcp.StringPtr("ENCRYPT_DECRYPT"),
+				RotationPeriod:   gcp.StringPtr("86400s"),
+				NextRotationTime: gcp.StringPtr("2020-07-01T00:00:00Z"),
+			})),
+			want: want{
+				mg: cryptoKeyObj(
+					cryptoKeyWithParameters(v1alpha1.CryptoKeyParameters{
+						Purpose:          gcp.StringPtr("ENCRYPT_DECRYPT"),
+						RotationPeriod:   gcp.StringPtr("86400s"),
+						NextRotationTime: gcp.StringPtr("2020-07-01T00:00:00Z"),
+					}),
+					cryptoKeyWithObservation(observation),
+					cryptoKeyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{connection.KeyCryptoKeyName: []byte(cryptoKey)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			e := newCryptoKeyExternal(tc.kube, server)
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCryptoKeyCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+keyRing+"/cryptoKeys", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(cryptoKeyName, r.URL.Query().Get("cryptoKeyId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				ck := &kmsv1.CryptoKey{}
+				if err := json.NewDecoder(r.Body).Decode(ck); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(period, ck.RotationPeriod); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(ck)
+			}),
+			mg: cryptoKeyObj(cryptoKeyWithParameters(v1alpha1.CryptoKeyParameters{RotationPeriod: gcp.StringPtr(period)})),
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&kmsv1.CryptoKey{})
+			}),
+			mg:  cryptoKeyObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateCryptoKey),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			e := newCryptoKeyExternal(nil, server)
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCryptoKeyUpdate(t *testing.T) {
+	observed := &kmsv1.CryptoKey{
+		Name:             cryptoKey,
+		RotationPeriod:   period,
+		NextRotationTime: "2020-07-01T00:00:00Z",
+		Labels:           map[string]string{"team": "payments"},
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(observed)
+					return
+				}
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(cryptoKeyPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("labels", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(observed)
+			}),
+			mg: cryptoKeyObj(cryptoKeyWithParameters(v1alpha1.CryptoKeyParameters{
+				RotationPeriod: gcp.StringPtr(period),
+				Labels:         map[string]string{"team": "billing"},
+			})),
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(observed)
+			}),
+			mg: cryptoKeyObj(cryptoKeyWithParameters(v1alpha1.CryptoKeyParameters{
+				Labels: map[string]string{"team": "payments"},
+			})),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(observed)
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&kmsv1.CryptoKey{})
+			}),
+			mg:  cryptoKeyObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateCryptoKey),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			e := newCryptoKeyExternal(nil, server)
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCryptoKeyDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	destroy := func(ck *v1alpha1.CryptoKey) {
+		ck.Spec.ForProvider.DestroyPrimaryVersionOnDeletion = gcp.BoolPtr(true)
+	}
+	enabled := cryptoKeyWithObservation(v1alpha1.CryptoKeyObservation{Name: cryptoKey, PrimaryVersion: primaryVersion, PrimaryVersionState: "ENABLED"})
+	scheduled := cryptoKeyWithObservation(v1alpha1.CryptoKeyObservation{Name: cryptoKey, PrimaryVersion: primaryVersion, PrimaryVersionState: stateDestroyScheduled})
+	unexpected := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotCryptoKey": {
+			handler: unexpected,
+			mg:      &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotCryptoKey),
+			},
+		},
+		"LeftInPlace": {
+			handler: unexpected,
+			mg:      cryptoKeyObj(enabled),
+			want: want{
+				mg: cryptoKeyObj(enabled, cryptoKeyWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"PrimaryVersionDestroyed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/"+primaryVersion+":destroy", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&kmsv1.CryptoKeyVersion{Name: primaryVersion, State: stateDestroyScheduled})
+			}),
+			mg: cryptoKeyObj(destroy, enabled),
+			want: want{
+				mg: cryptoKeyObj(destroy, enabled, cryptoKeyWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"PrimaryVersionAlreadyScheduled": {
+			handler: unexpected,
+			mg:      cryptoKeyObj(destroy, scheduled),
+			want: want{
+				mg: cryptoKeyObj(destroy, scheduled, cryptoKeyWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DestroyFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&kmsv1.CryptoKeyVersion{})
+			}),
+			mg: cryptoKeyObj(destroy, enabled),
+			want: want{
+				mg:  cryptoKeyObj(destroy, enabled, cryptoKeyWithConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDestroyPrimary),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			e := newCryptoKeyExternal(nil, server)
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
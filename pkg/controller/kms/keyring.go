@@ -0,0 +1,159 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	kms "github.com/crossplane/provider-gcp/pkg/clients/kms"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new Cloud KMS client"
+	errUpdateCR  = "cannot update Cloud KMS custom resource"
+
+	errNotKeyRing    = "managed resource is not a Cloud KMS KeyRing"
+	errGetKeyRing    = "cannot get Cloud KMS key ring"
+	errCreateKeyRing = "cannot create Cloud KMS key ring"
+)
+
+// Log messages.
+const (
+	msgKeyRingNotDeleted = "GCP does not allow key rings to be deleted; leaving the key ring in place"
+)
+
+type newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*kmsv1.Service, error)
+
+// SetupKeyRing adds a controller that reconciles Cloud KMS KeyRings.
+func SetupKeyRing(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.KeyRingGroupKind)
+	log := l.WithValues("controller", name)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.KeyRing{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.KeyRingGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&keyRingConnector{kube: mgr.GetClient(), log: log, newServiceFn: kmsv1.NewService})),
+			managed.WithLogger(log),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// deleted returns true if the external resource of the supplied managed
+// resource was already requested to be deleted. Key rings and crypto keys
+// cannot be deleted, so they are reported not to exist from then on to let
+// the managed resource be finalized.
+func deleted(mg resource.Managed) bool {
+	return meta.WasDeleted(mg) && mg.GetCondition(runtimev1alpha1.TypeReady).Reason == runtimev1alpha1.ReasonDeleting
+}
+
+type keyRingConnector struct {
+	kube         client.Client
+	log          logging.Logger
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *keyRingConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.KeyRing)
+	if !ok {
+		return nil, errors.New(errNotKeyRing)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, kmsv1.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &keyRingExternal{keyRings: s.Projects.Locations.KeyRings, log: c.log, projectID: p.Spec.ProjectID}, nil
+}
+
+type keyRingExternal struct {
+	keyRings  *kmsv1.ProjectsLocationsKeyRingsService
+	log       logging.Logger
+	projectID string
+}
+
+func (e *keyRingExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.KeyRing)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKeyRing)
+	}
+	if deleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	observed, err := e.keyRings.Get(kms.KeyRingName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetKeyRing)
+	}
+
+	cr.Status.AtProvider = kms.GenerateKeyRingObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	// Key rings have no mutable fields, so an existing key ring is always up
+	// to date.
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (e *keyRingExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.KeyRing)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKeyRing)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.keyRings.Create(kms.KeyRingParent(e.projectID, cr.Spec.ForProvider.Location), &kmsv1.KeyRing{}).KeyRingId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateKeyRing)
+}
+
+// Update is a no-op; key rings cannot be changed once they have been created.
+func (e *keyRingExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete leaves the key ring in place, because GCP does not allow key rings
+// to be deleted.
+func (e *keyRingExternal) Delete(_ context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.KeyRing)
+	if !ok {
+		return errors.New(errNotKeyRing)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	e.log.Info(msgKeyRingNotDeleted, "name", cr.Status.AtProvider.Name)
+	return nil
+}
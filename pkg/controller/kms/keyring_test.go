@@ -0,0 +1,256 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kmsv1 "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	providerName = "gcp-provider"
+	location     = "global"
+	keyRingName  = "secrets"
+	keyRing      = "projects/" + projectID + "/locations/" + location + "/keyRings/" + keyRingName
+	keyRingPath  = "/v1/" + keyRing
+)
+
+var (
+	errBoom   = errors.New("boom")
+	deletedAt = metav1.Now()
+
+	_ managed.ExternalConnecter = &keyRingConnector{}
+	_ managed.ExternalClient    = &keyRingExternal{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type keyRingModifier func(*v1alpha1.KeyRing)
+
+func keyRingWithConditions(c ...runtimev1alpha1.Condition) keyRingModifier {
+	return func(kr *v1alpha1.KeyRing) { kr.Status.SetConditions(c...) }
+}
+
+func keyRingWithObservation(o v1alpha1.KeyRingObservation) keyRingModifier {
+	return func(kr *v1alpha1.KeyRing) { kr.Status.AtProvider = o }
+}
+
+func keyRingWithDeletionTimestamp(t metav1.Time) keyRingModifier {
+	return func(kr *v1alpha1.KeyRing) { kr.SetDeletionTimestamp(&t) }
+}
+
+func keyRingObj(m ...keyRingModifier) *v1alpha1.KeyRing {
+	kr := &v1alpha1.KeyRing{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        keyRingName,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: keyRingName},
+		},
+		Spec: v1alpha1.KeyRingSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.KeyRingParameters{Location: location},
+		},
+	}
+	for _, f := range m {
+		f(kr)
+	}
+	return kr
+}
+
+func TestKeyRingObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotKeyRing": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotKeyRing),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&kmsv1.KeyRing{})
+			}),
+			mg: keyRingObj(),
+			want: want{
+				mg:  keyRingObj(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&kmsv1.KeyRing{})
+			}),
+			mg: keyRingObj(),
+			want: want{
+				mg:  keyRingObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetKeyRing),
+			},
+		},
+		"Available": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(keyRingPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&kmsv1.KeyRing{Name: keyRing})
+			}),
+			mg: keyRingObj(),
+			want: want{
+				mg: keyRingObj(
+					keyRingWithObservation(v1alpha1.KeyRingObservation{Name: keyRing}),
+					keyRingWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request: %s", r.URL.Path)
+			}),
+			mg: keyRingObj(keyRingWithDeletionTimestamp(deletedAt), keyRingWithConditions(runtimev1alpha1.Deleting())),
+			want: want{
+				mg:  keyRingObj(keyRingWithDeletionTimestamp(deletedAt), keyRingWithConditions(runtimev1alpha1.Deleting())),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := kmsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := keyRingExternal{keyRings: s.Projects.Locations.KeyRings, log: logging.NewNopLogger(), projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyRingCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/projects/"+projectID+"/locations/"+location+"/keyRings", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(keyRingName, r.URL.Query().Get("keyRingId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&kmsv1.KeyRing{Name: keyRing})
+			}),
+			mg: keyRingObj(),
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&kmsv1.KeyRing{})
+			}),
+			mg:  keyRingObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateKeyRing),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := kmsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := keyRingExternal{keyRings: s.Projects.Locations.KeyRings, log: logging.NewNopLogger(), projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyRingDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+	s, _ := kmsv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := keyRingExternal{keyRings: s.Projects.Locations.KeyRings, log: logging.NewNopLogger(), projectID: projectID}
+
+	mg := keyRingObj()
+	if err := e.Delete(context.Background(), mg); err != nil {
+		t.Errorf("Delete(...): %s", err)
+	}
+	if diff := cmp.Diff(keyRingObj(keyRingWithConditions(runtimev1alpha1.Deleting())), mg, test.EquateConditions()); diff != "" {
+		t.Errorf("Delete(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/orgpolicy"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new Resource Manager client"
+
+	errNotPolicy   = "managed resource is not an Organization Policy"
+	errGetPolicy   = "cannot get Organization Policy"
+	errSetPolicy   = "cannot set Organization Policy"
+	errClearPolicy = "cannot clear Organization Policy"
+)
+
+// Prefixes of the resources an Organization Policy may be attached to.
+const (
+	prefixFolders       = "folders/"
+	prefixOrganizations = "organizations/"
+)
+
+// SetupPolicy adds a controller that reconciles Organization Policies.
+func SetupPolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.PolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Policy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PolicyGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&connector{kube: mgr.GetClient(), newServiceFn: crm.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*crm.Service, error)
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return nil, errors.New(errNotPolicy)
+	}
+
+	opts, _, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, crm.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := c.newServiceFn(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &external{crm: svc}, nil
+}
+
+type external struct {
+	crm *crm.Service
+}
+
+// Observe makes observation about the external resource.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicy)
+	}
+
+	p, err := e.get(ctx, cr.Spec.ForProvider.Parent, cr.Spec.ForProvider.Constraint)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	cr.Status.AtProvider = orgpolicy.GeneratePolicyObservation(*p)
+
+	// A constraint that is not set on its parent resource is inherited from
+	// the parent's ancestors.
+	if !orgpolicy.IsSet(*p) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: orgpolicy.IsUpToDate(cr.Spec.ForProvider, *p),
+	}, nil
+}
+
+// Create sets the policy on its parent resource.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicy)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.set(ctx, cr.Spec.ForProvider.Parent, orgpolicy.GeneratePolicy(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errSetPolicy)
+}
+
+// Update replaces the policy on its parent resource.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicy)
+	}
+	p := orgpolicy.GeneratePolicy(cr.Spec.ForProvider)
+	p.Etag = cr.Status.AtProvider.Etag
+	_, err := e.set(ctx, cr.Spec.ForProvider.Parent, p)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errSetPolicy)
+}
+
+// Delete clears the policy from its parent resource, which then inherits the
+// policy of its ancestors.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return errors.New(errNotPolicy)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	err := e.clear(ctx, cr.Spec.ForProvider.Parent, cr.Spec.ForProvider.Constraint, cr.Status.AtProvider.Etag)
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errClearPolicy)
+}
+
+func (e *external) get(ctx context.Context, parent, constraint string) (*crm.OrgPolicy, error) {
+	req := &crm.GetOrgPolicyRequest{Constraint: constraint}
+	switch {
+	case strings.HasPrefix(parent, prefixFolders):
+		return e.crm.Folders.GetOrgPolicy(parent, req).Context(ctx).Do()
+	case strings.HasPrefix(parent, prefixOrganizations):
+		return e.crm.Organizations.GetOrgPolicy(parent, req).Context(ctx).Do()
+	default:
+		return e.crm.Projects.GetOrgPolicy(parent, req).Context(ctx).Do()
+	}
+}
+
+func (e *external) set(ctx context.Context, parent string, p *crm.OrgPolicy) (*crm.OrgPolicy, error) {
+	req := &crm.SetOrgPolicyRequest{Policy: p}
+	switch {
+	case strings.HasPrefix(parent, prefixFolders):
+		return e.crm.Folders.SetOrgPolicy(parent, req).Context(ctx).Do()
+	case strings.HasPrefix(parent, prefixOrganizations):
+		return e.crm.Organizations.SetOrgPolicy(parent, req).Context(ctx).Do()
+	default:
+		return e.crm.Projects.SetOrgPolicy(parent, req).Context(ctx).Do()
+	}
+}
+
+func (e *external) clear(ctx context.Context, parent, constraint, etag string) error {
+	req := &crm.ClearOrgPolicyRequest{Constraint: constraint, Etag: etag}
+	var err error
+	switch {
+	case strings.HasPrefix(parent, prefixFolders):
+		_, err = e.crm.Folders.ClearOrgPolicy(parent, req).Context(ctx).Do()
+	case strings.HasPrefix(parent, prefixOrganizations):
+		_, err = e.crm.Organizations.ClearOrgPolicy(parent, req).Context(ctx).Do()
+	default:
+		_, err = e.crm.Projects.ClearOrgPolicy(parent, req).Context(ctx).Do()
+	}
+	return err
+}
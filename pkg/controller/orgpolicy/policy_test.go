@@ -0,0 +1,402 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	crm "google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+)
+
+const (
+	parent       = "projects/myproject-id-1234"
+	folder       = "folders/1234"
+	constraint   = "constraints/iam.disableServiceAccountCreation"
+	etag         = "BwWKmjvelug="
+	providerName = "gcp-provider"
+)
+
+var (
+	_ managed.ExternalConnecter = &connector{}
+	_ managed.ExternalClient    = &external{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type policyModifier func(*v1alpha1.Policy)
+
+func policyWithConditions(c ...runtimev1alpha1.Condition) policyModifier {
+	return func(p *v1alpha1.Policy) { p.Status.SetConditions(c...) }
+}
+
+func policyWithParent(parent string) policyModifier {
+	return func(p *v1alpha1.Policy) { p.Spec.ForProvider.Parent = parent }
+}
+
+func policyWithEtag(etag string) policyModifier {
+	return func(p *v1alpha1.Policy) { p.Status.AtProvider.Etag = etag }
+}
+
+func policy(m ...policyModifier) *v1alpha1.Policy {
+	p := &v1alpha1.Policy{
+		Spec: v1alpha1.PolicySpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.PolicyParameters{
+				Parent:        parent,
+				Constraint:    constraint,
+				BooleanPolicy: &v1alpha1.BooleanPolicy{Enforced: true},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicy": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotPolicy),
+			},
+		},
+		"NotSet": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("/v1/"+parent+":getOrgPolicy", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&crm.OrgPolicy{Constraint: constraint, Etag: etag})
+			}),
+			mg: policy(),
+			want: want{
+				mg:  policy(policyWithEtag(etag)),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDateOnFolder": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("/v1/"+folder+":getOrgPolicy", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&crm.OrgPolicy{
+					Constraint:    constraint,
+					Etag:          etag,
+					BooleanPolicy: &crm.BooleanPolicy{Enforced: true},
+				})
+			}),
+			mg: policy(policyWithParent(folder)),
+			want: want{
+				mg: policy(
+					policyWithParent(folder),
+					policyWithEtag(etag),
+					policyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&crm.OrgPolicy{
+					Constraint:    constraint,
+					Etag:          etag,
+					BooleanPolicy: &crm.BooleanPolicy{},
+				})
+			}),
+			mg: policy(),
+			want: want{
+				mg: policy(
+					policyWithEtag(etag),
+					policyWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&crm.Empty{})
+			}),
+			mg: policy(),
+			want: want{
+				mg:  policy(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := crm.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := external{crm: s}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicy": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotPolicy),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("/v1/"+parent+":setOrgPolicy", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				req := &crm.SetOrgPolicyRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				want := &crm.OrgPolicy{Constraint: constraint, BooleanPolicy: &crm.BooleanPolicy{Enforced: true}}
+				if diff := cmp.Diff(want, req.Policy); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(req.Policy)
+			}),
+			mg: policy(),
+			want: want{
+				mg: policy(policyWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"SetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&crm.Empty{})
+			}),
+			mg: policy(),
+			want: want{
+				mg:  policy(policyWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errSetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := crm.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := external{crm: s}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				req := &crm.SetOrgPolicyRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				want := &crm.OrgPolicy{
+					Constraint:    constraint,
+					Etag:          etag,
+					BooleanPolicy: &crm.BooleanPolicy{Enforced: true},
+				}
+				if diff := cmp.Diff(want, req.Policy); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(req.Policy)
+			}),
+			mg: policy(policyWithEtag(etag)),
+		},
+		"SetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(&crm.Empty{})
+			}),
+			mg: policy(policyWithEtag(etag)),
+			want: want{
+				err: errors.Wrap(gError(http.StatusConflict, ""), errSetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := crm.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := external{crm: s}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("/v1/"+folder+":clearOrgPolicy", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				req := &crm.ClearOrgPolicyRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				want := &crm.ClearOrgPolicyRequest{Constraint: constraint, Etag: etag}
+				if diff := cmp.Diff(want, req); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&crm.Empty{})
+			}),
+			mg: policy(policyWithParent(folder), policyWithEtag(etag)),
+			want: want{
+				mg: policy(
+					policyWithParent(folder),
+					policyWithEtag(etag),
+					policyWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&crm.Empty{})
+			}),
+			mg: policy(),
+			want: want{
+				mg: policy(policyWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"ClearFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&crm.Empty{})
+			}),
+			mg: policy(),
+			want: want{
+				mg:  policy(policyWithConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errClearPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := crm.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := external{crm: s}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/osconfig"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new OS Config client"
+	errUpdateCR  = "cannot update OSPolicyAssignment custom resource"
+
+	errNotAssignment    = "managed resource is not an OSPolicyAssignment"
+	errGetAssignment    = "cannot get OS policy assignment"
+	errCreateAssignment = "cannot create OS policy assignment"
+	errUpdateAssignment = "cannot update OS policy assignment"
+	errDeleteAssignment = "cannot delete OS policy assignment"
+)
+
+// SetupOSPolicyAssignment adds a controller that reconciles
+// OSPolicyAssignments.
+func SetupOSPolicyAssignment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.OSPolicyAssignmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.OSPolicyAssignment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OSPolicyAssignmentGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&assignmentConnector{kube: mgr.GetClient(), newServiceFn: osconfig.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type assignmentConnector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*osconfig.Service, error)
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *assignmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OSPolicyAssignment)
+	if !ok {
+		return nil, errors.New(errNotAssignment)
+	}
+
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, osconfig.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	oc, err := c.newServiceFn(ctx, opts...)
+	return &assignmentExternal{kube: c.kube, osconfig: oc, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type assignmentExternal struct {
+	kube      client.Client
+	osconfig  *osconfig.Service
+	projectID string
+}
+
+func (e *assignmentExternal) name(cr *v1alpha1.OSPolicyAssignment) string {
+	return osconfig.OSPolicyAssignmentName(e.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr))
+}
+
+func (e *assignmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OSPolicyAssignment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAssignment)
+	}
+	observed, err := e.osconfig.GetOSPolicyAssignment(ctx, e.name(cr))
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAssignment)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	osconfig.LateInitializeOSPolicyAssignment(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = osconfig.GenerateOSPolicyAssignmentObservation(*observed)
+
+	// A deleted assignment exists until the OS policies were removed from all
+	// instances it was rolled out to.
+	if observed.Deleted {
+		cr.SetConditions(runtimev1alpha1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	switch observed.RolloutState {
+	case v1alpha1.RolloutStateSucceeded:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha1.RolloutStateInProgress, v1alpha1.RolloutStateCancelling:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: osconfig.IsOSPolicyAssignmentUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *assignmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OSPolicyAssignment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAssignment)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.osconfig.CreateOSPolicyAssignment(ctx, e.projectID, cr.Spec.ForProvider.Zone, meta.GetExternalName(cr), osconfig.GenerateOSPolicyAssignment(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAssignment)
+}
+
+// Update creates a new revision of the assignment. The revision is rolled out
+// per the rollout of the assignment; its progress is reported as the rollout
+// state of the assignment.
+func (e *assignmentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OSPolicyAssignment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAssignment)
+	}
+	_, err := e.osconfig.PatchOSPolicyAssignment(ctx, e.name(cr), osconfig.GenerateOSPolicyAssignment(cr.Spec.ForProvider), osconfig.OSPolicyAssignmentUpdateMask...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAssignment)
+}
+
+func (e *assignmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OSPolicyAssignment)
+	if !ok {
+		return errors.New(errNotAssignment)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.osconfig.DeleteOSPolicyAssignment(ctx, e.name(cr))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAssignment)
+}
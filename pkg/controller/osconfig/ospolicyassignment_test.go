@@ -0,0 +1,454 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
+	"github.com/crossplane/provider-gcp/pkg/clients/osconfig"
+)
+
+const (
+	projectID      = "myproject-id-1234"
+	providerName   = "gcp-provider"
+	zone           = "us-central1-a"
+	testAssignment = "test-assignment"
+)
+
+var (
+	_ managed.ExternalConnecter = &assignmentConnector{}
+	_ managed.ExternalClient    = &assignmentExternal{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type assignmentModifier func(*v1alpha1.OSPolicyAssignment)
+
+func assignmentWithConditions(c ...runtimev1alpha1.Condition) assignmentModifier {
+	return func(a *v1alpha1.OSPolicyAssignment) { a.Status.SetConditions(c...) }
+}
+
+func assignmentWithObservation(o v1alpha1.OSPolicyAssignmentObservation) assignmentModifier {
+	return func(a *v1alpha1.OSPolicyAssignment) { a.Status.AtProvider = o }
+}
+
+func assignmentWithPackage(name string) assignmentModifier {
+	return func(a *v1alpha1.OSPolicyAssignment) {
+		a.Spec.ForProvider.OSPolicies[0].ResourceGroups[0].Resources[0].Package.Apt.Name = name
+	}
+}
+
+func assignmentObj(m ...assignmentModifier) *v1alpha1.OSPolicyAssignment {
+	fixed := int32(1)
+	a := &v1alpha1.OSPolicyAssignment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testAssignment,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: testAssignment},
+		},
+		Spec: v1alpha1.OSPolicyAssignmentSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.OSPolicyAssignmentParameters{
+				Zone: zone,
+				OSPolicies: []v1alpha1.OSPolicy{{
+					ID:   "nginx",
+					Mode: "ENFORCEMENT",
+					ResourceGroups: []v1alpha1.OSPolicyResourceGroup{{
+						Resources: []v1alpha1.OSPolicyResource{{
+							ID:      "pkg",
+							Package: &v1alpha1.PackageResource{DesiredState: "INSTALLED", Apt: &v1alpha1.PackageName{Name: "nginx"}},
+						}},
+					}},
+				}},
+				InstanceFilter: v1alpha1.InstanceFilter{
+					InclusionLabels: []v1alpha1.LabelSet{{Labels: map[string]string{"role": "web"}}},
+				},
+				Rollout: v1alpha1.Rollout{
+					DisruptionBudget: v1alpha1.FixedOrPercent{Fixed: &fixed},
+					MinWaitDuration:  "60s",
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+// observed returns the assignment that the API returns for assignmentObj.
+func observed(m ...func(*osconfig.OSPolicyAssignment)) *osconfig.OSPolicyAssignment {
+	a := osconfig.GenerateOSPolicyAssignment(assignmentObj().Spec.ForProvider)
+	a.Name = osconfig.OSPolicyAssignmentName(projectID, zone, testAssignment)
+	a.RevisionID = "rev1"
+	a.RolloutState = v1alpha1.RolloutStateSucceeded
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func assignmentPath() string {
+	return "/v1/" + osconfig.OSPolicyAssignmentName(projectID, zone, testAssignment)
+}
+
+func TestOSPolicyAssignmentObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotOSPolicyAssignment": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotAssignment),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(assignmentPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&osconfig.OSPolicyAssignment{})
+			}),
+			mg: assignmentObj(),
+			want: want{
+				mg: assignmentObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&osconfig.OSPolicyAssignment{})
+			}),
+			mg: assignmentObj(),
+			want: want{
+				mg:  assignmentObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetAssignment),
+			},
+		},
+		"RolledOut": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observed())
+			}),
+			mg: assignmentObj(),
+			want: want{
+				mg: assignmentObj(
+					assignmentWithObservation(v1alpha1.OSPolicyAssignmentObservation{
+						Name:         osconfig.OSPolicyAssignmentName(projectID, zone, testAssignment),
+						RevisionID:   "rev1",
+						RolloutState: v1alpha1.RolloutStateSucceeded,
+					}),
+					assignmentWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RollingOutPreviousRevision": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observed(func(a *osconfig.OSPolicyAssignment) {
+					a.RolloutState = v1alpha1.RolloutStateInProgress
+					a.Reconciling = true
+				}))
+			}),
+			mg: assignmentObj(assignmentWithPackage("apache2")),
+			want: want{
+				mg: assignmentObj(
+					assignmentWithPackage("apache2"),
+					assignmentWithObservation(v1alpha1.OSPolicyAssignmentObservation{
+						Name:         osconfig.OSPolicyAssignmentName(projectID, zone, testAssignment),
+						RevisionID:   "rev1",
+						RolloutState: v1alpha1.RolloutStateInProgress,
+						Reconciling:  true,
+					}),
+					assignmentWithConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Deleted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observed(func(a *osconfig.OSPolicyAssignment) {
+					a.Deleted = true
+					a.RolloutState = v1alpha1.RolloutStateInProgress
+				}))
+			}),
+			mg: assignmentObj(),
+			want: want{
+				mg: assignmentObj(
+					assignmentWithObservation(v1alpha1.OSPolicyAssignmentObservation{
+						Name:         osconfig.OSPolicyAssignmentName(projectID, zone, testAssignment),
+						RevisionID:   "rev1",
+						RolloutState: v1alpha1.RolloutStateInProgress,
+					}),
+					assignmentWithConditions(runtimev1alpha1.Deleting())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := osconfig.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := assignmentExternal{osconfig: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOSPolicyAssignmentCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotOSPolicyAssignment": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotAssignment),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/projects/"+projectID+"/locations/"+zone+"/osPolicyAssignments", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(testAssignment, r.URL.Query().Get("osPolicyAssignmentId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				a := &osconfig.OSPolicyAssignment{}
+				if err := json.NewDecoder(r.Body).Decode(a); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(osconfig.GenerateOSPolicyAssignment(assignmentObj().Spec.ForProvider), a); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&osconfig.Operation{})
+			}),
+			mg: assignmentObj(),
+			want: want{
+				mg: assignmentObj(assignmentWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&osconfig.Operation{})
+			}),
+			mg: assignmentObj(),
+			want: want{
+				mg:  assignmentObj(assignmentWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAssignment),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := osconfig.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := assignmentExternal{osconfig: s, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOSPolicyAssignmentUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotOSPolicyAssignment": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotAssignment),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(assignmentPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("description,osPolicies,instanceFilter,rollout", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				a := &osconfig.OSPolicyAssignment{}
+				if err := json.NewDecoder(r.Body).Decode(a); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff("apache2", a.OSPolicies[0].ResourceGroups[0].Resources[0].Pkg.Apt.Name); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&osconfig.Operation{})
+			}),
+			mg: assignmentObj(assignmentWithPackage("apache2")),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&osconfig.Operation{})
+			}),
+			mg:   assignmentObj(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAssignment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := osconfig.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := assignmentExternal{osconfig: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOSPolicyAssignmentDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotOSPolicyAssignment": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotAssignment),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(assignmentPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&osconfig.Operation{})
+			}),
+			mg: assignmentObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&osconfig.Operation{})
+			}),
+			mg: assignmentObj(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&osconfig.Operation{})
+			}),
+			mg:   assignmentObj(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAssignment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := osconfig.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := assignmentExternal{osconfig: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,15 +18,12 @@ package pubsub
 
 import (
 	"context"
-	"fmt"
 
 	pubsub "cloud.google.com/go/pubsub/apiv1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"google.golang.org/api/option"
 	pubsubpb "google.golang.org/genproto/googleapis/pubsub/v1"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -38,20 +35,17 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-gcp/apis/pubsub/v1alpha1"
-	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/topic"
 )
 
 const (
-	errGetProvider       = "cannot get Provider"
-	errProviderSecretRef = "cannot find Secret reference on Provider"
-	errGetProviderSecret = "cannot get Provider Secret"
-
 	errNotTopic        = "managed resource is not of type Topic"
 	errNewClient       = "cannot create client"
 	errGetTopic        = "cannot get Topic"
+	errGetSettings     = "cannot get Topic message retention and schema settings"
 	errUpdateTopic     = "cannot update Topic"
+	errUpdateSettings  = "cannot update Topic message retention and schema settings"
 	errKubeUpdateTopic = "cannot update Topic custom resource"
 	errCreateTopic     = "cannot create Topic"
 	errDeleteTopic     = "cannot delete Topic"
@@ -66,15 +60,16 @@ func SetupTopic(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.Topic{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.TopicGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newPubSubClient: pubsub.NewPublisherClient}),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&connector{client: mgr.GetClient(), newPubSubClient: pubsub.NewPublisherClient, newSettingsClient: topic.NewSettingsService})),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
 type connector struct {
-	client          client.Client
-	newPubSubClient func(ctx context.Context, opts ...option.ClientOption) (*pubsub.PublisherClient, error)
+	client            client.Client
+	newPubSubClient   func(ctx context.Context, opts ...option.ClientOption) (*pubsub.PublisherClient, error)
+	newSettingsClient func(ctx context.Context, opts ...option.ClientOption) (*topic.SettingsService, error)
 }
 
 // Connect returns an ExternalClient with necessary information to talk to GCP API.
@@ -83,34 +78,38 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if !ok {
 		return nil, errors.New(errNotTopic)
 	}
-	p := &gcpv1alpha3.Provider{}
-	if err := c.client.Get(ctx, meta.NamespacedNameOf(cr.Spec.ProviderReference), p); err != nil {
-		return nil, errors.Wrap(err, errGetProvider)
+	p, creds, err := gcp.ProviderCredentials(ctx, c.client, cr.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
 	}
 
-	if p.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretRef)
+	// The Pub/Sub publisher client uses gRPC, so it is built from the
+	// credentials directly rather than from an HTTP client.
+	opts := []option.ClientOption{option.WithScopes(pubsub.DefaultAuthScopes()...), gcp.UserAgentOption(p.Spec.HTTPClient)}
+	if creds != nil {
+		opts = append(opts, option.WithCredentialsJSON(creds))
 	}
-
-	s := &corev1.Secret{}
-	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
-	if err := c.client.Get(ctx, n, s); err != nil {
-		return nil, errors.Wrap(err, errGetProviderSecret)
+	ps, err := c.newPubSubClient(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	ps, err := c.newPubSubClient(ctx,
-		option.WithCredentialsJSON(s.Data[p.Spec.CredentialsSecretRef.Key]),
-		option.WithScopes(pubsub.DefaultAuthScopes()...))
+	httpOpts, err := gcp.CredentialsClientOptions(ctx, creds, p.Spec.HTTPClient, pubsub.DefaultAuthScopes()...)
+	if err != nil {
+		return nil, err
+	}
+	st, err := c.newSettingsClient(ctx, httpOpts...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &external{projectID: p.Spec.ProjectID, client: c.client, ps: ps}, nil
+	return &external{projectID: p.Spec.ProjectID, client: c.client, ps: ps, settings: st}, nil
 }
 
 type external struct {
 	projectID string
 	client    client.Client
 	ps        topic.PublisherClient
+	settings  topic.SettingsClient
 }
 
 // Observe makes observation about the external resource.
@@ -119,12 +118,18 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotTopic)
 	}
-	t, err := e.ps.GetTopic(ctx, &pubsubpb.GetTopicRequest{Topic: fmt.Sprintf("projects/%s/topics/%s", e.projectID, meta.GetExternalName(cr))})
+	name := topic.FullyQualifiedName(e.projectID, meta.GetExternalName(cr))
+	t, err := e.ps.GetTopic(ctx, &pubsubpb.GetTopicRequest{Topic: name})
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFoundGRPC, err), errGetTopic)
 	}
+	st, err := e.settings.GetSettings(ctx, name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetSettings)
+	}
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	topic.LateInitialize(&cr.Spec.ForProvider, *t)
+	topic.LateInitializeSettings(&cr.Spec.ForProvider, *st)
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
 		if err := e.client.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateTopic)
@@ -133,9 +138,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	cr.SetConditions(runtimev1alpha1.Available())
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: topic.IsUpToDate(cr.Spec.ForProvider, *t),
+		ResourceUpToDate: topic.IsUpToDate(cr.Spec.ForProvider, *t) && topic.IsSettingsUpToDate(cr.Spec.ForProvider, *st),
 		ConnectionDetails: managed.ConnectionDetails{
 			v1alpha1.ConnectionSecretKeyTopic:       []byte(meta.GetExternalName(cr)),
+			v1alpha1.ConnectionSecretKeyTopicName:   []byte(name),
 			v1alpha1.ConnectionSecretKeyProjectName: []byte(e.projectID),
 		},
 	}, nil
@@ -148,8 +154,18 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotTopic)
 	}
 	cr.SetConditions(runtimev1alpha1.Creating())
-	_, err := e.ps.CreateTopic(ctx, topic.GenerateTopic(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider))
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTopic)
+	t, err := e.ps.CreateTopic(ctx, topic.GenerateTopic(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTopic)
+	}
+
+	// Message retention and schema settings cannot be supplied to CreateTopic,
+	// so they are applied as soon as the topic exists.
+	st, mask := topic.GenerateSettingsUpdate(cr.Spec.ForProvider, topic.Settings{})
+	if len(mask) == 0 {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, errors.Wrap(e.settings.UpdateSettings(ctx, t.GetName(), st, mask...), errUpdateSettings)
 }
 
 // Update initiates an update to the external resource.
@@ -159,13 +175,26 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotTopic)
 	}
 
-	t, err := e.ps.GetTopic(ctx, &pubsubpb.GetTopicRequest{Topic: fmt.Sprintf("projects/%s/topics/%s", e.projectID, meta.GetExternalName(cr))})
+	name := topic.FullyQualifiedName(e.projectID, meta.GetExternalName(cr))
+	t, err := e.ps.GetTopic(ctx, &pubsubpb.GetTopicRequest{Topic: name})
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTopic)
 	}
+	if req := topic.GenerateUpdateRequest(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, *t); len(req.UpdateMask.Paths) > 0 {
+		if _, err := e.ps.UpdateTopic(ctx, req); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTopic)
+		}
+	}
 
-	_, err = e.ps.UpdateTopic(ctx, topic.GenerateUpdateRequest(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider, *t))
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTopic)
+	st, err := e.settings.GetSettings(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetSettings)
+	}
+	update, mask := topic.GenerateSettingsUpdate(cr.Spec.ForProvider, *st)
+	if len(mask) == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(e.settings.UpdateSettings(ctx, name, update, mask...), errUpdateSettings)
 }
 
 // Delete initiates an deletion of the external resource.
@@ -174,6 +203,6 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotTopic)
 	}
-	err := e.ps.DeleteTopic(ctx, &pubsubpb.DeleteTopicRequest{Topic: fmt.Sprintf("projects/%s/topics/%s", e.projectID, meta.GetExternalName(cr))})
+	err := e.ps.DeleteTopic(ctx, &pubsubpb.DeleteTopicRequest{Topic: topic.FullyQualifiedName(e.projectID, meta.GetExternalName(cr))})
 	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFoundGRPC, err), errDeleteTopic)
 }
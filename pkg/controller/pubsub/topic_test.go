@@ -18,6 +18,7 @@ package pubsub
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"google.golang.org/grpc/codes"
@@ -32,6 +33,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/googleapis/gax-go"
 	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -79,8 +81,36 @@ func (m *MockPublisherClient) DeleteTopic(ctx context.Context, req *pubsubpb.Del
 	return m.MockDeleteTopic(ctx, req, opts...)
 }
 
+type MockSettingsClient struct {
+	MockGetSettings    func(ctx context.Context, name string) (*topic.Settings, error)
+	MockUpdateSettings func(ctx context.Context, name string, s *topic.Settings, mask ...string) error
+}
+
+func (m *MockSettingsClient) GetSettings(ctx context.Context, name string) (*topic.Settings, error) {
+	return m.MockGetSettings(ctx, name)
+}
+func (m *MockSettingsClient) UpdateSettings(ctx context.Context, name string, s *topic.Settings, mask ...string) error {
+	return m.MockUpdateSettings(ctx, name, s, mask...)
+}
+
+func settingsClient(st *topic.Settings) *MockSettingsClient {
+	return &MockSettingsClient{
+		MockGetSettings: func(_ context.Context, _ string) (*topic.Settings, error) {
+			return st, nil
+		},
+	}
+}
+
 type TopicOption func(*v1alpha1.Topic)
 
+func withLabels(l map[string]string) TopicOption {
+	return func(t *v1alpha1.Topic) { t.Spec.ForProvider.Labels = l }
+}
+
+func withRetention(d string) TopicOption {
+	return func(t *v1alpha1.Topic) { t.Spec.ForProvider.MessageRetentionDuration = &d }
+}
+
 func newTopic(opts ...TopicOption) *v1alpha1.Topic {
 	t := &v1alpha1.Topic{
 		Spec: v1alpha1.TopicSpec{
@@ -144,6 +174,9 @@ func TestConnect(t *testing.T) {
 				newPubSubClient: func(ctx context.Context, opts ...option.ClientOption) (*pubsub.PublisherClient, error) {
 					return &pubsub.PublisherClient{}, nil
 				},
+				newSettingsClient: func(ctx context.Context, opts ...option.ClientOption) (*topic.SettingsService, error) {
+					return &topic.SettingsService{}, nil
+				},
 			},
 			args: args{
 				mg: newTopic(),
@@ -162,7 +195,7 @@ func TestConnect(t *testing.T) {
 				mg: newTopic(),
 			},
 			want: want{
-				err: errors.Wrap(errBoom, errGetProvider),
+				err: errors.Wrap(errBoom, "cannot get Provider"),
 			},
 		},
 		"FailedToGetProviderSecret": {
@@ -178,7 +211,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: newTopic()},
-			want: want{err: errors.Wrap(errBoom, errGetProviderSecret)},
+			want: want{err: errors.Wrap(errBoom, "cannot get Provider Secret")},
 		},
 		"ProviderSecretNil": {
 			conn: &connector{
@@ -195,7 +228,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: newTopic()},
-			want: want{err: errors.New(errProviderSecretRef)},
+			want: want{err: errors.New("cannot find Secret reference on Provider")},
 		},
 		"FailedToCreateComputeClient": {
 			conn: &connector{
@@ -215,6 +248,27 @@ func TestConnect(t *testing.T) {
 			args: args{mg: newTopic()},
 			want: want{err: errors.Wrap(errBoom, errNewClient)},
 		},
+		"FailedToCreateSettingsClient": {
+			conn: &connector{
+				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+					switch key {
+					case client.ObjectKey{Name: providerName}:
+						*obj.(*gcpv1alpha3.Provider) = provider
+					case client.ObjectKey{Namespace: namespace, Name: providerSecretName}:
+						*obj.(*corev1.Secret) = secret
+					}
+					return nil
+				}},
+				newPubSubClient: func(ctx context.Context, opts ...option.ClientOption) (*pubsub.PublisherClient, error) {
+					return &pubsub.PublisherClient{}, nil
+				},
+				newSettingsClient: func(ctx context.Context, opts ...option.ClientOption) (*topic.SettingsService, error) {
+					return nil, errBoom
+				},
+			},
+			args: args{mg: newTopic()},
+			want: want{err: errors.Wrap(errBoom, errNewClient)},
+		},
 	}
 
 	for name, tc := range cases {
@@ -229,9 +283,10 @@ func TestConnect(t *testing.T) {
 
 func TestObserve(t *testing.T) {
 	type args struct {
-		kube client.Client
-		ps   topic.PublisherClient
-		mg   resource.Managed
+		kube     client.Client
+		ps       topic.PublisherClient
+		settings topic.SettingsClient
+		mg       resource.Managed
 	}
 
 	type want struct {
@@ -277,6 +332,7 @@ func TestObserve(t *testing.T) {
 						return &pubsubpb.Topic{KmsKeyName: "olala"}, nil
 					},
 				},
+				settings: settingsClient(&topic.Settings{}),
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(errBoom),
 				},
@@ -286,6 +342,64 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(errBoom, errKubeUpdateTopic),
 			},
 		},
+		"GetSettingsFailed": {
+			reason: "Should return error if GetSettings fails",
+			args: args{
+				ps: &MockPublisherClient{
+					MockGetTopic: func(_ context.Context, _ *pubsubpb.GetTopicRequest, _ ...gax.CallOption) (*pubsubpb.Topic, error) {
+						return &pubsubpb.Topic{}, nil
+					},
+				},
+				settings: &MockSettingsClient{
+					MockGetSettings: func(_ context.Context, _ string) (*topic.Settings, error) {
+						return nil, errBoom
+					},
+				},
+				mg: newTopic(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSettings),
+			},
+		},
+		"SettingsNotFound": {
+			reason: "Should not return error if Topic is deleted between getting it and its settings",
+			args: args{
+				ps: &MockPublisherClient{
+					MockGetTopic: func(_ context.Context, _ *pubsubpb.GetTopicRequest, _ ...gax.CallOption) (*pubsubpb.Topic, error) {
+						return &pubsubpb.Topic{}, nil
+					},
+				},
+				settings: &MockSettingsClient{
+					MockGetSettings: func(_ context.Context, _ string) (*topic.Settings, error) {
+						return nil, &googleapi.Error{Code: http.StatusNotFound}
+					},
+				},
+				mg: newTopic(),
+			},
+		},
+		"RetentionNotUpToDate": {
+			reason: "Should report that the Topic is not up to date if its message retention differs",
+			args: args{
+				ps: &MockPublisherClient{
+					MockGetTopic: func(_ context.Context, _ *pubsubpb.GetTopicRequest, _ ...gax.CallOption) (*pubsubpb.Topic, error) {
+						return &pubsubpb.Topic{}, nil
+					},
+				},
+				settings: settingsClient(&topic.Settings{MessageRetentionDuration: "600s"}),
+				mg:       newTopic(withRetention("86400s")),
+			},
+			want: want{
+				eo: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ConnectionSecretKeyTopic:       []byte(""),
+						v1alpha1.ConnectionSecretKeyTopicName:   []byte(topic.FullyQualifiedName(projectID, "")),
+						v1alpha1.ConnectionSecretKeyProjectName: []byte(projectID),
+					},
+				},
+			},
+		},
 		"Success": {
 			reason: "Should succeed",
 			args: args{
@@ -294,7 +408,8 @@ func TestObserve(t *testing.T) {
 						return &pubsubpb.Topic{}, nil
 					},
 				},
-				mg: newTopic(),
+				settings: settingsClient(&topic.Settings{}),
+				mg:       newTopic(),
 			},
 			want: want{
 				eo: managed.ExternalObservation{
@@ -302,6 +417,7 @@ func TestObserve(t *testing.T) {
 					ResourceUpToDate: true,
 					ConnectionDetails: managed.ConnectionDetails{
 						v1alpha1.ConnectionSecretKeyTopic:       []byte(""),
+						v1alpha1.ConnectionSecretKeyTopicName:   []byte(topic.FullyQualifiedName(projectID, "")),
 						v1alpha1.ConnectionSecretKeyProjectName: []byte(projectID),
 					},
 				},
@@ -311,7 +427,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.args.kube, ps: tc.args.ps, projectID: projectID}
+			e := &external{client: tc.args.kube, ps: tc.args.ps, settings: tc.args.settings, projectID: projectID}
 			got, err := e.Observe(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
 				t.Errorf("Observe(...): -want, +got:\n%s", diff)
@@ -325,9 +441,10 @@ func TestObserve(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	type args struct {
-		kube client.Client
-		ps   topic.PublisherClient
-		mg   resource.Managed
+		kube     client.Client
+		ps       topic.PublisherClient
+		settings topic.SettingsClient
+		mg       resource.Managed
 	}
 
 	type want struct {
@@ -354,6 +471,25 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreateTopic),
 			},
 		},
+		"UpdateSettingsFailed": {
+			reason: "Should return error if message retention cannot be set after creation",
+			args: args{
+				ps: &MockPublisherClient{
+					MockCreateTopic: func(_ context.Context, _ *pubsubpb.Topic, _ ...gax.CallOption) (*pubsubpb.Topic, error) {
+						return &pubsubpb.Topic{}, nil
+					},
+				},
+				settings: &MockSettingsClient{
+					MockUpdateSettings: func(_ context.Context, _ string, _ *topic.Settings, _ ...string) error {
+						return errBoom
+					},
+				},
+				mg: newTopic(withRetention("86400s")),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateSettings),
+			},
+		},
 		"Success": {
 			reason: "Should not fail if all calls succeed",
 			args: args{
@@ -365,11 +501,34 @@ func TestCreate(t *testing.T) {
 				mg: newTopic(),
 			},
 		},
+		"SuccessWithRetention": {
+			reason: "Should set message retention after creation",
+			args: args{
+				ps: &MockPublisherClient{
+					MockCreateTopic: func(_ context.Context, _ *pubsubpb.Topic, _ ...gax.CallOption) (*pubsubpb.Topic, error) {
+						return &pubsubpb.Topic{Name: topic.FullyQualifiedName(projectID, "")}, nil
+					},
+				},
+				settings: &MockSettingsClient{
+					MockUpdateSettings: func(_ context.Context, name string, s *topic.Settings, mask ...string) error {
+						want := &topic.Settings{MessageRetentionDuration: "86400s"}
+						if diff := cmp.Diff(want, s); diff != "" {
+							t.Errorf("UpdateSettings(...): -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff([]string{topic.PathMessageRetentionDuration}, mask); diff != "" {
+							t.Errorf("UpdateSettings(...): -want mask, +got mask:\n%s", diff)
+						}
+						return nil
+					},
+				},
+				mg: newTopic(withRetention("86400s")),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.args.kube, ps: tc.args.ps, projectID: projectID}
+			e := &external{client: tc.args.kube, ps: tc.args.ps, settings: tc.args.settings, projectID: projectID}
 			got, err := e.Create(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
 				t.Errorf("Observe(...): -want, +got:\n%s", diff)
@@ -383,9 +542,10 @@ func TestCreate(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
 	type args struct {
-		kube client.Client
-		ps   topic.PublisherClient
-		mg   resource.Managed
+		kube     client.Client
+		ps       topic.PublisherClient
+		settings topic.SettingsClient
+		mg       resource.Managed
 	}
 
 	type want struct {
@@ -423,12 +583,53 @@ func TestUpdate(t *testing.T) {
 						return nil, errBoom
 					},
 				},
-				mg: newTopic(),
+				mg: newTopic(withLabels(map[string]string{"foo": "bar"})),
 			},
 			want: want{
 				err: errors.Wrap(errBoom, errUpdateTopic),
 			},
 		},
+		"GetSettingsFailed": {
+			reason: "Should return error if GetSettings fails",
+			args: args{
+				ps: &MockPublisherClient{
+					MockGetTopic: func(_ context.Context, _ *pubsubpb.GetTopicRequest, _ ...gax.CallOption) (*pubsubpb.Topic, error) {
+						return &pubsubpb.Topic{}, nil
+					},
+				},
+				settings: &MockSettingsClient{
+					MockGetSettings: func(_ context.Context, _ string) (*topic.Settings, error) {
+						return nil, errBoom
+					},
+				},
+				mg: newTopic(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSettings),
+			},
+		},
+		"UpdateSettingsFailed": {
+			reason: "Should return error if UpdateSettings fails",
+			args: args{
+				ps: &MockPublisherClient{
+					MockGetTopic: func(_ context.Context, _ *pubsubpb.GetTopicRequest, _ ...gax.CallOption) (*pubsubpb.Topic, error) {
+						return &pubsubpb.Topic{}, nil
+					},
+				},
+				settings: &MockSettingsClient{
+					MockGetSettings: func(_ context.Context, _ string) (*topic.Settings, error) {
+						return &topic.Settings{MessageRetentionDuration: "600s"}, nil
+					},
+					MockUpdateSettings: func(_ context.Context, _ string, _ *topic.Settings, _ ...string) error {
+						return errBoom
+					},
+				},
+				mg: newTopic(withRetention("86400s")),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateSettings),
+			},
+		},
 		"Success": {
 			reason: "Should not fail if all calls succeed",
 			args: args{
@@ -440,14 +641,22 @@ func TestUpdate(t *testing.T) {
 						return nil, nil
 					},
 				},
-				mg: newTopic(),
+				settings: &MockSettingsClient{
+					MockGetSettings: func(_ context.Context, _ string) (*topic.Settings, error) {
+						return &topic.Settings{MessageRetentionDuration: "600s"}, nil
+					},
+					MockUpdateSettings: func(_ context.Context, _ string, _ *topic.Settings, _ ...string) error {
+						return nil
+					},
+				},
+				mg: newTopic(withLabels(map[string]string{"foo": "bar"}), withRetention("86400s")),
 			},
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.args.kube, ps: tc.args.ps, projectID: projectID}
+			e := &external{client: tc.args.kube, ps: tc.args.ps, settings: tc.args.settings, projectID: projectID}
 			got, err := e.Update(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.eo, got); diff != "" {
 				t.Errorf("Observe(...): -want, +got:\n%s", diff)
@@ -461,9 +670,10 @@ func TestUpdate(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	type args struct {
-		kube client.Client
-		ps   topic.PublisherClient
-		mg   resource.Managed
+		kube     client.Client
+		ps       topic.PublisherClient
+		settings topic.SettingsClient
+		mg       resource.Managed
 	}
 
 	type want struct {
@@ -515,7 +725,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.args.kube, ps: tc.args.ps, projectID: projectID}
+			e := &external{client: tc.args.kube, ps: tc.args.ps, settings: tc.args.settings, projectID: projectID}
 			err := e.Delete(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
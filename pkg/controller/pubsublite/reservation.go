@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsublite
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/pubsublite"
+)
+
+// Error strings.
+const (
+	errNotReservation    = "managed resource is not a Pub/Sub Lite reservation"
+	errGetReservation    = "cannot get Pub/Sub Lite reservation"
+	errCreateReservation = "cannot create Pub/Sub Lite reservation"
+	errUpdateReservation = "cannot update Pub/Sub Lite reservation"
+	errDeleteReservation = "cannot delete Pub/Sub Lite reservation"
+)
+
+// SetupLiteReservation adds a controller that reconciles LiteReservations.
+func SetupLiteReservation(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LiteReservationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LiteReservation{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LiteReservationGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&reservationConnector{kube: mgr.GetClient(), newServiceFn: pubsublite.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type reservationConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *reservationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LiteReservation)
+	if !ok {
+		return nil, errors.New(errNotReservation)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, pubsublite.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	return &reservationExternal{lite: s, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type reservationExternal struct {
+	lite      *pubsublite.Service
+	projectID string
+}
+
+func (e *reservationExternal) name(cr *v1alpha1.LiteReservation) string {
+	return pubsublite.ReservationName(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr))
+}
+
+func (e *reservationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LiteReservation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotReservation)
+	}
+	observed, err := e.lite.GetReservation(ctx, e.name(cr))
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetReservation)
+	}
+
+	cr.Status.AtProvider = pubsublite.GenerateReservationObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: pubsublite.IsReservationUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *reservationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LiteReservation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotReservation)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.lite.CreateReservation(ctx, e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), pubsublite.GenerateReservation(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateReservation)
+}
+
+func (e *reservationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LiteReservation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotReservation)
+	}
+	_, err := e.lite.PatchReservation(ctx, e.name(cr), pubsublite.GenerateReservation(cr.Spec.ForProvider), pubsublite.ReservationUpdateMask...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateReservation)
+}
+
+func (e *reservationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LiteReservation)
+	if !ok {
+		return errors.New(errNotReservation)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	err := e.lite.DeleteReservation(ctx, e.name(cr))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteReservation)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsublite
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/pubsublite"
+)
+
+// Error strings.
+const (
+	errNotSubscription    = "managed resource is not a Pub/Sub Lite subscription"
+	errGetSubscription    = "cannot get Pub/Sub Lite subscription"
+	errCreateSubscription = "cannot create Pub/Sub Lite subscription"
+	errUpdateSubscription = "cannot update Pub/Sub Lite subscription"
+	errDeleteSubscription = "cannot delete Pub/Sub Lite subscription"
+)
+
+// SetupLiteSubscription adds a controller that reconciles LiteSubscriptions.
+func SetupLiteSubscription(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LiteSubscriptionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LiteSubscription{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LiteSubscriptionGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&subscriptionConnector{kube: mgr.GetClient(), newServiceFn: pubsublite.NewService})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type subscriptionConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *subscriptionConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LiteSubscription)
+	if !ok {
+		return nil, errors.New(errNotSubscription)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, pubsublite.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	return &subscriptionExternal{kube: c.kube, lite: s, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type subscriptionExternal struct {
+	kube      client.Client
+	lite      *pubsublite.Service
+	projectID string
+}
+
+func (e *subscriptionExternal) name(cr *v1alpha1.LiteSubscription) string {
+	return pubsublite.SubscriptionName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+}
+
+func (e *subscriptionExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LiteSubscription)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSubscription)
+	}
+	observed, err := e.lite.GetSubscription(ctx, e.name(cr))
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSubscription)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	pubsublite.LateInitializeSubscription(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = pubsublite.GenerateSubscriptionObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: pubsublite.IsSubscriptionUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *subscriptionExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LiteSubscription)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSubscription)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.lite.CreateSubscription(ctx, e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr), pubsublite.GenerateSubscription(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubscription)
+}
+
+func (e *subscriptionExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LiteSubscription)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSubscription)
+	}
+	_, err := e.lite.PatchSubscription(ctx, e.name(cr), pubsublite.GenerateSubscription(cr.Spec.ForProvider), pubsublite.SubscriptionUpdateMask...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubscription)
+}
+
+func (e *subscriptionExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LiteSubscription)
+	if !ok {
+		return errors.New(errNotSubscription)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	err := e.lite.DeleteSubscription(ctx, e.name(cr))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSubscription)
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsublite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/pubsublite"
+)
+
+const testSubscription = "test-subscription"
+
+var (
+	_ managed.ExternalConnecter = &subscriptionConnector{}
+	_ managed.ExternalClient    = &subscriptionExternal{}
+)
+
+type subscriptionModifier func(*v1alpha1.LiteSubscription)
+
+func subscriptionWithConditions(c ...runtimev1alpha1.Condition) subscriptionModifier {
+	return func(s *v1alpha1.LiteSubscription) { s.Status.SetConditions(c...) }
+}
+
+func subscriptionWithObservation(o v1alpha1.LiteSubscriptionObservation) subscriptionModifier {
+	return func(s *v1alpha1.LiteSubscription) { s.Status.AtProvider = o }
+}
+
+func subscriptionWithDelivery(d string) subscriptionModifier {
+	return func(s *v1alpha1.LiteSubscription) { s.Spec.ForProvider.DeliveryRequirement = gcp.StringPtr(d) }
+}
+
+func subscriptionObj(m ...subscriptionModifier) *v1alpha1.LiteSubscription {
+	s := &v1alpha1.LiteSubscription{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testSubscription,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: testSubscription},
+		},
+		Spec: v1alpha1.LiteSubscriptionSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.LiteSubscriptionParameters{
+				Location:            location,
+				Topic:               gcp.StringPtr(topicName()),
+				DeliveryRequirement: gcp.StringPtr(v1alpha1.DeliverImmediately),
+			},
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func subscriptionName() string {
+	return pubsublite.SubscriptionName(projectID, location, testSubscription)
+}
+
+func observedSubscription(delivery string) *pubsublite.Subscription {
+	return &pubsublite.Subscription{
+		Name:           subscriptionName(),
+		Topic:          topicName(),
+		DeliveryConfig: &pubsublite.DeliveryConfig{DeliveryRequirement: delivery},
+	}
+}
+
+func TestSubscriptionObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotSubscription": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotSubscription),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/admin/"+subscriptionName(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&pubsublite.Subscription{})
+			}),
+			mg: subscriptionObj(),
+			want: want{
+				mg: subscriptionObj(),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedSubscription(v1alpha1.DeliverImmediately))
+			}),
+			mg: subscriptionObj(),
+			want: want{
+				mg: subscriptionObj(
+					subscriptionWithObservation(v1alpha1.LiteSubscriptionObservation{Name: subscriptionName()}),
+					subscriptionWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DeliveryRequirementChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedSubscription(v1alpha1.DeliverImmediately))
+			}),
+			mg: subscriptionObj(subscriptionWithDelivery(v1alpha1.DeliverAfterStored)),
+			want: want{
+				mg: subscriptionObj(
+					subscriptionWithDelivery(v1alpha1.DeliverAfterStored),
+					subscriptionWithObservation(v1alpha1.LiteSubscriptionObservation{Name: subscriptionName()}),
+					subscriptionWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := pubsublite.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := subscriptionExternal{lite: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSubscriptionUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotSubscription": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotSubscription),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("deliveryConfig", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &pubsublite.Subscription{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(&pubsublite.DeliveryConfig{DeliveryRequirement: v1alpha1.DeliverAfterStored}, got.DeliveryConfig); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedSubscription(v1alpha1.DeliverAfterStored))
+			}),
+			mg: subscriptionObj(subscriptionWithDelivery(v1alpha1.DeliverAfterStored)),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&pubsublite.Subscription{})
+			}),
+			mg:   subscriptionObj(subscriptionWithDelivery(v1alpha1.DeliverAfterStored)),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateSubscription),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := pubsublite.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := subscriptionExternal{lite: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pubsublite contains controllers for GCP Pub/Sub Lite resources.
+package pubsublite
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/pubsublite"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new Pub/Sub Lite client"
+	errUpdateCR  = "cannot update Pub/Sub Lite custom resource"
+
+	errNotTopic               = "managed resource is not a Pub/Sub Lite topic"
+	errGetTopic               = "cannot get Pub/Sub Lite topic"
+	errCreateTopic            = "cannot create Pub/Sub Lite topic"
+	errUpdateTopic            = "cannot update Pub/Sub Lite topic"
+	errDeleteTopic            = "cannot delete Pub/Sub Lite topic"
+	errPartitionCountDecrease = "cannot decrease the partition count of a Pub/Sub Lite topic; partitions can only be added"
+)
+
+type newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*pubsublite.Service, error)
+
+// SetupLiteTopic adds a controller that reconciles LiteTopics.
+func SetupLiteTopic(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LiteTopicGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LiteTopic{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LiteTopicGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&topicConnector{kube: mgr.GetClient(), newServiceFn: pubsublite.NewService})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type topicConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *topicConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LiteTopic)
+	if !ok {
+		return nil, errors.New(errNotTopic)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, pubsublite.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	return &topicExternal{kube: c.kube, lite: s, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type topicExternal struct {
+	kube      client.Client
+	lite      *pubsublite.Service
+	projectID string
+}
+
+func (e *topicExternal) name(cr *v1alpha1.LiteTopic) string {
+	return pubsublite.TopicName(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr))
+}
+
+func (e *topicExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LiteTopic)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTopic)
+	}
+	observed, err := e.lite.GetTopic(ctx, e.name(cr))
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTopic)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	pubsublite.LateInitializeTopic(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	cr.Status.AtProvider = pubsublite.GenerateTopicObservation(*observed)
+	cr.SetConditions(runtimev1alpha1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: pubsublite.IsTopicUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *topicExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LiteTopic)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTopic)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.lite.CreateTopic(ctx, e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr), pubsublite.GenerateTopic(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTopic)
+}
+
+// Update updates the topic. Partitions can be added to a topic but never
+// removed, so a decreased partition count is reported as an error rather
+// than sent to the API.
+func (e *topicExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LiteTopic)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTopic)
+	}
+	observed, err := e.lite.GetTopic(ctx, e.name(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTopic)
+	}
+	if pubsublite.IsPartitionCountDecreased(cr.Spec.ForProvider, *observed) {
+		return managed.ExternalUpdate{}, errors.New(errPartitionCountDecrease)
+	}
+	_, err = e.lite.PatchTopic(ctx, e.name(cr), pubsublite.GenerateTopic(cr.Spec.ForProvider), pubsublite.TopicUpdateMask(cr.Spec.ForProvider)...)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTopic)
+}
+
+func (e *topicExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LiteTopic)
+	if !ok {
+		return errors.New(errNotTopic)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	err := e.lite.DeleteTopic(ctx, e.name(cr))
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTopic)
+}
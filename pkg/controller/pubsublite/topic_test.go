@@ -0,0 +1,459 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pubsublite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/pubsublite/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/pubsublite"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	providerName = "gcp-provider"
+	location     = "us-central1-a"
+	testTopic    = "test-topic"
+)
+
+var (
+	_ managed.ExternalConnecter = &topicConnector{}
+	_ managed.ExternalClient    = &topicExternal{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type topicModifier func(*v1alpha1.LiteTopic)
+
+func topicWithConditions(c ...runtimev1alpha1.Condition) topicModifier {
+	return func(t *v1alpha1.LiteTopic) { t.Status.SetConditions(c...) }
+}
+
+func topicWithObservation(o v1alpha1.LiteTopicObservation) topicModifier {
+	return func(t *v1alpha1.LiteTopic) { t.Status.AtProvider = o }
+}
+
+func topicWithPartitions(n int64) topicModifier {
+	return func(t *v1alpha1.LiteTopic) { t.Spec.ForProvider.PartitionConfig.Count = n }
+}
+
+func topicObj(m ...topicModifier) *v1alpha1.LiteTopic {
+	t := &v1alpha1.LiteTopic{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testTopic,
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: testTopic},
+		},
+		Spec: v1alpha1.LiteTopicSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.LiteTopicParameters{
+				Location: location,
+				PartitionConfig: v1alpha1.LitePartitionConfig{
+					Count:    2,
+					Capacity: &v1alpha1.LitePartitionCapacity{PublishMiBPerSec: 4, SubscribeMiBPerSec: 8},
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func topicName() string {
+	return pubsublite.TopicName(projectID, location, testTopic)
+}
+
+func topicPath() string {
+	return "/v1/admin/" + topicName()
+}
+
+func observedTopic(partitions int64) *pubsublite.Topic {
+	return &pubsublite.Topic{
+		Name: topicName(),
+		PartitionConfig: &pubsublite.PartitionConfig{
+			Count:    partitions,
+			Capacity: &pubsublite.Capacity{PublishMibPerSec: 4, SubscribeMibPerSec: 8},
+		},
+	}
+}
+
+func TestTopicObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTopic": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotTopic),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(topicPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&pubsublite.Topic{})
+			}),
+			mg: topicObj(),
+			want: want{
+				mg: topicObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&pubsublite.Topic{})
+			}),
+			mg: topicObj(),
+			want: want{
+				mg:  topicObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetTopic),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedTopic(2))
+			}),
+			mg: topicObj(),
+			want: want{
+				mg: topicObj(
+					topicWithObservation(v1alpha1.LiteTopicObservation{Name: topicName()}),
+					topicWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PartitionsAdded": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedTopic(2))
+			}),
+			mg: topicObj(topicWithPartitions(3)),
+			want: want{
+				mg: topicObj(
+					topicWithPartitions(3),
+					topicWithObservation(v1alpha1.LiteTopicObservation{Name: topicName()}),
+					topicWithConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				o := observedTopic(2)
+				o.RetentionConfig = &pubsublite.RetentionConfig{Period: "86400s"}
+				_ = json.NewEncoder(w).Encode(o)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errors.New("boom"))},
+			mg:   topicObj(),
+			want: want{
+				mg: topicObj(func(t *v1alpha1.LiteTopic) {
+					t.Spec.ForProvider.RetentionConfig = &v1alpha1.LiteRetentionConfig{Period: gcp.StringPtr("86400s")}
+				}),
+				err: errors.Wrap(errors.New("boom"), errUpdateCR),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := pubsublite.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := topicExternal{kube: tc.kube, lite: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTopicCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTopic": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotTopic),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/admin/projects/"+projectID+"/locations/"+location+"/topics", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(testTopic, r.URL.Query().Get("topicId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &pubsublite.Topic{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(pubsublite.GenerateTopic(topicObj().Spec.ForProvider), got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedTopic(2))
+			}),
+			mg: topicObj(),
+			want: want{
+				mg: topicObj(topicWithConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&pubsublite.Topic{})
+			}),
+			mg: topicObj(),
+			want: want{
+				mg:  topicObj(topicWithConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateTopic),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := pubsublite.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := topicExternal{lite: s, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTopicUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotTopic": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotTopic),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedTopic(2))
+					return
+				}
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(topicPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("partitionConfig.count,partitionConfig.capacity", r.URL.Query().Get("updateMask")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &pubsublite.Topic{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(int64(3), got.PartitionConfig.Count); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedTopic(3))
+			}),
+			mg: topicObj(topicWithPartitions(3)),
+		},
+		"PartitionCountDecreased": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedTopic(2))
+			}),
+			mg:   topicObj(topicWithPartitions(1)),
+			want: errors.New(errPartitionCountDecrease),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&pubsublite.Topic{})
+			}),
+			mg:   topicObj(topicWithPartitions(3)),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errGetTopic),
+		},
+		"PatchFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedTopic(2))
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&pubsublite.Topic{})
+			}),
+			mg:   topicObj(topicWithPartitions(3)),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateTopic),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := pubsublite.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := topicExternal{lite: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTopicDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"NotTopic": {
+			mg:   &fake.Managed{},
+			want: errors.New(errNotTopic),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(topicPath(), r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: topicObj(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg: topicObj(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			mg:   topicObj(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTopic),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := pubsublite.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := topicExternal{lite: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
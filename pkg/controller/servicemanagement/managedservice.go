@@ -0,0 +1,231 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemanagement
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	servicemanagement "google.golang.org/api/servicemanagement/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/servicemanagement/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	sm "github.com/crossplane/provider-gcp/pkg/clients/servicemanagement"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new Service Management client"
+
+	errNotManagedService = "managed resource is not a ManagedService"
+	errUpdateCR          = "cannot update ManagedService custom resource"
+	errGetService        = "cannot get managed service"
+	errCreateService     = "cannot create managed service"
+	errDeleteService     = "cannot delete managed service"
+	errGetOperation      = "cannot get config submission operation"
+	errSubmitConfig      = "cannot submit service config"
+	errSubmitFailed      = "service config submission failed: %s"
+	errListRollouts      = "cannot list service rollouts"
+	errCreateRollout     = "cannot create service rollout"
+)
+
+// SetupManagedService adds a controller that reconciles ManagedServices.
+func SetupManagedService(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ManagedServiceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ManagedService{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ManagedServiceGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&connector{kube: mgr.GetClient(), newServiceFn: servicemanagement.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*servicemanagement.APIService, error)
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ManagedService)
+	if !ok {
+		return nil, errors.New(errNotManagedService)
+	}
+
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, servicemanagement.ServiceManagementScope)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := c.newServiceFn(ctx, opts...)
+	return &external{kube: c.kube, sm: svc, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type external struct {
+	kube      client.Client
+	sm        *servicemanagement.APIService
+	projectID string
+}
+
+// Observe observes the service and the rollout of its config. Config
+// submission is asynchronous, so Observe also records the ID of a submitted
+// config version once its submission operation completes.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.ManagedService)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotManagedService)
+	}
+	name := meta.GetExternalName(cr)
+	s, err := e.sm.Services.Get(name).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetService)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	sm.LateInitializeSpec(&cr.Spec.ForProvider, *s)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	obs := &cr.Status.AtProvider
+	obs.ServiceName = s.ServiceName
+
+	// A config that has not yet been submitted must be submitted before
+	// anything else can happen.
+	if obs.ConfigFilesHash != sm.ConfigFilesHash(cr.Spec.ForProvider) {
+		cr.SetConditions(runtimev1alpha1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	if obs.SubmitOperation != "" {
+		op, err := e.sm.Operations.Get(obs.SubmitOperation).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetOperation)
+		}
+		if !op.Done {
+			cr.SetConditions(runtimev1alpha1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		}
+		if op.Error != nil {
+			return managed.ExternalObservation{}, errors.Errorf(errSubmitFailed, op.Error.Message)
+		}
+		id, err := sm.SubmittedConfigID(op)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		obs.SubmittedConfigID = id
+		obs.SubmitOperation = ""
+	}
+
+	rsp, err := e.sm.Services.Rollouts.List(name).PageSize(1).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListRollouts)
+	}
+	var latest *servicemanagement.Rollout
+	if len(rsp.Rollouts) > 0 {
+		latest = rsp.Rollouts[0]
+		obs.RolloutID = latest.RolloutId
+		obs.RolloutStatus = latest.Status
+		if latest.Status == v1alpha1.RolloutStatusSuccess {
+			obs.ConfigID = sm.RolledOutConfigID(latest)
+		}
+	}
+
+	switch obs.RolloutStatus {
+	case v1alpha1.RolloutStatusSuccess:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case "", v1alpha1.RolloutStatusPending, v1alpha1.RolloutStatusInProgress:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: obs.SubmittedConfigID == "" || sm.RollsOut(latest, obs.SubmittedConfigID),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ManagedService)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotManagedService)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.sm.Services.Create(sm.GenerateManagedService(meta.GetExternalName(cr), e.projectID, cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateService)
+}
+
+// Update submits the config files as a new config version if they changed
+// since they were last submitted, and otherwise rolls out the most recently
+// submitted config version.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ManagedService)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotManagedService)
+	}
+	name := meta.GetExternalName(cr)
+	obs := &cr.Status.AtProvider
+
+	if h := sm.ConfigFilesHash(cr.Spec.ForProvider); obs.ConfigFilesHash != h {
+		op, err := e.sm.Services.Configs.Submit(name, sm.GenerateSubmitConfigSourceRequest(cr.Spec.ForProvider)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSubmitConfig)
+		}
+		obs.SubmitOperation = op.Name
+		obs.SubmittedConfigID = ""
+		obs.ConfigFilesHash = h
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if obs.SubmittedConfigID == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.sm.Services.Rollouts.Create(name, sm.GenerateRollout(obs.SubmittedConfigID)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errCreateRollout)
+}
+
+// Delete deletes the service. Deleted services may be undeleted within 30
+// days, during which their name cannot be reused.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ManagedService)
+	if !ok {
+		return errors.New(errNotManagedService)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.sm.Services.Delete(meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteService)
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicemanagement
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	servicemanagement "google.golang.org/api/servicemanagement/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/servicemanagement/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	sm "github.com/crossplane/provider-gcp/pkg/clients/servicemanagement"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	providerName = "gcp-provider"
+	serviceName  = "test-api.endpoints.myproject-id-1234.cloud.goog"
+	operation    = "operations/serviceConfigs.test-api:1234"
+	configID     = "2020-10-01r0"
+	rolloutID    = "2020-10-01r1"
+)
+
+var (
+	_ managed.ExternalConnecter = &connector{}
+	_ managed.ExternalClient    = &external{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type serviceModifier func(*v1alpha1.ManagedService)
+
+func withConditions(c ...runtimev1alpha1.Condition) serviceModifier {
+	return func(s *v1alpha1.ManagedService) { s.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.ManagedServiceObservation) serviceModifier {
+	return func(s *v1alpha1.ManagedService) { s.Status.AtProvider = o }
+}
+
+func withContents(c string) serviceModifier {
+	return func(s *v1alpha1.ManagedService) { s.Spec.ForProvider.ConfigFiles[0].FileContents = c }
+}
+
+func service(m ...serviceModifier) *v1alpha1.ManagedService {
+	s := &v1alpha1.ManagedService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-api",
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: serviceName},
+		},
+		Spec: v1alpha1.ManagedServiceSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.ManagedServiceParameters{
+				ProducerProjectID: gcp.StringPtr(projectID),
+				ConfigFiles: []v1alpha1.ConfigFile{{
+					FilePath:     "openapi.yaml",
+					FileContents: "swagger: '2.0'",
+					FileType:     "OPEN_API_YAML",
+				}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+// hash returns the config files hash of a service built with the supplied
+// modifiers.
+func hash(m ...serviceModifier) string {
+	return sm.ConfigFilesHash(service(m...).Spec.ForProvider)
+}
+
+// handler serves the supplied responses by request path, and fails the test
+// for any other request.
+func handler(t *testing.T, responses map[string]interface{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		rsp, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if code, ok := rsp.(int); ok {
+			w.WriteHeader(code)
+			_ = json.NewEncoder(w).Encode(&servicemanagement.Operation{})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(rsp)
+	})
+}
+
+func TestObserve(t *testing.T) {
+	getService := "GET /v1/services/" + serviceName
+	getOperation := "GET /v1/" + operation
+	listRollouts := "GET /v1/services/" + serviceName + "/rollouts"
+	svc := &servicemanagement.ManagedService{ServiceName: serviceName, ProducerProjectId: projectID}
+
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		responses map[string]interface{}
+		mg        resource.Managed
+		want      want
+	}{
+		"NotManagedService": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotManagedService),
+			},
+		},
+		"NotFound": {
+			responses: map[string]interface{}{getService: http.StatusNotFound},
+			mg:        service(),
+			want: want{
+				mg: service(),
+			},
+		},
+		"GetFailed": {
+			responses: map[string]interface{}{getService: http.StatusBadRequest},
+			mg:        service(),
+			want: want{
+				mg:  service(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetService),
+			},
+		},
+		"ConfigNotSubmitted": {
+			responses: map[string]interface{}{getService: svc},
+			mg:        service(),
+			want: want{
+				mg: service(
+					withObservation(v1alpha1.ManagedServiceObservation{ServiceName: serviceName}),
+					withConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"SubmissionInProgress": {
+			responses: map[string]interface{}{
+				getService:   svc,
+				getOperation: &servicemanagement.Operation{Name: operation},
+			},
+			mg: service(withObservation(v1alpha1.ManagedServiceObservation{SubmitOperation: operation, ConfigFilesHash: hash()})),
+			want: want{
+				mg: service(
+					withObservation(v1alpha1.ManagedServiceObservation{ServiceName: serviceName, SubmitOperation: operation, ConfigFilesHash: hash()}),
+					withConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SubmissionFailed": {
+			responses: map[string]interface{}{
+				getService:   svc,
+				getOperation: &servicemanagement.Operation{Name: operation, Done: true, Error: &servicemanagement.Status{Message: "boom"}},
+			},
+			mg: service(withObservation(v1alpha1.ManagedServiceObservation{SubmitOperation: operation, ConfigFilesHash: hash()})),
+			want: want{
+				mg:  service(withObservation(v1alpha1.ManagedServiceObservation{ServiceName: serviceName, SubmitOperation: operation, ConfigFilesHash: hash()})),
+				err: errors.Errorf(errSubmitFailed, "boom"),
+			},
+		},
+		"SubmittedNotRolledOut": {
+			responses: map[string]interface{}{
+				getService: svc,
+				getOperation: &servicemanagement.Operation{
+					Name:     operation,
+					Done:     true,
+					Response: googleapi.RawMessage(`{"serviceConfig":{"id":"` + configID + `"}}`),
+				},
+				listRollouts: &servicemanagement.ListServiceRolloutsResponse{},
+			},
+			mg: service(withObservation(v1alpha1.ManagedServiceObservation{SubmitOperation: operation, ConfigFilesHash: hash()})),
+			want: want{
+				mg: service(
+					withObservation(v1alpha1.ManagedServiceObservation{ServiceName: serviceName, SubmittedConfigID: configID, ConfigFilesHash: hash()}),
+					withConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RolledOut": {
+			responses: map[string]interface{}{
+				getService: svc,
+				listRollouts: &servicemanagement.ListServiceRolloutsResponse{Rollouts: []*servicemanagement.Rollout{{
+					RolloutId:              rolloutID,
+					Status:                 v1alpha1.RolloutStatusSuccess,
+					TrafficPercentStrategy: sm.GenerateRollout(configID).TrafficPercentStrategy,
+				}}},
+			},
+			mg: service(withObservation(v1alpha1.ManagedServiceObservation{SubmittedConfigID: configID, ConfigFilesHash: hash()})),
+			want: want{
+				mg: service(
+					withObservation(v1alpha1.ManagedServiceObservation{
+						ServiceName:       serviceName,
+						ConfigID:          configID,
+						RolloutID:         rolloutID,
+						RolloutStatus:     v1alpha1.RolloutStatusSuccess,
+						SubmittedConfigID: configID,
+						ConfigFilesHash:   hash(),
+					}),
+					withConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ConfigChanged": {
+			responses: map[string]interface{}{getService: svc},
+			mg: service(
+				withContents("swagger: '2.0'\nhost: test-api"),
+				withObservation(v1alpha1.ManagedServiceObservation{SubmittedConfigID: configID, ConfigFilesHash: hash()})),
+			want: want{
+				mg: service(
+					withContents("swagger: '2.0'\nhost: test-api"),
+					withObservation(v1alpha1.ManagedServiceObservation{ServiceName: serviceName, SubmittedConfigID: configID, ConfigFilesHash: hash()}),
+					withConditions(runtimev1alpha1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(handler(t, tc.responses))
+			defer server.Close()
+			s, _ := servicemanagement.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := external{sm: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	submit := "POST /v1/services/" + serviceName + "/configs:submit"
+	rollout := "POST /v1/services/" + serviceName + "/rollouts"
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		responses map[string]interface{}
+		mg        resource.Managed
+		want      want
+	}{
+		"NotManagedService": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotManagedService),
+			},
+		},
+		"Submit": {
+			responses: map[string]interface{}{submit: &servicemanagement.Operation{Name: operation}},
+			mg:        service(withObservation(v1alpha1.ManagedServiceObservation{SubmittedConfigID: configID, ConfigFilesHash: "old"})),
+			want: want{
+				mg: service(withObservation(v1alpha1.ManagedServiceObservation{SubmitOperation: operation, ConfigFilesHash: hash()})),
+			},
+		},
+		"SubmitFailed": {
+			responses: map[string]interface{}{submit: http.StatusBadRequest},
+			mg:        service(),
+			want: want{
+				mg:  service(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errSubmitConfig),
+			},
+		},
+		"Rollout": {
+			responses: map[string]interface{}{rollout: &servicemanagement.Operation{}},
+			mg:        service(withObservation(v1alpha1.ManagedServiceObservation{SubmittedConfigID: configID, ConfigFilesHash: hash()})),
+			want: want{
+				mg: service(withObservation(v1alpha1.ManagedServiceObservation{SubmittedConfigID: configID, ConfigFilesHash: hash()})),
+			},
+		},
+		"RolloutFailed": {
+			responses: map[string]interface{}{rollout: http.StatusBadRequest},
+			mg:        service(withObservation(v1alpha1.ManagedServiceObservation{SubmittedConfigID: configID, ConfigFilesHash: hash()})),
+			want: want{
+				mg:  service(withObservation(v1alpha1.ManagedServiceObservation{SubmittedConfigID: configID, ConfigFilesHash: hash()})),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateRollout),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(handler(t, tc.responses))
+			defer server.Close()
+			s, _ := servicemanagement.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := external{sm: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
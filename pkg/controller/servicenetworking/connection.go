@@ -25,36 +25,29 @@ import (
 	compute "google.golang.org/api/compute/v1"
 	"google.golang.org/api/option"
 	servicenetworking "google.golang.org/api/servicenetworking/v1"
-	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
-	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-gcp/apis/servicenetworking/v1beta1"
-	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/connection"
 )
 
 // Error strings.
 const (
-	errGetProvider       = "cannot get provider"
-	errProviderSecretNil = "cannot find Secret reference on Provider"
-	errGetProviderSecret = "cannot get provider secret"
-	errNewClient         = "cannot create new Compute Service"
-	errNotConnection     = "managed resource is not a Connection"
-	errListConnections   = "cannot list external Connection resources"
-	errGetNetwork        = "cannot get VPC Network"
-	errCreateConnection  = "cannot create external Connection resource"
-	errUpdateConnection  = "cannot update external Connection resource"
-	errDeleteConnection  = "cannot delete external Connection resource"
+	errNewClient        = "cannot create new Compute Service"
+	errNotConnection    = "managed resource is not a Connection"
+	errListConnections  = "cannot list external Connection resources"
+	errGetNetwork       = "cannot get VPC Network"
+	errCreateConnection = "cannot create external Connection resource"
+	errUpdateConnection = "cannot update external Connection resource"
+	errDeleteConnection = "cannot delete external Connection resource"
 )
 
 // NOTE(negz): There is no 'Get' method for connections, only 'List', and the
@@ -91,7 +84,7 @@ func SetupConnection(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1beta1.Connection{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.ConnectionGroupVersionKind),
-			managed.WithExternalConnecter(conn),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(conn)),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -110,31 +103,25 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.New(errNotConnection)
 	}
 
-	p := &gcpv1alpha3.Provider{}
-	if err := c.client.Get(ctx, meta.NamespacedNameOf(ga.Spec.ProviderReference), p); err != nil {
-		return nil, errors.Wrap(err, errGetProvider)
+	p, creds, err := gcp.ProviderCredentials(ctx, c.client, ga.Spec.ProviderReference)
+	if err != nil {
+		return nil, err
 	}
 
-	if p.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretNil)
+	opts, err := gcp.CredentialsClientOptions(ctx, creds, p.Spec.HTTPClient, compute.ComputeScope)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
 	}
-
-	s := &v1.Secret{}
-	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
-	if err := c.client.Get(ctx, n, s); err != nil {
-		return nil, errors.Wrap(err, errGetProviderSecret)
+	cmp, err := c.newCompute(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	cmp, err := c.newCompute(ctx,
-		option.WithCredentialsJSON(s.Data[p.Spec.CredentialsSecretRef.Key]),
-		option.WithScopes(compute.ComputeScope))
+	opts, err = gcp.CredentialsClientOptions(ctx, creds, p.Spec.HTTPClient, servicenetworking.ServiceManagementScope)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-
-	sn, err := c.newServiceNetworking(ctx,
-		option.WithCredentialsJSON(s.Data[p.Spec.CredentialsSecretRef.Key]),
-		option.WithScopes(servicenetworking.ServiceManagementScope))
+	sn, err := c.newServiceNetworking(ctx, opts...)
 	return &external{sn: sn, compute: cmp, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
 }
 
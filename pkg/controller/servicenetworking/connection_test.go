@@ -146,7 +146,7 @@ func TestConnect(t *testing.T) {
 				mg: conn(),
 			},
 			want: want{
-				err: errors.Wrap(errBoom, errGetProvider),
+				err: errors.Wrap(errBoom, "cannot get Provider"),
 			},
 		},
 		"FailedToGetProviderSecret": {
@@ -162,7 +162,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: conn()},
-			want: want{err: errors.Wrap(errBoom, errGetProviderSecret)},
+			want: want{err: errors.Wrap(errBoom, "cannot get Provider Secret")},
 		},
 		"ProviderSecretNil": {
 			conn: &connector{
@@ -179,7 +179,7 @@ func TestConnect(t *testing.T) {
 				}},
 			},
 			args: args{mg: conn()},
-			want: want{err: errors.New(errProviderSecretNil)},
+			want: want{err: errors.New("cannot find Secret reference on Provider")},
 		},
 		"FailedToCreateComputeClient": {
 			conn: &connector{
@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	serviceusage "google.golang.org/api/serviceusage/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	su "github.com/crossplane/provider-gcp/pkg/clients/serviceusage"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new Service Usage client"
+
+	errNotService        = "managed resource is not a Service"
+	errGetService        = "cannot get service"
+	errEnableService     = "cannot enable service"
+	errDisableService    = "cannot disable service"
+	errDependentServices = "cannot disable service because enabled services depend on it; set disableDependentServices to disable them too"
+)
+
+// SetupService adds a controller that reconciles Services.
+func SetupService(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.ServiceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Service{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&connector{kube: mgr.GetClient(), newServiceFn: serviceusage.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube         client.Client
+	newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*serviceusage.Service, error)
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return nil, errors.New(errNotService)
+	}
+
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, serviceusage.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := c.newServiceFn(ctx, opts...)
+	return &external{su: svc, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+type external struct {
+	su        *serviceusage.Service
+	projectID string
+}
+
+// name returns the resource name of the supplied Service.
+func (e *external) name(cr *v1alpha1.Service) string {
+	project := e.projectID
+	if cr.Spec.ForProvider.Project != nil {
+		project = *cr.Spec.ForProvider.Project
+	}
+	return su.ServiceName(project, meta.GetExternalName(cr))
+}
+
+// Observe observes the state of the service. A disabled service is
+// considered not to exist, so that it is enabled when created and
+// considered deleted once disabled.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotService)
+	}
+	s, err := e.su.Services.Get(e.name(cr)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetService)
+	}
+	cr.Status.AtProvider = su.GenerateServiceObservation(*s)
+	if s.State != v1alpha1.StateEnabled {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	// DisableDependentServices only affects how the service is disabled, so
+	// an enabled service is always up to date.
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create enables the service. Enabling is a long running operation; Observe
+// reports the service as existing once it completes.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotService)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	_, err := e.su.Services.Enable(e.name(cr), &serviceusage.EnableServiceRequest{}).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errEnableService)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete disables the service. Disabling a service that enabled services
+// depend on fails unless DisableDependentServices is set.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return errors.New(errNotService)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	_, err := e.su.Services.Disable(e.name(cr), su.GenerateDisableServiceRequest(cr.Spec.ForProvider)).Context(ctx).Do()
+	if su.IsErrorDependentServices(err) {
+		return errors.Wrap(err, errDependentServices)
+	}
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDisableService)
+}
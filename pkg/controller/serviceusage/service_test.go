@@ -0,0 +1,320 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	serviceusage "google.golang.org/api/serviceusage/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	providerName = "gcp-provider"
+	serviceName  = "compute.googleapis.com"
+	dependents   = "The service compute.googleapis.com is depended on by the following active service(s): container.googleapis.com"
+)
+
+var (
+	_ managed.ExternalConnecter = &connector{}
+	_ managed.ExternalClient    = &external{}
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type serviceModifier func(*v1alpha1.Service)
+
+func withConditions(c ...runtimev1alpha1.Condition) serviceModifier {
+	return func(s *v1alpha1.Service) { s.Status.SetConditions(c...) }
+}
+
+func withObservation(o v1alpha1.ServiceObservation) serviceModifier {
+	return func(s *v1alpha1.Service) { s.Status.AtProvider = o }
+}
+
+func withProject(p string) serviceModifier {
+	return func(s *v1alpha1.Service) { s.Spec.ForProvider.Project = &p }
+}
+
+func service(m ...serviceModifier) *v1alpha1.Service {
+	s := &v1alpha1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "compute-api",
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: serviceName},
+		},
+		Spec: v1alpha1.ServiceSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+// handler serves the supplied responses by request path, and fails the test
+// for any other request. Status codes are served with an empty body, and
+// *googleapi.Errors with their code and message.
+func handler(t *testing.T, responses map[string]interface{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		rsp, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch v := rsp.(type) {
+		case int:
+			w.WriteHeader(v)
+			_ = json.NewEncoder(w).Encode(&serviceusage.Operation{})
+		case *googleapi.Error:
+			w.WriteHeader(v.Code)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"error": map[string]interface{}{"code": v.Code, "message": v.Message}})
+		default:
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(rsp)
+		}
+	})
+}
+
+func TestObserve(t *testing.T) {
+	name := "projects/" + projectID + "/services/" + serviceName
+	getService := "GET /v1/" + name
+
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		responses map[string]interface{}
+		mg        resource.Managed
+		want      want
+	}{
+		"NotService": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotService),
+			},
+		},
+		"NotFound": {
+			responses: map[string]interface{}{getService: http.StatusNotFound},
+			mg:        service(),
+			want: want{
+				mg: service(),
+			},
+		},
+		"GetFailed": {
+			responses: map[string]interface{}{getService: http.StatusForbidden},
+			mg:        service(),
+			want: want{
+				mg:  service(),
+				err: errors.Wrap(gError(http.StatusForbidden, ""), errGetService),
+			},
+		},
+		"Disabled": {
+			responses: map[string]interface{}{getService: &serviceusage.GoogleApiServiceusageV1Service{Name: name, State: v1alpha1.StateDisabled}},
+			mg:        service(),
+			want: want{
+				mg: service(withObservation(v1alpha1.ServiceObservation{Name: name, State: v1alpha1.StateDisabled})),
+			},
+		},
+		"Enabled": {
+			responses: map[string]interface{}{getService: &serviceusage.GoogleApiServiceusageV1Service{
+				Name:   name,
+				State:  v1alpha1.StateEnabled,
+				Config: &serviceusage.GoogleApiServiceusageV1ServiceConfig{Title: "Compute Engine API"},
+			}},
+			mg: service(),
+			want: want{
+				mg: service(
+					withObservation(v1alpha1.ServiceObservation{Name: name, Title: "Compute Engine API", State: v1alpha1.StateEnabled}),
+					withConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"OtherProject": {
+			responses: map[string]interface{}{"GET /v1/projects/other/services/" + serviceName: &serviceusage.GoogleApiServiceusageV1Service{State: v1alpha1.StateEnabled}},
+			mg:        service(withProject("other")),
+			want: want{
+				mg: service(
+					withProject("other"),
+					withObservation(v1alpha1.ServiceObservation{State: v1alpha1.StateEnabled}),
+					withConditions(runtimev1alpha1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(handler(t, tc.responses))
+			defer server.Close()
+			s, _ := serviceusage.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := external{su: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	enable := "POST /v1/projects/" + projectID + "/services/" + serviceName + ":enable"
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		responses map[string]interface{}
+		mg        resource.Managed
+		want      want
+	}{
+		"Successful": {
+			responses: map[string]interface{}{enable: &serviceusage.Operation{Name: "operations/enable"}},
+			mg:        service(),
+			want: want{
+				mg: service(withConditions(runtimev1alpha1.Creating())),
+			},
+		},
+		"Failed": {
+			responses: map[string]interface{}{enable: gError(http.StatusForbidden, "Not found or permission denied")},
+			mg:        service(),
+			want: want{
+				mg:  service(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(gError(http.StatusForbidden, "Not found or permission denied"), errEnableService),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(handler(t, tc.responses))
+			defer server.Close()
+			s, _ := serviceusage.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := external{su: s, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	disable := "POST /v1/projects/" + projectID + "/services/" + serviceName + ":disable"
+
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		responses map[string]interface{}
+		mg        resource.Managed
+		want      want
+	}{
+		"Successful": {
+			responses: map[string]interface{}{disable: &serviceusage.Operation{Name: "operations/disable"}},
+			mg:        service(),
+			want: want{
+				mg: service(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"NotFound": {
+			responses: map[string]interface{}{disable: http.StatusNotFound},
+			mg:        service(),
+			want: want{
+				mg: service(withConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DependentServices": {
+			responses: map[string]interface{}{disable: gError(http.StatusBadRequest, dependents)},
+			mg:        service(),
+			want: want{
+				mg:  service(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, dependents), errDependentServices),
+			},
+		},
+		"Failed": {
+			responses: map[string]interface{}{disable: http.StatusForbidden},
+			mg:        service(),
+			want: want{
+				mg:  service(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusForbidden, ""), errDisableService),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(handler(t, tc.responses))
+			defer server.Close()
+			s, _ := serviceusage.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := external{su: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,15 +19,14 @@ package storage
 import (
 	"context"
 	"reflect"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/pkg/errors"
-	"golang.org/x/oauth2/google"
-	"google.golang.org/api/option"
+	"google.golang.org/api/googleapi"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -38,7 +37,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
-	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	gcpstorage "github.com/crossplane/provider-gcp/pkg/clients/storage"
 )
 
@@ -48,16 +47,39 @@ const (
 
 	reconcileTimeout      = 1 * time.Minute
 	requeueAfterOnSuccess = 30 * time.Second
+
+	// A reference that cannot be resolved, for example to a ServiceAccount
+	// that has not yet been created, is retried after this long.
+	requeueAfterUnresolved = 10 * time.Second
 )
 
+// reasonRelocationUnsupported is the reason GCS reports when a bucket cannot
+// be relocated between its current and the desired location. Other errors,
+// for example for a desired location that does not exist, use other reasons.
+const reasonRelocationUnsupported = "relocationNotSupported"
+
 // Error strings
 const (
-	errProviderSecretNil = "cannot find Secret reference on Provider"
+	errNoncurrentWithoutVersioning = "lifecycle rule %d matches only noncurrent object versions, but versioning is not enabled"
+	errImmutableAttrs              = "cannot change %s of an existing bucket; annotate the bucket with %s: \"true\" to delete and recreate it"
+	errCheckBucketEmpty            = "cannot determine whether bucket is empty"
+	errRecreateNotEmpty            = "cannot recreate bucket to change %s: the bucket is not empty"
+	errDeleteChanged               = "bucket was changed since it was last observed; deleting it once its changes are observed"
+	errRelocate                    = "cannot relocate bucket"
+	errGetRelocation               = "cannot get relocation of bucket"
+	errRelocationFailed            = "cannot relocate bucket to %s: %s"
+	errGetBucketPolicy             = "cannot get IAM policy of bucket"
+	errGrantServiceAccount         = "cannot grant service account its role on bucket"
+	errRemoveLifecycle             = "cannot remove lifecycle rules of bucket"
+	errShortenLockedRetention      = "cannot shorten or remove a locked retention period of %d seconds"
+	errLockWithoutRetention        = "cannot lock a retention policy without a retention period"
+	errLockRetentionPolicy         = "cannot lock retention policy of bucket"
 )
 
 var (
-	resultRequeue    = reconcile.Result{Requeue: true}
-	requeueOnSuccess = reconcile.Result{RequeueAfter: requeueAfterOnSuccess}
+	resultRequeue     = reconcile.Result{Requeue: true}
+	requeueOnSuccess  = reconcile.Result{RequeueAfter: requeueAfterOnSuccess}
+	requeueUnresolved = reconcile.Result{RequeueAfter: requeueAfterUnresolved}
 )
 
 // Reconciler reconciles a GCP storage bucket bucket
@@ -65,6 +87,7 @@ type Reconciler struct {
 	client.Client
 	factory
 	initializer managed.Initializer
+	resolver    managed.ReferenceResolver
 	log         logging.Logger
 }
 
@@ -78,6 +101,7 @@ func SetupBucket(mgr ctrl.Manager, l logging.Logger) error {
 		factory:     &bucketFactory{mgr.GetClient()},
 		log:         l.WithValues("controller", name),
 		initializer: managed.NewNameAsExternalName(mgr.GetClient()),
+		resolver:    managed.NewAPISimpleReferenceResolver(mgr.GetClient()),
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -106,6 +130,16 @@ func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, err
 		return reconcile.Result{}, err
 	}
 
+	// References are not resolved for a bucket being deleted, because the
+	// referenced resources may already be gone. A reference that cannot be
+	// resolved yet is reported and retried rather than returned as an error.
+	if b.DeletionTimestamp == nil {
+		if err := r.resolver.ResolveReferences(ctx, b); err != nil {
+			b.Status.SetConditions(runtimev1alpha1.ReconcileError(err))
+			return requeueUnresolved, r.Status().Update(ctx, b)
+		}
+	}
+
 	bh, err := r.newSyncDeleter(ctx, b)
 	if err != nil {
 		b.Status.SetConditions(runtimev1alpha1.ReconcileError(err))
@@ -129,40 +163,37 @@ type bucketFactory struct {
 }
 
 func (m *bucketFactory) newSyncDeleter(ctx context.Context, b *v1alpha3.Bucket) (syncdeleter, error) {
-	p := &gcpv1alpha3.Provider{}
-	if err := m.Get(ctx, meta.NamespacedNameOf(b.Spec.ProviderReference), p); err != nil {
+	opts, p, err := gcp.ProviderClientOptions(ctx, m.Client, b.Spec.ProviderReference, storage.ScopeFullControl)
+	if err != nil {
 		return nil, err
 	}
 
-	if p.GetCredentialsSecretReference() == nil {
-		return nil, errors.New(errProviderSecretNil)
-	}
-
-	s := &corev1.Secret{}
-	n := types.NamespacedName{Namespace: p.Spec.CredentialsSecretRef.Namespace, Name: p.Spec.CredentialsSecretRef.Name}
-	if err := m.Get(ctx, n, s); err != nil {
-		return nil, errors.Wrapf(err, "cannot get provider's secret %s", n)
+	opts = gcp.WithEndpoint(opts, p.Spec.Endpoints, gcp.APIStorage)
+	sc, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating storage client")
 	}
 
-	creds, err := google.CredentialsFromJSON(context.Background(), s.Data[p.Spec.CredentialsSecretRef.Key], storage.ScopeFullControl)
+	rs, err := gcpstorage.NewRelocationService(ctx, opts...)
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot retrieve creds from json")
+		return nil, errors.Wrapf(err, "error creating storage relocation client")
 	}
 
-	sc, err := storage.NewClient(ctx, option.WithCredentials(creds))
+	ps, err := gcpstorage.NewPatchService(ctx, opts...)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error creating storage client")
+		return nil, errors.Wrapf(err, "error creating storage patch client")
 	}
 
+	name := meta.GetExternalName(b)
 	ops := &bucketHandler{
 		Bucket: b,
-		gcp:    &gcpstorage.BucketClient{BucketHandle: sc.Bucket(meta.GetExternalName(b))},
+		gcp:    &gcpstorage.BucketClient{BucketHandle: sc.Bucket(name), Name: name, Relocations: rs, Patches: ps},
 		kube:   m.Client,
 	}
 
 	return &bucketSyncDeleter{
 		operations:    ops,
-		createupdater: &bucketCreateUpdater{operations: ops, projectID: creds.ProjectID},
+		createupdater: &bucketCreateUpdater{operations: ops, projectID: p.Spec.ProjectID, defaultLocation: p.Spec.DefaultRegion},
 	}, nil
 
 }
@@ -188,7 +219,11 @@ func (bh *bucketSyncDeleter) delete(ctx context.Context) (reconcile.Result, erro
 	bh.setStatusConditions(runtimev1alpha1.Deleting())
 
 	if bh.isReclaimDelete() {
-		if err := bh.deleteBucket(ctx); err != nil && err != storage.ErrBucketNotExist {
+		err := bh.deleteBucket(ctx, bh.getStatusMetageneration())
+		if gcp.IsErrorPreconditionFailed(err) {
+			return bh.observeChanged(ctx)
+		}
+		if err != nil && err != storage.ErrBucketNotExist {
 			bh.setStatusConditions(runtimev1alpha1.ReconcileError(err))
 			return resultRequeue, bh.updateStatus(ctx)
 		}
@@ -201,6 +236,24 @@ func (bh *bucketSyncDeleter) delete(ctx context.Context) (reconcile.Result, erro
 	return reconcile.Result{}, bh.updateObject(ctx)
 }
 
+// observeChanged records the attributes of a bucket that could not be deleted
+// because it was changed since it was last observed, and requeues so that
+// deleting it is retried against the changed bucket.
+func (bh *bucketSyncDeleter) observeChanged(ctx context.Context) (reconcile.Result, error) {
+	attrs, err := bh.getAttributes(ctx)
+	if err == storage.ErrBucketNotExist {
+		bh.removeFinalizer()
+		return reconcile.Result{}, bh.updateObject(ctx)
+	}
+	if err != nil {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(err))
+		return resultRequeue, bh.updateStatus(ctx)
+	}
+	bh.setStatusAttrs(attrs)
+	bh.setStatusConditions(runtimev1alpha1.ReconcileError(errors.New(errDeleteChanged)))
+	return resultRequeue, bh.updateStatus(ctx)
+}
+
 // sync - synchronizes the state of the bucket resource with the state of the
 // bucket Kubernetes bucket
 func (bh *bucketSyncDeleter) sync(ctx context.Context) (reconcile.Result, error) {
@@ -218,6 +271,11 @@ func (bh *bucketSyncDeleter) sync(ctx context.Context) (reconcile.Result, error)
 		return bh.create(ctx)
 	}
 
+	if err := bh.grantServiceAccount(ctx); err != nil {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(err))
+		return resultRequeue, bh.updateStatus(ctx)
+	}
+
 	return bh.update(ctx, attrs)
 }
 
@@ -231,6 +289,9 @@ type createupdater interface {
 type bucketCreateUpdater struct {
 	operations
 	projectID string
+
+	// defaultLocation is the location of buckets created without one.
+	defaultLocation string
 }
 
 // newBucketCreateUpdater new instance of bucketCreateUpdater
@@ -246,6 +307,15 @@ func (bh *bucketCreateUpdater) create(ctx context.Context) (reconcile.Result, er
 	bh.setStatusConditions(runtimev1alpha1.Creating())
 	bh.addFinalizer()
 
+	// GCS creates buckets without a location in the US multi-region, so unlike
+	// most resources a bucket need not have a location. The default location
+	// only applies when the bucket is created; the location it was created in
+	// is synced back to the spec, so that a later change to the default does
+	// not relocate the bucket.
+	if bh.defaultLocation != "" && bh.getSpecLocation() == "" {
+		bh.setSpecLocation(bh.defaultLocation)
+	}
+
 	if err := bh.createBucket(ctx, bh.projectID); err != nil {
 		bh.setStatusConditions(runtimev1alpha1.ReconcileError(err))
 		return resultRequeue, bh.updateStatus(ctx)
@@ -271,15 +341,77 @@ func (bh *bucketCreateUpdater) create(ctx context.Context) (reconcile.Result, er
 
 // update bucket resource if needed
 func (bh *bucketCreateUpdater) update(ctx context.Context, attrs *storage.BucketAttrs) (reconcile.Result, error) {
+	if r := bh.getStatusRelocation(); r != nil {
+		// A failed relocation is only reported until the location changes;
+		// relocating to a different location is attempted afresh.
+		if r.Failure == "" || strings.EqualFold(r.DestinationLocation, bh.getSpecLocation()) {
+			return bh.relocating(ctx, r)
+		}
+		bh.setStatusRelocation(nil)
+	}
+
+	// Immutable attributes must be handled first; updating the bucket syncs
+	// its observed attributes back to the spec, which would discard them.
+	if changed := bh.getChangedImmutableAttrs(attrs); len(changed) > 0 {
+		if len(changed) == 1 && changed[0] == "location" {
+			return bh.relocate(ctx, attrs, changed)
+		}
+		return bh.recreate(ctx, attrs, changed)
+	}
+
 	current := v1alpha3.NewBucketUpdatableAttrs(attrs)
-	if reflect.DeepEqual(*current, bh.getSpecAttrs()) {
+	desired := bh.getSpecAttrs()
+
+	// There is no point retrying quickly until the spec changes, which
+	// triggers a sync anyway.
+	if err := validateRetentionPolicy(desired.RetentionPolicy, attrs.RetentionPolicy); err != nil {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(err))
+		return requeueOnSuccess, bh.updateStatus(ctx)
+	}
+
+	// Lifecycle rules are only compared if the spec manages them. Retention
+	// policies are locked separately from updating the bucket.
+	upToDate := false
+	lifecycleUpToDate := desired.Lifecycle == nil || gcpstorage.IsLifecycleUpToDate(*desired.Lifecycle, *current.Lifecycle)
+	if gcp.LabelsUpToDate(desired.Labels, current.Labels) && lifecycleUpToDate {
+		current.Labels, desired.Labels = nil, nil
+		current.Lifecycle, desired.Lifecycle = nil, nil
+		current.RetentionPolicy, desired.RetentionPolicy = withoutLock(current.RetentionPolicy), withoutLock(desired.RetentionPolicy)
+		upToDate = reflect.DeepEqual(*current, desired)
+	}
+	if upToDate && !needsRetentionLock(bh.getSpecAttrs().RetentionPolicy, attrs.RetentionPolicy) {
 		return requeueOnSuccess, nil
 	}
 
-	attrs, err := bh.updateBucket(ctx, attrs.Labels)
-	if err != nil {
-		bh.setStatusConditions(runtimev1alpha1.ReconcileError(err))
-		return resultRequeue, bh.updateStatus(ctx)
+	if !upToDate {
+		var err error
+		attrs, err = bh.updateBucket(ctx, attrs)
+		if gcp.IsErrorPreconditionFailed(err) {
+			// The bucket was changed after we observed it. Requeue to observe
+			// it again rather than overwriting the change with a stale update.
+			return resultRequeue, nil
+		}
+		if err != nil {
+			bh.setStatusConditions(runtimev1alpha1.ReconcileError(err))
+			return resultRequeue, bh.updateStatus(ctx)
+		}
+	}
+
+	// A retention policy is locked once, after its retention period is set.
+	// Once locked, the observed policy no longer needs to be locked.
+	if needsRetentionLock(bh.getSpecAttrs().RetentionPolicy, attrs.RetentionPolicy) {
+		err := bh.lockRetentionPolicy(ctx, attrs.MetaGeneration)
+		if gcp.IsErrorPreconditionFailed(err) {
+			return resultRequeue, nil
+		}
+		if err != nil {
+			bh.setStatusConditions(runtimev1alpha1.ReconcileError(errors.Wrap(err, errLockRetentionPolicy)))
+			return resultRequeue, bh.updateStatus(ctx)
+		}
+		if attrs, err = bh.getAttributes(ctx); err != nil {
+			bh.setStatusConditions(runtimev1alpha1.ReconcileError(err))
+			return resultRequeue, bh.updateStatus(ctx)
+		}
 	}
 
 	// Sync attributes back to spec
@@ -288,6 +420,112 @@ func (bh *bucketCreateUpdater) update(ctx context.Context, attrs *storage.Bucket
 		return resultRequeue, err
 	}
 
+	bh.setStatusAttrs(attrs)
 	bh.setStatusConditions(runtimev1alpha1.ReconcileSuccess())
 	return requeueOnSuccess, bh.updateStatus(ctx)
 }
+
+// relocate starts relocating a bucket whose location was changed. Buckets can
+// only be relocated between some locations; those that cannot be relocated
+// are recreated instead, if they opt in to it.
+func (bh *bucketCreateUpdater) relocate(ctx context.Context, attrs *storage.BucketAttrs, changed []string) (reconcile.Result, error) {
+	op, err := bh.relocateBucket(ctx)
+	if isErrorRelocationUnsupported(err) {
+		return bh.recreate(ctx, attrs, changed)
+	}
+	if err != nil {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(errors.Wrap(err, errRelocate)))
+		return resultRequeue, bh.updateStatus(ctx)
+	}
+
+	bh.setStatusRelocation(op)
+	bh.setStatusConditions(runtimev1alpha1.ReconcileSuccess())
+	return requeueOnSuccess, bh.updateStatus(ctx)
+}
+
+// relocating reports the progress of the supplied relocation. The bucket is
+// not otherwise updated until the relocation is done.
+func (bh *bucketCreateUpdater) relocating(ctx context.Context, r *v1alpha3.BucketRelocation) (reconcile.Result, error) {
+	// There is no point retrying a failed relocation until the location
+	// changes, which triggers a sync anyway.
+	if r.Failure != "" {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(errors.Errorf(errRelocationFailed, r.DestinationLocation, r.Failure)))
+		return requeueOnSuccess, bh.updateStatus(ctx)
+	}
+
+	op, err := bh.getRelocation(ctx, r.Operation)
+	if err != nil {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(errors.Wrap(err, errGetRelocation)))
+		return resultRequeue, bh.updateStatus(ctx)
+	}
+
+	switch {
+	case !op.Done:
+		bh.setStatusRelocation(op)
+		bh.setStatusConditions(runtimev1alpha1.ReconcileSuccess())
+		return requeueOnSuccess, bh.updateStatus(ctx)
+	case op.Error != nil:
+		bh.setStatusRelocation(op)
+		r = bh.getStatusRelocation()
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(errors.Errorf(errRelocationFailed, r.DestinationLocation, r.Failure)))
+		return requeueOnSuccess, bh.updateStatus(ctx)
+	}
+
+	// The relocated bucket's attributes were observed before the relocation
+	// was done. Requeue to observe them again.
+	bh.setStatusRelocation(nil)
+	bh.setStatusConditions(runtimev1alpha1.ReconcileSuccess())
+	return resultRequeue, bh.updateStatus(ctx)
+}
+
+// isErrorRelocationUnsupported returns true if the supplied error indicates
+// that a bucket cannot be relocated to the desired location. Only that reason
+// is matched, because the bucket may be deleted and recreated instead, which
+// must not happen because of, for example, a typo in the desired location.
+func isErrorRelocationUnsupported(err error) bool {
+	gerr, ok := err.(*googleapi.Error)
+	if !ok {
+		return false
+	}
+	for _, e := range gerr.Errors {
+		if e.Reason == reasonRelocationUnsupported {
+			return true
+		}
+	}
+	return false
+}
+
+// recreate deletes a bucket whose immutable attributes were changed, so that it
+// is created with the desired attributes by a subsequent sync. Deleting a
+// bucket deletes its objects, so buckets are only recreated if they opt in to
+// it and are empty. GCS also refuses to delete buckets that are not empty,
+// which covers objects written after the bucket was found to be empty, and the
+// bucket is only deleted if it was not changed since it was observed.
+func (bh *bucketCreateUpdater) recreate(ctx context.Context, attrs *storage.BucketAttrs, changed []string) (reconcile.Result, error) {
+	names := strings.Join(changed, " and ")
+
+	// There is no point retrying quickly until the spec or the annotation
+	// changes, which triggers a sync anyway.
+	if !bh.isRecreate() {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(errors.Errorf(errImmutableAttrs, names, gcp.AnnotationKeyRecreate)))
+		return requeueOnSuccess, bh.updateStatus(ctx)
+	}
+
+	empty, err := bh.isBucketEmpty(ctx)
+	if err != nil {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(errors.Wrap(err, errCheckBucketEmpty)))
+		return resultRequeue, bh.updateStatus(ctx)
+	}
+	if !empty {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(errors.Errorf(errRecreateNotEmpty, names)))
+		return requeueOnSuccess, bh.updateStatus(ctx)
+	}
+
+	if err := bh.deleteBucket(ctx, attrs.MetaGeneration); err != nil && err != storage.ErrBucketNotExist {
+		bh.setStatusConditions(runtimev1alpha1.ReconcileError(err))
+		return resultRequeue, bh.updateStatus(ctx)
+	}
+
+	bh.setStatusConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileSuccess())
+	return resultRequeue, bh.updateStatus(ctx)
+}
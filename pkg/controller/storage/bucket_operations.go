@@ -18,7 +18,10 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"cloud.google.com/go/iam"
 	"cloud.google.com/go/storage"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -31,7 +34,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane/provider-gcp/apis/connection"
 	"github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	gcpstorage "github.com/crossplane/provider-gcp/pkg/clients/storage"
 )
 
@@ -40,9 +45,16 @@ type operations interface {
 	addFinalizer()
 	removeFinalizer()
 	isReclaimDelete() bool
+	isRecreate() bool
+	getChangedImmutableAttrs(*storage.BucketAttrs) []string
 	getSpecAttrs() v1alpha3.BucketUpdatableAttrs
+	getSpecLocation() string
+	getStatusMetageneration() int64
+	getStatusRelocation() *v1alpha3.BucketRelocation
 	setSpecAttrs(*storage.BucketAttrs)
+	setSpecLocation(string)
 	setStatusAttrs(*storage.BucketAttrs)
+	setStatusRelocation(*gcpstorage.Operation)
 	setStatusConditions(c ...runtimev1alpha1.Condition)
 	setBindable()
 
@@ -53,9 +65,14 @@ type operations interface {
 
 	// GCP Storage Client operations
 	createBucket(ctx context.Context, projectID string) error
-	deleteBucket(ctx context.Context) error
-	updateBucket(ctx context.Context, labels map[string]string) (*storage.BucketAttrs, error)
+	deleteBucket(ctx context.Context, metageneration int64) error
+	updateBucket(ctx context.Context, observed *storage.BucketAttrs) (*storage.BucketAttrs, error)
 	getAttributes(ctx context.Context) (*storage.BucketAttrs, error)
+	isBucketEmpty(ctx context.Context) (bool, error)
+	relocateBucket(ctx context.Context) (*gcpstorage.Operation, error)
+	getRelocation(ctx context.Context, name string) (*gcpstorage.Operation, error)
+	grantServiceAccount(ctx context.Context) error
+	lockRetentionPolicy(ctx context.Context, metageneration int64) error
 }
 
 type bucketHandler struct {
@@ -89,18 +106,94 @@ func (bh *bucketHandler) isReclaimDelete() bool {
 	return bh.Spec.ReclaimPolicy == runtimev1alpha1.ReclaimDelete
 }
 
+func (bh *bucketHandler) isRecreate() bool {
+	return gcp.ShouldRecreate(bh)
+}
+
+// getChangedImmutableAttrs returns the JSON names of the spec attributes that
+// differ from the supplied attributes but cannot be updated in place. Unset
+// spec attributes are not considered changed.
+func (bh *bucketHandler) getChangedImmutableAttrs(attrs *storage.BucketAttrs) []string {
+	var changed []string
+	spec := bh.Spec.GetSpecAttrs()
+	// GCS reports locations in upper case regardless of how they were
+	// specified.
+	if l := spec.Location; l != "" && !strings.EqualFold(l, attrs.Location) {
+		changed = append(changed, "location")
+	}
+	if c := spec.StorageClass; c != "" && c != attrs.StorageClass {
+		changed = append(changed, "storageClass")
+	}
+	return changed
+}
+
 func (bh *bucketHandler) getSpecAttrs() v1alpha3.BucketUpdatableAttrs {
-	return bh.Spec.BucketUpdatableAttrs
+	return bh.Spec.GetSpecAttrs().BucketUpdatableAttrs
+}
+
+func (bh *bucketHandler) getSpecLocation() string {
+	return bh.Spec.Location
 }
 
+// getStatusMetageneration returns the metageneration of the bucket when it
+// was last observed.
+func (bh *bucketHandler) getStatusMetageneration() int64 {
+	return bh.Status.Metageneration
+}
+
+func (bh *bucketHandler) getStatusRelocation() *v1alpha3.BucketRelocation {
+	return bh.Status.Relocation
+}
+
+// setSpecAttrs syncs the supplied attributes back to the spec. Lifecycle rules
+// are not synced if the spec does not manage them, so that they can continue
+// to be managed elsewhere. A retention policy the spec locks stays locked in
+// the spec, even if it is not locked yet.
 func (bh *bucketHandler) setSpecAttrs(attrs *storage.BucketAttrs) {
+	managed := bh.Spec.Lifecycle != nil
+	locked := bh.Spec.RetentionPolicy != nil && bh.Spec.RetentionPolicy.Locked
 	bh.Spec.BucketSpecAttrs = v1alpha3.NewBucketSpecAttrs(attrs)
+	if !managed {
+		bh.Spec.Lifecycle = nil
+	}
+	if locked && bh.Spec.RetentionPolicy != nil {
+		bh.Spec.RetentionPolicy.Locked = true
+	}
+}
+
+func (bh *bucketHandler) setSpecLocation(l string) {
+	bh.Spec.Location = l
 }
 
 func (bh *bucketHandler) setStatusAttrs(attrs *storage.BucketAttrs) {
 	bh.Status.BucketOutputAttrs = v1alpha3.NewBucketOutputAttrs(attrs)
 }
 
+// setStatusRelocation records the supplied relocation operation, or clears the
+// recorded relocation if it is nil. The destination of a relocation that is
+// already recorded is kept, even if the spec's location changed since.
+func (bh *bucketHandler) setStatusRelocation(op *gcpstorage.Operation) {
+	if op == nil {
+		bh.Status.Relocation = nil
+		return
+	}
+	r := &v1alpha3.BucketRelocation{
+		Operation:           op.Name,
+		DestinationLocation: bh.getSpecLocation(),
+		ProgressPercent:     op.ProgressPercent(),
+	}
+	if cur := bh.Status.Relocation; cur != nil && cur.Operation == op.Name {
+		r.DestinationLocation = cur.DestinationLocation
+	}
+	if op.Error != nil {
+		r.Failure = op.Error.Message
+		if r.Failure == "" {
+			r.Failure = fmt.Sprintf("operation failed with code %d", op.Error.Code)
+		}
+	}
+	bh.Status.Relocation = r
+}
+
 func (bh *bucketHandler) setStatusConditions(c ...runtimev1alpha1.Condition) {
 	bh.Status.SetConditions(c...)
 }
@@ -124,6 +217,9 @@ func (bh *bucketHandler) getSecret(ctx context.Context, nn types.NamespacedName,
 	return bh.kube.Get(ctx, nn, s)
 }
 
+// bucketEndpoint is the URL under which buckets are reached over HTTPS.
+const bucketEndpoint = "https://storage.googleapis.com/"
+
 const (
 	saSecretKeyAccessKey   = "interopAccessKey"
 	saSecretKeySecret      = "interopSecret"
@@ -144,11 +240,15 @@ func (bh *bucketHandler) updateSecret(ctx context.Context) error {
 		if err := bh.kube.Get(ctx, nn, ss); err != nil {
 			return errors.Wrapf(err, "failed to retrieve storage service account secret: %s", nn)
 		}
-		s.Data[runtimev1alpha1.ResourceCredentialsSecretUserKey] = ss.Data[saSecretKeyAccessKey]
-		s.Data[runtimev1alpha1.ResourceCredentialsSecretPasswordKey] = ss.Data[saSecretKeySecret]
-		s.Data[runtimev1alpha1.ResourceCredentialsSecretTokenKey] = ss.Data[saSecretKeyCredentials]
+		s.Data[connection.KeyUsername] = ss.Data[saSecretKeyAccessKey]
+		s.Data[connection.KeyPassword] = ss.Data[saSecretKeySecret]
+		s.Data[connection.KeyToken] = ss.Data[saSecretKeyCredentials]
 	}
-	s.Data[runtimev1alpha1.ResourceCredentialsSecretEndpointKey] = []byte(meta.GetExternalName(bh))
+	name := meta.GetExternalName(bh)
+	s.Data[connection.KeyEndpoint] = []byte(name)
+	s.Data[connection.KeyBucketName] = []byte(name)
+	s.Data[connection.KeyGSURI] = []byte("gs://" + name)
+	s.Data[connection.KeyHTTPSEndpoint] = []byte(bucketEndpoint + name)
 
 	return errors.Wrapf(apply(ctx, bh.kube, s), "failed to apply connection secret: %s/%s", s.Namespace, s.Name)
 }
@@ -165,17 +265,140 @@ func apply(ctx context.Context, kube client.Client, o runtime.Object) error {
 // GCP Storage Bucket operations
 //
 func (bh *bucketHandler) createBucket(ctx context.Context, projectID string) error {
-	return bh.gcp.Create(ctx, projectID, v1alpha3.CopyBucketSpecAttrs(&bh.Spec.BucketSpecAttrs))
+	if err := validateLifecycle(bh.Spec.BucketUpdatableAttrs); err != nil {
+		return err
+	}
+	attrs := bh.Spec.GetSpecAttrs()
+	return bh.gcp.Create(ctx, projectID, v1alpha3.CopyBucketSpecAttrs(&attrs))
 }
 
-func (bh *bucketHandler) deleteBucket(ctx context.Context) error {
-	return bh.gcp.Delete(ctx)
+func (bh *bucketHandler) deleteBucket(ctx context.Context, metageneration int64) error {
+	return bh.gcp.Delete(ctx, metageneration)
 }
 
-func (bh *bucketHandler) updateBucket(ctx context.Context, labels map[string]string) (*storage.BucketAttrs, error) {
-	return bh.gcp.Update(ctx, v1alpha3.CopyToBucketUpdateAttrs(bh.Spec.BucketUpdatableAttrs, labels))
+func (bh *bucketHandler) updateBucket(ctx context.Context, observed *storage.BucketAttrs) (*storage.BucketAttrs, error) {
+	if err := validateLifecycle(bh.Spec.BucketUpdatableAttrs); err != nil {
+		return nil, err
+	}
+	spec := bh.getSpecAttrs()
+	metageneration := observed.MetaGeneration
+	if spec.Lifecycle != nil && len(spec.Lifecycle.Rules) == 0 && len(observed.Lifecycle.Rules) > 0 {
+		// The storage client omits a lifecycle without rules from updates
+		// rather than removing the bucket's rules, so they are removed
+		// separately.
+		mg, err := bh.gcp.RemoveLifecycle(ctx, metageneration)
+		if gcp.IsErrorPreconditionFailed(err) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errRemoveLifecycle)
+		}
+		if metageneration != 0 {
+			metageneration = mg
+		}
+	}
+	update := v1alpha3.CopyToBucketUpdateAttrs(spec)
+	gcp.UpdateLabels(&update, bh.Spec.Labels, observed.Labels)
+	return bh.gcp.Update(ctx, update, metageneration)
 }
 
 func (bh *bucketHandler) getAttributes(ctx context.Context) (*storage.BucketAttrs, error) {
 	return bh.gcp.Attrs(ctx)
 }
+
+func (bh *bucketHandler) isBucketEmpty(ctx context.Context) (bool, error) {
+	return bh.gcp.Empty(ctx)
+}
+
+func (bh *bucketHandler) relocateBucket(ctx context.Context) (*gcpstorage.Operation, error) {
+	return bh.gcp.Relocate(ctx, bh.getSpecLocation())
+}
+
+func (bh *bucketHandler) getRelocation(ctx context.Context, name string) (*gcpstorage.Operation, error) {
+	return bh.gcp.GetRelocation(ctx, name)
+}
+
+// defaultServiceAccountRole is the role a bucket's service account is granted
+// unless another is specified.
+const defaultServiceAccountRole = "roles/storage.objectAdmin"
+
+// grantServiceAccount grants the bucket's service account its role on the
+// bucket, if it does not already have it. The policy is written with the etag
+// it was read with, so that a concurrent change is not overwritten.
+func (bh *bucketHandler) grantServiceAccount(ctx context.Context) error {
+	if bh.Spec.ServiceAccount == nil {
+		return nil
+	}
+	member := "serviceAccount:" + *bh.Spec.ServiceAccount
+	role := iam.RoleName(defaultServiceAccountRole)
+	if bh.Spec.ServiceAccountRole != nil {
+		role = iam.RoleName(*bh.Spec.ServiceAccountRole)
+	}
+
+	p, err := bh.gcp.Policy(ctx)
+	if err != nil {
+		return errors.Wrap(err, errGetBucketPolicy)
+	}
+	if p.HasRole(member, role) {
+		return nil
+	}
+	p.Add(member, role)
+	return errors.Wrap(bh.gcp.SetPolicy(ctx, p), errGrantServiceAccount)
+}
+
+// lockRetentionPolicy locks the bucket's retention policy, provided the
+// bucket's metageneration still matches the supplied one.
+func (bh *bucketHandler) lockRetentionPolicy(ctx context.Context, metageneration int64) error {
+	return bh.gcp.LockRetentionPolicy(ctx, metageneration)
+}
+
+// validateRetentionPolicy returns an error if the supplied desired retention
+// policy would shorten or remove the observed one while it is locked, which
+// GCS does not allow, or if it is to be locked without a retention period.
+func validateRetentionPolicy(desired *v1alpha3.RetentionPolicy, observed *storage.RetentionPolicy) error {
+	if desired != nil && desired.Locked && desired.RetentionPeriodSeconds == 0 {
+		return errors.New(errLockWithoutRetention)
+	}
+	if observed == nil || !observed.IsLocked {
+		return nil
+	}
+	period := int(observed.RetentionPeriod.Seconds())
+	if desired == nil || desired.RetentionPeriodSeconds < period {
+		return errors.Errorf(errShortenLockedRetention, period)
+	}
+	return nil
+}
+
+// needsRetentionLock returns true if the supplied desired retention policy is
+// locked but the observed one is not.
+func needsRetentionLock(desired *v1alpha3.RetentionPolicy, observed *storage.RetentionPolicy) bool {
+	return desired != nil && desired.Locked && observed != nil && !observed.IsLocked
+}
+
+// withoutLock returns a copy of the supplied retention policy that is not
+// locked, so that retention periods can be compared regardless of whether
+// they are locked yet.
+func withoutLock(rp *v1alpha3.RetentionPolicy) *v1alpha3.RetentionPolicy {
+	if rp == nil {
+		return nil
+	}
+	out := *rp
+	out.Locked = false
+	return &out
+}
+
+// validateLifecycle returns an error if any lifecycle rule of the supplied
+// attributes only matches noncurrent object versions while versioning is
+// disabled. Such rules are accepted by GCS but never take effect, which is
+// rarely what was intended.
+func validateLifecycle(ba v1alpha3.BucketUpdatableAttrs) error {
+	if ba.VersioningEnabled || ba.Lifecycle == nil {
+		return nil
+	}
+	for i, r := range ba.Lifecycle.Rules {
+		if r.Condition.MatchesNoncurrentVersions() {
+			return errors.Errorf(errNoncurrentWithoutVersioning, i)
+		}
+	}
+	return nil
+}
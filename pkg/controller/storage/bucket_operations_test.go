@@ -19,7 +19,9 @@ package storage
 import (
 	"context"
 	"testing"
+	"time"
 
+	"cloud.google.com/go/iam"
 	"cloud.google.com/go/storage"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -33,28 +35,43 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	gcpstorage "github.com/crossplane/provider-gcp/pkg/clients/storage"
 	storagefake "github.com/crossplane/provider-gcp/pkg/clients/storage/fake"
 )
 
 type mockOperations struct {
-	mockIsReclaimDelete     func() bool
-	mockAddFinalizer        func()
-	mockRemoveFinalizer     func()
-	mockGetSpecAttrs        func() v1alpha3.BucketUpdatableAttrs
-	mockSetSpecAttrs        func(*storage.BucketAttrs)
-	mockSetStatusAttrs      func(*storage.BucketAttrs)
-	mockSetStatusConditions func(...runtimev1alpha1.Condition)
-	mockSetBindable         func()
+	mockIsReclaimDelete         func() bool
+	mockIsRecreate              func() bool
+	mockGetChangedImmutable     func(*storage.BucketAttrs) []string
+	mockAddFinalizer            func()
+	mockRemoveFinalizer         func()
+	mockGetSpecAttrs            func() v1alpha3.BucketUpdatableAttrs
+	mockGetSpecLocation         func() string
+	mockSetSpecLocation         func(string)
+	mockGetStatusMetageneration func() int64
+	mockGetStatusRelocation     func() *v1alpha3.BucketRelocation
+	mockSetSpecAttrs            func(*storage.BucketAttrs)
+	mockSetStatusAttrs          func(*storage.BucketAttrs)
+	mockSetStatusRelocation     func(*gcpstorage.Operation)
+	mockSetStatusConditions     func(...runtimev1alpha1.Condition)
+	mockSetBindable             func()
 
 	mockUpdateObject func(ctx context.Context) error
 	mockUpdateStatus func(ctx context.Context) error
 	mockUpdateSecret func(ctx context.Context) error
 
 	mockCreateBucket  func(ctx context.Context, projectID string) error
-	mockDeleteBucket  func(ctx context.Context) error
-	mockUpdateBucket  func(ctx context.Context, labels map[string]string) (*storage.BucketAttrs, error)
+	mockDeleteBucket  func(ctx context.Context, metageneration int64) error
+	mockUpdateBucket  func(ctx context.Context, observed *storage.BucketAttrs) (*storage.BucketAttrs, error)
 	mockGetAttributes func(ctx context.Context) (*storage.BucketAttrs, error)
+	mockIsBucketEmpty func(ctx context.Context) (bool, error)
+
+	mockRelocateBucket func(ctx context.Context) (*gcpstorage.Operation, error)
+	mockGetRelocation  func(ctx context.Context, name string) (*gcpstorage.Operation, error)
+
+	mockGrantServiceAccount func(ctx context.Context) error
+	mockLockRetentionPolicy func(ctx context.Context, metageneration int64) error
 }
 
 var _ operations = &mockOperations{}
@@ -63,6 +80,14 @@ func (o *mockOperations) isReclaimDelete() bool {
 	return o.mockIsReclaimDelete()
 }
 
+func (o *mockOperations) isRecreate() bool {
+	return o.mockIsRecreate()
+}
+
+func (o *mockOperations) getChangedImmutableAttrs(attrs *storage.BucketAttrs) []string {
+	return o.mockGetChangedImmutable(attrs)
+}
+
 func (o *mockOperations) addFinalizer() {
 	o.mockAddFinalizer()
 }
@@ -75,14 +100,34 @@ func (o *mockOperations) getSpecAttrs() v1alpha3.BucketUpdatableAttrs {
 	return o.mockGetSpecAttrs()
 }
 
+func (o *mockOperations) getSpecLocation() string {
+	return o.mockGetSpecLocation()
+}
+
+func (o *mockOperations) getStatusMetageneration() int64 {
+	return o.mockGetStatusMetageneration()
+}
+
+func (o *mockOperations) getStatusRelocation() *v1alpha3.BucketRelocation {
+	return o.mockGetStatusRelocation()
+}
+
 func (o *mockOperations) setSpecAttrs(attrs *storage.BucketAttrs) {
 	o.mockSetSpecAttrs(attrs)
 }
 
+func (o *mockOperations) setSpecLocation(l string) {
+	o.mockSetSpecLocation(l)
+}
+
 func (o *mockOperations) setStatusAttrs(attrs *storage.BucketAttrs) {
 	o.mockSetStatusAttrs(attrs)
 }
 
+func (o *mockOperations) setStatusRelocation(op *gcpstorage.Operation) {
+	o.mockSetStatusRelocation(op)
+}
+
 func (o *mockOperations) setStatusConditions(c ...runtimev1alpha1.Condition) {
 	o.mockSetStatusConditions(c...)
 }
@@ -111,18 +156,38 @@ func (o *mockOperations) createBucket(ctx context.Context, projectID string) err
 	return o.mockCreateBucket(ctx, projectID)
 }
 
-func (o *mockOperations) deleteBucket(ctx context.Context) error {
-	return o.mockDeleteBucket(ctx)
+func (o *mockOperations) deleteBucket(ctx context.Context, metageneration int64) error {
+	return o.mockDeleteBucket(ctx, metageneration)
 }
 
-func (o *mockOperations) updateBucket(ctx context.Context, labels map[string]string) (*storage.BucketAttrs, error) {
-	return o.mockUpdateBucket(ctx, labels)
+func (o *mockOperations) updateBucket(ctx context.Context, observed *storage.BucketAttrs) (*storage.BucketAttrs, error) {
+	return o.mockUpdateBucket(ctx, observed)
 }
 
 func (o *mockOperations) getAttributes(ctx context.Context) (*storage.BucketAttrs, error) {
 	return o.mockGetAttributes(ctx)
 }
 
+func (o *mockOperations) isBucketEmpty(ctx context.Context) (bool, error) {
+	return o.mockIsBucketEmpty(ctx)
+}
+
+func (o *mockOperations) relocateBucket(ctx context.Context) (*gcpstorage.Operation, error) {
+	return o.mockRelocateBucket(ctx)
+}
+
+func (o *mockOperations) getRelocation(ctx context.Context, name string) (*gcpstorage.Operation, error) {
+	return o.mockGetRelocation(ctx, name)
+}
+
+func (o *mockOperations) grantServiceAccount(ctx context.Context) error {
+	return o.mockGrantServiceAccount(ctx)
+}
+
+func (o *mockOperations) lockRetentionPolicy(ctx context.Context, metageneration int64) error {
+	return o.mockLockRetentionPolicy(ctx, metageneration)
+}
+
 //
 //
 func Test_bucketHandler_addFinalizer(t *testing.T) {
@@ -280,6 +345,28 @@ func Test_bucketHandler_setSpecAttrs(t *testing.T) {
 			args:   &storage.BucketAttrs{Location: "foo"},
 			want:   testSpecAttrs,
 		},
+		{
+			name: "ManagedLifecycle",
+			fields: fields{bucket: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{
+				BucketSpecAttrs: v1alpha3.BucketSpecAttrs{BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{Lifecycle: &v1alpha3.Lifecycle{}}},
+			}}}},
+			args: &storage.BucketAttrs{Lifecycle: storage.Lifecycle{Rules: []storage.LifecycleRule{{Action: storage.LifecycleAction{Type: "Delete"}}}}},
+			want: v1alpha3.BucketSpecAttrs{BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{
+				Lifecycle: &v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{{Action: v1alpha3.LifecycleAction{Type: "Delete"}}}},
+			}},
+		},
+		{
+			name: "LockedRetentionPolicy",
+			fields: fields{bucket: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{
+				BucketSpecAttrs: v1alpha3.BucketSpecAttrs{BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{
+					RetentionPolicy: &v1alpha3.RetentionPolicy{RetentionPeriodSeconds: 3600, Locked: true},
+				}},
+			}}}},
+			args: &storage.BucketAttrs{RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: time.Hour}},
+			want: v1alpha3.BucketSpecAttrs{BucketUpdatableAttrs: v1alpha3.BucketUpdatableAttrs{
+				RetentionPolicy: &v1alpha3.RetentionPolicy{RetentionPeriodSeconds: 3600, Locked: true},
+			}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -325,6 +412,66 @@ func Test_bucketHandler_setStatusAttrs(t *testing.T) {
 	}
 }
 
+func Test_bucketHandler_setStatusRelocation(t *testing.T) {
+	spec := v1alpha3.BucketSpec{BucketParameters: v1alpha3.BucketParameters{BucketSpecAttrs: v1alpha3.BucketSpecAttrs{Location: "EU"}}}
+	tests := []struct {
+		name   string
+		bucket *v1alpha3.Bucket
+		args   *gcpstorage.Operation
+		want   *v1alpha3.BucketRelocation
+	}{
+		{
+			name: "Started",
+			bucket: &v1alpha3.Bucket{
+				Spec: spec,
+			},
+			args: &gcpstorage.Operation{Name: "op"},
+			want: &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "EU"},
+		},
+		{
+			name: "InProgress",
+			bucket: &v1alpha3.Bucket{
+				Spec: spec,
+				Status: v1alpha3.BucketStatus{
+					Relocation: &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "ASIA"},
+				},
+			},
+			args: &gcpstorage.Operation{
+				Name:     "op",
+				Metadata: &gcpstorage.RelocationMetadata{CommonMetadata: &gcpstorage.CommonMetadata{ProgressPercent: 42}},
+			},
+			want: &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "ASIA", ProgressPercent: 42},
+		},
+		{
+			name:   "Failed",
+			bucket: &v1alpha3.Bucket{Spec: spec},
+			args:   &gcpstorage.Operation{Name: "op", Done: true, Error: &gcpstorage.OperationError{Code: 9}},
+			want:   &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "EU", Failure: "operation failed with code 9"},
+		},
+		{
+			name: "Cleared",
+			bucket: &v1alpha3.Bucket{
+				Spec: spec,
+				Status: v1alpha3.BucketStatus{
+					Relocation: &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "EU"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bh := &bucketHandler{
+				Bucket: tt.bucket,
+			}
+			bh.setStatusRelocation(tt.args)
+			got := tt.bucket.Status.Relocation
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("bucketHandler.setStatusRelocation(): -want, +got\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_bucketHandler_updateObject(t *testing.T) {
 	ctx := context.TODO()
 	bucket := &v1alpha3.Bucket{}
@@ -416,6 +563,23 @@ func Test_bucketHandler_updateSecret(t *testing.T) {
 				kube:   test.NewMockClient(),
 			},
 		},
+		{
+			name: "WithBucketDetails",
+			fields: fields{
+				Bucket: newBucket(testBucketName).
+					withWriteConnectionSecretToReference(testNamespace, testBucketName).Bucket,
+				kube: &test.MockClient{
+					MockCreate: func(ctx context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+						s := obj.(*corev1.Secret)
+						assertSecretData(s.Data, runtimev1alpha1.ResourceCredentialsSecretEndpointKey, testBucketName)
+						assertSecretData(s.Data, "bucket-name", testBucketName)
+						assertSecretData(s.Data, "gs-uri", "gs://"+testBucketName)
+						assertSecretData(s.Data, "https-endpoint", "https://storage.googleapis.com/"+testBucketName)
+						return nil
+					},
+				},
+			},
+		},
 		{
 			name: "FailureToRetrieveSecret",
 			fields: fields{
@@ -513,27 +677,43 @@ func Test_bucketHandler_deleteBucket(t *testing.T) {
 	bc := &bucketHandler{
 		Bucket: &v1alpha3.Bucket{},
 		gcp: &storagefake.MockBucketClient{
-			MockDelete: func(ctx context.Context) error { return nil },
+			MockDelete: func(ctx context.Context, metageneration int64) error {
+				if metageneration != 3 {
+					t.Errorf("bucketHandler.deleteBucket(): want metageneration 3, got %d", metageneration)
+				}
+				return nil
+			},
 		},
 	}
-	if err := bc.deleteBucket(ctx); err != nil {
+	if err := bc.deleteBucket(ctx, 3); err != nil {
 		t.Errorf("bucketHandler.deleteBucket() unexpected error %v", err)
 	}
 }
 
 func Test_bucketHandler_updateBucket(t *testing.T) {
 	ctx := context.TODO()
+	b := &v1alpha3.Bucket{}
+	b.Spec.Labels = map[string]string{"application": "crossplane", "Foo": "bar"}
 	bc := &bucketHandler{
-		Bucket: &v1alpha3.Bucket{},
+		Bucket: b,
 		gcp: &storagefake.MockBucketClient{
-			MockUpdate: func(ctx context.Context, update storage.BucketAttrsToUpdate) (attrs *storage.BucketAttrs, e error) {
+			MockUpdate: func(ctx context.Context, update storage.BucketAttrsToUpdate, metageneration int64) (attrs *storage.BucketAttrs, e error) {
+				if metageneration != 3 {
+					t.Errorf("bucketHandler.updateBucket(): want metageneration 3, got %d", metageneration)
+				}
+				want := v1alpha3.CopyToBucketUpdateAttrs(b.Spec.BucketUpdatableAttrs)
+				want.SetLabel("application", "crossplane")
+				want.DeleteLabel("baz")
+				if diff := cmp.Diff(want, update, cmp.AllowUnexported(storage.BucketAttrsToUpdate{})); diff != "" {
+					t.Errorf("bucketHandler.updateBucket(): -want update, +got update:\n%s", diff)
+				}
 				return &storage.BucketAttrs{}, nil
 			},
 		},
 	}
-	labels := map[string]string{"Foo": "bar"}
+	observed := &storage.BucketAttrs{Labels: map[string]string{"Foo": "bar", "baz": "qux"}, MetaGeneration: 3}
 	want := &storage.BucketAttrs{}
-	got, err := bc.updateBucket(ctx, labels)
+	got, err := bc.updateBucket(ctx, observed)
 	if err != nil {
 		t.Errorf("bucketHandler.updateBucket() unexpected error %v", err)
 	}
@@ -542,6 +722,154 @@ func Test_bucketHandler_updateBucket(t *testing.T) {
 	}
 }
 
+func Test_bucketHandler_updateBucketLifecycle(t *testing.T) {
+	ctx := context.TODO()
+	errBoom := errors.New("boom")
+	deleteRule := storage.LifecycleRule{Action: storage.LifecycleAction{Type: "Delete"}}
+
+	type want struct {
+		removed        bool
+		lifecycle      *storage.Lifecycle
+		metageneration int64
+		err            error
+	}
+	tests := map[string]struct {
+		lifecycle *v1alpha3.Lifecycle
+		observed  storage.Lifecycle
+		removeErr error
+		want      want
+	}{
+		"Unmanaged": {
+			observed: storage.Lifecycle{Rules: []storage.LifecycleRule{deleteRule}},
+			want:     want{metageneration: 3},
+		},
+		"Managed": {
+			lifecycle: &v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{{Action: v1alpha3.LifecycleAction{Type: "Delete"}}}},
+			want:      want{lifecycle: &storage.Lifecycle{Rules: []storage.LifecycleRule{deleteRule}}, metageneration: 3},
+		},
+		"RemoveAll": {
+			lifecycle: &v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{}},
+			observed:  storage.Lifecycle{Rules: []storage.LifecycleRule{deleteRule}},
+			want:      want{removed: true, lifecycle: &storage.Lifecycle{}, metageneration: 4},
+		},
+		"NoneToRemove": {
+			lifecycle: &v1alpha3.Lifecycle{},
+			want:      want{lifecycle: &storage.Lifecycle{}, metageneration: 3},
+		},
+		"RemoveFailed": {
+			lifecycle: &v1alpha3.Lifecycle{},
+			observed:  storage.Lifecycle{Rules: []storage.LifecycleRule{deleteRule}},
+			removeErr: errBoom,
+			want:      want{removed: true, err: errors.Wrap(errBoom, errRemoveLifecycle)},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			removed := false
+			b := &v1alpha3.Bucket{}
+			b.Spec.Lifecycle = tc.lifecycle
+			bc := &bucketHandler{
+				Bucket: b,
+				gcp: &storagefake.MockBucketClient{
+					MockRemoveLifecycle: func(_ context.Context, metageneration int64) (int64, error) {
+						removed = true
+						return metageneration + 1, tc.removeErr
+					},
+					MockUpdate: func(_ context.Context, update storage.BucketAttrsToUpdate, metageneration int64) (*storage.BucketAttrs, error) {
+						if diff := cmp.Diff(tc.want.lifecycle, update.Lifecycle); diff != "" {
+							t.Errorf("bucketHandler.updateBucket(): -want lifecycle, +got lifecycle:\n%s", diff)
+						}
+						if metageneration != tc.want.metageneration {
+							t.Errorf("bucketHandler.updateBucket(): want metageneration %d, got %d", tc.want.metageneration, metageneration)
+						}
+						return &storage.BucketAttrs{}, nil
+					},
+				},
+			}
+			_, err := bc.updateBucket(ctx, &storage.BucketAttrs{Lifecycle: tc.observed, MetaGeneration: 3})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("bucketHandler.updateBucket(): -want error, +got error:\n%s", diff)
+			}
+			if removed != tc.want.removed {
+				t.Errorf("bucketHandler.updateBucket(): want lifecycle removed %t, got %t", tc.want.removed, removed)
+			}
+		})
+	}
+}
+
+func Test_bucketHandler_grantServiceAccount(t *testing.T) {
+	ctx := context.TODO()
+	email := "bucket-writer@example.iam.gserviceaccount.com"
+	member := "serviceAccount:" + email
+	errBoom := errors.New("boom")
+
+	granted := func(role iam.RoleName) *iam.Policy {
+		p := &iam.Policy{}
+		p.Add(member, role)
+		return p
+	}
+
+	type want struct {
+		err     error
+		members []string
+	}
+	tests := map[string]struct {
+		spec   v1alpha3.BucketParameters
+		policy *iam.Policy
+		getErr error
+		setErr error
+		want   want
+	}{
+		"NoServiceAccount": {},
+		"AlreadyGranted": {
+			spec:   v1alpha3.BucketParameters{ServiceAccount: &email},
+			policy: granted(defaultServiceAccountRole),
+		},
+		"GrantDefaultRole": {
+			spec:   v1alpha3.BucketParameters{ServiceAccount: &email},
+			policy: granted("roles/storage.objectViewer"),
+			want:   want{members: []string{member}},
+		},
+		"GetPolicyFailed": {
+			spec:   v1alpha3.BucketParameters{ServiceAccount: &email},
+			getErr: errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGetBucketPolicy)},
+		},
+		"SetPolicyFailed": {
+			spec:   v1alpha3.BucketParameters{ServiceAccount: &email},
+			policy: &iam.Policy{},
+			setErr: errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGrantServiceAccount), members: []string{member}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var set *iam.Policy
+			bc := &bucketHandler{
+				Bucket: &v1alpha3.Bucket{Spec: v1alpha3.BucketSpec{BucketParameters: tc.spec}},
+				gcp: &storagefake.MockBucketClient{
+					MockPolicy: func(_ context.Context) (*iam.Policy, error) { return tc.policy, tc.getErr },
+					MockSetPolicy: func(_ context.Context, p *iam.Policy) error {
+						set = p
+						return tc.setErr
+					},
+				},
+			}
+			err := bc.grantServiceAccount(ctx)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("bucketHandler.grantServiceAccount(): -want error, +got error:\n%s", diff)
+			}
+			var members []string
+			if set != nil {
+				members = set.Members(defaultServiceAccountRole)
+			}
+			if diff := cmp.Diff(tc.want.members, members); diff != "" {
+				t.Errorf("bucketHandler.grantServiceAccount(): -want members, +got members:\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_bucketHandler_getAttributes(t *testing.T) {
 	ctx := context.TODO()
 	type fields struct {
@@ -581,3 +909,147 @@ func Test_bucketHandler_getAttributes(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateRetentionPolicy(t *testing.T) {
+	tests := map[string]struct {
+		desired  *v1alpha3.RetentionPolicy
+		observed *storage.RetentionPolicy
+		want     error
+	}{
+		"Unlocked": {
+			observed: &storage.RetentionPolicy{RetentionPeriod: time.Hour},
+		},
+		"ExtendLocked": {
+			desired:  &v1alpha3.RetentionPolicy{RetentionPeriodSeconds: 7200},
+			observed: &storage.RetentionPolicy{RetentionPeriod: time.Hour, IsLocked: true},
+		},
+		"ShortenLocked": {
+			desired:  &v1alpha3.RetentionPolicy{RetentionPeriodSeconds: 60},
+			observed: &storage.RetentionPolicy{RetentionPeriod: time.Hour, IsLocked: true},
+			want:     errors.Errorf(errShortenLockedRetention, 3600),
+		},
+		"RemoveLocked": {
+			observed: &storage.RetentionPolicy{RetentionPeriod: time.Hour, IsLocked: true},
+			want:     errors.Errorf(errShortenLockedRetention, 3600),
+		},
+		"LockWithoutRetention": {
+			desired: &v1alpha3.RetentionPolicy{Locked: true},
+			want:    errors.New(errLockWithoutRetention),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateRetentionPolicy(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("validateRetentionPolicy(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_validateLifecycle(t *testing.T) {
+	archived := v1alpha3.LifecycleRule{
+		Action:    v1alpha3.LifecycleAction{Type: storage.DeleteAction},
+		Condition: v1alpha3.LifecycleCondition{Liveness: storage.Archived},
+	}
+	newer := v1alpha3.LifecycleRule{
+		Action:    v1alpha3.LifecycleAction{Type: storage.DeleteAction},
+		Condition: v1alpha3.LifecycleCondition{NumNewerVersions: 3},
+	}
+	old := v1alpha3.LifecycleRule{
+		Action:    v1alpha3.LifecycleAction{Type: storage.SetStorageClassAction, StorageClass: "NEARLINE"},
+		Condition: v1alpha3.LifecycleCondition{AgeInDays: 30, Liveness: storage.Live},
+	}
+
+	tests := map[string]struct {
+		attrs v1alpha3.BucketUpdatableAttrs
+		want  error
+	}{
+		"NoRules": {
+			attrs: v1alpha3.BucketUpdatableAttrs{},
+		},
+		"LiveObjectRulesWithoutVersioning": {
+			attrs: v1alpha3.BucketUpdatableAttrs{Lifecycle: &v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{old}}},
+		},
+		"NoncurrentRulesWithVersioning": {
+			attrs: v1alpha3.BucketUpdatableAttrs{
+				VersioningEnabled: true,
+				Lifecycle:         &v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{old, archived, newer}},
+			},
+		},
+		"ArchivedWithoutVersioning": {
+			attrs: v1alpha3.BucketUpdatableAttrs{Lifecycle: &v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{old, archived}}},
+			want:  errors.Errorf(errNoncurrentWithoutVersioning, 1),
+		},
+		"NumNewerVersionsWithoutVersioning": {
+			attrs: v1alpha3.BucketUpdatableAttrs{Lifecycle: &v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{newer}}},
+			want:  errors.Errorf(errNoncurrentWithoutVersioning, 0),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateLifecycle(tt.attrs)
+			if diff := cmp.Diff(tt.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("validateLifecycle(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_bucketHandler_createBucketNoncurrentWithoutVersioning(t *testing.T) {
+	b := &v1alpha3.Bucket{}
+	b.Spec.Lifecycle = &v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{{Condition: v1alpha3.LifecycleCondition{NumNewerVersions: 1}}}}
+	bc := &bucketHandler{
+		Bucket: b,
+		gcp: &storagefake.MockBucketClient{
+			MockCreate: func(ctx context.Context, s string, attrs *storage.BucketAttrs) error {
+				t.Errorf("bucketHandler.createBucket(): unexpected call to create bucket")
+				return nil
+			},
+		},
+	}
+	want := errors.Errorf(errNoncurrentWithoutVersioning, 0)
+	if diff := cmp.Diff(want, bc.createBucket(context.TODO(), "foo"), test.EquateErrors()); diff != "" {
+		t.Errorf("bucketHandler.createBucket(): -want, +got:\n%s", diff)
+	}
+}
+
+func Test_bucketHandler_getChangedImmutableAttrs(t *testing.T) {
+	tests := map[string]struct {
+		spec     v1alpha3.BucketSpecAttrs
+		defaults *v1alpha3.BucketDefaults
+		attrs    *storage.BucketAttrs
+		want     []string
+	}{
+		"Unset": {
+			attrs: &storage.BucketAttrs{Location: "US", StorageClass: "STANDARD"},
+		},
+		"LocationCaseDiffers": {
+			spec:  v1alpha3.BucketSpecAttrs{Location: "us-central1", StorageClass: "STANDARD"},
+			attrs: &storage.BucketAttrs{Location: "US-CENTRAL1", StorageClass: "STANDARD"},
+		},
+		"Changed": {
+			spec:  v1alpha3.BucketSpecAttrs{Location: "europe-west1", StorageClass: "NEARLINE"},
+			attrs: &storage.BucketAttrs{Location: "US-CENTRAL1", StorageClass: "STANDARD"},
+			want:  []string{"location", "storageClass"},
+		},
+		"DefaultStorageClassChanged": {
+			spec:     v1alpha3.BucketSpecAttrs{StorageClass: "STANDARD"},
+			defaults: &v1alpha3.BucketDefaults{StorageClass: gcp.StringPtr("NEARLINE")},
+			attrs:    &storage.BucketAttrs{StorageClass: "STANDARD"},
+			want:     []string{"storageClass"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := &v1alpha3.Bucket{}
+			b.Spec.BucketSpecAttrs = tt.spec
+			b.Spec.Defaults = tt.defaults
+			bh := &bucketHandler{Bucket: b}
+			got := bh.getChangedImmutableAttrs(tt.attrs)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("bucketHandler.getChangedImmutableAttrs(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package storage
 
 import (
 	"context"
+	"net/http"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,6 +47,8 @@ import (
 	"github.com/crossplane/provider-gcp/apis"
 	"github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	gcpstorage "github.com/crossplane/provider-gcp/pkg/clients/storage"
 )
 
 func init() {
@@ -133,6 +137,11 @@ func (b *bucket) withServiceAccountSecretRef(namespace, name string) *bucket {
 	return b
 }
 
+func (b *bucket) withServiceAccountRef(name string) *bucket {
+	b.Spec.ServiceAccountRef = &runtimev1alpha1.Reference{Name: name}
+	return b
+}
+
 func (b *bucket) withWriteConnectionSecretToReference(namespace, name string) *bucket {
 	b.Spec.WriteConnectionSecretToReference = &runtimev1alpha1.SecretReference{Namespace: namespace, Name: name}
 	return b
@@ -153,6 +162,11 @@ func (b *bucket) withProvider(name string) *bucket {
 	return b
 }
 
+func (b *bucket) withLocation(l string) *bucket {
+	b.Spec.Location = l
+	return b
+}
+
 func (b *bucket) withConditions(c ...runtimev1alpha1.Condition) *bucket {
 	b.Status.SetConditions(c...)
 	return b
@@ -170,6 +184,11 @@ func newProvider(name string) *provider {
 	}}
 }
 
+func (p *provider) withDefaultRegion(r string) *provider {
+	p.Spec.DefaultRegion = r
+	return p
+}
+
 func (p *provider) withSecret(namespace, name, key string) *provider {
 	p.Spec.CredentialsSecretRef = &runtimev1alpha1.SecretKeySelector{
 		SecretReference: runtimev1alpha1.SecretReference{
@@ -267,6 +286,18 @@ func TestReconciler_Reconcile(t *testing.T) {
 			wantRs:  rsDone,
 			wantErr: nil,
 		},
+		{
+			name: "ReferenceNotResolved",
+			fields: fields{
+				client: fake.NewFakeClient(newBucket(name).withServiceAccountRef("bucket-writer").withFinalizer("foo.bar").Bucket),
+			},
+			wantRs:  requeueUnresolved,
+			wantErr: nil,
+			wantObj: newBucket(name).
+				withServiceAccountRef("bucket-writer").
+				withFinalizer("foo.bar").
+				withConditions(runtimev1alpha1.ReconcileError(errors.New(`cannot resolve references: cannot get managed resource: serviceaccounts.iam.gcp.crossplane.io "bucket-writer" not found`))).Bucket,
+		},
 		{
 			name: "ReconcileSync",
 			fields: fields{
@@ -286,6 +317,7 @@ func TestReconciler_Reconcile(t *testing.T) {
 
 				log:         logging.NewNopLogger(),
 				initializer: managed.NewNameAsExternalName(tt.fields.client),
+				resolver:    managed.NewAPISimpleReferenceResolver(tt.fields.client),
 			}
 			got, err := r.Reconcile(req)
 			if diff := cmp.Diff(tt.wantErr, err, test.EquateErrors()); diff != "" {
@@ -331,8 +363,10 @@ func Test_bucketFactory_newHandler(t *testing.T) {
 	"auth_provider_x509_cert_url": "https://www.googleapis.com/oauth2/v1/certs",
 	"client_x509_cert_url": "%s"}`
 	type want struct {
-		err error
-		sd  syncdeleter
+		err             error
+		sd              syncdeleter
+		location        string
+		defaultLocation string
 	}
 	tests := []struct {
 		name   string
@@ -345,9 +379,9 @@ func Test_bucketFactory_newHandler(t *testing.T) {
 			Client: fake.NewFakeClient(),
 			bucket: newBucket(bucketName).withProvider(providerName).Bucket,
 			want: want{
-				err: kerrors.NewNotFound(schema.GroupResource{
+				err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{
 					Group:    gcpv1alpha3.Group,
-					Resource: "providers"}, "test-provider"),
+					Resource: "providers"}, "test-provider"), "cannot get Provider"),
 			},
 		},
 		{
@@ -355,19 +389,18 @@ func Test_bucketFactory_newHandler(t *testing.T) {
 			Client: fake.NewFakeClient(newProvider(providerName).withSecret(ns, secretName, secretKey).Provider),
 			bucket: newBucket(bucketName).withProvider(providerName).Bucket,
 			want: want{
-				err: errors.WithStack(
-					errors.Errorf("cannot get provider's secret %s/%s: secrets \"%s\" not found", ns, secretName, secretName)),
+				err: errors.Wrap(kerrors.NewNotFound(schema.GroupResource{
+					Resource: "secrets"}, secretName), "cannot get Provider Secret"),
 			},
 		},
 		{
-			name: "InvalidCredentials",
+			name: "MissingCredentials",
 			Client: fake.NewFakeClient(newProvider(providerName).
 				withSecret(ns, secretName, secretKey).Provider,
 				newSecret(ns, secretName).Secret),
 			bucket: newBucket(bucketName).withProvider(providerName).Bucket,
 			want: want{
-				err: errors.WithStack(
-					errors.Errorf("cannot retrieve creds from json: unexpected end of JSON input")),
+				err: errors.Errorf("Provider credentials Secret %s/%s has no data at key %q", ns, secretName, secretKey),
 			},
 		},
 		{
@@ -386,6 +419,35 @@ func Test_bucketFactory_newHandler(t *testing.T) {
 						nil, nil), ""),
 			},
 		},
+		{
+			name: "DefaultLocation",
+			Client: fake.NewFakeClient(newProvider(providerName).
+				withSecret(ns, secretName, secretKey).withDefaultRegion("europe-west1").Provider,
+				newSecret(ns, secretName).withKeyData(secretKey, secretData).Secret),
+			bucket: newBucket(bucketName).withUID("test-uid").withProvider(providerName).Bucket,
+			want: want{
+				sd: newBucketSyncDeleter(
+					newBucketClients(
+						newBucket(bucketName).withUID("test-uid").withProvider(providerName).Bucket,
+						nil, nil), ""),
+				defaultLocation: "europe-west1",
+			},
+		},
+		{
+			name: "ResourceLocationWins",
+			Client: fake.NewFakeClient(newProvider(providerName).
+				withSecret(ns, secretName, secretKey).withDefaultRegion("europe-west1").Provider,
+				newSecret(ns, secretName).withKeyData(secretKey, secretData).Secret),
+			bucket: newBucket(bucketName).withUID("test-uid").withProvider(providerName).withLocation("EU").Bucket,
+			want: want{
+				sd: newBucketSyncDeleter(
+					newBucketClients(
+						newBucket(bucketName).withUID("test-uid").withProvider(providerName).Bucket,
+						nil, nil), ""),
+				location:        "EU",
+				defaultLocation: "europe-west1",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -399,6 +461,15 @@ func Test_bucketFactory_newHandler(t *testing.T) {
 			if diff := cmp.Diff(tt.want.sd, got, cmpopts.IgnoreUnexported(bucketSyncDeleter{})); diff != "" {
 				t.Errorf("bucketFactory.newSyncDeleter() -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tt.want.location, tt.bucket.Spec.Location); diff != "" {
+				t.Errorf("bucketFactory.newSyncDeleter() -want location, +got location:\n%s", diff)
+			}
+			if sd, ok := got.(*bucketSyncDeleter); ok {
+				cu := sd.createupdater.(*bucketCreateUpdater)
+				if diff := cmp.Diff(tt.want.defaultLocation, cu.defaultLocation); diff != "" {
+					t.Errorf("bucketFactory.newSyncDeleter() -want default location, +got default location:\n%s", diff)
+				}
+			}
 		})
 	}
 }
@@ -435,8 +506,14 @@ func Test_bucketSyncDeleter_delete(t *testing.T) {
 			name: "DeleteSuccessful",
 			fields: fields{
 				ops: &mockOperations{
-					mockIsReclaimDelete:     func() bool { return true },
-					mockDeleteBucket:        func(ctx context.Context) error { return nil },
+					mockIsReclaimDelete:         func() bool { return true },
+					mockGetStatusMetageneration: func() int64 { return 3 },
+					mockDeleteBucket: func(ctx context.Context, metageneration int64) error {
+						if metageneration != 3 {
+							t.Errorf("deleteBucket(...): want metageneration 3, got %d", metageneration)
+						}
+						return nil
+					},
 					mockRemoveFinalizer:     func() {},
 					mockUpdateObject:        func(ctx context.Context) error { return nil },
 					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
@@ -451,8 +528,9 @@ func Test_bucketSyncDeleter_delete(t *testing.T) {
 			name: "DeleteFailedNotFound",
 			fields: fields{
 				ops: &mockOperations{
-					mockIsReclaimDelete: func() bool { return true },
-					mockDeleteBucket: func(ctx context.Context) error {
+					mockIsReclaimDelete:         func() bool { return true },
+					mockGetStatusMetageneration: func() int64 { return 3 },
+					mockDeleteBucket: func(ctx context.Context, _ int64) error {
 						return storage.ErrBucketNotExist
 					},
 					mockRemoveFinalizer:     func() {},
@@ -470,8 +548,9 @@ func Test_bucketSyncDeleter_delete(t *testing.T) {
 			name: "DeleteFailedOther",
 			fields: fields{
 				ops: &mockOperations{
-					mockIsReclaimDelete: func() bool { return true },
-					mockDeleteBucket: func(ctx context.Context) error {
+					mockIsReclaimDelete:         func() bool { return true },
+					mockGetStatusMetageneration: func() int64 { return 3 },
+					mockDeleteBucket: func(ctx context.Context, _ int64) error {
 						return errors.New("test-error")
 					},
 					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
@@ -482,6 +561,80 @@ func Test_bucketSyncDeleter_delete(t *testing.T) {
 				res: resultRequeue,
 			},
 		},
+		{
+			name: "DeleteChanged",
+			fields: fields{
+				ops: &mockOperations{
+					mockIsReclaimDelete:         func() bool { return true },
+					mockGetStatusMetageneration: func() int64 { return 3 },
+					mockDeleteBucket: func(ctx context.Context, _ int64) error {
+						return &googleapi.Error{Code: http.StatusPreconditionFailed}
+					},
+					mockGetAttributes: func(ctx context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{MetaGeneration: 4}, nil
+					},
+					mockSetStatusAttrs: func(attrs *storage.BucketAttrs) {
+						if attrs.MetaGeneration != 4 {
+							t.Errorf("setStatusAttrs(...): want metageneration 4, got %d", attrs.MetaGeneration)
+						}
+					},
+					mockSetStatusConditions: func(c ...runtimev1alpha1.Condition) {
+						if c[0].Type == runtimev1alpha1.TypeReady {
+							return
+						}
+						want := runtimev1alpha1.ReconcileError(errors.New(errDeleteChanged))
+						if diff := cmp.Diff(want, c[0]); diff != "" {
+							t.Errorf("setStatusConditions(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockUpdateStatus: func(ctx context.Context) error { return nil },
+				},
+			},
+			want: want{
+				res: resultRequeue,
+			},
+		},
+		{
+			name: "DeleteChangedFailedToGetAttributes",
+			fields: fields{
+				ops: &mockOperations{
+					mockIsReclaimDelete:         func() bool { return true },
+					mockGetStatusMetageneration: func() int64 { return 3 },
+					mockDeleteBucket: func(ctx context.Context, _ int64) error {
+						return &googleapi.Error{Code: http.StatusPreconditionFailed}
+					},
+					mockGetAttributes: func(ctx context.Context) (*storage.BucketAttrs, error) {
+						return nil, errors.New("test-error")
+					},
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			want: want{
+				res: resultRequeue,
+			},
+		},
+		{
+			name: "DeleteChangedNotFound",
+			fields: fields{
+				ops: &mockOperations{
+					mockIsReclaimDelete:         func() bool { return true },
+					mockGetStatusMetageneration: func() int64 { return 3 },
+					mockDeleteBucket: func(ctx context.Context, _ int64) error {
+						return &googleapi.Error{Code: http.StatusPreconditionFailed}
+					},
+					mockGetAttributes: func(ctx context.Context) (*storage.BucketAttrs, error) {
+						return nil, storage.ErrBucketNotExist
+					},
+					mockRemoveFinalizer:     func() {},
+					mockUpdateObject:        func(ctx context.Context) error { return nil },
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+				},
+			},
+			want: want{
+				res: reconcile.Result{},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -557,6 +710,21 @@ func Test_bucketSyncDeleter_sync(t *testing.T) {
 			},
 			want: want{res: reconcile.Result{}},
 		},
+		{
+			name: "GrantServiceAccountFailed",
+			fields: fields{
+				ops: &mockOperations{
+					mockUpdateSecret: func(ctx context.Context) error { return nil },
+					mockGetAttributes: func(ctx context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{}, nil
+					},
+					mockGrantServiceAccount: func(ctx context.Context) error { return errors.New("test-grant-error") },
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			want: want{res: resultRequeue},
+		},
 		{
 			name: "UpdateBucket",
 			fields: fields{
@@ -565,6 +733,7 @@ func Test_bucketSyncDeleter_sync(t *testing.T) {
 					mockGetAttributes: func(ctx context.Context) (*storage.BucketAttrs, error) {
 						return &storage.BucketAttrs{}, bucket404
 					},
+					mockGrantServiceAccount: func(ctx context.Context) error { return nil },
 				},
 				cu: &MockBucketCreateUpdater{
 					MockUpdate: func(ctx context.Context, attrs *storage.BucketAttrs) (reconcile.Result, error) {
@@ -599,8 +768,9 @@ func Test_bucketCreateUpdater_create(t *testing.T) {
 	testError := errors.New("test-error")
 
 	type fields struct {
-		ops       operations
-		projectID string
+		ops             operations
+		projectID       string
+		defaultLocation string
 	}
 	type want struct {
 		err error
@@ -676,12 +846,63 @@ func Test_bucketCreateUpdater_create(t *testing.T) {
 				res: requeueOnSuccess,
 			},
 		},
+		{
+			name: "DefaultLocation",
+			fields: fields{
+				ops: &mockOperations{
+					mockAddFinalizer:    func() {},
+					mockGetSpecLocation: func() string { return "" },
+					mockSetSpecLocation: func(l string) {
+						if diff := cmp.Diff("europe-west1", l); diff != "" {
+							t.Errorf("setSpecLocation(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockCreateBucket:        func(ctx context.Context, projectID string) error { return nil },
+					mockGetAttributes:       func(ctx context.Context) (*storage.BucketAttrs, error) { return nil, nil },
+					mockSetSpecAttrs:        func(attrs *storage.BucketAttrs) {},
+					mockUpdateObject:        func(ctx context.Context) error { return nil },
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockSetBindable:         func() {},
+					mockSetStatusAttrs:      func(attrs *storage.BucketAttrs) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+				defaultLocation: "europe-west1",
+			},
+			want: want{
+				res: requeueOnSuccess,
+			},
+		},
+		{
+			name: "SpecLocationWins",
+			fields: fields{
+				ops: &mockOperations{
+					mockAddFinalizer:    func() {},
+					mockGetSpecLocation: func() string { return "EU" },
+					mockSetSpecLocation: func(l string) {
+						t.Errorf("setSpecLocation(%q): unexpected call for a bucket with a location", l)
+					},
+					mockCreateBucket:        func(ctx context.Context, projectID string) error { return nil },
+					mockGetAttributes:       func(ctx context.Context) (*storage.BucketAttrs, error) { return nil, nil },
+					mockSetSpecAttrs:        func(attrs *storage.BucketAttrs) {},
+					mockUpdateObject:        func(ctx context.Context) error { return nil },
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockSetBindable:         func() {},
+					mockSetStatusAttrs:      func(attrs *storage.BucketAttrs) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+				defaultLocation: "europe-west1",
+			},
+			want: want{
+				res: requeueOnSuccess,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			bh := &bucketCreateUpdater{
-				operations: tt.fields.ops,
-				projectID:  tt.fields.projectID,
+				operations:      tt.fields.ops,
+				projectID:       tt.fields.projectID,
+				defaultLocation: tt.fields.defaultLocation,
 			}
 			got, err := bh.create(ctx)
 			if diff := cmp.Diff(tt.want.err, err, test.EquateErrors()); diff != "" {
@@ -694,9 +915,16 @@ func Test_bucketCreateUpdater_create(t *testing.T) {
 	}
 }
 
+// relocationUnsupported fails like relocating a bucket between locations that
+// do not support relocation.
+func relocationUnsupported(ctx context.Context) (*gcpstorage.Operation, error) {
+	return nil, &googleapi.Error{Code: http.StatusBadRequest, Errors: []googleapi.ErrorItem{{Reason: reasonRelocationUnsupported}}}
+}
+
 func Test_bucketCreateUpdater_update(t *testing.T) {
 	ctx := context.TODO()
 	testError := errors.New("test-error")
+	invalidLocation := &googleapi.Error{Code: http.StatusBadRequest, Errors: []googleapi.ErrorItem{{Reason: "invalid"}}}
 
 	type fields struct {
 		ops       operations
@@ -716,6 +944,8 @@ func Test_bucketCreateUpdater_update(t *testing.T) {
 			name: "NoChanges",
 			fields: fields{
 				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
 					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
 						return v1alpha3.BucketUpdatableAttrs{}
 					},
@@ -725,14 +955,73 @@ func Test_bucketCreateUpdater_update(t *testing.T) {
 			args: &storage.BucketAttrs{},
 			want: want{res: requeueOnSuccess},
 		},
+		{
+			name: "NoLabelChanges",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
+					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
+						return v1alpha3.BucketUpdatableAttrs{Labels: map[string]string{}}
+					},
+				},
+				projectID: "",
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "NoLifecycleChanges",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
+					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
+						return v1alpha3.BucketUpdatableAttrs{Lifecycle: &v1alpha3.Lifecycle{Rules: []v1alpha3.LifecycleRule{
+							{Action: v1alpha3.LifecycleAction{Type: "Delete"}, Condition: v1alpha3.LifecycleCondition{AgeInDays: 365}},
+							{
+								Action:    v1alpha3.LifecycleAction{Type: "SetStorageClass", StorageClass: "NEARLINE"},
+								Condition: v1alpha3.LifecycleCondition{AgeInDays: 30, MatchesStorageClasses: []string{"STANDARD", "MULTI_REGIONAL"}},
+							},
+						}}}
+					},
+				},
+				projectID: "",
+			},
+			args: &storage.BucketAttrs{Lifecycle: storage.Lifecycle{Rules: []storage.LifecycleRule{
+				{
+					Action:    storage.LifecycleAction{Type: "SetStorageClass", StorageClass: "NEARLINE"},
+					Condition: storage.LifecycleCondition{AgeInDays: 30, MatchesStorageClasses: []string{"MULTI_REGIONAL", "STANDARD"}},
+				},
+				{Action: storage.LifecycleAction{Type: "Delete"}, Condition: storage.LifecycleCondition{AgeInDays: 365}},
+			}}},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "UnmanagedLifecycle",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
+					mockGetSpecAttrs:        func() v1alpha3.BucketUpdatableAttrs { return v1alpha3.BucketUpdatableAttrs{} },
+				},
+				projectID: "",
+			},
+			args: &storage.BucketAttrs{Lifecycle: storage.Lifecycle{Rules: []storage.LifecycleRule{
+				{Action: storage.LifecycleAction{Type: "Delete"}, Condition: storage.LifecycleCondition{AgeInDays: 365}},
+			}}},
+			want: want{res: requeueOnSuccess},
+		},
 		{
 			name: "FailureToUpdateBucket",
 			fields: fields{
 				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
 					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
 						return v1alpha3.BucketUpdatableAttrs{RequesterPays: true}
 					},
-					mockUpdateBucket: func(ctx context.Context, labels map[string]string) (*storage.BucketAttrs, error) {
+					mockUpdateBucket: func(ctx context.Context, observed *storage.BucketAttrs) (*storage.BucketAttrs, error) {
 						return nil, testError
 					},
 					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
@@ -747,11 +1036,13 @@ func Test_bucketCreateUpdater_update(t *testing.T) {
 			name: "FailureToUpdateObject",
 			fields: fields{
 				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
 					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
 						return v1alpha3.BucketUpdatableAttrs{RequesterPays: true}
 					},
-					mockUpdateBucket: func(ctx context.Context, labels map[string]string) (*storage.BucketAttrs, error) {
-						return nil, nil
+					mockUpdateBucket: func(ctx context.Context, observed *storage.BucketAttrs) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{}, nil
 					},
 					mockSetSpecAttrs:        func(attrs *storage.BucketAttrs) {},
 					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
@@ -770,13 +1061,16 @@ func Test_bucketCreateUpdater_update(t *testing.T) {
 			name: "Successful",
 			fields: fields{
 				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
 					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
 						return v1alpha3.BucketUpdatableAttrs{RequesterPays: true}
 					},
-					mockUpdateBucket: func(ctx context.Context, labels map[string]string) (*storage.BucketAttrs, error) {
-						return nil, nil
+					mockUpdateBucket: func(ctx context.Context, observed *storage.BucketAttrs) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{}, nil
 					},
 					mockSetSpecAttrs:        func(attrs *storage.BucketAttrs) {},
+					mockSetStatusAttrs:      func(attrs *storage.BucketAttrs) {},
 					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
 					mockUpdateObject:        func(ctx context.Context) error { return nil },
 					mockUpdateStatus:        func(ctx context.Context) error { return nil },
@@ -788,6 +1082,397 @@ func Test_bucketCreateUpdater_update(t *testing.T) {
 				res: requeueOnSuccess,
 			},
 		},
+		{
+			name: "ShortenLockedRetention",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
+					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
+						return v1alpha3.BucketUpdatableAttrs{RetentionPolicy: &v1alpha3.RetentionPolicy{RetentionPeriodSeconds: 60, Locked: true}}
+					},
+					mockSetStatusConditions: func(c ...runtimev1alpha1.Condition) {
+						want := runtimev1alpha1.ReconcileError(errors.Errorf(errShortenLockedRetention, 3600))
+						if diff := cmp.Diff([]runtimev1alpha1.Condition{want}, c, test.EquateConditions()); diff != "" {
+							t.Errorf("setStatusConditions(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockUpdateStatus: func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: time.Hour, IsLocked: true}},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "LockRetentionPolicy",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
+					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
+						return v1alpha3.BucketUpdatableAttrs{RetentionPolicy: &v1alpha3.RetentionPolicy{RetentionPeriodSeconds: 3600, Locked: true}}
+					},
+					mockLockRetentionPolicy: func(ctx context.Context, metageneration int64) error {
+						if metageneration != 2 {
+							t.Errorf("lockRetentionPolicy(...): want metageneration 2, got %d", metageneration)
+						}
+						return nil
+					},
+					mockGetAttributes: func(ctx context.Context) (*storage.BucketAttrs, error) {
+						return &storage.BucketAttrs{RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: time.Hour, IsLocked: true}}, nil
+					},
+					mockSetSpecAttrs:        func(attrs *storage.BucketAttrs) {},
+					mockSetStatusAttrs:      func(attrs *storage.BucketAttrs) {},
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateObject:        func(ctx context.Context) error { return nil },
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: time.Hour}, MetaGeneration: 2},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "AlreadyLocked",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
+					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
+						return v1alpha3.BucketUpdatableAttrs{RetentionPolicy: &v1alpha3.RetentionPolicy{RetentionPeriodSeconds: 3600, Locked: true}}
+					},
+				},
+			},
+			args: &storage.BucketAttrs{RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: time.Hour, IsLocked: true}},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "StaleMetageneration",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return nil },
+					mockGetSpecAttrs: func() v1alpha3.BucketUpdatableAttrs {
+						return v1alpha3.BucketUpdatableAttrs{RequesterPays: true}
+					},
+					mockUpdateBucket: func(ctx context.Context, observed *storage.BucketAttrs) (*storage.BucketAttrs, error) {
+						return nil, &googleapi.Error{Code: http.StatusPreconditionFailed}
+					},
+				},
+				projectID: "",
+			},
+			args: &storage.BucketAttrs{MetaGeneration: 2},
+			want: want{res: resultRequeue},
+		},
+		{
+			name: "ImmutableChangeWithoutRecreate",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return []string{"location"} },
+					mockRelocateBucket:      relocationUnsupported,
+					mockIsRecreate:          func() bool { return false },
+					mockSetStatusConditions: func(c ...runtimev1alpha1.Condition) {
+						want := runtimev1alpha1.ReconcileError(errors.Errorf(errImmutableAttrs, "location", gcp.AnnotationKeyRecreate))
+						if diff := cmp.Diff([]runtimev1alpha1.Condition{want}, c, test.EquateConditions()); diff != "" {
+							t.Errorf("setStatusConditions(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockUpdateStatus: func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "RecreateFailureToCheckEmpty",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return []string{"location"} },
+					mockRelocateBucket:      relocationUnsupported,
+					mockIsRecreate:          func() bool { return true },
+					mockIsBucketEmpty:       func(ctx context.Context) (bool, error) { return false, testError },
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: resultRequeue},
+		},
+		{
+			name: "RecreateNotEmpty",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return []string{"location", "storageClass"} },
+					mockIsRecreate:          func() bool { return true },
+					mockIsBucketEmpty:       func(ctx context.Context) (bool, error) { return false, nil },
+					mockDeleteBucket: func(ctx context.Context, _ int64) error {
+						t.Errorf("deleteBucket(...): unexpected call")
+						return nil
+					},
+					mockSetStatusConditions: func(c ...runtimev1alpha1.Condition) {
+						want := runtimev1alpha1.ReconcileError(errors.Errorf(errRecreateNotEmpty, "location and storageClass"))
+						if diff := cmp.Diff([]runtimev1alpha1.Condition{want}, c, test.EquateConditions()); diff != "" {
+							t.Errorf("setStatusConditions(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockUpdateStatus: func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "RecreateFailureToDelete",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return []string{"location"} },
+					mockRelocateBucket:      relocationUnsupported,
+					mockIsRecreate:          func() bool { return true },
+					mockIsBucketEmpty:       func(ctx context.Context) (bool, error) { return true, nil },
+					mockDeleteBucket:        func(ctx context.Context, _ int64) error { return testError },
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: resultRequeue},
+		},
+		{
+			name: "Recreated",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return []string{"location"} },
+					mockRelocateBucket:      relocationUnsupported,
+					mockIsRecreate:          func() bool { return true },
+					mockIsBucketEmpty:       func(ctx context.Context) (bool, error) { return true, nil },
+					mockDeleteBucket: func(ctx context.Context, metageneration int64) error {
+						if metageneration != 5 {
+							t.Errorf("deleteBucket(...): want metageneration 5, got %d", metageneration)
+						}
+						return nil
+					},
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{MetaGeneration: 5},
+			want: want{res: resultRequeue},
+		},
+		{
+			name: "InvalidLocation",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return []string{"location"} },
+					mockRelocateBucket: func(ctx context.Context) (*gcpstorage.Operation, error) {
+						return nil, invalidLocation
+					},
+					mockIsRecreate: func() bool { return true },
+					mockIsBucketEmpty: func(ctx context.Context) (bool, error) {
+						t.Errorf("isBucketEmpty(...): unexpected call")
+						return true, nil
+					},
+					mockDeleteBucket: func(ctx context.Context, _ int64) error {
+						t.Errorf("deleteBucket(...): unexpected call")
+						return nil
+					},
+					mockSetStatusConditions: func(c ...runtimev1alpha1.Condition) {
+						want := runtimev1alpha1.ReconcileError(errors.Wrap(invalidLocation, errRelocate))
+						if diff := cmp.Diff([]runtimev1alpha1.Condition{want}, c, test.EquateConditions()); diff != "" {
+							t.Errorf("setStatusConditions(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockUpdateStatus: func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: resultRequeue},
+		},
+		{
+			name: "RelocationStarted",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return []string{"location"} },
+					mockRelocateBucket: func(ctx context.Context) (*gcpstorage.Operation, error) {
+						return &gcpstorage.Operation{Name: "op"}, nil
+					},
+					mockSetStatusRelocation: func(op *gcpstorage.Operation) {
+						if diff := cmp.Diff(&gcpstorage.Operation{Name: "op"}, op); diff != "" {
+							t.Errorf("setStatusRelocation(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "FailureToRelocate",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return nil },
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return []string{"location"} },
+					mockRelocateBucket: func(ctx context.Context) (*gcpstorage.Operation, error) {
+						return nil, testError
+					},
+					mockSetStatusConditions: func(c ...runtimev1alpha1.Condition) {
+						want := runtimev1alpha1.ReconcileError(errors.Wrap(testError, errRelocate))
+						if diff := cmp.Diff([]runtimev1alpha1.Condition{want}, c, test.EquateConditions()); diff != "" {
+							t.Errorf("setStatusConditions(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockUpdateStatus: func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: resultRequeue},
+		},
+		{
+			name: "RelocationInProgress",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation {
+						return &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "EU"}
+					},
+					mockGetRelocation: func(ctx context.Context, name string) (*gcpstorage.Operation, error) {
+						return &gcpstorage.Operation{Name: name}, nil
+					},
+					mockSetStatusRelocation: func(op *gcpstorage.Operation) {
+						if op == nil {
+							t.Errorf("setStatusRelocation(...): unexpected nil operation")
+						}
+					},
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "FailureToGetRelocation",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation {
+						return &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "EU"}
+					},
+					mockGetRelocation: func(ctx context.Context, name string) (*gcpstorage.Operation, error) {
+						return nil, testError
+					},
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: resultRequeue},
+		},
+		{
+			name: "RelocationFailed",
+			fields: fields{
+				ops: func() operations {
+					r := &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "EU"}
+					return &mockOperations{
+						mockGetStatusRelocation: func() *v1alpha3.BucketRelocation { return r },
+						mockGetRelocation: func(ctx context.Context, name string) (*gcpstorage.Operation, error) {
+							return &gcpstorage.Operation{Name: name, Done: true, Error: &gcpstorage.OperationError{Message: "boom"}}, nil
+						},
+						mockSetStatusRelocation: func(op *gcpstorage.Operation) {
+							r = &v1alpha3.BucketRelocation{Operation: op.Name, DestinationLocation: "EU", Failure: op.Error.Message}
+						},
+						mockSetStatusConditions: func(c ...runtimev1alpha1.Condition) {
+							want := runtimev1alpha1.ReconcileError(errors.Errorf(errRelocationFailed, "EU", "boom"))
+							if diff := cmp.Diff([]runtimev1alpha1.Condition{want}, c, test.EquateConditions()); diff != "" {
+								t.Errorf("setStatusConditions(...): -want, +got:\n%s", diff)
+							}
+						},
+						mockUpdateStatus: func(ctx context.Context) error { return nil },
+					}
+				}(),
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "RelocationPreviouslyFailed",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation {
+						return &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "EU", Failure: "boom"}
+					},
+					mockGetSpecLocation: func() string { return "eu" },
+					mockGetRelocation: func(ctx context.Context, name string) (*gcpstorage.Operation, error) {
+						t.Errorf("getRelocation(...): unexpected call")
+						return nil, nil
+					},
+					mockSetStatusConditions: func(c ...runtimev1alpha1.Condition) {
+						want := runtimev1alpha1.ReconcileError(errors.Errorf(errRelocationFailed, "EU", "boom"))
+						if diff := cmp.Diff([]runtimev1alpha1.Condition{want}, c, test.EquateConditions()); diff != "" {
+							t.Errorf("setStatusConditions(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockUpdateStatus: func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "RelocationFailedLocationChanged",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation {
+						return &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "EU", Failure: "boom"}
+					},
+					mockGetSpecLocation: func() string { return "ASIA" },
+					mockSetStatusRelocation: func(op *gcpstorage.Operation) {
+						if op != nil {
+							t.Errorf("setStatusRelocation(...): want nil operation, got %v", op)
+						}
+					},
+					mockGetChangedImmutable: func(*storage.BucketAttrs) []string { return []string{"location"} },
+					mockRelocateBucket:      relocationUnsupported,
+					mockIsRecreate:          func() bool { return false },
+					mockSetStatusConditions: func(c ...runtimev1alpha1.Condition) {
+						want := runtimev1alpha1.ReconcileError(errors.Errorf(errImmutableAttrs, "location", gcp.AnnotationKeyRecreate))
+						if diff := cmp.Diff([]runtimev1alpha1.Condition{want}, c, test.EquateConditions()); diff != "" {
+							t.Errorf("setStatusConditions(...): -want, +got:\n%s", diff)
+						}
+					},
+					mockUpdateStatus: func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: requeueOnSuccess},
+		},
+		{
+			name: "Relocated",
+			fields: fields{
+				ops: &mockOperations{
+					mockGetStatusRelocation: func() *v1alpha3.BucketRelocation {
+						return &v1alpha3.BucketRelocation{Operation: "op", DestinationLocation: "EU"}
+					},
+					mockGetRelocation: func(ctx context.Context, name string) (*gcpstorage.Operation, error) {
+						return &gcpstorage.Operation{Name: name, Done: true}, nil
+					},
+					mockSetStatusRelocation: func(op *gcpstorage.Operation) {
+						if op != nil {
+							t.Errorf("setStatusRelocation(...): want nil operation, got %v", op)
+						}
+					},
+					mockSetStatusConditions: func(_ ...runtimev1alpha1.Condition) {},
+					mockUpdateStatus:        func(ctx context.Context) error { return nil },
+				},
+			},
+			args: &storage.BucketAttrs{},
+			want: want{res: resultRequeue},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
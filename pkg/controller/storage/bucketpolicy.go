@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+
+	"cloud.google.com/go/storage"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	gcpstorage "github.com/crossplane/provider-gcp/pkg/clients/storage"
+)
+
+// Error strings.
+const (
+	errNotBucketPolicy = "managed resource is not a BucketPolicy"
+	errNewPolicyClient = "cannot create Cloud Storage IAM policy client"
+	errSetBucketPolicy = "cannot set IAM policy of bucket"
+)
+
+// SetupBucketPolicy adds a controller that reconciles BucketPolicies.
+func SetupBucketPolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.BucketPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.BucketPolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BucketPolicyGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&policyConnector{kube: mgr.GetClient(), newClient: gcpstorage.NewPolicyClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type policyConnector struct {
+	kube      client.Client
+	newClient func(ctx context.Context, opts ...option.ClientOption) (gcpstorage.PolicyClient, error)
+}
+
+// Connect returns an ExternalClient that manages the IAM policy of a bucket
+// using the credentials of the referenced Provider.
+func (c *policyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BucketPolicy)
+	if !ok {
+		return nil, errors.New(errNotBucketPolicy)
+	}
+	opts, _, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, storage.ScopeFullControl)
+	if err != nil {
+		return nil, err
+	}
+	pc, err := c.newClient(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewPolicyClient)
+	}
+	return &policyExternal{policies: pc}, nil
+}
+
+// A policyExternal manages the bindings a BucketPolicy declares in the IAM
+// policy of its bucket. Bindings are compared as sets of role and member
+// pairs, so their order does not matter. The bindings it last granted are
+// recorded in the BucketPolicy's status, so that members removed from its
+// spec are revoked while bindings it did not grant are left intact.
+type policyExternal struct {
+	policies gcpstorage.PolicyClient
+}
+
+func (e *policyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BucketPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBucketPolicy)
+	}
+	p, err := e.policies.Policy(ctx, gcp.StringValue(cr.Spec.ForProvider.Bucket))
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetBucketPolicy)
+	}
+
+	desired, recorded := cr.Spec.ForProvider.Bindings, cr.Status.AtProvider.Bindings
+	if !gcpstorage.HasAnyBinding(p, desired) && !gcpstorage.HasAnyBinding(p, recorded) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.SetConditions(runtimev1alpha1.Available())
+	stale := gcpstorage.StaleBindings(recorded, desired)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: gcpstorage.HasBindings(p, desired) && !gcpstorage.HasAnyBinding(p, stale),
+	}, nil
+}
+
+func (e *policyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BucketPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBucketPolicy)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	return managed.ExternalCreation{}, e.apply(ctx, cr)
+}
+
+func (e *policyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BucketPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBucketPolicy)
+	}
+	return managed.ExternalUpdate{}, e.apply(ctx, cr)
+}
+
+// apply grants the bindings the supplied BucketPolicy declares, and revokes
+// those it previously granted but no longer declares. The policy is written
+// with the etag it was read with, so that a concurrent change is not
+// overwritten; the write is retried on the next reconcile.
+func (e *policyExternal) apply(ctx context.Context, cr *v1alpha1.BucketPolicy) error {
+	bucket := gcp.StringValue(cr.Spec.ForProvider.Bucket)
+	p, err := e.policies.Policy(ctx, bucket)
+	if err != nil {
+		return errors.Wrap(err, errGetBucketPolicy)
+	}
+	gcpstorage.RemoveBindings(p, gcpstorage.StaleBindings(cr.Status.AtProvider.Bindings, cr.Spec.ForProvider.Bindings))
+	gcpstorage.AddBindings(p, cr.Spec.ForProvider.Bindings)
+	if err := e.policies.SetPolicy(ctx, bucket, p); err != nil {
+		return errors.Wrap(err, errSetBucketPolicy)
+	}
+	cr.Status.AtProvider.Bindings = gcpstorage.NormalizeBindings(cr.Spec.ForProvider.Bindings)
+	return nil
+}
+
+func (e *policyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BucketPolicy)
+	if !ok {
+		return errors.New(errNotBucketPolicy)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	bucket := gcp.StringValue(cr.Spec.ForProvider.Bucket)
+	p, err := e.policies.Policy(ctx, bucket)
+	if gcp.IsErrorNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetBucketPolicy)
+	}
+	granted := append(append([]v1alpha1.BucketPolicyBinding{}, cr.Spec.ForProvider.Bindings...), cr.Status.AtProvider.Bindings...)
+	if !gcpstorage.HasAnyBinding(p, granted) {
+		return nil
+	}
+	gcpstorage.RemoveBindings(p, granted)
+	return errors.Wrap(e.policies.SetPolicy(ctx, bucket, p), errSetBucketPolicy)
+}
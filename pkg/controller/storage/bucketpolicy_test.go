@@ -0,0 +1,342 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"cloud.google.com/go/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/storage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/storage/fake"
+)
+
+const (
+	policyBucket = "cool-bucket"
+	roleViewer   = "roles/storage.objectViewer"
+	roleAdmin    = "roles/storage.objectAdmin"
+	memberAlice  = "user:alice@example.com"
+	memberBob    = "user:bob@example.com"
+	memberOther  = "group:others@example.com"
+)
+
+var errPolicyBoom = errors.New("boom")
+
+type bucketPolicyModifier func(*v1alpha1.BucketPolicy)
+
+func withBindings(b ...v1alpha1.BucketPolicyBinding) bucketPolicyModifier {
+	return func(p *v1alpha1.BucketPolicy) { p.Spec.ForProvider.Bindings = b }
+}
+
+func withGranted(b ...v1alpha1.BucketPolicyBinding) bucketPolicyModifier {
+	return func(p *v1alpha1.BucketPolicy) { p.Status.AtProvider.Bindings = b }
+}
+
+func withPolicyConditions(c ...runtimev1alpha1.Condition) bucketPolicyModifier {
+	return func(p *v1alpha1.BucketPolicy) { p.Status.SetConditions(c...) }
+}
+
+func bucketPolicy(m ...bucketPolicyModifier) *v1alpha1.BucketPolicy {
+	p := &v1alpha1.BucketPolicy{
+		Spec: v1alpha1.BucketPolicySpec{
+			ForProvider: v1alpha1.BucketPolicyParameters{Bucket: gcp.StringPtr(policyBucket)},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+// policy returns an IAM policy that grants each role to the supplied members.
+func policy(grants map[string][]string) *iam.Policy {
+	p := &iam.Policy{}
+	for r, members := range grants {
+		for _, m := range members {
+			p.Add(m, iam.RoleName(r))
+		}
+	}
+	return p
+}
+
+func policyClient(p *iam.Policy, setErr error, set **iam.Policy) *fake.MockPolicyClient {
+	return &fake.MockPolicyClient{
+		MockPolicy: func(_ context.Context, bucket string) (*iam.Policy, error) {
+			if bucket != policyBucket {
+				return nil, errors.Errorf("unexpected bucket %q", bucket)
+			}
+			return p, nil
+		},
+		MockSetPolicy: func(_ context.Context, _ string, p *iam.Policy) error {
+			if set != nil {
+				*set = p
+			}
+			return setErr
+		},
+	}
+}
+
+// grants returns the members of each role of the supplied policy.
+func grants(p *iam.Policy) map[string][]string {
+	if p == nil {
+		return nil
+	}
+	g := map[string][]string{}
+	for _, r := range p.Roles() {
+		g[string(r)] = p.Members(r)
+	}
+	return g
+}
+
+func TestBucketPolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		o   managed.ExternalObservation
+		err error
+	}
+	cases := map[string]struct {
+		reason   string
+		policies *fake.MockPolicyClient
+		mg       resource.Managed
+		want     want
+	}{
+		"GetFailed": {
+			reason: "Errors getting the policy should be returned",
+			policies: &fake.MockPolicyClient{MockPolicy: func(_ context.Context, _ string) (*iam.Policy, error) {
+				return nil, errPolicyBoom
+			}},
+			mg:   bucketPolicy(),
+			want: want{mg: bucketPolicy(), err: errors.Wrap(errPolicyBoom, errGetBucketPolicy)},
+		},
+		"BucketNotFound": {
+			reason: "A BucketPolicy of a bucket that does not exist should not exist",
+			policies: &fake.MockPolicyClient{MockPolicy: func(_ context.Context, _ string) (*iam.Policy, error) {
+				return nil, &googleapi.Error{Code: http.StatusNotFound}
+			}},
+			mg:   bucketPolicy(),
+			want: want{mg: bucketPolicy()},
+		},
+		"NoBindingGranted": {
+			reason:   "A BucketPolicy none of whose bindings are granted should not exist",
+			policies: policyClient(policy(map[string][]string{roleViewer: {memberOther}}), nil, nil),
+			mg:       bucketPolicy(withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice}})),
+			want: want{
+				mg: bucketPolicy(withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice}})),
+			},
+		},
+		"UpToDateInAnyOrder": {
+			reason: "Bindings should be compared regardless of order, and bindings the BucketPolicy does not declare should be ignored",
+			policies: policyClient(policy(map[string][]string{
+				roleViewer: {memberOther, memberBob, memberAlice},
+				roleAdmin:  {memberOther},
+			}), nil, nil),
+			mg: bucketPolicy(withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice, memberBob}})),
+			want: want{
+				mg: bucketPolicy(
+					withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice, memberBob}}),
+					withPolicyConditions(runtimev1alpha1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"MissingMember": {
+			reason:   "A BucketPolicy whose members are not all granted their role should not be up to date",
+			policies: policyClient(policy(map[string][]string{roleViewer: {memberAlice}}), nil, nil),
+			mg:       bucketPolicy(withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice, memberBob}})),
+			want: want{
+				mg: bucketPolicy(
+					withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice, memberBob}}),
+					withPolicyConditions(runtimev1alpha1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"StaleMember": {
+			reason:   "A BucketPolicy that previously granted a member it no longer declares should not be up to date",
+			policies: policyClient(policy(map[string][]string{roleViewer: {memberAlice, memberBob}}), nil, nil),
+			mg: bucketPolicy(
+				withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice}}),
+				withGranted(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice, memberBob}})),
+			want: want{
+				mg: bucketPolicy(
+					withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice}}),
+					withGranted(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice, memberBob}}),
+					withPolicyConditions(runtimev1alpha1.Available())),
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &policyExternal{policies: tc.policies}
+			o, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBucketPolicyUpdate(t *testing.T) {
+	type want struct {
+		granted []v1alpha1.BucketPolicyBinding
+		set     map[string][]string
+		err     error
+	}
+	cases := map[string]struct {
+		reason   string
+		observed *iam.Policy
+		setErr   error
+		mg       *v1alpha1.BucketPolicy
+		want     want
+	}{
+		"GrantAndRevoke": {
+			reason: "Declared members should be granted and previously granted members that are no longer declared revoked, leaving other bindings intact",
+			observed: policy(map[string][]string{
+				roleViewer: {memberAlice, memberOther},
+				roleAdmin:  {memberBob},
+			}),
+			mg: bucketPolicy(
+				withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberBob, memberBob}}),
+				withGranted(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice}})),
+			want: want{
+				granted: []v1alpha1.BucketPolicyBinding{{Role: roleViewer, Members: []string{memberBob}}},
+				set: map[string][]string{
+					roleViewer: {memberOther, memberBob},
+					roleAdmin:  {memberBob},
+				},
+			},
+		},
+		"SetFailed": {
+			reason:   "Errors setting the policy, for example because its etag changed, should be returned",
+			observed: policy(nil),
+			setErr:   errPolicyBoom,
+			mg:       bucketPolicy(withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice}})),
+			want: want{
+				set: map[string][]string{roleViewer: {memberAlice}},
+				err: errors.Wrap(errPolicyBoom, errSetBucketPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set *iam.Policy
+			e := &policyExternal{policies: policyClient(tc.observed, tc.setErr, &set)}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.set, grants(set), cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want policy, +got policy:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.granted, tc.mg.Status.AtProvider.Bindings); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want granted, +got granted:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBucketPolicyDelete(t *testing.T) {
+	type want struct {
+		set map[string][]string
+		err error
+	}
+	cases := map[string]struct {
+		reason   string
+		policies func(set **iam.Policy) *fake.MockPolicyClient
+		mg       resource.Managed
+		want     want
+	}{
+		"BucketNotFound": {
+			reason: "Deleting the BucketPolicy of a bucket that no longer exists should succeed",
+			policies: func(_ **iam.Policy) *fake.MockPolicyClient {
+				return &fake.MockPolicyClient{MockPolicy: func(_ context.Context, _ string) (*iam.Policy, error) {
+					return nil, &googleapi.Error{Code: http.StatusNotFound}
+				}}
+			},
+			mg: bucketPolicy(),
+		},
+		"AlreadyRevoked": {
+			reason: "The policy should not be written if none of the bindings are granted",
+			policies: func(set **iam.Policy) *fake.MockPolicyClient {
+				return policyClient(policy(map[string][]string{roleViewer: {memberOther}}), nil, set)
+			},
+			mg: bucketPolicy(withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice}})),
+		},
+		"RevokeManagedBindings": {
+			reason: "Only bindings the BucketPolicy declares or granted should be revoked",
+			policies: func(set **iam.Policy) *fake.MockPolicyClient {
+				return policyClient(policy(map[string][]string{
+					roleViewer: {memberAlice, memberBob, memberOther},
+					roleAdmin:  {memberAlice},
+				}), nil, set)
+			},
+			mg: bucketPolicy(
+				withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice}}),
+				withGranted(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberBob}})),
+			want: want{
+				set: map[string][]string{
+					roleViewer: {memberOther},
+					roleAdmin:  {memberAlice},
+				},
+			},
+		},
+		"SetFailed": {
+			reason: "Errors setting the policy should be returned",
+			policies: func(set **iam.Policy) *fake.MockPolicyClient {
+				return policyClient(policy(map[string][]string{roleViewer: {memberAlice}}), errPolicyBoom, set)
+			},
+			mg: bucketPolicy(withBindings(v1alpha1.BucketPolicyBinding{Role: roleViewer, Members: []string{memberAlice}})),
+			want: want{
+				set: map[string][]string{},
+				err: errors.Wrap(errPolicyBoom, errSetBucketPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set *iam.Policy
+			e := &policyExternal{policies: tc.policies(&set)}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.set, grants(set), cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want policy, +got policy:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
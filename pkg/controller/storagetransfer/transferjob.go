@@ -0,0 +1,239 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package storagetransfer contains controllers for GCP Storage Transfer
+// Service resources.
+package storagetransfer
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	storagetransfer "google.golang.org/api/storagetransfer/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/storagetransfer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	sts "github.com/crossplane/provider-gcp/pkg/clients/storagetransfer"
+)
+
+// Error strings.
+const (
+	errNewClient = "cannot create new Storage Transfer Service client"
+
+	errNotTransferJob    = "managed resource is not a TransferJob"
+	errUpdateCR          = "cannot update TransferJob custom resource"
+	errGetTransferJob    = "cannot get transfer job"
+	errListOperations    = "cannot list transfer operations"
+	errCreateTransferJob = "cannot create transfer job"
+	errUpdateTransferJob = "cannot update transfer job"
+	errDeleteTransferJob = "cannot delete transfer job"
+	errGetAWSAccessKey   = "cannot get AWS access key"
+	errScheduleImmutable = "cannot change the schedule of an existing transfer job"
+)
+
+const (
+	// operationsName is the resource name under which the transfer operations
+	// of all jobs are listed.
+	operationsName = "transferOperations"
+
+	// statusMask is the field mask used to soft delete a transfer job.
+	statusMask = "status"
+)
+
+type newServiceFn func(ctx context.Context, opts ...option.ClientOption) (*storagetransfer.Service, error)
+
+// SetupTransferJob adds a controller that reconciles TransferJobs.
+func SetupTransferJob(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TransferJobGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TransferJob{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TransferJobGroupVersionKind),
+			managed.WithExternalConnecter(gcp.NewTracingConnecter(&transferJobConnector{kube: mgr.GetClient(), newServiceFn: storagetransfer.NewService})),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type transferJobConnector struct {
+	kube         client.Client
+	newServiceFn newServiceFn
+}
+
+// Connect returns an ExternalClient with necessary information to talk to GCP API.
+func (c *transferJobConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TransferJob)
+	if !ok {
+		return nil, errors.New(errNotTransferJob)
+	}
+	opts, p, err := gcp.ProviderClientOptions(ctx, c.kube, cr.Spec.ProviderReference, storagetransfer.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	s, err := c.newServiceFn(ctx, opts...)
+	return &transferJobExternal{kube: c.kube, sts: s, projectID: p.Spec.ProjectID}, errors.Wrap(err, errNewClient)
+}
+
+// A transferJobExternal manages a transfer job. Transfer jobs cannot be
+// deleted outright; their status is set to DELETED instead, after which they
+// are considered gone.
+type transferJobExternal struct {
+	kube      client.Client
+	sts       *storagetransfer.Service
+	projectID string
+}
+
+func (e *transferJobExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TransferJob)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTransferJob)
+	}
+	name := sts.TransferJobName(meta.GetExternalName(cr))
+	observed, err := e.sts.TransferJobs.Get(name).ProjectId(e.projectID).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetTransferJob)
+	}
+	if observed.Status == v1alpha1.TransferJobStatusDeleted {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	sts.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
+		}
+	}
+
+	var ops []*storagetransfer.Operation
+	err = e.sts.TransferOperations.List(operationsName).Filter(sts.OperationsFilter(e.projectID, name)).Pages(ctx, func(rsp *storagetransfer.ListOperationsResponse) error {
+		ops = append(ops, rsp.Operations...)
+		return nil
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListOperations)
+	}
+	latest, err := sts.LatestOperation(ops)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListOperations)
+	}
+
+	cr.Status.AtProvider = sts.GenerateTransferJobObservation(*observed, latest)
+	cr.SetConditions(sts.Condition(observed.Status))
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: sts.IsUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (e *transferJobExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TransferJob)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTransferJob)
+	}
+	cr.SetConditions(runtimev1alpha1.Creating())
+	job, err := e.generate(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err = e.sts.TransferJobs.Create(job).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTransferJob)
+}
+
+func (e *transferJobExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TransferJob)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTransferJob)
+	}
+	name := sts.TransferJobName(meta.GetExternalName(cr))
+	observed, err := e.sts.TransferJobs.Get(name).ProjectId(e.projectID).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTransferJob)
+	}
+	if !sts.IsScheduleUpToDate(cr.Spec.ForProvider, *observed) {
+		return managed.ExternalUpdate{}, errors.New(errScheduleImmutable)
+	}
+	job, err := e.generate(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// The schedule of a transfer job cannot be updated.
+	job.Schedule = nil
+	req := &storagetransfer.UpdateTransferJobRequest{ProjectId: e.projectID, TransferJob: job, UpdateTransferJobFieldMask: sts.UpdateMask}
+	_, err = e.sts.TransferJobs.Patch(name, req).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTransferJob)
+}
+
+func (e *transferJobExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TransferJob)
+	if !ok {
+		return errors.New(errNotTransferJob)
+	}
+	cr.SetConditions(runtimev1alpha1.Deleting())
+	req := &storagetransfer.UpdateTransferJobRequest{
+		ProjectId:                  e.projectID,
+		TransferJob:                &storagetransfer.TransferJob{Status: v1alpha1.TransferJobStatusDeleted},
+		UpdateTransferJobFieldMask: statusMask,
+	}
+	_, err := e.sts.TransferJobs.Patch(sts.TransferJobName(meta.GetExternalName(cr)), req).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTransferJob)
+}
+
+// generate returns the transfer job of the supplied TransferJob, including
+// the access key of its Amazon S3 source, if any.
+func (e *transferJobExternal) generate(ctx context.Context, cr *v1alpha1.TransferJob) (*storagetransfer.TransferJob, error) {
+	job := sts.GenerateTransferJob(e.projectID, sts.TransferJobName(meta.GetExternalName(cr)), cr.Spec.ForProvider)
+	src := cr.Spec.ForProvider.TransferSpec.AWSS3DataSource
+	if src == nil {
+		return job, nil
+	}
+	id, err := e.secretValue(ctx, src.AccessKeyIDSecretRef)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAWSAccessKey)
+	}
+	secret, err := e.secretValue(ctx, src.SecretAccessKeySecretRef)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAWSAccessKey)
+	}
+	job.TransferSpec.AwsS3DataSource.AwsAccessKey = &storagetransfer.AwsAccessKey{AccessKeyId: id, SecretAccessKey: secret}
+	return job, nil
+}
+
+func (e *transferJobExternal) secretValue(ctx context.Context, ref runtimev1alpha1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", err
+	}
+	return string(s.Data[ref.Key]), nil
+}
@@ -0,0 +1,479 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagetransfer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	storagetransfer "google.golang.org/api/storagetransfer/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplane/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/storagetransfer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID      = "myproject-id-1234"
+	providerName   = "gcp-provider"
+	jobName        = "transferJobs/nightly"
+	jobPath        = "/v1/" + jobName
+	operationsPath = "/v1/transferOperations"
+)
+
+var (
+	_ managed.ExternalConnecter = &transferJobConnector{}
+	_ managed.ExternalClient    = &transferJobExternal{}
+
+	errBoom = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type transferJobModifier func(*v1alpha1.TransferJob)
+
+func transferJobWithConditions(c ...runtimev1alpha1.Condition) transferJobModifier {
+	return func(j *v1alpha1.TransferJob) { j.Status.SetConditions(c...) }
+}
+
+func transferJobWithObservation(o v1alpha1.TransferJobObservation) transferJobModifier {
+	return func(j *v1alpha1.TransferJob) { j.Status.AtProvider = o }
+}
+
+func transferJobWithAWSSource() transferJobModifier {
+	return func(j *v1alpha1.TransferJob) {
+		j.Spec.ForProvider.TransferSpec.GCSDataSource = nil
+		j.Spec.ForProvider.TransferSpec.AWSS3DataSource = &v1alpha1.AWSS3Data{
+			BucketName:               "s3-bucket",
+			AccessKeyIDSecretRef:     runtimev1alpha1.SecretKeySelector{SecretReference: runtimev1alpha1.SecretReference{Name: "aws", Namespace: "default"}, Key: "id"},
+			SecretAccessKeySecretRef: runtimev1alpha1.SecretKeySelector{SecretReference: runtimev1alpha1.SecretReference{Name: "aws", Namespace: "default"}, Key: "secret"},
+		}
+	}
+}
+
+func transferJob(m ...transferJobModifier) *v1alpha1.TransferJob {
+	j := &v1alpha1.TransferJob{
+		Spec: v1alpha1.TransferJobSpec{
+			ResourceSpec: runtimev1alpha1.ResourceSpec{
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
+			},
+			ForProvider: v1alpha1.TransferJobParameters{
+				Description: gcp.StringPtr("nightly"),
+				TransferSpec: v1alpha1.TransferSpec{
+					GCSDataSource: &v1alpha1.GCSData{BucketName: gcp.StringPtr("source")},
+					GCSDataSink:   v1alpha1.GCSData{BucketName: gcp.StringPtr("sink")},
+				},
+				Schedule: v1alpha1.Schedule{
+					ScheduleStartDate: v1alpha1.Date{Year: 2020, Month: 6, Day: 1},
+				},
+				Status: gcp.StringPtr(v1alpha1.TransferJobStatusEnabled),
+			},
+		},
+	}
+	meta.SetExternalName(j, "nightly")
+	for _, f := range m {
+		f(j)
+	}
+	return j
+}
+
+func observedJob(status string) *storagetransfer.TransferJob {
+	return &storagetransfer.TransferJob{
+		Name:        jobName,
+		ProjectId:   projectID,
+		Description: "nightly",
+		Status:      status,
+		TransferSpec: &storagetransfer.TransferSpec{
+			GcsDataSource: &storagetransfer.GcsData{BucketName: "source"},
+			GcsDataSink:   &storagetransfer.GcsData{BucketName: "sink"},
+		},
+		Schedule: &storagetransfer.Schedule{
+			ScheduleStartDate: &storagetransfer.Date{Year: 2020, Month: 6, Day: 1},
+		},
+	}
+}
+
+// jobHandler serves the supplied transfer job and a single transfer operation.
+func jobHandler(t *testing.T, j *storagetransfer.TransferJob) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		switch r.URL.Path {
+		case jobPath:
+			_ = json.NewEncoder(w).Encode(j)
+		case operationsPath:
+			if diff := cmp.Diff(`{"project_id":"`+projectID+`","job_names":["`+jobName+`"]}`, r.URL.Query().Get("filter")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			md, _ := json.Marshal(&storagetransfer.TransferOperation{Name: "transferOperations/1", Status: "SUCCESS"})
+			_ = json.NewEncoder(w).Encode(&storagetransfer.ListOperationsResponse{
+				Operations: []*storagetransfer.Operation{{Name: "transferOperations/1", Metadata: md}},
+			})
+		default:
+			t.Errorf("unexpected request path %s", r.URL.Path)
+		}
+	})
+}
+
+func TestTransferJobObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTransferJob": {
+			mg: &fake.Managed{},
+			want: want{
+				mg:  &fake.Managed{},
+				err: errors.New(errNotTransferJob),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&storagetransfer.Empty{})
+			}),
+			mg: transferJob(),
+			want: want{
+				mg:  transferJob(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Deleted": {
+			handler: jobHandler(t, observedJob(v1alpha1.TransferJobStatusDeleted)),
+			mg:      transferJob(),
+			want: want{
+				mg:  transferJob(),
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"UpToDate": {
+			handler: jobHandler(t, observedJob(v1alpha1.TransferJobStatusEnabled)),
+			mg:      transferJob(),
+			want: want{
+				mg: transferJob(
+					transferJobWithConditions(runtimev1alpha1.Available()),
+					transferJobWithObservation(v1alpha1.TransferJobObservation{
+						Name:            jobName,
+						Status:          v1alpha1.TransferJobStatusEnabled,
+						LatestOperation: &v1alpha1.TransferOperationObservation{Name: "transferOperations/1", Status: "SUCCESS"},
+					})),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NeedsUpdate": {
+			handler: jobHandler(t, observedJob(v1alpha1.TransferJobStatusDisabled)),
+			mg:      transferJob(),
+			want: want{
+				mg: transferJob(
+					transferJobWithConditions(runtimev1alpha1.Available()),
+					transferJobWithObservation(v1alpha1.TransferJobObservation{
+						Name:            jobName,
+						Status:          v1alpha1.TransferJobStatusDisabled,
+						LatestOperation: &v1alpha1.TransferOperationObservation{Name: "transferOperations/1", Status: "SUCCESS"},
+					})),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"LateInitFailed": {
+			handler: jobHandler(t, observedJob(v1alpha1.TransferJobStatusEnabled)),
+			kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:      transferJob(func(j *v1alpha1.TransferJob) { j.Spec.ForProvider.Description = nil }),
+			want: want{
+				mg:  transferJob(),
+				err: errors.Wrap(errBoom, errUpdateCR),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&storagetransfer.Empty{})
+			}),
+			mg: transferJob(),
+			want: want{
+				mg:  transferJob(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetTransferJob),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagetransfer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := transferJobExternal{kube: tc.kube, sts: s, projectID: projectID}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransferJobCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				j := &storagetransfer.TransferJob{}
+				if err := json.NewDecoder(r.Body).Decode(j); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(jobName, j.Name); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(j)
+			}),
+			mg: transferJob(),
+		},
+		"AWSAccessKey": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				j := &storagetransfer.TransferJob{}
+				if err := json.NewDecoder(r.Body).Decode(j); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				want := &storagetransfer.AwsAccessKey{AccessKeyId: "id", SecretAccessKey: "secret"}
+				if diff := cmp.Diff(want, j.TransferSpec.AwsS3DataSource.AwsAccessKey); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(j)
+			}),
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				s := obj.(*corev1.Secret)
+				s.Data = map[string][]byte{"id": []byte("id"), "secret": []byte("secret")}
+				return nil
+			}},
+			mg: transferJob(transferJobWithAWSSource()),
+		},
+		"GetAWSAccessKeyFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:   transferJob(transferJobWithAWSSource()),
+			err:  errors.Wrap(errBoom, errGetAWSAccessKey),
+		},
+		"CreateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&storagetransfer.Empty{})
+			}),
+			mg:  transferJob(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateTransferJob),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagetransfer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := transferJobExternal{kube: tc.kube, sts: s, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransferJobUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					_ = r.Body.Close()
+					_ = json.NewEncoder(w).Encode(observedJob(v1alpha1.TransferJobStatusDisabled))
+					return
+				}
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				req := &storagetransfer.UpdateTransferJobRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff("description,transfer_spec,status", req.UpdateTransferJobFieldMask); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(v1alpha1.TransferJobStatusEnabled, req.TransferJob.Status); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(req.TransferJob)
+			}),
+			mg: transferJob(),
+		},
+		"ScheduleImmutable": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				j := observedJob(v1alpha1.TransferJobStatusEnabled)
+				j.Schedule.ScheduleStartDate.Day = 2
+				_ = json.NewEncoder(w).Encode(j)
+			}),
+			mg:  transferJob(),
+			err: errors.New(errScheduleImmutable),
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(observedJob(v1alpha1.TransferJobStatusDisabled))
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&storagetransfer.Empty{})
+			}),
+			mg:  transferJob(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateTransferJob),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagetransfer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := transferJobExternal{sts: s, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransferJobDelete(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(jobPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				req := &storagetransfer.UpdateTransferJobRequest{}
+				if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+					t.Errorf("cannot decode request body: %s", err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(v1alpha1.TransferJobStatusDeleted, req.TransferJob.Status); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(req.TransferJob)
+			}),
+			mg: transferJob(),
+			want: want{
+				mg: transferJob(transferJobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&storagetransfer.Empty{})
+			}),
+			mg: transferJob(),
+			want: want{
+				mg: transferJob(transferJobWithConditions(runtimev1alpha1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&storagetransfer.Empty{})
+			}),
+			mg: transferJob(),
+			want: want{
+				mg:  transferJob(transferJobWithConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTransferJob),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := storagetransfer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := transferJobExternal{sts: s, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}